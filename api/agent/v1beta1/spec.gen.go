@@ -19,173 +19,207 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9i3LcNrbgr2A5t8r2THdLspOso6pbuYr8iDaWpatHUve6tTGaPN2NiAQYAJTcSalq",
-	"/2H/cL9kCy8SJMFu9sNxZkozVXGLeB0cHBwcnBf+iGKW5YwClSI6/CMS8RwyrH/GjMMvdwcTkPjgF5YD",
-	"xTn55WgiWFpIOMdyriolIGJOckkYjQ6jC8g5CNUXwhRhWxdNSQoox3I+igZRzlkOXBLQg+TBfq7mULVW",
-	"VZBkCJt+GEVyDkgshIRshN4zCUjOsUSYLhB8IkISOjNV70maogkgdgf8nhMpgSoI4BPO8hSiw2jvDvO9",
-	"lM32cJ6PUjaLBpFc5KpESE7oLHp4KL+wya8Qy+hh0IGYnPwEXGj4m9M5Oj+xZSiBKaEg9BTuzDdIkME6",
-	"YlMk50Qg7tCIVQfqM6bIjD9Cl8BVQyTmrEgTFDN6B1wiDjGbUfJ72ZtQOFPDpFiCkIhQCZziFN3htIAB",
-	"wjRBGV4gDqpfVFCvB11FjNAp44AInbJDNJcyF4d7ezMiR7cvxYiwvZhlWUGJXOzFjEpOJoVkXOwlcAfp",
-	"niCzIebxnEiIZcFhD+dkqIGlalJilCV/4yBYwWMQelVokUWHHyKL2GgQTVMym8tYpmqw6nN001ylQfRp",
-	"OGPDxtJ1LlR+pSuGiE51YZYBEM7zlMR6CXz49H4REA2i3wqcpCAjNRCVmFDg0SCaQ5qFQVQ9DO8wpzhT",
-	"xP8hsqAclz3aD/9ZdlzWqPq3n37QwyyZpINdtQUq1Xxxmp5No8MPf0T/xmEaHUZ/26t2/54lm71gh29I",
-	"Cq6nh8EGHVxAiiW5M4xD9cDht4JwSBQiNBe4aW21PtN7Te9+wtzwkhpngaoAJwlRdXF6XqvSIpg6Qbym",
-	"d4QzmgGV6A5zgicpoFtYDPX2QDkmXAwQoQpYSFBSqG4QL6gkGYyQoqdbWOiNZloAjucoK4RUTGkC8h6A",
-	"ogNd4fnXL1A8xxzHErjeDw1crMGIStycMy7bdK6+ogznuYKWUDRlPMMSjaM5E1IVHpbkrP4aR+gpjGaj",
-	"ARpHL/df7h++3B9Hz+p81H5X3B1LxWaiw+h/j8fJPw7Vf/6tzVZ7wM7ZHUmAf49FYK8esyxjFFUrrmaB",
-	"cJr6u1bvZtE+c3DFAdYmY8c9HgaR2sYdR5cHg6pVrvnB//s//7e+0ihldDZAQmIu0T2Rc4RRCgqHiHFE",
-	"i2wC3PBquyiIMnSvuKrIcQyj4Inlby032ZtN6MeuwWUOsZ4pUTPNCMWScfXBUpGRFgwT60Cm5XFe5zW2",
-	"2dnKVqi30yy2o4nii/Xajk13NLDM1m/zUFLM4r1e5BKLD4OIUdiUiQaQsBEvDYK8ETwB9G7UUxPrvVj3",
-	"hT3+35GMGOmzKU2acpTqCnqLBw7m+uaO8yLALs6vTSeK3ymwxAi9MWyPg9o4moFPsIBEyZdNHlJndvuj",
-	"//l1iKNlkDG+aA9+qr/b8fUWZ7k5jZCSnraA5PnX32RbiaytpVi2CjGjQnJMaN+lSMt13YTNdlDJRtO7",
-	"lFgWIiz2mTItZCNB6Cyt82973Ujgjhh26+TAcw45trLdpWLf5udFQan59ZpzpgS2a3pL2b3iRGr/pyAh",
-	"6S8f1mfgj9kq9IBolVVQtYocmK2CCu5WkTeRHti/FsDb8hkv6JEIn5+FAI0EJ4mbu57+bC5I/gLZe9AE",
-	"lOSFCpoAV7IXEYgIRJk0PajesLl26W7UPiRU3xnLU0gEJH/0lEzd35MUno3QK5jiIpXl9cpChc1AeKak",
-	"RV5QoYZ7OgMKHKfpAnHG5DNEphokkUNMpgSS9vldv8tcW0z4n4filuRDx0OGOdM3u+hQ8gI22h0/sbQw",
-	"okx1Qagvyit7a8VanEnQnW6hpp6gyULf9ZcxgrCkdE3JbwUgf6H9fu0KBbhMi/NyiFNMsnOWknixLb8x",
-	"2LioddkUqvSEAhLVH9tIBycZnoEZvSZ4bXQgn7KCyl11piHr7PGm12EfaNliCWb5A0zhHRFSc2hvY9rK",
-	"iiCIhEzsZt2jagNhzvEi2mJHXTTJMjGsQx+qiuVEg459Nmf3HjeZY5qkevfZ/XE/B7Mx2L3i6jW0aPVR",
-	"xu4Mb3GHlR3vZoOLmJmL4fvLT8+dcIX3LXbQseWnwIHGEBJYbJHj0AnkKVtAgs6OT4aKMlKCqUREUbW6",
-	"Zaljc4pjiSY4vlX4XDp2iBX48Gxy0RKXRZZhvugpp9SvuaJbRvkBcCrni2gQvYIZxwkkQbnkPfNhWV84",
-	"qYNfDdpZxYOms05ALqlXCMon9SrNiXUtxbGiyqmqB5dkpvbUBfxWgAhoTzqrVlpbtVO5/ajVEkiQGYUE",
-	"xVVbNOUs06t2fBTSUPjK5A24Wtn8YRDdEpq05/EjoYliFhiZRbYqqnISbh9cvL68Qk5Ra6QvQ9fefCul",
-	"dIYXiNCpk9PKSQJNtJyi/4hTotiaKCb6WmdRpQSqETrGVAlIE0BFnmAJyQidUHSMM0iPsYDPrpLWKqOh",
-	"QpkYhS95EidY4o3W5Uwj7hQkVl0Jewauf23vIkF7wkeiZNY77Nv02VYvlaRmKc3DkZ1jb464fGItEv6Z",
-	"4zwHxb9ZQROEtSg+jDkoukHHlxcDlLEEUnOVvi0mwClIEIgwTS84JyNvS4rR3cFoKQjtjQqfcsLNxQhi",
-	"RpPg/Vm3Nwri0qBzh1OSELkob9IeIGoYo5SNDiNC5YvnFSUqWX8GXKEQPkmOl6m3S7GoRcV1Cael91Yd",
-	"IyzN/oHyWlTddByONf9SeM5ZXqT602Shvx6dnyChmYLCva6vZo4V08myQqrLVEDLbYgryHjVxXCCBXzz",
-	"1RBozBJI0Pnr0+r3j8eXfzvYV+CM0CmW8dxa2RQJjkp2TCBNkLr4+fSwjKcbxldbkslCQog3aC7P3wfF",
-	"mhOaGCLTMPGSJkwbo+HV3Pi3Aqf6aqgvRkEeVJAAP78+efUnrJMHhMCzkKh+rb+XN1x9wIAW3m9hgUwr",
-	"b/72hkeEKOoHZE2uX0nATmOwXJ78ExDTYI+OmmvEsQN+2CGNV1SG85yzO5zuJUAJTvemmKQFByRKKbKc",
-	"umdcEB2Lgci0Mq8HLCpe1fDGtV225aBBhU3ElMxeLkSvLad4LjHCXcBG5MqMtKyWm/nbb4R+VAIkir2K",
-	"HNCRRh0kA/QKKFH/Kgy9wSQ1QG1+2ywhCt4yfbrx5tWfWtrWhe1svl3WuI10CAGT7bb9dKg3tlXBdBnA",
-	"NlOd0JTQ7i5vHtZYXkc8261q2U+5lnnAKL5Nx8ZS1qBoGTZC3gy6dm3FkxKQmKTG6MAoIKwOF+l4WFxw",
-	"rq8TUjEq50Kj2PdFeXivxGnYyqy+VtxBXZQKfXFAU5am7F5dkX6spAg1pH+bQNcCrElXrZZ2YUqUmGrP",
-	"xth4m6iLdcB2goW84pgKg1HSZWpW9ZAkGRhclLDKsi0k5hqmMGe5v4KEMjkHXmOy6r41VH2FLz5Cnd1t",
-	"KH4oMkwRB5xoJm7rIWKOIoUjt354wgppIS7BCwoXbKKP3uStVp/LoFOVmv3IXTVGs7JmpYuvsHGPhZZC",
-	"jF2tyM2wvoz9zVdBGZsDFkGPLvR0wglMnyFToxLj3ZhPRK+ZbnNVc0N1XM1s14MQLZUzqxZ2fUa0WidY",
-	"w8hAkyCboitewAC9wamAAbJaHF9rpcqjQaQreHqqfmqpBnS2r8ZX13XjcznSyql3eJBZ77GK8Iivu/Cm",
-	"6GSMaBBdnZ/+BFyL/EaV5QqM9KERoUSPdtU4BiHIJIXmH47xnWMudNXLBY31j5/UtVPVUAyskCfqOJpx",
-	"EIpMrvMEW/uhOpxc1dMilSRP4eyeAhcarjsSwyuIWZYRoa7+qlG/1XlNOUvTDKi0kqw331ZZfbqdwrDX",
-	"RWedEpedNUokd9aog3MBORNEMr4Iol5hvLOgtT5+YblWb1IA6VZB/xFaNbMa3tqZD/4Kmi9913EJ7U/J",
-	"rGnA2ULYektkoM+NpKzqFL6EmIPcldy2K/h+kDIP9bUM2W3PnH9taV57CXymK0FdttKGnx52I2MgMic7",
-	"EZWfQPAgzxkP+TD5zpa7s1Vqx86AGoT7bjy7cLppSxUGeUFBvqf4kBeu91NGFQd1vKQi7vp6Zabaaq/t",
-	"ykzBkG20WkXj9x406W/g6tye3pKtzhl9/SlX7Dwo56pyBGUF566ryFJBkRSpNtWQDMRoTBU6bA0i0Me/",
-	"I/v/j4doiE4JLSSIQ/Tx7x9RZnWk+8Ovvx2hIfqBFbxV9PyFKnqFFwq9p4zKeb3GwfDFgaoRLDp47jX+",
-	"GeC22fs3ozG9LHK1dSBBasmxZAqIoap4WKpxMV1Y85T1RFbdEIrmCuSyP7gDvtDfnqlxPw4/HqILTGdV",
-	"q/3hy48acQfP0dGpopKX6OjU1B58PETazcBVPhgcPLe1hdQqoIPnco4yjUPTZu/jIbqUkFdg7bk2Bphm",
-	"i0vjX1afy8sKJYrrvPSajOlr4+ynMIf2hy8HB98Mn7+wSzrq7Vd9XAjJMnPgn9ApW2Y1aF50tFHFhK0k",
-	"KNYdOb9ruypBOJpaYa8TQg2Fan2qvhPWrdf9+IiZTRti871ujc3nC0FinHqDPBpcHw2ufbupROotr+y2",
-	"ow1MqTfrbYuWx2bb2WpHURCQTSBJIEDwP89BzqHlJ6z2gGvkzD8TxmRs5C2PCCaMpYBpd6xFQ/XkexKu",
-	"dhnEySIsTZigC3uPd56h93MSz7V5QLdEvT0TdWRHgLG+L0dxdZDTenV5VwfUUzvyoSUC8UK7kln/2ZMp",
-	"mqSY3g5Cq8cL6nxptV+t7tN+MB5rTb/Xnbu5brULw47hD4Nu/8NKo2WrlE5uTVTu2B3RsYoV5qLSCU0R",
-	"tUd1g0oJWO7TwfohQS2eUveyCkkNwlRw1DfXPmENF86A41rjomZFlaW73pcmjKbZnaRa/1qLoPhsutjl",
-	"vnwdmtk18W8UCF0oP/bMIJXy1WBWMbIpmbURzEFtd0g6I5cvbAUXq9zZ7yozeH2c9WcuWNop69liX+Sz",
-	"imf9OWaUQmzVsSWttJEhzAXr5FWYodpidPLKV/Y3RgjTlWl56kkyje1SiuLlKO7gdweNgts6Ufx7Lfw0",
-	"xlQLb8KYtwklkuCU/G4MQmU8M/CMUJwOSpglc80GCGTctYY4OaPpwnDdBhE3ZjXwELjm+vo6yFD8lEWF",
-	"uStgR3xJXXNZWvVbCysxn4HcQmDz4bvSnYVNnGacLSbvdd4+g0qPGrMBhRq2hYQM5Jwl9W3qGx6uKWg1",
-	"uzYrxJLxxQWIGtDL1PfLIPZ6XlatPupy1JwoSYcTuTieQ3zbxfm66zY5Qp03EtcCxaoJyoGrXWa8BTc8",
-	"lobBY6m65DbHNBDt+jTqxsgOj6PO7lfYA9dAe0W0ztP7mgqnL/INY6VdZh0yDk2gGmlZHR+G7noldN1V",
-	"Krh74rrT5GrFrS4Kb/GDvKHz0aMk6l4uFzumOUVHa0ty1ZbRUlw1kxUynKpdYrV9jpNMicZZ7hDS6PxO",
-	"t6wk+X6uEbvbqTaQ0yymu5XIPNv5iuyWA7TB7s0DOg8qz9RabqQwH9hozzf23yBc3rWFVzCLNp9Ysb/f",
-	"kSnEiziFjaT71LXewQ2qqY+tOv+sZ1UDATs8pkI9d1Gnn/0nhNv2eWR8FiyJ1A3p9S9r0mkD6ialNYpr",
-	"UATKQ6CtqLaaZs9EOCrDL0WmaGKFViMgo7PL8gbVKWKFTbRXtU6MfdYouTi6vni3+iLaZb1cNdNNtuXZ",
-	"Ze95/VS/Xbu5BfeaLnlFZp1BEokua/ZlzE1IzPHzr785xPuj0ehZX3zVB10Te6Vvy1o4LC3Zq0QXm+9j",
-	"Q75TB86duAkRtzvvtEoPssNum27jeRGVI9l5bLVcy23uomZ0NwtYT+ZWxYL+jLnlPcecSBLjNBCKug6L",
-	"rAPqR7q2S6vBQ6UeQKFiB2SobKXHoGc66uCUDT6Jl9govVDWXiHyufWD2aHjSd2zpuWAYhSC3dCZ8l0B",
-	"FnTPCsEkWAoddpLU4S2W5K5S6Vld1pYA1tWXwcC7+sm8Gx2VdqHeBmJ7tjc9ihpaSzWJGgewrjR2lW20",
-	"45YobDjThJBoTFtJh9rWFOpYLqLOk7pvUMPTCMt4fm5S2AXNGo5adEVkk93VZ9iyhBifDwdHQYnUMsvA",
-	"5oIyuWiU1C2K6ZR8GiATMj6HNB0KuUgBzVI2cYNp+PXoeIYJFdIFQKQLlDKcgBlCw5ThT++AzuQ8Onz+",
-	"9Te15Hwf9off4uHvR8P/PhyPh7+Mxvp/H8bjm/8xHg/H47+Px9/d/OPpf/Sr9+y7p+Px6IOpGCoOpgBc",
-	"nWfDuCRskdfF84613RjCfljvUFyuemwrG8M3KuHlyrBMHtm2GdaaDZIaI2osC5xWES3bnglWIK1lTfIO",
-	"6235W9vZILBJcdtCtpshG7bI/kGB5XqZVCra+O7skgrnweAifyk+SyCgf4Zuft5UhkKtfLN6id2pqJyq",
-	"7RKA9glMshRo4nCAuiBby5TR0/dnV68PjTG99C2z6bs4yILTWmDus566OetN8KtgdEhmlHEo3QfKe/3u",
-	"lBa7OHXLjrbz5A3evNRJufXGa202c645p8JNe606WXaeO35WO0t3w8kMBMk1JbKbh1kPua1PoqRDDesx",
-	"rhpi69wzCjNTn2b8PV8yFE2d1SQqavB3w5q3xc39QDyuMMc8uccctJOt8QImdOY8lmpJwnfvH2JhcJGR",
-	"n81DJICvHWo518ofFVa4n+mIl3CqqAuYMGbji87ZPXBIzqbTmkb+6B4TqYOlrDeECa+bpiSW57gQaypA",
-	"axPyQGuVedAGSut391qRP6dAcW2agfKmSrZWGEJGoFoTPyvWuMZp+7lvn7lMs3YzeUld4FPORHWsase9",
-	"0Zi+xvFcp+qIGecgckYTEzBc3erMrrKvCcQ4xxOSErkYjelqR3AzidqmjFmamgzqpfdyp7irgOx0VlJi",
-	"x5H2P7TeSsE97Dskd/Th1VBSoglPsHhqualXPSt6CnkPfc+YRCev1unK+NlvfKq2/P2VaOIYq1mC8NTP",
-	"Su576bhvT5ibzsw+lkvUtKEY1Nd0TbbXutit8JrJdU1tfcgwxTMTna4PAHMs6nz+cVokqkSnWbTfvTSz",
-	"Cbun9qatDiybWyRgVLf1Lk2UzmaSpplh2UUphOy004dNsJ5sZBQx0O/UUOkf46b7z36M1zCww2O83e8a",
-	"psoKtaWdMr9ir7DOnHNWyLOp/e0FC2+ieK8B6Q0RKPVHDTZuRC3XS1fq1om4XRlKuJvovcFfLCYxyOSs",
-	"akdzN9OB5m9E3Jq8W+u8vpQQDtpRr3x+ySl3Vff1PpfPZd23XV4VyzJ+ZPgTyYqsyqWH05Td+zETxtNX",
-	"MhTbJOXmJZayQcXIyyzcCOsgMaZYxp31TgFus7zYZLZGl2ES9lcxi+VHnTrqEH0UJvxPmGyAA/QxMx9M",
-	"RJ/6MDcfdOziqP5iytPvDj8cDL+9GY+Tvz/7bjxOPohsftP/+ZTXNGbq5OrjQAq2riFU7T6sVxZL3Hh/",
-	"yucoeWrSFptEfL2TPpihzm1j9/f3tpPu6TQSQrTn1KqyJAurzYimSMN4qi7Vqz7GAz7GA3Z106K6HYQG",
-	"tvv8ExKudqRjwWmPneaqVnm6wuJZue887bndgPplrU63fezyuixJc3jvxRi6zT7HAk0AKHIdhEMKUzyB",
-	"dJvnwY5cYkvTk74k53m6qNKOdwQ0t19oMvPcftkqkXwLAaibKNqSxwpIVtGGZxHblkqOOryh9AmNpQ3g",
-	"9OnkHosaifRz9nUtvu+KHq0Hoaq6vNcrXa7XgT+lHgnzVi3BBmbJAOLLBRr1p8rwjTxYzRyEXkUDTqvu",
-	"E+H8/7RFLuAOJnh4XUK5gv0Mp8LkevIJLbD/68bgLeO/B5G+4FysCsK70kS7NBBPn/M2Jmj0q2AUPXWR",
-	"s886/OR3zf1c1j1n09OPr3oMkYjSCjgHipTg4ZEZESF23cEx1SJvziy79BYdFdfbQK1OujiWOWN3x5w9",
-	"8/eqZLz+Vmhn5B2tnWe3nYATwnj4q2bO9R83DWIsNoVmiack5GkfL2uvr01IwieJnl5fvRm+fIYYb2Yz",
-	"9wbREZ8k7VwLVc/dojYkI++m2F//pxDVHcaqSsvA1TaGZpwVeRg/aq5PBNI1Bt69HIiW7rB7ecq+iQmc",
-	"xOjkVf0trnHEGZPjaGmugRVJBTKWwFIIc+DWx04/LTBC/8UKfecyMBsdeaZuSFOckZRgjlgscVo97Yn1",
-	"pft34MwlANr/5quvND1gcwbGJLMNTLhrqM1Xz/efqUufLEiyJ0DO1D+SxLcLNLHKCFRGn+h0DrVnx0xa",
-	"h8Zk9C1ZzVMx6gqvCrxw9onCvuvWiS12r3Pbf8b1/KxPpCl63oFCceunk2u7brMMef6r7cHHl0u2so6q",
-	"LpzBsZVpZkbkhQI4LNd4Sfcwektk3XvU5uBfR3XpFJY2Qn9KZi6IvsrZ2ZGsxRWvFu2rrmpvRLR9HLW0",
-	"egF3ZJlsZ0oV0IXwngZaCm8rxUIJfGvUQZcSdtkjxv5sG27bvV/Fsivf+yBuPiP7+TJebpYRMp5jLquM",
-	"kHNIs5UpgLQaNMfx8nCmslYo+Y99wUxJU/UHYLPFEOf5sBoiML55y79b0jeZHVpekt4WND0EsxI5Vbg6",
-	"cSZEcsxJukDUvm3i8qWLGtQeuv0dF9EZoZ808c6iw+hg9PzAOC+aTLf6RXc8SRV9GZDnTEihKUP9ig7d",
-	"CKOYZZbkTbFhFdGe/Wi049E5hyn55J4v5KAndcwKJcO9GET2GqNZjX5G/eV+idzjtBAS+Ml5WHQy+FJc",
-	"e4lPrkOqPm9L1ZF9Q95bb6T7sZmnzBP6msMJ3/isX7Qx7vg8AY4mMFUyiE7dZzetfYO+vhQfLKyqUlKY",
-	"DCYLnKWRm8SQ3QHnJAExWmSp2sh932TZddrQjhS6rcNmLmXe87Sh6Ierq/Oe541aqPPgmaO+uhPG3HCf",
-	"mHV1TjeSedonJ+U33Vo1KALugHsWqntOpAS69WnF26eVO2xcIq8FjdGSc8zEJoQmz8sby/XFO/u6DssU",
-	"yU6l1cyqi46Oz0QnUiesMT4WgH4rQFsYOc5Av4YviniOsDhE42hPUfmeZHvOdPGdrv3vunZILFx6IpbL",
-	"9+cfgo4ie5P60ldIluTJ7XuKnR2fBB6/DJ07OY5vexl5t97eS5+YbcfLmpdGtaBUzSZTzSFB2LvpaF65",
-	"JA53m1d6L/V20UYpc2xs0J3pSU+8M/jW9L4eKs+LNA09PHsyfc/kudFntZ6fdd6C9YvYE7/NkxH6eQ5U",
-	"K/9U2VF6jxfiycBLmE0Eyos0Vdc9nQRXkgward6rklqjrBAS4dQkV9TPTHWngzFjRoPmZHSvPW3SCj9l",
-	"P+qPRl/qk+1vKZ7D1EqZNOKN79KhF/Hhs1LidtHu7Q4DAU9+PL89SdhUnaUrHtcN3OBqJLrZ9D0yX+NR",
-	"4NXQmicCOcyIkHxh35PP8EIdyrjKC+o1NO/plG4/iiu5zgbqkE0Znal/7QWX8Uy0+bHwHXn6nHBrPzq8",
-	"/D2qZYeL8cFeEhPpHxxWytlZ3HGlP1khZhootzmIuh5+OFwfI6Vwb54bbHO0zXFT6qYCYSqfV5zpRHEH",
-	"Qpe+zdFC6q5gVxc4NVpfxZP3jpdp+E+qvy6o3PCWUrORGxx4NxErW3VqOFavWYXWdVUklW5kdVf/vDrp",
-	"wFbz9TrV0t6sMo/a1tUG6L1XT3XmkMfnOXyU9L2TvMcZJDY/tNkudc2CmmpALrQyWksu/DxXijWvEp6/",
-	"XDu0qCxTEn+Zr1YrAHCaohy4IFoPWOXy0VL+HN/BwPIaqwgQuoUBRufW5rauOTgDNnRKmazC3jf0Yagq",
-	"m9cBa0HJ4Zco7DvAZSLBJU5HJqpce2reY+GeHO7vaZRACpuMRdJUh6mo5uuMN1vy2OIREvBboY9Lmx6/",
-	"5qfqvWnpPcRYuqiYVLXGYwedN19aNufPCF0AToaMpouebzNu7cJyinUeSOt+ewsLo1O1mlmjyapnV2Z8",
-	"hin53USqxljCjHH151MRs9x8FZBCLJ85Yg5SUb8D09QPv4+pDrHQKnl+wliqs04YS4X7PlAiwFj7je6p",
-	"scaRfS2o6/UC3arTM+mIIpbj3wpwSNTDEp1HtPQ0NxrVJ6KKGfR8mDDtnmdvQ+05lvHc8xEvxeowYUz1",
-	"y5MNjsI6tpcNWDPWOZur1ldG4CSJjPnAnNMcMnan91c9V5r3UlhQPjtC/+vy7D06N2JBqbYKm3PCoBoH",
-	"cvvMLNNbVAE1ap0CLF9mEmznowig/D8LnKQgH5+ne3yxupNGLqy9KnwhuagHaljTlr6RhHW2Xfum3dZd",
-	"tN3J8p5JyxHLR+wVs9L13XHJ7oB75pbKxip4vEdoAp9Gv4oteJSTOo9S4PLCRm7m3aHb7XnO6wnGG86y",
-	"WnWv+g57rnYGVblwKyXESSdOKGR4t0F8BxzPwER8IeK9VGMNjXpgQmcj9EafJofLg6meiCf1KKkn2ZN6",
-	"lNST+ZPOKKnxOPlHd2BUDjwGKjvTh1blCmtmRsZ3lZPZTAmeIUwaoceoFe5g41w6NSK4tD2F40fdMN7a",
-	"1SZXF2ZuNiLDGgTteDFb2qIud/QFUzrqcPN+OvhOWKqOO6t4I3bWMaCswoRLLKfmT9T8M0Kx/WAf7lQ/",
-	"j8+vu5a640XLQfRK5xENN+oKXh1EpzZRaLhd9xW9ukIu3msBs3Z3VlLj5sdMxxQ3OmCWzWATn/oORKpz",
-	"qlOR0JMoluYUCIfb4po/WOPW6nj/smyKuhLiqtYIndF0oZ9NN19z4MhxBu0yb3jqbjIsVidTKMeiOg4J",
-	"nZ0o6TQYIFYeJBOQ9wC0DBjWTdVk/oSzoQyf7ToglmiXBv76BGbcm8eueBefGIFHFty6VunZxDh1URQJ",
-	"o0+cgwgyZiXvNvkZA1XjoPvzZTGbGYcu7aNi4Yqdx7C+X5oQiAHaR8S6Ghv9rH+Nf/E8eI1/jI7daXSs",
-	"EEHRp4/46CfsUHh0N9+uBxRFWE7NcDwnFDqHup8vGgOohbamibF+7aHgMI7cgwPoxAJkSEA/H5lLrTvi",
-	"YLIE1gNz7jBJ1cAjdIQuNJgoTjE3Ggnn9SVcgGICaFKoTQcm36DzeENErkgesiy/VoU8dEaVqHmIxtFl",
-	"EccgxDhSt3Nvpp+dbEQO8RDTZNj5hkOPKOPyKUHNJkoKqIiuP4M0yfaOdMplhTfoNh7MyWw+TNVMTbo/",
-	"nae5ymFacwwxOZw1aCnTQRPaXll+nrrnPFwnukICtT8zrJgTxdSqc6YcxNwUFWtlS2nP8sgB0i668CBu",
-	"l55Uc2gXlo+UdAzoJtYuflWfebvCaQ0XIag97LSLV2ZwsU1ea8feFYRgvH/rUWaaIlyglKMC5yY8cL+G",
-	"vKBW6Z0SequLzA+vBKcEC738wtQwP7waamQSm7T6bgRCTaKUqFSf688m/5DxJJrgxCOdQbQe9XioeV3O",
-	"q7PsogS2XeWdm3pX0bLGRxY77ZJTh6+uomXdXjqUtoteVUhuF55UaG8XvvUWIkBg3tK0S7/H4VZVtr8A",
-	"7tVptJLG3zGcrKBwxQF60LeQxURRMLNpDymTwykrNI+e4GQoQNoNDTb7YQZ85tH0ppysnMKlgaD5+Z2D",
-	"qFnwnsk3FsBm0fc4uSzhbRa67I3N76duPq2CBjGWBX05kZf/tf1gdsXYtkkx2zz1mmap4CHYLbs5t12d",
-	"maimuDzLgV5e/mDtLyjBkJnj2ssKv//Vy4CIAxVxbzPTJlt/MES7db/1raSd0ydlp6FtdW8EiIFGknXG",
-	"cPbKSogoEccLSp0sUKLqm6/qF008/H1/+O3w5h9BLaQaqONdbpf/34VyjiMh5snIWp3H0bOG+tkrXCm2",
-	"6WHr9FRfTX8FBjWK9rDYW467Ihn8N3M+cM7F+B0zern203vod0ahMg9yYW/+moBPjt4fWWMkOrp4fbT3",
-	"7uz46Ork7P0A3WtJX32sp3dS0jChOmaaIxYDpgNtg3UtSy8fnd4Lc0niIsUcCSJBB9jqSweWCHPAA72V",
-	"LOLRkXYAwnvv4f6X/2L8doBeF4ob7J1jTpxipqA4m5BZwQqBXgzjOeY41jEPbq6NQFz0dBy9Pb0aR2rV",
-	"r6+O7WL3S+513cr72HTNnRLqTK62lkl8VUimrkZxmblS66loEsp5KUnmSp2rkp4OK0KZDDZzUDnmjL7+",
-	"pNbRKR70c+VvOY7Bz/62virQNX4YRNKjzbU7Kgm7dS+SURDa3lvGd85pv3Tc9hoyK2jdivraxEw/uefx",
-	"V3ks60B0S5G6c7PM9biwaA9kvKcD8tSVczpKDjnbNNPfg/f+p4Yj1lOHDJM0Oowk4Ow/pimZzWUs0xFh",
-	"kXOQ0PzyjS5Bx+riy1J0BThT8gtXTd1Vuda65ebxod7FzdNQs2dW2WSTl+hUeBCnmBvTos4WC5lNzzBN",
-	"AaS+6kMyc+6UxnlEzoFwdM/4rdpowuRETkkMVEDl3xod5TieA3o+2m9N5v7+foR18Yjx2Z5tK/benRy/",
-	"fn/5evh8tD+ayyw1RC71cjWQdHR+Eg2iO6cXjCwR2izAig6jw+jFaH90UMVJ/hHteUlAbDYcp/syUZCh",
-	"BHzHxrMZo+Oq8aVpXGXkq/ThpVrkJCkbd7aMDHmBkN+zZNFIsuF5m+/9ajVRZk9vxpM6gXiok7n1tDWp",
-	"sYXZhc/3D74odKElSdRqf7W//3kBK5PUtaD4HieoBFJBcvClILmmuJBz7ahlkfLiS4HyhvEJSRKgBo5v",
-	"vxQc9fTzGpjnXwyYK8bQKaYLRy46idHXX26RLs0ZcE1LRbJx88AzfRnu5JLRjaq2hIvu/aG4/4OOJQAZ",
-	"8ojBiRHfSuf0zo3fZqZvQS7jpFWQrbYAL3c9XM3MkWRoZmw8RPVgk4jY4816oNe55sBbrqbSoaDktwJO",
-	"jA3TeMTftJjs/l+IyZ79+MjVOrjaV18KjlLN9MjPdsjPrHRrmdeeS0TYycXegrQ5B2zGQpv1r1sMfAvS",
-	"5UA0CRLXZVemlWVJ9cFF01djNxzroZ0KtXz0TGsaSwh+qp4i0cPqJAbVuMEMkMvG/dPZol2STh743Gz4",
-	"5lZEXsjmX4pNfkn2hCr+9OWEv7+u2OdxJcM0wiyossDnWMbhWEcXx+il5ny1ig/pZrUUrZvxIV9G0hDu",
-	"iufcrHMhHuqh/7GDNayFV/S6Dn9hlvR47X0UEFdz4EcJsSEhoi4RsWTGgygvAiLftX11aV2Ge2EClHbM",
-	"cqt3kP50nrtDvvbIYv8ZWewja+sv1VXZ3/ubGSgKvfiz3L7QfkboT9zS7cH/CvaEDqge7QiPdoR/kavk",
-	"X1qeanG+To64ymTwFuS6TPEtyBBHXEvq6h5vp3aBL6Dt6sUZH5X/j3e7f2le9GDyXztmYBxU9nBO9u4O",
-	"TC4907r93LjlNAIx2rybaRcjywisINjWotd76OYzfmftKTzcPPz/AAAA//8kDpGn2d4AAA==",
+	"H4sIAAAAAAAC/+x9DXPcNpLoX8HjXZXtXc5IspM8R1VXe4rsOHprWTpJztZdpLfGkD0zWJMAA4CSJylV",
+	"vf/w/uH7Ja/wRYIkyOF8OMle+a421hBAo9FoNBqN7savUcLyglGgUkTHv0YiWUKO9Z8J4/D3+6MZSHz0",
+	"d1YAxQX5+8lMsKyUcInlUlVKQSScFJIwGh1HV1BwEAoWwhRhWxfNSQaowHI5jeKo4KwALgnoToognJsl",
+	"1K1VFSQZwgYOo0guAYmVkJBP0TsmAckllgjTFYJPREhCF6bqA8kyNAPE7oE/cCIlUIUBfMJ5kUF0HB3c",
+	"Y36QscUBLoppxhZRHMlVoUqE5IQuosfH6gub/QMSGT3GPYQpyI/Ahca/PZyTyzNbhlKYEwpCD+HefIMU",
+	"GaojNkdySQTijoxYAVCfMUWm/ym6Bq4aIrFkZZaihNF74BJxSNiCkl8qaELRTHWTYQlCIkIlcIozdI+z",
+	"EmKEaYpyvEIcFFxUUg+CriKm6JxxQITO2TFaSlmI44ODBZHTjy/FlLCDhOV5SYlcHSSMSk5mpWRcHKRw",
+	"D9mBIIsJ5smSSEhkyeEAF2SikaVqUGKap//CQbCSJyD0rNAyj45/iixhoziaZ2SxlInMVGf15+iuPUtx",
+	"9GmyYJPW1PVOVHGjK4aYToEw0wAIF0VGEj0FPn56vQiI4ujnEqcZyEh1RCUmFHgUR0vI8jCKCsLkHnOK",
+	"c8X8P0UWldMKov3wHxXgqkYN3376QXczMEiHu2oLVKrx4iy7mEfHP/0a/SuHeXQc/ctBvfoPLNscBAF+",
+	"TzJwkB7jLQBcQYYluTeCQ0Hg8HNJOKSKEFoK3HWW2pjhvab3P2JuZElDskBdgNOUqLo4u2xU6TBMkyFe",
+	"03vCGc2BSnSPOcGzDNBHWE308kAFJlzEiFCFLKQoLRUYxEsqSQ5TpPjpI6z0QjMtACdLlJdCKqE0A/kA",
+	"QNGRrvD86xcoWWKOEwlcr4cWLTYQRDVtPkFyydkswOz6s2jzOZqtFP5UjQMjtb4VboQiRvWyIFKgiteF",
+	"GWKhICFRJglAKhAxi8e1hU+qzQORSyQklqVAh91NwFYOL0kHSf1PwcJ8Uaop0UQiEvLwVOaEnpnCo4py",
+	"mHO8MoRz6ynYpVqgTgpUVZFkijaN0RE6Ra9gjstMVuJ2TriQFRXr5mGp0t1u/HXhCHO3xeT/ADiTy9Ml",
+	"JB8DO5KdNr1xJqqO4YWlbmR3HB9V9PoTTmS2cpygtoMYyaSIEeMIPkFSMbYoICFzAml3olW96HgL6RFk",
+	"6sc4mmOSlRxulhzEkmWWhfSERMcv4tDclvnMzEbCqICkVEIJKTiQGqIIhOcSOHpYkmTZWSFErwBBUuCQ",
+	"opIaiq3UWOeM51hGxxGh8sXzSPMgycvcZ0FCJSyAK9wVCXclxg9SFhUx9A5/j7MGEaKjQxG1CfGq5Ha9",
+	"WyFkBj5F3+shHKOCCaIJY/FFc5Zl7AFSJSGeiCdozjgSkDCaihg9yc2HnNBSgvqwNB+WrDTCrMBSAldd",
+	"/++fjibf3t3epn/6SeTLu3/troE4Uutv/crUOMeoFKBFlBUvOQiBFyC6C9Pf23XbaahvITGXZfGG4wQu",
+	"gRPW5KloiJqGb9ocoyEKtz0YrmrxGwdEFpRxSOsp2D/lb2/TP/cTXUtwIcJL6Wj8UrJw5mUWWk6Y1mtm",
+	"YF1VNRaY0C3Wlkx2Xlo3Sb2y1IbOStlaWGs5wQ7Zylo150qnVmKSMi0qM0YXmmOw2VfcCv5jLMTH3Xad",
+	"gE62bJX26yQLoHoT1atXb1BmrwqIY0VLRrNac5mVEuFMMMdFU3TS2MzQw5IJcNyppgWZQxfjElKEBUph",
+	"wXEKqd7csEDAuVqcSrnBWeY0IQ9AQxfZSaB7u/ZjW3HZakKqHWKsDqiVNUzRDzc3l+jN6xukNBIQEs05",
+	"y3XtFO5JAkPqHwdRMCrASeWEpaCI7Pab54eHWqF78e23XS1hyURrqWUswZn+HJJDqkCxiQCa2t4NwpIF",
+	"RXxtc3DwD4JwVT23YViQYXiMy/B2pUqcwcIQbQDProjDn4yI++brr198vU7kaQ6D5rjUFAaHZiq3B6en",
+	"/Nr9FChlenHdAyfzla4ptPEBJWqy5ophwD8a2+40lPFH4A6nXmvkLLDhCtfRXVtn1rOxjcJ8GZxG9RXl",
+	"uCiUZCEUmVlCt5pLVeFxpeKrX7cRegrTxTRGt9HLw5eHxy8Pb6NnTauT/d7dnI/Vf4Kb81rcObtXW+d3",
+	"WAQW+inLc0ZRvcr0nqAkmb/uVaeiuxpxbS/ZRrDptoM6nY+DqlUdJI7+3//5v81zsd4wY6NRWTmFMlA0",
+	"VILa6CPGsmUnBVGmdmEJosAJrD9wucFuxT92Dq4Lc8hJiRppTiiWTB80LRfZE682+fQQ01qEPOANI1Nv",
+	"K1uh2U4bpHqaKCtSs7YzavU0sKYpv81jxTGrd3qSKyo+xhGjsK3JKUCErSxPQZS3widA3q0gtak+ytB1",
+	"ZY2lb0lOpAjZ3k05ynQFvcQDBoeW5aUoA+Li8r0BgghFCq3qYKj2dcmJNnfNsDp4MdqRIU1hdzj9n1+H",
+	"JBphfwNl3g0NxFgK0YOuEDCcPBFolrHkIzq7UKaYAivdTDLE5BK4X1FMkUdBY4diSjt0sJf4HupfbI6O",
+	"Dg8b+D8/PPQ24qPDw8PDdRtxDjnjq+64zvV3S1ktvFhhrJKopETuQOPnX3+T70d9d0x0ziixUqtlOMr0",
+	"ZUOZgXCKjbPio1IdvEO2o/e6oACeAJV4YU+83M2zPZ83J9hwsVbAbXmCC5wQuXIMYRUq0mEPtMQCUWZA",
+	"jOT5wLjUMqiG1F5J6IJaYxjWLQvgSIDSlOQKEYGwOaXtdi5ws6GRuyoz6B4J+tktMCJT9Z9hUEKxNqGL",
+	"s4ZJa9CEZUalG/5RLFkt5aIzqLsdVqjTMpq8Xd2o7XoOvaoA7SRH9Mof2Kt6RYbmxOpIrmbAqkwxshqK",
+	"r+a1pX7fmt+YIq+0jKlorg+zTrVJivKyEmnhXbRvpSEsEPYEorMoVDuvlnvMWBtOL9+LrswjsiPm7CwZ",
+	"NbgpGvY6cAP2u5UE0bvLaavsbNUZOFF3TNKcM/wD7zdfRf176RCZz4dlWh+lc28zdsT2ROQW5G5f3TS4",
+	"IzCSJh01O1XfdpIMQ7phwqiQHBM6VkHMKm1zH9LE6q5qYmsFYx+Anb6ylbDyuLtDOVOmlyIShC6yJnc1",
+	"bDu+KeSSQ4Gt2eNaYi7Nn1fGRBnF0WtlUozi6D39SNmDOnmp804GEtLNTScGS7/PTqGHRKesxqpT5NDs",
+	"FNR4d4q8gYygfmVn3+COWoExd9Q3p5eKqSkkumwDE6XXKsFKjUAgJJ5lRCxDV5fbGiVtN722yA1th01w",
+	"O5kM92U3e1+kWNotIih6yqw6xGVYSFTqBt1dv7uamlOQsgftWtHt5Af2oE1DXVu2MuazB2XyJxkg8UBk",
+	"slScY88UFB6cG1ZstorU6ZVmjhTbLFXJbfTV9Lm4jYKzaC8dA4iVOaaIA0618wgHLPT9g9kYLSEUkpxl",
+	"mT7wJR+DPZjy73DoMv9vS9AH39aA3CWjZNZghq3ZueBwT1gpqooKAV0F0vrGzaIwYywDrK08Bt30pIdh",
+	"1dQEJ8BQ3WDSwrHBxAr6RE/wOk26xiSuuaJBpK0YWRiHkJZKXdITER5xKYD7N9LGJ1F/No58jWtg4683",
+	"A+1FUtIUuBJPRLg7LA1BQcNG5mkwyk5AqPZtrB1vQnrOUzJ3v2cZPOtef1ussGxfsGGBni6AAseZ0rkZ",
+	"k8+UjFQoNVw5hnzu3ltK+J8n4iMpJs7GMSmYvt2MjiUvYat9+keWlTk0Hdlah0LrXYm1ITlF97pFpYy2",
+	"DhkdARO2Ub+n5Oey6Xrgw7UztN63R7FwkmGSX7KMJKtdVR9DjasGyPY60QMKLIVfd7HLnuV4Aab3hsl7",
+	"K1PoOSup3BcwjVkvxLtRZtZAy45IMNMfEApvidBbnb8wbeW93RDbVbCXy+EQDzV0nCuQmNAo7llnS/bg",
+	"SZMlpmmmV59dHw9LMAuDPRh1rXlxzyFn90a2OLXZ9ne3xRWYGUufGuLr8XuRCu864qBnyc+BA02CRhBb",
+	"5CR0CkXGVpCii9OzieKMjGAqEcn1uZUjrC5dcSK1lkDoYrjvkCjw8dlmh/wb4x8zhtMxVP5rOQNOQYJA",
+	"D7ZZXGlTGL3Sg82ByhipZjAvs2uQapyvMOSMXoOMuzpid3o+kj7PUVXipqiLwhgE9qnsOQzcdq/qrXyK",
+	"FEz7sZ1yLJZvGSuUHnMxn0/He8j5POm6622ub0TDMHTRKEB6EGHq195hupIankCslLVtRSjetN9H9WU8",
+	"6QI4+45o1h7oaiM2004LmrZyCev68z0qKi7fRV77trPWmtSsG7ulWU+Ko6s35gqbbZatuC7zHPPVSENH",
+	"0y9A9Bs5jLOSwvOVdZgKGjbeMR+Xza0bTfTrTnureNj01gkYNpoVggaOZpX2wPqm4rR2l7kmC7UVXhkX",
+	"m4DVuK9qHRSkZEXlkqW2BSTIgkLqe+XUhpDTk5BLhx+rtAVzV80f4x75+1cle4lC1Z6jTQRENQi3fV29",
+	"vr6pbxD1ocnwtTfeOuZJxSsROnfHq2qQQFN9vNA/kowAlUiUM30PXnkzSTZFp5ha10t7hJyiM4pOcQ7Z",
+	"KRbw2SOetI/NRJFM9OwtEqdY4q3m5UIT7hwk1tKr2NLXvpcFrWK+i1zsh21gdv1xKlaLnbisaGTHOFoi",
+	"Dg+sa1bhuChAqV2spCnC+gQ9STgovkGn11cxylkKmbmh/1irO4RpfsEFmXpLUkzvj6aDKITiJQpizFHX",
+	"5oYypEXq9ib+qIoXvMcZSYlcVUb+lsdex3DY3f3gk+R4KHqqP/amfZvbCqtSgBGWZv1AtRnXBgpHYy2/",
+	"FJ0LVpQZlvWV0snlmfNFZNTUVyPXMTl5Xio7LgSCqAxzBQWvUllmWMA3X02AJiyFFF2+Pq///uvp9b8c",
+	"HSp0pugcy2RpLdOKBaeVOCaQaUUD+/wwJNON4GtMibofCzroKynP3wU1vzOaGibz/TkhNTuDdYnT0vjn",
+	"EmfaoqPtGUEZVJKAPH9/9uo3mCcPCR3EEcBDf68MU3qDAa1TqlA708obv9X5iBBlc4McDh5rM7Az9A2r",
+	"3L8BYVri0XFzgzn2IA97jnc1l+Gi4OweZwcpUIKzAxuH5dy92bweundNL3omQ/vVu+jt0O09/q5UpoXw",
+	"qvWX6emJD1f4lyfW/ip5afUmVZZjihegIzzVbOgrI+16oJhqVvs8KwCqjcXdgvRa+72aMVOmHWgEUYw4",
+	"aoF7MMIjtb131b24ZhrEaAI1v43rmFEj4kXId9iVmUNBbcavvMb/qvRklHgVOSi/O66sOzF6BZRAaojy",
+	"vb6S2M0WVmEUtIE1wyercY1fFF2v090ip/u8tB/j3YC5wOdd4TQidh7j/dzHb2/DHTbF7sN03eeyvZ3J",
+	"mWaE9oO8e9yA8Rxb78ZvFZyKy4pA0PsugIO2DBl2m7+L++RJvSmkIDHJjEMKo4Cw2t0rO1FScq7ls7SX",
+	"xvrop/bPq0p7WkvTcFyE+lrLLXVSLfXJzfoIqjOqZ8VUXfrHOfRegA1CULOFJEM4VecEq5wkJuJUWTYC",
+	"fjVYyBuOqTAUJX3BEapefbNa4yqrtpCac7CinN1+FSZUO0OPvV8db9W09RAxuoCikZs/PGOltBhX6AW1",
+	"O2eTe6OvHWUwaYoa/dSd9aaLqmZ9h1lTQ98yqx1b+0uXBaMj/cuMjTYUH/90xgnMnzkrbnWOcn0+EaNG",
+	"ustZ2XXVcza2oOMQL1Ujqyd2c0G03srfoEjscgPc8BJi9D3OBMTImtF8s6Eqj+JIV/AMhePsgi3sLKzW",
+	"Vwe69bnqae3QezLE2AjymvGIbzzyhui0nyiObi7PfwSuz1xR7BcYvUgTgmShqjqOmswyaP9wgu8Sc6Gr",
+	"Xq9oov/4UZ37VQ0lwEp5prajBQeh2ET76ViPtAISV/W8zCQpMrh4oMCFxkspt69AGbuIEIRZ37BTRudk",
+	"8YqTueziV2FwiiXO2ELl3ngFBYcEj/doe02VB0cOVNqDiEetTlmTWL1nGQ9Eb51qJnprVFPUW6OJzhVo",
+	"v3PGV8GJU9TqLejMrl9Y0fn7DEC6OdQ/QnNu5tKbefPBn3/zZTQXmO9tXrCz3hiT/VZhbFuG+WNgRbqO",
+	"TpJ6o6jvp7XbWsgBDydmt8AfgVaeyPqeWl9LE6n2LUikQKmCPkUGlg0l13/b86MZpQ4HFs6i4A6VWq7E",
+	"6ApySAmWYALPOah0Y+b8mddmag7UpDdwra3Rr779tqOpwI0Xik0qVZACJRXoETT3fQIaW0Blfwm7AMyJ",
+	"+q/Nuaaon2iYqLDasTC7+ANwQMkS0wWkiHGUgvYbXecIiCtO2GZTbfHTeMcJ07btGrLDceQNkQGYW51D",
+	"aj31GhIOcl8nm33hp2K39wXrIiEhUEP83A3c/HKoHw1M+0V+psN8c2FrV5cRnjK6ntXJiZeSrNfFWgwH",
+	"9u/PO0tBDVmQP1Ngln8eMMQLHsFHyreibMVTOBlXr5TmfNkojvX5FOsbXoZso/XWbR960Ilx69hDf3gD",
+	"coOrbGOFUqWCJ1RVjqCq4FJD6IBC5e9cZvqWmyjvv1uqyGFrEIE+/AnZ//9wjCbo3MQbHqMPf/qAcnu9",
+	"dDj5+tspmqAfVOxhu+j5C1X0CutYpXNG5bJZ42jy4kjVCBYdPfca/w3gYxv6N9Nbel0WNvxOTTmWTCEx",
+	"URWPqxswTFf2Zt9mvVBgCDXhkhU8uAe+0t+eqX4/TD4coyu16VetDicvP2jCHT1HJ+eKS16ik3NTO/5w",
+	"jLRjpat8FB89t7WF8Wo/ei6XNmbTtDn4cIyuJRQ1WgeujUGm3eLaxPY0x/KyJomSOi+9Jrf0tQm/VpRD",
+	"h5OX8dE3k+cv7JROR+fwOC2FZLlRjc/onA1duLZNFPo+2iSUTVGiAbkcH3ZWgni0L9Q8IIQaDtVXUdqa",
+	"01TDxskRM5pAyK7+3nRkKZYrQRKc9et6X3xVvviqrAlT3YNjigW0hRfK3WbLouMZ2HUv31PGHchnkKaQ",
+	"DgcMtXyyXSN3zp0xJhOjb4WDgpTx0USA7arZNOLIen1cW+ZoPypjffjFCGdVL8rGpu/jYD1YR0d57FnV",
+	"M0H0Iz1gu36vPXG+AWP4niKdiEC81A7/NsrpbI5mGaYf4xDH8ZK6iCcd/aRhYj8NYDs6ae/BSJ/D1Tfu",
+	"jxKp7ee2ihct3yTlnoNG6rXVPp44R+i11v6AV7/oTyXSSOCgsh510jTsZXStsIR1bgFVKESfj7UnO+PN",
+	"U4J15HzTaTikyQlTwRHSy8I86IfdOjxb9XFQXvoanrm3c9qNvs3y1+bnu9kadk3vuefakP7GQtRH8lPv",
+	"UrlsZ9cwtsouga0hWGWwD9kT1GcFab3t0+QfTUw6/ea06gwTJiusf/TosxyPd2FzEHofqbhyXbio4D6i",
+	"rHNJa/az+bQJlvUeHmyxf4awd5D6sxfT7zF6dyaFObGfvQrvdrYYnb3y731bPYQXhWl57qnGrbVene2q",
+	"Xpwm6fQLhbd1aPy3xksDNk2B3iwkQ4QSSXBGfoFmemvgOaE4iyucJXPNYgQy6ZtDnCqZbbbE1gpsjSr2",
+	"CLjh/PoXSqFkLpYU5vCJHfOlzWsoPxtrc2Il5guQO5wAfPxuNLCwt4vpZ4fBe8AHblf8QKk2EXKQS5Y2",
+	"l6l/m/Segr4z1TfMibqLvALRQHroSmkIYw/yULVmr8OkOVNqKCdypW3WfWK7v25bIjQFO3EtbCrpArha",
+	"Zetvmgb21ElwT62tJu0+DUb73kr7KbLHvbQX/BplcQOy10zroq7eU+EMkL6PRHXJvgkbhwZQ9zRUx8eh",
+	"v16FXX+VGu+RtO71vrG6Yh+Hs/kgR5vvZylQSeRqzzxnXqHYUA2tl4xWQeuRrFFAVe2Kqt19nOQgJM6L",
+	"RuqZGrhOau0ds8Z5ye1vpdqsTGYy3ZFRFvneZ2S/EqCL9mgZ0LtReX4z1UIKy4Gt1nxr/cXh8r4lvEZY",
+	"dOXEmvX9lswhWSUZbHU0yVzrPRz/2gb+Gvhn3ataBNjjNhWC3Med/mMwIdp29yPjgGZZpOsVVX/ZkE9b",
+	"WLc5rVXcwCJQ3uewNVBtPc9eiHCEpF+KTJFL2GYUZHRxXZ2gelWs8J3/TQOIrmSNvRy9v3q7/iDadx2+",
+	"bqTbLMuL69Hj+rF5unZjC641XfKKLHoDFlNd1oZl7i+RWOLnX39zjA+n0+mzsfRqdroh9ZrpQsfSsLKX",
+	"r1NdPkcS15SIj58pQeqewXbzi9bpQu04dpquYScO0fDiMBPYfLezzsvwN8ydyzAnUt3uBtJCbCIim4j6",
+	"WSe6pXXnoVIPoVCxQzJUttZ53LuL7JGULTmJBy69vbQSo7JMVcbF/XkyNV21Qm8ozsmiH7u21XPnoL+u",
+	"72APTi3P1V18RC0UA1qwDHruxzI3JYnONW4rOxPfjmNvWkaD8fXNTX8/5i8Fme2CsVUb2leiLYOoGkRD",
+	"uFi3L8tANqmB2E/S+SpPcJeI5kqzJ5WULXSxwKLlx9byilOG+0uTJz404opbdEVkM8o3R9huYnMPOzxK",
+	"SqRWh2L7kgSv3rYQ5XxOPpmXy5BYQpZNhFxlgBYZm7nONP66d53vU0gXZpetkLpJA9OFxinHn94CXchl",
+	"dPz862+aGfAPJ9/iyS8nk/86vr2d/H16q//vp9vbu/9xezu5vf3T7e1f7v789N/H1Xv2l6e3t9OfTMVQ",
+	"cfBppPVZ8Iz7zA4CwYuisGAMYz9utt8OWzW7dszwYU14Odbs/oFsW+VyJDkmma6IE1nirI6b3HW7Ma3b",
+	"T1tWesCu8q3rGBNYpLh7c7ifLlt3tOOD4qv50jQ3ThfuvlbRPBjC6k/FZwmE97fn7feb+gLVvi6rTR77",
+	"s345K941AB0T/mo50ER71lE9Viijp+8ubl4fGyeKyg+yyrYnS04b+TeejTT7WS+SfwhGJ+aV1sptpDIZ",
+	"7M8eso9dtwK0mytS8FCnX4rddeF1FpvZ15wD7LZQayBD+7mTZ429dD+SzGCQvqdE9ssw6825806U9lh4",
+	"PcHVIGxTekZhYerzjL/mK4GiubMeRM0N/mrY8CC6vX+MJxWWmKcPmIN2CDce6zp/uyZAbWT4PH4zjXDA",
+	"z+g5E6DXHg2oG6WJDNvyL3TUWDgj5BXMGLNxqJdMxf6lF/N5w9h/8oCJ1EG11tHChjBmJJGXWLkwbGQ4",
+	"aAzIQ61T5mEbKG2aBRpF/pgCxY1hBsrb1t5GYYgYgWpt+qyZ44akHRdqcOHeqbOLycvdBp8K5j/1swAq",
+	"VXAETpY6I1fCuHkLODVpKepTnVlVErgCnOACz0hG5Gp6S9cHLZhBNBZloszi+tG89hsgXXVXIdnrB6XU",
+	"jhNVw3+joIOP7zzfA8OrUTtCzlYt1DqQFT+FHJO+Y0wqj6QNQJmYkK131U5silJNnGA1UxAe+oWrhK6d",
+	"9B2Jc9vx3qdyRZouFnFzTjcUe52D3RqHnELXNO/EYYoXJgeKgmQjNUSMCE2yMjUP/gN1371HINSzFfak",
+	"rTYsm1sr/OiJqndtIsq20zSta70DUSkhewX6uA3V063uWwz2e70D9bdxA/6zb+MNCuxxG+/C3eAWtCZt",
+	"dQVa3LBXWGeOuyjlxdz+7SWV2Mam30DS6yJQ6vcabNzKbtEsXWu2J+Lj2rDX/USaxn+w+NmgkLOmHS3d",
+	"DAAt34j4aNJrdldd/Z5+936Sg/YBNG/p18hr8E2Yw2PRfQRim3tntRzKK2WfyapT5tqXTetYGZs8hOln",
+	"JzOQ9imxqkEtyKs3clwW/4H3RrGxZZjnfuv42uqjTp14jD6ID81nST/kH5rPkn5Yfuh9lvTpX46rl0mf",
+	"/eX2Nu17nrSHdK9pwtTONcY3FWxdw6jaM1nPLJa4toxVPlZOohSZeVTE5NsdnRzIdHVpG7vf31kg/cNp",
+	"JQ7qjqlTZSDZuk18qljDOMEO2lW/xK5+iV3tA9Phuj2EsXZh/gZ51XvSdoVebe6tWmeDDKtn1brzrOcI",
+	"Kmj9EQHY5f8ayGb84MXDusW+xALNAChyAPrCX2eQiaGj9Jqj7InLX20g6UNyUWSr+lGgnuD7zozace4+",
+	"bbVKvoMC1M8UXc1jDSbreMO7EduVSwbfNsTShgT7fKIuI3wWGedH7Fp81xeP3AxrVnVHKHwe1Ngf0oi0",
+	"rOumYItryQDhqwmajufK8Ik8WM1shF5Fg06n7hPhXAsV1iFPM8HX5xp3TwL4icyFyQnoM1pg/Tcvg3fM",
+	"VRBH+oBztS6+78YPIwzH+Ol93oYbTdV9F3rqIqaf9bjg71v6udyu7k7vgWSZLxCJqG4BTRY/4bMZESFx",
+	"3SMx1SRvLyz77BY9FTdbQB0gfRILZ1tx0DrRPyYZvb8Uuhnppxvnme+meYYwHf6omeO/JxmcmpjeMMWq",
+	"gF81xXMScuJPhtrrYxOS8Emip+9vvp+8fIYYbz9a4nWig0lJ1jsXqp47RW3JRt5J8fFxE0L1R8iq0iom",
+	"tkuhBWdlEaaPGusTgXSN2DuXA9HaHXbvwiqq0TIHThJ09qr5Uu5txBmToWeexyeTyFkKgxgWwK2PnX5B",
+	"aIr+k5X6zGVwNjbynHFAc5yTjGCOWCJxZrONowywPnT/Apy5ZFWH33z1leYHbPbAhOS2gYmkDbX56vnh",
+	"M3XokyVJDwTIhfpHkuTjCs2sMQJVgS06jUfjUWCTzqM1GH1KNiHwqUdXhV4460hpX13upRZ70E/YfMb5",
+	"/KwPGCt+3oNB0Zcuj/GWAKpVtxWEk5lgWSlBZTrQEDoGukqsbGKqC2dB7WRFWhB5BfMwp3A/QSRGb4hs",
+	"eo/ap3Y2MV06g6UN/leuzDY+v87t3JP+xxWvV+1rUI2noDowjbZ6BfdkSLczpQrpUngvAA7i28neUCHf",
+	"6TXuM8L2pWpqj7blET76zVo786M3YpVo5rdJ9bpd9tJkibmss5cuW3lxNn2x1YuUqmqFkj7Z94VzoFqN",
+	"ApO/MDqO8tUEF8XEf3+00782Kw5o+iZpRMdL0luCBkIIscoUrnacGZEcc5KtELVPmLlXOUQDa4/c/oqL",
+	"6ILQT5p5F9FxdDR9fmScF01G9ONfI6DqFjB1KC+ZkEJzhvorOnY9TBOWW5Y3xUZURAf2o7GOR5cc5uST",
+	"e1ycgx7UKSupjI5fxJE9xmhRw7iMjl8eVsQ9zUohgZ9dhlUnQy8ltQd8ch1RVa3adJSW5s6mnm+k4dhc",
+	"ZhnWFxR6aP7lMyIUYeOOz1PgaAZzxk1CroldtKntsTEVP1lcVaW0NMlRVjhXQTa2gN0D5yQFMV3lWXTn",
+	"6fDr3bn3muK2Jw11Z7NRNumRuw1FP9zcXI7cb9REXQb3HPW1mVz+iZlX53QjmWd9clp+261VoyLgHrh3",
+	"Q2VTEe28W/HubuU2G5fAbUUTNLCPmdiE0OB5dWJ5f/XWPqLHcsWyc2kts+qgo0qn6EzqXDjGxwLQzyXo",
+	"G0aOc5DAhXtT+xjdRgeKyw8kO3BXF3/Rtf9N1w6phYM7YjV9v/0m6DhyNKsPvnU1kNN57C52cXoWeJo+",
+	"tO8UOPk46pJ35+Wtx3yupG8g3Xg3FFfXMYpSPZpcNYcUYe+ko2XlQIjvdg+Rmf6v9XLRl1Jm29gCnIGk",
+	"B94b12ugb0bKyzLL/Ccg3LMbZ/N3TF4ae1YU93gLNg9iT/w2T6bob0ug2vinyk6yB7wST2IvuTsRqChV",
+	"pLtN2CxJDq1W71RJo1FeColwZtJ16tck+zPNmD6juD0YDXXknbSiTwVH/WjBUp8svEE6h7mVMs0NTZcO",
+	"PYmPn5UTdwuk7wIMBDz5qQLsTsLmCFO1DicK9YxgKrvyJXCCa7DodsP32FyP3Uq6NUJwPbbmJWAOCyIk",
+	"XykPEmLu1meAsLvVAu41NK+2VW4/Sio5YLHaZFVqQPWvPeAynouuPBa+I8+YHc6Nd/wcD756OLS56IZD",
+	"MZH+xmG1nL2FNNf2kzVqpsFyl42o7/GU480pUin35lXhrkTbnjaVbSoQpvJ51ZleEvcQdPB9mw5R94W7",
+	"OsCp3sYanmoskWn4T2q/Lqnc8pTSuCM3NPBOIla36rVwrJ+zmqybmkiq4hGg/nlt0oGl5tt16qm9W3c9",
+	"alvXC2D0Wj3XSUm+PCXjk2TsmUS94J7avOBmuTQtC2qoAb3Q6mgdvfDzHCk2PEp4/nLd0KKqDCndyOV/",
+	"1QYAnGX1C+pemiCt5S/xPcRW1lhDgNAtDDI6pzq3dc3GGbhDp5TJOux9Sx+GunLg1fjwqyn2uf8qR+GA",
+	"05GJKlcttauRGcoGnkb6Sb0t+lJ+FypMBTLYqL/FwJO+ytvj51Jvl/bBhYafqvdycg2ldlExWXCNxw66",
+	"dA/cV/TW+496xRGnE0az1cgXgHd2YTnHOsWkKVYxb8amai2zxpLVTNzM+AJTlbZZ1UuwhAXj6udTkbDC",
+	"fBWQQSKfOWYOctG4DdPUD+5yehMLzZLnJ4yl2uuEualw32NEKLrVfqMHqq/byL5sNfgeRq9n0glFrMA/",
+	"l+CIqLslOkVp5WluLKpPRB0z6PkwYdo/ztEXteFnBT+jbskSMlaxbFqXZyVNM0BFOcuIWNrIA9o4LQay",
+	"0qzR6tw6q0I2htQ8QhvmaNerfhR7BdzcNJRUWf561L2BU/aNB1F7MHQuXp+I6lQdtwMs8EK/JGoy41lH",
+	"B0M+cQDpAo7vjxDjjU//blPl6UR56Mz0Fkq5b5aBXglFhpNa+sxL9TrJzyXOjDpYT19BKDXLXtYJ+9SS",
+	"yO6NkdH0Y+xbu1roFQeMu1MesnLXU7ONwmi5erR2cIllsvRCM6rTbFgez/Wz4i3eZj27mo0TNZfiNvu0",
+	"bwPEaRqZWzujHnPI2b36QzazH9azEXYYOEH/6/riHbo02nhlLQ7fooZR1UV6saf6KVyL1LRDZlYM3cR3",
+	"08AESP4fJU4zkF+eQ93pWabtH7D9jd5W3eFl4sHLox2eE+4FeTfetnZlL7DDe9lVM3LLVDV7V/gSp29F",
+	"d9s6y5tTNd8xaVUkTO11g9JedH2nP7N74N79a+10IXhyQGgKn6b/EDsoLY4ZTzLg8sqGchf9uRy641w2",
+	"HzNoec/r3V3BDruy90ZZuvhLtVlKd75QxPC1hnvgeAEmBBQR78ky63mgOyZ0MUXfa/XyeDi68ol40gyb",
+	"fJI/aYZNPlk+6Q2bvL1N/9wfKVkAT4DK3lTFdbmimhmRZg3JyWJhHjzvUtKcgoyd8R62Tq7VYIJrCykc",
+	"UO668eauMbjm6eZuKzZsYNANILWlHe5ym3IwfazOPzHuUq4XlxpwbxWvx946BpV1lHCZJtX4iRp/Tii2",
+	"H+yr0+rP08v3fVPd8xxzHL3SOYvDjfqi2ePo3CYlDrfrt9nVNqXVO330aRjTHuNdtpmeIW61wQyNYJsg",
+	"mx5CPt41V1TDsjiSKQaTjITj73FDmW+ZsZzsH0qvqishrmrZx/kYtYtPCS/kJIOOoTEydT8pV+udKZR0",
+	"VW2HhC7OqAQejBitNpIZyAcA6oiCdFMQv8neUMXT920QA+bm2J+fwIhHy9jeB2H0dz1v1h/MHibUaBKc",
+	"ubCqlNEnzmMMmXtmz7z0GSPXk2A8xHW5WBgPT+20ZvFKXAiBPmmbmKgYHSJiYw/MhY1v13vxPGjX+xIu",
+	"v9dweSGCqs8Y9dHP4ENEfSbve6NXhPXUHCdLQqG3q4flqtWBmmhrOrrVL8uUXJkqDT46nEXXNyyg3z4u",
+	"pIIBXP+krBmpd49JpjqeohNlZRaMoiTD3JgonRuocBHLKaBZqRYdCM25zgUWEbkmm9BQwr2aeOiCAmJz",
+	"5eN4XSYJCHEbIcb9kX52thEFJBNM00nvezEj0g5Ub65qMVFxQM104wWkyb55onOwK7pB/23ikiyWk0yN",
+	"1OT/1Inb66TGDU8xXWZQy5iOotIODNXnuXs6yAHRFVJo/MwxoRIoptbQNOcglqao3Ch9UneUJw6RbtGV",
+	"h3G39KweQ7ewehCpp0M3sG7xK8DDFc4btAhh7VGnW7w2pZNt8lp7+q9hBBMO0Aw71RzhIicdF7i4gdj9",
+	"NeEltbdgGaEfIa3+8EpwRrDQ0y9MDfOHV0P1TBLzhIfrgVBjho+q+zT92SQkM66FM5x6rBNHm3GPR5rX",
+	"1bh6y64qZLtV3rqh9xUNNT6x1OmWnDt69RUNgb12JO0WvaqJ3C08q8neLXzjTUSAwbyp6ZZ+h8Ot6vSf",
+	"Adqr3Wgtj7+1z14PcLiSACP4W8hypjiY2TyolMnJnJVaRs9wOhEg7YIGmw41B77weHpbSVYN4dpg0P78",
+	"1mHULnjH5PcWwXbRdzi9rvBtF7p0ru3v5248nYIWM1YFYyWRlxC6Y57DtWDbJed0e9dr31MHN8F+3c35",
+	"8etUZQ3D5UUB9Pr6B3shi1IMOaOtZyIOv3oZUHGgZu5dRtoW64+GaXeG21xKOlplVgENLasHo0DEmkjW",
+	"O8s5MNRKREU4XlLqdIGKVN981Txo4skvh5NvJ3d/DlohVUdhbKoHQdyV520kxDKdWjeU2+hZExm/cK3a",
+	"prtt8lNzNv0ZiBsc7VFxtB6nXFX+izmnWBdz8JYZu1z3mU/0C6NQ+wtwYU/+moHPTt6dWO8EdHL1+uTg",
+	"7cXpyc3ZxTvlPAQc9MdmvreEUUkoUIkYRywBTGPtlOFaVm5/qnKBuSRJmWGOBJFQ31djiTAHHOulZAmP",
+	"TrRHID54Bw9//0/GP8bodamkwcEl5sQZZkqK8xlZlKwU6MVERYviRNorYj3WVmQ+enobvTm/uY3UrL+/",
+	"ObWTPS7b3/tOIti2r/6cUOeDYWvpIeFSMnU0SqpUttpORdNQElxJclfqfBfVN2BlKLXJdh5rp5zR15/U",
+	"PDrDg5CYyzccJ+Cng9zcFOgaKwOWx5sbA6oYu3MuklEQ29FLxvfW676q3nUjNDNo/QzH3okZOIXnAlyH",
+	"MCi2dxypgZtpbgaKRgcgkwMdoauOnPNpeszZtqk/H723hjUeiR465Jhk0XEkAef/Ps/IYikTmU0Ji5zH",
+	"lJaX3+sSpCICOMvQDWAV7Vty1dQdlRutO35fPzVB3D0NNXtmjU1G0GrCpKCsBuZqUaePhtzma5lnAFIf",
+	"9SFdOMcb400ml0A4emD8o1powiRJz0gCVEDtlBSdFDhZAno+PewM5uHhYYp18ZTxxYFtKw7enp2+fnf9",
+	"evJ8ejhdyjwzTC71dLWIdHJ5pkJ6nV0wskxo04IrPoyOoxfTw+lRHTj9a3TgZQWy6bGc7UsVFyyUkfPU",
+	"hDpgdFo3vjaN6xSdtT28MoucpVXj3paRYS8Q8juWrlpZd7zwk4N/WEuUWdPbyaReJB6bbG5d702ufGFW",
+	"4fPDo98Vu9CUpGq2vzo8/LyIVVkrO1h8h1NUIakwOfq9MHlPcSmX2nPTEuXF74XK94zPSJoCNXh8+3vh",
+	"0XyPQiPz/HdD5oYxdI7pyrGLzmr29e83SddmD3hPK0OycfPAC30Y7pWS0Z2qNiBFD35V0v9RBxeBDHnE",
+	"4NSob1W0Su/C7wrTNyCHJGkdda9vgIfdE9cLcyQZWpg7HqIg2KxCdnvT/7SlZuxNV9voUFLycwln5g7T",
+	"hMjcdYTs4R9IyF789YtU65FqX/1eeFRmpi/ybI/yzGq3VngdOG/rXin2BmTQLbtfDXwD0iVFNRlTNxVX",
+	"ppUVSc3ORdtXYz8S6/ExDiGl3ybRlsYKgx/rt4l0tzqrSd1vMCXsUL+/uVi0U9IrA5+bBd9eisiL4f5D",
+	"icnfUzyhWj79fsrfH1ft86SSERphEVTfwBdYJuEwGRfY7OXqfbVODulmjZzN28khX0fSGO5L5txtciCe",
+	"6K7/vIc5bAR+jDoO/84i6cux94uCuF4Cf9EQWxoi6lMRK2EcR0UZUPne22fYNhW4VyZ0as8it34Y7TeX",
+	"uXuUa19E7D+jiP0i2sZrdfVzEOOvGSgKPQE2fL/QafFb3it0O/8j3Cf0YPXlHuHLPcJ/k6PkH1qf6ki+",
+	"Xom47spAGds2FIpvQIYk4kZaV39/e70X+B2sXaMk4xfj/5ez3X9rWfRoEuI7YWAcVA5wQQ7uj0xyTbwI",
+	"yYkLJ2mECsFvnc20i5EVBFYRfIyHIfTLGR9YdwiPd4//fwDeF3d2MAkBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,4 +8,25 @@ const (
 	CatalogListKind     = "CatalogList"
 	CatalogItemKind     = "CatalogItem"
 	CatalogItemListKind = "CatalogItemList"
+	// The CatalogItem versions installed as applications of a fleet or device.  Contains a JSON list of CatalogItemInstallations
+	CatalogAnnotationInstalledItems = "catalog-controller/installedItems"
+
+	BulkOperationAPIVersion = "v1alpha1"
+	BulkOperationKind       = "BulkOperation"
+	BulkOperationListKind   = "BulkOperationList"
+	// The label selectors of the devices the creator of a bulk operation may apply its action to, if the creator's
+	// permission for the action is only granted by label-scoped RoleBindings.  Contains a JSON list of label selectors.
+	BulkOperationAnnotationScopeLabelSelectors = "bulkoperation-controller/scopeLabelSelectors"
+
+	RoleAPIVersion = "v1alpha1"
+	RoleKind       = "Role"
+	RoleListKind   = "RoleList"
+
+	RoleBindingAPIVersion = "v1alpha1"
+	RoleBindingKind       = "RoleBinding"
+	RoleBindingListKind   = "RoleBindingList"
+
+	EnrollmentApprovalPolicyAPIVersion = "v1alpha1"
+	EnrollmentApprovalPolicyKind       = "EnrollmentApprovalPolicy"
+	EnrollmentApprovalPolicyListKind   = "EnrollmentApprovalPolicyList"
 )
@@ -19,109 +19,176 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PbNtb/V8Fy/zOx9y/R1+RpPNOZx+skrbdJ49pO9kXd6UDkkYQ1CbAAKFvN+Ls/",
-	"cw4AXiTSlhPHSbZ601okLgfn/jsAmA9RovJCSZDWRAcfIpNMIef052Eh3oM2Qkn8lYJJtCgs/YwOT479",
-	"O5bCWEgwzE6BzdwzSJkbh6kxs1NhmIZCgwFpOQ6Aj7lkavQfSGzMzkBjR2amqsxSlig5A22ZhkRNpPiz",
-	"Gs0wq2iajFswlglpQUuesRnPShgwLlOW8znTgOOyUjZGoCYmZm+UBibkWB2wqbWFOdjamggbX35nYqG2",
-	"EpXnpRR2vpUoabUYlVZps5XCDLItIyZDrpOpsJDYUsMWL8SQiJW4KBPn6d81GFXqBEwcDSKQZR4d/BrN",
-	"dnhWTPlONIjGmZhMbWIznK16/tsgsvMCooPIWC3kJBpE18OJGrYf3gyiI255piYoj0KrArQVQLLiLVn9",
-	"Pw3j6CD6+1Yt2y3Pwq2GVG8G0aWQ6bJ0fxIyZcIwztzUjnu1EPERyuH05dk5C0t2gnYyrZuaWrwoGiHH",
-	"oF3LsVY5jQIyLZSQln4kmQBpmSlHubCoN3+UYCxKPmZHXEpl2QhYWaTcQhqzY8mOeA7ZETfw2YWL4jBD",
-	"ZBmJd0k6OViecsvvEsFsZwSW7/yuCpC8EL+/JZ69ActxFFNActcIXg/OsCl2sdyWZtVOrvHNzSBC7goN",
-	"KSppQ4O8WjQW5Kmq9dSJuaGSxxbyZUVqvGyoBNo+L4pMJM4bWMgLNGmnEpwlrlfMji0rtJqJFAy6GV5m",
-	"Fr3DWExK7bo6s2Z2yi1LuETdSEpjVU5GfzUFyXiaBn1tTaoYZ+MMwKIsvxlr+hS9a0jjI5QNuzmFe3jN",
-	"OdRWjHliOwKNZDwjN2/FDBj3DdlY6Zx3iE7yHJZHeUt/8IxNy5zLoQae8lEGLBWmyPicYS8c0nE9TEI+",
-	"/JrnRYaE/3L09t+77IUwl+w45xPokoR7sDI7w7LPsdvNICq16OBAWPK702O28fbomGkYgwaZwIC9O309",
-	"YGd7rOB2OmBgk3izTfUfJZ+j89OQTrnd0lPIhiOlbDL8I1FXu8uLWBAukrSi8M794tvk41OK9wuSY8cy",
-	"yUq0bEeOQJ4OR6XIUtBMlbYoQ1sTsxfO+ikDQO/NhQTNxJgpmc2ZktAWmg+8VctoEIXl8lxEg0gYhX9L",
-	"niiZ8qH7OcvTS/zfFDVY86toEE0S6I7OOMVwxjVqjsG5ehhy1CChp8kvnrKe14dEcM/LYyK8r6df3q2N",
-	"3rtF970lXvS8PCUW9bz8ATnXVpQjbmGi9NwpCQk0OogaTjkaLMcP6kEKFOICExbyppjN3GD0GbSGurfU",
-	"wlxnYbSOd4fNCRYWF+LSKOuwgqNW1BoLyNJ21EIvKcYCUsYtLZBlmJewDV6FPrNJGa6agdYiTTG02ZBx",
-	"u9Yx84546Dr76Dguswyz4iLjCdDg7fcbmFXloCeQbi57VBdwKSymqXB+9KTRwuoSBrcuN0wDcvaeazNg",
-	"hdLWDNhMZWUOZlAFPdPlwj5Evh/++frtD7+/fvn+5evoIMJED10RjYbCfL79fPsA/0OyWXJZbiFn5Ibv",
-	"t5x/nb39mbmODu5gyE4aAmcF1zwHi2kuyshOQWhct0iJBY2wXdODUagrWL0Ay0UGKUtVUuYBMw1YQX6f",
-	"jzJMo1nO9WWqrmQjEi578tvc9gtMKrwud9BQvaSEGqcgzVV6wRCZ0kEJY3ZCaQoqmUzRTihvcyMhiqPM",
-	"c1nDcjAGA+oSFac+EDHfgsF1kXHH/qvp3EVr0ZoDuX+FdmUVSxUT0ljgaTsmnlM3pL3VN2bvDDA5EfJ6",
-	"WGSlaXZeivTemlA8t6QblFcQBgbW6NFm4EbDIjGcLUTwmqA7g3Vg5B0B+7Uw9tZcHRu4jDXDv9SYNV6a",
-	"h8uXceFmmZLXPZNWzVdMr+qMLOJa8/ka8H4lgBcF7BDI/aCEU4A7tJsGRqXMsrfj6ODXT8Dii1WWpC6/",
-	"LCS5KEhv07byLiPIlJw4UZ6JXGRco1ui5KOgUCzZT+UItATrK0aV1UM6gSEviruNPhC1zJbfFuPYG89K",
-	"cuNtbB7KVuzltQWZGlYzgl0JO62WZxJVCDnpjGiNIU8DRuny6v5VKOcVWuRcz2uIQGlO8KFN+BfwwLIH",
-	"8l07vMlhP3zEnCSexANG4GDAjs/eEmsIkTBCJKbtjX8NCPMWOBiQBqG5OwEYapnv6FDIXd2wFYr2vt6w",
-	"wtgdXrETeJ4sigUBKOqCKuu80/JJzM7KgpIw1kKnhuCpqfCpz+464WmhVQ52CqVp/PkQ4PTMFzh6Yx02",
-	"aNWw2wWm5YSnI0Nu4JoVhVFBoZtBgEL3kWULbNAQrVxuxVGaGSAO4oohP3dWUH68pXBippiHClmxCbMG",
-	"ISfdQaOV1b7TWVf8l5foG+Da1/dbXToHnaocis4cEl1zeIv66Ms80M7BCq1oL6JrbJF0Zcg4lNKM3Cka",
-	"hs/yWqNiT5ovsEtI9u64cxJf59RdZuje4GxFOcqEmeKPjuk2UNxczrGlBZ6TdDZ70teGe15RXWqXfjOI",
-	"zFRp+6JJ6JLfZSMtYMyUhGEmJLDG6y7yO+k0zrP064hvUEcwEkpTX0gAE7CUxE0hK+IHqNyFip13g11h",
-	"Z8ZFRrYS2rDSIAlHQiZCYkBiuUoRtCPs8OjAELUhIGISMGDmUhTG1bKNpRGTKZcSMv+GtsFySAW39WSL",
-	"Uct3IZzsRokG0Zgbiw40TB4dRLvx/n68HQ0iGhtb78b7e/E2+Vk+iQ6i2W68/5SazELOH/lHGMuaE4Xx",
-	"F9xThfeiM0hKLeycjcU1GocfO2YnGXCDae9E8xTiBuLyRO4RBTVF+8sU7XdQtLT0eoS95RHw0ceE2gbE",
-	"WYy0M2HESGTC3idavK87LYZAGr9pzw2dvCM03l6vbXkWjPpCMgQoIdg1C3AKQ/VY6PyKa6Qg1WJGFc9m",
-	"AXYKWR4NMOKnGVh6mRfKUHtM7+9dsENK355FS2t6VROy8OZFoGvheU+RFl/96KheePpLtYilkcKaFqem",
-	"JbYF0MDMq4GV/gzgQ6Qk+BFa6sEnOGv7YSomgNqPqr2QyVSmspQx+TcO3oTsr4Fwmvi8x7vWVvBA+Qot",
-	"Y7n6cnTM3DtypiLPS+c2KyOJ2ZvSljzL5gyuk6w0iA0I5VA6+4rAwQEzU7779NlBHLdzVv+Y70H6/GnC",
-	"YbS9Ox7Ds++SNH0+Tr/b399+9uy7bQ7P99Jne3vJaOfZ/u5uur0N3/H/SXZ3nz99Otp/lu7fUlUy3WkM",
-	"ho86nvjyg6xjx6AuTjZhlXeiFE26g+ylKE657MqeziCfgWYa36JXqGJZVbsOo6dCQ2KzuQN1jjIX2lAI",
-	"fw6N1ZiSuEIGRempsmNxvQh8L8rt7T34fifejrcZ/Uh2Yu/tuwjvCr1VYfej6F0x0mIEGdL2U3fMjWgJ",
-	"0SDaiXdc/FzdPigqLS6LYCZqKJJclFnWr8ZO/duMreP20vSzvmM+Z5BzaUVS6ZxIQVoxFqADdN6Jd+O9",
-	"AUbu7Xh7qJOdzZhVddtxXRxmSqdAtTVE90EKE82LaZvOPjIXAt+sqhNVTmuFCshhTc7ijhIbqyxTV8F6",
-	"FtM0VAtd51cXkmtgUqWAasEaikVLokUGwhg2zfgI/yS7VcZ3jS/ku4Z1upYOjKZsNK+zwg1n+5shG9zI",
-	"y8yKgp4ofSErE2YbpmGym41t1p7g0dyMupB+c6m1SxTwacxeXnMyGXQ6mMHzCWXbztfmpbGtnaz4Qt5R",
-	"JHrfSobakqrf0YavVhnmwIps2AA4LTLQCSNCXpJqPsYgHWBTev8koybjhR+s8+VJPUO9xFvr7L019kev",
-	"r9+7tr6uq//V6uq3VtF8BQ3fjDpraE1XG1daR76uAggO+GYqwXA2zLnkE0jv2HZ6pILVx9V/Hq30c4+q",
-	"z4MWbb5sveYhcHSvtlfHKbv1nV43zzMSV0qtyeG4t630wi2182iFy1S7JmuMB4syrwdcyW8v+omjMO+y",
-	"J1/cXKop7HIPfZzyLCLPr8GW2h8CIdmiifuEPFXyiQ0tlCXtpsEfMAgmKu0CNuUEsxZI2Y/n5yeBBGxb",
-	"nwN0ohywbSbGDAOGcYdF3eYRnUCxe40jdAgPJqDX4fDBw2HP+ZDDpfOcy96qtkfHxwJ0/zaCBm663WLO",
-	"k6mQ0DtVdRylmgAFLSTRcBG94iIrNVxE4RAMO/YEORUQhkFeWBwDUYYwTKrWwRseyskxO2SnRCZLMq4x",
-	"06YD1aTGfrGkxqMS7QsMaW4jr+/227cbsudlzTz2liDAAbuIzsokAWMuIvTkjZV+drVBoDHkMh3W54pu",
-	"h4xdWZBfuHcTlQYMbj1Ls+hP1zdlHuymDDF2+aJMeLz6VZn+mPfRZ0OqIf7JDXQcD1llD6l3TLej1FXd",
-	"X6GsUY0SEmrDUjpF6NJqOh+N5mKrRGI5uxCGHZ4cs1MIycVtTCQOLOk6PmVVyoBBriT5N0or9XkXmq2p",
-	"FK74JuyUkXjohkaaYrB0FYWEIRsY6spydpBxY881l8YxU+Q9W8HYjlmRQ8ApnlZb9YXUhVBkmvenSImk",
-	"9KQV/jFWDnGsewUtAiasiiPhaKM/MYk8CqLjI1VaT3FFXqf7ViODniH9AaT30N2rjwPoiydVS5eJtblx",
-	"xQ0mO2zEDaSsLNy0zbzn2X5n3tMfQDcIWGwy16LehQ9zPjErrXS1q069ettz+amKAh1q9JExYXHKO0Jr",
-	"xYdBqK6da/TUr3hmYMDeyUuprmTTV+L7aBBRg2gQ+RarVrja1PmxFp6GoRceVzPdtuo7NhcrTRPNRLOx",
-	"usMCYTFgiD4/efMeKNHBX40XL0C6Z6/ovPRyU0pMhNvwbf0ITu6Ea0NNz+YyoT/e80zg/0/RY5X2WJ5o",
-	"NdFgUDneYXbsWHpWQBKavvGl2LdXErQhumYigReAMVIYDPPYaTXBvJRaZRmC4VOXojfWu/Suvdwj9IZj",
-	"OnZ9JiY45/IQvW0qXva2qJjc26JNzikUygir9LyT9cjx3hdL8mm+rGT1KgOwQQr0o0tqThoN2bkHTQm6",
-	"J6vKsUPtq8JbZ62VDlHWBbLgg53jNXNpp2BF0iiJUCl9ymcwYILK9xgVMmGsu10w41qo0lTQxiNmdlhj",
-	"RkzucID6fpQasw/1mc4BC4TddJYlrJBlh/2+4XMq8YNFSIx+qzSg6TdnmciFdRscwGSZj1ytiioUHmBD",
-	"6nLP+npAFXwogGk25YblmHkShxqoB8NXhZVUwf8ooUpjR0RHinFaGEMvXDHBw9yQDTcQMXKeQqbLfKgG",
-	"bxWSqQXMXHYg4ZowPyXh1XGeit1Hjk20pYUezQhjMaeisZAsD6oL5XQ8sMyvtF0jxXUnUy4nkCKOIhbY",
-	"KZeMszFcsVzIEtlFMi24MQjSz+lWgZN4wBi0m1Nx2+1lunNHtM4gWs/KK5FlSKLb1kt4FjjlOe1kORba",
-	"UMWuUBKDUSkzMIbNVeno0ZCAqFhp1SVIf5VXMtAal+MCZw/mzt2VDrczVXZdqDhvaZQpRwYFK61XLk8n",
-	"Mf5qKpIpc5tz1psPpK5JEHRYikfhEJ46ZQk16dTt2aE4HFcNZJAgzBlgp0U9r9YRiDKsdJEyXAnywwSm",
-	"ZzBG6EXGI1OmcmEtpCwtqTRkQAueiT893m4SSnLMiwwssA0QpOkjSHhpEN+74oFlybSUlziSqt+GCzM2",
-	"ZMLUaLNejwbPOqeBi2tyCxHmU1YSMKyii56k47OdeOcpS1UosjXmcFqOGaZEMeIiqvs0i3qDK/sHGCty",
-	"cvX/cNYm/gRfjVUZyo+IOKJyWAWkcV4N5Cn7xrYqeD6l/Q+49vdN70yMb1ZIGBs3D5aPtNSH8cViAOFZ",
-	"xgq0fqpmdgYRZxMi7ARjD+/FyH/7tokGbjuq1FQd5FWZuvvaXMehhqW9DN/YIYt55QtFAp23CYgen4Ub",
-	"y/Oi2y9UYM6XSBG7uKWkq6O1FDL4mLm8AVD3+8w3uQWoHTLn3ZLKu7QKxA083ABx3ihSMHTwgnB9zE5U",
-	"UWZ0Ly7wmy63xuwUeEqHV1bEde70wieI/w0vyHW7uvclzEMqs/T9hhDJlZ5wicZLRyncqUP8uWESVbin",
-	"ziFvNvcMlrSo+4MAFFAal/N8ga0TW2M62CWlRoGeW6aupAkVPPcckzd2QYXGLZzrIuq/q4lhsBXIO7+E",
-	"4NMez0SatjqQYxq5xRPTqPg1zrvI/nWu4qFOuE2mPtdH+qq9p26dGBN0XXAmqsey6rK5VejOkFNNPMhT",
-	"upTvzsPQX7makWmBP8i7tK3K7bRLbHSZ90QRg+heSvf+IipmN6kuN/LVKaXDIZ14qaqgisiT0VUwWDoZ",
-	"vMDtr3Nv7ZbS93qvbb3Xtt5rW++1PeReG7LL3xGh7y84dzUCrkEfls7Bu1+vgqP417/PcS5qHR34tzW9",
-	"yB0K63pynHY7+Hfvjl9UYnBZSKNS7gN9Db1j9oYXfpOg1b7O1GOM/3RcQ9KlR9DzKKQmSMnvVIYKEaEQ",
-	"P8E8usHF0zcufEnGfx8JoUkWHUQWeP6/zW2yekRcxCt6Q0UKrTJ2Djyny6WZ5wFqSKv3Usr2a3uI3za6",
-	"um16H+vyeHfiCdBY3IVbd5CLvnmgxu5zW6ThkE7QrLFP84sZV0pfZoqnJr6QF/I87EmFHGYjfDFvs97n",
-	"ogeolhNogB+HjDSgEyZnbpUvr7hTqZlIQLotLM+zw4InU2C7dPS4zaarq6uY0+tY6cmW72u2Xh8fvfz5",
-	"7OVwN96OpzbPKKoLS6eYF9h/eHLculxUffoPNdGF0ugg2ou34x2fM5Cib/kDP1WmNQHbc56zeVSO8UQr",
-	"44Be85Bn5VNQ86nbYZY1O9Lc4UsmtD/akYP6o/VVQ+QtqXSoGRj6UlMVG11O2qzgeduqRyD8mWN62ag+",
-	"+VZPQs3qia86eEdfaJhRGbRd0ukxsqqm6V0D78AsN4MuKOZqPq44iC0TW1diqOzjS20BUTukJbQ/8d3+",
-	"dhXMQM/t1F+g7yKUep35WR+RWncMfBAO6FHhiNRFaWTxJbAn3z8ZsCff43/RYp/87fsn4RLARXQJ853v",
-	"SW47g0uY7/7N/di9iDb7VkozftxKUZVyfi3yMm9V4JzmVYts1gXrmt95XYMl/O4KTv2K1uqO6WlLzeFa",
-	"GOsGXSiuYq5IdbX2551MQ++FRN9ZlzOJQ72aIXJhW3y6Mzm+ocudjnLyH7vb2yGc+C/XNL6ZtfUfn5DV",
-	"M6x4sJJOulPAWqhb/YQObv8BJ+1BSR1z/5OnLIBVImLnCxDxTvLSTqlwkToq9r4AFa+UHtEXw4iE3edf",
-	"gIRzpdgbLudBJAZJefpFuHHmc5V3skr0Xf2DT0zrgyo3g+h6GHIKjCHNYHwzqKLzapGZtU48LYfiEKi/",
-	"QAxeh+B1CF6H4G83BD9e+P0i4fbLhNcvEE6/YPh8mHBZqM4bj7Qbx/hSJFwOhK5puGfoSkpg7D9VOn9o",
-	"o3FrrWtWVpdws2SrO59n2i4GpWtj/ezGuv0Yxnqk5DgTbpdn7R7uyKbbmfTWB//XzdZ9a17+Hw84qm8n",
-	"9ubYK9W6FreIu5wX5Qi0w1dnuS3fVbuW+6WRn5Djf3XZ98Nn2Z+Se66LK+uAcZ+Asf8IU/6sLHulSpmu",
-	"I8ZHJZT0DVsCcr3ev5VYHrsP/H8t7v+3z5rnui9hd2hAvajWN4Bpq6w6Cvjo+XEfuesc+b/Y5T16Wv6N",
-	"5MZby7XmxQx56wN6nhvnJDOwnf+gBB0Vb7vLu9Jl1+nrdJiDFedu+bWe+el/9/bWnynr61elkOytndDn",
-	"ckJfn0voBL4/0F2r+1jyD2DXZvyoZnxHKrO25b+eLRel7fqXMNw/yXUfIOP7rC36G4BR1c2Au3HUIzuf",
-	"NXBb+9k1cHtk4HZvoHbLRmkLnn1qBKB/LI8GXIOktcGvi9MPhs9uMeAalT2E9U7cbblvBBitbXdtu18a",
-	"j3GbdFwPpivOK9kvtXxACyaCvggUGtLU///Tjlu37oavdLLqUb3KGnesccf6HNdnLWbRF9ZWAy/tGtZD",
-	"eE93mf7bqCR9de5xfcp17ZDXDvm//WAtVZ+26o9P9KLX5r9gcR8cexY+7/A5diPWMHbtatYw9n4w9n6G",
-	"3AS0X6Epr9HsGs2uPdpfG1vez6G1UeY37NK+fYS59h5r6PXXgl704Tr6ZiR5GfdBsS1eiK3ZDv0DPn6s",
-	"pS89BxdG/1Rw56dDFs5n3fx2838BAAD//w4KQpLFnQAA",
+	"H4sIAAAAAAAC/+y9a3MbN7Iw/FewPG+V7X0pSrKdPBtVpepR5CSrs/FaK8nZD6vUFjjTJHE0A0wAjGRu",
+	"Sv/9qW4Acx9yKEuyfTxfbGpwB/rejcYfk0ilmZIgrZkc/TEx0QpSTj+PM/EraCOUxL9iMJEWmaU/J8dn",
+	"p76MxbAQEgyzK2A37hvEzPXD1ILZlTBMQ6bBgLQcO8DPXDI1/x+I7IxdgMaGzKxUnsQsUvIGtGUaIrWU",
+	"4j9Fb4ZZRcMk3IKxTEgLWvKE3fAkhynjMmYpXzMN2C/LZaUHqmJm7K3SwIRcqCO2sjYzR/v7S2Fn138x",
+	"M6H2I5WmuRR2vR8pabWY51Zpsx/DDST7Riz3uI5WwkJkcw37PBN7NFmJizKzNP4vDUblOgIzm0wnIPN0",
+	"cvSvyc0hT7IVP5xMJ4tELFc2sgmOVnz/bTqx6wwmRxNjtZDLyXTyYW+p9uof76aTH/Lk+l0GmtvOU6kV",
+	"M55liQCDO80j+mIV40nCYrgRERiWchuthFwyYQ0zkECEq52xyxUwVXQjDMu0isAYiBk3axmttJIqN8ma",
+	"zdd0HrdKX4M2UyYkm2OnYPCI/Ti4FZnGDq0Agixeg6z/T8NicjT5r/0SEvf9ge9XYPBuOrkWMm6v+m9C",
+	"xjhLztxGubMuQQ4/4SzPf7y4ZOGAHFg6CCyrmhIYEZCEXIB2NRdapdQLyDhTQlr6I0oESMtMPk9xDzX8",
+	"noOxCKczdsKlVJbNgeVZzC3EM3Yq2QlPITnhBh4dFBF4zB5uGZ1AC5ZSsDzmlm87gpvDOVh++G+VgeSZ",
+	"+Pc72rO3YDn2YjKItvVQA8sLbIANLbe52a2pa3J3N53gTgsNMaJXBZo8iFQW52dYYpg78hYyHUcDUMpV",
+	"QljDw/dIxdk8T66ZauGdVQx4tEJMQODoxYYY8KyFGYIPzcN4Q52+qfZwN50kfA6Jw7Q4FjgpnpzVxmxB",
+	"Q33Vv1AHRC/iOFBdv4ApUxo/qRvQt1pYYGLBeKKBx2tmwM7YO5msWY7kwtOHM6QJvk+3a7NJx4FoSNUN",
+	"/FJMvoHmsDaOnyADCPNzbUr0DLs8dBLCQtq9I/4D15qvy793AFcHLZfYrAmy1NdAmLz049Z347IEQLVo",
+	"weCsvto4NtPyvAweoNu3AJV+Q2esCkmsCpimvrvnYPIUmKb/CthmGacdj3PAs4mUXCQislRfg4xBM/ef",
+	"6w3LxTLXhUxQGYLxJReyykUrK5pMJzWQn07cfOiHG6Gbp2JfezdcS54iHvyra7/r43RUaAzdUaOYTWdZ",
+	"mGDzuB0uY9vEbiFE1aqBHGn3l9/GfthgRLPcNncRI/zeDW+4a/Vj6mEsxvBlRx//XK2rc1twkUCMwHjL",
+	"DTPXIssg7uxRF3syGPmqO9SJgn6hRedbsbHVY+ce3fcUApRf5FEEEAMysZ9ogybTyYXbm/vAtJt1tdeO",
+	"4mKgrqZh7OZ+/CLMNjDFKk4yS/CXWrBa8QNKhgUZb/Cx3mGLBoMhqosnjALpZyCQ4iE7cXQ3wdCBwFa8",
+	"P1tx04PsGRZ18t8q3wIZO1w9z6V0v05UmiVg61h+wmUEyf3QnCZZjtQuK8dul1Vn0y7tJg6uYTHj5qZd",
+	"eLVgA3HAKsyVz8HUeX+TWFqul2ANafhV0Zsk7SCgpjN2bFkCHHFeQqHRsjQ3BPaZVjcihriD7BTS/47S",
+	"HULLQkASX/jBOkwl5URIXsUzjWxtvZ5blOt1IqvQjPo27DnMlrMpuyrAd6ZuJejvf0oA7D7ES7iavOhE",
+	"KhLsHmlyXgovJncN68PvidYdTq9h/fJP7o+X3XNrIqvbz63oeFHojZtgiypVyCgtIdNqqcGYPoytw0QU",
+	"sOLYdmO/FSk0tqZoM21IN5HHFBxmoXTK7eRogkR4D3vpOjgkskLmfZRHGVGVmiV8sM70UjG84JF6082M",
+	"veWSL0ttyIDuFeDc1B37Nz2iYJ7OQdfGagt2srZaIe23r8vhhLSwBL1RYDxmqzzlck8Dj/k8AVYpDisv",
+	"aHDtJDrXlQVKPhjJHe2/m078Nn7MriAYVAiWUWzB9cAdclKl2SRyFoi6ENpYdnhwsPFoEDC9yE1mO9o9",
+	"HZN0UeL+LWgorX8zVsiRRQ0uy99zWCsZNybBNTCFunikcmkdBuwufNV0ow5BzC/l/qcTi5hJZemE1syq",
+	"KVsozeADR4Rmc4h4bgjb125TgsHDb50XtCBmxnILA4/VWK53IzC+RTgTlCQrdqVhlMWEQ7z/bhVdDEdy",
+	"qyxPdh6RW2elhthTLW+kZrcrkN17M2g+DebjKEMHmnfsVpM+toCvi4WdcMsTtcSFj6bwr9gU7uFgNyN4",
+	"aPSw5m/f66mFtA1IlcKqFMW9zB15sRzSLOHWm185i1yrGTu1Qdg26B/kaBKpW/ucP85heMQlwkaUG6tS",
+	"8tYRdqPt2sNrbVDFOFskAPaLcix9DNxVTuMewIbNHMA9POQcaysWPOpgYMeS8YT8s1bcAOO+InOkuX10",
+	"qNe2e3mXOe9FSxAUJkv42pkkkVPTrodBsHfPuidHk3+cvPvnS/ZGmGt2mvJlJ08cYtzvWLazLE4nuRYd",
+	"OxCW/P78lD1/d3LKNCxAg4xgyt6f/zJlF69Yxu1qysBGsxf1Wf+e8zUSPw3xitt9vYJkb66UjfZ+j9Tt",
+	"y636FE5p4OH1WDPXmbNtNE6OncooyRGz3XQE7unePBdJDJqp3GZ5qEsOBcJ+4/0BlgsJmomFkwmVhPqh",
+	"eZtJUXMynYTl8lSg6GgU/pY8UjLme+7PmzS+xv9WCMGa306mk2UEA+0oPRtyUplCT5V/+Jn1FB+nor/w",
+	"1Kj+wmO/vI2VfnWL7itdxf2F5/y2v/Bn3Lk6oJxwC0ul1w5I6EAnR5MKUZ5M2/yDWjhV2/MFJiyk1WM2",
+	"a4PcZ1rraudTC2NdhN46yo6rAzQWF/jSPOnAgpMa1/LmmCrXQiopFgJixi0tkCUol7DnvGB95gWpSeoG",
+	"tBZxDBKr+lAZV3vGPCHec409d1zkSbJmGrKER0Cd18ufS2VZCnoJ8YsuAwZOvd8LbHUO043LDcOAvPmV",
+	"azNlmdLWTNmNStDtNy2YnukiYX9MfDv8+cu7n//9y4+//vgLSeELRUo19oaH+d3BdwdH+A+dTYtkuYVc",
+	"EBnebTn/ffHu78w1dHFKyLKjyoGzjGueggVdGBeFxnWLmG/wU/O4i1m9AevU61hFeRqCnaYsI7rP5wmp",
+	"iynX17G6lRVO2Kbkm8j2G8g0RD0hQJVCEqh16n4jj6wjIlM6AOGMnZGYgkAmY8QTkttcT16nzTu8Nb2G",
+	"m3PPiJivweBDlnC3/bfkBRSGidoYuPu3iFdWsVgxIY0FHtd54iU1w7nX2s7YewNMLoX8sJcluak27vAl",
+	"Ejbh8WwQN6quzkqL+gY+r2AksrMGBy8ntJVZh43cwrBPpbE8Sc6dbrVRaq9X9YpzLUjPkeZKE9x54ZpN",
+	"qSJZlxFMnGZbKWfCMoTsYI/3hMJHuvQgWJe4XlDlv3eKf02vc6UBA2n1ui5g2Eb1yuI6YWGoszusvzED",
+	"QaEVZM5zQXO+HvkbvMKLdnoyunK3nTP2Nrc5R7oOH6IkNygc3wq7KlWatj0WS7bP0h2XVex2pUyw4ZU6",
+	"Wt/s+2e0wcfvDnw3YnwWIKGm/HENnoUxIf0ZdgaGBLBFmPMEGmIXJmJsBe4cuR/GiWKY5z0E96Yv9PWy",
+	"MpcSI9qAuBLLFZiS0dNqhSHAqNCuGsl4OXv9zexgZ47gkb2HJfRUpPhaHaMpTUSrfqogTFgjbnfTBvAo",
+	"SN3pDyktaJu783MPf5Zz304Nanx1oCpY5cb3Iym9m63kA5CDewwgOk1Czf6Hb2ym4Uao3Pw6CKUQTdBX",
+	"UvY/h4XSMGXCDVyFF6yYZ0vN457QoY1oXA4RBKGtnDqAod+lcoBpC/C3MPPu+JlGhXr0TKXw6WJnmoMO",
+	"ct5UGo1xM19N3EzTTolAmSTvFpOjf32EYb3pMtnICXyh1zCQ4s0hUXLpjvJCpCLhFOtAloSM9GrJ/pbP",
+	"QUuwYOr8GOIl7PEsM4PpQntbfmvKQW/9VpJOVje0h8sj7McPFmRsWLkRTiQLyzORyoRcdqqnlS7Pg8Gx",
+	"S0XzRUFeybRIuV6X9j6yWQSFqGrLDca9NgXyTTuoyXG/LbiIICFL35SdXrzDEmdeZGReNHXV6l/BXLzB",
+	"thvMhmSa3WpNRSjzDZ1JcVszrIVHuys1LAzmHVSx04p81jwWtCYjLKi8IlvyJTrnM7KosJqp2ZCt2RTG",
+	"Zm+q6bQ1Z1qlYFeQm8rPh7A0d4eDNSrUbpLVVYC29aLD3FUxUg48jMKuSYKbE993aV61HD6YAOk8G92i",
+	"8183eEHMCo1KQhbbhFKDkMtuplEzUb3XSRf/l9fMKgYf/C27WpPOTlcqhazTIISkOZQiPHqfDdTOlGVa",
+	"0Y3Arr5F1CXFYVdKMyKniBhq0e4VW9J4YbuEZO9PeyRVclrqLjR0JThals8TYVagO4d7jsfN5RprWuAp",
+	"nc6LHltUhTwPBJeSpKMXcKW0fVOdaDuEaq4FoLsF9hIhO0OomnjVETFClKUfRnyFkoPRoVThhQ5gCZaE",
+	"uBUk2ewB3HDB/ebJYBfbueEiIVwJdVhOUTMnQkZCIkNiqYohcTZEb+ozNNvAEFEImFKwlA87MZZ6jFZc",
+	"Skh8CV1GTSEW3JaDNbmWb0JGb9cLhZMYun4QBnd2gNdkB6C+sfbL2etXswOsZvlycjS5KUwFhYYTrAd3",
+	"0/pAof8GeSqMt5MLiHIt7JotxAdEDt/3jJ0lwA0EBWs2uWtO8hXNoJzR6/aMXnfMqLX0sodX7R7w031Y",
+	"bUXFaXLaG2HEXCTC7sItfi0bdd7nquJzBSa3sMbNztcaZUGuL6QzdXnOVfWmKYpNEjq95RpnEGtxQ+7L",
+	"qjd1BQlqrb/nPE7AUmGaKQp+IvF+Z+8bzvTdxaS1pp/KiTRK3oR5Nb73eFyx6K9u1o2v/ygW0eoprKk5",
+	"NC2xfgAVnXmYstIvAfwxURJ8DzXw4Esctf4xFktA6L/7rSXJFKjSkph8iVNvgvRX0XB2utH4QPLKstMX",
+	"gUKoKyNiKtI0d2SzQJJ+wzOJsz+RcnDEzIq//Obbo9msLrP6z/wVxN99E3GYH7xcLODbv0Rx/N0i/svr",
+	"1wfffvuXAw7fvYq/ffUqmh9++/rly/jgAP7C/0/08uV333wzf/1t/HqDi6gnTBHZR1K1VwlDcQyh1bT0",
+	"NFbVKk9EiZt0M9lrkZ1z2SU9XUB6A5ppLEWqUPCywhEdeo+FhshSBGsxM8fa8BD+s2esBp56QwZx6ZWy",
+	"C/Ghqfhe5QcHr+D7w9nB7IDRH9Hh7NXsoG/iXay3cAzca74DOS1ykD2KJenmuRNawmQ6OZwdOv45HD+I",
+	"KzWXRWomQihOOcuTpB+MHfjXN/amz8S/wVR5ASmXVkQFzIkYpBULATqozoezl7NXU+TcB7ODPR0dvsA7",
+	"t94Juyg9vS7GG2ETtftwCkvNs9VgT0SViJXWz4JoDbCAHNe9DTUmt1BJom4D9jTFNAQLXcpXV5JrYFLF",
+	"gGDBuKwviRYZJkaeJn9lhfBWGd90diXfV7DT1XTKKLnuCqnwucP9F0EafJ7miRUZfVH6ShYozJ6bCsq+",
+	"qMRM9TCPamTJlfSRIrWQj6Cfom2IE8oo6WzxfEnStqO14a5TEZYyu5JbjES/1oSh+kmVZRS9pVWCMrAi",
+	"HDYADopcYHxvlE+s+QKZdFCb4t2FjHIab3xnnYVn5QjlEjfa2Xtt7E9uX9/Ztj7a1b82u/oFHUwvLLvi",
+	"ypVKzs6BLoopvW46Ak2d9HINLKT2oQhsPM4ZczWxUKSZ0iFOx59XeeYOZFw8CC3D55doVfGkter9riMZ",
+	"mih7rrxxu3KTXogEHMnTdBtkHSJLSbEv1+gFMTpwYg9sKQiW/Z60ffZaKVsJ+wn1apxxv3QBbjbotJeA",
+	"MnFhx90+5ynTkDiTeZgfLIWxPqxSekNvccS0+8RosFNe5wwNeaDWVDTFv3St9HKfZ9meX+yRS7nVIy37",
+	"CWz3GeP++8EroFmSHFUFwhpTIXiszbEyu65puSvD54Cqfp//d665jFbTsFFIUYRtzsLfbdgGOuGag+tz",
+	"GxClXMitwlXZeCNV2GRb77hk3Qquqdzb8K0cyhdmA9P0VFVCd0DYFWiW+tuliYpIEla6TU4YJ6pgAy1o",
+	"I/8T2b7vZ0p+MivyDgbkB7X/do9Q8JwhN5Rc5ae0GD+EJa8Xs3qumteKm5fMo1xrEnlcaY3L9sE9Bvs7",
+	"kt8xWKU/aIJK2eEgybEpqZyEcbtkSTU3oG8g/hlkb8JBJKLLorw1OyQ++IEm5+7NJtzYBmlQQ29Bhxlt",
+	"JupIqD0lX3GzCvwm5VIswNigKPm5FsLutll6/rMlCqA8yC6K/aPUKkkQwo8zJBI8OVOJiDoYZ19NxnOr",
+	"Um6FI7OcCj1hL9ucBzHb59nUSy7Ff9wZlXdqmbBjasav/D5qH5w96H3BvkG6NfNNteuqek9NAU+nvG+e",
+	"wiCi3EsURv3+a9Hv+0DgLZLp4RhC1RvSfgdT4CzzvMRzjxk7DifnOAMYJhZMWJYCYIMkKVi7Fha04KUC",
+	"QBk/bYZuNHcNTmkGxaAnGshWzRNTmibBTpkR0rNdRQpE0TH2qcEbG3yyGn+VAfVbhChuLZhO60HnwD2B",
+	"gipNlXRhgGF1ZXMWYacLfxWqkuHDL2PFb4DNAaQHfZws2vx3c8DtkJypTHpaphqp706ah+Or5y658jL8",
+	"92h1UHq9NwedCHk16dNd4jyy73MR92ycr8Hevz99Y5pZO60KUDVl3JQHWU+T8swwd/WTsH+3PTOgBU/+",
+	"TrlCtszQVfV5RT7BVCtY0eFHWjC6JFOZ0zPDLs/eosNCOFla45GSD6OAZixNEpBLaALijR/KGXocFS6Q",
+	"1IokYfyWi4IZ+JF8X1gJkG2Ul2qYknTptEhqVaWic6US4LJbfdooVAymZg3ThbulMoSc0Y6t1G2bPAy8",
+	"ObHwNye4DSnp0brgrx5oMmsWiXAvS8Sstn5WJlQPVNI5nSpplI3qrk/nGilpvIyRVlL5oqezP8/bw6R8",
+	"bs+1XLcnOW7HKXFUGK7Mzb+JSpnOiOU08Ln7yEmOSRLpEkp3erEui+RWQnpAKlYhoFNNqiHEjAWpzjl2",
+	"OUvULWgWRqzXdsmvpiyrtcEBDQJZ0WjujFcOhqhNmZG/CdIVilQ3PR40dedXL7enO3L73SWJuC09z7uu",
+	"wpdlbKk5pYNxsEIBCXPjUtr6L7WHEOpYWBT1pjNzxW7ROFyZUb3J2jxUXU0w/yBhkrmaMM8wTT4PnZVV",
+	"UZo0KoGryYxdTf58NSlEHhRzatPup/SpkKeu8LAjuAw3o/eS09y43XNZ2Gx1wZi0HInQEbuaLMG6RaGy",
+	"435FGrgF99tJ1O53hvN3P2NIwEL1d6QSJCtCSfyqtF8z7iKulyZ077W27NXhYMMmdIHYueoCLvzqdBjE",
+	"iTiAUQa6koC8zLoQttAqlptweX+pVZ71GD20ypcrhqP8IChL6vgsxddu+0BgeFA7RwW6ugHcFwbySTyo",
+	"AuBkK8Z6A8F6WlzPSZCVaK1uoflaA/XgCGPJXbjj0oXIMaLCiAoBOB8LI7qNfY0Kdfve41DrLRa95qCD",
+	"bHiVRqPZ7qsx2zWxZhN0d2qzJqe+nLs9iDSeBdDty6CtbJCmt9mQZHmHs070PccIUDb3vMmqEhycQO/i",
+	"nb2VSWmvHrskI17bC/6kluVJWPi+sDexY7q3CnExmIQb0IEdItpPmZNycZymANsJDVolcA6L9sKbSj1t",
+	"athjr5P6afTceXJn091zizsXQwhTEU7vQ0Au8lIvHi56+22ozHsbxPphNgKtq1PGGxvGaemM7mPS0jes",
+	"vA6ogQjutg1IJHHmclAqEZzbNKh/1QI3VaWZJtwyRixlVX3wAOEWatche6ne7vL1BEP2ZXzoWVLnUrCv",
+	"Ugr0rQKVqIbUvjd0S+ZnXNXAaNruefiOugt9934R/Qy8m3M/LcvejVePTPqrYtL93LkjPq6qkVX4hcPJ",
+	"NkyjgarPkoVFrg+y6ZUdVz1ugixPpLoJVPHW1K50xNnBkF0x3u3GPGgJXZvXFwHlvju80GBz7XNFEvvH",
+	"0BRvrYmVfGZDDefoc50/IHGIVNx1ZSpfLp39+a+Xl2dhCli3TBfsQrSm7AA3HtHJgB1kTh2JxQMTi494",
+	"/6OMs3P7uPEFEA3cdEdJphxNI9A7VJG1svYwrvcVXtEjTrmGq4mfz4yd+gk5EBCGQZpZ7AM0/SlVLT8n",
+	"DxfVkTSc0zRZlHDtpS7pwNgvlsB4niN+gSHIrdwY6ly42YzIredTKpZoevPDeLN6ZaWPDjYmg2iPy3iv",
+	"TD+65QmhDh7hF+7JRAEB040pN5tcaXwJ+8FewqaNbT+EHT4Pfwq7P5b13lmnii5+cK/+NBNPDclO0dvn",
+	"Lu/A/taRltn1EoLyDYsp2bALzVcSGEd0KcJao3bUsDDs+AzvXYSg4U2b+EPnm3f4lRUxrsxYndP5Vy5t",
+	"lpm0aLQqULhrveQ/xeMJrwvzcFcxYrgNDGGly8xh7KXm0j17dSn6tEGsVz6cU87VFm3DbQTcNE9PcSaS",
+	"xJPhr+j0Mi26p8AKPuLrhcTK7sUed3R8rnLrZ1xMr5N8D43FnhXvw1WiskkSq+8GPbgKls25AbzONfgV",
+	"n34G+pzuGbxgrkaZ3yeM+cwMWumwF1F64bbnjZSCC3SA0T15QnPILay12IdpuLd7SdE/P/HE4HMQ8lqq",
+	"29q7kVhOz0MmlMnC1xh6d7Y+O99X42vouvG5GGnTqrekLQnVmKgKmpXVHfuIksl0cnn2tgiTmlYL3oAU",
+	"9ScyG1VJMBEulUztj0Dkzrg2VPViLSP68StPROwsHonK7ak8848C4hajdOy2FPXCUPWtv+T9Dp9cdG9P",
+	"Nx9dD6964s2qN1osbHt+xQw6UmkMvhLdCn+q7FarrL5ZJ2VA44VY4ozbXfTWKU6it0b5imlfjfp0yut/",
+	"nQeHu9Vb0DrdamGxz5T/Npwh/dF15u4sKyfvPlTP330ZDAXuexMW/KnX1uS/FTP2LbvhowMZ2zNoY2SR",
+	"bNIxm/DkdO1td+RJIbioxXjdPcpdCXJ7apfUT7cU5IYYQnU39tsmwU6wKF6XNThia+kp2JWK2w+bF8ZW",
+	"6eKCiRBRCO05GLADUXbTjCs9b6pWH7VjVwqrWaeJFEsqNq4gIvgAx7W0K7AiqsRdFYGl08p14kQY/wDv",
+	"DddC5abQvL1Bhx0XXZDugR2Ur/yoBfuj9EtPWZjYXedtuJ6XT9/ytQ8gD7mgyRmBf3OWiFQUgYvlW4Lh",
+	"OjrafyD2V9SLRy4K2YjkK81W3LBUaXDXQCtK+WV45YCRDYD/nkOhZc1pHuRNEMbkEITJwsRnVdNgw60b",
+	"MXaCeSJcLQ1WC7iB8k1XHwdVzKTc7hO3TS6ew4eLgrSuL5yWt/lkyhGcsGV+pfVrwLjuaMXl0gXw0xbY",
+	"FZeMswXcslTIHLeLzjTj7jHQarBeUIEpjUmx2y6Jj0u4R+sMR+u38hajkufB7RLxJOyUK/bWFRcaqcFk",
+	"ShqYslwmYAxbq9zNR0MEothKq65B+vvGkoHWuBwn1/WYhFJ3C9+lZMml3fY6pcnnBg9WWg9cfp608c5f",
+	"67LSWI8+Iag8HHRYijcSQfjqgCVcu469v1b5V6DLxy8pZrwJ58U6wqQMy50gV7zS4boJm57AAi0DhDzo",
+	"dvK3GOIcd8bHzvtAo/pE6RxdSDh77u9/hwdSRfHEQbTK5TX2pMrS8OyLDYoaVXpRrkeD3zoHgc01uYUI",
+	"8zErCSYWlbjHbrlkN4ezw29YrIINuDKGg3IhLUg8xtxUXoVpwg2u7M9grEhJlvgzVTOYQsBdAi4d2eyE",
+	"rLWFnQfH1UCUsq9vqwLlc091zwHd7JEd+trpVs5aEueOXG5FGRNNBoJRpBlooj5xNxNxOCFCCiRs4amY",
+	"975SXef47/ALSKmcsexjwtvLyk7x7XsFu9wgmo9XEo3labbtkV5q6V77pqXs8Ng3hTrcYyyPANR8l/GW",
+	"G+wIx8xRt6igLjX/RcVc0775HUQs9wgyO1NZnvBKwIW7SoM3VHi8F+4yDDA7fPTthrc8I9JNxewa1kGU",
+	"ab1CGji5D7R0XN6n28Q/n1MIC311BPlF1aXVgqJhcQtq3pv3mB767zqliv+IW7yhYoKB2X2n2xlXZAff",
+	"x7GuJv0vjk0nDUbeE0hEYo/fRBq2EhlSyhbPTMUgXUn0JvvXOYRCnaFHtPL2VeET7YaJBVlWGsRE9WBW",
+	"6dWximWgcaeqOgCP6WlJlwiOfqXqBn9YMB2awN20J5HRMaMn6c4UbRDlNOp/X6nnDgEWBeOp0iE73ayl",
+	"Vqls4qfRpVm1bi00dvvzdP1u8MyMruDRFTy6gkdX8EO6gnG7fHJ0elbOkas5cA36OLer8q+fAqH4739e",
+	"4lhUe3LkS8v54u4QW9fL054oQLxYXRxDK3WLZ/Sl6j1jb3nmfVi1+qWkPqN7hpPpREh67QP0OoQsHuFM",
+	"/i3icoY8E38DDBK6m7qXWr1Jxr/yDSkXyeRoYoGn/7fqxS17vKTXx7CEjBRaJewSeEqvqiR+DxBCaq1b",
+	"Itu/6l389ryr2QtPY50c7/JzASKLS5vn0pLRhX61CNdg1ILSvNZuyLh3X2+Vvk4UjzEj7JW8DC7TIMM8",
+	"vznkSbbihy9KNyx9QLBc1m8uhovXPoLWKm9ecelYExGBdB5Wv2fHGY9WwF7ODlrbdHt7O+NUPMOsfL6t",
+	"2f/l9OTHv1/8uIc5f1c2TYirC0v55Rrbf3x2WsuqHxaCTTwrnRxNXs0OZodeZiBA35/nyXVBCejTEmxP",
+	"IOYPeXL9LtStR6wXXZzGvnatsqExwzOh5LbvD2IvKuKeEigHW4GhO7EFT3SyaNVy53Gq7KFMmcBss9az",
+	"YKt65q0NnsCHx+wappwe5AqdBJLAO3SVu2lrvZXEDwrHsFpEtrTAqIWXYiAuNGmnYQntQ/Lrt4ThBvTa",
+	"rnyse9dE69cInm62tLdmGvLBkcGI4EJp3OJrYM++fzZlz77HfxFTn/3p+2ch6/XV5BrWh9/TuR1Or2H9",
+	"8k/uj5dXkxd9K6UR77dSBKWUfxBpntYsbw7yikVW7YGlre+ytL2S3m7AbgS0WnMUS2tgDh+Esa7ThlEV",
+	"ZUSyp9UfJzcVuKdcqBUzJu1QL2SIVNjaPm2/bk6vmbiZE9V4eXAQ2Ih/d7nyWOL+/3hBrBxhkwpQIx0U",
+	"lE6sqmGx+huSttcPOGwRWdAa6wceh5QbbtDDJxj0veS5XZFJInajvnqCUX9Sek4v2NOQL797giEvlWJv",
+	"uVyHLTY49DdPstoLL1W8l4VI7iwVfEmuvRp3JMdcpjpzjbsrTbyHRTos5lFVlampFrQ2wmGfkVDlJjyZ",
+	"UIoxxe1eYctkIh3s182mNpWJE57B2B9UvH4cTHUbXMroVudw1yITh485eNepxCOdeHQ6cfAUdAIDLhIR",
+	"2ZEydVKmD3uB3EyO6sU07Yakv/8HMv87R8oSsB0GmTf0fQNRowrupZGqedW/gu4yUulcknvPWJUZJizj",
+	"C5Ls8W4mieVqUdA3IVnm43baRM3NpknUNioVTRt89zqYVd69UshHZFItxCP6r0nYNkmVjykb9UPMu799",
+	"ZTTn9RMM+Xdl2U8ql/FIdDqJTqex4GewvVSjGnDklG2H8KT5ZaD3fPRa0IJaxsoWYfgZ7CNRhSXYL4Ek",
+	"bJWDRsowUoYvQxzZj7iMgJ4q7lG0qLxJXdCDgbE1RXdMSHYG7nK90uzcCyHZiq63uDAANxT6x96EfKEo",
+	"uVB2eJ5o4DElCYjAGIjZNUAWjPJ5UtzC4VE3UXLzfCS65GY+kqaRNA0hTaNu9lkTw0DykCb6ZzWKsJd+",
+	"V0ztqR4eaWVc1F31qbm2T+Y4SaoNR6fM6JQZnTKjU2YQOawQjqdzyfSErD2t6XX4JB5Twhg+i0dz4Qyf",
+	"wqMz5+FT2casPc9ts+kaM65w52GceVt4xEno7Ol58MiCRxY8suAvlwWPERFjRMQn022r7HJLLESTE/YF",
+	"KpwUT/w+RohC6P2JgxNqw45hCWNYwtdHHrqk6bokvf+H/3W3v6vNyz83fVI+bNwrYw+ydTWt713Eq8Pa",
+	"HtVo1zCD+/QhZfzPTvp+eCn7Y2TP0bgyMozRc/y/S6A8dW81bqL+NcES639G5P+3R5VzabFdEFAuivav",
+	"5k8u8jI8uXzcN91RRh490g8nln8hsvF+29bclJB3Cdutovs2cdk1+jwJ5nTg2DW6NsbyjkRoExH6/EjC",
+	"hlDaXTD5Z7AjGj8pGm8RZUZc/vpwOcu7HlJyaXx2UmR8mxGjvwA1qkjTtF2PemLiMypuI50dFbfPQXHb",
+	"F9JYnmy44XDqKjBeS6xXIzqcckRVdsJVoQzoz0zI/20hzRL/0CaVv/Hf/f1MyiR1K8PbZ9S6SANb7VyY",
+	"4j6Enz3EU58HNs+Wmsflm9SeJEMcZt9maX6BI0v7BCzN731BL7sZnMvW7m7kFecSNsCDQHEZ75OwutPK",
+	"JEZnyciARgZEPCYwlzoj2tliuCFip2Yn/Fi6Pd68HxF/9JI+gqFwAwKX5sGHwN4v5Ib8hmC8EXdH3H1K",
+	"wyC3UUfScEp8Pgh/qeYDYjBN6JMoMHs09P//cfd+ahnjB4X4PilVGfWPUf8YA4of1atCD/sNU17qzpSH",
+	"oJ4uxf6XYf/57MjjeN1iJMgjQf7ffsPDuT3KJyl6tdfybY/d9NiL8OjDY/gQRjV2JDWjGrubGrsbIlcV",
+	"2s8QlUdtdtRmR4r2deuWuxG0upb5BZO0L1/DHKnHqHp9daoXSK2SJAVpeZZpdcOTTCUiEtCvftH1+h+L",
+	"dse+3Rm2W2/LZNXTTsBWqjfmthpzW425rcbcVkgV+6jPmA5hTHb1yRhuDytdD0hWILfz0760BX0NHylB",
+	"Vu9wT5wxa/M8Rp/OmEJrJDp1sX+DpL9ZEdghJvhedMw13kDHdjKGbJ3AGEc8Gi1Gk+fHE5f+wOJ7UYGf",
+	"wT4pCfhCgpF3kXNGijBShE+q42yKuLsXUfDekSclDF9ElN7HqWGfkjyNSuBIlkfv0qh3Br1TqwTmgh5f",
+	"3OJyOlcJ/OBqbvMyVaqOfqXRrzT6lUa/0iB6WKEboytpdCV9Mu5aYYpDUl13ccY+f1Gl7iO5iKojPLFX",
+	"qDX0qAOMjqCvk2TUZPBKYVvq3iXnyzBK46rXKc1OhpquYUa/zWgOGK2096IFG3LADEPon8E+AjZ/IS6Y",
+	"LULFiM8jPj+1OrAxtcEwlPaulUdA6y/CgbKzkvLE9GTUikbSOXpGvg5FbIDfY4jDY/R0jJ6O0dMxejoG",
+	"ywSji2N0cXxSNjnUtzHIqfGI3oxP4cYYJfXRf/FV0oOWvFwRlHd1VQzyUdzH7jF6JUZVfLRi3hPDt7gj",
+	"tvshPhpjvyDPw4isI7J+UvF8q69hmJPho3H2i3ErfAp/wtM5Eka9ZPQgjKrQp1OF7qYTZ/90RDTXyeRo",
+	"ss8zsX9zOLn7reilSV/fBcJsmJLshzy5Lr7UnQueos7z5Log5pO2FbfeXzMFYbWnkIhsWx/DUor5Tnvv",
+	"n2wbpe1N8T3Srg9p3XkHpdJJcP7c/Xb3/wYAnaNChE5VAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
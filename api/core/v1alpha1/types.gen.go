@@ -12,6 +12,30 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for BulkOperationActionType.
+const (
+	BulkOperationActionDecommission BulkOperationActionType = "Decommission"
+	BulkOperationActionPatchLabels  BulkOperationActionType = "PatchLabels"
+	BulkOperationActionRerender     BulkOperationActionType = "Rerender"
+	BulkOperationActionResume       BulkOperationActionType = "Resume"
+)
+
+// Defines values for BulkOperationDeviceResultType.
+const (
+	BulkOperationDeviceFailed    BulkOperationDeviceResultType = "Failed"
+	BulkOperationDeviceSkipped   BulkOperationDeviceResultType = "Skipped"
+	BulkOperationDeviceSucceeded BulkOperationDeviceResultType = "Succeeded"
+)
+
+// Defines values for BulkOperationPhase.
+const (
+	BulkOperationPhaseCanceled  BulkOperationPhase = "Canceled"
+	BulkOperationPhaseCompleted BulkOperationPhase = "Completed"
+	BulkOperationPhaseFailed    BulkOperationPhase = "Failed"
+	BulkOperationPhasePending   BulkOperationPhase = "Pending"
+	BulkOperationPhaseRunning   BulkOperationPhase = "Running"
+)
+
 // Defines values for CatalogItemArtifactType.
 const (
 	CatalogItemArtifactTypeAmi         CatalogItemArtifactType = "ami"
@@ -49,9 +73,132 @@ const (
 	CatalogItemVisibilityPublished CatalogItemVisibility = "published"
 )
 
+// Defines values for RoleBindingSubjectKind.
+const (
+	RoleBindingSubjectKindGroup RoleBindingSubjectKind = "Group"
+	RoleBindingSubjectKindUser  RoleBindingSubjectKind = "User"
+)
+
 // ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
 type ApiVersion = string
 
+// BulkOperation BulkOperation applies an action to all devices matching its selectors. The operation is processed asynchronously by the workers, in batches of devices.
+type BulkOperation struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata externalRef0.ObjectMeta `json:"metadata"`
+
+	// Spec BulkOperationSpec describes the devices a bulk operation targets and the action applied to them. At least one selector must be provided.
+	Spec BulkOperationSpec `json:"spec"`
+
+	// Status BulkOperationStatus represents the progress of a bulk operation.
+	Status *BulkOperationStatus `json:"status,omitempty"`
+}
+
+// BulkOperationAction BulkOperationAction is the action a bulk operation applies to each of its devices.
+type BulkOperationAction struct {
+	// Decommission Metadata about a device decommissioning request.
+	Decommission *externalRef0.DeviceDecommission `json:"decommission,omitempty"`
+
+	// Labels Labels to add to the devices, or to overwrite if already set. Only used by the PatchLabels action.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// RemoveLabels Keys of the labels to remove from the devices. Only used by the PatchLabels action.
+	RemoveLabels *[]string `json:"removeLabels,omitempty"`
+
+	// Type The action of a bulk operation. PatchLabels adds, overwrites or removes device labels. Decommission decommissions the devices. Resume resumes devices paused due to conflicts. Rerender renders the configuration of the devices again.
+	Type BulkOperationActionType `json:"type"`
+}
+
+// BulkOperationActionType The action of a bulk operation. PatchLabels adds, overwrites or removes device labels. Decommission decommissions the devices. Resume resumes devices paused due to conflicts. Rerender renders the configuration of the devices again.
+type BulkOperationActionType string
+
+// BulkOperationDeviceResult BulkOperationDeviceResult is the result of the action of a bulk operation on a device.
+type BulkOperationDeviceResult struct {
+	// Device The name of the device.
+	Device string `json:"device"`
+
+	// Message Why the action failed or was skipped.
+	Message *string `json:"message,omitempty"`
+
+	// Result The result of the action of a bulk operation on a device.
+	Result BulkOperationDeviceResultType `json:"result"`
+}
+
+// BulkOperationDeviceResultType The result of the action of a bulk operation on a device.
+type BulkOperationDeviceResultType string
+
+// BulkOperationList BulkOperationList is a list of BulkOperations.
+type BulkOperationList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of BulkOperations.
+	Items []BulkOperation `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata externalRef0.ListMeta `json:"metadata"`
+}
+
+// BulkOperationPhase The phase of a bulk operation.
+type BulkOperationPhase string
+
+// BulkOperationSpec BulkOperationSpec describes the devices a bulk operation targets and the action applied to them. At least one selector must be provided.
+type BulkOperationSpec struct {
+	// Action BulkOperationAction is the action a bulk operation applies to each of its devices.
+	Action BulkOperationAction `json:"action"`
+
+	// FieldSelector A selector to restrict the devices of the operation by their fields (e.g., "metadata.owner=Fleet/edge").
+	FieldSelector *string `json:"fieldSelector,omitempty"`
+
+	// LabelSelector A selector to restrict the devices of the operation by their labels (e.g., "key1=value1,key2!=value2").
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
+
+// BulkOperationStatus BulkOperationStatus represents the progress of a bulk operation.
+type BulkOperationStatus struct {
+	// CompletedAt The time the operation completed, failed or was canceled.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// Continue The position of the next batch of devices to process. Managed by the service.
+	Continue *string `json:"continue,omitempty"`
+
+	// FailedDevices The number of devices the action failed on.
+	FailedDevices int64 `json:"failedDevices"`
+
+	// Message A human-readable description of the phase of the operation.
+	Message *string `json:"message,omitempty"`
+
+	// Phase The phase of a bulk operation.
+	Phase BulkOperationPhase `json:"phase"`
+
+	// ProcessedDevices The number of devices the action was applied to so far.
+	ProcessedDevices int64 `json:"processedDevices"`
+
+	// Results The results of the first 100 devices the action failed on or skipped, in the order the devices were processed. Succeeded devices and devices beyond the first 100 are only counted.
+	Results *[]BulkOperationDeviceResult `json:"results,omitempty"`
+
+	// SkippedDevices The number of devices the action did not apply to, for example because they were already in the requested state.
+	SkippedDevices int64 `json:"skippedDevices"`
+
+	// StartedAt The time the operation started processing devices.
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// SucceededDevices The number of devices the action succeeded on.
+	SucceededDevices int64 `json:"succeededDevices"`
+
+	// TotalDevices The number of devices that matched the selectors when the operation started.
+	TotalDevices *int64 `json:"totalDevices,omitempty"`
+}
+
 // Catalog defines model for Catalog.
 type Catalog struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -63,7 +210,7 @@ type Catalog struct {
 	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
 	Metadata externalRef0.ObjectMeta `json:"metadata"`
 
-	// Spec CatalogSpec describes the configuration of a catalog. Catalogs are containers for locally-managed CatalogItems.
+	// Spec CatalogSpec describes the configuration of a catalog. Catalogs are containers for CatalogItems that are either managed locally or synchronized from a remote source.
 	Spec CatalogSpec `json:"spec"`
 
 	// Status CatalogStatus represents the current status of a catalog source.
@@ -124,6 +271,51 @@ type CatalogItemDeprecation struct {
 	Replacement *string `json:"replacement,omitempty"`
 }
 
+// CatalogItemInstallRequest CatalogItemInstallRequest selects the version of a CatalogItem to install, the Fleet or Device to install it on, and the values of its configurable parameters.
+type CatalogItemInstallRequest struct {
+	// ApplicationName The name of the application entry. Defaults to the name of the CatalogItem.
+	ApplicationName *string `json:"applicationName,omitempty"`
+
+	// Device The name of the Device the application is added to. The Device must not be owned by a Fleet. Mutually exclusive with fleet.
+	Device *string `json:"device,omitempty"`
+
+	// Fleet The name of the Fleet to whose device template the application is added. Mutually exclusive with device.
+	Fleet *string `json:"fleet,omitempty"`
+
+	// Values Parameter values that are merged into the configuration of the version and validated against its configSchema.
+	Values *map[string]interface{} `json:"values,omitempty"`
+
+	// Version The version to install. Defaults to the highest version that is not deprecated.
+	Version *string `json:"version,omitempty"`
+}
+
+// CatalogItemInstallation CatalogItemInstallation records which version of a CatalogItem is installed as an application.
+type CatalogItemInstallation struct {
+	// ApplicationName The name of the application entry.
+	ApplicationName string `json:"applicationName"`
+
+	// Catalog The name of the Catalog of the installed CatalogItem.
+	Catalog string `json:"catalog"`
+
+	// Deprecation Deprecation information for a catalog item or version. Presence indicates deprecated status.
+	Deprecation *CatalogItemDeprecation `json:"deprecation,omitempty"`
+
+	// Device The name of the Device the CatalogItem is installed on.
+	Device *string `json:"device,omitempty"`
+
+	// Fleet The name of the Fleet the CatalogItem is installed on.
+	Fleet *string `json:"fleet,omitempty"`
+
+	// Item The name of the installed CatalogItem.
+	Item string `json:"item"`
+
+	// PreviousVersion The version that was installed before, if the application was upgraded.
+	PreviousVersion *string `json:"previousVersion,omitempty"`
+
+	// Version The installed version.
+	Version string `json:"version"`
+}
+
 // CatalogItemList CatalogItemList is a list of CatalogItems.
 type CatalogItemList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -287,7 +479,22 @@ type CatalogList struct {
 	Metadata externalRef0.ListMeta `json:"metadata"`
 }
 
-// CatalogSpec CatalogSpec describes the configuration of a catalog. Catalogs are containers for locally-managed CatalogItems.
+// CatalogSource CatalogSource describes a Repository the CatalogItems of a catalog are synchronized from. Items are imported and updated from the source, and items removed from the source are deprecated.
+type CatalogSource struct {
+	// Path The path of a file or directory containing CatalogItem definitions in a git repository. Defaults to the root of the repository.
+	Path *string `json:"path,omitempty"`
+
+	// Reference The OCI artifact containing CatalogItem definitions, relative to the registry of an OCI repository and including a tag or digest. Required for OCI repositories.
+	Reference *string `json:"reference,omitempty"`
+
+	// Repository The name of the git or OCI Repository resource to synchronize the catalog from.
+	Repository string `json:"repository"`
+
+	// TargetRevision The branch, tag or commit to synchronize from a git repository. Defaults to the default branch of the repository.
+	TargetRevision *string `json:"targetRevision,omitempty"`
+}
+
+// CatalogSpec CatalogSpec describes the configuration of a catalog. Catalogs are containers for CatalogItems that are either managed locally or synchronized from a remote source.
 type CatalogSpec struct {
 	// DisplayName Human-readable display name shown in catalog listings.
 	DisplayName *string `json:"displayName,omitempty"`
@@ -301,6 +508,9 @@ type CatalogSpec struct {
 	// ShortDescription A brief one-line description of the catalog.
 	ShortDescription *string `json:"shortDescription,omitempty"`
 
+	// Source CatalogSource describes a Repository the CatalogItems of a catalog are synchronized from. Items are imported and updated from the source, and items removed from the source are deprecated.
+	Source *CatalogSource `json:"source,omitempty"`
+
 	// Support Link to support resources or documentation for getting help.
 	Support *string `json:"support,omitempty"`
 
@@ -312,6 +522,174 @@ type CatalogSpec struct {
 type CatalogStatus struct {
 	// Conditions Current state of the catalog source.
 	Conditions []externalRef0.Condition `json:"conditions"`
+
+	// ObservedGeneration The generation of the catalog spec the items were last synchronized for.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// ObservedRevision The git commit hash or OCI manifest digest of the source the items were last synchronized from.
+	ObservedRevision *string `json:"observedRevision,omitempty"`
+}
+
+// EnrollmentApprovalPolicy EnrollmentApprovalPolicy automatically approves the EnrollmentRequests of an organization that match it.
+type EnrollmentApprovalPolicy struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata externalRef0.ObjectMeta `json:"metadata"`
+
+	// Spec EnrollmentApprovalPolicySpec describes which EnrollmentRequests a policy approves and how.
+	Spec EnrollmentApprovalPolicySpec `json:"spec"`
+}
+
+// EnrollmentApprovalPolicyList EnrollmentApprovalPolicyList is a list of EnrollmentApprovalPolicies.
+type EnrollmentApprovalPolicyList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of EnrollmentApprovalPolicies.
+	Items []EnrollmentApprovalPolicy `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata externalRef0.ListMeta `json:"metadata"`
+}
+
+// EnrollmentApprovalPolicyMatch EnrollmentApprovalPolicyMatch describes the EnrollmentRequests a policy approves. A request matches if it meets all of the criteria that are set. tpmVerified or enrollmentCredentials must be set, since the other criteria are reported by the device and not attested.
+type EnrollmentApprovalPolicyMatch struct {
+	// EnrollmentCredentials The common names of the enrollment certificates the requests must have been submitted with.
+	EnrollmentCredentials *[]string `json:"enrollmentCredentials,omitempty"`
+
+	// LabelSelector A selector the labels requested by the device must match, for example "source=factory-berlin".
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// ProductUuids The product UUIDs of the devices to approve, as reported in the request's system info.
+	ProductUuids *[]string `json:"productUuids,omitempty"`
+
+	// SerialNumbers The product serial numbers of the devices to approve, as reported in the request's system info.
+	SerialNumbers *[]string `json:"serialNumbers,omitempty"`
+
+	// TpmVerified If true, the device's TPM chain of trust and credential challenge must have been verified. Requests that are still awaiting the TPM challenge are evaluated again once it completes.
+	TpmVerified *bool `json:"tpmVerified,omitempty"`
+}
+
+// EnrollmentApprovalPolicySpec EnrollmentApprovalPolicySpec describes which EnrollmentRequests a policy approves and how.
+type EnrollmentApprovalPolicySpec struct {
+	// Fleet The name of the fleet that should own the approved devices. The labels of the fleet's selector are set on the devices, so the fleet's selector must consist of matchLabels only.
+	Fleet *string `json:"fleet,omitempty"`
+
+	// Labels Labels to set on the devices approved by the policy, in addition to the labels requested by the devices.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Match EnrollmentApprovalPolicyMatch describes the EnrollmentRequests a policy approves. A request matches if it meets all of the criteria that are set. tpmVerified or enrollmentCredentials must be set, since the other criteria are reported by the device and not attested.
+	Match EnrollmentApprovalPolicyMatch `json:"match"`
+
+	// Priority The order in which the policies of an organization are evaluated. Policies with a lower priority are evaluated first, policies with the same priority by name. The first matching policy approves the request. Defaults to 0.
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// PolicyRule PolicyRule grants a set of verbs on a set of resources.
+type PolicyRule struct {
+	// Resources The resources the rule applies to, for example "devices", "fleets" or the subresource "devices/console". "*" matches all resources.
+	Resources []string `json:"resources"`
+
+	// Verbs The verbs granted on the resources. One of: "get", "list", "create", "update", "patch", "delete", "deletecollection", or "*" for all verbs.
+	Verbs []string `json:"verbs"`
+}
+
+// Role Role is a named set of permissions that can be granted to users and groups of an organization through RoleBindings.
+type Role struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata externalRef0.ObjectMeta `json:"metadata"`
+
+	// Spec RoleSpec describes the permissions granted by a Role.
+	Spec RoleSpec `json:"spec"`
+}
+
+// RoleBinding RoleBinding grants the permissions of a Role to users and groups of an organization, optionally narrowed to the devices and fleets matching a label selector.
+type RoleBinding struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata externalRef0.ObjectMeta `json:"metadata"`
+
+	// Spec RoleBindingSpec describes which subjects are granted a Role and on which resources.
+	Spec RoleBindingSpec `json:"spec"`
+}
+
+// RoleBindingList RoleBindingList is a list of RoleBindings.
+type RoleBindingList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of RoleBindings.
+	Items []RoleBinding `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata externalRef0.ListMeta `json:"metadata"`
+}
+
+// RoleBindingSpec RoleBindingSpec describes which subjects are granted a Role and on which resources.
+type RoleBindingSpec struct {
+	// LabelSelector An optional label selector narrowing the binding to requests on a single device or fleet whose labels match it, for example "site=berlin". A scoped binding never grants list, create or deletecollection.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// RoleRef The name of the Role granted by the binding.
+	RoleRef string `json:"roleRef"`
+
+	// Subjects The users and groups the Role is granted to.
+	Subjects []RoleBindingSubject `json:"subjects"`
+}
+
+// RoleBindingSubject RoleBindingSubject identifies a user or a group the Role is granted to.
+type RoleBindingSubject struct {
+	// Kind The kind of a RoleBinding subject.
+	Kind RoleBindingSubjectKind `json:"kind"`
+
+	// Name The name of the user, or the name of the group or role assigned to users by the identity provider.
+	Name string `json:"name"`
+}
+
+// RoleBindingSubjectKind The kind of a RoleBinding subject.
+type RoleBindingSubjectKind string
+
+// RoleList RoleList is a list of Roles.
+type RoleList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of Roles.
+	Items []Role `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata externalRef0.ListMeta `json:"metadata"`
+}
+
+// RoleSpec RoleSpec describes the permissions granted by a Role.
+type RoleSpec struct {
+	// Rules The rules granting permissions. A request is allowed if any rule matches it.
+	Rules []PolicyRule `json:"rules"`
 }
 
 // Status Status is a return value for calls that don't return other objects.
@@ -335,6 +713,21 @@ type Status struct {
 	Status string `json:"status"`
 }
 
+// ListBulkOperationsParams defines parameters for ListBulkOperations.
+type ListBulkOperationsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the parameter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListAllCatalogItemsParams defines parameters for ListAllCatalogItems.
 type ListAllCatalogItemsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the parameter must match the value of the 'continue' field in the previous list response.
@@ -377,6 +770,54 @@ type ListCatalogItemsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListEnrollmentApprovalPoliciesParams defines parameters for ListEnrollmentApprovalPolicies.
+type ListEnrollmentApprovalPoliciesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListRoleBindingsParams defines parameters for ListRoleBindings.
+type ListRoleBindingsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListRolesParams defines parameters for ListRoles.
+type ListRolesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateBulkOperationJSONRequestBody defines body for CreateBulkOperation for application/json ContentType.
+type CreateBulkOperationJSONRequestBody = BulkOperation
+
 // CreateCatalogJSONRequestBody defines body for CreateCatalog for application/json ContentType.
 type CreateCatalogJSONRequestBody = Catalog
 
@@ -386,6 +827,9 @@ type CreateCatalogItemJSONRequestBody = CatalogItem
 // ReplaceCatalogItemJSONRequestBody defines body for ReplaceCatalogItem for application/json ContentType.
 type ReplaceCatalogItemJSONRequestBody = CatalogItem
 
+// InstallCatalogItemJSONRequestBody defines body for InstallCatalogItem for application/json ContentType.
+type InstallCatalogItemJSONRequestBody = CatalogItemInstallRequest
+
 // PatchCatalogApplicationJSONPatchPlusJSONRequestBody defines body for PatchCatalog for application/json-patch+json ContentType.
 type PatchCatalogApplicationJSONPatchPlusJSONRequestBody = externalRef0.PatchRequest
 
@@ -398,6 +842,24 @@ type PatchCatalogStatusApplicationJSONPatchPlusJSONRequestBody = externalRef0.Pa
 // ReplaceCatalogStatusJSONRequestBody defines body for ReplaceCatalogStatus for application/json ContentType.
 type ReplaceCatalogStatusJSONRequestBody = Catalog
 
+// CreateEnrollmentApprovalPolicyJSONRequestBody defines body for CreateEnrollmentApprovalPolicy for application/json ContentType.
+type CreateEnrollmentApprovalPolicyJSONRequestBody = EnrollmentApprovalPolicy
+
+// ReplaceEnrollmentApprovalPolicyJSONRequestBody defines body for ReplaceEnrollmentApprovalPolicy for application/json ContentType.
+type ReplaceEnrollmentApprovalPolicyJSONRequestBody = EnrollmentApprovalPolicy
+
+// CreateRoleBindingJSONRequestBody defines body for CreateRoleBinding for application/json ContentType.
+type CreateRoleBindingJSONRequestBody = RoleBinding
+
+// ReplaceRoleBindingJSONRequestBody defines body for ReplaceRoleBinding for application/json ContentType.
+type ReplaceRoleBindingJSONRequestBody = RoleBinding
+
+// CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
+type CreateRoleJSONRequestBody = Role
+
+// ReplaceRoleJSONRequestBody defines body for ReplaceRole for application/json ContentType.
+type ReplaceRoleJSONRequestBody = Role
+
 // AsCatalogItemVersion0 returns the union data inside the CatalogItemVersion as a CatalogItemVersion0
 func (t CatalogItemVersion) AsCatalogItemVersion0() (CatalogItemVersion0, error) {
 	var body CatalogItemVersion0
@@ -9,10 +9,192 @@ import (
 	"strconv"
 	"strings"
 
+	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/flightctl/flightctl/pkg/k8s/selector/labels"
+	"github.com/samber/lo"
 	"github.com/santhosh-tekuri/jsonschema/v5"
 )
 
+// BulkOperation validation
+
+func (b BulkOperation) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(b.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(b.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(b.Metadata.Annotations)...)
+
+	if isBlank(b.Spec.LabelSelector) && isBlank(b.Spec.FieldSelector) {
+		allErrs = append(allErrs, errors.New("at least one of spec.labelSelector or spec.fieldSelector must be provided"))
+	}
+	allErrs = append(allErrs, b.Spec.Action.Validate()...)
+	return allErrs
+}
+
+// Validate ensures the action is known and carries the parameters it needs.
+func (a BulkOperationAction) Validate() []error {
+	allErrs := []error{}
+	switch a.Type {
+	case BulkOperationActionPatchLabels:
+		if len(lo.FromPtr(a.Labels)) == 0 && len(lo.FromPtr(a.RemoveLabels)) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("spec.action: %s requires labels or removeLabels", a.Type))
+		}
+		allErrs = append(allErrs, validation.ValidateLabelsWithPath(a.Labels, "spec.action.labels")...)
+		for i, key := range lo.FromPtr(a.RemoveLabels) {
+			allErrs = append(allErrs, validation.ValidateLabelsWithPath(&map[string]string{key: ""}, fmt.Sprintf("spec.action.removeLabels[%d]", i))...)
+			if _, ok := lo.FromPtr(a.Labels)[key]; ok {
+				allErrs = append(allErrs, fmt.Errorf("spec.action: label %q cannot be both set and removed", key))
+			}
+		}
+	case BulkOperationActionDecommission:
+		if a.Decommission == nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.action: %s requires decommission", a.Type))
+		} else if a.Decommission.Target != v1beta1.DeviceDecommissionTargetTypeUnenroll && a.Decommission.Target != v1beta1.DeviceDecommissionTargetTypeFactoryReset {
+			allErrs = append(allErrs, fmt.Errorf("spec.action.decommission.target must be %q or %q", v1beta1.DeviceDecommissionTargetTypeUnenroll, v1beta1.DeviceDecommissionTargetTypeFactoryReset))
+		}
+	case BulkOperationActionResume, BulkOperationActionRerender:
+	default:
+		return append(allErrs, fmt.Errorf("spec.action.type must be one of: %s, %s, %s, %s",
+			BulkOperationActionPatchLabels, BulkOperationActionDecommission, BulkOperationActionResume, BulkOperationActionRerender))
+	}
+
+	if a.Type != BulkOperationActionPatchLabels && (a.Labels != nil || a.RemoveLabels != nil) {
+		allErrs = append(allErrs, fmt.Errorf("spec.action: labels and removeLabels are only allowed for %s", BulkOperationActionPatchLabels))
+	}
+	if a.Type != BulkOperationActionDecommission && a.Decommission != nil {
+		allErrs = append(allErrs, fmt.Errorf("spec.action: decommission is only allowed for %s", BulkOperationActionDecommission))
+	}
+	return allErrs
+}
+
+func isBlank(s *string) bool {
+	return s == nil || strings.TrimSpace(*s) == ""
+}
+
+// Role validation
+
+// PolicyRuleVerbs are the verbs a PolicyRule may grant, in addition to the "*" wildcard.
+var PolicyRuleVerbs = []string{"get", "list", "create", "update", "patch", "delete", "deletecollection"}
+
+// builtInRoleNames are the names of the roles built into flightctl, which custom Roles cannot shadow.
+var builtInRoleNames = append([]string{v1beta1.RoleAdmin, v1beta1.RoleOrgAdmin, v1beta1.RoleOperator, v1beta1.RoleViewer, v1beta1.RoleInstaller}, v1beta1.KnownExternalRoles...)
+
+func (r Role) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+
+	if r.Metadata.Name != nil && lo.Contains(builtInRoleNames, *r.Metadata.Name) {
+		allErrs = append(allErrs, fmt.Errorf("metadata.name: %q is reserved for a built-in role", *r.Metadata.Name))
+	}
+	if len(r.Spec.Rules) == 0 {
+		allErrs = append(allErrs, errors.New("spec.rules must contain at least one rule"))
+	}
+	for i, rule := range r.Spec.Rules {
+		allErrs = append(allErrs, rule.validate(fmt.Sprintf("spec.rules[%d]", i))...)
+	}
+	return allErrs
+}
+
+func (p PolicyRule) validate(path string) []error {
+	allErrs := []error{}
+	if len(p.Resources) == 0 {
+		allErrs = append(allErrs, fmt.Errorf("%s.resources must contain at least one resource", path))
+	}
+	for i, resource := range p.Resources {
+		if strings.TrimSpace(resource) == "" || strings.ToLower(resource) != resource {
+			allErrs = append(allErrs, fmt.Errorf("%s.resources[%d]: %q must be a non-empty lowercase resource name", path, i, resource))
+		}
+	}
+	if len(p.Verbs) == 0 {
+		allErrs = append(allErrs, fmt.Errorf("%s.verbs must contain at least one verb", path))
+	}
+	for i, verb := range p.Verbs {
+		if verb != "*" && !lo.Contains(PolicyRuleVerbs, verb) {
+			allErrs = append(allErrs, fmt.Errorf("%s.verbs[%d]: %q must be \"*\" or one of: %s", path, i, verb, strings.Join(PolicyRuleVerbs, ", ")))
+		}
+	}
+	return allErrs
+}
+
+// RoleBinding validation
+
+func (r RoleBinding) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&r.Spec.RoleRef, "spec.roleRef")...)
+	if r.Spec.RoleRef == v1beta1.RoleAdmin || r.Spec.RoleRef == v1beta1.ExternalRoleAdmin {
+		allErrs = append(allErrs, fmt.Errorf("spec.roleRef: %q cannot be bound by a role binding", r.Spec.RoleRef))
+	}
+
+	if len(r.Spec.Subjects) == 0 {
+		allErrs = append(allErrs, errors.New("spec.subjects must contain at least one subject"))
+	}
+	for i, subject := range r.Spec.Subjects {
+		if subject.Kind != RoleBindingSubjectKindUser && subject.Kind != RoleBindingSubjectKindGroup {
+			allErrs = append(allErrs, fmt.Errorf("spec.subjects[%d].kind must be %q or %q", i, RoleBindingSubjectKindUser, RoleBindingSubjectKindGroup))
+		}
+		if strings.TrimSpace(subject.Name) == "" {
+			allErrs = append(allErrs, fmt.Errorf("spec.subjects[%d].name must not be empty", i))
+		}
+	}
+	if r.Spec.LabelSelector != nil {
+		if isBlank(r.Spec.LabelSelector) {
+			allErrs = append(allErrs, errors.New("spec.labelSelector must not be empty if set"))
+		} else if _, err := labels.Parse(*r.Spec.LabelSelector); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.labelSelector: %w", err))
+		}
+	}
+	return allErrs
+}
+
+// EnrollmentApprovalPolicy validation
+
+func (e EnrollmentApprovalPolicy) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(e.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(e.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(e.Metadata.Annotations)...)
+
+	match := e.Spec.Match
+	if !lo.FromPtr(match.TpmVerified) && len(lo.FromPtr(match.SerialNumbers)) == 0 && len(lo.FromPtr(match.ProductUuids)) == 0 &&
+		len(lo.FromPtr(match.EnrollmentCredentials)) == 0 && match.LabelSelector == nil {
+		allErrs = append(allErrs, errors.New("spec.match must set at least one criterion"))
+	} else if !lo.FromPtr(match.TpmVerified) && len(lo.FromPtr(match.EnrollmentCredentials)) == 0 {
+		// serial numbers, product UUIDs and labels are reported by the agent and could be claimed by any holder of an enrollment certificate
+		allErrs = append(allErrs, errors.New("spec.match must set tpmVerified or enrollmentCredentials, serialNumbers, productUuids and labelSelector are not attested"))
+	}
+	allErrs = append(allErrs, validateNonEmptyValues(match.SerialNumbers, "spec.match.serialNumbers")...)
+	allErrs = append(allErrs, validateNonEmptyValues(match.ProductUuids, "spec.match.productUuids")...)
+	allErrs = append(allErrs, validateNonEmptyValues(match.EnrollmentCredentials, "spec.match.enrollmentCredentials")...)
+	if match.LabelSelector != nil {
+		if isBlank(match.LabelSelector) {
+			allErrs = append(allErrs, errors.New("spec.match.labelSelector must not be empty if set"))
+		} else if _, err := labels.Parse(*match.LabelSelector); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.match.labelSelector: %w", err))
+		}
+	}
+
+	allErrs = append(allErrs, validation.ValidateLabelsWithPath(e.Spec.Labels, "spec.labels")...)
+	if e.Spec.Fleet != nil {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(e.Spec.Fleet, "spec.fleet")...)
+	}
+	return allErrs
+}
+
+func validateNonEmptyValues(values *[]string, path string) []error {
+	allErrs := []error{}
+	for i, value := range lo.FromPtr(values) {
+		if strings.TrimSpace(value) == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s[%d] must not be empty", path, i))
+		}
+	}
+	return allErrs
+}
+
 // Catalog validation
 
 func (c Catalog) Validate() []error {
@@ -20,6 +202,12 @@ func (c Catalog) Validate() []error {
 	allErrs = append(allErrs, validation.ValidateResourceName(c.Metadata.Name)...)
 	allErrs = append(allErrs, validation.ValidateLabels(c.Metadata.Labels)...)
 	allErrs = append(allErrs, validation.ValidateAnnotations(c.Metadata.Annotations)...)
+	if c.Spec.Source != nil {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(&c.Spec.Source.Repository, "spec.source.repository")...)
+		allErrs = append(allErrs, validation.ValidateGitRevision(c.Spec.Source.TargetRevision, "spec.source.targetRevision")...)
+		allErrs = append(allErrs, validation.ValidateString(c.Spec.Source.Path, "spec.source.path", 0, 2048, nil, "")...)
+		allErrs = append(allErrs, validation.ValidateString(c.Spec.Source.Reference, "spec.source.reference", 0, 2048, nil, "")...)
+	}
 	return allErrs
 }
 
@@ -90,6 +278,59 @@ func (ci CatalogItem) Validate() []error {
 	return allErrs
 }
 
+// ValidateInstallConfig validates the configuration an installation of the CatalogItem results in
+// against the given configSchema and the configuration fields supported by the item's type.
+func (ci CatalogItem) ValidateInstallConfig(config map[string]any, configSchema *map[string]any) []error {
+	category := CatalogItemCategoryApplication
+	if ci.Spec.Category != nil && *ci.Spec.Category != "" {
+		category = *ci.Spec.Category
+	}
+	allErrs := validateCatalogItemConfig(category, ci.Spec.Type, &config, "values")
+	if configSchema == nil {
+		return allErrs
+	}
+
+	schema, err := compileConfigSchema(configSchema, "configSchema")
+	if err != nil {
+		return append(allErrs, err)
+	}
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return append(allErrs, fmt.Errorf("values: %w", err))
+	}
+	var doc any
+	if err := json.Unmarshal(configBytes, &doc); err != nil {
+		return append(allErrs, fmt.Errorf("values: %w", err))
+	}
+	if err := schema.Validate(doc); err != nil {
+		allErrs = append(allErrs, fmt.Errorf("values do not match the configSchema: %w", err))
+	}
+	return allErrs
+}
+
+// Validate ensures that exactly one install target is given and that the version is valid semver.
+func (r CatalogItemInstallRequest) Validate() []error {
+	allErrs := []error{}
+	if isBlank(r.Fleet) == isBlank(r.Device) {
+		allErrs = append(allErrs, errors.New("exactly one of fleet or device must be specified"))
+	}
+	if !isBlank(r.Fleet) {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(r.Fleet, "fleet")...)
+	}
+	if !isBlank(r.Device) {
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(r.Device, "device")...)
+	}
+	if !isBlank(r.Version) {
+		if err := validateSemver(*r.Version); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("version: %v", err))
+		}
+	}
+	if r.ApplicationName != nil {
+		allErrs = append(allErrs, validation.ValidateString(r.ApplicationName, "applicationName", 1, validation.DNS1123MaxLength, validation.GenericNameRegexp, "")...)
+	}
+	return allErrs
+}
+
 func validateCatalogItemReference(ref *CatalogItemReference) []error {
 	allErrs := []error{}
 
@@ -677,9 +918,17 @@ func validateConfigSchema(schema *map[string]any, path string) []error {
 		return nil
 	}
 
+	if _, err := compileConfigSchema(schema, path); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// compileConfigSchema compiles a configSchema. External schema references are not resolved.
+func compileConfigSchema(schema *map[string]any, path string) (*jsonschema.Schema, error) {
 	schemaBytes, err := json.Marshal(schema)
 	if err != nil {
-		return []error{fmt.Errorf("%s: failed to marshal schema: %v", path, err)}
+		return nil, fmt.Errorf("%s: failed to marshal schema: %v", path, err)
 	}
 
 	compiler := jsonschema.NewCompiler()
@@ -687,14 +936,14 @@ func validateConfigSchema(schema *map[string]any, path string) []error {
 		return nil, fmt.Errorf("%s: external schema references are forbidden", path)
 	}
 	if err := compiler.AddResource("configSchema.json", strings.NewReader(string(schemaBytes))); err != nil {
-		return []error{fmt.Errorf("%s: invalid JSON Schema: %v", path, err)}
+		return nil, fmt.Errorf("%s: invalid JSON Schema: %v", path, err)
 	}
 
-	if _, err := compiler.Compile("configSchema.json"); err != nil {
-		return []error{fmt.Errorf("%s: invalid JSON Schema: %v", path, err)}
+	compiled, err := compiler.Compile("configSchema.json")
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid JSON Schema: %v", path, err)
 	}
-
-	return nil
+	return compiled, nil
 }
 
 // validateEnvVars validates environment variable names and values.
@@ -1,13 +1,398 @@
 package v1alpha1
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
+	v1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
 
+func TestBulkOperationValidate(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		name          string
+		labelSelector *string
+		fieldSelector *string
+		action        BulkOperationAction
+		errContains   string
+	}{
+		{
+			name:          "valid label patch",
+			labelSelector: lo.ToPtr("env=prod"),
+			action:        BulkOperationAction{Type: BulkOperationActionPatchLabels, Labels: &map[string]string{"site": "a"}, RemoveLabels: &[]string{"old"}},
+		},
+		{
+			name:          "valid decommission",
+			fieldSelector: lo.ToPtr("metadata.owner=Fleet/edge"),
+			action:        BulkOperationAction{Type: BulkOperationActionDecommission, Decommission: &v1beta1.DeviceDecommission{Target: v1beta1.DeviceDecommissionTargetTypeUnenroll}},
+		},
+		{
+			name:          "valid rerender",
+			labelSelector: lo.ToPtr("env=prod"),
+			action:        BulkOperationAction{Type: BulkOperationActionRerender},
+		},
+		{
+			name:        "missing selectors",
+			action:      BulkOperationAction{Type: BulkOperationActionResume},
+			errContains: "at least one of spec.labelSelector or spec.fieldSelector",
+		},
+		{
+			name:          "unknown action",
+			labelSelector: lo.ToPtr("env=prod"),
+			action:        BulkOperationAction{Type: "Reboot"},
+			errContains:   "spec.action.type must be one of",
+		},
+		{
+			name:          "label patch without labels",
+			labelSelector: lo.ToPtr("env=prod"),
+			action:        BulkOperationAction{Type: BulkOperationActionPatchLabels},
+			errContains:   "requires labels or removeLabels",
+		},
+		{
+			name:          "label set and removed",
+			labelSelector: lo.ToPtr("env=prod"),
+			action:        BulkOperationAction{Type: BulkOperationActionPatchLabels, Labels: &map[string]string{"site": "a"}, RemoveLabels: &[]string{"site"}},
+			errContains:   "cannot be both set and removed",
+		},
+		{
+			name:          "decommission without target",
+			labelSelector: lo.ToPtr("env=prod"),
+			action:        BulkOperationAction{Type: BulkOperationActionDecommission},
+			errContains:   "requires decommission",
+		},
+		{
+			name:          "labels with another action",
+			labelSelector: lo.ToPtr("env=prod"),
+			action:        BulkOperationAction{Type: BulkOperationActionResume, Labels: &map[string]string{"site": "a"}},
+			errContains:   "only allowed for PatchLabels",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := BulkOperation{
+				ApiVersion: BulkOperationAPIVersion,
+				Kind:       BulkOperationKind,
+				Metadata:   v1beta1.ObjectMeta{Name: lo.ToPtr("op")},
+				Spec: BulkOperationSpec{
+					LabelSelector: tt.labelSelector,
+					FieldSelector: tt.fieldSelector,
+					Action:        tt.action,
+				},
+			}
+
+			errs := op.Validate()
+			if tt.errContains == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errs[0].Error(), tt.errContains)
+		})
+	}
+}
+
+func TestRoleValidate(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		name        string
+		roleName    string
+		rules       []PolicyRule
+		errContains string
+	}{
+		{
+			name:     "valid role",
+			roleName: "console-user",
+			rules: []PolicyRule{
+				{Resources: []string{"devices", "devices/console"}, Verbs: []string{"get", "update"}},
+				{Resources: []string{"*"}, Verbs: []string{"*"}},
+			},
+		},
+		{
+			name:        "no rules",
+			roleName:    "empty",
+			errContains: "spec.rules must contain at least one rule",
+		},
+		{
+			name:        "built-in role name",
+			roleName:    "operator",
+			rules:       []PolicyRule{{Resources: []string{"devices"}, Verbs: []string{"get"}}},
+			errContains: "reserved for a built-in role",
+		},
+		{
+			name:        "rule without resources",
+			roleName:    "console-user",
+			rules:       []PolicyRule{{Verbs: []string{"get"}}},
+			errContains: "spec.rules[0].resources must contain at least one resource",
+		},
+		{
+			name:        "unknown verb",
+			roleName:    "console-user",
+			rules:       []PolicyRule{{Resources: []string{"devices"}, Verbs: []string{"reboot"}}},
+			errContains: "spec.rules[0].verbs[0]",
+		},
+		{
+			name:        "uppercase resource",
+			roleName:    "console-user",
+			rules:       []PolicyRule{{Resources: []string{"Devices"}, Verbs: []string{"get"}}},
+			errContains: "lowercase resource name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role := Role{
+				ApiVersion: RoleAPIVersion,
+				Kind:       RoleKind,
+				Metadata:   v1beta1.ObjectMeta{Name: lo.ToPtr(tt.roleName)},
+				Spec:       RoleSpec{Rules: tt.rules},
+			}
+
+			errs := role.Validate()
+			if tt.errContains == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errs[0].Error(), tt.errContains)
+		})
+	}
+}
+
+func TestRoleBindingValidate(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		name          string
+		roleRef       string
+		subjects      []RoleBindingSubject
+		labelSelector *string
+		errContains   string
+	}{
+		{
+			name:          "valid scoped binding",
+			roleRef:       "device-patcher",
+			subjects:      []RoleBindingSubject{{Kind: RoleBindingSubjectKindGroup, Name: "berlin-ops"}, {Kind: RoleBindingSubjectKindUser, Name: "alice"}},
+			labelSelector: lo.ToPtr("site=berlin,env in (prod,staging)"),
+		},
+		{
+			name:     "valid unscoped binding",
+			roleRef:  "device-patcher",
+			subjects: []RoleBindingSubject{{Kind: RoleBindingSubjectKindGroup, Name: "ops"}},
+		},
+		{
+			name:        "missing role reference",
+			subjects:    []RoleBindingSubject{{Kind: RoleBindingSubjectKindGroup, Name: "ops"}},
+			errContains: "spec.roleRef",
+		},
+		{
+			name:     "built-in role reference",
+			roleRef:  v1beta1.ExternalRoleOperator,
+			subjects: []RoleBindingSubject{{Kind: RoleBindingSubjectKindGroup, Name: "ops"}},
+		},
+		{
+			name:        "admin role reference",
+			roleRef:     v1beta1.ExternalRoleAdmin,
+			subjects:    []RoleBindingSubject{{Kind: RoleBindingSubjectKindGroup, Name: "ops"}},
+			errContains: "cannot be bound by a role binding",
+		},
+		{
+			name:        "no subjects",
+			roleRef:     "device-patcher",
+			errContains: "spec.subjects must contain at least one subject",
+		},
+		{
+			name:        "unknown subject kind",
+			roleRef:     "device-patcher",
+			subjects:    []RoleBindingSubject{{Kind: "ServiceAccount", Name: "ops"}},
+			errContains: "spec.subjects[0].kind",
+		},
+		{
+			name:        "empty subject name",
+			roleRef:     "device-patcher",
+			subjects:    []RoleBindingSubject{{Kind: RoleBindingSubjectKindUser}},
+			errContains: "spec.subjects[0].name must not be empty",
+		},
+		{
+			name:          "invalid label selector",
+			roleRef:       "device-patcher",
+			subjects:      []RoleBindingSubject{{Kind: RoleBindingSubjectKindGroup, Name: "ops"}},
+			labelSelector: lo.ToPtr("site in berlin"),
+			errContains:   "spec.labelSelector",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binding := RoleBinding{
+				ApiVersion: RoleBindingAPIVersion,
+				Kind:       RoleBindingKind,
+				Metadata:   v1beta1.ObjectMeta{Name: lo.ToPtr("binding")},
+				Spec: RoleBindingSpec{
+					RoleRef:       tt.roleRef,
+					Subjects:      tt.subjects,
+					LabelSelector: tt.labelSelector,
+				},
+			}
+
+			errs := binding.Validate()
+			if tt.errContains == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errs[0].Error(), tt.errContains)
+		})
+	}
+}
+
+func TestEnrollmentApprovalPolicyValidate(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		name        string
+		spec        EnrollmentApprovalPolicySpec
+		errContains string
+	}{
+		{
+			name: "valid policy",
+			spec: EnrollmentApprovalPolicySpec{
+				Match: EnrollmentApprovalPolicyMatch{
+					TpmVerified:   lo.ToPtr(true),
+					SerialNumbers: &[]string{"SN-0001", "SN-0002"},
+					LabelSelector: lo.ToPtr("source=factory-berlin"),
+				},
+				Labels: &map[string]string{"site": "berlin"},
+				Fleet:  lo.ToPtr("berlin-gateways"),
+			},
+		},
+		{
+			name:        "no criteria",
+			spec:        EnrollmentApprovalPolicySpec{Match: EnrollmentApprovalPolicyMatch{TpmVerified: lo.ToPtr(false)}},
+			errContains: "spec.match must set at least one criterion",
+		},
+		{
+			name:        "only criteria reported by the device",
+			spec:        EnrollmentApprovalPolicySpec{Match: EnrollmentApprovalPolicyMatch{SerialNumbers: &[]string{"SN-0001"}, LabelSelector: lo.ToPtr("source=factory-berlin")}},
+			errContains: "spec.match must set tpmVerified or enrollmentCredentials",
+		},
+		{
+			name:        "empty serial number",
+			spec:        EnrollmentApprovalPolicySpec{Match: EnrollmentApprovalPolicyMatch{TpmVerified: lo.ToPtr(true), SerialNumbers: &[]string{" "}}},
+			errContains: "spec.match.serialNumbers[0] must not be empty",
+		},
+		{
+			name:        "invalid label selector",
+			spec:        EnrollmentApprovalPolicySpec{Match: EnrollmentApprovalPolicyMatch{TpmVerified: lo.ToPtr(true), LabelSelector: lo.ToPtr("source in factory")}},
+			errContains: "spec.match.labelSelector",
+		},
+		{
+			name: "invalid label",
+			spec: EnrollmentApprovalPolicySpec{
+				Match:  EnrollmentApprovalPolicyMatch{EnrollmentCredentials: &[]string{"factory-berlin"}},
+				Labels: &map[string]string{"site": "berlin/north"},
+			},
+			errContains: "spec.labels",
+		},
+		{
+			name: "invalid fleet name",
+			spec: EnrollmentApprovalPolicySpec{
+				Match: EnrollmentApprovalPolicyMatch{
+					EnrollmentCredentials: &[]string{"factory-berlin"},
+					ProductUuids:          &[]string{"4c4c4544-0042-3010-8052-b4c04f4d3732"},
+				},
+				Fleet: lo.ToPtr("Berlin_Gateways"),
+			},
+			errContains: "spec.fleet",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := EnrollmentApprovalPolicy{
+				ApiVersion: EnrollmentApprovalPolicyAPIVersion,
+				Kind:       EnrollmentApprovalPolicyKind,
+				Metadata:   v1beta1.ObjectMeta{Name: lo.ToPtr("factory-berlin")},
+				Spec:       tt.spec,
+			}
+
+			errs := policy.Validate()
+			if tt.errContains == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errs[0].Error(), tt.errContains)
+		})
+	}
+}
+
+func TestCatalogValidate(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		name        string
+		source      *CatalogSource
+		errContains string
+	}{
+		{
+			name: "no source",
+		},
+		{
+			name: "git source",
+			source: &CatalogSource{
+				Repository:     "app-catalog",
+				TargetRevision: lo.ToPtr("main"),
+				Path:           lo.ToPtr("/catalog"),
+			},
+		},
+		{
+			name: "oci source",
+			source: &CatalogSource{
+				Repository: "app-registry",
+				Reference:  lo.ToPtr("myorg/app-catalog:latest"),
+			},
+		},
+		{
+			name:        "missing repository",
+			source:      &CatalogSource{},
+			errContains: "spec.source.repository",
+		},
+		{
+			name: "invalid target revision",
+			source: &CatalogSource{
+				Repository:     "app-catalog",
+				TargetRevision: lo.ToPtr("-main"),
+			},
+			errContains: "spec.source.targetRevision",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := Catalog{
+				ApiVersion: CatalogAPIVersion,
+				Kind:       CatalogKind,
+				Metadata:   v1beta1.ObjectMeta{Name: lo.ToPtr("edge-apps")},
+				Spec:       CatalogSpec{Source: tt.source},
+			}
+
+			errs := catalog.Validate()
+			if tt.errContains == "" {
+				require.Empty(errs)
+				return
+			}
+			require.NotEmpty(errs)
+			require.Contains(errs[0].Error(), tt.errContains)
+		})
+	}
+}
+
 func TestCatalogItemValidate(t *testing.T) {
 	require := require.New(t)
 
@@ -1530,3 +1915,132 @@ func TestValidateReplacesGraph(t *testing.T) {
 		})
 	}
 }
+
+func TestCatalogItemValidateInstallConfig(t *testing.T) {
+	ci := CatalogItem{
+		Spec: CatalogItemSpec{
+			Category:  lo.ToPtr(CatalogItemCategoryApplication),
+			Type:      CatalogItemTypeContainer,
+			Reference: CatalogItemReference{Uri: "quay.io/prometheus/prometheus"},
+		},
+	}
+	configSchema := &map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"envVars": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"RETENTION": map[string]any{
+						"type":    "string",
+						"pattern": "^[0-9]+[dhm]$",
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		config        map[string]any
+		configSchema  *map[string]any
+		errorContains string
+	}{
+		{
+			name:         "values match the schema",
+			config:       map[string]any{"envVars": map[string]any{"RETENTION": "30d"}},
+			configSchema: configSchema,
+		},
+		{
+			name:   "no schema",
+			config: map[string]any{"envVars": map[string]any{"RETENTION": "forever"}},
+		},
+		{
+			name:          "values do not match the schema",
+			config:        map[string]any{"envVars": map[string]any{"RETENTION": "forever"}},
+			configSchema:  configSchema,
+			errorContains: "values do not match the configSchema",
+		},
+		{
+			name:          "unknown config key",
+			config:        map[string]any{"unknown": "value"},
+			configSchema:  configSchema,
+			errorContains: "values",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ci.ValidateInstallConfig(tt.config, tt.configSchema)
+			if tt.errorContains == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.NotEmpty(t, errs)
+			found := false
+			for _, err := range errs {
+				if strings.Contains(err.Error(), tt.errorContains) {
+					found = true
+				}
+			}
+			require.True(t, found, "expected error containing %q, got %v", tt.errorContains, errs)
+		})
+	}
+}
+
+func TestCatalogItemInstallRequestValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       CatalogItemInstallRequest
+		errorContains string
+	}{
+		{
+			name:    "fleet",
+			request: CatalogItemInstallRequest{Fleet: lo.ToPtr("my-fleet")},
+		},
+		{
+			name: "device with version and application name",
+			request: CatalogItemInstallRequest{
+				Device:          lo.ToPtr("my-device"),
+				Version:         lo.ToPtr("1.2.0"),
+				ApplicationName: lo.ToPtr("my-app"),
+			},
+		},
+		{
+			name:          "no target",
+			request:       CatalogItemInstallRequest{},
+			errorContains: "exactly one of fleet or device",
+		},
+		{
+			name:          "fleet and device",
+			request:       CatalogItemInstallRequest{Fleet: lo.ToPtr("my-fleet"), Device: lo.ToPtr("my-device")},
+			errorContains: "exactly one of fleet or device",
+		},
+		{
+			name:          "invalid fleet name",
+			request:       CatalogItemInstallRequest{Fleet: lo.ToPtr("My_Fleet")},
+			errorContains: "fleet",
+		},
+		{
+			name:          "invalid version",
+			request:       CatalogItemInstallRequest{Fleet: lo.ToPtr("my-fleet"), Version: lo.ToPtr("v1.0.0")},
+			errorContains: "version",
+		},
+		{
+			name:          "invalid application name",
+			request:       CatalogItemInstallRequest{Fleet: lo.ToPtr("my-fleet"), ApplicationName: lo.ToPtr("")},
+			errorContains: "applicationName",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.request.Validate()
+			if tt.errorContains == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.NotEmpty(t, errs)
+			require.Contains(t, errors.Join(errs...).Error(), tt.errorContains)
+		})
+	}
+}
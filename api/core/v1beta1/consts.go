@@ -43,10 +43,17 @@ const (
 	DeviceDisconnectedTimeout = 5 * time.Minute
 
 	DeviceQueryConsoleSessionMetadata = "metadata"
+	DeviceQueryLogsUnit               = "unit"
+	DeviceQueryLogsApplication        = "app"
+	DeviceQueryLogsFollow             = "follow"
+	DeviceQueryLogsSince              = "since"
+	DeviceQueryLogsTail               = "tail"
 
 	EnrollmentRequestAPIVersion = "v1beta1"
 	EnrollmentRequestKind       = "EnrollmentRequest"
 	EnrollmentRequestListKind   = "EnrollmentRequestList"
+	// The common name of the enrollment certificate an enrollment request was submitted with
+	EnrollmentRequestAnnotationEnrollmentCredential = "enrollment-controller/enrollmentCredential"
 
 	FleetAPIVersion = "v1beta1"
 	FleetKind       = "Fleet"
@@ -66,6 +73,12 @@ const (
 	FleetAnnotationLastBatchCompletionReport = "fleet-controller/lastBatchCompletionReport"
 	// A frozen digest of device selection definition during rollout
 	FleetAnnotationDeviceSelectionConfigDigest = "fleet-controller/deviceSelectionConfigDigest"
+	// The template version the fleet was running before the current rollout started.  Used as the rollback target
+	FleetAnnotationPreviousTemplateVersion = "fleet-controller/previousTemplateVersion"
+	// When present, the fleet was rolled back and is pinned to this template version until a new template version is created
+	FleetAnnotationRollbackTemplateVersion = "fleet-controller/rollbackTemplateVersion"
+	// The time at which the canary cohort finished updating and started soaking.  Contains an RFC3339 timestamp
+	FleetAnnotationCanarySoakStartTime = "fleet-controller/canarySoakStartTime"
 	// The requestID related to an event
 	EventAnnotationRequestID = "event-controller/requestID"
 
@@ -138,6 +151,7 @@ const (
 	UpdateStateError          = consts.UpdateStateError
 	UpdateStateRollingBack    = consts.UpdateStateRollingBack
 	UpdateStateRetrying       = consts.UpdateStateRetrying
+	UpdateStateRolledBack     = consts.UpdateStateRolledBack
 )
 
 type DecommissionState string
@@ -160,11 +174,15 @@ const (
 	RolloutSuspendedReason = "Suspended"
 	// Rollout is pending on user approval
 	RolloutWaitingReason = "Waiting"
+	// Rollout failed and the fleet was rolled back to the previous template version
+	RolloutRolledBackReason = "RolledBack"
 
 	// The name of the preliminary batch
 	PreliminaryBatchName = "preliminary batch"
 	// The name of the final implicit batch
 	FinalImplicitBatchName = "final implicit batch"
+	// The name of the canary cohort batch
+	CanaryBatchName = "canary batch"
 
 	// System-level resource name for events
 	FlightCtlSystemResourceName = "flightctl-system"
@@ -73,6 +73,10 @@ func StatusNotImplemented(message string) Status {
 	return NewFailureStatus(http.StatusNotImplemented, http.StatusText(http.StatusNotImplemented), message)
 }
 
+func StatusServiceUnavailable(message string) Status {
+	return NewFailureStatus(http.StatusServiceUnavailable, http.StatusText(http.StatusServiceUnavailable), message)
+}
+
 func StatusAuthNotConfigured(message string) Status {
 	return NewFailureStatus(http.StatusTeapot, "Auth not configured", message)
 }
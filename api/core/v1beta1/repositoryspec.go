@@ -32,3 +32,19 @@ func (t RepositorySpec) GetRepoURL() (string, error) {
 		return "", fmt.Errorf("unknown repository type: %s", repoType)
 	}
 }
+
+// GetOciRepoSpec returns the OCI repository spec, failing if the repository is not of type oci.
+func (t RepositorySpec) GetOciRepoSpec() (*OciRepoSpec, error) {
+	repoType, err := t.Discriminator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine repository type: %w", err)
+	}
+	if repoType != string(OciRepoSpecTypeOci) {
+		return nil, fmt.Errorf("repository type is %q, expected %q", repoType, OciRepoSpecTypeOci)
+	}
+	ociSpec, err := t.AsOciRepoSpec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode OCI repository spec: %w", err)
+	}
+	return &ociSpec, nil
+}
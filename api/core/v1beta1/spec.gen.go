@@ -18,393 +18,493 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+z9jXLcNpYwDN8Ktp+tsj3batnOTJ6MqlLzKLKdaBJbWklO6tnIXwKR6G6M2GAPAUru",
-	"pFz13cN7h++VvIVzABAkAZKtP8cOd6smVhO/BwcH5//8Pkny1ToXTCg52ft9IpMlW1H45z5dHxf5FU9Z",
-	"cbpmif4pZTIp+FrxXEz2mg0Ifr1gklBB9oXkFxkj+6XKV1T3IMcZVfO8WJHH+/vHT8ja9CVJLuZ8URbQ",
-	"ajaZTtZFvmaF4gzWQdf8bZG1pz9bMsKFYoWgGdnfPyb7x4fk7ckPegS1WbPJ3kSqgovF5MN0Qku1zAv+",
-	"G8wRHe5ov1TL56TWmDCRrnMuVHTsJONMqMO0c0xsRA5fdAxxypKCqSHDSGjZHmo6uS64Ykci20z2VFGy",
-	"D9NJyuU6o5s3dMXaQ39XrqjYKRhNqT4t05YIumJknhdELZk7qODKmdAdzd7ntMwUTjxtTPTTkqkl0wNy",
-	"Cafljp9LYgbxJrjI84xRoWewDc/gSwg2ug/J53BuTCie4MH562aiXE32fp5Qup68C2xDJvka0a0+/A9c",
-	"Kj20AT82IyonBft3ySQcAVdsBV1bo5ofaFHQDfydX7Je7INGfVj3YTrRK+CFBv3PdRhN7ZUJoL23Bg9x",
-	"GwjowFFBKr/4F0uU3sP+hcyzUrFjqpbtfZywdcGkpiaaCFDTlsx5xsiaqmX7eq+D42h4uN66iYY5xXFy",
-	"AWgpN1Kx1Yy8yRUjakkVoWJD2HsuFRcLbHrNs4xcMJJfsULfDMWAwLD3dLXO9L52r2ixm+WLXbpez7J8",
-	"EYR0GwZr/iMrJCy1RRWPD803krI5Fxpdloxc4W8sJUhiNVLBXSgsxBBpNRoLglPNyCkrdEcil3mZpZpS",
-	"XrFCkYIl+ULw39xogJJ6mowqJlVFF69oVrIpoSIlK7ohBdPjklJ4I0ATOSOv80IT1Hm+R5ZKreXe7u6C",
-	"q9nlV3LG890kX61KwdVmN8mFKvhFqfJC7qbsimW7ki92aJEsuWKJKgu2S9d8BxYr9KbkbJX+r4LJvCwS",
-	"Jv3rePXsgin6bDKdzDO+WKpEZXqy6uf2ZZ1O3u8s8p3WXdtfr/sphAYRXa8zQyL8pcA7KPXt+XdJ0wyu",
-	"gd4q5YIVk+lkybJVeDV6hJ0rWmiiKfVQZikHbkTzw3+7gV2Lanzz03cwDe7HLlM3YwIeBpplR/PJ3s+/",
-	"T/6zYPPJ3uR/7VYP+K5Bht1XPGO204dpd9sTllHFr/A+68Y1uqJ/bFOBxvpeiqsfaYG3uXa3WfWBpinX",
-	"bWl2XGvSOsf64b0UV7zIxUo/ele04PBKXbLNDmAtWVNeyCnhQq+LpSQt9TCkKIXiKzYj+uwv2QbwH3sw",
-	"mizJqpRKk4ULpq4ZE+QZNHj+ty9IsqQFTRQrpEd07bbDpMCB4TgvAo+3/pWs6HqtF8aFflVXVJHzyTKX",
-	"Sn/cc1im/zqfkMdstphNyfnkq6dfPd376un55EmdaJnfNSmlSl/0yd7k/3d+nv7Xnv6f/ww90/4yzVvx",
-	"DZWB23KQr1b4dppDAjaAZpl/b+A+yRCr5u5gF8rZq/phOhFBruSsfk2RHbGH9uz//f//P/WjIlkuFlMi",
-	"FS0UueZqSSjJmIYMyQsiytUFK5AGGlATkZNrTa3kmias/3m1+3rXgwBNdpnrTa24oCov9A8GDfQ/LbmJ",
-	"gMjQDm/wGjmK9jIN6v2AdEW6aHpTb23JX6SDIWJ+nw8ODwyX6QD2YTrJBRtAsQL77SNcwYX0zRKAT1+n",
-	"JoSa1O/EPGw/8BVXMsQS4XeSQQPHVjfeofpNStZl4G4ev8VBNB1J8kK/2q+QnBRMoy7QwAsqWaqZpOaF",
-	"rRORp7P//bcQpVixVV5s2pO/ht/N/HDJ8jUSdKL5glus5PnfvlwN5btaUO8CeJILqQrKxVCoZ+4Ie8hX",
-	"5Oz7Fn2qqCplmE3Bb8D/EcnFIquTQMP0puyKI8WyfMtxwdbU8CKnmgLiP09KIfBfL4si1wzGW3Ep8mt9",
-	"w/Vly5hi6XB+pr4Df87WR28RrW/Vqlqf7DJbH6p1tz55G6kD+q1kRZsdKUqxL8OvTSkZ7NcyiShcwM/I",
-	"pvtnYbjxC6YZDVIKLWOSM92KSyJyhSPo0Sgy/zCMvjNcgJDiCLkMMKXkMZ/bvy8y9mRGXqBg7Zh8syqK",
-	"E9GFZo6KUkg93eMFE6ygWbYhRZ6rJ4TPYUlyzRI+5zUhO8hRvzWQ8H/ekZd8vWPv+w4IphrAoGHowfkf",
-	"86zEN77iXevwf2HEJArvfEquoIfeZUouNiBHdl3aMAvxVvB/l4z4Z+qPaw4jQBFaBLFgSUb56jjPeLLZ",
-	"gjbgxk9qvZuMBaw9wFX8PvDZPFzRBcOJasxH35v2Oi+FukE/mC/a+V3zaQw0al1KPJUO1Yt/NUzjmtZl",
-	"q+Noa2UGoe9JEwecrmtywvRVnkwjSL3Mr71buqQizQDVDTJeLxliYX6tCWNts6AcWOVXeGctvTfzvetm",
-	"8nHZSCW735o7uW1vWtcscpXmrGAiYaFH23yyRC5l6yzfsJQcHRzu6KPNOBWKcI2Bmq3Xj8ycJopc0ORS",
-	"g65z7tC989fTw9nL03K1osVm4ANel5Zk/PH+jtFMLTeT6eQFWxQ0ZWnwwX6T+2vZ/tWuL7+aNNrEW020",
-	"TeDBrjcIPtz1Js2NaaiXankAFoE2raA1vVv3xXctQacAt9USom78NY27tMktxM6LBRVGzSpf+irxkA68",
-	"1prQglkFOMratXm7deJdZFMj4RXlGahMIpvZgpKWaungFyKidXnZQT94sUq1fLERdMWTIw8U+1LyBah6",
-	"AlrVvi6Ewj8lMEfAKdWhXMkipVp6tidN1gOaDCT3UdX0P0+P3ji1tEYaaI88mWHukPPzF0F4qo9gzllh",
-	"dTw/n08WRV6u5flkSs4nT88n7zRt+/l8kpRS5Sv8OS8W55N3T7azNfgza/Q+Lticv6+/Xa1X6wzMPbqh",
-	"E5hqOwB2yumn8mKxY5RTnTdCT39azodNL6HhkOl3AC7h6VWvKrg2MHV45FPnFBEu8NY28F2h2aVCmh6s",
-	"P8kzNhDb600Je68KmihJilzzEfMiXwUxmpQS2IkKU2+P43rKXUBXg+5tJH4Hf8Ha3B+MZqtfaJIwabDc",
-	"ft4SoaWWOa0mrUKivRYWndqGgER5sdjTM1rF62NL3x/tPXoyIycAR3NnLRvhpgLiLNcZqFwaNGUHjGQp",
-	"noQdSMsVeakaIyyy/IJmoIHUfMEGjFlZVidRN8Rj2NtD4e825DpCp1OPMUZaDUiMQnYNkzXkcGMsbRF0",
-	"vc8u/arde8dz1v0ETSdrVqAeoeNFxCbRIaSiqnsRp9AiMkBbsaq20qoOmKB/gG4wDRmhG0ofYsjW3S2I",
-	"c51dSFIwqkD6Mtez8bxocgH2IY2XbXo55EXVPfW7tDPkaYXGRjGTdL10btT7fm0Hr+je3157+YbRrigK",
-	"RTl+/2tlg0evhTCvXPdTIrJcr3NQdJKLXC3J0eGLA6Dw6MYR9GO6kfByyUVAlviei5RwwGWAizFvup3Y",
-	"p+zk5ekZsbZ3pLIIIm/TlZ/Bim4IF3Or9DSUmVXeKMjrog9SeQH2DOMJo3nuGTmgQuRgpivXKVUsnZFD",
-	"QQ7oimUHVLJ79zIAw+SOBln4PV0xRVOqaN8RHAGMXjNFgfUwmquhAhKqw+JCkTlUbzlmjj481sJdNy6D",
-	"+Ad4kVlB0H9U5d3hpePcIvJna9o7kDPH2/BRboM+U7wL2+E0nngfUg8xl1O6jmJMw091Orn8SsYaf/+V",
-	"bDTONaI+j9IBIObNLjyN8nT6GWg2XzMhl3weNakfrZk41Q0auvgm81fz8hvMBLZW1MeyBfbc2yWyg567",
-	"3ji5vvbNw9OcY9QL0uoSh8jaDSHbF1FQzm6KIp2Cy92JJo21D5cnGh3vTo5oDTxYfmj2jFGFTnkleHpd",
-	"PZxaUIvb3eImOJgayzvCucan9ssDYZ7Xt0DW+N7rJdNY5dZlfVUtnt0fb22waKhaoLXP7qMbcuFCLauj",
-	"suCXTFkNh7Qqk96b1zD5675hgFn+CBQoYDWBOWARtdm29PG+jcZmy5PB3YWO4xuqkoBeD34GRkkQljEA",
-	"OxfkAn6WmnURaKAK+MWEN7Wi7/mqXBkvO5IXZM2KhAkFZrq5sXkBaJEHIsbsDnPqqYaRoGM3KhCdFRd6",
-	"2sneM7d5LhRbaKbxHSgLM5YY0tvJ2dALlp3axrpjCZrKs2XB5DLP0r4B/HV9iB3EqYFs5EDs55rDuEVP",
-	"gBMC8IIR9p4lpWKphmL8vGR0vv36uDgjdxq1QSw64pZmH7k4xA7PAupaVVDFFr0eEyd5luWlOrXNm6ju",
-	"xgmh+YHe81xL6uyULwQXixPkvwPOc7GmNenf8u9oiSPmxU+qvpUUcLA/yvh/Mhk/ikOWYZfO4eJmw2D3",
-	"u9IcROcJqxE6m9d1CtGmD6Ze6FzBIDIWpx2j2uFzVTt0X+C2w0ZB12uwROWlSAlF/TiaEVJycHoyJas8",
-	"ZRl6FlyWF6wQTDFJeA7ApGs+894OObt6NutcQvv6sPdrjhrnU5bkIg36O0N/DH9xUWRXNOMpVxun2/cW",
-	"oqdBcyjyTV88n7TZqOkEjM1dwTvDueJGVI8emFCFyMWcF2zl2GphDA+thvM6X5cZ/HSxgV/3jw+JhBuj",
-	"YQ/tQeLRN3K1KhW9yFgghgcRKcghnAFXKtmXf91hIslTlpLjl6+rf39/cPq/nj3Vy5mR15YrWzJwrZ05",
-	"voGzDLgz6uNDF/OBVKF2JBcbxUIXB9iRIixrHooUkQxt9Q4nsA+GvwCp+ndJM/AEBqEneEFLHiB2bw9f",
-	"PMA5eYuQdBGS3d7C786hGc158CZcsg3BXt7+jbjBpSzrnNx2Yp11EO/2HXsAwDRIocXmGnJsR/oiTqIV",
-	"QtG1FrBptpsywWm2O6c8KwvUkpTu8sIuvSArGYE74fMqUjfkelU1jQT945Bt3nxaAY7kWp5yMB90uzR5",
-	"RVEoFBZnv6FnJ6oBvZs2I9+L/FqQxGtYMLIPoGPplLxgguv/agi9otyEuw/jVOyYvY533haCONCOshoc",
-	"UxqLIOxVAbbjRLfoEvFR38I7Phaf1+vqLjIu4r3ffQgD2J7UYLi6Lg6a60CA7MAx0DIwzEL+bhrD8eoG",
-	"p0xRnmH0VC4YoZrqKnvjk7IogAlV4EphAto1XTtxr5oPlHDEqf61ujaaky6BsyTzPMvya81Df1+9pHp0",
-	"n90kbyUzMZ8a3KC4SzWr5rwYQO3GRLkK6LWoVGcFFRKBx2M6XN2OKL5iuG23VuX6shT5dA0kQxb1SkSu",
-	"lqiWdNRHM+Q7eqwwZyz1+xXJ00Fcng7TjnCk0RpG9qjoRV4qs2K3vLDTyAU8P+m3EDGkgtkM9O5nlrWe",
-	"LVzLKvyogsY1lfASo6ttucZpfT7zy78G+cyCURlMpUAeXxSczZ8QbFGxsnbOR3LQTgeK5XbUiBhuRpmG",
-	"0MZtojrDTvrQH5lR2+cUECufk7OiZFPyimaSTYlxsPcVyPr7ZDqBBl4IwbCIgcbqzFiNX+3QjZ/dTP4u",
-	"IwkZjB68whzuS6febuzrOZlOzo5f/8gK4FsxoMB+wHcV9qwf1XZT0Ofyi4w1/7BE6pgWEpqebkQC//hR",
-	"y066BeomDzXtXxRM6sN/q0VqE265Zolt+rrMFF9n7OhasELCuq54wl4wLU1zqWVV3WnYQbwURZ5lKyaU",
-	"4dG8/ba+1bcbZfO8IaJtHCyjLRyQoy3qyzlh61xylRebIOg1xKMfWufjf3Rn9SpjTNlTgD9Cp4an4Z0d",
-	"/uCfIP4y9BwRzed80fRiGMaafMtVoHuvAdy9g5ip5wYMzQ1m/U6pdaibgUE7ov4PzlOCX+HtedA6KwFx",
-	"aQPC2jB+DR8yLqtI4OC7tc6LUEYBP6XIjWIhIVNJQMgt/KD6LUPg2+8lgiTIeIYexhYe1XVNDRDU85M4",
-	"MNYCKDGEcAXa1XbeqU8Otm2grUvb4nUuNJW1RKi6fvVNr7BZf6KkSlmdE9OpXxfhjx4Mau7OQ9TeCZKY",
-	"Ihcv3681IQ+yqPo7Ya6BjfPQaKHHTssM1PB8xeTsXOhNmhZckl//Qsz//7pHdshrLkrF5B759S+/kpVR",
-	"8T3d+dvfZ2SHfJeXRevT8y/0pxd0o4H2OhdqWW/xbOeLZ7pF8NOz517nnxi7bI7+5excnKKfMUuJPkiq",
-	"cr2IHd1wz2khqdgY04Nx0NbDcEGWesluPHbFig389kTP++vOr3vkhIpF1evpzle/AuCePSf7r/XZf0X2",
-	"X2Pr6a97BIwvtvGz6bPnprVUoNZ49lwtyQpgiH12f90jp4qtq2Xt2j64mGaPU3S/qe/lqwokmoJ+5XU5",
-	"Fy8xjYiGHHm689X02Zc7z78wRxqkqQcQWIev+qGY51367aY4Aup/NNKnBCP0bKYkcwDBKZv6S28QLhAZ",
-	"QfMHkls9ULh153Hh7cXh73Vb9nq5kTyhmTfeaK7+E5mrKx53uBBs+tzAEP0uiq2tvC2hyO5tM4ex1QVL",
-	"064w62ZeFS6J7eSckPJcJciThQOtRTxraqWN8V38+rOJ0HQT8RQ0LlRzPz/M9ZInS1AlQ08yOGkJZEML",
-	"ULE3bhbbhlhFUCxJUkBjc0eZdLgkRQlhlyaLzuGcXGRUXE5Dp1eUwmbUgew6MKb5AfNrNLPf3Hmym6HX",
-	"KJz06cM0nu6k0vyYJi4lRxNqN89+Yq91jxXBZcfQqOrh0rRSgbnbN+1Mjte6//X0D6E3VmIDiz5LSFbR",
-	"yAMTyKjRkMbMw955bf23F7Wn9oUCnWItadld6Be784lEtI1xqKJIHgPkgaebrxSKCC8TyRbwlmX6FrI0",
-	"mtz2xDSw6Wyj4/YZLevzdG5S5lmU3zGffbbH6E3h5yQXgiVGxegOO+QvCaLD4YswSTOfyeELXwPdmCGM",
-	"GNjztffEN/DdcZ5uFvugWlIPKdPRuv11LetpQgVwNRKNkeDHSTP+G1opXMpbVqy4oNnUrRk9SXW3KWEq",
-	"iR0XTas04g3UbOxq6gEwfpS+Ci2UXtDsGrlgalEqrSve/LzbDTsZLRaYPL2fvfGXcgb9woYzHHLYlrxx",
-	"2rTd+SrgZZF6htbWVkwt87R+pXxt+FvBQPcLuu5E5cXmhMna+rp0yl0r9kbualaf1UHhUPMFBVebgyVL",
-	"LmMEKd62eXvrJIvbHiTRXciaFfpGoMvVDd+AneAbUMlfzTlxRbcg/fHN34z2R0fqMShtAcwK62wWp7dC",
-	"Wl2Eb25x2v5t8DC0gWqmrjb+GuLt3OriTap1t8EaNc8Z5iSGoq27u27oE2AWSAOkNjdHGvCP3ZbFqdAb",
-	"2Jtq0T3MjW7tYNV+H/lKs4ertd17Y/Ar6FkxrsPs4De6VSb9KB6R5bfVenUbON/4YrYXM/hqRh8Az67m",
-	"8Dt8PW90FRvXIrKl2M3qucPt61tdux+oVKeMidijYb83HwpANak/KB8LafT+ZdGJ2l4ehv9ApwYmrNee",
-	"lpR5w12zA5Ub+OMWEMegH/icJZskY9/l+aVFHIsB37B5XvhmzP25YoX3NzY4YRd57reoftgGM2pLaU0d",
-	"aNNcTXQYf4Gxcbw1t4FzI7Ens73vQGBsKmurwe+KW2js9WaMQmiQGCHyi2SEINbmCNAXwVCDuoG8/suW",
-	"JKmx6iZRaXyurSLwPbS0nmZ18hSMlqm+1UNj8PeHy7PhzTdIKWQMCGOMyx8txmU6MaqvYSdoeYu7C44J",
-	"OcC8YAoqq7xA78K24h4VZ/0GZWwH+pNabgSyLot1LutlgbpWEsx8DPZBLhbg/9NxWeb6uw32XlKFhsUG",
-	"uzU0KKABdw8SrQUNBfcJk3l21QFumxwAmochjnt02SqoJLluTB6LMsswHTz+Atpx/aN+3KyeJ2C8fKAD",
-	"tnsPHvC6YFc8L+XrbQ7anLHtm22MHTm94YGjE0dWxl0bvzPZvpNczDOeKGAfC7MxHwBo6IbdQH5n+y/Y",
-	"1wsWqc7QiXKNtcVR7kiGo938r14xR1Amgg6IHJ06BWhU6xL2gzqrDYJOUGglKoZVuIs5E3mbuglLeHQ6",
-	"eAs/1lXedhtB6g9fXvBFNM4shW/NsdDlgcglff63L/fo09ls9mQoaOqTdgAKLtuSrw+WVCw+DmVvriF4",
-	"5QW77qBygl0buob0zlE3kzJ/GHGzpKFjItskPJvIBRsyVfzixk/KubtuhdjOz6xPGWVKDPVzGvV1WMVK",
-	"yuXlbfpXdYZuNkIz8mldTtygZnVDQduN47LmD4fAriN1lVD/J1oYEeOg4IonNAvk899GEqov1C8X0P5a",
-	"TR766i0o9NkuMvTN9+1336EqhoukDXtQzSFOYULFxngj1nUhfuqVd83SgxBI631uhSvZCFooxqqX43LN",
-	"uFzvMAWxuWAIFeluXpgQXfvrjOwrkjGqqbFgVWNbbs7kFkprNat+b6x+b8KqQoVfr4s8LcEoOFWcFV/P",
-	"C6jFmHp5uMwdrG8yZA23y8FdQmWtWnoYL7+OgQIqqrjZJ0ZIeU4TxvmRSj+qqg4SWeV5daE/Gi+/xsme",
-	"TY2GY72kkv3H18dMpFxE08E2IHW3e4TBh+2xjgzeHi/Z5hlaVp9NL9nm+X/gH8/DG/rQRVTgUsh1LjDQ",
-	"rfNWtIqWQTcUhWGbGNXlpHsP+RAKqREEJ3tffGhb8ust4l5ADriaVb5mBSMmBdK8BDcaHCjkBtQy6tem",
-	"jBPfLu6zWUi8w3XRqxsyqHbQDXKRRkNHW4JB4iqWhBeC32+whmC8Rmh62Z/pjCaKX1W+C8Zov63qyLpk",
-	"BLM81DVtWxvjISxx4DqMGNN0rW9QF7202gNunNTr2ZyHw6Dhph4sVAAeb5Fy8OajtSPIhoN9w11fS4XH",
-	"WONVdiXzQjWGqQZb30yL2poMh2YdpeCoEJmaoo5FVcMAcoNPCWbCWrIs25Fqk2E5AzsZrB9mpwvKhVQ2",
-	"KjjbkCynKcMpYE0r+v4HJhZqOdl7/rcva9Vrf36683e689v+zv/snZ/v/DI7h//7+fz83X+cn++cn//l",
-	"/Pwf7/7r8f8Z1u7JPx6fn89+xoahz/8Zz63YVRcMVY3DKsF5EWemh8sKHaOLnZ4TbV+JsDlCerW9DPEk",
-	"pu+KgrGXZ+gxmaiSZlXw9m1prZGoa4USPWZ5CwrT9hcO3DLa9qbbevSGN+LwHBDuFDCtJ/jPWs9EDclg",
-	"dDwNqZxumPfBf3EGkezKVRB8B4xV9kYWdusUcDeWVPL4zdHZyz20A7hgClNIs2CqLEQtZ8qTgaZX49H7",
-	"L5mLHb4QecGcC6+zat3IELflG+X6DA4AC0r/25oHWpiNBN9GvAwYoGrf9abZ2197T7a+9zhZ+lZwFb/x",
-	"xtCzDeFNI34c3jWvQaZOViZhKuMfpX+X3J0E/KjWW52cj3od/PGNXaS927akRXoNaauFjRzT8oTxxncb",
-	"uB/XabMGmwjjLpynA6C5mUV8q0qOYT+cI4ikDhdt9D0bjnMtT6VH83nNUWf/mnIFAfPGexizKYDB4JiW",
-	"cktjeW1D3tJa37zVBr7WFUC1T21vjdrn2jYD35vm+9rHEDACzZrwqY6zRtaGBfId2cLl5jZ4iejY+3Uu",
-	"q/cGokpm5+IlTZaQXizJiwIk9RQTvFRiBF4Lzc/SjCR0TS94xtVmdi76QwJxE7VbleRZBvbOyjYeZc/0",
-	"IqMu+/o93ofgGOOzH7yEvrk7MobXQvM/GJNq4NQKWKxG1qgTcqz/Js8VOXyxzVAYcTnkCWsFeX6AMgxI",
-	"BBHa4V0eOUp5ainlwOU1rfA+QB0U2quY1o8vTrdakkSPl/kaWoJZZkUFXVTaJOMxIaeEiyQrU/0F6hCb",
-	"37365ml+LYwUZ4vXmRLiDVuRaXeKAde9jBVuxrV2j/tN+3/oAVt6I+MgrulOncX859HkQ7/D57G22Zs9",
-	"j+0htnAXqwDmfMXWZ/kLCvn2jkp1NDf/9nwEb2IVqS3SmyLw1Z812LnhrFj/2jJ8+KJmD1tmFas2jAcM",
-	"h06ggQs3Z+jNUBWeAPt/pwReYXLssRuQcctVhfu99Rbtk4uC0Ut9ozt3crEh5/66zidtx8cKuWSTp/0D",
-	"LN6sqXvhKlc0ixgH9Scvajc008AMaIb6/ZGgY6SXLug0w6UAVNMAsjbPv7HhIDXi8rI3ucnW+USmf7CE",
-	"KMEHPKmqUZoB4O3m8hKz2wZy2USL+Ka8AHPXxlXytfpv46/hjdm9l3W4Luw7PKuihFm/KVMThNdQYTZa",
-	"1CtdsCuWmZLl+TVL9bJMaySTBSb0IhzwdG2yerXBAFWIv9nElRRoArxkG2DeTfATgW4axM63qZr/ApZb",
-	"02N4WuvHP+/v/A/d+e3pzt/f/bzj/v3L7uzdX578w/s4QN8M6vG3wpVsj5RbwbonHtWxZ+QVe7eXOi0B",
-	"cwz4QAPfUTYFvu73TN+o9jInpWjP685xq/mDPFyeXLJiv0T03sKcih2NvaJR0FMf89HBISnYguvTCDpr",
-	"l2o5JCHFUcL3bdMP08maSnmdFxHbj/1KwNZ9yXApZhmbQOF/R+7duME82bHM1LV0DD1T9Ugzdo/edN5u",
-	"gwS87MopahHJZay3OONSUGDYtsqJhnrGFJsRIGiu/qoTUmwmcPB1pQQSDvIrE1HFCpNHFkU4imrpUnA1",
-	"I1VqJfcjZG3eI79KzFIkMef+lPy6wh8w8ZD+YYk/QIolwB+PLPxj7+dnO39/d36e/uXJP87P05/lahmm",
-	"AS9FkmsBbEjcMDNt8U2CsG8g4lTRyiDhDtQV0s0oF1oChcz2gxNQ4lTHprP9+xszyAc/D+WBs0Q0She4",
-	"FjtG1993m6oxT02HJiIGxgwhXytJZhu2rSYddYBM/nONjbiATmPZmFPpM86p1EKb7dIrtbvfbcmfSObY",
-	"kAgTbVql/w7rMNx18GyapLqY8RQN1Kag7ag1cO0lb7J3cEkluWBMEDtAOFcT+oJ1iU89ath9W0gCRwIF",
-	"73qdbWzuzmhattbhmX1udUKe9DdIwIkfdVuy6Jm078Q9n4Lbnv1+xCEeXmCqTL4r//Svqawd/LAIctvj",
-	"m01/WU7TdoBA54069bc0ILt+3xHcwLEjAHh3QLMgroVDGYPN6lGNrSYPFt8YnHmQUbnNBIxBj59rYa/w",
-	"s9yP6eBvytHR1DXEO9Zq+0jaECZw6QlEVMhIDEmojJRfEUdiBnWfegaeqrqP2PAkjtMJaLFP+pJ7nQHR",
-	"7UzwBShr8hfN/iVzQR7bRHkdzt93+ibbuhPWfQjKJnvPNJfO4WjJBNF3yCOTXIaYiMg7rs9zGLJFrEuR",
-	"htvR+kGkt2LybsQyeA5wfdWXfFxul2CabV1YqV1Dht2C5t9ZqaS2KNpxuqZJFxu1zK+NMkOTYLj1WLWG",
-	"vMr4YqnIgSbJeeYjq5drpK2dqtQ3W0vVoE/7MPWF6ZLv2FcofOxvT36wp/P2sLqFmHazlOjIvC7sK/bf",
-	"J0SjCFiNMy4uMeczzGffzg5D/03VBTGtQQNe1QRRGAxCCauX7EEL3axeFM288Q29pI80WH74BqiBQ+94",
-	"V3InnHnwABp6ZTxeUEWrZfrXHDRMQPqpXboen8x5hnrFsx9OwxcfF3PJNp2L+J5ttpr8km365m5e9ghU",
-	"2kscdPDDScIAymBTSOprkd/w0L19aaTKC66iIK/a7tumcej7XIobmdRqmsYucCigFjlh/QoD8UjTgknn",
-	"ddG7cfLYMrXLXCotwe2t80INCJHuAJBbbPDkNfcbOOYrFLk8faGx37MrdAqniuQJeIC7XNPobBYg5uG4",
-	"uKaQCsmOQSWNsEASXPDFAvg1kCP05KgmR3kFeCOIYWRz/h414IyDfkUPt0cegwobHFf0D/KJN4P5SkuV",
-	"r6BupQ1ZCXN6NxX/0ir+vJPW673ZWHVwYb+CpAqowRum53PFWEbB784Fv0jdun3ErirhZkPMaub71HBc",
-	"mxpzd6jZjVeYk8u8UFOyosmSC1at0xw/3LJ6LoxGLTq8dJ7BxToeHGAFWK9u1YGp8epS6NkPb52neP2X",
-	"VkObGaTxiz9mO6gu8nOjx8Hx21aI+MHx22ZQ+cHx2zf6AasavYaY+1Zf/LnZHX9tjPCCy8tWf/1js7f+",
-	"rdHXLwVV82D2PrQcn71vzZD6F1yaB9lPchhwgW54JDd/dtlsvA+NUQ8gKly1/NfM723PNdch6LPmznOr",
-	"6nFWAmxgQyT3UnfWoo6yavqXQ3Flfjs0ftJnVF66if0fj1mxogKiCL07ECklZ38+FLT+wVD7tGpSXbR2",
-	"2bhqeX4VueoW+7+eKlq0f3VLrQ1gzNfN37+hKlm+4HKNKYkaXw3UWGbh3urqj+sXxjvQF1x5Jzao1l4L",
-	"dtWnYPk9/SMXixaBqpXma/7oWqPv8wmTKi8i2V+w5yCu4BSbOoG/y43LY5OOsKIm0pMpMbTGp+SO1Fg6",
-	"9K7fPtCtv6wzLYGioWYCt/+pYQ+jzKmXvifAo+64KriGzZpW9XXTKk+G4Vo3a5Atall8MAwZyqHpf3ZS",
-	"h05tZHdeuR7CssXIzRRqsbxHPYF7kSxJnRcxMmK8R8eoHmUYOmzVJTzuVgvtWWODPg0YsN4jPKohEANG",
-	"w5bhUSxxHjCMaVqNE3iZouU0my3Do7SfsgEDtjpVY3c9a1FX2GgXf9zaG9KNKcHG7bF611Vr5kl3Ng74",
-	"Dfi1+emyPkwHVliNDj4objdy/Yf17iZ1NxmjSdT6a73GkHObnlEsHFrLMYge/Z17sbVviI4rvk3X7Tbd",
-	"ST236Rwh5lsPcatFhMn1h3db5bEDHiTic2A/NfwMrsLVWu/LucBNN8yjANSBoxfBZ+tF4IkTQTHCrQIV",
-	"QxySu65zAXJTWyXU0NLbzv3K3i3n6VF+u3lDe37FM6tYiO0ZPqIxes5D2fOTrv7ggEwUe6/I47dnr3a+",
-	"AiUzuiNXdoZqEohDMtOETMm6nfVH7rcQeu7VwQgDvf14eS791RXkigScRLIw84w9khhbMvVc1I36HTzV",
-	"bQJcUa5YwRNy+GJGXmD4FphTzydFnqvzSWcVw55yhas8ZZ0rXLPCKASJbjsj/zcvgcbgmjHqeaUpwpyu",
-	"eMZpQfJE0cyarzNGwSn9N1bkNqfe0y//+lc4ZYqeNQlfmQ5Y2yvU56/Pnz7RRE6VPN2VTC30fxRPLjfk",
-	"wvjlE1c8BApFaiLmAIsFIxubgZui9ylJ6sFVLy9c17KU0azaCC1IAnuv53mTqpQxxD6yqnS/hkjiNFom",
-	"Va6XqmRYdEBtaE9B5v984sau/WwlgndmhdvF9Pm0qpeZ8S92b7X/C8idzY4peEb83o58c6QnEgMHvFOA",
-	"gJioX99SyPyklmMAwZ8sgAAwYrugAexyt4ECMGaYNXef6qw5ptx/MNa8mm4Qa473b2TNP1fWvF+6bcWf",
-	"XUBNheBrDp+AIalnZ6giVR+mDkaHMjlkFJkbBWJ39XBs1Qzthy0PTEdgMgAfsyJhQkULOphmmusy7Sz/",
-	"foPJ5mXWt7Gq5W02p9hqnVHFOv2gfWHsrN7BOj9yadBIS2fGrxH8d/Mg/ii+YulRqfo2Ce1goNvs8cZZ",
-	"K4bP0lWLpAnjqbmMIdSausQRHiY4XPcAN4gstPVmnwVd8MxBIcLwUXD6JgjQd4b9VP3e4d1Ngu8Q0jXc",
-	"0hC3ke4Q131LgPcBOqzffXhoNwyJwVdPN38TzXDgAxtB6rzTTSCIxmoG2S+ZzakXhO/dnW7H1FDEQKRs",
-	"2wOuoLD9YdcNGQ9/yMbw+aD3yXBB93+TGgamh4eutXeHwFvYJgVVbBGIhTVjaDEIWjgvkMoJBvKJfnPv",
-	"r0/9ybn1e9Pc+YBjDMZwtdtsF77V4iAaqnOMf/qmjycxDFuVXB/JClY+bQCsM6VOpX8Ib7UjIhK20hsF",
-	"abY6LEv+Sa0xRA5UhWI6JcxaVRkPCSOXzHxt1K9rJ2trpAe7NzWQVwqllaMxrLNpZluz+40ididG3xiV",
-	"B1cTgNZTwkBXTrNsQ3glbXhXY0mvGOjyIQAF30jIECTogtXCP7gglFwvYyao7WIM3YnfPhV/2koNuU35",
-	"VkeqBqm46tRqy6DGb7kK1JNpvVgLrk70MsIBwsY7A4OVvuWqXkmFYDTNNjnqbGY6WziTL+ytrBxAgtxa",
-	"4T73PznVUE6dF+YAgbadsCveFSSNX/WiS1uyqXe9rXJJbvGtWaexbHvTiRjEBzfKDfWvxpibzMlHcOe7",
-	"8uJQqCLXNxqyOwZfkUjDKuUfZD7j/ndSSv2YYU+yf3xIHh8fnZ6RXT/9/u7vqDn9hacfdmGQJ17dr6P9",
-	"Ui2f+3htFK2HmLoY/zhlScEwqdM3VPKEQGym/v725AdAxDbixp2K63toMk4LrpblRZBhKgujnDGpOidW",
-	"l0vXfIb9Zkm+mkwDk3pAuqASAnPrVsbwWLBn7Kv/nJKLUpGECnLBCObV5r+ZhGJmhpdCsWJdcMmMfrsf",
-	"i1TMEehbjVfr3Fn7hify0wSmuirW8Gry1tkMbpKIHMITyeN1eZHxBLs8mZLvzs6Od/X/nMJ3KGZ0evod",
-	"/AFlO3OF5dKqTWj4HdhCDlIuzb/ftWqseQ17KPd3VcsP/ph9VTFcw07fdg88IEvUpIcGRg608HrnpRns",
-	"b3VHH28DSOkvQ18mlZMkywVSx37U0UNP4wj0HctWXtTOcJNxoIbbN1SyQCrYSNnfE//BA9q6pIUyfCSX",
-	"ZMmylV/0KPiqAGDXNOmujOxaVVkQvWJKKVtn+WZlo81sNcDJarND1+udaorA/GDd6kjDgTVrW+WOvGcd",
-	"RwgtzLuFtLjgqqAFzzZEMAlBozbcoFnD0IHbf8UnYsHFe3gQF5O9ybPZ82cY7AkZX9GLgV6ADQSXvMyl",
-	"koAE+l+TPTuDIZ+aouNnZD8mu+ZHFMcnxxAYO/nwDmz4BYNNHeSlUJO9L2p5CPQGJ3tfPXXAPchKqVhx",
-	"eBwWsxBer3iwKJ+1cVqggoeKyzZm0qZ6501gHHCBKVhGIbslbM3Pyg/sMZa7K1JWkAs2zwuMG96xRUzN",
-	"jLWj+NmsdacqWzrb0JW+juZDfsWKgqdMzjarTF/PG1bIj9XwDl74PL/cT9p3vXFn551lurDEvynkumIq",
-	"kF30ghH2niWlwoQwg4QBvbZOgUDxFctjpp4/dOpT8kg+qmc+fbR6VM98qlHu0fLR7bOffghlxB7m3F9h",
-	"x0kp7PWt/xhIR3r1Iy1uk4voZVXjl1zRgkOg8CXb7KDzwJryAopq/As1vTb9cSk0jIPZ5YtSRB1NVxrQ",
-	"dQz1K3ZQsSG0WJQrEN6RgZaKipQWKVZrJHIjFH2vkYe7Er+WVK9MfIGdSZI1X4N6egEJGKcaozhIExss",
-	"C2sXQUqhyQvV7OeS7CTou/k+bB27zovLFzziU6c/Ympzm6QctwupbTHzdymEdcUwCx0gWZVhpW392u5t",
-	"g2uu2zFVy6N1rz9Zrc/L9+uCmfKmvevyGrej1QVh7rNH3JjGPyglkhP9LOqjc5qAMM0zuc9ZGjy10JZb",
-	"9ymPeL66+P3H8gnR8+PbpcDrl2X5NXpqgtCvtyCp4tL4mcKvbunDnX9qvo4BghxXPlDj+ee0EOjjTEAL",
-	"79DSgRqUVQkGBd0SzKH8+lMN1SCO1GSNLeSnOhenF6mlISw6oylBQJNGZ0kReLsw9zOxHttFnitysB/E",
-	"n4Fp0E16ETS4B9Y1KP25vORrlE9/ZIUTDQOpZy75mhRslStmdFTkyusQTimrMjkIGGc/nGJKJOsnPmjp",
-	"evRLthk++iXbDB88v4yV56xyz98a+lskn++aa0CN9eoGdCsvtVQ+UHspcCXD9JeaKhwHyYj+1WosURX8",
-	"CHl6W19O5V5aWxvp0CxtCkuRTONlxd9dF1wpJm6t/Sza2k+rvKSmbv5GJKRDL4rFoEObL1zUBoj9mlQm",
-	"+UqT/LkyiZwrRdUhKp2QjWHk3yWD0iQFXTHFCklkmSwJlXvkfLKrKeKuynet9+Q/oPXX0DrkRN+pYXXH",
-	"9/BKVYuRMbp+Q80YIIzzLJWt2jGuuFQNv9uIfVM11h0opPTUAzVSPqC08P4ddO3SSQF8rCaKZtksohjh",
-	"KZYqiiC4HgGRH/nSXGRYVc921bw4+kEaBVG1fdBPF5KsILGZvm32miA3DkIbPKRmnZb5vdhYbDN1WolG",
-	"DM3hw0qYNEw9JPhasmyNhFVh5i+zI1cfVqm1Q5Rba+IOtRAf0Kq1Izlupl47Ojgk0BbihwrF5zRRQYXY",
-	"miaXg0oXbaN3gO29zkuhfsyzcsWa26uvHtugAaha+Ep31/yhF58UMS44qHTGZOtGOFWV62SFWqruntgJ",
-	"thOBih0oCovjMssqW35lsjicv8nVMRqPW4YKW2G1bpl45Pd5NCM/aRFPMgXf9rNrupGPMI4L4cglWZfg",
-	"36CfxQ2oKhq93ugvtU7AptOsYDTdEPYeNG0iUsgX55xMm5uBUQcSJg0fN47+ozGW/smMZ0EaxqyAKcIc",
-	"zYe7wpqB96K2zFg47ItaUjTDU+RzzVUdHRzugOqKU6HalzlgG67hWO+mPJSEHdmsft3EpX9h6GBgiyQZ",
-	"EruiG02WXX5MVngdRY7FKowLlyYBdjBQoGS5fh0kMVbyvFjJNp2r27QGsDV2v8GTgwLQN6HPWDk6kCLP",
-	"BgD5tNdwsYMldG9BVQBfj7YYFzSQbEPjIfJB/z6dOh4jJdvkY7BOwoZ5NdUR98tvRgEXSm/zsG6LgfQ6",
-	"Ifs4K4q8eB3LKalnhxbEZM2yCRqtpnBOeVYWYTkmL/iCC5q5zK6D8j0UTBWbA/vi1pfzpha6geRQUXlZ",
-	"la3RvXlNBzQoiKIGhebK+043mrvl4Q+6nQHpHs58bSf5o5z+NZX24K0pDl02V7S4ROXhugKMcVe+JYp4",
-	"Cx2CL/+8VgP8eUKtBjjz/POnM18WAfnknz99fxrKZp/y8Pv98v0aTSm2CUkyylfWbmp0Lv/86SyUD6Ac",
-	"4BpUo+a9BTq5lGUoP4BbJjbwF3mLNeJgQTT+1/WlfBuTezWQyeN/nh69IT+xC/I925BTpp5UqgKQP30F",
-	"gfGZsXVRzanBoqHEA3X2+wiItneO+te16s+maFIh2t2GUPj7r2S3hNZo4OXypeT78oIVgikmd4/WTJwu",
-	"+Vy557ZPbULXPHoE3FA/bwZw2Hp78kM4hozLdUY34RiX7xoJlLEtcXpVoH5xHmFauUx44lvI4eMnV3qN",
-	"S/L9V7ICBZfEDBJWk+fFggr+G0BqX2qUWQ2grxrlj8I9UeKByfsfpkYZBR8WFt0uv5Lh8IgLmryR4eFP",
-	"vtk/aLjkVOlFwrehyDO23f5P6j3MGDFdlBWrrUJK5RC+vkYFhPFI0UPiutGGKiCLKf/NhAuYb6CaQhMM",
-	"mIJ3CpYxKpnndgL9C+aPK423toVKlV8UJzS5XOaQyT9R2Q5NV1zsnJdPn36RuF7wJxuQtr+GA1N75aL4",
-	"1jqAIMVwVxKdQbulhbvi1KcTCbMN9av2CAd2/ESTD5VC3dBoUqsFiDDwDCNGxRZ1tus/swqs23rrVTSh",
-	"f6hPN6FQQLD0XQyro+2NVDG9qwsQupYQzxPOR1JJ5imXiotEmWJgU0OgGE2WRLNx+qFCs6VCDvt8csk2",
-	"XwMndj6ZnYu63xur/Hm+rpzfgI9e8Fx8XcodRqXaeQZR6Kz4+oIml0yk27jAmZ2demFNrd1BVXob+GSS",
-	"rsBvaB7Lr8DdxuQNsvY7iU5hBZNlBh9WVCXLH7BKGhUp/l25k6B71/6bFyydkZertdrsijLLGrNL7Kb5",
-	"1KXJht2IiGqM2vfIvW62h0L3bqW3qgy3omu98d8v2WYKZ/wBfbDCld3aKGeTlAT9M/UXj1u0kWDGZ2Uj",
-	"1JIpcDS3FhrnH+J7aWnMxeO4ogXPS+kCqmAZckb2K4vsim7wfQUbU475yX+vYsumxC7sQzgHHxdlgGa9",
-	"Rg2mxh/u1ZLRf1OS8RV3GvIqKwSgt7NRo9Mfd0V6a0X4WAGaDkgRBxCiV5Rnmlv0S9FAYQ/675IZ3Nw4",
-	"W5fKUdRx2lRbytQoSr3kORRjwSBCjEskCxBPrcXsK7SuCfZe2bviVlKB+wDBBFY7/W5LLsEcD2PpZZn8",
-	"O+sck+FbkJmd1n0F9L6tM5BmpDQI1JIKQsmcXVuXSTzTNZVSXz1zaeHEbfwrWgOdRwCwbShFwz7t0Taq",
-	"+vAUud7MQqomcc55ATXNIW0km5JSZExKsslLXE/BEsYdKI1LCJTZEnVNS8T5YEW54GJxqNgqohppJm+5",
-	"kPpghTLIZdYJgMeXXhMoDX7jHWAqJ9mDtlsBOdr1tMhitfOpIWiQ+A88Hi1lAyNRE8/dPuyiJCkFlMsE",
-	"PEVA6mEs0DM2V6QUcHlESvIVV56vp2QF17y2cYz3F+rldyCPzSN/wRJaSkY4fIYYyGUpwCcyr74CCEzJ",
-	"rIxK0+hJtZ+CGdAhBjb3hBtxLqA32onNcJVnKUiIVJCrZ7NnfyNpjjoNprw5EMs1QyH0MepNOEeUJt7o",
-	"nf2FScVXYEv/C942/puJIk3yLEMdwoxgtThp2UA9b8GAUsbGRpM6UIPC+dIaE9SQBDetN6PxnLUFhqA/",
-	"1xmcnEbLS7bxqad58tGDX8ZSB6FHZaxImPO3rCIIgIDAK9uoaHGouZs3uYL/vnyvH6fJdPIiZ/JNruDv",
-	"oJhchY8E9lWPZVA5TryNZq3BL2oQept+1wa77GISkYGpHGWH55BrHq5mVbg4xK7P2pwdFjOyyc5f54Kr",
-	"vNfOtsJm/WoN31HLdOqXmP3R34X864ekbfd3Ap71g/0h3tAVS8kVekWAiNBWowXs3MYQ3bJz39rHIe7b",
-	"gArXmmI7oG9pN6o0386Rsq7pbO23q5yKiRGN7CwWcjsF9WmkU1CpP50U8+R/f/nl8+jR4+d2z3YxBrVd",
-	"GYb4wN0dY5vv6xfc/4c4CnQjdEAv72mQhdHbD1caY+1JfFWj6mMzaK1xTX3fUWz1MO0c0ySfPHzRMQTq",
-	"xYYME1N8TCfXBVfsSGQbpwv6A+q4m4fXp+bmTWrRmRIkQGA6bEgecI0VCdn7OWcFeVxaXW3jm6scjKQo",
-	"UprzD6+ez3Wb57EERrdWqcskX3eFYRq4YzMUKNtVx3v1PHACfXcaX4zeu6wFdC7med9wtt2wEfV1Osgo",
-	"X9Wuyc+TNSifC5b+4tz53zUvDtgT/UwdzrcfrZ1GmnMLstIaKDJdYOoch5BsgQYGYy/4+TywhvPJO/ii",
-	"ufrM/iHLi/PJuye34C6bNoUmRfYOsn4OHoVtUMrbGSSODl8c9DxCjRaNJ+jwxcHgB6jnkdBD3fqJ8Ab5",
-	"1B+IGmh7n4cu0q5HMsT77ckPFvFdro4k0ZyqnC3yfIHR658qKedp8vEIuYbyLcn4AxHKf/50Zh6DPziB",
-	"NFh9b9SvypzWpnvum757dQ08zTKyZgWob9OwFh6VikaZKKGHUQNjsXNsi06eAVZdiFxRl1HshkaKqjFo",
-	"oUysl1fIvwWQxBSJPuMrJhVdRUy8ELavxzLp6K+pNFtJa8qtlCq2oxuHcxdj8eht5zIaxBSrUQ+fb8GE",
-	"V5CkVYob1MOJU8/WkvFT5yZNqlGsVjFlUmOvyUhIjvN1mUHJegtvLL1PThhNd3KRbQam0c5ua6N6jRYq",
-	"U2MAHKzQFoS6MoiVwxxM1hRi7hKaSRKq2EJzJ4w8BrIGv6La8ImzaUxuHNGG7cMPzbVgQWulVwyBKpJf",
-	"Q5oNLFAFv081hTuH3P67SKWMSTZiR6hZQoIx78Zu5Fdkd7KR9Iwzj2TleHVlLCsYkBDd54coRTqJRxXs",
-	"N501/IRyDW3wWHzi7opPDMNpdzZp57HXFM5Yh8K+522MSLjmRwKYUOeHNCN6dHBoI0g4+kx26f/SPLnU",
-	"1yySKRK+wtRtNZzmxbariOoP17HNrdnA8LYtQ2i2GGIJjxJ+w9hXPV0Vj2MjdtqRNI0nHcI0X7uKaDaU",
-	"TT8LrRA2LJVelRFD3MKJ9sg5dELaRgr7rJDH6zLLnkzN55+0jOO3AaEHGwElX5dy+cQHllmJ6xwE2x0k",
-	"aMgrjO7UYZlmH9AlRm89It5Ux78hy1wqfZem5NV/v3gDKfcOjwlN00IDFBzBrdsRWeeFskzuv0u6mfF8",
-	"WkVgFSxdUgW/rTbu1yRf7f3t6dOnU/Ls789nz778avZs9sz88vPe3rN38O+w/AQ7Y4Hki63zh7hfaA3n",
-	"l+RCsARJc15DhlZA89SM+O7Bs1XcPiI7T/jAuEfv8mqKcaQ7tkPVDNJ0xBM7z+seHUioWUMR4ny4QTs2",
-	"KuX7dS4JuvYe6Ac7z44zKlgcAA68phdQ4CLPyFr3+5Sc2wPe/rdS7tyT3n5d5PqWgKfcK56p0PyHcz+e",
-	"BB4h003anABcGucDK7eB21PKCuuO1HBArLxsrSsR8O/k0SXbPNLU/JFzqnwEPi4wq254yTYQtG2yDU4h",
-	"SZlZjl0NNd6b5HHBFrRIwSvJ+g88qZybjA+QicLFs5GGFu7o5ZM5gERT5Dl4yyiNkybjEhWRPCZ3q+xa",
-	"MyE1HkU1Xn9aT/5Pz+rSpQYLPlye1qvtBzRW8fysq3j6hx8s4tBZ6LAPncJ+8M0W9fKc/teHq9LZmnWQ",
-	"o1Xt6ow1Oz/Xmp2tS9KJ0m2G3rcrtDG6n68kjq8EflIu82tbs6EIw4q9R/1hiGF/ab6RwxdOf9pY4ADt",
-	"4jFVyfIE8QfSUdj70qn92DLzpt6kYYR8doWmWPV7nWEMD9b/hupJEcfPcN7MfQJGrmMMGXKZjcJuo+Gl",
-	"4tVVOaEpuM6bRc1ayJevu6phNAlHV8XS6pt1pjZkxLC3NTrilTTFVsENHrt40BCQvGL04DQIZgyTj9kd",
-	"lSS56FQhVy3jVDgwquHfzicLps4n+h/6ocB/oZ0I/400C/8NFSbxn2jawX//xaiwwIDmZniyHZ9mNxjT",
-	"Txhq7ZZtaurgCqBWj2yvxpn9ngxJm2MWMPVBGkKq6lTD77CDuosu8eKCcW1AYgJ5jqp28WH9waopPGPy",
-	"4GfWQ89eo6+3shBM/rukacbUnZdgGNjvpcndvUWXtxJseIPbB9ybh+cj70yK17eI7pRNH94FlfXOPpVW",
-	"RYfeIv/xsJleOhYSlopvlrYUFAfXVDoma7v6gN6sYWhiVYVwrPJJVSSNVgUYIFg5nNUv9my2+9rYFWuh",
-	"fpMrY1mlwqSvgydKt7eqkfyKFV5i2KoSiCySXS5S9n72LzmMG/E1uMF9u6/2zbQ40kh02agyM7Wa8OH6",
-	"5Ga9mfrivsPh2hpn/C2GULWqX94hNurVQCo3UkuoencS1CjMfBISfYUqNu5BuvqJA/uFa/L1iE+Rao8+",
-	"XofZkPr3ujLAfTPG2AfRBRSNSQfxKN7tHRUBn6sioHG3OlC5laOpHnBdf3F6Iqs6Iouc24F5qDoSXntN",
-	"9WMWt5S7hrcNmfLX11toxF9hX+PaInvOKVI7ttliuwKy9eO7ZQHX+mC3reK6XSFVGym5n7FCnZRY0azJ",
-	"bHs7aLOCy4bhs15rGVJd67HDFtUy5kT5wl4Uy63xFfKLXi4desUKutCipFGF5BcmqYJJUwgTc7GYkVdw",
-	"nnvddaX6K0Z1VYs6P0//K1YgajpZd+hzzjDro1XT5HOzIwyvLvhioal6CJLoX4pJma5YwVV/mWr/vE9N",
-	"J/S+ahZvtiN6x1TbR90w3YtctcnabiLmawtnLDP+Ey0EstwHBYdkEZPp5FDM88FceWQt1cDRJt6M0Ta4",
-	"FG/T3wdf/BP3iOs3jrD3WgxNyRWnsO3940N/0wcaf8HIzk75Qi/TKlynk5eiyLNsxYSqfsOKzBNTNntS",
-	"EymqlZ1uhH4EzlqV5vdLtbQie1DkbcRVG+V19Ok6OH4bJWDrMhSkPZ284PIy6vfH5WW4FwawR8Pho+Ht",
-	"7RfOjzsf/NBFdtP3jHWtq8cDMgIJ/RhGo+jbBxhmYk5bFTjMMOi+HtfwUvuIhNIa2LAQaEQK3WpGjmx+",
-	"IPx1Ddl8DCXg0qqDt+DBm69ZgBWXdLXOuFhAZt8rmnU8PhdMXTMm7P4JdNXrfoD3xJUe7Kg6GDvqqX8U",
-	"gR13EWugDlG6pb/WNRA1F3JI9WTyB2H2cZOJvlJ/5VihB9wkDC1Eo8Id23tHiesT0VZUiLWtvsLredca",
-	"i2roA5PrKK6NxsRZvWm1sZnELBtpmdioHi5J7XYhBsyCcTz6oLn6jsqAVlb/atknzK4EjcOM9/0o0ANQ",
-	"i6dI7wUYtJLgBl5qyrU9wLoU6R4op7UjrC2vDzusRuuB9FLGSrgRydZvItD1UTP1+WqmGnS08wlvaKeU",
-	"SeP6WD5xDzSm0OvUdMSLkaJFaB6sQcpFq7jYoW7pWmARpKqDceM1YTLopBviHdAxV+QadWxvrrm1lzRZ",
-	"mpS89aEw5NIOoBfsMzBVgtKPX7UQC+ufsCsedtI484JHC9MqAOntSg02Ju1wXwm8xd34dwOdm9//llo3",
-	"ejNS2lkq3SqfDuBdiaXOc88yWern2hmD9ToiWaDtwN92xBy7wb2Q4sDYQzIF3kB5+JHM9bXJg3yGYNdH",
-	"4fBfuKHsGrNWk8fcFYW6yNALXJQZ+KvYIIyA/z274nkpOyawTW4xi3nmXnGWpR2cASSrNHHYUOPc9KtI",
-	"QEVbHKo753/YgQsSN3wx/mdmgyns38pojYLw7tRE17iv+r6CyBXLt9YmLJGWA2q7nLw6ILqvKzgPMQi9",
-	"1VYwpt0LZ3J1gas4izaJummJEZvxLgTxaNFQt7PQ5rcLIDDVS8pI5ZKTPMvyUqHuEdODh1X6jt1Y5tfA",
-	"ZkBbkzQf/c0KHKvPJvYNVcny1GRZiFHreqO2pk+qgiq22AxX8zVG7ACGX2eyhqr+Z2vbMJsma/zV8Azg",
-	"KhhwFsbYMKR6Z3zF8rI3B43VZ6Hk1zqmTukhfLgYDVeUsK9vynTB+hfRbA+1mCE2+WxZMLnMs3SAH6C1",
-	"PoS9gHC1p/ZkgzfDnjvK5Dk3JVEQMAYpDfdUPxn/TtZRIXQzT+Xyjurcn55+11Xmfl3wK6rY92xzTKVc",
-	"LwsqWbxePX5HvaRcHru+f4wy9bUl9QaGm50DgIZXlA8hToxnNXwqR0WnKgthBGGIpKZZZt7cNBePbCV0",
-	"gsnfvaQmd6McSFy4f22F5WLBIHUQeH2ZJSRVsD+3mfqn5Cnhc5vkuskOfvE8qHAatQN3qh2IFAUcYkWv",
-	"RCGEo3X9jginVIbN9SuaLLlg0amul5vGBPqgDRt5PnmFNQnPJ2Y9JjU8l1V1BLZaq43J5g7J4OuyXVVT",
-	"YZ+cwDJJktECc+BY50WzWUDji1LfL4Zp5fMrVhQ8ZSSi2JTdF9mGuzjgkSMoTrFHzien+BidT0he+Du9",
-	"d7TRbNkOFemOAWkvQxZSEpmNGzLhMKBCuhC3cgrOuul+ovgV0yBicSFtyRfLnUxviujdEqo74Zlitio/",
-	"PgcGhFVkOU3RvM6F+xlrRE6mEzsINEhZ7c8Vhbz7VJgQn7lmEvCTqWww0PTf3uW+XUj704m34vbXw2oP",
-	"7Y+v7K4iE9qNtT+/qO+83eB1DRahVXvQaX9+a+FVnflLiEPvOXMMVq97K8Hhz3lWO3CbvMClMdgpSmGS",
-	"p2VcXMIn/If3hWacSjhpiS3wH14LPTNPUBqwM3CByr2JS8MGPwOHxDFd3wUkn7FYMp1shygeaF66fUW/",
-	"nbjFtpv8YLce+9TVed9Ap/3ltYVX7FPXsKcWpO1PLyogtz8eVmBvf/zWO4gAgnlH0/76DQ33euuOLwB7",
-	"/cb46PxDTtMeZNb3egAqS1VeaGTNaQrbEbnameclENkLmu5Ipsw1BTMRUNhi4aHvTemT28IprqD58w92",
-	"Rc0Pb3L1yiyw+ekbmp669TY/vjTrb/7+2u6n9aGBd+5DgL68FVxVXHUzfZWjTH0scOSFauYnDD5YcZbK",
-	"JmTRCFCPPIGEKqffWYklpWyFryh9/wMTC7Wc7D1/+tevovlbttlUkwR/QKzbZog62oNEfeH6h67ANT7h",
-	"U9i6KZ9n82VUz7gDR1EKYV9jB4Av/1p3VKE7vz3d+fvOu/8Kej7qicKrgesHZhIXLCnlMp2ZpKLnkyeN",
-	"qCDvYy+PBNPWsaR+Rj6wpzWU9KAYYpqafnPtvdUb1N1l/HyOxCpTR6+XP5nXSwNFtnN8aXa+W9+Xxujh",
-	"kJ1Ao3rcTqPBw8XuhCYeZNxrXuvRVeJzdZUIXb4+DG+F89TouNEdx8k5KPMj5XP1J3K9zGU1gE1EPAfH",
-	"/7xfI4DjD9msozDDQt6N4t/6JN8y0gXhdDf2doPV+6oj1Xet9LID7jWVaCz3IrCHpP3exjjeyqYfPIft",
-	"HCDcBgzuzeB8+Yr9Ty4amUB/yDFcobEGDZPfcsG81H7SuCzDbIf7b/ZtFpH9k5f7uz8cHeyfHR69sQWK",
-	"9Y91fgZLeuqTzguSJ4wKrLFqe7qkfrrxmhaKJ2VGCyK5Pgmultx4KtCC0Slw5objI/tQK5ruvmHXv/zf",
-	"vLickpelxr/dY1pw6zxeCrq64IsyLyX5YidZ0oImSlNNu1csFyzL9TovFEvJ4/PJt6/PMAXH27MDw2W2",
-	"yNNZfsmEl94mVE3L2FYLF38RKlHyC0+j/Q39rk4jXP86R/KasgUTO+y9KuiOogskLHmxmux5U32IWgr2",
-	"awk/nYWglgf0F/h5UVCMG+92Txi4tDxl03ylL7yW2e36fkFjUMh14vj7g5e4PtvmLtfiJm4sCjb9S9hG",
-	"b44LmrTN86h7+wWQoVmIBwAa1gP0L9dbEhIf1MD8UhY8ukbbiLw9OSSPLb3qPGnC57VKtfXMsAa7n9zV",
-	"Gfi7aBxBHZIBBzL4bEv8QrIlr8Pdom1t6MY6IdNj9ATg610tAwarTd94hTwcmXpkIMgKIEnDcla9NM00",
-	"C6cejx2RzS2MKcehUTg9GaieYt2xarOGS7zzL536n9pA3qdIrrQ1L5j8hYdkeUy4qVsYrzr9rnBhg3rC",
-	"bvo8jQLo8MUBOXxhoPz4nz+dPZmRY3xO/XLZ0M5kGGeCpxVWBWx9nbfG0QXv8oRdTPWXCAFEMDQp3zeM",
-	"FsFIwZCJHT1WTpMlS02YcdM9qCpHKk0rS7ZyzRclJM2vhbHOAI+B/Jv0y9QrvrJfXWp2hV4yARG012nl",
-	"oMhFvY6uVLRQ3xY0YS+84OWh3jfK49Y6hVHbriX0qElwDaH7/lay4lDM844rr7HMNovf+chtfdl9TcNV",
-	"Ll6VWQZKv96qYAGhQi+1lqvw7jJ1BipitRkT28aVwgrboMuLkJ8GyvpdvF7w3nhKkvqpXMX0j99y5Yun",
-	"4TpNEaHGDvpOz+zVI25N8rpdE9lkjMOiyUOjBXAciBcwTgZVOQygf4Zzh8HxSsu6WniXqWRXLLh4v6uX",
-	"M0v3irw/KXLYkx0i6ZOy4GpzCiUTYOUXQOdsnQn865UVGP/509mkKsdgvlbzQ1YOxOxY8vy3b8OJOGuF",
-	"iTwfXkJe0zUkmm6kFq1Ki80scuo3bfLvkoE/P2K1XopmEao7sObfM8NaaCnUiPaKJnDuUJVtsjdRjK7+",
-	"j0ujPeN5NaLexSv4QkwGfnLG6Mo4jZrCFHu7u7XerRpTP9eHePc41O2JUbUhQhvPvSSjBeYjW1FBF2wF",
-	"8ugcdSsgPbN0wZynqX4d1JLxglznxaV+UeTsXICFOmGGUJqd7a9psmTk+expazPX19czCp9nebHYNX3l",
-	"7g+HBy/fnL7ceT57OluqVYZ0XwGuNoC0f3w4mVYXeXL17IIp+syUyhd0zSd7ky9mT2fPTPAFoOOuZtB3",
-	"E+dcuAiplr5lqpn4vVU9wrnBaMwE+dB4LEKWS3gLYMLnT59anDAVD2mV42/3X8bTCCntkHqIZhZAuMaD",
-	"9L3e+1+ffXVn8znteGsuKK0h8oqIsRQmf/73B5j8LM/Jayo2xKgYUH+PzP/Pk/rBYTkSPPVG4s3o0UMQ",
-	"aG96T8i0Uc1lHrYwanzL1LE3+T2iSCNtaQB6nYlL4RCfPnuAQ3wrrKhsUOdPibfTyd/u8PDjUx/aknho",
-	"IiHovjDs2tjyx9E7U+eEXe5Eclzk721xPqMJsRlsK/DHKmxgkgNVcHaFCW99JW/4ltkl3Of9askFIdRu",
-	"rHa8VOOlal6qK5rx1PiaBC/Vj6YBlAWuXxGnhmhfAdsLWJ6CrphihQRDVZt1Do2qb51dmmOBl4ymwJZb",
-	"vs7XcVr+nQYSgGNGnXu6iV0ooXcC28Cr9xCTfkNTi4IPd9/PTHxatdfxwv9BL/zv9mHTl+jDrlM4rvNe",
-	"Gxl7jyk2Qk+rb0STW7yuj4/3X5t6ZE/aBg5j4crya9QjgFXJKBPChOfMGHA6qc4bL6y/49kvZUV7QNfg",
-	"KI8Pw4mvlEAjQQ8hAiB9k6ebO0OVmqFTn7U/1Pud6+vrHc0F7JRFZuKubjz2h+Z2P9wjba1bO6KExyk9",
-	"75jK9k5fI7ZDrp9FnLjgB2KRn8SvnsKijvG6sd9W9mE+FLC21U5tQ43roF5yKTPKTHnuSuhHi9X50C/K",
-	"3B0YQQ8AtetXVBkvjVqjR+hdULJHGOFuVYQusB5EXHuEMX2XHaTzmZ+GyribOoKYq0wVPKkL1hhs5+oz",
-	"S1OlnRdYGFDOyAv0yACqxq5YsVFLU4IltFDodeoF3D/QajF7wNRSRy4WJkIqLzSILxl59PWjKXn0tf5f",
-	"KlLy6D++flQ57V6yzTOsovhsesk2z/8D/3huXCtCO4UZb7ZTjUkr+p6vypWtr4+pkQDObpMGU+oIAmho",
-	"XLuhCIE0qpIYotW6Ez6vYzlUNcBBbX+DvysKsIWC+K6qJaHSuziQGE+WFxKCiBXeoihm8BW4M1dw6o3d",
-	"vFfG1SccMSWN0eV9vpxrS1J9+sUDzPoqLy54mjLx0dnVh9jtqdHzvxVO19d6LdcuZe2HaYQXPYBCUVoO",
-	"DT6P7dcRO9QS4t4P+1WbYhCL9Owe5w5BLR2v8b1f46cPcY0PcjHPOFqVR8LRJBzvd6rKbpM6v93iwHd/",
-	"BwkY6UzGVNCdRf++BcXBDg2K06sA87PMBSfS7CCuMSKP3kwOfXCFmOUm/jQU4a8PMOWbXBEMHR1JQoAk",
-	"xA3rg2/1t0zdy5VeYAaXP/p97uMwxls93uoHlxCoSgKOaFDPeIubDe3v5W7DAu/0dg8VW3Zg6v/a0l3D",
-	"LwT9wEreofRlFF4+L6I2yksfn4yWAeYInfy3oKInWC79XuhoVRfgwQnpfep/Hpp6jhqnkWiPRPtPoeRK",
-	"qnpzEuvNWbeMbptztE5dnwE62nG0Ro/W6NEaPVqjBxHIKBUZTdOjafqjPb7Rx3SAnXrAixqzWXfVjL0P",
-	"ASY+3wNbs3sWMgoao2l7JDwNEaCD4e+WBwZYwFNjAfdpGTE3k1Q0KWQF76JhW+mG+snoaB8f9RejJe0O",
-	"6EpQO1AwmqLk7cSOpONut2znD0wI7syqDmmP/12yQ0xNAqzPxxGBRlox0oo/nvDTaYK/kfADfR+YXIyG",
-	"+vulT6NcNhqARlHwHslwGWTZwCLf4NoOBnNtxqL/wKT4k7D131JV9lGp8aipG1+E8UUYlYNbKAd36Xpd",
-	"5FcUKlQH35p9aMAIJPETmy7Wv83xo69ZtMO+nfzO3huVE1pf8PjejNz/SOtHWv850/qKimuijylUKVRI",
-	"l7sFkyUmSg6bs0/gu8u7ekElS0ku0CGp8hGiIt3NjeOP+zXkK6xHw4o08p6s2Tg6zvSRiGV9CfEEMiOd",
-	"HJ1Y7p2E1O775B1QjQua2CqeMAbK3pix0tITc+UthfjQpDfN74609Hia4uXocyutaMToQzr6kI4+pJ+J",
-	"D2kARy7yPGNUkHlGFxpPTB0jkotsA6tZrWixqdefkzPyk94JgConIJzZDPsIFoCkqUOAQ8HNN4P5SXyx",
-	"CBkAPL8WrHiE2FTD+0cVjJrFyKDiyyMzsB7qEeESVhSDm9c2hGUGHvfshoL0dfSuHRmTj8yYDHGlbbAM",
-	"Mb9ZbHavYsVDe8T6s45K9dH99U9HGUIihy9rbJHHqZ+MYEtHRrZSOjcGH51SR63q6Gi27W2Pp2vqv7zf",
-	"MnVnN/cTyc0U5w7Gazte2wdk37udQXuvLjS8s8s7+nTeIQEZJYvRhDsKM3dFJ7sSLvWTSeOXeWeE8pPw",
-	"uNxG7/JwhHHU8YyUeKTEn71aaTdlSb4yZUmjPpCu1n1loEL1j9e3rWqqPt6hwqka9JMg6z4URt53pLij",
-	"xP4R6V+d2AWIYUalkgwLBnaXraZSEd2SKL5iUtHVOkK1OtR4P1CpTll/Ob9h6rzouubop/hpqPocTDoY",
-	"07+2z+VNDvXq9SJGGjPSmI9JYxwNCdCXgomUFVidspO+2IaG2QoSkRPT5i5tAqHJrSuVqSB6h+Qk6GUG",
-	"JOxS5NfCLeRHVtQYvoa7ETQ+qbed/FEtFiP5GoXSkWDW3asNUQwQTImz9pFLbKZJ2zZmVLOl0Zg6GlNH",
-	"tumPYkzd+jp7ptU7u9CjgXVUMo2UbKRktzF3bk3IasbPOyNlowl0JF0j6RqFvz+o8GcEPC36MVHkWbZi",
-	"QiW5mPNFp9RXNa6FuoWEvZeu6QGOuwVRpQNTe2Ew7hzyBBAuZVlPIjsjh3NiytikUxeiqwGHYXxLllwS",
-	"3pfcxUT7yfAkENUHEZRckoRK5gINudXrmSjNJkRm5FAQmmUkV0tWQF9cpAdlfyIM1oSVXzDCVmsVDaFM",
-	"ZPHRVHGtgx8p/cik/knobnVzq3QqdSI7rGpWdYcGVstqdRgzHIwZDsYMB2OVrC2f7LE61hi//0d8RPtC",
-	"+UXHkxkL62/1uKcI//Y8DxzsH1nA6BM+xv3/mSlKTTMS4NDDjPsWiQG2I0rYK0SUtlJGx6ccUweMcvyo",
-	"sf2kSFQ8b8F2tKWmj70XwvKJOOMMYoVGAjMqCj+OjNOZ72C7Kw+d7vnSjw4790N4RvFrZKdGduoe6GtX",
-	"noTtyKtxG7pnAvtJuBHdUL/1UWjrqFYb6fpI10dN3u1qUQWeivYLYXrdwwvxyVWbam3BVeD62C+FXUi/",
-	"tnGk3aMG4k9PSesVn+IkdfsAwtvrM2/muz9qNUeaMtKUj6fVvBUZCOs474MQjJrOUdM5UsBRIv4cNJ23",
-	"Irkxved9EN1R+zkyfyPz93kLlH4k4pVeSVRoPGGq4OyKSUJdEAR2mZ2LcFAMDtgXCPOnibU4zQtF8iJl",
-	"BcRMmrqGEPugN2RTF9bjXB7pMR6Rx4Jda/o854VU0cXB4LVFpTgUxJ7KZDKdMFGuNLpQ+At+fDe9aZwI",
-	"nj+emz4iG+jRF0N0NyUmP+sIqnvVV+hjG2NMxhiTj/dYaQwMPFD4YujXaJ4x1hem+Uq36QvNfIUDjeGY",
-	"YzjmGI75+RacPjRZH2KVpe2mga7EVkJTk1dWnuIgH6+QM5Ct8Y0e3+iP9kbDTRlSxrn+DMfCPaHVPYV4",
-	"4tgPHNbpTTr6nI2hnH82olBj3A23XjHuu7/Dfz/sKrZaZ1Rp/lDyXMQ5euBGbGvimodY+jPT6seqUa/a",
-	"O78WyExpJqA1TUTJPfdo1g2Tu4+CxShYjILFmOdFk90G3Rq5+5G7/2M+5O1Xe8DLPiAzQ2oyM7Qe4Eg2",
-	"hsaFufU7f3/PfNOyPnDmMeXDaL4ezdd1ehSUDgpGU2SNHV/QS0O+ZWokIA9JQJrQHinJSEk+Kc5mcGqp",
-	"Xp0nNrQ6z62c8upDj1mjxos/Xvy7YCEgb1Pvxf2WqTu6tXcYvPTnsHaOZGMkGx/XztmZ/6mXdEC7OyIe",
-	"Y8DT3dGOUY86BjmNVt87IpFdKZx6KaSJXrojGvlJxCdt4ZryYCRx9IIZSfBIgj9Xx5tBKUBAn15FodY1",
-	"65Y+hyXjm4Wa3qt8PIqmo2j6JxZNm0V3hwuqd3WXR3F1FFdHIjYSsRsIjwXKhFsyI74keVdEbJQnRx5o",
-	"JB+fljnfy1+B3uOD8lekXCouEuW8vLGvS8tQUZ+KPmzWLJbo4geceQAB0qMYx2tHdgqzMLeIIl/FTHaX",
-	"XKSdVMimdzBV/oekdtgnc56ZoITmWnKRbTAswUVZE7WkfujBgl8xge2dN/29uOrfwSrRS71vlXfuZl+h",
-	"G673QfJl3EwmZu/pap1hD1ztS/wFrroxE+9NzI+Vk7++OZm9BuDNjzlprniRixUT6ut1kadlotALr2AL",
-	"nouvS7nDqFQ7z/QGOCu+vqDJJRMplm0eRlng8o2u9KMr/Ud7oQDv2y+UuQ76acqLBRX8N1jWdhmWaj1n",
-	"hBxpUofEQ9Y/IsXT1KSUrCBLKglNEiY1uQlnxjiqrerPmqbpPnWHPoRHEjWSqAcnUdWLDQlz8saNtxTM",
-	"/71NyOq9ND0r2DqXXOUFZz0pek5sy01fnp4Tf8wxW88YVDsG1Y5BtQOIYkVhxhd2fGE/mhDgnsTNkJQ5",
-	"gWcxljenanpPyXO8CR44g05z5tGBaEyj86ekFjV2u8ZcN7ntbWLUBhEZbF0jMluZ0QKTjCFro3FrNG7d",
-	"hA50xK0NuszfMnXnN/kTcdPr5iXGqzxe5QcWALpjyQZdZ+OmdscXevTVu2OiMsomY3DDKA7dJe3sDDIb",
-	"RDqNf+CdE89PwkdwW43OwxLMUYM0UumRSn/+Sitj7d2IpNdGjE1PNyLptxJXbUcz8WgmHs3Eo5l4IKdQ",
-	"EY7RUDwaij/iK1o9jMNMxYHXMW4srhrfm7nYm+LBDcbNuUeGfzQZ/0npRoP/9tntFgO+ndl4EMGxhuMa",
-	"wdlSxRKYaDQejxqA0eJ0M4rQaT4edKnBgHwPN/qTMSJ38xfjpR4v9YOLB32G5EEX21hR7+Fqj+bkOycv",
-	"o+QymipGYeluqWiPSXkQEXVG5Xsgo5+IYXlb3c9DE89R2zTS7JFm/ykUXLbsV8y2rAVfaeb0imi1BN6q",
-	"Nti90a6xINZo/jFYbrH2HfRFyy4yDmWRTfYmu3TNd6+eTfQTbvo0EfvIYjAmrNJnyoQyG5l59V1qHyZt",
-	"w7Q3UC7IfqmWx0V+xVNW1N0wvPHWpkHvaAesUHyu52anfCG4WJizCA6dVK0lti7cO9c9Dya6Cg6KtXC6",
-	"R9AAxHaEJsqWAq8NYH7vXclLUeRZtmJCde2UuVaDdqjXZ9JdcbEg7EqjoT+c/qF3afVch35/zK62zRJM",
-	"DiuaFLmUJOXzOSuYCI+OmXG2Gd3PmBIcspaqom/fsewTZizPoal/pJiPkhvLe70G7DhhHDYceKHMiJZS",
-	"fHj34f8LAAD//yLankdD+wIA",
+	"H4sIAAAAAAAC/+z9i3IcN7IgDL8KTu9GSJppNiX5sh7+4ThLU7LNsSXykNQ49phaD1iF7sawutADoEj1",
+	"TDDif4fvDb8n+QKZAApVhbo0b5Ls2o0zFrtwSSQSiURe/z1JxGotcpZrNdn790QlS7ai8M99uj6W4oqn",
+	"TJ6uWWJ+SplKJF9rLvLJXr0Bwa8XTBGak/1c8YuMkf1CixU1PchxRvVcyBV5ur9//IysbV+SiHzOF4WE",
+	"VrPJdLKWYs2k5gzgoGv+TmbN6c+WjPBcM5nTjOzvH5P940Py7uRnM4LerNlkb6K05PlicjOd0EIvheT/",
+	"gjlahzvaL/TyJak0JixP14LnunXsJOMs14dp55jYiBy+6hjilCWS6SHDKGjZHGo6uZZcs6M820z2tCzY",
+	"zXSScrXO6OYtXbHm0D8WK5rvSEZTanbLtiU5XTEyF5LoJfMbFYWc5aajXfucFpnGiae1iX5ZMr1kZkCu",
+	"YLf89nNF7CDBBBdCZIzmZgbX8Ay+xHBj+hAxh31jueYJblwIN8uL1WTv1wml68n7yDJUItZMNYf/mStt",
+	"hrbox2ZECyLZPwumYAu4Zivo2hjV/kClpBv4W1yyXuqDRn1UdzOdGAi4NKj/tYqjqTsyEbIPYAgIt0aA",
+	"Hh0lpsTFP1iizRr2L5TICs2OqV4213HC1pIplmtgAtS2JXOeMbKmetk83uvoOAYfvrdpYnBOcRyRA1mq",
+	"jdJsNSNvhWZEL6kmNN8Q9oErzfMFNr3mWUYuGBFXTJqToRkwGPaBrtaZWdfuFZW7mVjs0vV6lolFFNNN",
+	"HKz535hUAGqDKx4f2m8kZXOeMwXQXuFvLCXIYg1RwVmQDmNItIaMc4JTzcgpk6YjUUtRZKnhlFdMaiJZ",
+	"IhY5/5cfDUjSTJNRzZQu+eIVzQo2JTRPyYpuiGRmXFLkwQjQRM3IGyEZ4flc7JGl1mu1t7u74Hp2+Y2a",
+	"cbGbiNWqyLne7CYi15JfFFpItZuyK5btKr7YoTJZcs0SXUi2S9d8B4DNzaLUbJX+D8mUKGTCVHgcr15c",
+	"ME1fTKaTecYXS53ozExW/tw8rNPJh52F2Gmctf31up9DGBTR9TqzLCIEBe5BZU7PPwuaZnAMzFIpz5mc",
+	"TCdLlq3i0JgRdq6oNExTmaEsKAd+RPvDf/mBfYtyfPvTjzANrseBaZqxHC4GmmVH88ner/+e/E/J5pO9",
+	"yf/YLS/wXUsMu9/zjLlON9Puticso5pf4Xk2jSt8xfzY5AI1+F7nV3+jEk9z5Wyz8gNNU27a0uy40qSx",
+	"j9XNe51fcSnyFcs1uaKSwy11yTY7QLVkTblUU8JzAxdLSVqYYYgscs1XbEbM3l+yDdA/9mA0WZJVobRh",
+	"CxdMXzOWkxfQ4OVXX5BkSSVNNJNqNmksO84KSjR8YMmxFBcRGoSfVZ38yMXGgJobkCkxJ8yAwXMicqBW",
+	"rhXxJKhwNWszElFFkjCWKsKRpl1f9sH0ueZ6SZSmulDkeZPj2sbxk+JGMv9nxqJyURjsq+6bbsXzQ/z4",
+	"onntlccoOiUIG8ItxDaFO7bIK6vj+Yy8QiHDM7w5l0p7LJbd44e9+xZ1iHnfvc8/Mprp5cGSJZcR9m93",
+	"CC6kxLTBbV9CJ8veQ6jI6w800dnGbbrhvVOik/WUCEnYB5Z4clVrlvA5Z2lzT027yV73SY+S6s10Mqc8",
+	"KyQ7W0qmliKrynJfTGM7VqwuEMeJyBVLCsNAiBmHpbh+RehcM0mulzxZNuieA10rnjLJUlLkiJyNWZZ5",
+	"IFA92ZvwXH/xcgKUxVfFKiQsnmu2YNLAbrC1xbp/1Hrt122GkVc0q6x38uK5mtTX/Mo+TjzDwDXOyPcA",
+	"7R5ZC8UBBxY0MhdZJq5Zao74E/UEZGnFEpGnakqerPCHFc8LzcwPS/xhKQpkPGuqNZNm6v/764udv7w/",
+	"P0//9KtaLd//z5gMnkdF+/rRApinpFAMeIzlDyumFF0w1TxZ4Z0JfaPyv9JU6mL9g6QJO2aSiyr5TLqw",
+	"iSRSJw4YUTlWjgRUIy3JCF/kQrK03IL7x/z5efrndqQDC1YqfmpeDD81dpx5kcVODs3L49FxhHyLBeX5",
+	"LY6RTrY5RWdJeYjMPSsKXTtDvZtuV2eZpdleI4EaPpcL4HWZyBdAHBTvAHdYP40zdzP4hohIRcva13ZR",
+	"YcFyuNvgTMJlgvdKhJ8atIk8KwWKi0ITminhaGNG9isXD7leCsUczZkdIPgaEVKzlFBFUraQNGUpXERU",
+	"ESalOXJG5qBZ5gSUYICKiDCUIweXaePF3Idmz82HClwgGdGc/Hh2dkx+eH3mnvFkLsUKWqfsiiesS9aS",
+	"TK1FrpjjoIlImUGduxtePn8O0tMXf/lL855eClU7K5lIaAY/x3iG+WA2X7E8tbMjwFpE2XH5mnbj70bH",
+	"Ne0ccw9UGc3xhGxRSJkv7imOSOuAs8mO6AdkR19/9dUXX/WxJ6AbVl2X2cLo0rBxfXGw5afuT0VSAUfm",
+	"ikk+30BLBW9tkpjNmhuCYeHz0E4Howx/BjYo9RSAs4N1NzidvG+oecxu9Einx9EdM7+SFV2vDWvgOcEN",
+	"IedAkObjnhedzV/nE/KUzRazKTmffPP8m+d73zw/nzyrqk7s7807c8/8T/TODMG0GqvvqIoc3wOxWqEG",
+	"z54dYNWG64Sn2YyvYgpjrwnoYULQrFOACqczrbws/uL//f//P9UHI1xZUxRfLKMhGTOYIULayx81MRbV",
+	"JBfmHtRMrWnC+p8nbl19BFBX2nOzqBXPqRbwArNkYJ+CoKJoQZHVYASDV5Qirb1sg2o/UKC0dDFaj2pr",
+	"p4Rp6WBVKWGfG08HVtftEXYznYicDdCbRNbbpz6JAtI3SwQ/fZ3qGKrrYE6seu1nvuJaxRSz+J1k0MAr",
+	"92sP5JqmYF1EzubxOxyE8JwkQpbvICKZIV3QxFxQxVIi8saBrTKR57P/9VWMU3DxCzMKwdhCUF9FrqFB",
+	"5KH/RJGLTCSX5PDIqA7WVLKUaEEEGCCChmpGAgyi3kQYscmNvaRXrPxLzMmL588r8L98/jy4y148f/78",
+	"ed9dtmIrITfNdb2B3y1mgX2INSrMSJFzfQccv/zq69XWIqyjlzci55Zv1BQdGWiii4wpJwY4FS8pzJMy",
+	"put4Bx/WTCYs13Rh33LSbal9eVb3EgkWhFD7PaFrmnC9cXtvxQ/eoASypIrkAocYSN6RdRmK90uqHxpy",
+	"lFvlDYWeayaJYkau0BvCFaH4KBksGzvEAxwnRcZihqQ2IooAj00/MfiVoU2eLw4rKphOlQsuADp+KpqX",
+	"2v3cWNT7YUfMXdRV4vT2ki0eUye+z9DjDQey47ZoPclANf61aFBoZYkpsVd3KOrU+W7bUexa5ys45R5p",
+	"8Phy13uyLo49U4lfWW0HgFBFaMCS3LvWX3PAeQS+eQ+O36km1+G6wWgs7lHqq57Y264RR/huo5lqvT1A",
+	"uXexaayRG1uDRmE5fIt9/eWk/Y7qwuibbq7ShtRVcMk5vAZM6haYravwK4QQWUkVj0A5/rehB7ZLvEpE",
+	"rrSkPB8qY2VeYNvykFtJz2xXeUdvOYa73fvYRUCJjaXjNzghRPF8kVUpoaIiCF/Ux5KtqX09n2oqNf7z",
+	"BPVXk+nktZRCTqaTd/llLq7No8DI5xnTLN3+BY5QhnM2PgZANL6VUDU+OTAbH0q4G5+ChVQR7VWrW5gQ",
+	"xZpZE+LZwbEhwJwl8G0LpVbQK6Hm0iVMaXqRcbWMmZtuq8ay07Rqr7bUNlWHu5OS6RaalnfrlGrLpKMc",
+	"ocj88ySjSpMCOjRv0+YZqWI7Fddgz464b4lrUDs0FZ1GfyuujZaXZ4yoa66TpSESK0Ln7Nq5pEyRWadO",
+	"4MLtMBSyNF/OJ1/OXqrzSXTDrPWoxa+MeL8yyagClTNeTRYRBkgpsgyeMslldAb8/h2N2VpLn7LKgpy1",
+	"SAurjKFWJ7mW7IqLQvmGBgBowtLSdNL0QENw0/0W2jRbE90AxDpCUoOxQq9m9B3Y4D4Rs4RkWlJFBUl9",
+	"NKvQCl+TNYt8X8UXVygmQysiel3Bz+i/FC7auildMDDdF7lxviNnppW1UMAIZjSKnAyGMY9dnoP3Vunt",
+	"EBMqnvK5+/siY8+aJksLFdV18wlV5OmC5UzSzIitQuhnhvMZkCpG9S5Xo3cWE+HPO+qSr3fcQ30HPPaY",
+	"RA/Ivjv1byIrVqzq1FN7A1n/MQqqx5RcQQ8v5NVE8gbbiGs13+X8n0XVMhyOazej33fCEGaSUb46FhlP",
+	"NlsIH7jwk0rvOqED7BFa/vdATd7hii4YTlTRh/ap2d6IIte36AfztXZ+X9fWRRo1DiXuSodPang0bOPb",
+	"WOAsHW5rfIvtYkUiOGHmKE+mLUS9FNfBKV3SPM2A1C0xXi8ZUqG4RuGmau6UbCWu8Mw6edLO977b7oBg",
+	"t13aoSx7L6ftbeOYtRylOZMsT6JPcfvJMbmUrTOxYSk5OjjcMVubcZprwlfwzpKESs3nNNFwp/J80T13",
+	"7NyF8PTcJ78IeZkJmg5B6E/FBZM500yRa9tt6sUMSl7BulYs11NiurF5kZ0ybZb0irKVyE+ZnjaFp+ZO",
+	"XPI25zbzxe1GE4QhANynFOQgcJejabcJMbIW4KlzIKla/izE2lzwR/P5bLgPUEh+brrW7mCGio8BnwYN",
+	"BIuIY7/0f4FGZnmKiEKXz35lyND+Pmgu9BWKwBy62lgFlGtNxAWYegG3esn65gvt0J7KB/LWUINTO2lA",
+	"pVN34Er8OxQGy/MT9xxGdVqsVlRuBr7Wq9ZU1f5SRx8NA9Ir6xISfZ2/FSEs2z/Rq+CXk7Y2CaBpbRN5",
+	"nVcbRF/p1Sb1hRmsF3p5AHFLzYubVqIDuinFt7yZuqvTSQXdp9k27op5aZwXIRc0t8Eg6nUYuBN7VVVa",
+	"g53GhumgLb4yb3fkTpcMY4jwivLMjNy2mC3EmkIvPf5iEk3Vnu6xHz1YhV6+2uR0xZOjABX7SvEFOKRH",
+	"zC99XQiFfyp4qcCzpYrl0qJX6GUQIWdkrJjWHGSv1gCav54evfXBM6DlMe2tazLyRXyGhUAQnpotmHMm",
+	"nQ/Ir+eThRTFWp1PjEPI8/PJeyKk+TkplBYr/FnIxfnk/bPtIqLCmQ15H0s25x+qgmTcgQkaehVrZQXw",
+	"tvH+K0IudqzzSueJMNOfFvNh06tiPnD6HcBLfHrdG7BSGZh6Ogq5c4oEFxF8a/SuMTisJJoeqj8RGRtI",
+	"7dWmhH3QkiYatDtMoR4yRtGkUKi49JR6dxo3U+4CuVpybxLxe/gLYPN/MJqtfqPgiovk7D5vSdCm25vS",
+	"waYt7GX4gDW7C10ruy6M23LLw1OrNyWKEQdw0T4LkYM/mT83ZCFprmfEAMzS8CsGi80xDgNPOTmBzUQz",
+	"/EXBM73Dc7vDaHLiJl7BBp7hMBY4H9y1Y3BAtZDPwIJbIe8dCDp08WhTtAshJlFOYV7PswatnvceV2xN",
+	"YdQZ+RsipfTk8CNI+5SjKlxnkSdLmi8q2p/yMPiBqxxhr8ESTl1D4AhCLvZgDutl99R2JU/2njybWTxa",
+	"BuweaH4qgFStM/Cv0SKKIkC6G8ittTrCIhMXNAPVp0HexqAOvHaD4dQtmRKs7bGY0TZ3b7wtSQOVA168",
+	"1jLCVY0tUekWZl2G6tjqcqZza++QTbrlielkzSRqaDvEG2zSOoTSVHcDcQotWgZoetHprVzoBkzQP0A3",
+	"moaM0I2lmzZi6+4WpbnOLiSRjGrQa9njWZMVDLuAkERDl83Lb4h4ZHoaIWNniJwEja3KO+kSW/yoDy06",
+	"DYbowQUpd/iG8a5WEmp9voVfiawGyscfPtXUGEQV67UAEzW5EHpJjg5fHQCHx8wB0dQZt3qJxjVoP/Ec",
+	"dFXUmQgxotavxF1lJ69Pz0pfQOCyiKJg0WVou5E0eD535iRvs/YJEPDhgmkvigtwXvVe/FrMyAHNbcyQ",
+	"tY7NyGFODuiKZQdUsQcPbDdUoHYMylSLdlDTlGratwVHgKM3TFPTS60HBHQGBIWGhvYX7tQpnDw4do4+",
+	"OjYv9W5aNi2QLjL3qg8vVXV/dOml5hZlQmPae1AajKfho5wGs6d4FrajadzxPqIeEhtB6bqVYmqpkaaT",
+	"y29UW+OfvlG1xsIQ6stWPgDMvN6Fp60ynbkG6s3XLFdLPm+Nnzhas/zUNKhZOevCXyWxzGAhsAFRn8gW",
+	"WXNvl5YV9Jx1ut6qfX3zbt5XqbGCH6cYHqI4qbapPFHwSV1/inQ+XO7vaVKDffh7otbx/t4RjYEHvx/q",
+	"Pdu4Qud7Jbp7XT28jtc8t7ufm5DTyPpMIp4rcmr/e6A/6j/sYYzqkgVwufRIjs4eTra2VDRULdBYZ/fW",
+	"DTlwsZblVjn0K6adhkM5lUnvyavuEfSNI8zJR6EaTlggKrNtmVbsLhqbLXcGVxfbju+oTiJKWvgZBKWc",
+	"sIwB2nlOLuBnZUSXPGEtbtHxRVm3Tm9PljVHczRgAmqto6E19MKcs8lQFhR4jRum0+U9+h6UhRlLBrhh",
+	"/0wvWHbqGrdkkhgK103bRpxazLZsiPtcyVHmyBPwhAi8YJCCptA2aUjrfqnW+far4+KM3GvUBonoSFt9",
+	"eYaUllSzRa8v2okJESr0qWteJ3U/TozMD/YPygjxw3wu2tX8c5qpRiJE00XajJj0ApXUB/v1sPOaRwvb",
+	"tKWXXDJjVE9EylLzIDBQQsarwIpnb4LmJE0vEKH357otT5P3c62ORNiHNZe12JIOl1aY5js2F5JtN88F",
+	"S4RhmVc04+nw2SxW4nPZj4NxVHfigI0p5wjXFqAzTkcnAnP+YdDPoVJFDPO/QKaUg330oTrYJ9J2I9z0",
+	"SK2dJKcL5K0hxqCLc/4/Aqs+BtUY72Tb/WJDcsa9H7X3kc5F6Vh9sD8l1k/CBQzgqDY6R5eRYLxyubxl",
+	"15Pp5NiOOZlOAIjAa2SYk0gcVzh4/FswZbyBAyT+1YMX2SinENnizMeGqGpMEPViIZlSlTtsKUWxWFZ3",
+	"vskgWhQjZ7VRHZNnqZtgMAeuL+HYDhuPTr3jG3/Qe74Vou325jjAjzssQ9EenLXy+G6DRdvrZtoc6qd7",
+	"4vng7TGIVZTHempOuTvSs9unHCtdyRoDaFkord6y64P9bZF2Zrq2ea6G40awOoiSrPPXHQipXLyKPsSG",
+	"HmvYtu82Hk1dTpUly6COHxPatt0l8y95/MDgUC00zYYCw/PG4odOYzaS54tbr90NsO0K6+8eWG4dnmlt",
+	"b4aQFdJtq7+dZz4Iv4otoCEEzIbey8gNFOEWMZU0vP8HQmHfijtfzLBEHC366a1o+xK/cY+X0fRAcK+Z",
+	"TxF8HCLFHRcQvGjQhy3BYnawj4o1QAFLm5FhB/sENQLBYVH/P0fGpy6ga8CQdsM6Rjthmks/GCRvCzpy",
+	"5YasCFQiN1yuXJ0JnrVQmeBZHHLr3QM827Frv1amqn0LZq598YBUtrOMEtiCr5Yey+jBVCbKdlm7eMKe",
+	"mK2zWQngZ74A1xpEpGIaw71C4dkbV2MBrpahbHs1uZvD3JDDrrb6Y9LoTR3RD5sZz4jpZ4n4ltNalN22",
+	"N5DCGV/1PercuUBffLdP4QG5ZWwkoi1cR5Qp05zGMqfg7xCOikEJFCDVbLXOqPb5263KdmU0dYlYQlRy",
+	"ef1AMmIMs7YfIV2Y0nRjzjfPGWb2iST0LLMFKEEvy2jcC3hMuvQ6/q0wzxiDJ4Sz8jVlUwBgsGLFLNwE",
+	"DB3l32M64N6KDkaLEsBiOnv/PMdOG+jzYV1W6VVT3ekSc3NqduKCZeK60kE7NdmM/GIGQ57hvBuNXgdO",
+	"fKHWLE9Ba6U0oy21JQyuXaaZ3twcrt1DaJqmbr+iNFsejhN2JVwKB6pi9QfOlj7QiFZEPoi2Zlfi0tiE",
+	"wY/U7tbJ9wfkq5ffPCcHJz+7rpDU0rFYI9GbSyO8iN7lPnB2Mp38xDYmpYEUKw5n8LRYM6kYxmYcMKUA",
+	"5KP5Ebh/Vj3uO2+o9pVXAehoWIeto2kN7NZ28RW17xTY57e89k58Ak27a1UeWU8brpjkNLNxT0YGQZ70",
+	"NsxZaG0WKXIou9NpEEMd4LSSQuzfk3I0w5Pl13TJry7UKpPP2cvkS/HN5VfzfzxPr76k4p8vvyye0ytx",
+	"sczm6/XLIr+SYvH1c4xrUug6UdmRm/jd22/e8gIzZrKNv3R87QyKpi53Alpi11Q/H+g6jWAGKDdiwPMd",
+	"WgcvGeCA5Qzl9s+GpXBrgQ5T1m5NgtgNbV8ARuyuruetAgQHcLQopiqbhPVbpN8fDICUlRwa1s9lSnie",
+	"ZEVqQBG567ukZosxsPCCsTzc6IF5y+pw9wYQxZbaw79PUTgJWEJNDGlrWnEYLNMWCwkWZHASCMnGOw4d",
+	"7De3aHQL/F27BbbSkPPxGRbG2j4Mdr8vZ8PWeeKeh53Nq0r11qaP5pHYCcEwvXvbCKOn4u/WU7H7ADcV",
+	"iJKu1xC8Ioo8JRRd6jHyICUHpydTshIpyzCy9LLMwsAFIJOu+axyp1+9mHWCECs/s+YoBp9iqs1YHgvo",
+	"j0WavAoHbLlcb/zrt2Z+beT0aiqKIdjwIWLtXpuBCdVIXKUmv8wy5HAMF63B81qsi4zqUrNualLaxPIi",
+	"x/Zm5VDNaLUqTIo1Fg09k20Swhk4sij29ZdeiDx+/ab8908Hp//jxXMDjnnlWUeOJRYPmnm5gbMMHDpo",
+	"SA9dwgdyhcqWmIySUYM7X+RMxuX3wzz1Ep+X6FiKIoxNjw6s6p8FzeAh4l+ejXkKHmF27w5fPcI+BUBA",
+	"9ZwIHPC7zy4F3Bd9y4ylDnsF67cqYWuWqR2C4QTssnV15w54BMQ0JGWk5gpxbMf6WhLMlARF1+Z9S7Pd",
+	"lOWcZru2rJUr0yHm5SqDzLSqBe9Q5cTVk4yF3tPvijzNWp6o4Yms+pSo0Epjn6ag5/ccMHjHWuW21Wsr",
+	"pt2u2A/Qx8Juh2x5BeOac6HJmknFnVmh9ywHY8RXamdvPkGmJX0QkSesJK1hE4scubmKVYdw32xgsM+w",
+	"56t9/AQ2sSRoKJlJ9i7FFUun5BXLOUsRKd9DtsDhApkbs/d5GCwhSurNYgODCzy2FdK4mQ7u54o2btGl",
+	"UtboZrp1ttlhHvqdWdK2zADXVhajN50bKOtbe7+/iW+oo4zB++i7+N1bR6pjDhwjmmaoJVbx/bTtTJWM",
+	"MWWa8gwNEyJnhJrLzGtjkkJK4FHau49wBdfFiRcWQqTEC72YX8tjSpSWBQjsNo27eZoEacPM6KEUT94p",
+	"a1EBdINxJjUSsI8nNcsmRq0c8TCmSp9JmitEXqvlyrQrzVclrNr3ZSk+fwyS7G1jIMmh4MRwR8WhacRs",
+	"O8Lx6jM4cluFDqUIsQcvHr5rk2D9wHJW2j+aq5+5F8ts4VuWKTZLbEC+UzADKZaSYj3Yz0O22DH2ydML",
+	"ydn8mVNU+xeCm/OJGrTSgdoON2qLdsOOMo2RjV9EuYed/KE/WV5lnVOn4z8zVjjyPRq8rMNEaJMx3yfT",
+	"CTTY3pWjCp0dq/arG7r2c8V5o8KOmjRlIxJKyuHhoz9YjbutJ9PJ2fGbvzHpbD3BB7zHYc08izUFmyG/",
+	"yFj9D8ekjqlU0PR0kyfwj7+ZJ6lpgba7w9w7Fk4nkPLZel2sWeKavikyzdcZO7rOmVQAlxHGXjGjpOBK",
+	"cWGTh2PKsFeSz3UTPg/BAdU0Ewvj6P6KrSVL6PCU569zYwVdsVxbwTnAVuNbFVmtsncwRGsbvxOtLfwW",
+	"tbaognPCoLaHkJvoxhlstX5o7G740eP5+4wx7fYQ/ojtOe5lsPP4Q7j/+MtgKsDf67Rgd72yJvubh9j2",
+	"jNPHTYW+9pOSqZcpWVcs5RQk7iarpwkyd3rJcl9EArKzgtWea3PNsEQrkpoJZsQPRyS7ltw/bnBJUIE/",
+	"UAxKlmOxUvf4WbNkSk6YTe3uy4lUB1lTvfRuhK4n8kkjvGeMXtlpcTqek3VGq/kLcQ6gArf+wTyxik4/",
+	"UuSLH7q6D2Fq3Aqz95qBeCZcXI5Bq8btQHelMr4db+FrJhmxOYyIkCRlUFegL6U8TYa4PDTJKW5zhGb1",
+	"2OphYvoPXEe694blepnwlCWS6VsI97eY1RRpvEW3o4THelk6aZaC+52+AiG3yt1ff1U6hqzHA5ImQzsr",
+	"QvKgqn5rGQrVXUPzVpm2zQDRnHF3LvUUSqqIkuiTL3ZyG/R3XHPGqPm4hwU5o9WdbILqFZiLGozn88Nt",
+	"E2nrolY/x7G88thWF20L9Jz1x+l665sgtlO/cjUcPZoyf0i5tnAlyJqkyF9/WEumVPRxaL4T5hu4XHeG",
+	"LMzYaZGBXZGvmJqd52aRtgVX5O9/Ivb//32P7JA3WI1tj/z9T38nK2uzeL7z1V9mZIf8KArZ+PTyC/Pp",
+	"FYWSUW9ErpfVFi92vnhhWkQ/vXgZdP6Fscv66F/Pzo0rmPWcdykLlQH17wZiZ1Yx+mG0pdokVWYYnmMx",
+	"OT8eu2JyA789M/P+fefve+TE3Ne+1/Odb/4OiHvxkuy/MXv/Ddl/g62nf98jYE12jV9MX7y0rRWWNnnx",
+	"Ui9tRTvss/v3PXKq2boEa9f1QWDqPU4xBUF1Ld+UKDEc9Jugy3n+Gl3DDObI851vpi++3nn5hd3SKE89",
+	"gBySNuqpM2q2qQgAeyYGKqcuGaVLLoFAR6esG2SCQXgjCDcarlSeeQQ8UqbQh2yUzjnr5UbxhGbtwtfo",
+	"f/N79r8p34fD1U+2zy08a963Uuv+YiHZImq5qTWoGsOaYVVrn04WTfZFaQwvTZiXbBO576Hbdy0VAUyX",
+	"SqwcZC41PcAAuZ3tE/q1+Brit2BJUyIkvkYvNsS0Zzk4FCaiyIc75yAafzCDH5iOMbA6wuXgUwTdwHbL",
+	"xLCYKUKFfo+1+EK7vPJBiKFfupA5SwfqYbUsclQkdNXZkgz2aCUkC2bNcVY3I7lgCS0UK0OOq7UbvQN+",
+	"jc4drfi9nIbRd3ldDdag93rdhVhq/m1Lw7PVBUvTHqzUq9O4Tj7xiBA6wTdIPA7BKJWxnNwWYmql/lxr",
+	"TG7NeBCG5/YXeBpQyyMo2XUNqQrQi9f44Q6uI3V7ER3r1w6sBdKsANJSobN5Ou6rQhpXRBYQ8WSrox3O",
+	"yUVG88tpjI5kkbtKaRCEAWNSFWiL6lXN7r2I2R2Lnkzby1iV1g7bJKheW8Xa7atalYejzpZdoZdeM0yk",
+	"apFqr6NdqYhsavY36h5vu5BahaU+pwNfwKmthkzA0qaeHw5iqtWiKDFBXWEDhx4Mc6uVKlN9qkluXwed",
+	"bCwU4NH46a5FMAmG5+hezIPdVXZajIXtWEV9YBsiDwLTelGvN41q4CbarMLc5FKP6VfMz2akfrUyRtMY",
+	"tm5gqGwWFGIW+YLJytMwptnfsmqCHSF4FtUVenYKF0jYhpQ+P7TqPJ07pETWKjrbz+HDz9ps4eegnG5A",
+	"qc1NU6g8OXwVv1rsZ3L4KrR+12aIUzX2fBM8cmqH1b+9/SzuSeHubQO3dVj8Ft+Ua8qNHGorBAO71oLw",
+	"nGtOM/4v9JBwuizN5IrnNJt6mLVw3aaE6aRtu2hqWKkLOq2cq9qqpgEC27cyNMDFKpnbVbtkXJak0qrZ",
+	"zruWNfZQU7lgethTIQTlDPrFnXZwyGFLCsbpMDKFBdbqS1sxvRRpM2lLGevJwHIMdvbEWGRPmKrA12VE",
+	"64I4GLmrWXVWj4Xg4dVyTMsWhLc9btUSKoKXUlb1gQtPEfM9/r5N4tN3ZEPhCscc+C5DaLrUZT2KLwNK",
+	"x5JKowhCRX6qP8rDXmRJr+AagBGhL5WMiBXXOlpEpUbZdjVTi7d2Cj80GJBcb8DQ1HZTtretc+bqXcpd",
+	"D5KYLmTNpNmKfrtph3CyExVOSu1ifU6E6A4ySfvibyeUtI7UIyFvgcySo7iie+9y5TTtoRuP9wPZhsfE",
+	"FlDO1NUmhKG9nYeuvUkJdxOtrW5fVmpuI1Ex7yRJ/P3Q1n+6PdEYQtha9i7JG+TuEugeqdu09rhqck++",
+	"YkrT1bpSPr8c/Ap6Js10Ut2JIG9zqhA3dovcm1avV3fB860PZhOYwUez9XIPPK48fceP562OYu1YtCyp",
+	"7WT1nOHm8S2P3c9U6VPG8rZLw32vXxRAasp80CEV0tbzl7VO1PQedgkLwFm29LJycR63S3zjAWinoJ/5",
+	"nCWbJGM/CnHpCMdRAOYrDRzcIGlp8Dc2OGFGjRm0KH/YhjIqoDSmjrSpQ9M6TAhg2zgBzE3k3Oo9nrne",
+	"96DJqJsiy8HvS1qorfV2gkJskDZG5F+DLRhrSgTopWq5QdN1svxlS5ZUg7rOVGqfK1BEvrd5dXY0q7Kn",
+	"aHB7W3rYV2W21keJW3+1ZXZYbD+GpH9yIenTiSqTmfbvoPIJ7O4rlj3mGv2KGRyw9BVGrTTNdKjR7XeX",
+	"wnbwCq1UPyHrQq6FYpUkl12QRMofTCfODAue4R2HBfOfuaqqVKNutCZuDdWF1vAeYKIB0FB0G1tZdtWB",
+	"blf+A5rHMY5rdA0JVUSYxuRpXmSZza0Iv4AFyvxoLjenw4toKB5pg93aoxvs0tS92Waj7R67vtkGt5ul",
+	"t9xwtH9mRXvIzI/WP9wouTOeaJvJDRcWIgDduGA1kM7V/QvW9Qodw/sLfVRIrgZbO8kdqXhyivArwU8X",
+	"Vh2JWk5ydOqV261al7iX71llEGhk7duSvDv5ud8c0OYqGyzqNiLh0engJfytas5wy4hyf/jyii9a00Kk",
+	"8K0+Fjr0GTXny6++3qPPZ7PZs6GoqU7agSg4bEu+PsBIhI/B2eswRI98zq47uFzOri1fQ37nuZtkKxMA",
+	"NYy5OdbQMZFrEp8tFzkbMlX7wW3fKR8ItRVhexeNPmVUsi6GSRpVOJxiJeXq8i79V2wl5Ob2I9Qwalbj",
+	"B7XQDUVtN42rirc3IrtK1BhmYab9hUoXPii5Np6l5pUkpdi+kEYM0HKi2Ndy8tjXAKDYZwdk7FsYM+q/",
+	"Fys2NFsmzTfW176qCwmLK72/mVY/Q96b4PP7zqybBhxvBcHAZpETmMK75RGap7tC2ow67tcZ2dckY1Rp",
+	"DAp3jRuZOKtZNqvQ701YfsWlgJJd366lSAsw+E41Z/LbuQQLfdrMn1ldZMxNw4GDq9SSJ7pSACqooGWx",
+	"4Av8w+AYeR84JlnXfqpCd5kqSlRZydmHlBu6/BYnezG1Gg5I4/wf3x6jQ2Zbwecapu53jTD4sDVWiSFY",
+	"4yXbvECr+YvpJdu8/A/842V8QTddTAUOxb0k8FTFCrMF+Nd9QHzw2Vzd8HGy98VNM7VntUW7p12lHgP4",
+	"htr0zfMCXNVwoFl/9YXalO3Mt0v6rMmetMMxv/Ty6SgzXLa6TbXh1pQkjYcBerW0A1J33dkmh00ziLFl",
+	"+loQ68BwUdsBR1H9FRFpovlV6d1i3Tq2VUA5p51oareqvm5rdw0ziBgIh30M1X1bazzKgFYRA2wgV7Xq",
+	"u9oig20llCuGBfRNbSkrZD86a4SqBaHVQtrM2/KYas1krrqK/kFDsrYtK4upd3GVUC0cRc5RrTLFHC9C",
+	"wn9FoYkq5nP+YUow/e2SZdmO0puMkUUmLtxkAD/MTheU50q7nDXZhhiPSoZTAEwr+uFnli/0crL38quv",
+	"pxM7xGRv8n9/fb7zF7rzr/2d/947P9/5bXYO/+/X8/P3/3F+vnN+/qfz8/98/+en/3tYu2f/+fT8fPYr",
+	"Nox9/p/tNVgDHVGDC6LCctg5DTIa2B6+enwbd+30v2h6XMSNGuWbwrNgYvsa1a2W5smnMRNBQbMytdBd",
+	"OTb2rjDuUOTegsM0Ywwip4w2nUW3Hr3mbDs8I5rfBcAkero7x1uDyWjuJhpTXN0yC1p4bw1i2aUnLHgg",
+	"WNvurez0zrXgfuyx5Onbo7PXe2hN8AGHXFXCa4JEic8GGnCt7/0/lMh3+CIXknlne28bu5U5b8s7yvcZ",
+	"HIER1SFsa2RoUDYyfBcVOmCAsn3XneZOf+U+2frc42Tpu5zr9hNvzUXbMN60xRskOOYVzFTZyiTOZcKt",
+	"DM+SP5NAHyW85c6FpNchZd86AqBSm0ym11DePnfR1eZVgmstVU0PExlQTSFzL7EBEdTczq7eHKLHvafp",
+	"zXMEuU1A5bKQFIM8nBYm9I84FuZVlh7N5xV3n/1ryjUkZLL+5TbVjTE7HNNCbWlyrywoAK3xLYA28rWq",
+	"Rqp8avp8VD5Xlhn5XncCqHyMISPSrI6fcjsrbG1YsPuRjcJypyHIPs0+rIUq7xuI/zKR+DRZQk7hREgJ",
+	"7/0U0w+Wzwg8FppJM3BC1/SCZ1xvZud5f9g8LqJyqhKRZWA1LS3sreKZAbI1qMPcx/umhYvqiB7C0Gje",
+	"MkbQoozAutjUQGuMbEgnFnrxnRDaxFxsMRRmJRhyhTUSIZg72zFBxHZ8lUeuETl1nHIgeHVbfohQj4Um",
+	"FNPq9rXzrcZLoicOYQ0twbgD6YpLnZT1u6jEOkPyMfu7y5h8wUgqrnP7ijP3iE0EHHGPte1OMSlJr2Bl",
+	"o2pda3+537b/TQ/a0luZGBGme3U5C69HHP4+r8fKYm93PTaH2MLprESY9zhbn4lXmAvvqNBHc/vvwNPw",
+	"NraVCpDBFJGv4azRzjWXx+rXhvkkfGr2iGW16rxofvQPGjhwc2ZzEFCnlgEvgs4XeF95z38PiXfx2Qr/",
+	"3biL9smFZPTSnOjOlVxsyHkI1/mk6T5ZEpeqy7SfAPAWpm7Ab5FZIpxpYPxREWYZ+DSwY18vXdhpKeLc",
+	"JNb6/tcWHOVGXF32JgDbOufW9BNLGha9wBF1eHPjAHB3c3WJJS2a7GFNjU417q0iwWi2gRyfAfDO6yMY",
+	"s3stMEck4R3ulSxg1u+K1IZp1lSYtRYEc0TZYAB2xTJQkJls5CwlqW+NbDIoOcrBGgRZY7dIg+OUFGhI",
+	"xIQ4woVQlZF63kOqnP8CwK3oMQKt9dNf93f+m+786/nOX97/uuP//dvu7P2fnv1n8HGAvhnU4+9yekW5",
+	"dUeJ7eeK53xVrAKu4/aI+J7+UKcFUI5FH2jgsftk70WMdax4vt8zPf1Qm77Im/P6fdxq/qgMJ5JLJvcL",
+	"vWznilGjLHa09gpa6CXLdXiwjg4OiWQLbnYj6vJd6OWQJDZHCd93TY0plyp1LWSL7cd9JWAxv2QIigVj",
+	"UwOzcnP4caPFcdrK0VQSp/RM1fOacWsMpgtWG2XgRVfGe0dIvkyVoxl3Bl2eZEEM1jOmGRZU9R3KR4ov",
+	"p4r1LCGhNb+ycVlM2ioH+ISjqJYucq5npEw/6H9UhEq2R/6uMJOfwkJbU/L3Ff6AyfnMD0v8AdIQAv0E",
+	"bOE/9359sfOX9+fn6Z+e/ef5efqrWi3jPOB1ngjzABsSWc5sW7yTIDEAMHGqaWmQ8BvqJPB1RnluXqBQ",
+	"zmpwgnOc6th2dn9/Zwe5CfOcH3hLRPUMMd9ix+r6+05TOeap7VAnxMiYMeJrJGFv4rbRpKP4py16ZKgR",
+	"Aeg0lo15B3/HeQcbZLNdCsJm9/ut89lSmSD2hGltWiYqjOsw/HEIy0OXB7M9iQd1JQ46CoxdBwnf3Blc",
+	"UoXlht0AbfndjEfZHZI47LvqcTgSKHjX62zj8lu3pi5tbJ5d51Y7FLz+Bj1w2re6+bLombRvxwOfgrvu",
+	"/X6LWz3cwFTb9Hbh7hu7cbjxw+LQXY+2rJi1FH2m7YAHXTDqNFzSgNpPfVtwC8eOCOL9Bs2itBYPiIw2",
+	"q8ZGNpo8WpRkdOZBRuVGzzF08ndbzTd+LfdTummGGx00xDPWaPtEuUAocxRjcRlK9leqdLVjwzKYCiv0",
+	"hNwzclVVfcSGJzqeTkCLfdKX/u0szDIXTwEHJGszXM2Maw156lJadriQ3+ud7KqiOfeha55l4TXNlXc4",
+	"woI6KmSTXMWEiJZ73OznMGJrsS61NNyO1w9ivaWQdyuRoSSV3lqkIS03C5LOti4z2qxwyO7A8++tcGjz",
+	"Kdqxu7ZJlxgF9YcEsSwYTj3WVCTfZ3yx1ORA5FqKLCTWIGNJUztVqm+2flWDPu1mGj6mC77jbqH4tr87",
+	"+dntzrvD8hRigtxCoSPzWrpb7L9OiCERLB/F80t4R+N81dTaLYkVb6cuaNMa1PBVTtCKg0Ek4fSSPWRh",
+	"mlWTv9s7vgpWhWhA7XAb0sChd4IjuRPPTXkADYMyca+opiWY4TE3AyDrpw50Mz6kOQVIz34+jR98BOaS",
+	"bTqB+IlttprcOOL0zF0/7C1YaYI4aOOHs4QBnMElGc0X6FF0m00P1mWISkiuW1Fett13TduxH4xM/Mjh",
+	"r6r1AMfCclESdmkiaZpKprzXRe/CyVMn1C6F0uYFt7cWUg8ItO5AkAc2uvNG+o1s8xU+uQJ9obXfsyt0",
+	"CqeaiAQ8wH1+enQ2i1aJE7L/kQppyYX0uIA5tOSLBchremknRzU5vldANoJISDbnH1ADzjjoV8xwe+Qp",
+	"qLDBccX8oJ4FM9ivtNBiBcXq7e8qLund9vmXllHsnbzerM1FvIML+xWkZkAN3jA9ny9YNj787v3h11JV",
+	"eZ8sq2k7a8+setZQg8e1rYB8j5rd9vrHaimknpIVTZY8ZyWcdvvhlFUzatQqJeOhqxTCRKo4kMy6f1d+",
+	"4SL3ifjch3feU7z6S6Ohyy9S+yUcsxlU1/JzrcfB8btGoPnB8bt6aPrB8bu35gIrG72ByP1GX/y53h1/",
+	"rY1gfD0a/c2P9d7mt1rfsMxixYM5+NBwfG4UWtyEU9gLOUyVGHGBrnkk13/2OXGCD7VRDzD7e8N/zf7e",
+	"9FzzHaI+a7X9xB9PIL/adzS5bC2J2/g1AH2rQsfuMekBabYotOgcwX8fNgrNTi/5es3aygP7zFPdOZs6",
+	"yg2bXw7zK/vbofXvPqPq0sMX/njM5IrmEP0YnN2WEsvu58OcVj/YWyotm5QMollOuQQvrK5ccp/w11NN",
+	"ZfNXD2plAGt2r//+nQn2fMXVmkJCptpXizWWObw3ukbH9SQalpA+MKxKB3s4qCp1A5vlp2ihavOjSUtV",
+	"Z7WVItb1H33r74rs8shdWK6edfNLuOjKBz8QuoOfMKWFbEmrgyAMEpROsanXgXR5tgWS4xGWwEcWOyWW",
+	"/YaXm+e+9lt/pqs+lW5VjvNXdSlT2An8+qdWYm6V14O8SBGxfce6iyQ2cElNzV1fgOiTlglIrCC/WcNz",
+	"q5IeCSOzoYqq+Wcn4+lU0HYn7OvhWVuMXM9N15ZQqieWsSX9VPMYtwxTaxbp3+QRfUM1enSMGjCtocOW",
+	"XeLjbgVoD4w11jlgwGqP+KiWwQwYDVvGR3H3xoBhbNNynMil2VozvN4yPkrzlh0wYKNTOXbXjdvqXdza",
+	"JRy3cpl1U0q0cXOsXrgqzYIHswutfguugmEes5vpwDLyrYMPCoVuYR/DenezytuMUWeK/QXt24hzm56t",
+	"VDi0hHSUPPo791Jr3xAdR3ybrtstupN7btO5hZlvPcSdgIiz68EjVG/Nm/dVMasnLyGIPi3eH+5TzePj",
+	"Kl5b/qHcPPx0w3w7TPPRn+P3688RvGKirxcPBarouCIYlw3vvqZyrl5PynbuV7tvOU+PGcLPG1vz9zxz",
+	"Kp62NcNHdAuY81g1hKSrP7iCE80+aPL03dn3O9+Auh8dw0uLTzmJWZmbJmbUN+2cZ3i/rTZwdL+5aVl+",
+	"eyk989UXz2sJ/Ymv2qzgiS28NQ2CBawhBGIGXELjvFgxyRNy+GpGXmEgHRi2zydSCH0+6az82lPidSVS",
+	"1gnhmkmrmiWm7Yz8H1EAj0GYMf4cikLP6YpnnEoiEk0z50iQMWowTP7FpHA5Ep9//eWXsMsUfZwSvrId",
+	"sA5frM+XL58/M0xOFzzdVUwvzH80Ty435MJGSBBfDAaK6xom5hGLRXZri4GTgmUx0wCvBrx4LeBCMdmJ",
+	"LUjq+6D7eZtKvm2E7RU+YU2YxOvobOrjIGnMsDiNytCByi/8+cSPXfnZPSTeWwi3i64MeVWvBBMe7L7G",
+	"+xeQC52ZWqqTemZZiEH0rKclGhEEpggDsfHXoc2WhUlKx1COP1goB1DEduEb2OV+QzZgzLho7j9VRXP4",
+	"+fFE83K6QaI5NB9F89+taO4fpBc0uRyaSLw9AThG09nEAzS5ND8KtEOYTWEfuAIaOGOrdUY1s0Ar9HPB",
+	"fkrTjS15TnW9JSlyzTMYTNsvht4SVENh2tLmWdLVQfofCvVZ7c8IoFuoXWD/S6E+fe9GtLkgRxoRyRIh",
+	"U2XlSYh8TMChyTazDwwHOS0rKdQW2USbOWhnd0UdJrSmyqfQvIDSc9DWwRg9FNKrSTrDjMJxYB578reJ",
+	"L9J3X6QjDoAAIL89fdTW3kkuVUXS41QPqU0etW9+XNrR9zLz3Xa1UhVoKxbQqqBsbO+FaRZfHXyCx2E1",
+	"Z1GZv+Fxaky1rypON9YG1F0eHFvVE97Akgcm6bHZ9Y+ZNNyytViSbUbWvp2jmFtMNi+yvoWVLe+yuPsi",
+	"f64sGXEkf3N1Q1SLiJ86vmLpUdFb3B3awUB3WeOtczkNn2WbEz21hzFGWlOfTimgBE/rAeIGsYWm6eN3",
+	"wRfKZUUZw0eh6dsQQN8e9nP1B8d3Nwu+R0xXaAsEdTezgfqB79C4ie7xsV2FI37rmeZvW/P+hMi2kryT",
+	"T2x4pKFqZkhZMZdpNorf+xSNWqfWwoaebrnBJRa23+yqLfrxNxnnf9zzZKWghz9JNR+Bx8euBSCKXuma",
+	"SKrZIpIhwo5BlG3hHQFLP8jcYOW7B799qlfOne+b+soHbGO3WsG32S6ouSFB1MyY+Hj7rk8msQJbWXIG",
+	"2Yp9d1URFgiCGVVeJzJInVnTskCyPjNeTvOE/cLzVFwfrWPVMH6x6WwoCTqQa+hRZc9cBcsQa5Ybd9xs",
+	"A0YKHjZ0qRObA6p4XpycfdBv6uD2qEdMn16QDZTqFmBCVHAuchZZ9EAFzE0b3cbzPvhPLbkeAOTe/A6W",
+	"XIfV/zmpNIaYyLKQXqfGtlJ1L2AkLVtmv9bq+zbT0FbX8nBmlaBUXJ05tdhAWnRb7cypkyvdmh0NrpME",
+	"raeEmeVwmmUbQ/kO7WULsqRXDGzjEFqLcg7kPszpglUCW3lOqEl91OLSsV32BL/jdy8ylDaSXm9T3n46",
+	"SeXmpMhbaxmeBeTq6q7j3ljyryzJVigL5EizAV643JBrlxHSZzHRwrInn3eb586BB1MWpHKzI4vcG3+m",
+	"NvReQclGN78HLrAVb1HQKoZay0wGXz/lZbxlJosfuI6UImwIZAtu4mPbssJY/1E0B/zAdbV8HsEQ6m0S",
+	"E7t0xK7mOl84hlW6qMZ1/P5zv0RVDuUth9Exke2fsCvelRkHvxqgC1ftsxfeRqVND3xj1mlbiuXpJB/0",
+	"zKtVquyHxnq22J1voZ0fi4vDXEthzhqk9I5esC0NyzzPkO6Wh99JYeKuCPY0lb3I0+Oj0zOyG9Zc2v03",
+	"Gml/4+nNLgzyLCgZe2QyGLwM6dradA+xXgX+ccoSyTCT53dU8YSYXvDdJDUxSG8SbnvYVHUN9XfBgutl",
+	"cRF9DxTS6h5tfvaJMxvTNZ9hv1kiVpNpZNIASRdUQTaWqkNTfCxYM/Y1f07JRaFJQnPDI7GYCv8XS4NW",
+	"5HWumVxLrpg1pfdTkW7zOf7B0NVaeMei4fZhw2DKo+J8vGyyYpe2V5FcQE4K8nRdXGQ8wS7PpuTHs7Pj",
+	"XfM/p/AdKlienv4If5j15ALYbrgIg78DV71LqaX99/tGed6gYQ/n/rFseROO2dPt1DfsjN4L0GMaVR/H",
+	"NYoc6EwW7Jd5P/5gOoZ0GyHKEAxzmLQgSSZy5I79pGOGnrYT0I8sWwWh2sO90yLlf03m4kj+f76K2nFO",
+	"wgsPeOuSSm1FbK7IkmWrsNJl9FYBxK5pmwezfWv4VmXq63JckrJ1JjYrl2LAFZKerDY7dL3eKaeIzA+O",
+	"NB2517QsGifvoHKt4wgxwIJTSOUF15JKnm1IDlb0MqCyXv7aozu8xSf5gucf4EJcTPYmL2YvX2CGDwim",
+	"nYDDJL0AEx+CvBRKKyAC86/JnpvBsk/D0fHzGsSPya79EbVNk2PIhmKcBd+jPGEWdSCKXE/2vqgknzIL",
+	"nOx989wj9yArlGby8Dj+AkV8GX/HDncqh1TTqkwxa3PlB/tNYBzwtpUso5DSHJYWlmKClwPWOJYpk87a",
+	"XSgmd1z9eztjZSt+tbDulBXvZxu6MsfRfhBXTEqeMjXbrLLJ+0De7S+cG55x3PJogtTmgRficj9pnvXa",
+	"mZ131maF98CqUOC8tWI6klL+ghH2gSWF9fgYJMkb2DrfSpqvmCj0Z5jvnjxRT6rp7p+snlTT3RuSe7J8",
+	"cveU9zexMijDwg9L6jgpcnd8qz9GctBf/Y3KuySgfJ1fcSlyeK5fUckNJzIZyHbgnJA15RIqqf0DDRn2",
+	"HMsiNziOlhSSRd4a07IyiK5SaFimjeYbQuWiMNAoK0ArTfOUyhRLdBO1yTX9YIiHGybDstQ56yuyshGQ",
+	"biZF1nwNz+QFqCmnhqJQi7ch10yWQJDCvKgJNeLnkuwkGCbyIW78vRby8hVvcd83H4HT+co0uFyoZ4Dl",
+	"Xoo8d4oAC+iAl1URt0lUj+3eNrTmuxlf9KN1r+t6pc/rD2vJbE37XriCxs0URTlh/nPA3JihP6pRQpEF",
+	"M1vnNQFxnmcL3rA0umuxJTfOk2gJsvFJm56aHGK5vd2ohgAjlpnkl/7Rb5agqOZqvil/9aAP9zOuhFVE",
+	"GHK78oHaIAOvhcBwKiJkSJYe1aDH8/6id0JzrKjS1GA1SiOVt8YW76eqFGeANK8hrDRoOEFEyUhniYzc",
+	"XVjwg7jgMCmEJgf7UfoZWPvG5pRDf5IIXINq3pgQHHyf/o1J/zRszmzSAxHJVkIzq6MiV0GHuL1EZ2oQ",
+	"Ms5+PsU8mC4kbRDoZvRLthk++iXbDB/caEjaPJxcwaE7Y3+LikNdcw0w6ZQnoFt5aV7lA7WXOUIyTH9p",
+	"uMJxlI2YX53GEpXCT1Cmd0WFtQhqGbigyno9ewBFMUOXpXx3LbnWLL+z9lM2tZ9OeUmVTUmZJ6RDL6qK",
+	"uXkpRRYvfYAoPPsNq0zEiilC59pW7ygVVYeodEIxhpF/Fgzq0Um6YppJRVSRLAlVe+R8sms44q4Wuy5Q",
+	"4z+h9bfQ+nwSJ5tWDavfvsdXqjqKbOPrt9SMAcE43FQVYxhi6SqKVui7Sdi3VWPdg0LKTD1QIxUiyjze",
+	"f4SuXTopwI/TRNEsm7UoRniK9SlbCNyMgMSPcqkwNiSDX9fVyOLo5msVROXyQT8tFVlBNltz2twxQWkc",
+	"Hm1wkVo4nfB7sXHUhkdSmQS5ZiaEhCkr1ENW1yXL1qU9rFyRo1uDZU8od9bEHZpHfESr1gwavZ16zZTj",
+	"g7YQqiw1n9NERxVia5pcDqpXuY3eAZb3xmiA/iayYsXqy6tCj23QAFQCvjLdjXwYhEK3GBc8VjqzxphG",
+	"OFWZzW2FWqruntgJltOCFTdQKy6Oiywr3RxKk8Xh/K3Qx2hXn0xbyupXLRNPwj5PZuQX88RTGFn0ZD+7",
+	"phv1BEPGEY9ckXUB7jvmWtyAqqLW6635UukEYjrNJKPpBkPGiMhrWeYd/8E5TUqp6mJg1IGMyeDHj2P+",
+	"qI1lfrLjOZTGKStiirBbc3NfVDPwXEwnzb7NOq6VTLhWphBzI1UdHRzuGIAyTnPdPMwR23CFxnoXFZAk",
+	"rMhykB7m0g8Y+l64ypiWxRoPkAtGfFJ0JoOOucAKZdZD0bAANxgoUDJhbgdFrJVcyJVq8rmqTWuAWOPW",
+	"G925POP5rfgzdIzlRXaxxiHvtVLs4Bd6AFCZK6BHW4wADWTb0HjI+6B/nV4dj0kZmuxjsE7CRZTX1REP",
+	"K2+2Ii6WgO9xvXKb80ft40xKId+0JRI3s0MLYvOCuqzcTlNoPJsLGX/HCMkXPKeZT+c/KLWUZFpuDtyN",
+	"WwXnbSUyCdmhpuqyrFVoevOKDmhQjFAFC3XI+3a3Nbvc4290A5SH2PO1m+RT2X0MJoaNd6Y49EheUXmJ",
+	"ysN1iRjrjX9HEgkAHUIvf73WA/x5Yq0GOPP89Zez8C0C75O//vLTaayEUcrj9/frD2s0pbgmJMkoXzm7",
+	"qdW5/PWXs1jqoWKAa1CFm/dWZedKFUx2gIkNQiDvACMOFiXjf1xfqndt716DZPL0r6dHb8kv7IL8xDbk",
+	"lOlnpaoA3p+hgsD6zLhi+HbXAGio60W9/b4FRds7R/3jWvfni9ZI5G61MRL+6RvV/UKrNQgKOFDyU3HB",
+	"ZM40U7vGZf90yefaX7d9ahO65q1bwC33C2YAhy2jAouGSHK1zugmHsL1Y61qBrYlXq8K3K9dRpiWLhPB",
+	"8y3m8PGLr7fLFfnpG1WigitiB4mryYVc0Jz/CzC1rwzJrAbwV0PyR/Ge+OKByfsvplrtrBAXjtwuv1Hx",
+	"6J8Lmrxt8UY++W7/oOaSU2YyU21JJ9h26z+p9rBjtOmi3LPaKaS0gEw5a1RAWI8UMyTCjTbUHPK083/Z",
+	"aBj7DVRTaIIBU/COZBmjigVuJ9BfsnBcZR3ZHVbKDOo4oU0bN4fyTYnOdmi64vnOefH8+ReJ7wV/sgG1",
+	"mio0MHVHrpXeGhsQ5Rj+SKIzaPdr4b4k9elEwWxD/apLKAl2/EzzHBa5vqXRpFIAGnEQGEasiq3V2a5/",
+	"z0q0buut5z8PGOrzzV0YeViGLobl1vYG8dje5QGIHUsIdYqnPitf5ikkgEq0rQA7tQyK0WRJuCEaDh6K",
+	"K6o1Stjnk0u2+RYksfPJ7Dyv+r2x0p/n29L5DeToBRf5t4XaYVTpnRcGvZzJb03cH8vTbVzgppNqEFds",
+	"daZBGeeC+d3gNzSPGfe7MkWhs9/ZrFeSqSKDDxCYApOhWyD8XbqToHvX/ttXLJ2R16u13uzmRZbVZrfB",
+	"N8Qotmy9j1qwWG3UvkvuTb09BEx6SO9UDnhF12bh/75kmyns8Q36YMXL+TZJzuVDi/pnmi+BtOiC5KzP",
+	"yibXS6Z5Um5H6R8SemkZysXtMA5jolA+1gzAUDOy74cAVaMZAG1MNvPZv8uwuylxgN3E0/3yvIjwrDeo",
+	"wQzCMg1Xgr8pyfiKew15mfQEyNvbqNHpj+cp1nmsVl5mEjQdkI0WMESvKM+MtBjWH4RqbvSfBbO0ufG2",
+	"Li3wqeO1qbJMCVfL00cxTI6lKKMCW9DCPrOvgnBVe1Y8JCW6DxBNYLUz97biCszxMJYBy6b6WwusG+RQ",
+	"Zlda9RUw63bOQEIiCvSS5oSSObt2LpO4p2uqFEsRJW7HXXg3WgMdtlFsw1c0rNNtba2UI09R6s0cpiov",
+	"zjmXSvsAtykp8owpRTaiQHgkSxj3qLQuIVBbNa9qWlqcD0wwL88Xh5qtWlQj9dxEF8psbK4tcVk4AfF4",
+	"0xsGZdCPx8eVy3Qb7ZYC72jf0xGL086nlqEJabHqORsYiep07tfhgFKkyKFGOtApItIM45CesbkmRQ6H",
+	"J0+JWHEd+HoqJrmRta1jfAhokL6EPLWX/AVLaKEY4fDZLD1ZFjn4RIryK6DA1knNqLKNnpXrkcyiDimw",
+	"viZcCFd3WYlLpimyFF6INCdXL2YvviKpALgV08EcSOUQ7G22sVBeVGrSjVnZn5jSfAW29D9BM8X/ZQNs",
+	"E5FlqEOYESwRrJwYaOaVDDhl29hoUgduIL0vrTVBDcnf1LgzBgTPN5p4ZRk1h66QgN+niRT5MyRTU471",
+	"KfhhS8NLnrkIe7sV9nDU0ncYXoX5SH0kKnlt1lZeHVT/2fuMC2luHqn/zPIUrypEDLL2sKUV3RQoNP9b",
+	"5LGi471v2wNZ9XudTtz4vWGyRenGyfIWF0KzCJv63iAP8TU8Y+Ot4+8BhXGQ4NNdgHLo7gPqzLVrIdCK",
+	"vNV80UYdDs+WzPJNU1A7uN5x6TbERLWlbkOX37bSxd4huAxxgRvORVJX7QbG/C40/Pe1sd5DjTLB1Fuh",
+	"4e+oHqeMb4qsqxpsowVOvI3qt/agMSgMFv2+iXbV9YqB6QNP7uEh5PXNNbI0zw+x64vm0wNLrLp6QW9E",
+	"zrXoNQSvsFm/3i30JLSd+lU64ejvYwEgQyofhSuB0I/BDjtGxZqSK2iJSoSmnjfiiGE9JRqOGHd2wml3",
+	"vkGLQMXyElEINhuVphnv6VtVxTfW21XR0AYxt6ysLSZ8Cvr9lk5Rq9N0IufJ//r665etW4+fmz2b9cz0",
+	"dpXM2gfu7ti2+L5+0fXftJNAN0E324QmjtwaloZbNbAiPop9rfYNO2ilccW+FC8TY41unWNiI6Ppah8C",
+	"FbdDhmnTzE0nxrOamXwgXln5CRph6pvXZ4fhdW7Rmc4nwmA6jJwBcrGJfX/OOZPkaeGMCbVv1ibDc2RF",
+	"6lmLWf4Ttx8J0+ZlWwK5O9t8VCLWXXHCFu/YDDUe8OrdznwNO9B3pqFR/1kuFJM8n4u+4Vy7YSOa43Rg",
+	"jOeVY2LsQGzOpGTpb66V2Yqam4IxeIepZFxTa47nuf8VAHLqBNC0+8jpOQ6h2AItYNag9et5BIbzyXv4",
+	"Yp6dmftDFRfnk/fP7iBd1o1edY4cbGR1HwIOW+OUd7OYHR2+Oui5hGotalfQ4auDwRdQzyVhhrrzFREM",
+	"8rlfEBXU9l4PXazdjIQNzBF1hO+TySSJkVTVbCHEAtMrfK6snKfJx2PkBst3ZOOPxCiN8w9eBp84g7RU",
+	"/WDcr8x62OR7/hvhdRMRzTKyZhLsC2ncTISKPavtVtAD51WwJ7YteiFHRPU8F5r6bIC3tKKVjUFNerHx",
+	"1g6exHMWADxc5EYPpTRdrXvyh2JPLMQBS9mitErKMnabuayKG7pvM9+C5UFxvroCB+0XibcfVApTUe/H",
+	"T8pRnNo7ZcpQr80mSo7FusgMJjy+wedhRk4YTXeM9W9gGYPsrkbUN2hCxc/oAYjGStSVLalPEuZsdfYs",
+	"oR0voZotjHTCyFNga/Arqg2feaPb5NYhl9g+ftEYx4nYLgWFwag2/hUK70r3uzHPGscAnqe7yKWsz0CL",
+	"oatiqosmZbCGTYtEmNa/jVRgPXyiSs/Aq7I2FM3b13nTypFO2sNe9uveRGHGw5o2eCzEdn+F2IbRtN+b",
+	"tHPbKwpnrMnm7vMmRSTcyCMRSqjKQ0YQNXFHNsSJM9Wn/0tFcslkq60GvsLUTTWckcXOtlLFhcN1LHNr",
+	"MTC+bCcQ2iXGRMKjhA8JKbo/J0GR8KEeglVPhIsiTzOGntxqafNB5ZWAtIgvT497nru8fB6ULn89nlfS",
+	"HLhZnyiS0Y05/lQyUuQmaLfFb68jkO8sGDGsPlMmXH2ifODetJ72ii4wncuCKe0EVkSf2mXpgu1dvTAN",
+	"wp/+t1rSl199vTebzZ4Bl8GTa3MJV3MOo3VesnVGk/JKnxcmG/Q/C5qhX1+5fWue53iXInYBLMmUyK4w",
+	"aBjnIbWsUbfL/GAoYFju267sCeXW3Mbzz1J1y5G+ZfoDs7AyJNPtfTOYsiY0Q6T+G19/20UzG8GrEcW8",
+	"D43LotVIAziRyVBhOqH0QKQT3EwG0ix7NrWff5Fcs7ANqBWwEchK60Itn4XsyELiO0cZ0z3k6BHlndGp",
+	"JbbNbqYTt/QWBULJYDdkKZQ2mz8l3//Xq7eQdfXw2OQwkAahEAvkPE/JWkh/Kv9Z0M2Mi6kfaSZZuqQa",
+	"fltt/K+JWO199fz58yl58ZeXsxdffzN7MXthf/l1b+/Fe/h3XEMBK2OR/LuN/YfUD9Aa9i8Rec4SFH5E",
+	"hRgaOS2mdsT3j56w6O5JOUTCB4a+B4fX3MlHpmOTjVii6Ugp4YNverSMsWY1VaNrgvrn0ezVr9VMMLrD",
+	"+EVKkR1nNGftCPDotb2AA0uRkbXp9znFN0UCvu6kPn0gy9haCnNKwBHpe57p2PyH8zCkEC4h2025tDBc",
+	"WfcepxkBz1cQZtBXr+aDXgZaOG9SeCGTJ5ds88Rw8yfer/4JuDnCrKah8R/iPnQMPIc9OA4aah34yVPJ",
+	"FlSm4JjqPHSeeRidG6hNxIB7oywv3DHgGwFUM3ihzsFhUhuatEn3aN6Syup+1clrlitDR6065T9sMNfn",
+	"Z9fsUjRHL65Ar9x8Ft62Bv+ok/kIxfG3L24Ubn60xFFnWf0+coqHQtVb2GAhd5yCryoasXwrevQnseUQ",
+	"12cd5MoY9ood6vEQfIRD4COitiJlt+N9JN0i1ddaVAX60HLXpOh+uZJ4uRLkSbU0kR2Y+VLGccU+oIY+",
+	"JrC/tt/I4StvoagBOEB/f2y8eE+Qfswc/rx0aj+2TL5sFmkFoVBcoWk6wUIHGMYp2UpcmX9o1uJaHU+d",
+	"vE/AjHyMUaM+uV3cMTsOKnwyYNIUoqcsULMG8Yl1V0GkOuPoqslefnPxNJaNWPG2wkeCou3YKrrAY58S",
+	"IIakMmEAuuWacV26f79Vioi800iTdUeQHs4xkEOH+4/6VhDL/YR5pSigkBhRYiRDoewTwMV/AiN0z4EO",
+	"n/+4r7u7GiJLtULl+WTB9PnE/MPcXvgvNA/jv5GR4r+hsDf+Ey26+O8/Wb0a2M39DM+2Ex4d1tuUJvi1",
+	"BNtiDyFA/DWhcd3UsyF6VgtABaUxSi9JLS4ceKz7qMeS/LByCwW+1ySwoF37sOFg5RSBD8ngu79cSL+v",
+	"RwBZDCf/VdA0Y/reSwMN7Pfa1pTYosuPjGZ6ebBkyeVW/VzswyAf86Cfif3fpn0kimJ4XY7O5LB9QHSn",
+	"LjRFPiIE4M3gaWnleIdC2ONmPOsAJK4auF36btCeGF8aJ2luVwY6mDWOTawuFDcKnpR1VGlZiAiMgPHs",
+	"tm2yQ7Ovu5ycI8xboa0DB81tGle4p017px8SV0wGCdLLilhKJrs8T9mH2T/UMJEsVGNH1+2/OsHB0Ugt",
+	"4XOt2trUmQOGK9Xrddemk0ba6+mkqXbH39oIqmIBDDaxVrcNUpqSSmLx+3tGji+6z0KtUZKKZdoT5Uss",
+	"D+wXr03b84ZsKQgd0nVc7Kl+r2pE/Dfr8/EoChFZm3SQTFSuYtSG/G61IbWz1UHKjVyFVXef6o3TE8DZ",
+	"EcDo7hF3UXUUfgiaioR3uAv4hneNzAzh6y24FULY17gCZM8+tZSXr7fYrsZ8dfvuWOO9OthdC71vV1Dc",
+	"PUr2Myb1SYGVPevCdrCCpii4rFl/y89ufdSMHTcrF22+2i6JhJfW+ArlxdBD7YpJo9wplNUHiQubXMim",
+	"64WJjd6HfA/7udddX7G/cmJX1cTz8/TPbYUSp5N1h1LrDLMf2+8Ga7gizOIg+WLBpIpiEt3YzfhQd4jr",
+	"Tf8tFez3qe2ETp41wvEjBttUWUfVOt9LXJXJmr4y9muDZpww/guVOYrcB5JD0iRT9SGfi8FSeQss5cCt",
+	"TYIZW9sgKMGif4re+Cf+Ejd3nMkhIZTJZ8ApLHv/+DBc9AGT1tOAnfKFAdNpnaeT17kUWbZiuS5/ewW6",
+	"rcl08n3GmHt5eB9Ar4DY5OYSOGOrdUY1K29CY2h1T/bok7eWvsFq8FuvroPjd60MbF3EckFMJ6+4umx1",
+	"L+bqMt4L82S0Zt1ozaLRvOHC9BaDL7qW1fRdY11w9That2Di5n31EFeSdTQ3MC7EnDYqUdlhMEqmXc1N",
+	"3SUSy57ios+gEZGm1YwcuTx5+OuaSeL4DsjFyJy3kMHrt1lEFFdGy2CSTOWaySuadVw+F0xfM5a79RPo",
+	"ytSj3Ce+BG9H9d22rZ6GWxFZcRezBu7QyrfM16oGouLVbrbS5dHDKhy2Ikup/hJYqQ58RSwvRMvKPRu9",
+	"xxfXZ6KtKAlrW31F0PO+NRbl0Ac251+7NhoTSPaWl8BmCpP5pIWPNOCKVE4XUsAsGi5oNprrH6mKaGXN",
+	"r058wiyD0DgueD+MAj2CtfZSIb0Ig1YKfOGLXDO5PcK6FOkBKqeVLayA10cdTqP1SHopnNgw0K3vRAPt",
+	"qJn6HWumany08wqvaae0TWduCn67Cxo2p1vT0V6Ue22jz2K1uHneKLJ5aFr6FtNa0Jr1ZbaxQugSEZMd",
+	"0Ds5F4Z0XG8OWTVNenEApDaUXoYDGIBDAaZM1P3xq/dqKhdMn7ArHvdUOQti1KVtFcH0dkFjtUk7fHgi",
+	"d3E3/d1C5xb2v6PWjd6OlXZo3aYTp3w6gHulLUOnv5bJ0lzX3hhs4GiJqnQD/9CR2sAPHmQuiIw9JGPu",
+	"LZSHH8lcX5k8Kmfk7PoonmUATii7xuoN5Cn3xREvMnSFN6n1zR8uEiUShMCuuChUxwSuyR1msdfc95xl",
+	"aYdkAEmbbbqHayb99ViygJK3eFJ3mAToJj4XhZWL8T8zn2nX/q2t1iiK705NdEX6qq4rTlxX4pKlgQps",
+	"y/jSfZKUfasZ73kCybZNcU8mofjzWqsYT1mtRP62NThcFSjqYLsaxwfoQxCmhM8JZCZvEckNWG8HRaJj",
+	"W/hnuEZz2CFIKSVa9EzHPqy5ZGpf9yRkCce3faojD8vLAmDJn9imLYxuyT7suKhXCEEqI7Dsog/2cRPd",
+	"CqvAtdzRVPXL4AGJGaJz3lrQFQaBrdwKU1g7EPptk9Racprh+6cfSdg6yITfQnb9d35l3upWeRSGaIie",
+	"1rYkrE0xoKXlgIqEJ98fENPXXOR5SmUKYVO9NQIx0U0QgYmulJXQsObhv21hPJcGN8YfW0vd+5XFFr9d",
+	"zJO2DLal3t4JZp9HSwG6JMcNcP5xsBTX8CiAtt752KDQZrLvs2B/Z7x/T23qpbZDWG00nRzQnLZr9O3X",
+	"pvpeaUk1W2yG6+6rE/cp3t3EHagNa61XCD/87OyaFoVkjb/aYwxuyZFoCQyORYnHZNQShd4mG3/a3PRO",
+	"zUGcVDAcWBawru+KdMH6gai3hxpCtSoLsezvhrdiGn7QJKPt18htjgxRmA8qKlwwI2NJAZk48tSn/JiR",
+	"o0JDyKlN2LRmuR26uhEUSnpiV1X4uE1fmcb2Mf1VrCJoOJitCSKZOauJk8f4qiqHdSeMr2EpatEoIM/F",
+	"2VIytRRZOsB92xlx486UCP6pO0st5RLwK6o2BbcVFl1mFUcuZsVVIg+ZZcupj/HOU7XEVD5bioEHFb8b",
+	"A+Lp6Y9ES5qrtZCRU7aW/Ipq9hPbHFOl1ktJVZvR3n+HcZVaHvu+lWvfNLwWMp08djaJCki92UbsygFB",
+	"l4OXEKOgNh0A/o6KRayWZBWLBn8JzTL7hklF/kS7FlhUKshFdz/K1sTnkKlAWCwWDDI+ghetBSEpM8hw",
+	"VwFsSp4bQdgWz6k/r794GVXgj9rWe9W2thQbH+KVVKqWEI8udKfnIdEsYZcsec5ap7pebmoTmI22z/Lz",
+	"yfdY6/x8YuGxJae4KquuMVPqz1aJggulqisra7XtE3y0mDSwElMXOmdwu1gg44vCnC+GV5O4YlKaW7HF",
+	"UKS6D7LFZYk8cgSVi0xypVO8lc4nRMhwpQ9ONuYy3qF5umNR2isyx5TuduGWTQTvIEd0MQnwFIIf0v1E",
+	"8ytmUMTalV5LvljuZGZRxKyWUNMJ9xSTjIZBnzAgQJEJmqK7Es/9z1h7fjKduEGgQcoqfwYCF4w0l0wt",
+	"8ZOtmDbQlaq5yn0HSPPTSQBx8+thuYbmx+/dqlomdAtrfn7FaHeDNxVcxKAOsNP8/M7hq9zz15DcpGfP",
+	"MQNK1fsTNt8YJ8INx4bpxOfG2ZFFbvUGGc8vWer/EXyhGacKdlphC/xH0MLMzBN8r7kZeI7GkonPngs/",
+	"g4TEMcvyBU0DKplOtiOUADWv/bpav514YJtNfnZLb/vU1XnfYqf55Y3DV9unrmFPHUqbn16VSG5+PCzR",
+	"3vz4Q7AREQILtqb59Tsa7/XOb18E9+aOCcn5Z0HTHmI253oAKStdXBhiFTSF5eRC78xFAUz2gqY7iml7",
+	"TMHsDhxWLgLyvS1/8ks4RQjqP//sIKp/eCv09xbA+qfvaHrq4a1/fG3hr//+xq2n8aFGd/5DhL+8y7ku",
+	"pep6TkTPmfpE4JYbqp5WOnphtYtULsuXIYBqJB9k6Tr90b1YUspWeIvSDz+zfKGXk72Xz7/8pjUp2DaL",
+	"qrPgG6S6bYaokj08rS98/9gRuMYrfApLt2W5XRKm8hr36JBFnrvb2CPg6y+rjn9051/Pd/6y8/7PUU9y",
+	"M1EcGvMFzQQ+2F2pZTqzFo/zybMqMOHHXhkJpq1SSXWPQmRPKyQZYDEmNNX9kJtrqzaouh+GabiJM06N",
+	"XoR/MC/CGols50hY73y/voS10eMhkJFG1TjIWoPHi4WMTTxIc1nrOLqe/W5dz2KHr4/CG+GRFT7u7Nut",
+	"7BwMJPFbED7ZjDhuAFc/Ys5kSwncGi5w/CGL9RxmWMoSa0xxMR53jBxEPN2P/5Kl6k4zN9VB+J1HrjF2",
+	"g/NRkNFiiMV7G2ejRhGk6D5s51DmF2Bpbwb7GxRxLtNL/yww/CtinvqXyFngraBsCAjMdrj/dt+lpto/",
+	"eb2/+/PRwf7Z4dHbqU3maX6syjOGO3CzbURIIhJGcyzw7Xp6U5NpvKZS86TIqCSKa1Ym1qeaUMmoSaQv",
+	"iZX4yP6KSZ7Q3bfs+rf/I+TllLwuDP3tHlPJXTBOkdPVBV8UolDki51kSSVNtM1lD2vFvFSqWK+F1Kbu",
+	"+fnkhzdnmELp3dmBlTIb7OnMGLaDnGmxIqjW+i19PFusstxvPG3tjy2C3YhlUP6wI5C9pmzB8h32QUu6",
+	"o+kCGYuQq8leMNVNq6Vgv5JF2lsIKsmlf4OfF5Lmut/dayBoImVTsTIH3rzZHXy/oTEo5jly/NPBa4TP",
+	"tblPWPzENaBg0b/FvSjsdkGTpgMF6t5+A2Ko108EhE7e3w7cACRkPqiB+a2QvBVG14i8OzkkTx2/6txp",
+	"YxVyqY8h/qlCKJa6n93XHoSrqG1BFZMRh1z4bE+dWVGlw/2SbWXoGpyQPrh1B+DrfYEBg1Wmr91CAY1M",
+	"AzYQFQWQpWEV0l6eZpvF61m0bZEdAxvhUFHuiqqztu7wFThAe+ffOvU/lYGCT12uhb/x2FsesAEt8DjA",
+	"vcJzFyQZD3viaSuCTD3Gw1cWy0//+svZsxk5xusUHTfQdQza2bIVLOdpSVWxKjZdp8bzheDwRMeBLy0M",
+	"ENFQ53zfMSqjkdcxEzt6AZ0mS5YWWWSKV0EVeWVbObYljFyUkFRc59Y6AzIGym9qarmX+Vnzlfvq631o",
+	"9DyKPEF7HYEOpMhff1hL5pMHKk2l/kHShL0KkkEM9WjSgbTW+Rh17RqPHj2JwhA77yYRnwnz7zjyhspc",
+	"s/Yz33JaX3cf03iRqu9NHRrzqbeYa+RRYUCtJMC9v/TPkUKmTcHEtfEVTKOLUMVFzE8D3/pdsl703ARK",
+	"kuquXLXpH02OteB5Gi+v2fKocYMaTT4mZnwTD3uEn2tJguC0kivoNjT6Cscx35yTQVljCfifldxhcLH2",
+	"m16qhXeZTnbzBc8/GFXFfJbuSdG7ztbIoF+Mh9frKxZbc/mtmikJgjGxSNq1aRKUX22iwU7Vnf0V8IDD",
+	"QgQGQ53Oq9c/vz57/YqwK3h9QWRZQqXEShilQmRKjD4EuKDTiMyq/vgQ61pCSd6iVxBg+bujo5/e7J/8",
+	"BP1fn5wcndgJZ4PqU5qFYJ6BMqRLacnoKogqNoqu2mw4B74erWfkmiqFpeXMIE9qUz8x70m6YvDcE9b9",
+	"EXcAUjeiyowr4vMKdriLdBpbsFVQ7airdUkl0Tw1rUWJav2664SQsvWsskcBPYS6gzl6s+BTm+WpDUgA",
+	"ZIVX+v6rV69fmQQlR68Ovz+Ef1qim0wnbqtMNhczZfzmVywpJNcbc9WvkOYvQFBw1b/wr++dxuWvv5xN",
+	"yiJZ9mu5WZAmDK+GtliMd+/i6dErBVmDoCJC3tC1ggNbTfiuqscFLhczyT8LBgGGeC0YUIyMXV4ia/4T",
+	"s7K5UeNY3ZimeM6hGvVkb6IZXf1vX9xkxkU5olnF9/CF2LpI5IzRlfWL35s4BW2ld6O27q/VId4/jXV7",
+	"ZnXVNqwIfWCNBxYmSF3RnC7YChQ6c5evW8wJSxdlJm9zRPWScUmuhbw0Ipmanefg4pEwK2nYle2vabJk",
+	"5OXseWMx19fXMwqfZ0Iudm1ftfvz4cHrt6evd17Ons+WepWh4KSB2deQtH98OJmWN+Hk6sUF0/SFTRee",
+	"0zWf7E2+mD2fvbDRoECOu+aFu5t479xFTDf7A9P1cjyNml7ej+wwtQoW6/I7nThhCiZ8+fy5owl7sdAy",
+	"6fDuP6yrHjKQIXXg7SxAcDWJ7iez9i9ffHNv83nzUmMuAwk45Tm8sBQmf/mXR5j8TAjyxqTqtTo6NIDh",
+	"6/nXSXXjsEgc7not83jr1kNWit785qZVMJeVDOOk8QPTx8HkD0gitbztEex1Zm6HTXz+4hE28V3udE0s",
+	"/ePS7XTy1fPnjzD1oSsFjjZGgv4/w46NIWt3tUXPTPUp6ZM5k2MpPrii5FaV6FL4l+hvq3uGYp2WnF1h",
+	"xv/QShI/ZQ6EhzxfjYd1jLRr0I6HajxU9UN1RTOeWmet6KH6m21g5NTaEfF6vOYRcL1A5LEPJAWW3qbo",
+	"HBvVnDoHmheBl4ymIJY7uS40EkymAR7rL4L3D3gSu0jCrASWgUfvMSb9jqaOBB/vvJ/ZENxyreOB/0QP",
+	"/L/dxWYO0c2u19ivRa+RmX2w+qDI1RpaodUWt+vT4/03tkrss6aF0JqIjW8AKOLALGu1cXHGc2YtoJ1c",
+	"522gh+q49gtV8h5Q1nnOE+JwEupW0MrWw4gASd+JdHNvpFLxFDB7HQ71Yef6+nrHSAE7hcxs4OKtx76p",
+	"L/fmAXlr1VzYynikb3G/XLZ3+gqzHXL8HOG0P/zgWRRmFa7m1KpSvGkctlV9lL+fBzXoQ80lqJd8Dq8i",
+	"04G/HzqiY81kdCy0ZwdGMAOsCqV9EbVaoyfonlOwJ5hyx+ljfaYfeOK6LWzTd7lBOq/5aWO5ZXVnLXxM",
+	"eeVhjdGqLHXBspgvkXFpa8PNyCt0aQKuxq6Y3OilLYwXA7Rat+7xoAXcqqnjjkb7jLQipEHxJSNPvn0y",
+	"JU++Nf9rlGdP/uPbJ6XX+yXbvMDa1i+ml2zz8j/wj5fWNym2Upjxdis1lLSiH/iqWAXZWBzh+UXyvFy8",
+	"JxBy5kkSqyIppjsJrdLdOJpUqBzKLOGgrr+lX2MAMMfYWAF8sgJCVXBwIFOvKi4UhONrPEWtlMFXXFfw",
+	"1Bv8/KCCa8g42pQ0Vpf3+5VcGy/V5188wqzfC3nB05TlH11cfYzVnlo9/7vc6/oat+Xa59C/mbbIogeS",
+	"2Xdo9Hps3o7YIWw8eRjxqzLFIBHpxQPOHcNaOh7jBz/Gzx/jGBuzS8YTPTKOGOP4sFOWtq18VZOGBL77",
+	"b3gBI5/JmI76g2VsK46DHWocp1cBFrpFRCcy4iDC2PIevd079NEVYkc//cE4wpePMKVxm8HY65ElRFhC",
+	"u2F98Kn+gekHOdILpj+H89wnYYynejzVj/5CMLqmiHes+XmLkw3tH+Rsr51X272d7qHPlh2Y+s9bumuY",
+	"Ph9JyTuUv4yPl98XUxvfSx+fjRYR4QijZLbgoidsndHkYZ49ZaGiR2ekD6n/eWzuOWqcRqY9Mu0/hJIr",
+	"oVJoHxXY6Vy8lmIhmQLneuxk88RYz/wnCipICEIhgbipMbGmXIbBjibT/cogzvvlZ5LRdEO0NDZhbWvG",
+	"HOxHn9UH+ycW1lOXO/TBGGVjrvEp+4c/W8FZeQ8H64ImLjsgDGCOTxlvNtkLe9zUz2L4rXIQd+3h6Hb6",
+	"qJ/IMluNInopRbFA7wo3ai2wEg7r1NV7MYHckAOc0WTpkwOZM6mCQwmOAJie5mDfVYnhWoUhOLW6KE0H",
+	"lPJgvbLLfHwnFPYZe6Gw1G/zJ+t7EoPReZxgtgDYIyPR4882/N05oFBbEsKMZVrbwUbXkt+na0mdJYzu",
+	"JaNdehQrSrHC8I2qXOEkhIZQ4T8YiaK8ihVf5DxfOI/rbskiqNZ2iv0szvp8S1s7jo6mo6Pp6Gg6SgPD",
+	"pIE2LjKKBePb/+Nd0m2X6QAX1AE3aps7amvPB/JNbZ/vkR1VewAZbQjj62BkPHWNYrvA3/0eGODcir9X",
+	"eRmxJ5OUPCnm4NrFw7Yy+/az0dH1dTRNjk5y98BXotoBY6rDl7d/diQdZ7tpv3tcRnBvDrNQEuifBTvE",
+	"tJ2m8Ud6Ao28YuQVn97jp9O79laPH+j7yOxi9MF9WP40vstG367xKfiAbLiIimzgbFuT2g4GS23WWfeR",
+	"WfFn4cZ7R1XZR+XGo6ZuvBHGG2FUDm6hHNyla+M5TDOzmuhdsw8NGIH83PmmS/RvSvwYRtLaYd9Nfm/3",
+	"jRaEVgEe75tR+h95/cjrf8+8vuTihumjtxj6maldLEfSnt3zBL6Xnr1UsZSIHB2SSh8hmqe7wjr++F9j",
+	"YYBmtNIH/CFYJo6OM30kZlkFoT035MgnRyeWB2chlfPe4myKb++ou6njEG1ep/67Zy09nqZ4OPrcSj9e",
+	"nMjoQzr6kI4+pA/kQxqhkQshMkZzMs/owtCJrfGLZeMMNKsVlZtatNuM/GJWAqgSBB5nrngWogUwaWv0",
+	"4VDmsxssrM9BjtzXJ+I6Z/IJUlOF7oMabvVC3RCz9MQObIZ6QrgCiNrwFrSNUZnFRwxZUE0NUqDb+nQu",
+	"i7qr81fWylOE50ob272Y+5AqvWSrGTmwfal0Fe+QDHJ2nfGc7aQMdpalQfU2fz4hxzogq5o/PE/NffaE",
+	"2MsOi7CSsyoZI2LN4A2E8kUupEcnFHzrRSS02haFZnxXfG9q1+/RSedwfSwZWfArlnts+oKFtHqaaVkX",
+	"sMTVNEQ9FEE1uPeISwopDYaUptpfKpXbMLbWGiQfrZTFGKw1CrSfhEA7xAW7Jmq2+Vtjsz5R83BeuWdQ",
+	"ociVr0GTunqD9io2h97NbNo5vjElF4UmHPrmQpO1OdFKs7Tt6Kdyc1Lk3Xzu/UO+pR/bDTycdbQkjT7f",
+	"fzi21hrdaf+9SxcLyRZdNbkOoGC14Ub19za+a108Pso57p2mpmQhRbFmqX2I4dMBck3g8xGYnC3Zi0+0",
+	"SJEdB93QR3z7OzExy/jUsx+0Avnppj+4ZBtAGex2JY3IxWZG9s13cz8xDglCqFX3ml9NBXr+gaUo0D7x",
+	"lYjthgRP8PonxTU7nzybBp3wwTUlUJEdIagQV4lzVRi/QkWe4OeZfUTN8E+jCLAfhJrxFV2wJ2ZQ33qj",
+	"NFuZYoizSyZzlj2ZkTdYvFuyNdwpJTYuNkQZUqKw4NYdgMbfbTqthL7mf6Okd7Ww/1CNAswZihIvnj9/",
+	"TqgmK6F09TCYL/gAy6hcMKVdZypZMEAgs1h9gmEQ8tLWM5dFjgVjObxTJIPuRuHwuUSWIg/yLGl8tYyv",
+	"lk/+eh9edqD/iYMthz1x6o4UtcHHQKvRU2AMntj2tLdXF+g/vD8wfW8n9zMpJdD++B+P7XhsH1G12B3g",
+	"1Ht0oeG9Hd57jVOa/n5Vm59dVFU/uxtfJaMT5ahZvS+u3lXNoJ+p28ioe2Pr9xvzNB1NVtuZrB6PjY/m",
+	"sfHeGO+N373KbjdliVituAIfnbaYKQNZWmQssLugai3o21TjlR/vUZlXDvqJB0Ih9CEWRkl95LijNuQj",
+	"8r8qs4sww4wqrRjrLzJjGhLTkmi+YkrT1bqFa3WoSH+mSp+a2e5FVdoK11zIe2WVD+un6XDSIZh+2dyX",
+	"t4IcWCBGHjPymI/JYzwPifAXyfKUwUnr4S+uoRW2okzkxLa5T3tLbHIXeoF4vk92EvUhARZ2mYvr3ANi",
+	"Xcjb3u7Q+KTadvKpWoNG9jU+SkeGWQ3HtEwxwjDRG66XXWIzw9q2MVH78nyjoXo0VI9i06dhqN76OAdm",
+	"63s70GOSzVHJNHKykZPdxTi7NSOrmGrvjZV9FkkqP00T6Mi6xsff+Ph72MeffeCZpx/LpciyFct1IvI5",
+	"X3S++srGldQYscfea9/0AMfdgqnSgamAMXnPHPKKuZLTYY1pcjgntqJ9Og0LXNu0H0uWXNqIpY4ZbXYQ",
+	"FZ8EXGMg4wpXJKGK+cQk3On1bB6IOkZm5DAnNMuIgOA50xeBDLAcToSRbgD5BSNstdatKVcSJT+aKq6x",
+	"8SOnH4XUPwjfLU9umX6xymSHVdktz9DA6rqNDmNGtDEj2pgR7fecEW1M8jUm+frIVtfGrTPm+xoj5z8p",
+	"4asv9VfeIWq1pQFr9HigBNXNeR45v1YLAGMswZhq64/MUSoaNdZ82cUffFsk69iOKWGvGFPayojRPuWY",
+	"zmPU/4ya/s+KRbXnEtmOt1T0+A/CWD4TJ65BotDIYEYF88d543TmINnuyEOnBz70o6PXwzCe8fk1ilOj",
+	"OPUA/LUrG8h27NW6mz0wg/0s3M9uqd/6KLx1VKuNfH3k66Mm7241jyNXRSQrPvZ6gBvis6tq3FiCr/T8",
+	"sW8KB0i/tnHk3aMG4g/PSauVhdtZ6vaBp3fXZ94u5mPUao48ZeQpH0+reSc2ENdxPgQjGDWdo6Zz5IDj",
+	"i/j3oOm8E8tt03s+BNMdtZ+j8DcKf7/vB2UYwWr87NsfjSdMS86umCLUB89gl9l5Hg+mwgFvX43ydxaj",
+	"cyqkJkKmTEK4SZkHHhfkUl5W46OemDGekKc5uzb8ec6l0q3AweAVoFIcCmKWVTKZTlherAy5UPgLfnw/",
+	"vW18Ee4/7pvZIhcg1Bd7di+BO3+wyLsxTmmMU/rYcUpmhWNs0hib9PGEHEOBEcHG/IxSzDxjrC8s/HvT",
+	"pi8U/HscaAz/HsO/x/Dv32/496HNMkOJLXHujpkr0G4XDXylDRKa2jzW6hQH2VYwGWW7Ubb7uLIdXHej",
+	"bDfKdh9NtgMOOyDWvCa+tYWXQ6s+8e2PWLEPEfPIMfDBpKOD7hj3/kfjaJXXKvwcvlZ3/w3/vdnVbLXO",
+	"qGZXKAy0P2NBBHetiW8ee8ee2VZ/Kxv12gjFdY4vCMP5GtO0WATnluHeoYLK+JoeX9Pja/rzeU0/5IOk",
+	"xrfGp8n4NPk0L/LmrT3gZh+QxgZ/J7RxAbekrqkdmDvf8w93zdfdkAbOPObHGX19Rl+fKj+Kvg6k0VHq",
+	"ZSgX9PKQH5geGchjMpA6tkdOMnKSz0qyGZyHr1dhiw0HKWzrJ7869Jhibzz448G/DxECktz1HtwfmL6n",
+	"U3uPkZ6fhIn/wU21I9sY2cbHNdJ2JsvrZR3Q7p6Yx71Gh05/vzbizy6WtZfTjVrfMX51tFHfE0Pvys7X",
+	"y89tYOo9cfT7DT2djm4/W7n9PBoDHz2MxgtjvDB+r05NmIvKhBxf0OTSQBR37DQtauYKvBFMN3MbiByu",
+	"Cu78IQw7jrg11S4kO+893UgApBnvE8+HAJC7tY9i+8iFRy78x7PbeJ7bZMc9qQHBdFxmp4lw5VYl8O1S",
+	"0DyoKnjUwo5a2D+wFraWaWoLnex9neUxb98oNI1MbGRit9A8SlQobimMhGrI+2Jin0UevE9RvTeyj5F9",
+	"fKQXUJDXDgOlBuW1S0G5lGgf0IR9fbq2kvuU/MHkQ2hJgPczzjyAAZlRbIxRqXGygHkgpFi12RUueZ52",
+	"ciGX9g19WAalfNsnc57Z+Ls6LCLPNgBQkJdCL2kYZYeJFqC9Dxx7kKi0e4ASA7L6oLz3iLKS3BDeR8mj",
+	"d7s3MftAV+sMeyC0r/EX84N1q5rsTeyPHnA4OZk7BhC4hrkqr7gU+Yrl+tu1FGmRaHQ4l2zBRf5toXYY",
+	"VXrnhVkAZ/Jbo8xgeTp5f3MTrraLs8DhG6PGxqixj3ZDAd03byh7HMzVJOSC5vxfANZ2mVcrPWeEHBlW",
+	"h8xDVT8ixzPcpFBMkiVVhCYJU4bdxDOfHVWg+qOmb31I3WGI4ZFFjSzq0VlUeWNDQkRRO/GOg4W/NxlZ",
+	"tZfhZ5KtheJaSM56UjCeuJabvjyMJ+GYYzbGMX/EmD9izB8xgCmWHGa8Yccb9qM9AvyVuBmS2i5yLbbl",
+	"tyubTh5GoxxM8MjJ4uozj/6cY8a4PyS3qIjbFeG6Lm1vE449iMlg6wqT2cqMFplkjM4ejVujces2fKAj",
+	"RHvQYf6B6Xs/yZ+Jm163LDEe5fEoP/IDoDtsetBxtm5q93ygR1+9e2Yq49tkjHIYn0P3yTs7I5QHsU7r",
+	"H3jvzPOz8BHcVqPzuAxz1CCNXHrk0r9/pRV+U5s86bURY9PTTZ70W4nLtqOZeDQTj2bi0Uw8UFIoGcdo",
+	"KB4NxR/xFi0vxmGm4sjt2G4sLhs/mLk4mOLRDcb1uUeBfzQZ/0H5Rk3+Lr9GBPDtzMaDGI4zHFcYzpYq",
+	"lshEo/F41ACMFqfbcYRO8/GgQw0G5Ac40Z+NEblbvhgP9XioH/150GdIHnSwrRX1AY72aE6+d/YyvlxG",
+	"U8X4WLpfLtpjUh7ERL1R+QHY6GdiWN5W9/PYzHPUNo08e+TZfxAF15W4ZGnCpOZzAyzynLg6/QQa20pQ",
+	"ZEVzumArlmsSdidcqYKl1swIZjeesCeKHOxPCeN6yaT5ppjkNPOmPElolkXH0YJQO2XsMjEQHYTQPwzT",
+	"DqYwc+IYH0kCboEFZxtF4vGZ/0dhdgG7kP4gmHRdH3bkBU0ArMSOlYCcMZl6HgjssMn8bpo8M9Iozjl3",
+	"E5m1eun8YH0OXr0+2WF5IlKWhvyOlCtA94OnByc/P4PsNbkXfENmahw3+CJ3nJZqcrA/I78secag7cG+",
+	"KbRywaAci9BGyJoSRpOlG0xfCzMMJrkhByc/u7IB4jqPKjWjfAfcAAbI70v2wS9bFeCKQi7ZhvCU5WbU",
+	"sjLywT65XgrFACRUe06ND4VkayF1ebMc7OO6DMYwg13V8ca2AdQYTCmSs+vKFdPmdgE3j/yJbQ7Te8x6",
+	"s77kH3YshUQcOi54joUS67OMCtSRs37KnHVhC5R3cUzgjENYKzacTnZd6fUudmrZYVjIvMG0yvrsDyaP",
+	"jUXJR78ke24c1b6HvuhyiDdiYRj/ZJeu+e7Vi8nNe9+nTthHjoIxk6rZU5Zru5BZeUlVP0xuph0DiZzs",
+	"F3p5LMUVT5ms+gcH461tg+7RDFh48+aLhlgSjJhQdz8PGM+wAjNe/X52Y0UZUt+iA4nllC9yni8syUQx",
+	"EE6NraV/yHTPg4lio4Piy7UfAdiOIFdtDmB/74XkdW7KmZhHeddKmW81aIW4QZBX0WwRuzKnJRzO/NAL",
+	"WjVXeNgfsxNvA4LNAUsTKZQiKZ/PmWR5fHRou9XoYcbB6JCVVG99627L3mbHCgIC+kdq8/H3YwXanwEr",
+	"ThiHBUcuUjvilbvb3t/8fwMAevF/3AeJAwA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -25,6 +25,12 @@ const (
 	AppTypeQuadlet   AppType = "quadlet"
 )
 
+// Defines values for ApplicationHttpProbeScheme.
+const (
+	ApplicationHttpProbeSchemeHTTP  ApplicationHttpProbeScheme = "HTTP"
+	ApplicationHttpProbeSchemeHTTPS ApplicationHttpProbeScheme = "HTTPS"
+)
+
 // Defines values for ApplicationStatusType.
 const (
 	ApplicationStatusCompleted ApplicationStatusType = "Completed"
@@ -74,6 +80,37 @@ const (
 	AuthStaticRoleAssignmentTypeStatic AuthStaticRoleAssignmentType = "static"
 )
 
+// Defines values for CARotationDeviceIssuer.
+const (
+	CARotationDeviceIssuerNew      CARotationDeviceIssuer = "New"
+	CARotationDeviceIssuerOther    CARotationDeviceIssuer = "Other"
+	CARotationDeviceIssuerPrevious CARotationDeviceIssuer = "Previous"
+	CARotationDeviceIssuerUnknown  CARotationDeviceIssuer = "Unknown"
+)
+
+// Defines values for CARotationDeviceTrust.
+const (
+	CARotationDeviceTrustNo      CARotationDeviceTrust = "No"
+	CARotationDeviceTrustUnknown CARotationDeviceTrust = "Unknown"
+	CARotationDeviceTrustYes     CARotationDeviceTrust = "Yes"
+)
+
+// Defines values for CARotationPhase.
+const (
+	CARotationPhaseNone       CARotationPhase = "None"
+	CARotationPhasePublishing CARotationPhase = "Publishing"
+	CARotationPhaseRetired    CARotationPhase = "Retired"
+	CARotationPhaseSwitched   CARotationPhase = "Switched"
+)
+
+// Defines values for CertificateRevocationReason.
+const (
+	CertificateRevocationReasonCessationOfOperation CertificateRevocationReason = "CessationOfOperation"
+	CertificateRevocationReasonKeyCompromise        CertificateRevocationReason = "KeyCompromise"
+	CertificateRevocationReasonSuperseded           CertificateRevocationReason = "Superseded"
+	CertificateRevocationReasonUnspecified          CertificateRevocationReason = "Unspecified"
+)
+
 // Defines values for ConditionStatus.
 const (
 	ConditionStatusFalse   ConditionStatus = "False"
@@ -83,10 +120,14 @@ const (
 
 // Defines values for ConditionType.
 const (
+	ConditionTypeCatalogAccessible                    ConditionType = "Accessible"
+	ConditionTypeCatalogSynced                        ConditionType = "Synced"
 	ConditionTypeCertificateSigningRequestApproved    ConditionType = "Approved"
 	ConditionTypeCertificateSigningRequestDenied      ConditionType = "Denied"
 	ConditionTypeCertificateSigningRequestFailed      ConditionType = "Failed"
 	ConditionTypeCertificateSigningRequestTPMVerified ConditionType = "TPMVerified"
+	ConditionTypeDeviceCatalogItemDeprecated          ConditionType = "CatalogItemDeprecated"
+	ConditionTypeDeviceConfigDrifted                  ConditionType = "ConfigDrifted"
 	ConditionTypeDeviceDecommissioning                ConditionType = "DeviceDecommissioning"
 	ConditionTypeDeviceMultipleOwners                 ConditionType = "MultipleOwners"
 	ConditionTypeDeviceSpecValid                      ConditionType = "SpecValid"
@@ -101,6 +142,12 @@ const (
 	ConditionTypeResourceSyncSynced                   ConditionType = "Synced"
 )
 
+// Defines values for ConfigDriftAction.
+const (
+	ConfigDriftActionRemediate ConfigDriftAction = "Remediate"
+	ConfigDriftActionReport    ConfigDriftAction = "Report"
+)
+
 // Defines values for DeviceDecommissionTargetType.
 const (
 	DeviceDecommissionTargetTypeFactoryReset DeviceDecommissionTargetType = "FactoryReset"
@@ -198,63 +245,73 @@ const (
 
 // Defines values for EventReason.
 const (
-	EventReasonDeviceApplicationDegraded       EventReason = "DeviceApplicationDegraded"
-	EventReasonDeviceApplicationError          EventReason = "DeviceApplicationError"
-	EventReasonDeviceApplicationHealthy        EventReason = "DeviceApplicationHealthy"
-	EventReasonDeviceCPUCritical               EventReason = "DeviceCPUCritical"
-	EventReasonDeviceCPUNormal                 EventReason = "DeviceCPUNormal"
-	EventReasonDeviceCPUWarning                EventReason = "DeviceCPUWarning"
-	EventReasonDeviceConflictPaused            EventReason = "DeviceConflictPaused"
-	EventReasonDeviceConflictResolved          EventReason = "DeviceConflictResolved"
-	EventReasonDeviceConnected                 EventReason = "DeviceConnected"
-	EventReasonDeviceContentOutOfDate          EventReason = "DeviceContentOutOfDate"
-	EventReasonDeviceContentUpToDate           EventReason = "DeviceContentUpToDate"
-	EventReasonDeviceContentUpdating           EventReason = "DeviceContentUpdating"
-	EventReasonDeviceDecommissionFailed        EventReason = "DeviceDecommissionFailed"
-	EventReasonDeviceDecommissioned            EventReason = "DeviceDecommissioned"
-	EventReasonDeviceDisconnected              EventReason = "DeviceDisconnected"
-	EventReasonDeviceDiskCritical              EventReason = "DeviceDiskCritical"
-	EventReasonDeviceDiskNormal                EventReason = "DeviceDiskNormal"
-	EventReasonDeviceDiskWarning               EventReason = "DeviceDiskWarning"
-	EventReasonDeviceIsRebooting               EventReason = "DeviceIsRebooting"
-	EventReasonDeviceMemoryCritical            EventReason = "DeviceMemoryCritical"
-	EventReasonDeviceMemoryNormal              EventReason = "DeviceMemoryNormal"
-	EventReasonDeviceMemoryWarning             EventReason = "DeviceMemoryWarning"
-	EventReasonDeviceMultipleOwnersDetected    EventReason = "DeviceMultipleOwnersDetected"
-	EventReasonDeviceMultipleOwnersResolved    EventReason = "DeviceMultipleOwnersResolved"
-	EventReasonDeviceSpecInvalid               EventReason = "DeviceSpecInvalid"
-	EventReasonDeviceSpecValid                 EventReason = "DeviceSpecValid"
-	EventReasonDeviceUpdateFailed              EventReason = "DeviceUpdateFailed"
-	EventReasonEnrollmentRequestApprovalFailed EventReason = "EnrollmentRequestApprovalFailed"
-	EventReasonEnrollmentRequestApproved       EventReason = "EnrollmentRequestApproved"
-	EventReasonFleetInvalid                    EventReason = "FleetInvalid"
-	EventReasonFleetRolloutBatchCompleted      EventReason = "FleetRolloutBatchCompleted"
-	EventReasonFleetRolloutBatchDispatched     EventReason = "FleetRolloutBatchDispatched"
-	EventReasonFleetRolloutCompleted           EventReason = "FleetRolloutCompleted"
-	EventReasonFleetRolloutCreated             EventReason = "FleetRolloutCreated"
-	EventReasonFleetRolloutDeviceSelected      EventReason = "FleetRolloutDeviceSelected"
-	EventReasonFleetRolloutFailed              EventReason = "FleetRolloutFailed"
-	EventReasonFleetRolloutStarted             EventReason = "FleetRolloutStarted"
-	EventReasonFleetValid                      EventReason = "FleetValid"
-	EventReasonInternalTaskFailed              EventReason = "InternalTaskFailed"
-	EventReasonInternalTaskPermanentlyFailed   EventReason = "InternalTaskPermanentlyFailed"
-	EventReasonReferencedRepositoryUpdated     EventReason = "ReferencedRepositoryUpdated"
-	EventReasonRepositoryAccessible            EventReason = "RepositoryAccessible"
-	EventReasonRepositoryInaccessible          EventReason = "RepositoryInaccessible"
-	EventReasonResourceCreated                 EventReason = "ResourceCreated"
-	EventReasonResourceCreationFailed          EventReason = "ResourceCreationFailed"
-	EventReasonResourceDeleted                 EventReason = "ResourceDeleted"
-	EventReasonResourceDeletionFailed          EventReason = "ResourceDeletionFailed"
-	EventReasonResourceSyncAccessible          EventReason = "ResourceSyncAccessible"
-	EventReasonResourceSyncCommitDetected      EventReason = "ResourceSyncCommitDetected"
-	EventReasonResourceSyncInaccessible        EventReason = "ResourceSyncInaccessible"
-	EventReasonResourceSyncParsed              EventReason = "ResourceSyncParsed"
-	EventReasonResourceSyncParsingFailed       EventReason = "ResourceSyncParsingFailed"
-	EventReasonResourceSyncSyncFailed          EventReason = "ResourceSyncSyncFailed"
-	EventReasonResourceSyncSynced              EventReason = "ResourceSyncSynced"
-	EventReasonResourceUpdateFailed            EventReason = "ResourceUpdateFailed"
-	EventReasonResourceUpdated                 EventReason = "ResourceUpdated"
-	EventReasonSystemRestored                  EventReason = "SystemRestored"
+	EventReasonBulkOperationCompleted               EventReason = "BulkOperationCompleted"
+	EventReasonBulkOperationFailed                  EventReason = "BulkOperationFailed"
+	EventReasonBulkOperationProgressed              EventReason = "BulkOperationProgressed"
+	EventReasonDeviceApplicationDegraded            EventReason = "DeviceApplicationDegraded"
+	EventReasonDeviceApplicationError               EventReason = "DeviceApplicationError"
+	EventReasonDeviceApplicationHealthy             EventReason = "DeviceApplicationHealthy"
+	EventReasonDeviceCPUCritical                    EventReason = "DeviceCPUCritical"
+	EventReasonDeviceCPUNormal                      EventReason = "DeviceCPUNormal"
+	EventReasonDeviceCPUWarning                     EventReason = "DeviceCPUWarning"
+	EventReasonDeviceConfigDriftResolved            EventReason = "DeviceConfigDriftResolved"
+	EventReasonDeviceConfigDrifted                  EventReason = "DeviceConfigDrifted"
+	EventReasonDeviceConflictPaused                 EventReason = "DeviceConflictPaused"
+	EventReasonDeviceConflictResolved               EventReason = "DeviceConflictResolved"
+	EventReasonDeviceConnected                      EventReason = "DeviceConnected"
+	EventReasonDeviceContentOutOfDate               EventReason = "DeviceContentOutOfDate"
+	EventReasonDeviceContentUpToDate                EventReason = "DeviceContentUpToDate"
+	EventReasonDeviceContentUpdating                EventReason = "DeviceContentUpdating"
+	EventReasonDeviceDecommissionFailed             EventReason = "DeviceDecommissionFailed"
+	EventReasonDeviceDecommissioned                 EventReason = "DeviceDecommissioned"
+	EventReasonDeviceDisconnected                   EventReason = "DeviceDisconnected"
+	EventReasonDeviceDiskCritical                   EventReason = "DeviceDiskCritical"
+	EventReasonDeviceDiskNormal                     EventReason = "DeviceDiskNormal"
+	EventReasonDeviceDiskWarning                    EventReason = "DeviceDiskWarning"
+	EventReasonDeviceIsRebooting                    EventReason = "DeviceIsRebooting"
+	EventReasonDeviceMemoryCritical                 EventReason = "DeviceMemoryCritical"
+	EventReasonDeviceMemoryNormal                   EventReason = "DeviceMemoryNormal"
+	EventReasonDeviceMemoryWarning                  EventReason = "DeviceMemoryWarning"
+	EventReasonDeviceMultipleOwnersDetected         EventReason = "DeviceMultipleOwnersDetected"
+	EventReasonDeviceMultipleOwnersResolved         EventReason = "DeviceMultipleOwnersResolved"
+	EventReasonDeviceSpecInvalid                    EventReason = "DeviceSpecInvalid"
+	EventReasonDeviceSpecValid                      EventReason = "DeviceSpecValid"
+	EventReasonDeviceUpdateFailed                   EventReason = "DeviceUpdateFailed"
+	EventReasonDeviceUpdateRolledBack               EventReason = "DeviceUpdateRolledBack"
+	EventReasonEnrollmentRequestApprovalFailed      EventReason = "EnrollmentRequestApprovalFailed"
+	EventReasonEnrollmentRequestApproved            EventReason = "EnrollmentRequestApproved"
+	EventReasonEnrollmentRequestAutoApprovalFailed  EventReason = "EnrollmentRequestAutoApprovalFailed"
+	EventReasonEnrollmentRequestAutoApprovalSkipped EventReason = "EnrollmentRequestAutoApprovalSkipped"
+	EventReasonEnrollmentRequestAutoApproved        EventReason = "EnrollmentRequestAutoApproved"
+	EventReasonFleetInvalid                         EventReason = "FleetInvalid"
+	EventReasonFleetRolledBack                      EventReason = "FleetRolledBack"
+	EventReasonFleetRolloutBatchCompleted           EventReason = "FleetRolloutBatchCompleted"
+	EventReasonFleetRolloutBatchDispatched          EventReason = "FleetRolloutBatchDispatched"
+	EventReasonFleetRolloutCompleted                EventReason = "FleetRolloutCompleted"
+	EventReasonFleetRolloutCreated                  EventReason = "FleetRolloutCreated"
+	EventReasonFleetRolloutDeviceSelected           EventReason = "FleetRolloutDeviceSelected"
+	EventReasonFleetRolloutFailed                   EventReason = "FleetRolloutFailed"
+	EventReasonFleetRolloutStarted                  EventReason = "FleetRolloutStarted"
+	EventReasonFleetValid                           EventReason = "FleetValid"
+	EventReasonInternalTaskFailed                   EventReason = "InternalTaskFailed"
+	EventReasonInternalTaskPermanentlyFailed        EventReason = "InternalTaskPermanentlyFailed"
+	EventReasonReferencedRepositoryUpdated          EventReason = "ReferencedRepositoryUpdated"
+	EventReasonRepositoryAccessible                 EventReason = "RepositoryAccessible"
+	EventReasonRepositoryInaccessible               EventReason = "RepositoryInaccessible"
+	EventReasonResourceCreated                      EventReason = "ResourceCreated"
+	EventReasonResourceCreationFailed               EventReason = "ResourceCreationFailed"
+	EventReasonResourceDeleted                      EventReason = "ResourceDeleted"
+	EventReasonResourceDeletionFailed               EventReason = "ResourceDeletionFailed"
+	EventReasonResourceSyncAccessible               EventReason = "ResourceSyncAccessible"
+	EventReasonResourceSyncCommitDetected           EventReason = "ResourceSyncCommitDetected"
+	EventReasonResourceSyncInaccessible             EventReason = "ResourceSyncInaccessible"
+	EventReasonResourceSyncParsed                   EventReason = "ResourceSyncParsed"
+	EventReasonResourceSyncParsingFailed            EventReason = "ResourceSyncParsingFailed"
+	EventReasonResourceSyncSyncFailed               EventReason = "ResourceSyncSyncFailed"
+	EventReasonResourceSyncSynced                   EventReason = "ResourceSyncSynced"
+	EventReasonResourceUpdateFailed                 EventReason = "ResourceUpdateFailed"
+	EventReasonResourceUpdated                      EventReason = "ResourceUpdated"
+	EventReasonSystemRestored                       EventReason = "SystemRestored"
 )
 
 // Defines values for EventType.
@@ -270,6 +327,11 @@ const (
 	FileOperationUpdated FileOperation = "updated"
 )
 
+// Defines values for FleetRolledBackDetailsDetailType.
+const (
+	FleetRolledBack FleetRolledBackDetailsDetailType = "FleetRolledBack"
+)
+
 // Defines values for FleetRolloutBatchCompletedDetailsDetailType.
 const (
 	FleetRolloutBatchCompleted FleetRolloutBatchCompletedDetailsDetailType = "FleetRolloutBatchCompleted"
@@ -459,6 +521,7 @@ const (
 // Defines values for RolloutStrategy.
 const (
 	RolloutStrategyBatchSequence RolloutStrategy = "BatchSequence"
+	RolloutStrategyCanary        RolloutStrategy = "Canary"
 )
 
 // Defines values for SystemdActiveStateType.
@@ -516,6 +579,15 @@ const (
 	Bearer TokenResponseTokenType = "Bearer"
 )
 
+// Defines values for WatchEventType.
+const (
+	ADDED    WatchEventType = "ADDED"
+	BOOKMARK WatchEventType = "BOOKMARK"
+	DELETED  WatchEventType = "DELETED"
+	ERROR    WatchEventType = "ERROR"
+	MODIFIED WatchEventType = "MODIFIED"
+)
+
 // Defines values for ListEventsParamsOrder.
 const (
 	Asc  ListEventsParamsOrder = "asc"
@@ -590,6 +662,69 @@ type ApplicationEnvVars struct {
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 }
 
+// ApplicationExecProbe Probes the application by running a command in one of its containers. The probe succeeds if the command exits with status 0.
+type ApplicationExecProbe struct {
+	// Command The command and its arguments.
+	Command []string `json:"command"`
+
+	// Container The name of the container to run the command in. Defaults to the first running container of the application.
+	Container *string `json:"container,omitempty"`
+}
+
+// ApplicationHealthCheck A probe that checks the health of an application. Exactly one of http, tcp, or exec must be specified.
+type ApplicationHealthCheck struct {
+	// Exec Probes the application by running a command in one of its containers. The probe succeeds if the command exits with status 0.
+	Exec *ApplicationExecProbe `json:"exec,omitempty"`
+
+	// FailureThreshold The number of consecutive failed probes after which the application is considered unhealthy.
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+
+	// Http Probes the application with an HTTP GET request from the device. The probe succeeds if the response status code is between 200 and 399.
+	Http *ApplicationHttpProbe `json:"http,omitempty"`
+
+	// Interval Duration between probes. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	Interval *string `json:"interval,omitempty"`
+
+	// Name The name of the probe, used in status messages. Defaults to the type of the probe.
+	Name *string `json:"name,omitempty"`
+
+	// StartupGracePeriod Duration after the application starts during which failed probes are ignored. Format: integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	StartupGracePeriod *string `json:"startupGracePeriod,omitempty"`
+
+	// SuccessThreshold The number of consecutive successful probes after which an unhealthy application is considered healthy again.
+	SuccessThreshold *int32 `json:"successThreshold,omitempty"`
+
+	// Tcp Probes the application by opening a TCP connection from the device. The probe succeeds if the connection can be established.
+	Tcp *ApplicationTcpProbe `json:"tcp,omitempty"`
+
+	// Timeout Duration after which a probe fails. Must not be longer than the interval. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// ApplicationHealthChecks defines model for ApplicationHealthChecks.
+type ApplicationHealthChecks struct {
+	// HealthChecks Probes the agent runs to check that the application is not only running but also healthy. An application whose probes fail is reported as degraded, or as errored if all of its probes fail.
+	HealthChecks *[]ApplicationHealthCheck `json:"healthChecks,omitempty"`
+}
+
+// ApplicationHttpProbe Probes the application with an HTTP GET request from the device. The probe succeeds if the response status code is between 200 and 399.
+type ApplicationHttpProbe struct {
+	// Host The host to send the request to.
+	Host *string `json:"host,omitempty"`
+
+	// Path The path of the request.
+	Path *string `json:"path,omitempty"`
+
+	// Port The port on the device to send the request to.
+	Port int32 `json:"port"`
+
+	// Scheme The scheme of the request. HTTPS requests do not verify the server certificate.
+	Scheme *ApplicationHttpProbeScheme `json:"scheme,omitempty"`
+}
+
+// ApplicationHttpProbeScheme The scheme of the request. HTTPS requests do not verify the server certificate.
+type ApplicationHttpProbeScheme string
+
 // ApplicationPort Port mapping in format "hostPort:containerPort" (e.g., "8080:80").
 type ApplicationPort = string
 
@@ -612,19 +747,85 @@ type ApplicationResourceLimits struct {
 	// Cpu CPU limit in cores. Format restricted based on application type.
 	Cpu *string `json:"cpu,omitempty"`
 
+	// IoWeight Relative weight of the application's block IO compared to other applications. Applications without a weight have a weight of 100.
+	IoWeight *int `json:"ioWeight,omitempty"`
+
 	// Memory Memory limit with optional unit. Format restricted based on application type.
 	Memory *string `json:"memory,omitempty"`
 }
 
+// ApplicationResourceMonitor Alert rules on the resource usage of an application. Usage percentages are relative to the application's limits, or to the capacity of the device if the application has no limit.
+type ApplicationResourceMonitor struct {
+	// Cpu Alert rules on the CPU usage of the application. Only one alert per severity is allowed.
+	Cpu *[]ResourceAlertRule `json:"cpu,omitempty"`
+
+	// Memory Alert rules on the memory usage of the application. Only one alert per severity is allowed.
+	Memory *[]ResourceAlertRule `json:"memory,omitempty"`
+
+	// SamplingInterval Duration between usage samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+}
+
+// ApplicationResourceSpec defines model for ApplicationResourceSpec.
+type ApplicationResourceSpec struct {
+	// Resources Resource constraints for the application.
+	Resources *ApplicationResources `json:"resources,omitempty"`
+}
+
+// ApplicationResourceUsage Resource usage of an application. Only reported for compose, quadlet and container applications.
+type ApplicationResourceUsage struct {
+	// Cpu The types of resource statuses.
+	Cpu DeviceResourceStatusType `json:"cpu"`
+
+	// CpuPercentage CPU usage of the application as a percentage of its CPU limit, or of all CPUs of the device if it has no limit.
+	CpuPercentage float32 `json:"cpuPercentage"`
+
+	// Memory The types of resource statuses.
+	Memory DeviceResourceStatusType `json:"memory"`
+
+	// MemoryBytes Memory used by the application in bytes.
+	MemoryBytes int64 `json:"memoryBytes"`
+
+	// MemoryPercentage Memory usage of the application as a percentage of its memory limit, or of the memory of the device if it has no limit.
+	MemoryPercentage float32 `json:"memoryPercentage"`
+}
+
 // ApplicationResources Resource constraints for the application.
 type ApplicationResources struct {
 	// Limits Resource limits for the application.
 	Limits *ApplicationResourceLimits `json:"limits,omitempty"`
+
+	// Monitor Alert rules on the resource usage of an application. Usage percentages are relative to the application's limits, or to the capacity of the device if the application has no limit.
+	Monitor *ApplicationResourceMonitor `json:"monitor,omitempty"`
 }
 
 // ApplicationStatusType Status of a single application on the device.
 type ApplicationStatusType string
 
+// ApplicationTcpProbe Probes the application by opening a TCP connection from the device. The probe succeeds if the connection can be established.
+type ApplicationTcpProbe struct {
+	// Host The host to connect to.
+	Host *string `json:"host,omitempty"`
+
+	// Port The port on the device to connect to.
+	Port int32 `json:"port"`
+}
+
+// ApplicationUpdateStatus Result of the last update of an application on the device.
+type ApplicationUpdateStatus struct {
+	// Downtime How long the application was down while switching to the new version, as a duration string such as "4.2s".
+	Downtime string `json:"downtime"`
+
+	// Message Human readable reason why the update was rolled back.
+	Message *string `json:"message,omitempty"`
+
+	// RolledBack Whether the new version failed to start and the previous version was started again.
+	RolledBack bool `json:"rolledBack"`
+
+	// UpdatedAt The time the application was switched to the new version.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // ApplicationUser defines model for ApplicationUser.
 type ApplicationUser struct {
 	// RunAs The username of the system user this application should be run under. This is not the same as the user within any containers of the application (if applicable). Defaults to the user that the agent runs as (generally root) if not specified.
@@ -659,6 +860,30 @@ type ApplicationVolumeStatus struct {
 	Reference string `json:"reference"`
 }
 
+// ApplicationWorkloadStatus Status of a Kubernetes workload, such as a Deployment, StatefulSet or DaemonSet, of an application.
+type ApplicationWorkloadStatus struct {
+	// Kind The kind of the workload, such as Deployment, StatefulSet or DaemonSet.
+	Kind string `json:"kind"`
+
+	// Message Human readable reason why the workload is not ready, such as a pod in CrashLoopBackOff.
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the workload.
+	Name string `json:"name"`
+
+	// Namespace Namespace of the workload.
+	Namespace string `json:"namespace"`
+
+	// Ready The number of ready pods out of the desired pods of the workload.
+	Ready string `json:"ready"`
+
+	// Restarts Number of container restarts observed in the pods of the workload.
+	Restarts int `json:"restarts"`
+
+	// Status Status of a single application on the device.
+	Status ApplicationStatusType `json:"status"`
+}
+
 // ApplicationsSummaryStatusType Status of all applications on the device.
 type ApplicationsSummaryStatusType string
 
@@ -700,6 +925,9 @@ type AuthDynamicRoleAssignment struct {
 	// ClaimPath The JSON path to the role/group claim (e.g., ["groups"], ["roles"], ["realm_access", "roles"]).
 	ClaimPath []string `json:"claimPath"`
 
+	// RoleMapping Maps claim values (e.g., identity provider group names) to the role names they grant. Mapped role names may refer to custom Roles or to built-in roles by their external names (e.g., flightctl-operator). For organization-scoped values, the mapping applies to the part after the separator. Values without a mapping are used as role names unchanged.
+	RoleMapping *map[string][]string `json:"roleMapping,omitempty"`
+
 	// Separator Separator for org:role format (default ':'). Roles containing the separator are split into organization-scoped roles. Roles without separator are global and apply to all organizations.
 	Separator *string `json:"separator,omitempty"`
 
@@ -821,6 +1049,123 @@ type BatchSequence struct {
 	Strategy RolloutStrategy `json:"strategy"`
 }
 
+// CACertificateInfo Information about a CA certificate.
+type CACertificateInfo struct {
+	// KeyId The hex-encoded subject key identifier of the CA certificate.
+	KeyId string `json:"keyId"`
+
+	// NotAfter The time the CA certificate expires.
+	NotAfter time.Time `json:"notAfter"`
+
+	// NotBefore The time the CA certificate becomes valid.
+	NotBefore time.Time `json:"notBefore"`
+
+	// Subject The subject of the CA certificate.
+	Subject string `json:"subject"`
+}
+
+// CARotationDeviceIssuer Which CA of a CA rotation issued the management certificate of a device. Other if it was issued by neither the previous nor the new CA, Unknown if the device has not reported it.
+type CARotationDeviceIssuer string
+
+// CARotationDeviceList CARotationDeviceList is a list of the progress of devices through a CA rotation.
+type CARotationDeviceList struct {
+	// Items The progress of the listed devices.
+	Items []CARotationDeviceProgress `json:"items"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// CARotationDeviceProgress Progress of a device through a CA rotation.
+type CARotationDeviceProgress struct {
+	// CertificateIssuer Which CA of a CA rotation issued the management certificate of a device. Other if it was issued by neither the previous nor the new CA, Unknown if the device has not reported it.
+	CertificateIssuer CARotationDeviceIssuer `json:"certificateIssuer"`
+
+	// CertificateIssuerKeyId The hex-encoded subject key identifier of the CA that issued the management certificate of the device, if reported.
+	CertificateIssuerKeyId *string `json:"certificateIssuerKeyId,omitempty"`
+
+	// Name The name of the device.
+	Name string `json:"name"`
+
+	// TrustsNewCA Whether a device reports trusting the new CA of a CA rotation. Unknown if the device has not reported the CAs it trusts.
+	TrustsNewCA CARotationDeviceTrust `json:"trustsNewCA"`
+}
+
+// CARotationDeviceSummary Progress of the devices of the organization through a CA rotation.
+type CARotationDeviceSummary struct {
+	// IssuedByNewCA The number of devices that report a management certificate issued by the new CA.
+	IssuedByNewCA int64 `json:"issuedByNewCA"`
+
+	// Total The number of devices in the organization.
+	Total int64 `json:"total"`
+
+	// TrustingNewCA The number of devices that report trusting the new CA.
+	TrustingNewCA int64 `json:"trustingNewCA"`
+}
+
+// CARotationDeviceTrust Whether a device reports trusting the new CA of a CA rotation. Unknown if the device has not reported the CAs it trusts.
+type CARotationDeviceTrust string
+
+// CARotationPhase The phase of a CA rotation. In the Publishing phase both CAs are trusted and the previous CA signs certificates; in the Switched phase both CAs are trusted and the new CA signs certificates; in the Retired phase only the new CA is trusted.
+type CARotationPhase string
+
+// CARotationStatus Status of the rotation of the service's CA. Only the signing CA is set if no rotation is configured.
+type CARotationStatus struct {
+	// Devices Progress of the devices of the organization through a CA rotation.
+	Devices *CARotationDeviceSummary `json:"devices,omitempty"`
+
+	// NewCA Information about a CA certificate.
+	NewCA *CACertificateInfo `json:"newCA,omitempty"`
+
+	// Phase The phase of a CA rotation. In the Publishing phase both CAs are trusted and the previous CA signs certificates; in the Switched phase both CAs are trusted and the new CA signs certificates; in the Retired phase only the new CA is trusted.
+	Phase CARotationPhase `json:"phase"`
+
+	// PreviousCA Information about a CA certificate.
+	PreviousCA *CACertificateInfo `json:"previousCA,omitempty"`
+
+	// SigningCA Information about a CA certificate.
+	SigningCA CACertificateInfo `json:"signingCA"`
+
+	// SwitchTime The time the new CA starts signing certificates.
+	SwitchTime *time.Time `json:"switchTime,omitempty"`
+}
+
+// Canary Canary rolls out a new template version to a small cohort of devices first. The cohort must stay online with healthy applications for the soak duration before the rest of the fleet is updated.
+type Canary struct {
+	// Cohort Batch is an element in batch sequence.
+	Cohort Batch `json:"cohort"`
+
+	// RollbackOnFailure Roll the fleet back to the previous template version when the success percentage of the cohort falls below the success threshold. When false, the rollout is suspended instead.
+	RollbackOnFailure *bool `json:"rollbackOnFailure,omitempty"`
+
+	// SoakDuration The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	SoakDuration *Duration `json:"soakDuration,omitempty"`
+
+	// Strategy The strategy of choice for device selection in rollout policy.
+	Strategy RolloutStrategy `json:"strategy"`
+}
+
+// CertificateRevocationReason The reason a certificate was revoked. Maps to the RFC 5280 CRL reason code of the same name.
+type CertificateRevocationReason string
+
+// CertificateRevocationRequest Request to revoke certificates. Exactly one of serialNumber and deviceName must be provided. The reason defaults to Unspecified.
+type CertificateRevocationRequest struct {
+	// DeviceName The name of a device whose management certificates should all be revoked.
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// Reason The reason a certificate was revoked. Maps to the RFC 5280 CRL reason code of the same name.
+	Reason *CertificateRevocationReason `json:"reason,omitempty"`
+
+	// SerialNumber The hex-encoded serial number of the certificate to revoke.
+	SerialNumber *string `json:"serialNumber,omitempty"`
+}
+
+// CertificateRevocationResponse Response from revoking certificates.
+type CertificateRevocationResponse struct {
+	// RevokedCertificates The certificates that are revoked as a result of the request, including ones that had already been revoked.
+	RevokedCertificates []RevokedCertificate `json:"revokedCertificates"`
+}
+
 // CertificateSigningRequest CertificateSigningRequest represents a request for a signed certificate from the CA.
 type CertificateSigningRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -880,6 +1225,9 @@ type CertificateSigningRequestSpec struct {
 
 // CertificateSigningRequestStatus Indicates approval/denial/failure status of the CSR, and contains the issued certificate if any exists.
 type CertificateSigningRequestStatus struct {
+	// CaBundle The PEM-encoded CA certificates the device should trust for the management service. Only set by the service for issued device management certificates, and not persisted.
+	CaBundle *[]byte `json:"caBundle,omitempty"`
+
 	// Certificate The issued signed certificate, immutable once populated.
 	Certificate *[]byte `json:"certificate,omitempty"`
 
@@ -895,9 +1243,15 @@ type ComposeApplication struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
+	// HealthChecks Probes the agent runs to check that the application is not only running but also healthy. An application whose probes fail is reported as degraded, or as errored if all of its probes fail.
+	HealthChecks *[]ApplicationHealthCheck `json:"healthChecks,omitempty"`
+
 	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
 	Name *string `json:"name,omitempty"`
 
+	// Resources Resource constraints for the application.
+	Resources *ApplicationResources `json:"resources,omitempty"`
+
 	// Volumes List of application volumes.
 	Volumes *[]ApplicationVolume `json:"volumes,omitempty"`
 	union   json.RawMessage
@@ -948,6 +1302,15 @@ type ConditionStatus string
 // ConditionType Type of condition in CamelCase.
 type ConditionType string
 
+// ConfigDriftAction The action taken by the agent when it detects drift. Remediate rewrites the drifted files from the rendered device spec, Report only reports the drifted paths in the device status and leaves the files in place.
+type ConfigDriftAction string
+
+// ConfigDriftPolicy Specifies how the agent handles files written by config providers that were changed or deleted on the device.
+type ConfigDriftPolicy struct {
+	// Action The action taken by the agent when it detects drift. Remediate rewrites the drifted files from the rendered device spec, Report only reports the drifted paths in the device status and leaves the files in place.
+	Action *ConfigDriftAction `json:"action,omitempty"`
+}
+
 // ConfigProviderSpec defines model for ConfigProviderSpec.
 type ConfigProviderSpec struct {
 	union json.RawMessage
@@ -961,6 +1324,9 @@ type ContainerApplication struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
+	// HealthChecks Probes the agent runs to check that the application is not only running but also healthy. An application whose probes fail is reported as degraded, or as errored if all of its probes fail.
+	HealthChecks *[]ApplicationHealthCheck `json:"healthChecks,omitempty"`
+
 	// Image Reference to the image for this container.
 	Image string `json:"image"`
 
@@ -1028,6 +1394,21 @@ type Device struct {
 	Status *DeviceStatus `json:"status,omitempty"`
 }
 
+// DeviceAggregate DeviceAggregate contains the number of devices per group of values of the requested keys.
+type DeviceAggregate struct {
+	// GroupBy The keys the devices are grouped by.
+	GroupBy []string `json:"groupBy"`
+
+	// Groups The groups of devices, ordered by descending count.
+	Groups []DeviceGroupCount `json:"groups"`
+
+	// Total The total number of devices matching the selectors, including the devices of groups that were not returned.
+	Total int64 `json:"total"`
+
+	// Truncated Whether there are more groups than were returned because of the limit.
+	Truncated bool `json:"truncated"`
+}
+
 // DeviceApplicationStatus defines model for DeviceApplicationStatus.
 type DeviceApplicationStatus struct {
 	// AppType The type of the application.
@@ -1036,12 +1417,18 @@ type DeviceApplicationStatus struct {
 	// Embedded Whether the application is embedded in the bootc image.
 	Embedded bool `json:"embedded"`
 
+	// LastUpdate Result of the last update of an application on the device.
+	LastUpdate *ApplicationUpdateStatus `json:"lastUpdate,omitempty"`
+
 	// Name Human readable name of the application.
 	Name string `json:"name"`
 
 	// Ready The number of containers which are ready in the application.
 	Ready string `json:"ready"`
 
+	// Resources Resource usage of an application. Only reported for compose, quadlet and container applications.
+	Resources *ApplicationResourceUsage `json:"resources,omitempty"`
+
 	// Restarts Number of restarts observed for the application.
 	Restarts int `json:"restarts"`
 
@@ -1053,6 +1440,9 @@ type DeviceApplicationStatus struct {
 
 	// Volumes Status of volumes used by this application.
 	Volumes *[]ApplicationVolumeStatus `json:"volumes,omitempty"`
+
+	// Workloads Status of the Kubernetes workloads of the application. Only reported for Helm applications.
+	Workloads *[]ApplicationWorkloadStatus `json:"workloads,omitempty"`
 }
 
 // DeviceApplicationsSummaryStatus A summary of the health of applications on the device.
@@ -1066,6 +1456,9 @@ type DeviceApplicationsSummaryStatus struct {
 
 // DeviceConfigStatus Current status of the device config.
 type DeviceConfigStatus struct {
+	// DriftedPaths Paths of files written by config providers whose content on the device no longer matches the rendered device spec.
+	DriftedPaths *[]string `json:"driftedPaths,omitempty"`
+
 	// RenderedVersion Rendered version of the device config.
 	RenderedVersion string `json:"renderedVersion"`
 }
@@ -1088,6 +1481,15 @@ type DeviceDecommission struct {
 // DeviceDecommissionTargetType Specifies the desired decommissioning method of the device.
 type DeviceDecommissionTargetType string
 
+// DeviceGroupCount DeviceGroupCount is the number of devices sharing the same values of the grouping keys.
+type DeviceGroupCount struct {
+	// Count The number of devices in this group.
+	Count int64 `json:"count"`
+
+	// Values The values of the grouping keys for this group. Keys the devices of the group have no value for are omitted.
+	Values map[string]string `json:"values"`
+}
+
 // DeviceIntegrityCheckStatus DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
 type DeviceIntegrityCheckStatus struct {
 	// Info Human-readable information about the integrity check status.
@@ -1268,6 +1670,9 @@ type DeviceSpec struct {
 	// Config List of config providers.
 	Config *[]ConfigProviderSpec `json:"config,omitempty"`
 
+	// ConfigDriftPolicy Specifies how the agent handles files written by config providers that were changed or deleted on the device.
+	ConfigDriftPolicy *ConfigDriftPolicy `json:"configDriftPolicy,omitempty"`
+
 	// Consoles The list of active console sessions.
 	Consoles *[]DeviceConsole `json:"consoles,omitempty"`
 
@@ -1707,6 +2112,39 @@ type FleetList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// FleetRollbackRequest Request to roll a fleet back to one of its existing TemplateVersions. The fleet stays on that TemplateVersion until its template is changed again.
+type FleetRollbackRequest struct {
+	// TemplateVersion The name of the TemplateVersion of the fleet to roll back to.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRollbackStatus FleetRollbackStatus records the most recent rollback of a fleet to a previous TemplateVersion.
+type FleetRollbackStatus struct {
+	// FromTemplateVersion The name of the TemplateVersion that was current before the rollback.
+	FromTemplateVersion *string `json:"fromTemplateVersion,omitempty"`
+
+	// RolledBackAt The time the rollback was requested.
+	RolledBackAt time.Time `json:"rolledBackAt"`
+
+	// TemplateVersion The name of the TemplateVersion the fleet was rolled back to.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolledBackDetails defines model for FleetRolledBackDetails.
+type FleetRolledBackDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolledBackDetailsDetailType `json:"detailType"`
+
+	// FromTemplateVersion The name of the TemplateVersion that was current before the rollback.
+	FromTemplateVersion *string `json:"fromTemplateVersion,omitempty"`
+
+	// TemplateVersion The name of the TemplateVersion that the fleet was rolled back to.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolledBackDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolledBackDetailsDetailType string
+
 // FleetRolloutBatchCompletedDetails defines model for FleetRolloutBatchCompletedDetails.
 type FleetRolloutBatchCompletedDetails struct {
 	// Batch The batch within the fleet rollout.
@@ -1813,6 +2251,15 @@ type FleetRolloutStartedDetailsRolloutStrategy string
 type FleetRolloutStatus struct {
 	// CurrentBatch The batch number currently being rolled out.
 	CurrentBatch *int `json:"currentBatch,omitempty"`
+
+	// LastRollback FleetRollbackStatus records the most recent rollback of a fleet to a previous TemplateVersion.
+	LastRollback *FleetRollbackStatus `json:"lastRollback,omitempty"`
+
+	// MaintenanceWindowOpen Whether a maintenance window of the fleet is currently open. Only set if the fleet defines maintenance windows.
+	MaintenanceWindowOpen *bool `json:"maintenanceWindowOpen,omitempty"`
+
+	// NextMaintenanceWindow The time the next maintenance window of the fleet opens. Only set if the fleet defines maintenance windows and none is currently open.
+	NextMaintenanceWindow *time.Time `json:"nextMaintenanceWindow,omitempty"`
 }
 
 // FleetSpec FleetSpec is a description of a fleet's target state.
@@ -1841,6 +2288,9 @@ type FleetStatus struct {
 	// DevicesSummary A summary of the devices in the fleet returned when fetching a single Fleet.
 	DevicesSummary *DevicesSummary `json:"devicesSummary,omitempty"`
 
+	// DryRunDevices The devices matching the selector of the fleet, with the device spec that they would be rendered to. Only returned in responses to dry-run requests, for a sample of the matching devices.
+	DryRunDevices *[]Device `json:"dryRunDevices,omitempty"`
+
 	// Rollout FleetRolloutStatus represents information about the status of a fleet rollout.
 	Rollout *FleetRolloutStatus `json:"rollout,omitempty"`
 }
@@ -2190,6 +2640,30 @@ type ListMeta struct {
 	RemainingItemCount *int64 `json:"remainingItemCount,omitempty"`
 }
 
+// MaintenanceWindow MaintenanceWindow defines a recurring (cron) or fixed (time range) window during which a fleet rollout may update devices. Exactly one of at+duration or start+end must be set, and at+duration requires timeZone.
+type MaintenanceWindow struct {
+	// At Cron expression format for scheduling times.
+	// The format is `* * * * *`: - Minutes: `*` matches 0-59. - Hours: `*` matches 0-23. - Day of Month: `*` matches 1-31. - Month: `*` matches 1-12. - Day of Week: `*` matches 0-6.
+	// Supported operators: - `*`: Matches any value (e.g., `*` in hours matches every hour). - `-`: Range (e.g., `0-8` for 12 AM to 8 AM). - `,`: List (e.g., `1,12` for 1st and 12th minute). - `/`: Step (e.g., `*/12` for every 12th minute). - Single value (e.g., `8` matches the 8th minute).
+	// Example: `* 0-8,16-23 * * *`.
+	At *CronExpression `json:"at,omitempty"`
+
+	// Duration The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	Duration *Duration `json:"duration,omitempty"`
+
+	// End The end of a fixed window.
+	End *time.Time `json:"end,omitempty"`
+
+	// Selector A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. Empty/null label selectors match nothing.
+	Selector *LabelSelector `json:"selector,omitempty"`
+
+	// Start The start of a fixed window.
+	Start *time.Time `json:"start,omitempty"`
+
+	// TimeZone Time zone identifiers follow the IANA format AREA/LOCATION, where AREA represents a continent or ocean, and LOCATION specifies a particular site within that area, for example America/New_York, Europe/Paris. Only unambiguous 3-character time zones are supported ("GMT", "UTC").
+	TimeZone *TimeZone `json:"timeZone,omitempty"`
+}
+
 // MatchExpression defines model for MatchExpression.
 type MatchExpression struct {
 	// Key The label key that the selector applies to.
@@ -2359,6 +2833,24 @@ type OciAuth struct {
 // OciAuthType The type of authentication for OCI registries.
 type OciAuthType string
 
+// OciConfigProviderSpec defines model for OciConfigProviderSpec.
+type OciConfigProviderSpec struct {
+	// Name The name of the config provider.
+	Name string `json:"name"`
+
+	// OciRef The reference to a configuration bundle published as an OCI artifact.
+	OciRef struct {
+		// MountPath Path of the directory in the device's file system into which the artifact's layers are unpacked.
+		MountPath string `json:"mountPath"`
+
+		// Reference The artifact within the Repository's registry, followed by a tag or digest (e.g., configs/edge:v1 or configs/edge@sha256:...). In the rendered device spec this is replaced by the fully qualified reference pinned to the digest resolved at render time.
+		Reference string `json:"reference"`
+
+		// Repository The name of the OCI Repository resource.
+		Repository string `json:"repository"`
+	} `json:"ociRef"`
+}
+
 // OciRepoSpec OCI container registry specification.
 type OciRepoSpec struct {
 	// AccessMode Access mode for the registry: "Read" for read-only (pull), "ReadWrite" for read-write (pull and push).
@@ -2493,6 +2985,9 @@ type Percentage = string
 
 // Permission A permission defining allowed operations on a resource.
 type Permission struct {
+	// LabelSelector If set, the operations are only allowed on the devices or fleets whose labels match this selector.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
 	// Operations List of allowed operations (e.g., "get", "list", "create", "update", "patch", "delete", "*" for all operations).
 	Operations []string `json:"operations"`
 
@@ -2514,9 +3009,15 @@ type QuadletApplication struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime. The key and value each must be between 1 and 253 characters.
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 
+	// HealthChecks Probes the agent runs to check that the application is not only running but also healthy. An application whose probes fail is reported as degraded, or as errored if all of its probes fail.
+	HealthChecks *[]ApplicationHealthCheck `json:"healthChecks,omitempty"`
+
 	// Name The application name must be 1–253 characters long, start with a letter or number, and contain no whitespace.
 	Name *string `json:"name,omitempty"`
 
+	// Resources Resource constraints for the application.
+	Resources *ApplicationResources `json:"resources,omitempty"`
+
 	// RunAs The username of the system user this application should be run under. This is not the same as the user within any containers of the application (if applicable). Defaults to the user that the agent runs as (generally root) if not specified.
 	RunAs Username `json:"runAs,omitempty"`
 
@@ -2721,6 +3222,30 @@ type ResourceUpdatedDetailsDetailType string
 // ResourceUpdatedDetailsUpdatedFields defines model for ResourceUpdatedDetails.UpdatedFields.
 type ResourceUpdatedDetailsUpdatedFields string
 
+// RevokedCertificate A certificate that the service no longer accepts.
+type RevokedCertificate struct {
+	// CommonName The subject common name of the revoked certificate, if known.
+	CommonName *string `json:"commonName,omitempty"`
+
+	// DeviceName The name of the device the certificate was issued to, if known.
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// ExpiresAt The time the certificate expires, if known.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// IssuerKeyId The hex-encoded key identifier of the CA that issued the certificate.
+	IssuerKeyId string `json:"issuerKeyId"`
+
+	// Reason The reason a certificate was revoked. Maps to the RFC 5280 CRL reason code of the same name.
+	Reason CertificateRevocationReason `json:"reason"`
+
+	// RevokedAt The time the certificate was revoked.
+	RevokedAt time.Time `json:"revokedAt"`
+
+	// SerialNumber The hex-encoded serial number of the revoked certificate.
+	SerialNumber string `json:"serialNumber"`
+}
+
 // Rfc7662IntrospectionSpec Rfc7662IntrospectionSpec defines token introspection using RFC 7662 standard. Uses the OAuth2ProviderSpec clientId and clientSecret for authentication.
 type Rfc7662IntrospectionSpec struct {
 	// Type The introspection type.
@@ -2749,6 +3274,9 @@ type RolloutPolicy struct {
 	// DisruptionBudget DisruptionBudget defines the level of allowed disruption when rollout is in progress.
 	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
 
+	// MaintenanceWindows Time windows in which new devices of a rollout may be approved and rendered. Outside of an open window the rollout pauses and resumes when the next window opens. If not specified, the rollout is not restricted in time.
+	MaintenanceWindows *[]MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
 	// SuccessThreshold Percentage is the string format representing percentage string.
 	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
 }
@@ -2869,6 +3397,9 @@ type TemplateVersionStatus struct {
 	// Config List of config providers.
 	Config *[]ConfigProviderSpec `json:"config,omitempty"`
 
+	// ConfigDriftPolicy Specifies how the agent handles files written by config providers that were changed or deleted on the device.
+	ConfigDriftPolicy *ConfigDriftPolicy `json:"configDriftPolicy,omitempty"`
+
 	// Consoles The list of active console sessions.
 	Consoles *[]DeviceConsole `json:"consoles,omitempty"`
 
@@ -2996,6 +3527,24 @@ type VolumeMount struct {
 	Path string `json:"path"`
 }
 
+// WatchEvent WatchEvent describes a change to a watched resource.
+type WatchEvent struct {
+	// Object The resource the change applies to. DELETED events only carry the apiVersion, kind and metadata.name of the deleted resource. Not set for BOOKMARK and ERROR events.
+	Object interface{} `json:"object,omitempty"`
+
+	// ResourceVersion The position in the stream of changes. Set for BOOKMARK events, and may be passed as the 'resourceVersion' parameter to resume watching from this point.
+	ResourceVersion *string `json:"resourceVersion,omitempty"`
+
+	// Status Status is a return value for calls that don't return other objects.
+	Status *Status `json:"status,omitempty"`
+
+	// Type The type of a WatchEvent. ERROR events carry the status of a failure that ended the watch.
+	Type WatchEventType `json:"type"`
+}
+
+// WatchEventType The type of a WatchEvent. ERROR events carry the status of a failure that ended the watch.
+type WatchEventType string
+
 // AuthValidateParams defines parameters for AuthValidate.
 type AuthValidateParams struct {
 	// Authorization The authentication token to validate.
@@ -3017,6 +3566,21 @@ type ListAuthProvidersParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListCARotationDevicesParams defines parameters for ListCARotationDevices.
+type ListCARotationDevicesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the parameter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the listed devices by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the listed devices by their fields, using the same fields and operators as when listing devices.
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListCertificateSigningRequestsParams defines parameters for ListCertificateSigningRequests.
 type ListCertificateSigningRequestsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -3048,6 +3612,45 @@ type ListDevicesParams struct {
 
 	// SummaryOnly A boolean flag to include only a summary of the devices. When set to true, the response will contain only the summary information. Only the 'owner' and 'labelSelector' parameters are supported when 'summaryOnly' is true.
 	SummaryOnly *bool `form:"summaryOnly,omitempty" json:"summaryOnly,omitempty"`
+
+	// Watch Watch for changes to the described resources instead of listing them. Changes are streamed as newline-delimited WatchEvent objects with the 'application/x-ndjson' content type. The 'continue' and 'limit' parameters are ignored when 'watch' is true.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion When watching, stream changes after the given resourceVersion, as returned in a BOOKMARK WatchEvent, instead of starting with the current state of the resources.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+}
+
+// CreateDeviceParams defines parameters for CreateDevice.
+type CreateDeviceParams struct {
+	// DryRun If true, the request is validated and the resulting resource is returned, but it is not persisted.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// AggregateDevicesParams defines parameters for AggregateDevices.
+type AggregateDevicesParams struct {
+	// LabelSelector A selector to restrict the counted devices by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the counted devices by their fields, using the same fields and operators as when listing devices.
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// GroupBy The keys to group the devices by. A key is either a label key prefixed with 'metadata.labels.' (e.g., "metadata.labels.site"), 'metadata.owner', or a device status field selector such as 'status.summary.status', 'status.os.image' or 'status.systemInfo.kernel'. May be repeated to group by several keys.
+	GroupBy []string `form:"groupBy" json:"groupBy"`
+
+	// Limit The maximum number of groups returned, 1000 at most. Defaults to 1000. The largest groups are returned, and the response is marked as truncated if there are more.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PatchDeviceParams defines parameters for PatchDevice.
+type PatchDeviceParams struct {
+	// DryRun If true, the request is validated and the resulting resource is returned, but it is not persisted.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ReplaceDeviceParams defines parameters for ReplaceDevice.
+type ReplaceDeviceParams struct {
+	// DryRun If true, the request is validated and the resulting resource is returned, but it is not persisted.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
 }
 
 // GetRenderedDeviceParams defines parameters for GetRenderedDevice.
@@ -3075,6 +3678,12 @@ type ListEnrollmentRequestsParams struct {
 
 	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Watch Watch for changes to the described resources instead of listing them. Changes are streamed as newline-delimited WatchEvent objects with the 'application/x-ndjson' content type. The 'continue' and 'limit' parameters are ignored when 'watch' is true.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion When watching, stream changes after the given resourceVersion, as returned in a BOOKMARK WatchEvent, instead of starting with the current state of the resources.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
 }
 
 // ListEventsParams defines parameters for ListEvents.
@@ -3090,6 +3699,12 @@ type ListEventsParams struct {
 
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
 	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Watch Watch for changes to the described resources instead of listing them. Changes are streamed as newline-delimited WatchEvent objects with the 'application/x-ndjson' content type. The 'continue' and 'limit' parameters are ignored when 'watch' is true.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion When watching, stream changes after the given resourceVersion, as returned in a BOOKMARK WatchEvent, instead of starting with the current state of the resources.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
 }
 
 // ListEventsParamsOrder defines parameters for ListEvents.
@@ -3111,6 +3726,18 @@ type ListFleetsParams struct {
 
 	// AddDevicesSummary Include a summary of the devices in the fleet.
 	AddDevicesSummary *bool `form:"addDevicesSummary,omitempty" json:"addDevicesSummary,omitempty"`
+
+	// Watch Watch for changes to the described resources instead of listing them. Changes are streamed as newline-delimited WatchEvent objects with the 'application/x-ndjson' content type. The 'continue' and 'limit' parameters are ignored when 'watch' is true.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion When watching, stream changes after the given resourceVersion, as returned in a BOOKMARK WatchEvent, instead of starting with the current state of the resources.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+}
+
+// CreateFleetParams defines parameters for CreateFleet.
+type CreateFleetParams struct {
+	// DryRun If true, the request is validated and the resulting resource is returned, but it is not persisted.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
 }
 
 // ListTemplateVersionsParams defines parameters for ListTemplateVersions.
@@ -3134,6 +3761,18 @@ type GetFleetParams struct {
 	AddDevicesSummary *bool `form:"addDevicesSummary,omitempty" json:"addDevicesSummary,omitempty"`
 }
 
+// PatchFleetParams defines parameters for PatchFleet.
+type PatchFleetParams struct {
+	// DryRun If true, the request is validated and the resulting resource is returned, but it is not persisted.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ReplaceFleetParams defines parameters for ReplaceFleet.
+type ReplaceFleetParams struct {
+	// DryRun If true, the request is validated and the resulting resource is returned, but it is not persisted.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // ListLabelsParams defines parameters for ListLabels.
 type ListLabelsParams struct {
 	// Kind The type of resource to retrieve labels from.
@@ -3188,6 +3827,12 @@ type ListResourceSyncsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetCertificateRevocationListParams defines parameters for GetCertificateRevocationList.
+type GetCertificateRevocationListParams struct {
+	// IssuerKeyId The hex-encoded subject key identifier of the CA whose CRL to get, as reported by the CA rotation status. Defaults to the CA that signs new certificates.
+	IssuerKeyId *string `form:"issuerKeyId,omitempty" json:"issuerKeyId,omitempty"`
+}
+
 // AuthTokenJSONRequestBody defines body for AuthToken for application/json ContentType.
 type AuthTokenJSONRequestBody = TokenRequest
 
@@ -3263,6 +3908,9 @@ type PatchFleetApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceFleetJSONRequestBody defines body for ReplaceFleet for application/json ContentType.
 type ReplaceFleetJSONRequestBody = Fleet
 
+// RollbackFleetJSONRequestBody defines body for RollbackFleet for application/json ContentType.
+type RollbackFleetJSONRequestBody = FleetRollbackRequest
+
 // PatchFleetStatusApplicationJSONPatchPlusJSONRequestBody defines body for PatchFleetStatus for application/json-patch+json ContentType.
 type PatchFleetStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 
@@ -3287,6 +3935,9 @@ type PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceResourceSyncJSONRequestBody defines body for ReplaceResourceSync for application/json ContentType.
 type ReplaceResourceSyncJSONRequestBody = ResourceSync
 
+// RevokeCertificatesJSONRequestBody defines body for RevokeCertificates for application/json ContentType.
+type RevokeCertificatesJSONRequestBody = CertificateRevocationRequest
+
 // Getter for additional properties for DeviceSystemInfo. Returns the specified
 // element and whether it was found
 func (a DeviceSystemInfo) Get(fieldName string) (value string, found bool) {
@@ -4216,6 +4867,13 @@ func (t ComposeApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.HealthChecks != nil {
+		object["healthChecks"], err = json.Marshal(t.HealthChecks)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'healthChecks': %w", err)
+		}
+	}
+
 	if t.Name != nil {
 		object["name"], err = json.Marshal(t.Name)
 		if err != nil {
@@ -4223,6 +4881,13 @@ func (t ComposeApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.Resources != nil {
+		object["resources"], err = json.Marshal(t.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'resources': %w", err)
+		}
+	}
+
 	if t.Volumes != nil {
 		object["volumes"], err = json.Marshal(t.Volumes)
 		if err != nil {
@@ -4258,6 +4923,13 @@ func (t *ComposeApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["healthChecks"]; found {
+		err = json.Unmarshal(raw, &t.HealthChecks)
+		if err != nil {
+			return fmt.Errorf("error reading 'healthChecks': %w", err)
+		}
+	}
+
 	if raw, found := object["name"]; found {
 		err = json.Unmarshal(raw, &t.Name)
 		if err != nil {
@@ -4265,6 +4937,13 @@ func (t *ComposeApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["resources"]; found {
+		err = json.Unmarshal(raw, &t.Resources)
+		if err != nil {
+			return fmt.Errorf("error reading 'resources': %w", err)
+		}
+	}
+
 	if raw, found := object["volumes"]; found {
 		err = json.Unmarshal(raw, &t.Volumes)
 		if err != nil {
@@ -4379,6 +5058,32 @@ func (t *ConfigProviderSpec) MergeHttpConfigProviderSpec(v HttpConfigProviderSpe
 	return err
 }
 
+// AsOciConfigProviderSpec returns the union data inside the ConfigProviderSpec as a OciConfigProviderSpec
+func (t ConfigProviderSpec) AsOciConfigProviderSpec() (OciConfigProviderSpec, error) {
+	var body OciConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOciConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided OciConfigProviderSpec
+func (t *ConfigProviderSpec) FromOciConfigProviderSpec(v OciConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOciConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided OciConfigProviderSpec
+func (t *ConfigProviderSpec) MergeOciConfigProviderSpec(v OciConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ConfigProviderSpec) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -4891,6 +5596,34 @@ func (t *EventDetails) MergeFleetRolloutDeviceSelectedDetails(v FleetRolloutDevi
 	return err
 }
 
+// AsFleetRolledBackDetails returns the union data inside the EventDetails as a FleetRolledBackDetails
+func (t EventDetails) AsFleetRolledBackDetails() (FleetRolledBackDetails, error) {
+	var body FleetRolledBackDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolledBackDetails overwrites any union data inside the EventDetails as the provided FleetRolledBackDetails
+func (t *EventDetails) FromFleetRolledBackDetails(v FleetRolledBackDetails) error {
+	v.DetailType = "FleetRolledBack"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolledBackDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolledBackDetails
+func (t *EventDetails) MergeFleetRolledBackDetails(v FleetRolledBackDetails) error {
+	v.DetailType = "FleetRolledBack"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t EventDetails) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"detailType"`
@@ -4911,6 +5644,8 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsDeviceMultipleOwnersResolvedDetails()
 	case "DeviceOwnershipChanged":
 		return t.AsDeviceOwnershipChangedDetails()
+	case "FleetRolledBack":
+		return t.AsFleetRolledBackDetails()
 	case "FleetRolloutBatchCompleted":
 		return t.AsFleetRolloutBatchCompletedDetails()
 	case "FleetRolloutBatchDispatched":
@@ -5349,6 +6084,13 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.HealthChecks != nil {
+		object["healthChecks"], err = json.Marshal(t.HealthChecks)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'healthChecks': %w", err)
+		}
+	}
+
 	if t.Name != nil {
 		object["name"], err = json.Marshal(t.Name)
 		if err != nil {
@@ -5356,6 +6098,13 @@ func (t QuadletApplication) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.Resources != nil {
+		object["resources"], err = json.Marshal(t.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'resources': %w", err)
+		}
+	}
+
 	object["runAs"], err = json.Marshal(t.RunAs)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'runAs': %w", err)
@@ -5396,6 +6145,13 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["healthChecks"]; found {
+		err = json.Unmarshal(raw, &t.HealthChecks)
+		if err != nil {
+			return fmt.Errorf("error reading 'healthChecks': %w", err)
+		}
+	}
+
 	if raw, found := object["name"]; found {
 		err = json.Unmarshal(raw, &t.Name)
 		if err != nil {
@@ -5403,6 +6159,13 @@ func (t *QuadletApplication) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["resources"]; found {
+		err = json.Unmarshal(raw, &t.Resources)
+		if err != nil {
+			return fmt.Errorf("error reading 'resources': %w", err)
+		}
+	}
+
 	if raw, found := object["runAs"]; found {
 		err = json.Unmarshal(raw, &t.RunAs)
 		if err != nil {
@@ -5686,6 +6449,34 @@ func (t *RolloutDeviceSelection) MergeBatchSequence(v BatchSequence) error {
 	return err
 }
 
+// AsCanary returns the union data inside the RolloutDeviceSelection as a Canary
+func (t RolloutDeviceSelection) AsCanary() (Canary, error) {
+	var body Canary
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCanary overwrites any union data inside the RolloutDeviceSelection as the provided Canary
+func (t *RolloutDeviceSelection) FromCanary(v Canary) error {
+	v.Strategy = "Canary"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCanary performs a merge with any union data inside the RolloutDeviceSelection, using the provided Canary
+func (t *RolloutDeviceSelection) MergeCanary(v Canary) error {
+	v.Strategy = "Canary"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t RolloutDeviceSelection) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"strategy"`
@@ -5702,6 +6493,8 @@ func (t RolloutDeviceSelection) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "BatchSequence":
 		return t.AsBatchSequence()
+	case "Canary":
+		return t.AsCanary()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -18,6 +18,23 @@ type DeviceConsoleSessionMetadata struct {
 	Command           *DeviceCommand `json:"command,omitempty"`
 	TTY               bool           `json:"tty,omitempty"`
 	Protocols         []string       `json:"protocols,omitempty"`
+	// Logs makes the session stream the requested logs of the device instead of running a command
+	Logs *DeviceLogsRequest `json:"logs,omitempty"`
+}
+
+// DeviceLogsRequest selects the logs streamed by a device logs session. Without an application the
+// logs are read from the system journal, optionally restricted to some systemd units.
+type DeviceLogsRequest struct {
+	// Units restricts the journal logs to these systemd units
+	Units []string `json:"units,omitempty"`
+	// Application streams the logs of the containers of this application instead of the journal
+	Application string `json:"application,omitempty"`
+	// Follow keeps streaming new logs until the session is closed
+	Follow bool `json:"follow,omitempty"`
+	// Since only returns logs newer than a relative duration (e.g. 10m) or an RFC 3339 timestamp
+	Since string `json:"since,omitempty"`
+	// Tail only returns this number of most recent lines
+	Tail *int64 `json:"tail,omitempty"`
 }
 
 type RolloutBatchCompletionReport struct {
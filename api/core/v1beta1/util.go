@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"text/template"
+	"time"
 )
 
 type DeviceCompletionCount struct {
@@ -36,6 +37,7 @@ const (
 	HttpConfigProviderType       ConfigProviderType = "httpRef"
 	InlineConfigProviderType     ConfigProviderType = "inline"
 	KubernetesSecretProviderType ConfigProviderType = "secretRef"
+	OciConfigProviderType        ConfigProviderType = "ociRef"
 )
 
 type ApplicationProviderType string
@@ -107,6 +109,7 @@ func (c ConfigProviderSpec) Type() (ConfigProviderType, error) {
 		HttpConfigProviderType,
 		InlineConfigProviderType,
 		KubernetesSecretProviderType,
+		OciConfigProviderType,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -334,6 +337,87 @@ func (rd DeviceSpec) GetConsoles() []DeviceConsole {
 	}
 }
 
+// SinceTime returns the time from which logs are requested, or nil if all logs are requested.
+func (r DeviceLogsRequest) SinceTime(now time.Time) (*time.Time, error) {
+	if r.Since == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(r.Since); err == nil {
+		if d < 0 {
+			return nil, fmt.Errorf("since duration must not be negative: %s", r.Since)
+		}
+		since := now.Add(-d)
+		return &since, nil
+	}
+	since, err := time.Parse(time.RFC3339, r.Since)
+	if err != nil {
+		return nil, fmt.Errorf("since must be a duration (e.g. 10m) or an RFC 3339 timestamp: %s", r.Since)
+	}
+	return &since, nil
+}
+
+const (
+	DefaultApplicationHealthCheckInterval         = 10 * time.Second
+	DefaultApplicationHealthCheckTimeout          = time.Second
+	DefaultApplicationHealthCheckFailureThreshold = 3
+	DefaultApplicationHealthCheckSuccessThreshold = 1
+)
+
+// ProbeName returns the name of the health check, or the type of its probe if no name is set.
+func (h ApplicationHealthCheck) ProbeName() string {
+	if h.Name != nil && *h.Name != "" {
+		return *h.Name
+	}
+	switch {
+	case h.Http != nil:
+		return "http"
+	case h.Tcp != nil:
+		return "tcp"
+	case h.Exec != nil:
+		return "exec"
+	default:
+		return "unknown"
+	}
+}
+
+// GetInterval returns the duration between probes.
+func (h ApplicationHealthCheck) GetInterval() (time.Duration, error) {
+	return parseOptionalDuration(h.Interval, DefaultApplicationHealthCheckInterval)
+}
+
+// GetTimeout returns the duration after which a probe fails.
+func (h ApplicationHealthCheck) GetTimeout() (time.Duration, error) {
+	return parseOptionalDuration(h.Timeout, DefaultApplicationHealthCheckTimeout)
+}
+
+// GetStartupGracePeriod returns the duration after the application starts during which failed probes are ignored.
+func (h ApplicationHealthCheck) GetStartupGracePeriod() (time.Duration, error) {
+	return parseOptionalDuration(h.StartupGracePeriod, 0)
+}
+
+// GetFailureThreshold returns the number of consecutive failed probes after which the application is unhealthy.
+func (h ApplicationHealthCheck) GetFailureThreshold() int {
+	if h.FailureThreshold == nil || *h.FailureThreshold < 1 {
+		return DefaultApplicationHealthCheckFailureThreshold
+	}
+	return int(*h.FailureThreshold)
+}
+
+// GetSuccessThreshold returns the number of consecutive successful probes after which the application is healthy again.
+func (h ApplicationHealthCheck) GetSuccessThreshold() int {
+	if h.SuccessThreshold == nil || *h.SuccessThreshold < 1 {
+		return DefaultApplicationHealthCheckSuccessThreshold
+	}
+	return int(*h.SuccessThreshold)
+}
+
+func parseOptionalDuration(value *string, defaultValue time.Duration) (time.Duration, error) {
+	if value == nil || *value == "" {
+		return defaultValue, nil
+	}
+	return time.ParseDuration(*value)
+}
+
 type SensitiveDataHider interface {
 	HideSensitiveData() error
 }
@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -1039,3 +1040,22 @@ func TestAuthProviderPreserveSensitiveData(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceLogsRequestSinceTime(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	since, err := DeviceLogsRequest{}.SinceTime(now)
+	require.NoError(t, err)
+	require.Nil(t, since)
+
+	since, err = DeviceLogsRequest{Since: "10m"}.SinceTime(now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-10*time.Minute), *since)
+
+	since, err = DeviceLogsRequest{Since: "2024-12-31T23:00:00+01:00"}.SinceTime(now)
+	require.NoError(t, err)
+	require.True(t, since.Equal(time.Date(2024, 12, 31, 22, 0, 0, 0, time.UTC)))
+
+	_, err = DeviceLogsRequest{Since: "10 minutes"}.SinceTime(now)
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
 	"regexp"
 	"slices"
@@ -87,6 +88,9 @@ func (r DeviceSpec) Validate(fleetTemplate bool) []error {
 	if r.UpdatePolicy != nil {
 		allErrs = append(allErrs, r.UpdatePolicy.Validate()...)
 	}
+	if r.ConfigDriftPolicy != nil {
+		allErrs = append(allErrs, r.ConfigDriftPolicy.Validate()...)
+	}
 	if r.Consoles != nil {
 		allErrs = append(allErrs, fmt.Errorf("consoles are not supported through this api"))
 	}
@@ -170,6 +174,19 @@ func validateConfigs(configs []ConfigProviderSpec, fleetTemplate bool) []error {
 				break
 			}
 			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
+		case OciConfigProviderType:
+			provider, err := config.AsOciConfigProviderSpec()
+			if err != nil {
+				allErrs = append(allErrs, err)
+				break
+			}
+			path := provider.OciRef.MountPath
+			if _, exists := seenPath[path]; exists {
+				allErrs = append(allErrs, fmt.Errorf("spec.config[%d].ociRef, device path must be unique for all config providers: %s", i, path))
+			} else {
+				seenPath[path] = struct{}{}
+			}
+			allErrs = append(allErrs, provider.Validate(fleetTemplate)...)
 		default:
 			// if we hit this case, it means that the type should be added to the switch statement above
 			allErrs = append(allErrs, fmt.Errorf("unknown config provider type: %s", t))
@@ -446,6 +463,45 @@ func (h HttpConfigProviderSpec) Validate(fleetTemplate bool) []error {
 	return allErrs
 }
 
+func (o OciConfigProviderSpec) Validate(fleetTemplate bool) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateGenericName(&o.Name, "spec.config[].name")...)
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&o.OciRef.Repository, "spec.config[].ociRef.repository")...)
+
+	containsParams, paramErrs := validateParametersInString(&o.OciRef.Reference, "spec.config[].ociRef.reference", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if containsParams {
+		allErrs = append(allErrs, validation.ValidateOciImageReferenceWithTemplates(&o.OciRef.Reference, "spec.config[].ociRef.reference")...)
+	} else {
+		allErrs = append(allErrs, validation.ValidateOciImageReference(&o.OciRef.Reference, "spec.config[].ociRef.reference")...)
+		if !hasOciTagOrDigest(o.OciRef.Reference) {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].ociRef.reference: must include a tag or digest: %q", o.OciRef.Reference))
+		}
+	}
+
+	containsParams, paramErrs = validateParametersInString(&o.OciRef.MountPath, "spec.config[].ociRef.mountPath", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateFileOrDirectoryPath(&o.OciRef.MountPath, "spec.config[].ociRef.mountPath")...)
+		if err := validation.DenySystemDirectory(o.OciRef.MountPath); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].ociRef.mountPath: %w", err))
+		} else if err := validation.DenyForbiddenDevicePath(o.OciRef.MountPath); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.config[].ociRef.mountPath: %w", err))
+		}
+	}
+
+	return allErrs
+}
+
+// hasOciTagOrDigest reports whether the last path component of the reference carries a tag or digest.
+func hasOciTagOrDigest(reference string) bool {
+	if strings.Contains(reference, "@") {
+		return true
+	}
+	name := reference[strings.LastIndex(reference, "/")+1:]
+	return strings.Contains(name, ":")
+}
+
 func (r EnrollmentRequest) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
@@ -534,6 +590,20 @@ func (b BatchSequence) Validate() []error {
 	return errs
 }
 
+func (c Canary) Validate() []error {
+	var errs []error
+	errs = append(errs, c.Cohort.Validate()...)
+	if c.Cohort.Selector == nil && c.Cohort.Limit == nil {
+		errs = append(errs, errors.New("canary cohort must define at least one of [Selector, Limit]"))
+	}
+	if c.SoakDuration != nil {
+		if _, err := time.ParseDuration(*c.SoakDuration); err != nil {
+			errs = append(errs, fmt.Errorf("canary soak duration: %w", err))
+		}
+	}
+	return errs
+}
+
 func (r *RolloutDeviceSelection) Validate() []error {
 	var errs []error
 	if r == nil {
@@ -546,6 +616,8 @@ func (r *RolloutDeviceSelection) Validate() []error {
 		switch v := i.(type) {
 		case BatchSequence:
 			errs = append(errs, v.Validate()...)
+		case Canary:
+			errs = append(errs, v.Validate()...)
 		}
 	}
 	return errs
@@ -566,16 +638,65 @@ func (d *DisruptionBudget) Validate() []error {
 	return errs
 }
 
+func (w MaintenanceWindow) Validate() []error {
+	var errs []error
+	if w.TimeZone != nil {
+		errs = append(errs, validateTimeZone(lo.FromPtr(w.TimeZone))...)
+	}
+	isCron := w.At != nil || w.Duration != nil
+	isRange := w.Start != nil || w.End != nil
+	switch {
+	case isCron && isRange:
+		errs = append(errs, errors.New("only one of [at+duration, start+end] may be defined"))
+	case isCron:
+		if w.At == nil || w.Duration == nil {
+			errs = append(errs, errors.New("both at and duration must be defined"))
+			break
+		}
+		// the schedule must not depend on the time zone of the service evaluating it
+		if w.TimeZone == nil {
+			errs = append(errs, errors.New("timeZone must be defined for a recurring window"))
+		}
+		// allow only the standard 5 input cron syntax e.g. "* * * * *"
+		parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		if _, err := parser.Parse(*w.At); err != nil {
+			errs = append(errs, fmt.Errorf("invalid cron schedule: %s", err))
+		}
+		if d, err := time.ParseDuration(*w.Duration); err != nil {
+			errs = append(errs, fmt.Errorf("invalid duration: %w", err))
+		} else if d <= 0 {
+			errs = append(errs, errors.New("duration must be positive"))
+		}
+	case isRange:
+		if w.Start == nil || w.End == nil {
+			errs = append(errs, errors.New("both start and end must be defined"))
+			break
+		}
+		if !w.End.After(*w.Start) {
+			errs = append(errs, errors.New("end must be after start"))
+		}
+	default:
+		errs = append(errs, errors.New("one of [at+duration, start+end] must be defined"))
+	}
+	errs = append(errs, w.Selector.Validate()...)
+	return errs
+}
+
 func (r *RolloutPolicy) Validate() []error {
 	var errs []error
 	if r == nil {
 		return nil
 	}
-	if r.DeviceSelection == nil && r.DisruptionBudget == nil {
-		errs = append(errs, errors.New("at least one of [DeviceSelection, DisruptionBudget] must be defined"))
+	if r.DeviceSelection == nil && r.DisruptionBudget == nil && len(lo.FromPtr(r.MaintenanceWindows)) == 0 {
+		errs = append(errs, errors.New("at least one of [DeviceSelection, DisruptionBudget, MaintenanceWindows] must be defined"))
 	}
 	errs = append(errs, r.DeviceSelection.Validate()...)
 	errs = append(errs, r.DisruptionBudget.Validate()...)
+	for i, w := range lo.FromPtr(r.MaintenanceWindows) {
+		for _, err := range w.Validate() {
+			errs = append(errs, fmt.Errorf("maintenance window %d: %w", i, err))
+		}
+	}
 	if r.SuccessThreshold != nil {
 		if err := validatePercentage(*r.SuccessThreshold); err != nil {
 			errs = append(errs, fmt.Errorf("rollout policy success threshold: %w", err))
@@ -622,6 +743,16 @@ func (u DeviceUpdatePolicySpec) Validate() []error {
 	return allErrs
 }
 
+func (c ConfigDriftPolicy) Validate() []error {
+	var allErrs []error
+	switch action := lo.FromPtr(c.Action); action {
+	case "", ConfigDriftActionReport, ConfigDriftActionRemediate:
+	default:
+		allErrs = append(allErrs, fmt.Errorf("spec.configDriftPolicy.action: unsupported value %q: must be one of [%s, %s]", action, ConfigDriftActionReport, ConfigDriftActionRemediate))
+	}
+	return allErrs
+}
+
 func (u UpdateSchedule) Validate() []error {
 	var allErrs []error
 	if u.TimeZone != nil {
@@ -751,6 +882,26 @@ func (tv TemplateVersion) Validate() []error {
 	return allErrs
 }
 
+func (r DeviceLogsRequest) Validate() []error {
+	allErrs := []error{}
+	if r.Application != "" && len(r.Units) > 0 {
+		allErrs = append(allErrs, errors.New("units and application are mutually exclusive"))
+	}
+	for i := range r.Units {
+		allErrs = append(allErrs, validation.ValidateSystemdName(&r.Units[i], fmt.Sprintf("units[%d]", i))...)
+	}
+	if r.Application != "" {
+		allErrs = append(allErrs, validation.ValidateString(&r.Application, "application", 1, validation.DNS1123MaxLength, nil, "")...)
+	}
+	if _, err := r.SinceTime(time.Now()); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if r.Tail != nil && *r.Tail < 0 {
+		allErrs = append(allErrs, errors.New("tail must not be negative"))
+	}
+	return allErrs
+}
+
 func (l *LabelSelector) Validate() []error {
 	if l != nil && l.MatchExpressions == nil && l.MatchLabels == nil {
 		return []error{errors.New("at least one of [matchLabels,matchExpressions] must appear in a label selector")}
@@ -955,12 +1106,9 @@ func validateContainerApplication(app ApplicationProviderSpec, appName string, f
 	allErrs = append(allErrs, validateOciImageReference(&container.Image, pathPrefix+".image", fleetTemplate)...)
 	allErrs = append(allErrs, validateContainerPorts(container.Ports, pathPrefix+".ports")...)
 
-	if container.Resources != nil && container.Resources.Limits != nil {
-		allErrs = append(allErrs, validatePodmanCPULimit(container.Resources.Limits.Cpu, pathPrefix+".resources.limits.cpu")...)
-		allErrs = append(allErrs, validatePodmanMemoryLimit(container.Resources.Limits.Memory, pathPrefix+".resources.limits.memory")...)
-	}
-
+	allErrs = append(allErrs, validateApplicationResources(container.Resources, pathPrefix)...)
 	allErrs = append(allErrs, validateEnvVars(container.EnvVars, pathPrefix)...)
+	allErrs = append(allErrs, validateApplicationHealthChecks(container.HealthChecks, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(container.Volumes, appName, AppTypeContainer, fleetTemplate)...)
 
 	return allErrs
@@ -1024,6 +1172,8 @@ func validateComposeApplication(app ApplicationProviderSpec, appName string, fle
 	}
 
 	allErrs = append(allErrs, validateEnvVars(compose.EnvVars, pathPrefix)...)
+	allErrs = append(allErrs, validateApplicationHealthChecks(compose.HealthChecks, pathPrefix)...)
+	allErrs = append(allErrs, validateApplicationResources(compose.Resources, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(compose.Volumes, appName, AppTypeCompose, fleetTemplate)...)
 
 	return allErrs
@@ -1063,11 +1213,123 @@ func validateQuadletApplication(app ApplicationProviderSpec, appName string, fle
 	}
 
 	allErrs = append(allErrs, validateEnvVars(quadlet.EnvVars, pathPrefix)...)
+	allErrs = append(allErrs, validateApplicationHealthChecks(quadlet.HealthChecks, pathPrefix)...)
+	allErrs = append(allErrs, validateApplicationResources(quadlet.Resources, pathPrefix)...)
 	allErrs = append(allErrs, validateApplicationVolumes(quadlet.Volumes, appName, AppTypeQuadlet, fleetTemplate)...)
 
 	return allErrs
 }
 
+func validateApplicationResources(resources *ApplicationResources, pathPrefix string) []error {
+	if resources == nil {
+		return nil
+	}
+
+	var allErrs []error
+	if limits := resources.Limits; limits != nil {
+		allErrs = append(allErrs, validatePodmanCPULimit(limits.Cpu, pathPrefix+".resources.limits.cpu")...)
+		allErrs = append(allErrs, validatePodmanMemoryLimit(limits.Memory, pathPrefix+".resources.limits.memory")...)
+		if limits.IoWeight != nil && (*limits.IoWeight < 1 || *limits.IoWeight > 10000) {
+			allErrs = append(allErrs, fmt.Errorf("%s.resources.limits.ioWeight: must be between 1 and 10000, got %d", pathPrefix, *limits.IoWeight))
+		}
+	}
+	if monitor := resources.Monitor; monitor != nil {
+		allErrs = append(allErrs, validateAlertRules(lo.FromPtr(monitor.Cpu), monitor.SamplingInterval)...)
+		allErrs = append(allErrs, validateAlertRules(lo.FromPtr(monitor.Memory), monitor.SamplingInterval)...)
+	}
+	return allErrs
+}
+
+func validateApplicationHealthChecks(healthChecks *[]ApplicationHealthCheck, pathPrefix string) []error {
+	if healthChecks == nil {
+		return nil
+	}
+
+	allErrs := []error{}
+	seenNames := make(map[string]struct{})
+	for i, check := range *healthChecks {
+		path := fmt.Sprintf("%s.healthChecks[%d]", pathPrefix, i)
+
+		name := check.ProbeName()
+		if _, exists := seenNames[name]; exists {
+			allErrs = append(allErrs, fmt.Errorf("%s: duplicate health check name %q: set a unique name", path, name))
+		}
+		seenNames[name] = struct{}{}
+		if check.Name != nil {
+			allErrs = append(allErrs, validation.ValidateGenericName(check.Name, path+".name")...)
+		}
+
+		probes := 0
+		if check.Http != nil {
+			probes++
+			allErrs = append(allErrs, validateProbePort(check.Http.Port, path+".http.port")...)
+			if check.Http.Path != nil && !strings.HasPrefix(*check.Http.Path, "/") {
+				allErrs = append(allErrs, fmt.Errorf("%s.http.path must start with '/'", path))
+			}
+			if check.Http.Scheme != nil && *check.Http.Scheme != ApplicationHttpProbeSchemeHTTP && *check.Http.Scheme != ApplicationHttpProbeSchemeHTTPS {
+				allErrs = append(allErrs, fmt.Errorf("%s.http.scheme must be %s or %s", path, ApplicationHttpProbeSchemeHTTP, ApplicationHttpProbeSchemeHTTPS))
+			}
+			if check.Http.Host != nil {
+				allErrs = append(allErrs, validateProbeHost(*check.Http.Host, path+".http.host")...)
+			}
+		}
+		if check.Tcp != nil {
+			probes++
+			allErrs = append(allErrs, validateProbePort(check.Tcp.Port, path+".tcp.port")...)
+			if check.Tcp.Host != nil {
+				allErrs = append(allErrs, validateProbeHost(*check.Tcp.Host, path+".tcp.host")...)
+			}
+		}
+		if check.Exec != nil {
+			probes++
+			if len(check.Exec.Command) == 0 || check.Exec.Command[0] == "" {
+				allErrs = append(allErrs, fmt.Errorf("%s.exec.command must not be empty", path))
+			}
+			if check.Exec.Container != nil {
+				allErrs = append(allErrs, validation.ValidateGenericName(check.Exec.Container, path+".exec.container")...)
+			}
+		}
+		if probes != 1 {
+			allErrs = append(allErrs, fmt.Errorf("%s: exactly one of http, tcp, or exec must be specified", path))
+		}
+
+		interval, err := check.GetInterval()
+		if err != nil || interval <= 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.interval must be a positive duration: %s", path, lo.FromPtr(check.Interval)))
+		}
+		timeout, err := check.GetTimeout()
+		if err != nil || timeout <= 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.timeout must be a positive duration: %s", path, lo.FromPtr(check.Timeout)))
+		} else if interval > 0 && timeout > interval {
+			allErrs = append(allErrs, fmt.Errorf("%s.timeout must not be longer than the interval", path))
+		}
+		if grace, err := check.GetStartupGracePeriod(); err != nil || grace < 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.startupGracePeriod must be a non-negative duration: %s", path, lo.FromPtr(check.StartupGracePeriod)))
+		}
+		if check.FailureThreshold != nil && *check.FailureThreshold < 1 {
+			allErrs = append(allErrs, fmt.Errorf("%s.failureThreshold must be at least 1", path))
+		}
+		if check.SuccessThreshold != nil && *check.SuccessThreshold < 1 {
+			allErrs = append(allErrs, fmt.Errorf("%s.successThreshold must be at least 1", path))
+		}
+	}
+	return allErrs
+}
+
+func validateProbeHost(host string, path string) []error {
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+	return validation.ValidateHostnameOrFQDN(&host, path)
+}
+
+func validateProbePort(port int32, path string) []error {
+	if port < 1 || port > 65535 {
+		return []error{fmt.Errorf("%s must be between 1 and 65535", path)}
+	}
+	return nil
+}
+
 func validateEnvVars(envVars *map[string]string, pathPrefix string) []error {
 	return validation.ValidateStringMap(envVars, pathPrefix+".envVars", 1, validation.DNS1123MaxLength, validation.EnvVarNameRegexp, nil, "")
 }
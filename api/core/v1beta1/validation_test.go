@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/api/common"
 	"github.com/flightctl/flightctl/internal/consts"
@@ -134,6 +135,100 @@ func TestValidateUpdateScheduleCron(t *testing.T) {
 	}
 }
 
+func TestValidateMaintenanceWindow(t *testing.T) {
+	require := require.New(t)
+	start := time.Date(2025, 1, 1, 22, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		window  MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name:   "valid cron window",
+			window: MaintenanceWindow{At: lo.ToPtr("0 22 * * *"), Duration: lo.ToPtr("4h"), TimeZone: lo.ToPtr("Europe/Paris")},
+		},
+		{
+			name:   "valid time range window",
+			window: MaintenanceWindow{Start: lo.ToPtr(start), End: lo.ToPtr(start.Add(time.Hour))},
+		},
+		{
+			name: "valid window with selector",
+			window: MaintenanceWindow{
+				At:       lo.ToPtr("0 2 * * 6"),
+				Duration: lo.ToPtr("2h"),
+				TimeZone: lo.ToPtr("UTC"),
+				Selector: &LabelSelector{MatchLabels: &map[string]string{"site": "factory"}},
+			},
+		},
+		{
+			name:    "invalid empty window",
+			window:  MaintenanceWindow{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cron window without time zone",
+			window:  MaintenanceWindow{At: lo.ToPtr("0 22 * * *"), Duration: lo.ToPtr("4h")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cron without duration",
+			window:  MaintenanceWindow{At: lo.ToPtr("0 22 * * *")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cron expression",
+			window:  MaintenanceWindow{At: lo.ToPtr("0 25 * * *"), Duration: lo.ToPtr("1h")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid duration",
+			window:  MaintenanceWindow{At: lo.ToPtr("0 22 * * *"), Duration: lo.ToPtr("1d")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid end before start",
+			window:  MaintenanceWindow{Start: lo.ToPtr(start), End: lo.ToPtr(start.Add(-time.Hour))},
+			wantErr: true,
+		},
+		{
+			name:    "invalid start without end",
+			window:  MaintenanceWindow{Start: lo.ToPtr(start)},
+			wantErr: true,
+		},
+		{
+			name: "invalid cron and time range",
+			window: MaintenanceWindow{
+				At:       lo.ToPtr("0 22 * * *"),
+				Duration: lo.ToPtr("1h"),
+				Start:    lo.ToPtr(start),
+				End:      lo.ToPtr(start.Add(time.Hour)),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time zone",
+			window:  MaintenanceWindow{At: lo.ToPtr("0 22 * * *"), Duration: lo.ToPtr("1h"), TimeZone: lo.ToPtr("EST")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid empty selector",
+			window:  MaintenanceWindow{At: lo.ToPtr("0 22 * * *"), Duration: lo.ToPtr("1h"), Selector: &LabelSelector{}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.window.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+				return
+			}
+			require.Empty(errs)
+		})
+	}
+}
+
 func TestValidateUpdateScheduleTimeZone(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -758,6 +853,11 @@ func TestValidateConfigs(t *testing.T) {
 			configs: []ConfigProviderSpec{newHttpConfigProviderSpec("/dupe"), newInlineConfigProviderSpec([]string{"/new", "/dupe"})},
 			wantErr: true,
 		},
+		{
+			name:    "oci mount path vs inline same path",
+			configs: []ConfigProviderSpec{newOciConfigProviderSpec("configs/edge:v1", "/dupe"), newInlineConfigProviderSpec([]string{"/dupe"})},
+			wantErr: true,
+		},
 		{
 			name:    "all unique",
 			configs: []ConfigProviderSpec{newHttpConfigProviderSpec("/new"), newInlineConfigProviderSpec([]string{"/new2"})},
@@ -799,6 +899,105 @@ func newHttpConfigProviderSpec(path string) ConfigProviderSpec {
 	return provider
 }
 
+func newOciConfigProviderSpec(reference, mountPath string) ConfigProviderSpec {
+	var provider ConfigProviderSpec
+	spec := OciConfigProviderSpec{Name: "default-provider"}
+	spec.OciRef.Repository = "default-repo"
+	spec.OciRef.Reference = reference
+	spec.OciRef.MountPath = mountPath
+	_ = provider.FromOciConfigProviderSpec(spec)
+	return provider
+}
+
+func TestOciConfigProviderSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		reference     string
+		mountPath     string
+		fleetTemplate bool
+		wantErr       bool
+	}{
+		{
+			name:      "tag reference",
+			reference: "configs/edge:v1",
+			mountPath: "/etc/edge",
+		},
+		{
+			name:      "digest reference",
+			reference: "configs/edge@sha256:4b2c5f2a1e8f9f6a1e4b7f0a2b9d8c7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a2b1c",
+			mountPath: "/etc/edge",
+		},
+		{
+			name:      "missing tag or digest",
+			reference: "configs/edge",
+			mountPath: "/etc/edge",
+			wantErr:   true,
+		},
+		{
+			name:      "registry port is not a tag",
+			reference: "registry.example.com:5000/edge",
+			mountPath: "/etc/edge",
+			wantErr:   true,
+		},
+		{
+			name:      "relative mount path",
+			reference: "configs/edge:v1",
+			mountPath: "etc/edge",
+			wantErr:   true,
+		},
+		{
+			name:      "root mount path",
+			reference: "configs/edge:v1",
+			mountPath: "/",
+			wantErr:   true,
+		},
+		{
+			name:      "forbidden mount path",
+			reference: "configs/edge:v1",
+			mountPath: "/var/lib/flightctl/edge",
+			wantErr:   true,
+		},
+		{
+			name:      "system directory mount path",
+			reference: "configs/edge:v1",
+			mountPath: "/etc",
+			wantErr:   true,
+		},
+		{
+			name:      "nested system directory mount path",
+			reference: "configs/edge:v1",
+			mountPath: "/usr/local/",
+			wantErr:   true,
+		},
+		{
+			name:      "agent config directory mount path",
+			reference: "configs/edge:v1",
+			mountPath: "/etc/flightctl",
+			wantErr:   true,
+		},
+		{
+			name:          "parameterized reference in fleet template",
+			reference:     "configs/edge:{{ .metadata.labels.version }}",
+			mountPath:     "/etc/edge",
+			fleetTemplate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			provider := newOciConfigProviderSpec(tt.reference, tt.mountPath)
+			spec, err := provider.AsOciConfigProviderSpec()
+			require.NoError(err)
+			errs := spec.Validate(tt.fleetTemplate)
+			if tt.wantErr {
+				require.NotEmpty(errs)
+				return
+			}
+			require.Empty(errs)
+		})
+	}
+}
+
 func newInlineConfigProviderSpec(paths []string) ConfigProviderSpec {
 	var provider ConfigProviderSpec
 	var inlines []FileSpec
@@ -1081,6 +1280,48 @@ func TestValidateApplications(t *testing.T) {
 			},
 			wantErrs: []string{"must be in format 'number[unit]' where unit is b, k, m, or g"},
 		},
+		{
+			name: "container app with io weight and monitor - valid",
+			apps: []ApplicationProviderSpec{
+				newTestApplicationWithPortsAndResources(require, "app1", "quay.io/app/image:1", nil, &ApplicationResources{
+					Limits: &ApplicationResourceLimits{
+						IoWeight: lo.ToPtr(500),
+					},
+					Monitor: &ApplicationResourceMonitor{
+						SamplingInterval: "15s",
+						Memory: &[]ResourceAlertRule{
+							{Severity: ResourceAlertSeverityTypeWarning, Percentage: 80, Duration: "5m"},
+							{Severity: ResourceAlertSeverityTypeCritical, Percentage: 95, Duration: "1m"},
+						},
+					},
+				}),
+			},
+		},
+		{
+			name: "container app with io weight out of range",
+			apps: []ApplicationProviderSpec{
+				newTestApplicationWithPortsAndResources(require, "app1", "quay.io/app/image:1", nil, &ApplicationResources{
+					Limits: &ApplicationResourceLimits{
+						IoWeight: lo.ToPtr(0),
+					},
+				}),
+			},
+			wantErrs: []string{"ioWeight: must be between 1 and 10000"},
+		},
+		{
+			name: "container app with invalid monitor alert rule",
+			apps: []ApplicationProviderSpec{
+				newTestApplicationWithPortsAndResources(require, "app1", "quay.io/app/image:1", nil, &ApplicationResources{
+					Monitor: &ApplicationResourceMonitor{
+						SamplingInterval: "1m",
+						Cpu: &[]ResourceAlertRule{
+							{Severity: ResourceAlertSeverityTypeWarning, Percentage: 80, Duration: "30s"},
+						},
+					},
+				}),
+			},
+			wantErrs: []string{"sampling interval 1m0s must be less than the duration"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1450,6 +1691,50 @@ func TestResourceMonitorValidate_CPUPathField(t *testing.T) {
 	}
 }
 
+func TestDeviceSpecValidate_ConfigDriftPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *ConfigDriftPolicy
+		wantErr bool
+	}{
+		{
+			name:   "nil policy",
+			policy: nil,
+		},
+		{
+			name:   "empty action",
+			policy: &ConfigDriftPolicy{},
+		},
+		{
+			name:   "report",
+			policy: &ConfigDriftPolicy{Action: lo.ToPtr(ConfigDriftActionReport)},
+		},
+		{
+			name:   "remediate",
+			policy: &ConfigDriftPolicy{Action: lo.ToPtr(ConfigDriftActionRemediate)},
+		},
+		{
+			name:    "unsupported action",
+			policy:  &ConfigDriftPolicy{Action: lo.ToPtr(ConfigDriftAction("Ignore"))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			spec := DeviceSpec{ConfigDriftPolicy: tt.policy}
+			errs := spec.Validate(false)
+			if tt.wantErr {
+				require.Len(errs, 1)
+				require.Contains(errs[0].Error(), "spec.configDriftPolicy.action")
+				return
+			}
+			require.Empty(errs)
+		})
+	}
+}
+
 func TestDeviceSpecValidate_ResourceMonitors(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -2320,3 +2605,215 @@ func TestRepository_Validate_BackwardCompatibility(t *testing.T) {
 		require.Empty(t, errs, "HttpRepoSpec should validate successfully")
 	})
 }
+
+func TestRolloutDeviceSelection_Validate_Canary(t *testing.T) {
+	tests := []struct {
+		name    string
+		canary  Canary
+		wantErr bool
+	}{
+		{
+			name: "limit only",
+			canary: Canary{
+				Cohort: Batch{Limit: limitFromInt(t, 2)},
+			},
+		},
+		{
+			name: "selector with soak duration",
+			canary: Canary{
+				Cohort:       Batch{Selector: &LabelSelector{MatchLabels: &map[string]string{"stage": "canary"}}},
+				SoakDuration: lo.ToPtr("30m"),
+			},
+		},
+		{
+			name:    "empty cohort",
+			canary:  Canary{},
+			wantErr: true,
+		},
+		{
+			name: "invalid success threshold",
+			canary: Canary{
+				Cohort: Batch{Limit: limitFromInt(t, 1), SuccessThreshold: lo.ToPtr("150%")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid soak duration",
+			canary: Canary{
+				Cohort:       Batch{Limit: limitFromInt(t, 1)},
+				SoakDuration: lo.ToPtr("ten minutes"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.canary.Strategy = RolloutStrategyCanary
+			var selection RolloutDeviceSelection
+			require.NoError(t, selection.FromCanary(tt.canary))
+
+			errs := selection.Validate()
+
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func limitFromInt(t *testing.T, limit int) *Batch_Limit {
+	t.Helper()
+	var l Batch_Limit
+	require.NoError(t, l.FromBatchLimit1(limit))
+	return &l
+}
+
+func TestDeviceLogsRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		request DeviceLogsRequest
+		wantErr bool
+	}{
+		{
+			name:    "whole journal",
+			request: DeviceLogsRequest{},
+		},
+		{
+			name: "units with relative since and tail",
+			request: DeviceLogsRequest{
+				Units:  []string{"flightctl-agent.service", "crio"},
+				Follow: true,
+				Since:  "1h30m",
+				Tail:   lo.ToPtr(int64(100)),
+			},
+		},
+		{
+			name:    "application with timestamp since",
+			request: DeviceLogsRequest{Application: "my-app", Since: "2025-01-01T12:00:00Z"},
+		},
+		{
+			name:    "units and application",
+			request: DeviceLogsRequest{Units: []string{"crio"}, Application: "my-app"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid unit",
+			request: DeviceLogsRequest{Units: []string{"crio service"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid since",
+			request: DeviceLogsRequest{Since: "yesterday"},
+			wantErr: true,
+		},
+		{
+			name:    "negative since",
+			request: DeviceLogsRequest{Since: "-5m"},
+			wantErr: true,
+		},
+		{
+			name:    "negative tail",
+			request: DeviceLogsRequest{Tail: lo.ToPtr(int64(-1))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.request.Validate()
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+				return
+			}
+			require.Empty(t, errs)
+		})
+	}
+}
+
+func TestValidateApplicationHealthChecks(t *testing.T) {
+	tests := []struct {
+		name         string
+		healthChecks []ApplicationHealthCheck
+		wantErrs     []string
+	}{
+		{
+			name: "valid probes",
+			healthChecks: []ApplicationHealthCheck{
+				{Http: &ApplicationHttpProbe{Port: 8080, Path: lo.ToPtr("/healthz"), Scheme: lo.ToPtr(ApplicationHttpProbeSchemeHTTPS)}, Interval: lo.ToPtr("30s"), Timeout: lo.ToPtr("5s")},
+				{Tcp: &ApplicationTcpProbe{Port: 5432, Host: lo.ToPtr("127.0.0.1")}, StartupGracePeriod: lo.ToPtr("2m")},
+				{Exec: &ApplicationExecProbe{Command: []string{"pg_isready"}, Container: lo.ToPtr("db")}, FailureThreshold: lo.ToPtr(int32(5))},
+			},
+		},
+		{
+			name:         "no probe",
+			healthChecks: []ApplicationHealthCheck{{Name: lo.ToPtr("empty")}},
+			wantErrs:     []string{"exactly one of http, tcp, or exec must be specified"},
+		},
+		{
+			name: "multiple probes",
+			healthChecks: []ApplicationHealthCheck{
+				{Http: &ApplicationHttpProbe{Port: 8080}, Tcp: &ApplicationTcpProbe{Port: 8080}},
+			},
+			wantErrs: []string{"exactly one of http, tcp, or exec must be specified"},
+		},
+		{
+			name: "duplicate names",
+			healthChecks: []ApplicationHealthCheck{
+				{Tcp: &ApplicationTcpProbe{Port: 8080}},
+				{Tcp: &ApplicationTcpProbe{Port: 8081}},
+			},
+			wantErrs: []string{`duplicate health check name "tcp"`},
+		},
+		{
+			name: "invalid http probe",
+			healthChecks: []ApplicationHealthCheck{
+				{Http: &ApplicationHttpProbe{Port: 0, Path: lo.ToPtr("healthz"), Host: lo.ToPtr("not a host")}},
+			},
+			wantErrs: []string{".http.port must be between 1 and 65535", ".http.path must start with '/'", ".http.host"},
+		},
+		{
+			name: "empty exec command",
+			healthChecks: []ApplicationHealthCheck{
+				{Exec: &ApplicationExecProbe{Command: []string{}}},
+			},
+			wantErrs: []string{".exec.command must not be empty"},
+		},
+		{
+			name: "invalid durations and thresholds",
+			healthChecks: []ApplicationHealthCheck{
+				{
+					Tcp:                &ApplicationTcpProbe{Port: 8080},
+					Interval:           lo.ToPtr("ten seconds"),
+					StartupGracePeriod: lo.ToPtr("-1s"),
+					FailureThreshold:   lo.ToPtr(int32(0)),
+					SuccessThreshold:   lo.ToPtr(int32(-1)),
+				},
+			},
+			wantErrs: []string{".interval must be a positive duration", ".startupGracePeriod must be a non-negative duration", ".failureThreshold must be at least 1", ".successThreshold must be at least 1"},
+		},
+		{
+			name: "timeout longer than interval",
+			healthChecks: []ApplicationHealthCheck{
+				{Tcp: &ApplicationTcpProbe{Port: 8080}, Interval: lo.ToPtr("5s"), Timeout: lo.ToPtr("10s")},
+			},
+			wantErrs: []string{".timeout must not be longer than the interval"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateApplicationHealthChecks(&tt.healthChecks, "spec.applications[app1]")
+			if len(tt.wantErrs) == 0 {
+				require.Empty(t, errs)
+				return
+			}
+			require.Len(t, errs, len(tt.wantErrs), "errors: %v", errs)
+			for i, wantErr := range tt.wantErrs {
+				require.Contains(t, errs[i].Error(), wantErr)
+			}
+		})
+	}
+}
@@ -52,7 +52,13 @@ func main() {
 	defer store.Close()
 
 	processID := fmt.Sprintf("alert-exporter-%s-%s", util.GetHostname(), uuid.New().String())
-	queuesProvider, err := queues.NewRedisProvider(ctx, log, processID, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password, queues.DefaultRetryConfig())
+	queuesProvider, err := queues.NewProvider(ctx, log, processID, queues.ProviderOptions{
+		Type:     queues.ProviderType(cfg.KV.Provider),
+		Hostname: cfg.KV.Hostname,
+		Port:     cfg.KV.Port,
+		Password: cfg.KV.Password,
+		DB:       db,
+	}, queues.DefaultRetryConfig())
 	if err != nil {
 		log.Fatalf("initializing queue provider: %v", err)
 	}
@@ -61,7 +67,7 @@ func main() {
 		queuesProvider.Wait()
 	}()
 
-	kvStore, err := kvstore.NewKVStore(ctx, log, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, log, cfg, db)
 	if err != nil {
 		log.Fatalf("initializing kv store: %v", err)
 	}
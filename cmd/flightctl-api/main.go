@@ -42,7 +42,7 @@ func main() {
 	defer log.Println("API service stopped")
 	log.Printf("Using config: %s", cfg)
 
-	ca, err := crypto.LoadInternalCA(cfg.CA)
+	ca, err := crypto.LoadCA(cfg.CA)
 	if err != nil {
 		log.Fatalf("loading client-signer certificates: %v", err)
 	}
@@ -77,12 +77,18 @@ func main() {
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
 
 	processID := fmt.Sprintf("api-%s-%s", util.GetHostname(), uuid.New().String())
-	provider, err := queues.NewRedisProvider(ctx, log, processID, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password, queues.DefaultRetryConfig())
+	provider, err := queues.NewProvider(ctx, log, processID, queues.ProviderOptions{
+		Type:     queues.ProviderType(cfg.KV.Provider),
+		Hostname: cfg.KV.Hostname,
+		Port:     cfg.KV.Port,
+		Password: cfg.KV.Password,
+		DB:       db,
+	}, queues.DefaultRetryConfig())
 	if err != nil {
-		log.Fatalf("failed connecting to Redis queue: %v", err)
+		log.Fatalf("failed connecting to queue: %v", err)
 	}
 
-	kvStore, err := kvstore.NewKVStore(ctx, log, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, log, cfg, db)
 	if err != nil {
 		log.Fatalf("creating kvstore: %v", err)
 	}
@@ -99,7 +105,7 @@ func main() {
 		log.Fatalf("creating listener: %s", err)
 	}
 
-	agentServer, err := agentserver.New(ctx, log, cfg, store, caClient, agentListener, provider, agentTlsConfig)
+	agentServer, err := agentserver.New(ctx, log, cfg, store, caClient, agentListener, provider, agentTlsConfig, db)
 	if err != nil {
 		log.Fatalf("initializing agent server: %v", err)
 	}
@@ -110,7 +116,7 @@ func main() {
 			log.Fatalf("creating listener: %s", err)
 		}
 		// we pass the grpc server for now, to let the console sessions to establish a connection in grpc
-		server := apiserver.New(log, cfg, store, caClient, listener, provider, agentServer.GetGRPCServer())
+		server := apiserver.New(log, cfg, store, caClient, listener, provider, agentServer.GetGRPCServer(), db)
 		if err := server.Run(ctx); err != nil {
 			log.Fatalf("Error running server: %s", err)
 		}
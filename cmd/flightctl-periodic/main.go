@@ -38,7 +38,7 @@ func main() {
 	store := store.NewStore(db, log.WithField("pkg", "store"))
 	defer store.Close()
 
-	server := periodic.New(cfg, log, store)
+	server := periodic.New(cfg, log, store, db)
 	if err := server.Run(ctx); err != nil {
 		log.Fatalf("Error running server: %s", err)
 	}
@@ -94,7 +94,7 @@ func runRestore(ctx context.Context) error {
 	}()
 
 	log.Println("Initializing KV store connection for restore operations")
-	kvStore, err := kvstore.NewKVStore(ctx, log, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, log, cfg, db)
 	if err != nil {
 		log.Fatalf("initializing KV store: %v", err)
 	}
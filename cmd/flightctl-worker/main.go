@@ -57,9 +57,15 @@ func main() {
 	ctx = context.WithValue(ctx, consts.EventActorCtxKey, "service:flightctl-worker")
 
 	processID := fmt.Sprintf("worker-%s-%s", util.GetHostname(), uuid.New().String())
-	provider, err := queues.NewRedisProvider(ctx, log, processID, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password, queues.DefaultRetryConfig())
+	provider, err := queues.NewProvider(ctx, log, processID, queues.ProviderOptions{
+		Type:     queues.ProviderType(cfg.KV.Provider),
+		Hostname: cfg.KV.Hostname,
+		Port:     cfg.KV.Port,
+		Password: cfg.KV.Password,
+		DB:       db,
+	}, queues.DefaultRetryConfig())
 	if err != nil {
-		log.Fatalf("failed connecting to Redis queue: %v", err)
+		log.Fatalf("failed connecting to queue: %v", err)
 	}
 
 	k8sClient, err := k8sclient.NewK8SClient()
@@ -93,7 +99,7 @@ func main() {
 		}
 	}
 
-	server := workerserver.New(cfg, log, store, provider, k8sClient, workerCollector)
+	server := workerserver.New(cfg, log, store, provider, k8sClient, workerCollector, db)
 	if err := server.Run(ctx); err != nil {
 		log.Fatalf("Error running server: %s", err)
 	}
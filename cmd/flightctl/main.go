@@ -28,13 +28,18 @@ func NewFlightCtlCommand() *cobra.Command {
 	cmd.AddCommand(cli.NewCmdEdit())
 	cmd.AddCommand(cli.NewCmdDelete())
 	cmd.AddCommand(cli.NewCmdApprove())
+	cmd.AddCommand(cli.NewCmdBulk())
 	cmd.AddCommand(cli.NewCmdCancel())
+	cmd.AddCommand(cli.NewCmdCARotation())
 	cmd.AddCommand(cli.NewCmdCSRConfig())
 	cmd.AddCommand(cli.NewCmdConfig())
 	cmd.AddCommand(cli.NewCmdDecommission())
 	cmd.AddCommand(cli.NewCmdDeny())
+	cmd.AddCommand(cli.NewCmdInstall())
 	cmd.AddCommand(cli.NewCmdLogin())
 	cmd.AddCommand(cli.NewCmdResume())
+	cmd.AddCommand(cli.NewCmdRevoke())
+	cmd.AddCommand(cli.NewCmdRollback())
 	cmd.AddCommand(cli.NewCmdVersion())
 	cmd.AddCommand(cli.NewConsoleCmd())
 	cmd.AddCommand(cli.NewCmdCompletion())
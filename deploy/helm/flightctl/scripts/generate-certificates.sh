@@ -16,6 +16,7 @@ USERINFO_PROXY_SANS=()
 NAMESPACE=""
 INTERNAL_NAMESPACE=""
 CREATE_K8S_SECRETS="false"
+CLIENT_SIGNER_ROTATION="false"
 
 # Parse command-line arguments
 usage() {
@@ -36,6 +37,7 @@ Optional:
   --prometheus-san <dns>        DNS SAN for flightctl-prometheus (can be specified multiple times)
   --grafana-san <dns>           DNS SAN for flightctl-grafana (can be specified multiple times)
   --userinfo-proxy-san <dns>    DNS SAN for flightctl-userinfo-proxy (can be specified multiple times)
+  --client-signer-rotation      Generate the new Client Signer CA of a CA rotation in flightctl-api/rotation/
   --create-k8s-secrets          Create Kubernetes secrets using oc/kubectl
   --namespace <ns>              Kubernetes namespace (required if --create-k8s-secrets is set)
   --internal-namespace <ns>      Internal namespace to copy CA secrets to (optional)
@@ -90,6 +92,10 @@ while [[ $# -gt 0 ]]; do
             USERINFO_PROXY_SANS+=("$2")
             shift 2
             ;;
+        --client-signer-rotation)
+            CLIENT_SIGNER_ROTATION="true"
+            shift
+            ;;
         --create-k8s-secrets)
             CREATE_K8S_SECRETS="true"
             shift
@@ -263,6 +269,24 @@ else
     echo "[2/14] Generated Client Signer CA certificate (10 years, ECDSA P-256)"
 fi
 
+# The new Client Signer CA of a CA rotation, trusted next to the current one until the rotation completes
+CLIENT_SIGNER_NEXT_CA_KEY="$CERT_DIR/flightctl-api/rotation/client-signer.key"
+CLIENT_SIGNER_NEXT_CA_CERT="$CERT_DIR/flightctl-api/rotation/client-signer.crt"
+
+if [[ "$CLIENT_SIGNER_ROTATION" == "true" ]]; then
+    mkdir -p "$CERT_DIR/flightctl-api/rotation"
+
+    if [[ -f "$CLIENT_SIGNER_NEXT_CA_CERT" ]] && [[ -f "$CLIENT_SIGNER_NEXT_CA_KEY" ]]; then
+        echo "[2/14] Skipped generation of new Client Signer CA certificate for the CA rotation (already exists)"
+    else
+        # the subject differs from the current CA's so that both can be told apart in the trust bundle
+        generate_intermediate_ca "flightctl-client-signer-ca-$(date -u +%Y%m%d%H%M%S)" \
+            "$CLIENT_SIGNER_NEXT_CA_KEY" "$CLIENT_SIGNER_NEXT_CA_CERT" \
+            "$FLIGHTCTL_CA_CERT" "$FLIGHTCTL_CA_KEY"
+        echo "[2/14] Generated new Client Signer CA certificate for the CA rotation (10 years, ECDSA P-256)"
+    fi
+fi
+
 # Step 3: PAM Issuer Token Signer CA (intermediate CA signed by Flight Control CA)
 if [[ ${#PAM_ISSUER_SANS[@]} -gt 0 ]]; then
     mkdir -p "$CERT_DIR/flightctl-pam-issuer"
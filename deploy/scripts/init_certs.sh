@@ -206,5 +206,11 @@ for san in "${userinfo_proxy_sans[@]}"; do
     cert_gen_args+=("--userinfo-proxy-san" "$san")
 done
 
+# Generate the new Client Signer CA when a CA rotation is enabled
+ca_rotation=$(python3 "$YAML_HELPER" extract .ca.rotation.enabled "$CONFIG_FILE" --default false)
+if [ "${ca_rotation,,}" = "true" ]; then
+    cert_gen_args+=("--client-signer-rotation")
+fi
+
 # Generate certificates
 /usr/share/flightctl/generate-certificates.sh "${cert_gen_args[@]}"
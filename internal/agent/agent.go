@@ -87,6 +87,11 @@ func (a *Agent) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize TPM client: %w", err)
 	}
 
+	// trust the CA bundle published by the management service, falling back to the configured CA
+	if err := a.config.LoadManagementCABundle(); err != nil {
+		a.log.Warnf("Failed to load management CA bundle: %v", err)
+	}
+
 	// create identity provider
 	identityProvider := identity.NewProvider(
 		tpmClient,
@@ -329,8 +334,12 @@ func (a *Agent) Run(ctx context.Context) error {
 	// create config controller
 	configController := config.NewController(
 		rootReadWriter,
+		rootPodmanClient,
+		pullConfigResolver,
+		a.config.DataDir,
 		a.log,
 	)
+	statusManager.RegisterStatusExporter(configController)
 
 	bootstrap := device.NewBootstrap(
 		deviceName,
@@ -354,6 +363,12 @@ func (a *Agent) Run(ctx context.Context) error {
 		return fmt.Errorf("bootstrap failed: %w", err)
 	}
 
+	// trust the CA bundle the management service publishes while it rotates its CA, even if the
+	// management certificate is not renewed before the service switches to the new CA
+	bootstrap.ManagementClient().SetCABundleCallback(
+		a.newManagementCABundleCallback(ctx, bootstrap.ManagementClient(), statusManager),
+	)
+
 	// Initialize certificate manager
 	certManager, err := certmanager.NewAgentCertManager(
 		ctx, a.log,
@@ -417,6 +432,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		applicationsManager,
 		rootSystemdManager,
 		a.config.StatusUpdateInterval,
+		a.config.UpdateVerificationTimeout,
 		hookManager,
 		osManager,
 		policyManager,
@@ -524,3 +540,26 @@ func wipeCertificateAndRestart(ctx context.Context, identityProvider identity.Pr
 	log.Info("Successfully wiped certificate and restarted flightctl-agent service")
 	return nil
 }
+
+// newManagementCABundleCallback returns a callback that stores a CA bundle published by the management service
+// and reloads the management client when the bundle differs from the CAs the client trusts.
+func (a *Agent) newManagementCABundleCallback(ctx context.Context, managementClient client.Management, statusManager status.Manager) client.CABundleCallback {
+	return func(bundle []byte) {
+		updated, err := a.config.UpdateManagementCABundle(bundle)
+		if err != nil {
+			a.log.Warnf("Failed to store management CA bundle: %v", err)
+			return
+		}
+		if !updated {
+			return
+		}
+		a.log.Info("Stored the CA bundle published by the management service")
+		if ok, err := client.TryReload(managementClient); ok && err != nil {
+			a.log.Warnf("Failed to reload management client after storing the CA bundle: %v", err)
+		}
+		// report the trusted CAs, so that the progress of the CA rotation can be followed
+		if err := statusManager.Collect(ctx, status.WithForceCollect()); err != nil {
+			a.log.Warnf("Failed to collect status after storing the CA bundle: %v", err)
+		}
+	}
+}
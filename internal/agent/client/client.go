@@ -22,6 +22,9 @@ import (
 // RPCMetricsCallback defines the signature for RPC metrics callback functions.
 type RPCMetricsCallback func(operation string, durationSeconds float64, err error)
 
+// CABundleCallback defines the signature for functions receiving the CA bundle the management service publishes.
+type CABundleCallback func(bundle []byte)
+
 // NewFromConfig returns a new Flight Control API client from the given config.
 func NewFromConfig(config *baseclient.Config, log *log.PrefixLogger, opts ...HTTPClientOption) (*client.ClientWithResponses, error) {
 	options := &httpClientOptions{}
@@ -59,6 +62,7 @@ type Management interface {
 	GetRenderedDevice(ctx context.Context, name string, params *v1beta1.GetRenderedDeviceParams, rcb ...client.RequestEditorFn) (*v1beta1.Device, int, error)
 	PatchDeviceStatus(ctx context.Context, name string, patch v1beta1.PatchRequest, rcb ...client.RequestEditorFn) error
 	SetRPCMetricsCallback(cb RPCMetricsCallback)
+	SetCABundleCallback(cb CABundleCallback)
 	CreateCertificateSigningRequest(ctx context.Context, csr v1beta1.CertificateSigningRequest, rcb ...client.RequestEditorFn) (*v1beta1.CertificateSigningRequest, int, error)
 	GetCertificateSigningRequest(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.CertificateSigningRequest, int, error)
 }
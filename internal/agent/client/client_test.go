@@ -2,12 +2,14 @@ package client
 
 import (
 	"context"
+	"encoding/base64"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	baseclient "github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/pkg/poll"
 	"github.com/stretchr/testify/require"
@@ -188,3 +190,40 @@ func TestManagementClient_DeviceNotFoundHandling(t *testing.T) {
 		require.Nil(device)
 	})
 }
+
+func TestGetRenderedDevicePublishesCABundle(t *testing.T) {
+	require := require.New(t)
+	caBundle := []byte("-----BEGIN CERTIFICATE-----\nprevious\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nnew\n-----END CERTIFICATE-----\n")
+	rotating := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("/api/v1/devices/test/rendered", r.URL.Path)
+		if rotating {
+			w.Header().Set(consts.ManagementCABundleHeader, base64.StdEncoding.EncodeToString(caBundle))
+		}
+		// the rendered device did not change, and the device does not renew its certificate
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	httpClient, err := NewFromConfig(&baseclient.Config{Service: baseclient.Service{Server: server.URL}}, log.NewPrefixLogger("test"))
+	require.NoError(err)
+	management := NewManagement(httpClient, nil)
+	var published [][]byte
+	management.SetCABundleCallback(func(bundle []byte) {
+		published = append(published, bundle)
+	})
+
+	ctx := context.Background()
+	device, code, err := management.GetRenderedDevice(ctx, "test", nil)
+	require.NoError(err)
+	require.Nil(device)
+	require.Equal(http.StatusNoContent, code)
+	require.Empty(published)
+
+	rotating = true
+	device, code, err = management.GetRenderedDevice(ctx, "test", nil)
+	require.NoError(err)
+	require.Nil(device)
+	require.Equal(http.StatusNoContent, code)
+	require.Equal([][]byte{caBundle}, published)
+}
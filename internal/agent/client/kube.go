@@ -207,6 +207,13 @@ func (k *Kube) resolveKubeconfig() (string, error) {
 // WatchPodsCmd returns an exec.Cmd configured to watch pod events across all namespaces.
 // Use WithKubeLabels to filter pods by label selector.
 func (k *Kube) WatchPodsCmd(ctx context.Context, opts ...KubeOption) (*exec.Cmd, error) {
+	return k.WatchCmd(ctx, "pods", opts...)
+}
+
+// WatchCmd returns an exec.Cmd configured to watch events of a single resource
+// type, such as "deployments", across all namespaces.
+// Use WithKubeLabels to filter resources by label selector.
+func (k *Kube) WatchCmd(ctx context.Context, resource string, opts ...KubeOption) (*exec.Cmd, error) {
 	binary := k.Binary()
 
 	if binary == "" {
@@ -218,7 +225,7 @@ func (k *Kube) WatchPodsCmd(ctx context.Context, opts ...KubeOption) (*exec.Cmd,
 		opt(options)
 	}
 
-	args := []string{"get", "pods", "--watch", "--output-watch-events", "--all-namespaces", "-o", "json"}
+	args := []string{"get", resource, "--watch", "--output-watch-events", "--all-namespaces", "-o", "json"}
 
 	if len(options.labels) > 0 {
 		args = append(args, "-l", strings.Join(options.labels, ","))
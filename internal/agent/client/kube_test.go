@@ -201,6 +201,27 @@ func TestKube_WatchPodsCmd(t *testing.T) {
 	}
 }
 
+func TestKube_WatchCmd(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := log.NewPrefixLogger("test")
+	mockExec := executer.NewMockExecuter(ctrl)
+	mockReadWriter := fileio.NewMockReadWriter(ctrl)
+	k8s := NewKube(logger, mockExec, mockReadWriter, WithBinary("kubectl"), WithKubeconfigPath("/default/kubeconfig"))
+
+	cmd, err := k8s.WatchCmd(context.Background(), "deployments",
+		WithKubeKubeconfig("/tmp/kubeconfig"),
+		WithKubeLabels([]string{"agent.flightctl.io/app"}),
+	)
+	require.NoError(err)
+	require.Equal([]string{
+		"kubectl", "get", "deployments", "--watch", "--output-watch-events", "--all-namespaces", "-o", "json",
+		"-l", "agent.flightctl.io/app", "--kubeconfig", "/tmp/kubeconfig",
+	}, cmd.Args)
+}
+
 func TestKube_ResolveKubeconfig(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	client "github.com/flightctl/flightctl/internal/api/client/agent"
+	"github.com/flightctl/flightctl/internal/consts"
 )
 
 var _ Management = (*management)(nil)
@@ -31,6 +33,7 @@ func NewManagement(
 type management struct {
 	client                 *client.ClientWithResponses
 	rpcMetricsCallbackFunc RPCMetricsCallback
+	caBundleCallbackFunc   CABundleCallback
 }
 
 // SetRPCMetricsCallback sets the callback function to be called when a RPC
@@ -40,6 +43,13 @@ func (m *management) SetRPCMetricsCallback(cb RPCMetricsCallback) {
 	m.rpcMetricsCallbackFunc = cb
 }
 
+// SetCABundleCallback sets the callback function to be called when the
+// management service publishes a CA bundle along with the rendered device
+// spec, which it does while it rotates its CA.
+func (m *management) SetCABundleCallback(cb CABundleCallback) {
+	m.caBundleCallbackFunc = cb
+}
+
 // UpdateDeviceStatus updates the status of the device with the given name.
 func (m *management) UpdateDeviceStatus(ctx context.Context, name string, device v1beta1.Device, rcb ...client.RequestEditorFn) error {
 	start := time.Now()
@@ -103,6 +113,7 @@ func (m *management) GetRenderedDevice(ctx context.Context, name string, params
 	}
 	if resp.HTTPResponse != nil {
 		defer func() { _ = resp.HTTPResponse.Body.Close() }()
+		m.publishCABundle(resp.HTTPResponse.Header)
 	}
 
 	if resp.JSON200 != nil {
@@ -183,3 +194,17 @@ func (m *management) GetCertificateSigningRequest(ctx context.Context, name stri
 
 	return nil, resp.StatusCode(), nil
 }
+
+// publishCABundle passes the CA bundle attached to a response, if any, to the
+// CA bundle callback. Malformed bundles are ignored.
+func (m *management) publishCABundle(header http.Header) {
+	encoded := header.Get(consts.ManagementCABundleHeader)
+	if encoded == "" || m.caBundleCallbackFunc == nil {
+		return
+	}
+	bundle, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(bundle) == 0 {
+		return
+	}
+	m.caBundleCallbackFunc(bundle)
+}
@@ -30,8 +30,9 @@ type ManagementDelegate struct {
 	factory ManagementFactory
 	current atomic.Pointer[managementHolder]
 
-	// Keep the latest callback so it survives Reload().
-	rpcCB atomic.Value // stores RPCMetricsCallback
+	// Keep the latest callbacks so they survive Reload().
+	rpcCB      atomic.Value // stores RPCMetricsCallback
+	caBundleCB atomic.Value // stores CABundleCallback
 }
 
 // NewManagementDelegate creates a Management client wrapper that delegates all
@@ -70,13 +71,18 @@ func (d *ManagementDelegate) Reload() error {
 	// Publish the new client first so readers can observe it immediately.
 	d.current.Store(&managementHolder{mgmt: newMgmt})
 
-	// Best-effort: apply the latest metrics callback to the new client.
-	// This covers races with SetRPCMetricsCallback().
+	// Best-effort: apply the latest callbacks to the new client.
+	// This covers races with SetRPCMetricsCallback() and SetCABundleCallback().
 	if v := d.rpcCB.Load(); v != nil {
 		if cb, ok := v.(RPCMetricsCallback); ok {
 			newMgmt.SetRPCMetricsCallback(cb)
 		}
 	}
+	if v := d.caBundleCB.Load(); v != nil {
+		if cb, ok := v.(CABundleCallback); ok {
+			newMgmt.SetCABundleCallback(cb)
+		}
+	}
 
 	return nil
 }
@@ -113,6 +119,18 @@ func (d *ManagementDelegate) SetRPCMetricsCallback(cb RPCMetricsCallback) {
 	m.SetRPCMetricsCallback(cb)
 }
 
+func (d *ManagementDelegate) SetCABundleCallback(cb CABundleCallback) {
+	// Persist for future Reload() calls.
+	d.caBundleCB.Store(cb)
+
+	m, err := d.mgmt()
+	if err != nil {
+		// Not initialized yet; callback will be applied on Reload().
+		return
+	}
+	m.SetCABundleCallback(cb)
+}
+
 func (d *ManagementDelegate) UpdateDeviceStatus(
 	ctx context.Context,
 	name string,
@@ -124,6 +124,18 @@ func (mr *MockManagementMockRecorder) PatchDeviceStatus(ctx, name, patch any, rc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchDeviceStatus", reflect.TypeOf((*MockManagement)(nil).PatchDeviceStatus), varargs...)
 }
 
+// SetCABundleCallback mocks base method.
+func (m *MockManagement) SetCABundleCallback(cb CABundleCallback) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCABundleCallback", cb)
+}
+
+// SetCABundleCallback indicates an expected call of SetCABundleCallback.
+func (mr *MockManagementMockRecorder) SetCABundleCallback(cb any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCABundleCallback", reflect.TypeOf((*MockManagement)(nil).SetCABundleCallback), cb)
+}
+
 // SetRPCMetricsCallback mocks base method.
 func (m *MockManagement) SetRPCMetricsCallback(cb RPCMetricsCallback) {
 	m.ctrl.T.Helper()
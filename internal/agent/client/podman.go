@@ -250,6 +250,56 @@ func (p *Podman) ExtractArtifact(ctx context.Context, artifact, destination stri
 	return out, nil
 }
 
+// ExtractArtifactContents extracts an artifact into the destination directory, unpacking any
+// tar/tar.gz layers along the way.
+func (p *Podman) ExtractArtifactContents(ctx context.Context, artifact, destination string, writer fileio.ReadWriter) error {
+	tmpDir, err := writer.MkdirTemp("artifact_extract")
+	if err != nil {
+		return fmt.Errorf("creating temp directory: %w", err)
+	}
+	defer func() {
+		if rmErr := writer.RemoveAll(tmpDir); rmErr != nil {
+			p.log.Warnf("Failed to cleanup temp directory %q: %v", tmpDir, rmErr)
+		}
+	}()
+
+	if _, err := p.ExtractArtifact(ctx, artifact, tmpDir); err != nil {
+		return fmt.Errorf("extracting artifact: %w", err)
+	}
+
+	if err := writer.MkdirAll(destination, fileio.DefaultDirectoryPermissions); err != nil {
+		return fmt.Errorf("creating destination directory: %w", err)
+	}
+
+	entries, err := writer.ReadDir(tmpDir)
+	if err != nil {
+		return fmt.Errorf("reading extracted content: %w", err)
+	}
+
+	for _, entry := range entries {
+		srcPath := filepath.Join(tmpDir, entry.Name())
+
+		if !entry.IsDir() && (strings.HasSuffix(entry.Name(), ".tar") || strings.HasSuffix(entry.Name(), ".tar.gz") || strings.HasSuffix(entry.Name(), ".tgz")) {
+			if err := fileio.UnpackTar(writer, srcPath, destination); err != nil {
+				return fmt.Errorf("unpacking tar file %s: %w", entry.Name(), err)
+			}
+		} else {
+			destPath := filepath.Join(destination, entry.Name())
+			if entry.IsDir() {
+				if err := writer.CopyDir(srcPath, destPath); err != nil {
+					return fmt.Errorf("copying directory %s: %w", entry.Name(), err)
+				}
+			} else {
+				if err := writer.CopyFile(srcPath, destPath); err != nil {
+					return fmt.Errorf("copying file %s: %w", entry.Name(), err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 // Inspect returns the JSON output of the image inspection. The expectation is
 // that the image exists in local container storage.
 func (p *Podman) Inspect(ctx context.Context, image string) (string, error) {
@@ -525,6 +575,19 @@ func (p *Podman) Copy(ctx context.Context, src, dst string) error {
 	return nil
 }
 
+// Exec runs a command in a running container and returns its stdout.
+func (p *Podman) Exec(ctx context.Context, container string, command ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := append([]string{"exec", container}, command...)
+	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return "", fmt.Errorf("exec in container %s: %w", container, errors.FromStderr(stderr, exitCode))
+	}
+	return stdout, nil
+}
+
 func (p *Podman) InspectLabels(ctx context.Context, image string) (map[string]string, error) {
 	ctx, cancel := context.WithTimeout(ctx, p.timeout)
 	defer cancel()
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -21,6 +22,7 @@ import (
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
+	certutil "k8s.io/client-go/util/cert"
 	"sigs.k8s.io/yaml"
 )
 
@@ -38,6 +40,8 @@ const (
 	// DefaultPullTimeout is the default timeout for pulling a single OCI
 	// targets. Pull Timeout can not be greater that the prefetch timeout.
 	DefaultPullTimeout = util.Duration(10 * time.Minute)
+	// MinUpdateVerificationTimeout is the minimum time to verify an update, if verification is enabled
+	MinUpdateVerificationTimeout = util.Duration(time.Minute)
 	// MinSyncInterval is the minimum interval allowed for the spec fetch and status update
 	MinSyncInterval = util.Duration(2 * time.Second)
 	// DefaultConfigDir is the default directory where the device's configuration is stored
@@ -54,6 +58,8 @@ const (
 	DefaultManagementEndpoint = "https://localhost:7443"
 	// name of the CA bundle file
 	CacertFile = "ca.crt"
+	// ManagementCABundleFile is the name of the CA bundle file published to the device by the management service
+	ManagementCABundleFile = "management-ca.crt"
 	// GeneratedCertFile is the name of the cert file which is generated as the result of enrollment
 	GeneratedCertFile = "agent.crt"
 	// name of the agent's key file
@@ -90,6 +96,10 @@ type Config struct {
 	SpecFetchInterval util.Duration `json:"spec-fetch-interval,omitempty"`
 	// StatusUpdateInterval is the interval between two status updates
 	StatusUpdateInterval util.Duration `json:"status-update-interval,omitempty"`
+	// UpdateVerificationTimeout is how long the agent waits after an update for
+	// the applications to become healthy and the management service to be
+	// reachable before rolling the update back. Zero disables the verification.
+	UpdateVerificationTimeout util.Duration `json:"update-verification-timeout,omitempty"`
 
 	// TPM holds all TPM-related configuration
 	TPM TPM `json:"tpm,omitempty"`
@@ -257,6 +267,83 @@ func (cfg *Config) GetManagementCertMetricsCallback() mgmtcertcommon.ManagementC
 	return cfg.managementCertMetricsCallback
 }
 
+// ManagementCABundlePath returns the path of the CA bundle published to the device by the management service.
+func (cfg *Config) ManagementCABundlePath() string {
+	return filepath.Join(cfg.DataDir, DefaultCertsDirName, ManagementCABundleFile)
+}
+
+// LoadManagementCABundle makes the management client trust the CA bundle published to the device by the
+// management service, if the device has received one.
+func (cfg *Config) LoadManagementCABundle() error {
+	bundlePath := cfg.ManagementCABundlePath()
+	exists, err := cfg.readWriter.PathExists(bundlePath)
+	if err != nil || !exists {
+		return err
+	}
+	bundle, err := cfg.readWriter.ReadFile(bundlePath)
+	if err != nil {
+		return fmt.Errorf("reading management CA bundle: %w", err)
+	}
+	if err := validateCABundle(bundle); err != nil {
+		return fmt.Errorf("management CA bundle %s: %w", bundlePath, err)
+	}
+	cfg.setManagementCABundle(bundle)
+	return nil
+}
+
+// StoreManagementCABundle persists a CA bundle published by the management service and makes the
+// management client trust it once the client is reloaded.
+func (cfg *Config) StoreManagementCABundle(bundle []byte) error {
+	if err := validateCABundle(bundle); err != nil {
+		return fmt.Errorf("invalid management CA bundle: %w", err)
+	}
+	if err := cfg.readWriter.WriteFile(cfg.ManagementCABundlePath(), bundle, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("writing management CA bundle: %w", err)
+	}
+	cfg.setManagementCABundle(bundle)
+	return nil
+}
+
+// UpdateManagementCABundle stores a CA bundle published by the management service unless the management client
+// already trusts it, and reports whether it was stored.
+func (cfg *Config) UpdateManagementCABundle(bundle []byte) (bool, error) {
+	if current, err := cfg.ManagementCABundle(); err == nil && bytes.Equal(current, bundle) {
+		return false, nil
+	}
+	if err := cfg.StoreManagementCABundle(bundle); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ManagementCABundle returns the PEM-encoded CA certificates the management client trusts.
+func (cfg *Config) ManagementCABundle() ([]byte, error) {
+	service := cfg.ManagementService.Config.Service
+	if len(service.CertificateAuthorityData) > 0 || service.CertificateAuthority == "" {
+		return service.CertificateAuthorityData, nil
+	}
+	return cfg.readWriter.ReadFile(cfg.ManagementService.Config.GetCertificateAuthorityPath())
+}
+
+func (cfg *Config) setManagementCABundle(bundle []byte) {
+	// the client config must not have both a CA file and CA data
+	cfg.ManagementService.Config.Service.CertificateAuthority = ""
+	cfg.ManagementService.Config.Service.CertificateAuthorityData = bundle
+}
+
+func validateCABundle(bundle []byte) error {
+	certs, err := certutil.ParseCertsPEM(bundle)
+	if err != nil {
+		return err
+	}
+	for _, cert := range certs {
+		if !cert.IsCA {
+			return fmt.Errorf("certificate %q is not a CA certificate", cert.Subject.CommonName)
+		}
+	}
+	return nil
+}
+
 // Complete fills in defaults for fields not set by the config file
 func (cfg *Config) Complete() error {
 	// If the enrollment service hasn't been specified, attempt using the default local dev env.
@@ -303,6 +390,10 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("system-info-timeout cannot exceed %s, got %s", MaxSystemInfoTimeout, cfg.SystemInfoTimeout)
 	}
 
+	if cfg.UpdateVerificationTimeout != 0 && cfg.UpdateVerificationTimeout < MinUpdateVerificationTimeout {
+		return fmt.Errorf("minimum update verification timeout is %s have %s", MinUpdateVerificationTimeout, cfg.UpdateVerificationTimeout)
+	}
+
 	if cfg.TPM.AuthEnabled && !cfg.TPM.Enabled {
 		return fmt.Errorf("cannot enable TPM password authentication when TPM device identity is disabled")
 	}
@@ -451,6 +542,9 @@ func mergeConfigs(base, override *Config) {
 	overrideSliceIfNotNil(&base.SystemInfoCustom, override.SystemInfoCustom)
 	overrideIfNotEmpty(&base.SystemInfoTimeout, override.SystemInfoTimeout)
 
+	// update verification
+	overrideIfNotEmpty(&base.UpdateVerificationTimeout, override.UpdateVerificationTimeout)
+
 	// tpm
 	overrideIfNotEmpty(&base.TPM.Enabled, override.TPM.Enabled)
 	overrideIfNotEmpty(&base.TPM.AuthEnabled, override.TPM.AuthEnabled)
@@ -1,9 +1,17 @@
 package config
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/sirupsen/logrus"
@@ -271,3 +279,76 @@ image-pruning:
 	require.NotNil(cfg.ImagePruning.Enabled, "Enabled should not be nil when dropin enables pruning")
 	require.True(*cfg.ImagePruning.Enabled, "pruning dropin should override config setting")
 }
+
+func newTestCertificatePEM(t *testing.T, commonName string, isCA bool) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestManagementCABundle(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+	configuredCA := newTestCertificatePEM(t, "configured-ca", true)
+	caFile := filepath.Join(tmpDir, "ca.crt")
+	require.NoError(os.WriteFile(caFile, configuredCA, 0o600))
+	require.NoError(os.MkdirAll(filepath.Join(tmpDir, DefaultCertsDirName), 0o755))
+
+	newConfig := func() *Config {
+		cfg := NewDefault()
+		cfg.DataDir = tmpDir
+		cfg.readWriter = fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+		cfg.ManagementService.Config.Service.CertificateAuthority = caFile
+		return cfg
+	}
+
+	// without a published bundle, the configured CA is trusted
+	cfg := newConfig()
+	require.NoError(cfg.LoadManagementCABundle())
+	require.Equal(caFile, cfg.ManagementService.Config.Service.CertificateAuthority)
+	bundle, err := cfg.ManagementCABundle()
+	require.NoError(err)
+	require.Equal(configuredCA, bundle)
+
+	// bundles with certificates that are not CA certificates are rejected
+	require.ErrorContains(cfg.StoreManagementCABundle(newTestCertificatePEM(t, "device", false)), "not a CA certificate")
+	require.ErrorContains(cfg.StoreManagementCABundle([]byte("not a bundle")), "invalid management CA bundle")
+	require.NoFileExists(cfg.ManagementCABundlePath())
+
+	published := append(newTestCertificatePEM(t, "previous-ca", true), newTestCertificatePEM(t, "new-ca", true)...)
+	require.NoError(cfg.StoreManagementCABundle(published))
+	require.FileExists(cfg.ManagementCABundlePath())
+	require.Empty(cfg.ManagementService.Config.Service.CertificateAuthority)
+	bundle, err = cfg.ManagementCABundle()
+	require.NoError(err)
+	require.Equal(published, bundle)
+
+	// the published bundle is trusted again after a restart
+	cfg = newConfig()
+	require.NoError(cfg.LoadManagementCABundle())
+	require.Empty(cfg.ManagementService.Config.Service.CertificateAuthority)
+	require.Equal(published, cfg.ManagementService.Config.Service.CertificateAuthorityData)
+
+	// a bundle that is already trusted is not stored again
+	updated, err := cfg.UpdateManagementCABundle(published)
+	require.NoError(err)
+	require.False(updated)
+	retired := newTestCertificatePEM(t, "new-ca", true)
+	updated, err = cfg.UpdateManagementCABundle(retired)
+	require.NoError(err)
+	require.True(updated)
+	bundle, err = cfg.ManagementCABundle()
+	require.NoError(err)
+	require.Equal(retired, bundle)
+}
@@ -121,6 +121,9 @@ type Application interface {
 	Status() (*v1beta1.DeviceApplicationStatus, v1beta1.DeviceApplicationsSummaryStatus, error)
 	// ActionSpec returns the type-specific action configuration for this application.
 	ActionSpec() lifecycle.ActionSpec
+	// Health returns the health check tracker of the application, or nil if
+	// the application has no health checks.
+	Health() *Health
 }
 
 // Workload represents an application workload tracked by a Monitor.
@@ -130,6 +133,11 @@ type Workload struct {
 	Name     string
 	Status   StatusType
 	Restarts int
+	// Owner identifies the Kubernetes workload controller that manages the
+	// workload, if any.
+	Owner string
+	// Reason is the reason the workload is failing, such as CrashLoopBackOff.
+	Reason string
 }
 
 type application struct {
@@ -139,6 +147,7 @@ type application struct {
 	volume     provider.VolumeManager
 	status     *v1beta1.DeviceApplicationStatus
 	actionSpec lifecycle.ActionSpec
+	health     *Health
 }
 
 // NewApplication creates a new application from an application provider.
@@ -155,6 +164,7 @@ func NewApplication(p provider.Provider) *application {
 			RunAs:    spec.User,
 		},
 		volume: spec.Volume,
+		health: NewHealth(spec.HealthChecks()),
 	}
 }
 
@@ -256,6 +266,10 @@ func (a *application) Volume() provider.VolumeManager {
 	return a.volume
 }
 
+func (a *application) Health() *Health {
+	return a.health
+}
+
 func (a *application) Status() (*v1beta1.DeviceApplicationStatus, v1beta1.DeviceApplicationsSummaryStatus, error) {
 	// TODO: revisit performance of this function
 	healthy := 0
@@ -308,6 +322,23 @@ func (a *application) Status() (*v1beta1.DeviceApplicationStatus, v1beta1.Device
 		return nil, summary, fmt.Errorf("unknown application status: %d/%d/%d", total, healthy, initializing)
 	}
 
+	// health checks only apply once the workloads are running
+	if a.health != nil && newStatus == v1beta1.ApplicationStatusRunning {
+		state, message := a.health.State()
+		switch state {
+		case HealthStatePending:
+			newStatus = v1beta1.ApplicationStatusStarting
+			summary.Status = v1beta1.ApplicationsSummaryStatusDegraded
+		case HealthStateDegraded:
+			summary.Status = v1beta1.ApplicationsSummaryStatusDegraded
+			summary.Info = &message
+		case HealthStateUnhealthy:
+			newStatus = v1beta1.ApplicationStatusError
+			summary.Status = v1beta1.ApplicationsSummaryStatusError
+			summary.Info = &message
+		}
+	}
+
 	if a.status.Status != newStatus {
 		a.status.Status = newStatus
 	}
@@ -0,0 +1,328 @@
+package applications
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/samber/lo"
+)
+
+// HealthState is the result of the health checks of an application.
+type HealthState string
+
+const (
+	// HealthStatePending means not all health checks have reached a result yet.
+	HealthStatePending HealthState = "Pending"
+	// HealthStateHealthy means all health checks pass.
+	HealthStateHealthy HealthState = "Healthy"
+	// HealthStateDegraded means some, but not all, health checks fail.
+	HealthStateDegraded HealthState = "Degraded"
+	// HealthStateUnhealthy means all health checks fail.
+	HealthStateUnhealthy HealthState = "Unhealthy"
+)
+
+// Prober runs a single health check probe against the workloads of an application.
+type Prober interface {
+	Probe(ctx context.Context, check v1beta1.ApplicationHealthCheck, user v1beta1.Username, workloads []Workload) error
+}
+
+// Health tracks the results of the health checks of an application.
+type Health struct {
+	mu sync.Mutex
+	// runningSince is the time the application was first observed running. It
+	// is reset when the application stops so that the startup grace period
+	// applies again after a restart.
+	runningSince time.Time
+	// generation is incremented on reset so that results of probes started
+	// before the reset are discarded.
+	generation int
+	probes     []*probeState
+}
+
+type probeState struct {
+	check            v1beta1.ApplicationHealthCheck
+	name             string
+	interval         time.Duration
+	timeout          time.Duration
+	gracePeriod      time.Duration
+	failureThreshold int
+	successThreshold int
+
+	running   bool
+	lastRun   time.Time
+	failures  int
+	successes int
+	// healthy is nil until the probe reaches either threshold.
+	healthy   *bool
+	lastError error
+}
+
+// NewHealth returns a health tracker for the health checks, or nil if there are none.
+func NewHealth(checks []v1beta1.ApplicationHealthCheck) *Health {
+	if len(checks) == 0 {
+		return nil
+	}
+	h := &Health{}
+	for _, check := range checks {
+		// the durations are validated by the service, fall back to the defaults if they are not
+		interval, err := check.GetInterval()
+		if err != nil {
+			interval = v1beta1.DefaultApplicationHealthCheckInterval
+		}
+		timeout, err := check.GetTimeout()
+		if err != nil {
+			timeout = v1beta1.DefaultApplicationHealthCheckTimeout
+		}
+		gracePeriod, err := check.GetStartupGracePeriod()
+		if err != nil {
+			gracePeriod = 0
+		}
+		h.probes = append(h.probes, &probeState{
+			check:            check,
+			name:             check.ProbeName(),
+			interval:         interval,
+			timeout:          timeout,
+			gracePeriod:      gracePeriod,
+			failureThreshold: check.GetFailureThreshold(),
+			successThreshold: check.GetSuccessThreshold(),
+		})
+	}
+	return h
+}
+
+// Probe starts the probes that are due at the given time. Probes are only run
+// while at least one workload of the application is running.
+func (h *Health) Probe(ctx context.Context, now time.Time, prober Prober, user v1beta1.Username, workloads []Workload) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !hasRunningWorkload(workloads) {
+		h.reset()
+		return
+	}
+	if h.runningSince.IsZero() {
+		h.runningSince = now
+	}
+
+	for _, probe := range h.probes {
+		if probe.running || (!probe.lastRun.IsZero() && now.Sub(probe.lastRun) < probe.interval) {
+			continue
+		}
+		probe.running = true
+		probe.lastRun = now
+		go func(probe *probeState, generation int) {
+			probeCtx, cancel := context.WithTimeout(ctx, probe.timeout)
+			defer cancel()
+			err := prober.Probe(probeCtx, probe.check, user, workloads)
+			h.record(probe, generation, now, err)
+		}(probe, h.generation)
+	}
+}
+
+func (h *Health) record(probe *probeState, generation int, startedAt time.Time, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	probe.running = false
+	if generation != h.generation {
+		return
+	}
+	if err == nil {
+		probe.failures = 0
+		probe.successes++
+		probe.lastError = nil
+		if probe.successes >= probe.successThreshold {
+			probe.healthy = lo.ToPtr(true)
+		}
+		return
+	}
+
+	probe.successes = 0
+	probe.lastError = err
+	// failures during the startup grace period are not counted
+	if h.runningSince.IsZero() || startedAt.Sub(h.runningSince) < probe.gracePeriod {
+		return
+	}
+	probe.failures++
+	if probe.failures >= probe.failureThreshold {
+		probe.healthy = lo.ToPtr(false)
+	}
+}
+
+// reset forgets all probe results.
+func (h *Health) reset() {
+	if h.runningSince.IsZero() {
+		return
+	}
+	h.generation++
+	h.runningSince = time.Time{}
+	for _, probe := range h.probes {
+		probe.lastRun = time.Time{}
+		probe.failures = 0
+		probe.successes = 0
+		probe.healthy = nil
+		probe.lastError = nil
+	}
+}
+
+// State returns the health state of the application and a message describing
+// the failing health checks.
+func (h *Health) State() (HealthState, string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	pending := 0
+	var failing []string
+	for _, probe := range h.probes {
+		switch {
+		case probe.healthy == nil:
+			pending++
+		case !*probe.healthy:
+			failing = append(failing, fmt.Sprintf("health check %s failed: %v", probe.name, probe.lastError))
+		}
+	}
+
+	message := strings.Join(failing, ", ")
+	switch {
+	case len(failing) == len(h.probes):
+		return HealthStateUnhealthy, message
+	case len(failing) > 0:
+		return HealthStateDegraded, message
+	case pending > 0:
+		return HealthStatePending, ""
+	default:
+		return HealthStateHealthy, ""
+	}
+}
+
+func hasRunningWorkload(workloads []Workload) bool {
+	for _, workload := range workloads {
+		if workload.Status == StatusRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// podmanProber runs HTTP and TCP probes from the device and exec probes inside
+// the containers of the application.
+type podmanProber struct {
+	clientFactory client.PodmanFactory
+	httpClient    *http.Client
+}
+
+// NewPodmanProber returns a prober for podman applications.
+func NewPodmanProber(clientFactory client.PodmanFactory) Prober {
+	return &podmanProber{
+		clientFactory: clientFactory,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				// probes verify that the application serves requests, not its certificate
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+			},
+			// redirects are treated as success and not followed
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (p *podmanProber) Probe(ctx context.Context, check v1beta1.ApplicationHealthCheck, user v1beta1.Username, workloads []Workload) error {
+	switch {
+	case check.Http != nil:
+		return p.probeHTTP(ctx, check.Http)
+	case check.Tcp != nil:
+		return p.probeTCP(ctx, check.Tcp)
+	case check.Exec != nil:
+		return p.probeExec(ctx, check.Exec, user, workloads)
+	default:
+		return fmt.Errorf("no probe defined")
+	}
+}
+
+func (p *podmanProber) probeHTTP(ctx context.Context, probe *v1beta1.ApplicationHttpProbe) error {
+	scheme := "http"
+	if probe.Scheme != nil && *probe.Scheme == v1beta1.ApplicationHttpProbeSchemeHTTPS {
+		scheme = "https"
+	}
+	path := "/"
+	if probe.Path != nil && *probe.Path != "" {
+		path = *probe.Path
+	}
+	url := fmt.Sprintf("%s://%s%s", scheme, probeAddress(probe.Host, probe.Port), path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("GET %s returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+func (p *podmanProber) probeTCP(ctx context.Context, probe *v1beta1.ApplicationTcpProbe) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", probeAddress(probe.Host, probe.Port))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (p *podmanProber) probeExec(ctx context.Context, probe *v1beta1.ApplicationExecProbe, user v1beta1.Username, workloads []Workload) error {
+	container, err := execProbeContainer(probe, workloads)
+	if err != nil {
+		return err
+	}
+	podman, err := p.clientFactory(user)
+	if err != nil {
+		return fmt.Errorf("creating podman client: %w", err)
+	}
+	_, err = podman.Exec(ctx, container, probe.Command...)
+	return err
+}
+
+// execProbeContainer returns the container an exec probe runs in: the named
+// container, or the first running container of the application.
+func execProbeContainer(probe *v1beta1.ApplicationExecProbe, workloads []Workload) (string, error) {
+	for _, workload := range workloads {
+		if workload.Status != StatusRunning {
+			continue
+		}
+		if probe.Container != nil && *probe.Container != workload.Name {
+			continue
+		}
+		if workload.ID != "" {
+			return workload.ID, nil
+		}
+		return workload.Name, nil
+	}
+	if probe.Container != nil {
+		return "", fmt.Errorf("container %s is not running", *probe.Container)
+	}
+	return "", fmt.Errorf("no running container")
+}
+
+func probeAddress(host *string, port int32) string {
+	address := "localhost"
+	if host != nil && *host != "" {
+		address = *host
+	}
+	return net.JoinHostPort(address, strconv.Itoa(int(port)))
+}
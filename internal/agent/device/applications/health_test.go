@@ -0,0 +1,224 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProber struct {
+	mu      sync.Mutex
+	results map[string]error
+}
+
+func (f *fakeProber) set(name string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.results[name] = err
+}
+
+func (f *fakeProber) Probe(_ context.Context, check v1beta1.ApplicationHealthCheck, _ v1beta1.Username, _ []Workload) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.results[check.ProbeName()]
+}
+
+// probeAndWait runs the due probes and waits for them to complete.
+func probeAndWait(t *testing.T, h *Health, now time.Time, prober Prober, workloads []Workload) {
+	h.Probe(context.Background(), now, prober, "", workloads)
+	require.Eventually(t, func() bool {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		for _, probe := range h.probes {
+			if probe.running {
+				return false
+			}
+		}
+		return true
+	}, time.Second, time.Millisecond)
+}
+
+func TestHealth(t *testing.T) {
+	require := require.New(t)
+	running := []Workload{{Name: "app", Status: StatusRunning}}
+	start := time.Now()
+	at := func(seconds int) time.Time {
+		return start.Add(time.Duration(seconds) * time.Second)
+	}
+
+	require.Nil(NewHealth(nil))
+
+	h := NewHealth([]v1beta1.ApplicationHealthCheck{
+		{Name: lo.ToPtr("web"), Http: &v1beta1.ApplicationHttpProbe{Port: 8080}, Interval: lo.ToPtr("10s"), FailureThreshold: lo.ToPtr(int32(2)), StartupGracePeriod: lo.ToPtr("20s")},
+		{Name: lo.ToPtr("db"), Tcp: &v1beta1.ApplicationTcpProbe{Port: 5432}, Interval: lo.ToPtr("10s"), FailureThreshold: lo.ToPtr(int32(1))},
+	})
+	prober := &fakeProber{results: map[string]error{"web": errors.New("connection refused")}}
+
+	// web fails during its startup grace period, db succeeds
+	probeAndWait(t, h, at(0), prober, running)
+	state, _ := h.State()
+	require.Equal(HealthStatePending, state)
+
+	// probes are not run again before their interval elapsed
+	prober.set("db", errors.New("connection refused"))
+	probeAndWait(t, h, at(5), prober, running)
+	state, _ = h.State()
+	require.Equal(HealthStatePending, state)
+
+	// db reaches its failure threshold, web is still in its grace period
+	probeAndWait(t, h, at(10), prober, running)
+	state, message := h.State()
+	require.Equal(HealthStateDegraded, state)
+	require.Equal("health check db failed: connection refused", message)
+
+	// web reaches its failure threshold after the grace period
+	probeAndWait(t, h, at(20), prober, running)
+	probeAndWait(t, h, at(30), prober, running)
+	state, message = h.State()
+	require.Equal(HealthStateUnhealthy, state)
+	require.Contains(message, "health check web failed")
+
+	// a single success recovers both probes
+	prober.set("web", nil)
+	prober.set("db", nil)
+	probeAndWait(t, h, at(40), prober, running)
+	state, message = h.State()
+	require.Equal(HealthStateHealthy, state)
+	require.Empty(message)
+
+	// results are forgotten while the application is not running
+	probeAndWait(t, h, at(50), prober, []Workload{{Name: "app", Status: StatusExited}})
+	state, _ = h.State()
+	require.Equal(HealthStatePending, state)
+}
+
+func TestApplicationStatusWithHealthChecks(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		name                  string
+		results               map[string]error
+		expectedStatus        v1beta1.ApplicationStatusType
+		expectedSummaryStatus v1beta1.ApplicationsSummaryStatusType
+		expectedInfo          string
+	}{
+		{
+			name:                  "all health checks pass",
+			results:               map[string]error{},
+			expectedStatus:        v1beta1.ApplicationStatusRunning,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusHealthy,
+		},
+		{
+			name:                  "some health checks fail",
+			results:               map[string]error{"web": errors.New("timeout")},
+			expectedStatus:        v1beta1.ApplicationStatusRunning,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusDegraded,
+			expectedInfo:          "health check web failed: timeout",
+		},
+		{
+			name:                  "all health checks fail",
+			results:               map[string]error{"web": errors.New("timeout"), "db": errors.New("refused")},
+			expectedStatus:        v1beta1.ApplicationStatusError,
+			expectedSummaryStatus: v1beta1.ApplicationsSummaryStatusError,
+			expectedInfo:          "health check web failed: timeout, health check db failed: refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			volume, err := provider.NewVolumeManager(nil, "app", v1beta1.AppTypeCompose, v1beta1.CurrentProcessUsername, nil)
+			require.NoError(err)
+			app := &application{
+				status: &v1beta1.DeviceApplicationStatus{Name: "app", AppType: v1beta1.AppTypeCompose},
+				volume: volume,
+				health: NewHealth([]v1beta1.ApplicationHealthCheck{
+					{Name: lo.ToPtr("web"), Http: &v1beta1.ApplicationHttpProbe{Port: 8080}, FailureThreshold: lo.ToPtr(int32(1))},
+					{Name: lo.ToPtr("db"), Tcp: &v1beta1.ApplicationTcpProbe{Port: 5432}, FailureThreshold: lo.ToPtr(int32(1))},
+				}),
+				workloads: []Workload{{Name: "app", Status: StatusRunning}},
+			}
+
+			// running workloads are reported as starting until the health checks have a result
+			status, summary, err := app.Status()
+			require.NoError(err)
+			require.Equal(v1beta1.ApplicationStatusStarting, status.Status)
+			require.Equal(v1beta1.ApplicationsSummaryStatusDegraded, summary.Status)
+
+			probeAndWait(t, app.health, time.Now(), &fakeProber{results: tt.results}, app.Workloads())
+			status, summary, err = app.Status()
+			require.NoError(err)
+			require.Equal(tt.expectedStatus, status.Status)
+			require.Equal(tt.expectedSummaryStatus, summary.Status)
+			require.Equal(tt.expectedInfo, lo.FromPtr(summary.Info))
+		})
+	}
+}
+
+func TestPodmanProber(t *testing.T) {
+	require := require.New(t)
+	prober := NewPodmanProber(nil)
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusOK)
+		case "/moved":
+			http.Redirect(w, r, "/healthz", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(err)
+
+	httpCheck := func(path string) v1beta1.ApplicationHealthCheck {
+		return v1beta1.ApplicationHealthCheck{Http: &v1beta1.ApplicationHttpProbe{Port: int32(port), Path: lo.ToPtr(path), Host: lo.ToPtr("127.0.0.1")}}
+	}
+	require.NoError(prober.Probe(ctx, httpCheck("/healthz"), "", nil))
+	require.NoError(prober.Probe(ctx, httpCheck("/moved"), "", nil))
+	require.ErrorContains(prober.Probe(ctx, httpCheck("/ready"), "", nil), "returned status 503")
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	tcpPort := listener.Addr().(*net.TCPAddr).Port
+	tcpCheck := v1beta1.ApplicationHealthCheck{Tcp: &v1beta1.ApplicationTcpProbe{Port: int32(tcpPort), Host: lo.ToPtr("127.0.0.1")}}
+	require.NoError(prober.Probe(ctx, tcpCheck, "", nil))
+	require.NoError(listener.Close())
+	require.Error(prober.Probe(ctx, tcpCheck, "", nil))
+}
+
+func TestExecProbeContainer(t *testing.T) {
+	require := require.New(t)
+	workloads := []Workload{
+		{ID: "aaa", Name: "init", Status: StatusExited},
+		{ID: "bbb", Name: "web", Status: StatusRunning},
+		{ID: "ccc", Name: "db", Status: StatusRunning},
+	}
+
+	container, err := execProbeContainer(&v1beta1.ApplicationExecProbe{Command: []string{"true"}}, workloads)
+	require.NoError(err)
+	require.Equal("bbb", container)
+
+	container, err = execProbeContainer(&v1beta1.ApplicationExecProbe{Command: []string{"true"}, Container: lo.ToPtr("db")}, workloads)
+	require.NoError(err)
+	require.Equal("ccc", container)
+
+	_, err = execProbeContainer(&v1beta1.ApplicationExecProbe{Command: []string{"true"}, Container: lo.ToPtr("init")}, workloads)
+	require.ErrorContains(err, "container init is not running")
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/helm"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/pkg/log"
 )
@@ -39,6 +40,11 @@ type KubernetesMonitor struct {
 
 	clients   client.CLIClients
 	rwFactory fileio.ReadWriterFactory
+
+	controllerWatches []*controllerWatch
+	// controllers holds the workload controllers of each application by
+	// application ID and controller key.
+	controllers map[string]map[string]*kubernetesController
 }
 
 // NewKubernetesMonitor creates a new KubernetesMonitor with lazy initialization.
@@ -55,11 +61,16 @@ func NewKubernetesMonitor(
 		},
 	)
 
-	return &KubernetesMonitor{
-		monitor:   m,
-		clients:   clients,
-		rwFactory: rwFactory,
+	k := &KubernetesMonitor{
+		monitor:     m,
+		clients:     clients,
+		rwFactory:   rwFactory,
+		controllers: make(map[string]map[string]*kubernetesController),
+	}
+	for _, resource := range kubernetesControllerResources {
+		k.controllerWatches = append(k.controllerWatches, newControllerWatch(k, resource.name, resource.kind))
 	}
+	return k
 }
 
 // Ensure adds an application to be monitored.
@@ -69,7 +80,13 @@ func (m *KubernetesMonitor) Ensure(app Application) error {
 
 // Remove removes an application from monitoring.
 func (m *KubernetesMonitor) Remove(app Application) error {
-	return m.monitor.Remove(app)
+	if err := m.monitor.Remove(app); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.controllers, app.ID())
+	return nil
 }
 
 // Update updates an existing application, preserving workloads from the old app.
@@ -105,11 +122,28 @@ func (m *KubernetesMonitor) OnRestart() {
 }
 
 func (m *KubernetesMonitor) startMonitor(ctx context.Context) error {
-	return m.startStreaming(ctx, m)
+	if err := m.startStreaming(ctx, m); err != nil {
+		return err
+	}
+	for _, w := range m.controllerWatches {
+		if err := w.startStreaming(ctx, w); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (m *KubernetesMonitor) stopMonitor() error {
-	return m.stopStreaming()
+	var errs []error
+	for _, w := range m.controllerWatches {
+		if err := w.stopStreaming(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := m.stopStreaming(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
 }
 
 // Stop stops the Kubernetes monitor.
@@ -156,14 +190,21 @@ type kubernetesPod struct {
 	APIVersion string `json:"apiVersion"`
 	Kind       string `json:"kind"`
 	Metadata   struct {
-		Name      string            `json:"name"`
-		Namespace string            `json:"namespace"`
-		UID       string            `json:"uid"`
-		Labels    map[string]string `json:"labels,omitempty"`
+		Name            string                     `json:"name"`
+		Namespace       string                     `json:"namespace"`
+		UID             string                     `json:"uid"`
+		Labels          map[string]string          `json:"labels,omitempty"`
+		OwnerReferences []kubernetesOwnerReference `json:"ownerReferences,omitempty"`
 	} `json:"metadata"`
 	Status kubernetesPodStatus `json:"status"`
 }
 
+type kubernetesOwnerReference struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Controller *bool  `json:"controller,omitempty"`
+}
+
 type kubernetesPodStatus struct {
 	Phase             string                      `json:"phase"`
 	ContainerStatuses []kubernetesContainerStatus `json:"containerStatuses,omitempty"`
@@ -246,6 +287,8 @@ func (m *KubernetesMonitor) removeWorkload(app Application, pod *kubernetesPod)
 func (m *KubernetesMonitor) updatePodStatus(app Application, pod *kubernetesPod) {
 	status := m.mapPodPhaseToStatus(pod)
 	restarts := m.getPodRestartCount(pod)
+	owner := podOwner(pod)
+	reason := m.podErrorReason(pod)
 
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -253,6 +296,8 @@ func (m *KubernetesMonitor) updatePodStatus(app Application, pod *kubernetesPod)
 	workload, exists := app.Workload(pod.Metadata.Name)
 	if exists {
 		workload.Status = status
+		workload.Owner = owner
+		workload.Reason = reason
 		if pod.Metadata.UID != workload.ID {
 			workload.ID = pod.Metadata.UID
 			workload.Restarts = restarts
@@ -268,6 +313,8 @@ func (m *KubernetesMonitor) updatePodStatus(app Application, pod *kubernetesPod)
 		Name:     pod.Metadata.Name,
 		Status:   status,
 		Restarts: restarts,
+		Owner:    owner,
+		Reason:   reason,
 	})
 }
 
@@ -276,7 +323,7 @@ func (m *KubernetesMonitor) mapPodPhaseToStatus(pod *kubernetesPod) StatusType {
 	case podPhasePending:
 		return StatusInit
 	case podPhaseRunning:
-		if m.hasContainerErrors(pod) {
+		if m.containerErrorReason(pod) != "" {
 			return StatusDied
 		}
 		if m.allContainersReady(pod) {
@@ -304,7 +351,9 @@ func (m *KubernetesMonitor) allContainersReady(pod *kubernetesPod) bool {
 	return true
 }
 
-func (m *KubernetesMonitor) hasContainerErrors(pod *kubernetesPod) bool {
+// containerErrorReason returns the reason of the first failing container of
+// the pod, or an empty string if no container is failing.
+func (m *KubernetesMonitor) containerErrorReason(pod *kubernetesPod) string {
 	for _, cs := range pod.Status.ContainerStatuses {
 		if cs.State.Waiting != nil {
 			switch cs.State.Waiting.Reason {
@@ -313,14 +362,26 @@ func (m *KubernetesMonitor) hasContainerErrors(pod *kubernetesPod) bool {
 				containerReasonCreateContainerConfigErr,
 				containerReasonCreateContainerErr,
 				containerReasonInvalidImageName:
-				return true
+				return cs.State.Waiting.Reason
 			}
 		}
 		if cs.State.Terminated != nil && cs.State.Terminated.Reason == containerReasonOOMKilled {
-			return true
+			return cs.State.Terminated.Reason
 		}
 	}
-	return false
+	return ""
+}
+
+// podErrorReason returns the reason the pod is failing, or an empty string if
+// it is not failing.
+func (m *KubernetesMonitor) podErrorReason(pod *kubernetesPod) string {
+	if reason := m.containerErrorReason(pod); reason != "" {
+		return reason
+	}
+	if pod.Status.Phase == podPhaseFailed {
+		return podPhaseFailed
+	}
+	return ""
 }
 
 func (m *KubernetesMonitor) getPodRestartCount(pod *kubernetesPod) int {
@@ -0,0 +1,342 @@
+package applications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/helm"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+)
+
+const (
+	// workload controller kinds
+	kindDeployment  = "Deployment"
+	kindStatefulSet = "StatefulSet"
+	kindDaemonSet   = "DaemonSet"
+	kindReplicaSet  = "ReplicaSet"
+
+	// podTemplateHashLabel is the label a Deployment adds to the pods of each
+	// of its ReplicaSets.
+	podTemplateHashLabel = "pod-template-hash"
+)
+
+// kubernetesControllerResources are the workload controller resources whose
+// readiness is reported in the application status. kubectl watches a single
+// resource type per command.
+var kubernetesControllerResources = []struct {
+	name string
+	kind string
+}{
+	{name: "deployments", kind: kindDeployment},
+	{name: "statefulsets", kind: kindStatefulSet},
+	{name: "daemonsets", kind: kindDaemonSet},
+}
+
+// kubernetesController is the observed state of a workload controller of an
+// application.
+type kubernetesController struct {
+	kind      string
+	name      string
+	namespace string
+	desired   int
+	ready     int
+}
+
+// controllerKey returns the key that identifies a workload controller.
+func controllerKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// controllerWatch streams the events of one workload controller resource type
+// to the KubernetesMonitor.
+type controllerWatch struct {
+	*monitor
+
+	parent   *KubernetesMonitor
+	resource string
+	kind     string
+}
+
+func newControllerWatch(parent *KubernetesMonitor, resource, kind string) *controllerWatch {
+	return &controllerWatch{
+		monitor:  newMonitor(parent.log, "kubernetes "+resource),
+		parent:   parent,
+		resource: resource,
+		kind:     kind,
+	}
+}
+
+// CreateCommand implements streamingMonitor interface.
+func (w *controllerWatch) CreateCommand(ctx context.Context) (*exec.Cmd, error) {
+	kubeconfigPath, err := w.parent.clients.Kube().ResolveKubeconfig()
+	if err != nil {
+		return nil, fmt.Errorf("resolving kubeconfig: %w", err)
+	}
+	return w.parent.clients.Kube().WatchCmd(ctx, w.resource,
+		client.WithKubeKubeconfig(kubeconfigPath),
+		client.WithKubeLabels([]string{helm.AppLabelKey}),
+	)
+}
+
+// Parser implements streamingMonitor interface.
+func (w *controllerWatch) Parser() streamParser {
+	return jsonStreamParser{}
+}
+
+// HandleEvent implements streamingMonitor interface.
+func (w *controllerWatch) HandleEvent(_ context.Context, data []byte) {
+	w.parent.handleControllerEvent(data)
+}
+
+// OnRestart implements streamingMonitor interface.
+func (w *controllerWatch) OnRestart() {
+	w.parent.clearControllers(w.kind)
+}
+
+type kubernetesControllerWatchEvent struct {
+	Type   string                     `json:"type"`
+	Object kubernetesControllerObject `json:"object"`
+}
+
+type kubernetesControllerObject struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name      string            `json:"name"`
+		Namespace string            `json:"namespace"`
+		Labels    map[string]string `json:"labels,omitempty"`
+	} `json:"metadata"`
+	Spec struct {
+		Replicas *int `json:"replicas,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		// Deployment and StatefulSet
+		ReadyReplicas int `json:"readyReplicas"`
+		// DaemonSet
+		DesiredNumberScheduled int `json:"desiredNumberScheduled"`
+		NumberReady            int `json:"numberReady"`
+	} `json:"status"`
+}
+
+// replicas returns the desired and ready pods of the controller.
+func (o *kubernetesControllerObject) replicas() (int, int) {
+	if o.Kind == kindDaemonSet {
+		return o.Status.DesiredNumberScheduled, o.Status.NumberReady
+	}
+	// the API server defaults replicas to 1
+	desired := 1
+	if o.Spec.Replicas != nil {
+		desired = *o.Spec.Replicas
+	}
+	return desired, o.Status.ReadyReplicas
+}
+
+func (m *KubernetesMonitor) handleControllerEvent(data []byte) {
+	var event kubernetesControllerWatchEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		m.log.Errorf("Failed to decode kubernetes watch event: %s %v", string(data), err)
+		return
+	}
+
+	obj := &event.Object
+	releaseID, ok := obj.Metadata.Labels[helm.AppLabelKey]
+	if !ok {
+		m.log.Debugf("%s %s missing %s label, skipping", obj.Kind, obj.Metadata.Name, helm.AppLabelKey)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.apps[releaseID]; !ok {
+		m.log.Debugf("Application not found for release: %s", releaseID)
+		return
+	}
+
+	key := controllerKey(obj.Kind, obj.Metadata.Namespace, obj.Metadata.Name)
+	switch event.Type {
+	case "DELETED":
+		delete(m.controllers[releaseID], key)
+	case "ADDED", "MODIFIED":
+		desired, ready := obj.replicas()
+		if m.controllers[releaseID] == nil {
+			m.controllers[releaseID] = make(map[string]*kubernetesController)
+		}
+		m.controllers[releaseID][key] = &kubernetesController{
+			kind:      obj.Kind,
+			name:      obj.Metadata.Name,
+			namespace: obj.Metadata.Namespace,
+			desired:   desired,
+			ready:     ready,
+		}
+	default:
+		m.log.Warnf("Unknown watch event type: %s", event.Type)
+	}
+}
+
+// clearControllers removes the controllers of the given kind from all
+// applications. The watch lists all controllers again when it restarts.
+func (m *KubernetesMonitor) clearControllers(kind string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, controllers := range m.controllers {
+		for key, controller := range controllers {
+			if controller.kind == kind {
+				delete(controllers, key)
+			}
+		}
+	}
+}
+
+// Status returns the status of all monitored applications including the
+// status of their workload controllers.
+func (m *KubernetesMonitor) Status() ([]AppStatusResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var errs []error
+	results := make([]AppStatusResult, 0, len(m.apps))
+
+	for id, app := range m.apps {
+		appStatus, appSummary, err := app.Status()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result := AppStatusResult{
+			Status:  *appStatus,
+			Summary: appSummary,
+		}
+		applyControllerStatus(&result, app.Workloads(), m.controllers[id])
+		results = append(results, result)
+	}
+
+	if len(errs) > 0 {
+		return results, errors.Join(errs...)
+	}
+
+	return results, nil
+}
+
+// applyControllerStatus adds the status of the workload controllers of an
+// application to its status result. Controllers that are not ready degrade the
+// application and controllers without any ready pods because their pods are
+// failing put the application in error.
+func applyControllerStatus(result *AppStatusResult, pods []Workload, controllers map[string]*kubernetesController) {
+	if len(controllers) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(controllers))
+	for key := range controllers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	workloads := make([]v1beta1.ApplicationWorkloadStatus, 0, len(keys))
+	var messages []string
+	var hasError, hasNotReady bool
+	for _, key := range keys {
+		workload := controllerStatus(key, controllers[key], pods)
+		workloads = append(workloads, workload)
+
+		if workload.Status == v1beta1.ApplicationStatusError {
+			hasError = true
+		}
+		controller := controllers[key]
+		if controller.ready < controller.desired {
+			hasNotReady = true
+			message := fmt.Sprintf("%s %s/%s has %s ready pods", controller.kind, controller.namespace, controller.name, workload.Ready)
+			if workload.Message != nil {
+				message = fmt.Sprintf("%s: %s", message, *workload.Message)
+			}
+			messages = append(messages, message)
+		}
+	}
+	result.Status.Workloads = &workloads
+
+	switch {
+	case hasError:
+		result.Status.Status = v1beta1.ApplicationStatusError
+		result.Summary.Status = v1beta1.ApplicationsSummaryStatusError
+	case hasNotReady && result.Summary.Status == v1beta1.ApplicationsSummaryStatusHealthy:
+		result.Summary.Status = v1beta1.ApplicationsSummaryStatusDegraded
+	}
+	if len(messages) > 0 {
+		if result.Summary.Info != nil && *result.Summary.Info != "" {
+			messages = append([]string{*result.Summary.Info}, messages...)
+		}
+		info := strings.Join(messages, "; ")
+		result.Summary.Info = &info
+	}
+}
+
+// controllerStatus returns the status of a workload controller from its
+// observed state and the pods it owns.
+func controllerStatus(key string, controller *kubernetesController, pods []Workload) v1beta1.ApplicationWorkloadStatus {
+	var restarts int
+	var reason string
+	for _, pod := range pods {
+		if pod.Owner != key {
+			continue
+		}
+		restarts += pod.Restarts
+		if reason == "" && pod.Reason != "" {
+			reason = fmt.Sprintf("pod %s is in %s", pod.Name, pod.Reason)
+		}
+	}
+
+	var status v1beta1.ApplicationStatusType
+	switch {
+	case controller.ready >= controller.desired:
+		status = v1beta1.ApplicationStatusRunning
+	case controller.ready == 0 && reason != "":
+		status = v1beta1.ApplicationStatusError
+	case controller.ready > 0:
+		status = v1beta1.ApplicationStatusRunning
+	default:
+		status = v1beta1.ApplicationStatusStarting
+	}
+
+	workload := v1beta1.ApplicationWorkloadStatus{
+		Kind:      controller.kind,
+		Name:      controller.name,
+		Namespace: controller.namespace,
+		Ready:     strconv.Itoa(controller.ready) + "/" + strconv.Itoa(controller.desired),
+		Restarts:  restarts,
+		Status:    status,
+	}
+	if reason != "" {
+		workload.Message = &reason
+	}
+	return workload
+}
+
+// podOwner returns the key of the workload controller that manages the pod,
+// or an empty string if the pod is not managed by a tracked controller.
+func podOwner(pod *kubernetesPod) string {
+	for _, ref := range pod.Metadata.OwnerReferences {
+		if ref.Controller == nil || !*ref.Controller {
+			continue
+		}
+		switch ref.Kind {
+		case kindReplicaSet:
+			// the pods of a Deployment are owned by one of its ReplicaSets,
+			// which are named <deployment>-<pod-template-hash>.
+			hash := pod.Metadata.Labels[podTemplateHashLabel]
+			if hash == "" || !strings.HasSuffix(ref.Name, "-"+hash) {
+				return ""
+			}
+			return controllerKey(kindDeployment, pod.Metadata.Namespace, strings.TrimSuffix(ref.Name, "-"+hash))
+		case kindStatefulSet, kindDaemonSet:
+			return controllerKey(ref.Kind, pod.Metadata.Namespace, ref.Name)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,158 @@
+package applications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPodOwner(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		refs     []kubernetesOwnerReference
+		expected string
+	}{
+		{
+			name:     "deployment pod",
+			labels:   map[string]string{podTemplateHashLabel: "5d4f8c7b9"},
+			refs:     []kubernetesOwnerReference{{Kind: kindReplicaSet, Name: "web-5d4f8c7b9", Controller: lo.ToPtr(true)}},
+			expected: "Deployment/apps/web",
+		},
+		{
+			name:     "statefulset pod",
+			refs:     []kubernetesOwnerReference{{Kind: kindStatefulSet, Name: "db", Controller: lo.ToPtr(true)}},
+			expected: "StatefulSet/apps/db",
+		},
+		{
+			name:     "daemonset pod",
+			refs:     []kubernetesOwnerReference{{Kind: kindDaemonSet, Name: "agent", Controller: lo.ToPtr(true)}},
+			expected: "DaemonSet/apps/agent",
+		},
+		{
+			name: "replicaset without deployment",
+			refs: []kubernetesOwnerReference{{Kind: kindReplicaSet, Name: "standalone", Controller: lo.ToPtr(true)}},
+		},
+		{
+			name: "job pod",
+			refs: []kubernetesOwnerReference{{Kind: "Job", Name: "migrate", Controller: lo.ToPtr(true)}},
+		},
+		{
+			name: "non controller owner",
+			refs: []kubernetesOwnerReference{{Kind: kindStatefulSet, Name: "db"}},
+		},
+		{
+			name: "bare pod",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &kubernetesPod{}
+			pod.Metadata.Namespace = "apps"
+			pod.Metadata.Labels = tt.labels
+			pod.Metadata.OwnerReferences = tt.refs
+			require.Equal(t, tt.expected, podOwner(pod))
+		})
+	}
+}
+
+func TestKubernetesMonitorWorkloadStatus(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	volume, err := provider.NewVolumeManager(nil, "web", v1beta1.AppTypeHelm, v1beta1.CurrentProcessUsername, nil)
+	require.NoError(err)
+	app := &application{
+		id:     "web-release",
+		status: &v1beta1.DeviceApplicationStatus{Name: "web", AppType: v1beta1.AppTypeHelm},
+		volume: volume,
+	}
+	m := &KubernetesMonitor{
+		monitor:     newMonitor(log.NewPrefixLogger("test"), "kubernetes"),
+		controllers: make(map[string]map[string]*kubernetesController),
+	}
+	m.apps[app.ID()] = app
+
+	m.handleControllerEvent([]byte(`{"type":"ADDED","object":{"kind":"Deployment",
+		"metadata":{"name":"web","namespace":"apps","labels":{"agent.flightctl.io/app":"web-release"}},
+		"spec":{"replicas":2},"status":{"readyReplicas":2}}}`))
+	m.handleControllerEvent([]byte(`{"type":"ADDED","object":{"kind":"DaemonSet",
+		"metadata":{"name":"agent","namespace":"apps","labels":{"agent.flightctl.io/app":"web-release"}},
+		"status":{"desiredNumberScheduled":1,"numberReady":1}}}`))
+	// controllers of unknown applications are ignored
+	m.handleControllerEvent([]byte(`{"type":"ADDED","object":{"kind":"Deployment",
+		"metadata":{"name":"other","namespace":"apps","labels":{"agent.flightctl.io/app":"other-release"}},
+		"status":{"readyReplicas":1}}}`))
+	for _, name := range []string{"web-5d4f8c7b9-a", "web-5d4f8c7b9-b", "agent-x"} {
+		owner := `"ownerReferences":[{"kind":"ReplicaSet","name":"web-5d4f8c7b9","controller":true}]`
+		labels := `"agent.flightctl.io/app":"web-release","pod-template-hash":"5d4f8c7b9"`
+		if name == "agent-x" {
+			owner = `"ownerReferences":[{"kind":"DaemonSet","name":"agent","controller":true}]`
+			labels = `"agent.flightctl.io/app":"web-release"`
+		}
+		m.handlePodEvent(ctx, []byte(`{"type":"ADDED","object":{"kind":"Pod",
+			"metadata":{"name":"`+name+`","namespace":"apps","uid":"`+name+`","labels":{`+labels+`},`+owner+`},
+			"status":{"phase":"Running","containerStatuses":[{"name":"c","ready":true,"restartCount":1}]}}}`))
+	}
+
+	results, err := m.Status()
+	require.NoError(err)
+	require.Len(results, 1)
+	require.Equal(v1beta1.ApplicationStatusRunning, results[0].Status.Status)
+	require.Equal(v1beta1.ApplicationsSummaryStatusHealthy, results[0].Summary.Status)
+	require.Equal([]v1beta1.ApplicationWorkloadStatus{
+		{Kind: kindDaemonSet, Name: "agent", Namespace: "apps", Ready: "1/1", Restarts: 1, Status: v1beta1.ApplicationStatusRunning},
+		{Kind: kindDeployment, Name: "web", Namespace: "apps", Ready: "2/2", Restarts: 2, Status: v1beta1.ApplicationStatusRunning},
+	}, lo.FromPtr(results[0].Status.Workloads))
+
+	// one pod of the deployment crash loops
+	m.handlePodEvent(ctx, []byte(`{"type":"MODIFIED","object":{"kind":"Pod",
+		"metadata":{"name":"web-5d4f8c7b9-b","namespace":"apps","uid":"web-5d4f8c7b9-b","labels":{"agent.flightctl.io/app":"web-release","pod-template-hash":"5d4f8c7b9"},
+			"ownerReferences":[{"kind":"ReplicaSet","name":"web-5d4f8c7b9","controller":true}]},
+		"status":{"phase":"Running","containerStatuses":[{"name":"c","ready":false,"restartCount":4,"state":{"waiting":{"reason":"CrashLoopBackOff"}}}]}}}`))
+	m.handleControllerEvent([]byte(`{"type":"MODIFIED","object":{"kind":"Deployment",
+		"metadata":{"name":"web","namespace":"apps","labels":{"agent.flightctl.io/app":"web-release"}},
+		"spec":{"replicas":2},"status":{"readyReplicas":1}}}`))
+
+	results, err = m.Status()
+	require.NoError(err)
+	require.Len(results, 1)
+	require.Equal(v1beta1.ApplicationsSummaryStatusDegraded, results[0].Summary.Status)
+	require.Equal("Deployment apps/web has 1/2 ready pods: pod web-5d4f8c7b9-b is in CrashLoopBackOff", lo.FromPtr(results[0].Summary.Info))
+	web := lo.FromPtr(results[0].Status.Workloads)[1]
+	require.Equal("1/2", web.Ready)
+	require.Equal(5, web.Restarts)
+	require.Equal(v1beta1.ApplicationStatusRunning, web.Status)
+	require.Equal("pod web-5d4f8c7b9-b is in CrashLoopBackOff", lo.FromPtr(web.Message))
+
+	// no pod of the deployment is ready
+	m.handleControllerEvent([]byte(`{"type":"MODIFIED","object":{"kind":"Deployment",
+		"metadata":{"name":"web","namespace":"apps","labels":{"agent.flightctl.io/app":"web-release"}},
+		"spec":{"replicas":1},"status":{"readyReplicas":0}}}`))
+	m.handlePodEvent(ctx, []byte(`{"type":"DELETED","object":{"kind":"Pod",
+		"metadata":{"name":"web-5d4f8c7b9-a","namespace":"apps","uid":"web-5d4f8c7b9-a","labels":{"agent.flightctl.io/app":"web-release"}}}}`))
+
+	results, err = m.Status()
+	require.NoError(err)
+	require.Equal(v1beta1.ApplicationStatusError, results[0].Status.Status)
+	require.Equal(v1beta1.ApplicationsSummaryStatusError, results[0].Summary.Status)
+	require.Equal(v1beta1.ApplicationStatusError, lo.FromPtr(results[0].Status.Workloads)[1].Status)
+
+	// deleted controllers and restarted watches are no longer reported
+	m.handleControllerEvent([]byte(`{"type":"DELETED","object":{"kind":"Deployment",
+		"metadata":{"name":"web","namespace":"apps","labels":{"agent.flightctl.io/app":"web-release"}}}}`))
+	results, err = m.Status()
+	require.NoError(err)
+	require.Len(lo.FromPtr(results[0].Status.Workloads), 1)
+
+	m.clearControllers(kindDaemonSet)
+	results, err = m.Status()
+	require.NoError(err)
+	require.Nil(results[0].Status.Workloads)
+}
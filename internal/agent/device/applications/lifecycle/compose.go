@@ -1,13 +1,18 @@
 package lifecycle
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"maps"
+	"os"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/systemd"
 	"github.com/flightctl/flightctl/pkg/log"
 )
 
@@ -19,16 +24,23 @@ const (
 var _ ActionHandler = (*Compose)(nil)
 
 type Compose struct {
-	podmanFactory client.PodmanFactory
-	writerFactory fileio.ReadWriterFactory
-	log           *log.PrefixLogger
+	systemdFactory systemd.ManagerFactory
+	podmanFactory  client.PodmanFactory
+	writerFactory  fileio.ReadWriterFactory
+	log            *log.PrefixLogger
+
+	// loadedSlices are the contents of the slice unit files of the
+	// applications as of the last daemon reload, by application ID.
+	loadedSlices map[string][]byte
 }
 
-func NewCompose(log *log.PrefixLogger, rwFactory fileio.ReadWriterFactory, podmanFactory client.PodmanFactory) *Compose {
+func NewCompose(log *log.PrefixLogger, rwFactory fileio.ReadWriterFactory, systemdFactory systemd.ManagerFactory, podmanFactory client.PodmanFactory) *Compose {
 	return &Compose{
-		podmanFactory: podmanFactory,
-		writerFactory: rwFactory,
-		log:           log,
+		systemdFactory: systemdFactory,
+		podmanFactory:  podmanFactory,
+		writerFactory:  rwFactory,
+		log:            log,
+		loadedSlices:   make(map[string][]byte),
 	}
 }
 
@@ -47,7 +59,7 @@ func (c *Compose) add(ctx context.Context, action *Action) error {
 		return fmt.Errorf("creating writer: %w", err)
 	}
 
-	if err := c.ensurePodmanVolumes(ctx, action.Volumes, appName, podman, writer); err != nil {
+	if err := c.ensurePodmanVolumes(ctx, action.Volumes, appName, "", podman, writer); err != nil {
 		return fmt.Errorf("creating volumes: %w", err)
 	}
 
@@ -96,18 +108,30 @@ func (c *Compose) update(ctx context.Context, action *Action) error {
 		return fmt.Errorf("creating writer: %w", err)
 	}
 
+	// the old and new versions share the project name, container names, and
+	// published ports, so they can't run side by side. the images of the new
+	// version were prefetched before the update, so the application is only
+	// down while its containers are replaced.
+	switchStart := time.Now()
 	if err := c.stopAndRemoveContainers(ctx, action, podman); err != nil {
 		return err
 	}
 
-	if err := c.ensurePodmanVolumes(ctx, action.Volumes, projectName, podman, writer); err != nil {
-		return fmt.Errorf("creating volumes: %w", err)
+	if err := c.ensurePodmanVolumes(ctx, action.Volumes, projectName, action.PreviousPath, podman, writer); err != nil {
+		return c.rollback(ctx, action, podman, writer, switchStart, fmt.Errorf("creating volumes: %w", err))
 	}
 
 	// change to work dir and run `docker compose up -d`
 	noRecreate := true
 	if err := podman.Compose().UpFromWorkDir(ctx, action.Path, projectName, noRecreate); err != nil {
-		return err
+		return c.rollback(ctx, action, podman, writer, switchStart, err)
+	}
+	recordUpdate(ctx, action.ID, UpdateResult{Downtime: time.Since(switchStart)})
+
+	if action.PreviousPath != "" {
+		if err := writer.RemoveAll(action.PreviousPath); err != nil {
+			c.log.Warnf("Failed to remove previous version of application %s: %v", action.Name, err)
+		}
 	}
 
 	c.log.Infof("Updated application: %s", action.Name)
@@ -115,6 +139,39 @@ func (c *Compose) update(ctx context.Context, action *Action) error {
 	return nil
 }
 
+// rollback starts the previous version of an application whose new version
+// failed to start. The previous version is kept until the next update because
+// its containers may bind mount files from it. Artifact volumes get back the
+// contents they had in the previous version.
+func (c *Compose) rollback(ctx context.Context, action *Action, podman *client.Podman, writer fileio.ReadWriter, switchStart time.Time, updateErr error) error {
+	if action.PreviousPath == "" {
+		return updateErr
+	}
+	exists, err := writer.PathExists(action.PreviousPath)
+	if err != nil || !exists {
+		return updateErr
+	}
+
+	c.log.Warnf("Application %s failed to start, restarting the previous version: %v", action.Name, updateErr)
+	if err := c.stopAndRemoveContainers(ctx, action, podman); err != nil {
+		return errors.Join(updateErr, fmt.Errorf("removing failed version: %w", err))
+	}
+	if err := restorePreviousVolumes(ctx, podman, writer, action.PreviousPath, action.Volumes); err != nil {
+		return errors.Join(updateErr, fmt.Errorf("restoring previous volumes: %w", err))
+	}
+	noRecreate := true
+	if err := podman.Compose().UpFromWorkDir(ctx, action.PreviousPath, action.ID, noRecreate); err != nil {
+		return errors.Join(updateErr, fmt.Errorf("restarting previous version: %w", err))
+	}
+	recordUpdate(ctx, action.ID, UpdateResult{
+		Downtime:   time.Since(switchStart),
+		RolledBack: true,
+		Message:    updateErr.Error(),
+	})
+
+	return fmt.Errorf("%w: previous version restarted: %w", errors.ErrRolledBackApplication, updateErr)
+}
+
 // stopAndRemoveContainers stops and removes all containers, pods, and networks created by the compose application.
 func (c *Compose) stopAndRemoveContainers(ctx context.Context, action *Action, podman *client.Podman) error {
 	return cleanPodmanResources(
@@ -159,6 +216,26 @@ func cleanPodmanResources(ctx context.Context, podman *client.Podman, labels []s
 }
 
 func (c *Compose) Execute(ctx context.Context, actions Actions) error {
+	// the slices of added and updated applications must be loaded before
+	// their containers are started in them.
+	for user, byType := range actions.ByUser() {
+		changed, err := c.changedSlices(user, append(byType.Adds, byType.Updates...))
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		systemctl, err := c.systemdFactory(user)
+		if err != nil {
+			return fmt.Errorf("creating systemd client: %w", err)
+		}
+		if err := systemctl.DaemonReload(ctx); err != nil {
+			return fmt.Errorf("systemd daemon reload: %w", err)
+		}
+		maps.Copy(c.loadedSlices, changed)
+	}
+
 	for _, action := range actions {
 		switch action.Type {
 		case ActionAdd:
@@ -169,6 +246,7 @@ func (c *Compose) Execute(ctx context.Context, actions Actions) error {
 			if err := c.remove(ctx, &action); err != nil {
 				return err
 			}
+			delete(c.loadedSlices, action.ID)
 		case ActionUpdate:
 			if err := c.update(ctx, &action); err != nil {
 				return err
@@ -180,13 +258,47 @@ func (c *Compose) Execute(ctx context.Context, actions Actions) error {
 	return nil
 }
 
+// changedSlices returns the contents of the slice unit files of the given
+// applications that changed since they were last loaded, by application ID.
+func (c *Compose) changedSlices(user v1beta1.Username, actions []Action) (map[string][]byte, error) {
+	changed := make(map[string][]byte)
+	if len(actions) == 0 {
+		return changed, nil
+	}
+	reader, err := c.writerFactory(user)
+	if err != nil {
+		return nil, fmt.Errorf("creating reader: %w", err)
+	}
+	for _, action := range actions {
+		spec, ok := action.Spec.(ComposeSpec)
+		if !ok || spec.SlicePath == "" {
+			continue
+		}
+		contents, err := reader.ReadFile(spec.SlicePath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("reading slice of application %s: %w", action.Name, err)
+		}
+		if loaded, ok := c.loadedSlices[action.ID]; ok && bytes.Equal(loaded, contents) {
+			continue
+		}
+		changed[action.ID] = contents
+	}
+	return changed, nil
+}
+
 // ensurePodmanVolumes creates and populates each image-backed volume in Podman.
+// The contents of existing volumes are kept in the copy of the previous
+// version of the application at previousPath, if any.
 func (c *Compose) ensurePodmanVolumes(
 	ctx context.Context,
 	volumes []Volume,
 	appID string,
+	previousPath string,
 	podman *client.Podman,
-	writer fileio.Writer,
+	writer fileio.ReadWriter,
 ) error {
 	if len(volumes) == 0 {
 		return nil
@@ -195,7 +307,7 @@ func (c *Compose) ensurePodmanVolumes(
 	labels := []string{fmt.Sprintf("%s=%s", client.ComposeDockerProjectLabelKey, appID)}
 	// ensure the volume content is pulled and available
 	for _, volume := range volumes {
-		if err := c.ensurePodmanVolume(ctx, volume, labels, podman, writer); err != nil {
+		if err := c.ensurePodmanVolume(ctx, volume, labels, previousPath, podman, writer); err != nil {
 			return fmt.Errorf("pulling image volume: %w", err)
 		}
 	}
@@ -207,8 +319,9 @@ func (c *Compose) ensurePodmanVolume(
 	ctx context.Context,
 	volume Volume,
 	labels []string,
+	previousPath string,
 	podman *client.Podman,
-	writer fileio.Writer,
+	writer fileio.ReadWriter,
 ) error {
 	name := volume.ID
 	imageRef := volume.Reference
@@ -218,6 +331,9 @@ func (c *Compose) ensurePodmanVolume(
 		if err != nil {
 			return fmt.Errorf("inspect volume %w: %w", errors.WithElement(name), err)
 		}
+		if err := keepPreviousVolume(writer, previousPath, name, volumePath); err != nil {
+			return fmt.Errorf("keeping previous content of volume %w: %w", errors.WithElement(name), err)
+		}
 		if err := writer.RemoveContents(volumePath); err != nil {
 			return fmt.Errorf("removing volume content %w: %w", errors.WithElement(volumePath), err)
 		}
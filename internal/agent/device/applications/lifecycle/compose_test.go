@@ -2,12 +2,15 @@ package lifecycle
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/systemd"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 	testutil "github.com/flightctl/flightctl/test/util"
@@ -72,7 +75,7 @@ func TestComposeEnsurePodmanVolumeRetainReseeds(t *testing.T) {
 	var rwFactory fileio.ReadWriterFactory = func(username api.Username) (fileio.ReadWriter, error) {
 		return mockWriter, nil
 	}
-	compose := NewCompose(logger, rwFactory, podmanFactory)
+	compose := NewCompose(logger, rwFactory, nil, podmanFactory)
 
 	mountPath := "/var/lib/containers/storage/volumes/app-123-vol1/_data"
 
@@ -92,8 +95,234 @@ func TestComposeEnsurePodmanVolumeRetainReseeds(t *testing.T) {
 			ReclaimPolicy: api.Retain,
 		},
 		nil,
+		"",
 		podman,
 		mockWriter,
 	)
 	require.NoError(t, err)
 }
+
+func TestComposeUpdate(t *testing.T) {
+	const appPath = "/etc/compose/manifests/app1"
+	const slicePath = "/etc/containers/systemd/flightctl-app1_229522.slice"
+	const volumePath = "/var/lib/containers/storage/volumes/app1-229522-data/_data"
+	previousPath := PreviousAppPath(api.AppTypeCompose, appPath)
+
+	tests := []struct {
+		name              string
+		upFails           bool
+		extractFails      bool
+		withPrevious      bool
+		wantErr           error
+		wantRecorded      bool
+		wantRolledBack    bool
+		wantPreviousExist bool
+		wantVolumeData    string
+	}{
+		{
+			name:           "new version starts",
+			withPrevious:   true,
+			wantRecorded:   true,
+			wantVolumeData: "new",
+		},
+		{
+			name:              "new version fails and previous version is restarted",
+			upFails:           true,
+			withPrevious:      true,
+			wantErr:           errors.ErrRolledBackApplication,
+			wantRecorded:      true,
+			wantRolledBack:    true,
+			wantPreviousExist: true,
+			wantVolumeData:    "previous",
+		},
+		{
+			name:              "volume of new version fails and previous version is restarted",
+			extractFails:      true,
+			withPrevious:      true,
+			wantErr:           errors.ErrRolledBackApplication,
+			wantRecorded:      true,
+			wantRolledBack:    true,
+			wantPreviousExist: true,
+			wantVolumeData:    "previous",
+		},
+		{
+			name:           "new version fails without previous version",
+			upFails:        true,
+			wantVolumeData: "new",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tmpDir := t.TempDir()
+			readWriter := fileio.NewReadWriter(
+				fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+				fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+			)
+			require.NoError(readWriter.WriteFile(filepath.Join(appPath, "docker-compose.yaml"), []byte("services: {}"), fileio.DefaultFilePermissions))
+			require.NoError(readWriter.WriteFile(slicePath, []byte("[Unit]\n"), fileio.DefaultFilePermissions))
+			require.NoError(readWriter.WriteFile(filepath.Join(volumePath, "data"), []byte("previous"), fileio.DefaultFilePermissions))
+			if tt.withPrevious {
+				require.NoError(readWriter.WriteFile(filepath.Join(previousPath, "docker-compose.yaml"), []byte("services: {}"), fileio.DefaultFilePermissions))
+			}
+
+			mockExec := executer.NewMockExecuter(ctrl)
+			logger := log.NewPrefixLogger("test")
+			podman := client.NewPodman(logger, mockExec, readWriter, testutil.NewPollConfig())
+			podmanFactory := func(user api.Username) (*client.Podman, error) {
+				return podman, nil
+			}
+			rwFactory := func(user api.Username) (fileio.ReadWriter, error) {
+				return readWriter, nil
+			}
+			mockSystemd := systemd.NewMockManager(ctrl)
+			systemdFactory := func(user api.Username) (systemd.Manager, error) {
+				return mockSystemd, nil
+			}
+			compose := NewCompose(logger, rwFactory, systemdFactory, podmanFactory)
+
+			mockSystemd.EXPECT().DaemonReload(gomock.Any()).Return(nil)
+
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("volume", "inspect", "app1-229522-data")).Return(volumePath, "", 0).AnyTimes()
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("--version")).Return("podman version 5.5", "", 0).AnyTimes()
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("artifact", "extract", "artifact:data")).
+				DoAndReturn(func(_ context.Context, _ string, args ...string) (string, string, int) {
+					if tt.extractFails {
+						return "", "artifact not found", 1
+					}
+					require.NoError(readWriter.WriteFile(filepath.Join(args[len(args)-1], "data"), []byte("new"), fileio.DefaultFilePermissions))
+					return "", "", 0
+				})
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", gomock.Any()).Return("", "", 0).AnyTimes()
+			upFailure := 0
+			if tt.upFails {
+				upFailure = 1
+			}
+			if !tt.extractFails {
+				mockExec.EXPECT().ExecuteWithContextFromDir(gomock.Any(), appPath, "podman", gomock.Any()).Return("", "container exited", upFailure)
+			}
+			if (tt.upFails || tt.extractFails) && tt.withPrevious {
+				mockExec.EXPECT().ExecuteWithContextFromDir(gomock.Any(), previousPath, "podman", gomock.Any()).Return("", "", 0)
+			}
+
+			var recorded []UpdateResult
+			ctx := ContextWithUpdateRecorder(context.Background(), func(id string, result UpdateResult) {
+				require.Equal("app1-229522", id)
+				recorded = append(recorded, result)
+			})
+
+			action := Action{
+				ID:      "app1-229522",
+				Name:    "app1",
+				Type:    ActionUpdate,
+				AppType: api.AppTypeCompose,
+				Path:    appPath,
+				Volumes: []Volume{{ID: "app1-229522-data", Reference: "artifact:data"}},
+				Spec:    ComposeSpec{SlicePath: slicePath},
+			}
+			if tt.withPrevious {
+				action.PreviousPath = previousPath
+			}
+			err := compose.Execute(ctx, Actions{action})
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(err, tt.wantErr)
+			case tt.upFails || tt.extractFails:
+				require.Error(err)
+			default:
+				require.NoError(err)
+			}
+
+			if !tt.wantRecorded {
+				require.Empty(recorded)
+			} else {
+				require.Len(recorded, 1)
+				require.Equal(tt.wantRolledBack, recorded[0].RolledBack)
+				require.Positive(recorded[0].Downtime)
+			}
+
+			exists, err := readWriter.PathExists(previousPath)
+			require.NoError(err)
+			require.Equal(tt.wantPreviousExist, exists)
+
+			// artifact volumes hold the contents of the version that runs
+			data, err := readWriter.ReadFile(filepath.Join(volumePath, "data"))
+			require.NoError(err)
+			require.Equal(tt.wantVolumeData, string(data))
+		})
+	}
+}
+
+func TestComposeExecuteReloadsChangedSlices(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const appPath = "/etc/compose/manifests/app1"
+	const slicePath = "/etc/containers/systemd/flightctl-app1_229522.slice"
+
+	tmpDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	require.NoError(readWriter.WriteFile(filepath.Join(appPath, "docker-compose.yaml"), []byte("services: {}"), fileio.DefaultFilePermissions))
+
+	mockExec := executer.NewMockExecuter(ctrl)
+	logger := log.NewPrefixLogger("test")
+	podman := client.NewPodman(logger, mockExec, readWriter, testutil.NewPollConfig())
+	podmanFactory := func(user api.Username) (*client.Podman, error) {
+		return podman, nil
+	}
+	rwFactory := func(user api.Username) (fileio.ReadWriter, error) {
+		return readWriter, nil
+	}
+	mockSystemd := systemd.NewMockManager(ctrl)
+	systemdFactory := func(user api.Username) (systemd.Manager, error) {
+		return mockSystemd, nil
+	}
+	compose := NewCompose(logger, rwFactory, systemdFactory, podmanFactory)
+
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", gomock.Any()).Return("", "", 0).AnyTimes()
+	mockExec.EXPECT().ExecuteWithContextFromDir(gomock.Any(), appPath, "podman", gomock.Any()).Return("", "", 0).AnyTimes()
+
+	action := Action{
+		ID:      "app1-229522",
+		Name:    "app1",
+		AppType: api.AppTypeCompose,
+		Path:    appPath,
+		Spec:    ComposeSpec{SlicePath: slicePath},
+	}
+	add := action
+	add.Type = ActionAdd
+	update := action
+	update.Type = ActionUpdate
+	remove := action
+	remove.Type = ActionRemove
+
+	writeSlice := func(contents string) {
+		require.NoError(readWriter.WriteFile(slicePath, []byte(contents), fileio.DefaultFilePermissions))
+	}
+
+	// the new slice is loaded before the application starts
+	writeSlice("[Slice]\nMemoryMax=100M\n")
+	mockSystemd.EXPECT().DaemonReload(gomock.Any()).Return(nil).Times(1)
+	require.NoError(compose.Execute(context.Background(), Actions{add}))
+
+	// an update that leaves the slice unchanged does not reload
+	require.NoError(compose.Execute(context.Background(), Actions{update}))
+
+	// an update that changes the slice reloads
+	writeSlice("[Slice]\nMemoryMax=200M\n")
+	mockSystemd.EXPECT().DaemonReload(gomock.Any()).Return(nil).Times(1)
+	require.NoError(compose.Execute(context.Background(), Actions{update}))
+
+	// the slice of an application that is added again after its removal is reloaded
+	require.NoError(compose.Execute(context.Background(), Actions{remove}))
+	mockSystemd.EXPECT().DaemonReload(gomock.Any()).Return(nil).Times(1)
+	require.NoError(compose.Execute(context.Background(), Actions{add}))
+}
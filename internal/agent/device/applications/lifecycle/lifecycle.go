@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"hash/crc32"
 	"iter"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/util/validation"
 )
 
@@ -50,6 +53,10 @@ type Action struct {
 	AppType v1beta1.AppType
 	// Path to the application
 	Path string
+	// PreviousPath is the path to a copy of the previous version of the
+	// application which is started again if the updated application fails to
+	// start. Blank if there is no previous version to roll back to.
+	PreviousPath string
 	// User that owns the app. Blank means the same user as the current process.
 	User v1beta1.Username
 	// Embedded is true if the application is embedded in the device
@@ -73,6 +80,25 @@ type HelmSpec struct {
 
 func (HelmSpec) actionSpec() {}
 
+// QuadletSpec contains Quadlet-specific action configuration.
+type QuadletSpec struct {
+	// UnitPaths are the paths of the systemd units installed for the
+	// application outside of its quadlet directory, such as its target and
+	// slice. They are kept with the previous version of the application.
+	UnitPaths []string
+}
+
+func (QuadletSpec) actionSpec() {}
+
+// ComposeSpec contains Compose-specific action configuration.
+type ComposeSpec struct {
+	// SlicePath is the path of the unit file of the slice that the containers
+	// of the application run in.
+	SlicePath string
+}
+
+func (ComposeSpec) actionSpec() {}
+
 type Actions []Action
 
 type ActionsByType struct {
@@ -126,6 +152,107 @@ func BatchStartTimeFromContext(ctx context.Context) (time.Time, bool) {
 	return t, ok
 }
 
+const updateRecorderKey contextKey = "updateRecorder"
+
+// UpdateResult is the result of switching an application to a new version.
+type UpdateResult struct {
+	// Downtime is how long the application was not running during the switch.
+	Downtime time.Duration
+	// RolledBack is true if the new version failed to start and the previous
+	// version was started again.
+	RolledBack bool
+	// Message is the reason the update was rolled back.
+	Message string
+}
+
+// UpdateRecorder is called with the result of each application update.
+type UpdateRecorder func(id string, result UpdateResult)
+
+func ContextWithUpdateRecorder(ctx context.Context, recorder UpdateRecorder) context.Context {
+	return context.WithValue(ctx, updateRecorderKey, recorder)
+}
+
+// recordUpdate reports the result of an application update to the recorder
+// of the context, if any.
+func recordUpdate(ctx context.Context, id string, result UpdateResult) {
+	if recorder, ok := ctx.Value(updateRecorderKey).(UpdateRecorder); ok {
+		recorder(id, result)
+	}
+}
+
+// PreviousAppPath returns the path that the previous version of an
+// application installed at path is kept at during an update. Quadlet
+// applications are kept outside of the directory the quadlet generator reads,
+// so that the units of the previous version are not generated as well.
+func PreviousAppPath(appType v1beta1.AppType, path string) string {
+	switch appType {
+	case v1beta1.AppTypeQuadlet, v1beta1.AppTypeContainer:
+		return filepath.Join(filepath.Dir(filepath.Dir(path)), ".flightctl-previous", filepath.Base(path))
+	default:
+		return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".previous")
+	}
+}
+
+// PreviousQuadletPaths returns the directories that the quadlet files and the
+// units of the previous version of a quadlet application kept at
+// previousPath are copied to.
+func PreviousQuadletPaths(previousPath string) (appPath string, unitPath string) {
+	return filepath.Join(previousPath, "app"), filepath.Join(previousPath, "units")
+}
+
+// previousVolumePath returns the path that the contents an artifact volume had
+// in the previous version of an application kept at previousPath are copied to.
+func previousVolumePath(previousPath string, volumeID string) string {
+	return filepath.Join(previousPath, ".flightctl-volumes", volumeID)
+}
+
+// keepPreviousVolume copies the contents of an artifact volume into the copy
+// kept of the previous version of its application before the volume is
+// populated with the artifact of the new version. Contents already kept by an
+// earlier attempt of the same update are not overwritten, as the volume may
+// hold the new version by now.
+func keepPreviousVolume(rw fileio.ReadWriter, previousPath string, volumeID string, volumePath string) error {
+	if previousPath == "" {
+		return nil
+	}
+	exists, err := rw.PathExists(previousPath)
+	if err != nil || !exists {
+		return err
+	}
+	keptPath := previousVolumePath(previousPath, volumeID)
+	if exists, err := rw.PathExists(keptPath); err != nil || exists {
+		return err
+	}
+	return rw.CopyDir(volumePath, keptPath, fileio.WithPreserveSymlink())
+}
+
+// restorePreviousVolumes replaces the contents of the artifact volumes of an
+// application with the contents kept of its previous version. Volumes without
+// kept contents were created by the new version and are left as they are.
+func restorePreviousVolumes(ctx context.Context, podman *client.Podman, rw fileio.ReadWriter, previousPath string, volumes []Volume) error {
+	for _, volume := range volumes {
+		keptPath := previousVolumePath(previousPath, volume.ID)
+		exists, err := rw.PathExists(keptPath)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		volumePath, err := podman.InspectVolumeMount(ctx, volume.ID)
+		if err != nil {
+			return fmt.Errorf("inspect volume %w: %w", errors.WithElement(volume.ID), err)
+		}
+		if err := rw.RemoveContents(volumePath); err != nil {
+			return fmt.Errorf("removing volume content %w: %w", errors.WithElement(volumePath), err)
+		}
+		if err := rw.CopyDir(keptPath, volumePath, fileio.WithPreserveSymlink()); err != nil {
+			return fmt.Errorf("restoring volume content %w: %w", errors.WithElement(volume.ID), err)
+		}
+	}
+	return nil
+}
+
 // GenerateAppID generates a deterministic, lowercase, DNS-compatible ID with a fixed-length hash suffix.
 func GenerateAppID(name string, user v1beta1.Username) string {
 	const suffixLen = 6
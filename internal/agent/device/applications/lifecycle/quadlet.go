@@ -234,6 +234,13 @@ func (q *Quadlet) Execute(ctx context.Context, actions Actions) error {
 			return fmt.Errorf("unknown action type %s", byType.Unknown[0].Type)
 		}
 
+		// updated applications are down from when they are stopped until
+		// their new version is started.
+		switchStarts := make(map[string]time.Time, len(byType.Updates))
+		for _, a := range byType.Updates {
+			switchStarts[a.ID] = time.Now()
+		}
+
 		for _, a := range slices.Concat(byType.Removes, byType.Updates) {
 			if err := q.remove(ctx, a, systemctl); err != nil {
 				return fmt.Errorf("removing: %w", err)
@@ -246,15 +253,118 @@ func (q *Quadlet) Execute(ctx context.Context, actions Actions) error {
 
 		// Add requires daemon reload to be called prior to performing any service starting
 		for _, a := range slices.Concat(byType.Adds, byType.Updates) {
+			switchStart, isUpdate := switchStarts[a.ID]
 			if err := q.add(ctx, a, systemctl); err != nil {
+				if isUpdate {
+					err = q.rollback(ctx, a, systemctl, switchStart, err)
+				}
 				return fmt.Errorf("adding: %w", err)
 			}
+			if isUpdate {
+				recordUpdate(ctx, a.ID, UpdateResult{Downtime: time.Since(switchStart)})
+				q.removePreviousVersion(a)
+			}
 		}
 	}
 
 	return nil
 }
 
+// rollback starts the previous version of an application whose new version
+// failed to start. The quadlet files and units of the previous version are
+// restored in place of the new ones, so the previous version also remains
+// installed until the next update. Artifact volumes get back the contents
+// they had in the previous version.
+func (q *Quadlet) rollback(ctx context.Context, action Action, systemctl systemd.Manager, switchStart time.Time, updateErr error) error {
+	spec, ok := action.Spec.(QuadletSpec)
+	if action.PreviousPath == "" || !ok {
+		return updateErr
+	}
+	rw, err := q.rwFactory(action.User)
+	if err != nil {
+		return errors.Join(updateErr, fmt.Errorf("creating read/writer: %w", err))
+	}
+	appPath, unitPath := PreviousQuadletPaths(action.PreviousPath)
+	exists, err := rw.PathExists(appPath)
+	if err != nil || !exists {
+		return updateErr
+	}
+
+	q.log.Warnf("Application %s failed to start, restarting the previous version: %v", action.Name, updateErr)
+	// the services of the failed version were already stopped when it failed to start
+	if err := q.restorePreviousVersion(rw, action, spec, appPath, unitPath); err != nil {
+		return errors.Join(updateErr, fmt.Errorf("restoring previous version: %w", err))
+	}
+	if len(action.Volumes) > 0 {
+		podman, err := q.podmanFactory(action.User)
+		if err != nil {
+			return errors.Join(updateErr, fmt.Errorf("creating podman client: %w", err))
+		}
+		if err := restorePreviousVolumes(ctx, podman, rw, action.PreviousPath, action.Volumes); err != nil {
+			return errors.Join(updateErr, fmt.Errorf("restoring previous volumes: %w", err))
+		}
+	}
+	if err := systemctl.DaemonReload(ctx); err != nil {
+		return errors.Join(updateErr, fmt.Errorf("systemd daemon reload: %w", err))
+	}
+	previous := action
+	previous.Volumes = nil
+	if err := q.add(ctx, previous, systemctl); err != nil {
+		return errors.Join(updateErr, fmt.Errorf("restarting previous version: %w", err))
+	}
+	recordUpdate(ctx, action.ID, UpdateResult{
+		Downtime:   time.Since(switchStart),
+		RolledBack: true,
+		Message:    updateErr.Error(),
+	})
+
+	return fmt.Errorf("%w: previous version restarted: %w", errors.ErrRolledBackApplication, updateErr)
+}
+
+// restorePreviousVersion replaces the quadlet files and units of the new
+// version of an application with the copies kept of its previous version.
+func (q *Quadlet) restorePreviousVersion(rw fileio.ReadWriter, action Action, spec QuadletSpec, appPath string, unitPath string) error {
+	if err := rw.RemoveAll(action.Path); err != nil {
+		return err
+	}
+	if err := rw.CopyDir(appPath, action.Path); err != nil {
+		return err
+	}
+	for _, unit := range spec.UnitPaths {
+		previousUnit := filepath.Join(unitPath, filepath.Base(unit))
+		exists, err := rw.PathExists(previousUnit)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			// the previous version did not install the unit
+			if err := rw.RemoveFile(unit); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := rw.CopyFile(previousUnit, unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removePreviousVersion removes the copy of the previous version of an
+// application once its new version started.
+func (q *Quadlet) removePreviousVersion(action Action) {
+	if action.PreviousPath == "" {
+		return
+	}
+	rw, err := q.rwFactory(action.User)
+	if err == nil {
+		err = rw.RemoveAll(action.PreviousPath)
+	}
+	if err != nil {
+		q.log.Warnf("Failed to remove previous version of application %s: %v", action.Name, err)
+	}
+}
+
 func (q *Quadlet) ensureArtifactVolumes(ctx context.Context, action Action) error {
 	if len(action.Volumes) == 0 {
 		return nil
@@ -294,6 +404,9 @@ func (q *Quadlet) ensureArtifactVolumes(ctx context.Context, action Action) erro
 			if err != nil {
 				return fmt.Errorf("inspect volume %w: %w", errors.WithElement(volumeName), err)
 			}
+			if err := keepPreviousVolume(rw, action.PreviousPath, volumeName, volumePath); err != nil {
+				return fmt.Errorf("keeping previous content of volume %w: %w", errors.WithElement(volumeName), err)
+			}
 			if err := rw.RemoveContents(volumePath); err != nil {
 				return fmt.Errorf("removing volume content %w: %w", errors.WithElement(volumePath), err)
 			}
@@ -3,10 +3,12 @@ package lifecycle
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/systemd"
 	"github.com/flightctl/flightctl/pkg/executer"
@@ -1121,3 +1123,172 @@ func TestQuadlet_ensureArtifactVolumes(t *testing.T) {
 		})
 	}
 }
+
+func TestQuadletUpdate(t *testing.T) {
+	const (
+		appID       = "app1-229522"
+		appPath     = "/etc/containers/systemd/app1"
+		targetPath  = "/etc/systemd/system/app1-229522-flightctl-quadlet-app.target"
+		slicePath   = "/etc/systemd/system/app1-229522.slice"
+		target      = "app1-229522-flightctl-quadlet-app.target"
+		serviceUnit = "app1-229522-app.service"
+		volumePath  = "/var/lib/containers/storage/volumes/app1-229522-data/_data"
+	)
+	previousPath := PreviousAppPath(api.AppTypeQuadlet, appPath)
+	previousAppPath, previousUnitPath := PreviousQuadletPaths(previousPath)
+
+	tests := []struct {
+		name              string
+		startFails        bool
+		withPrevious      bool
+		wantErr           error
+		wantRecorded      bool
+		wantRolledBack    bool
+		wantPreviousExist bool
+		wantVolumeData    string
+	}{
+		{
+			name:           "new version starts",
+			withPrevious:   true,
+			wantRecorded:   true,
+			wantVolumeData: "new",
+		},
+		{
+			name:              "new version fails and previous version is restarted",
+			startFails:        true,
+			withPrevious:      true,
+			wantErr:           errors.ErrRolledBackApplication,
+			wantRecorded:      true,
+			wantRolledBack:    true,
+			wantPreviousExist: true,
+			wantVolumeData:    "previous",
+		},
+		{
+			name:           "new version fails without previous version",
+			startFails:     true,
+			wantVolumeData: "new",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tmpDir := t.TempDir()
+			readWriter := fileio.NewReadWriter(
+				fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+				fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+			)
+			require.NoError(readWriter.WriteFile(filepath.Join(appPath, "app1-229522-app.container"), []byte("new"), fileio.DefaultFilePermissions))
+			require.NoError(readWriter.WriteFile(targetPath, []byte("new target"), fileio.DefaultFilePermissions))
+			require.NoError(readWriter.WriteFile(slicePath, []byte("new slice"), fileio.DefaultFilePermissions))
+			require.NoError(readWriter.WriteFile(filepath.Join(volumePath, "data"), []byte("previous"), fileio.DefaultFilePermissions))
+			if tt.withPrevious {
+				require.NoError(readWriter.WriteFile(filepath.Join(previousAppPath, "app1-229522-app.container"), []byte("previous"), fileio.DefaultFilePermissions))
+				require.NoError(readWriter.WriteFile(filepath.Join(previousUnitPath, filepath.Base(targetPath)), []byte("previous target"), fileio.DefaultFilePermissions))
+			}
+
+			mockExec := executer.NewMockExecuter(ctrl)
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("image", "exists", "artifact:data")).Return("", "", 1).AnyTimes()
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("volume", "inspect", "app1-229522-data")).Return(volumePath, "", 0).AnyTimes()
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("--version")).Return("podman version 5.5", "", 0).AnyTimes()
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", newMatcher("artifact", "extract", "artifact:data")).
+				DoAndReturn(func(_ context.Context, _ string, args ...string) (string, string, int) {
+					require.NoError(readWriter.WriteFile(filepath.Join(args[len(args)-1], "data"), []byte("new"), fileio.DefaultFilePermissions))
+					return "", "", 0
+				})
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", gomock.Any()).Return("", "", 0).AnyTimes()
+			logger := log.NewPrefixLogger("test")
+			podman := client.NewPodman(logger, mockExec, readWriter, testutil.NewPollConfig())
+			podmanFactory := func(user api.Username) (*client.Podman, error) {
+				return podman, nil
+			}
+			rwFactory := func(user api.Username) (fileio.ReadWriter, error) {
+				return readWriter, nil
+			}
+			mockSystemd := systemd.NewMockManager(ctrl)
+			systemdFactory := func(user api.Username) (systemd.Manager, error) {
+				return mockSystemd, nil
+			}
+			q := NewQuadlet(logger, rwFactory, systemdFactory, podmanFactory)
+
+			mockSystemd.EXPECT().ListDependencies(gomock.Any(), target).Return([]string{serviceUnit}, nil).AnyTimes()
+			mockSystemd.EXPECT().ListUnitsByMatchPattern(gomock.Any(), []string{serviceUnit}).
+				Return([]client.SystemDUnitListEntry{{Unit: serviceUnit, LoadState: string(api.SystemdLoadStateLoaded)}}, nil).AnyTimes()
+			mockSystemd.EXPECT().Stop(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockSystemd.EXPECT().ResetFailed(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockSystemd.EXPECT().AddExclusions(gomock.Any()).AnyTimes()
+			mockSystemd.EXPECT().RemoveExclusions(gomock.Any()).AnyTimes()
+			mockSystemd.EXPECT().Logs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			if tt.startFails {
+				mockSystemd.EXPECT().Start(gomock.Any(), target).Return(fmt.Errorf("service exited"))
+			} else {
+				mockSystemd.EXPECT().Start(gomock.Any(), target).Return(nil)
+			}
+			if tt.startFails && tt.withPrevious {
+				mockSystemd.EXPECT().DaemonReload(gomock.Any()).Return(nil).Times(2)
+				mockSystemd.EXPECT().Start(gomock.Any(), target).Return(nil)
+			} else {
+				mockSystemd.EXPECT().DaemonReload(gomock.Any()).Return(nil)
+			}
+
+			var recorded []UpdateResult
+			ctx := ContextWithUpdateRecorder(context.Background(), func(id string, result UpdateResult) {
+				require.Equal(appID, id)
+				recorded = append(recorded, result)
+			})
+
+			action := Action{
+				ID:           appID,
+				Name:         "app1",
+				Type:         ActionUpdate,
+				AppType:      api.AppTypeQuadlet,
+				Path:         appPath,
+				PreviousPath: previousPath,
+				Volumes:      []Volume{{ID: "app1-229522-data", Reference: "artifact:data"}},
+				Spec:         QuadletSpec{UnitPaths: []string{targetPath, slicePath}},
+			}
+			err := q.Execute(ctx, Actions{action})
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(err, tt.wantErr)
+			case tt.startFails:
+				require.Error(err)
+			default:
+				require.NoError(err)
+			}
+
+			if !tt.wantRecorded {
+				require.Empty(recorded)
+			} else {
+				require.Len(recorded, 1)
+				require.Equal(tt.wantRolledBack, recorded[0].RolledBack)
+				require.Positive(recorded[0].Downtime)
+			}
+
+			exists, err := readWriter.PathExists(previousPath)
+			require.NoError(err)
+			require.Equal(tt.wantPreviousExist, exists)
+
+			if tt.wantRolledBack {
+				contents, err := readWriter.ReadFile(filepath.Join(appPath, "app1-229522-app.container"))
+				require.NoError(err)
+				require.Equal("previous", string(contents))
+				contents, err = readWriter.ReadFile(targetPath)
+				require.NoError(err)
+				require.Equal("previous target", string(contents))
+				// the previous version had no slice
+				exists, err := readWriter.PathExists(slicePath)
+				require.NoError(err)
+				require.False(exists)
+			}
+
+			// artifact volumes hold the contents of the version that runs
+			data, err := readWriter.ReadFile(filepath.Join(volumePath, "data"))
+			require.NoError(err)
+			require.Equal(tt.wantVolumeData, string(data))
+		})
+	}
+}
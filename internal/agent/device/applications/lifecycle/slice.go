@@ -0,0 +1,96 @@
+package lifecycle
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/quadlet"
+)
+
+const (
+	// AppSliceParent is the systemd slice that contains the slices of all
+	// applications.
+	AppSliceParent = "flightctl.slice"
+)
+
+// AppSliceName returns the name of the systemd slice that the workloads of an
+// application run in. Dashes separate the levels of the slice hierarchy, so
+// they are replaced in the application ID to nest the slice directly below
+// AppSliceParent.
+func AppSliceName(id string) string {
+	return "flightctl-" + strings.ReplaceAll(id, "-", "_") + ".slice"
+}
+
+// AppSliceUnit returns the unit file of the slice of an application, which
+// enforces the resource limits of the application on all of its workloads.
+func AppSliceUnit(name string, limits *v1beta1.ApplicationResourceLimits) ([]byte, error) {
+	unit := quadlet.NewEmptyUnit()
+	unit.Add("Unit", "Description", fmt.Sprintf("Flight Control application %s", name))
+	if limits != nil {
+		if limits.Cpu != nil {
+			cores, err := ParseCPULimit(*limits.Cpu)
+			if err != nil {
+				return nil, err
+			}
+			if cores > 0 {
+				unit.Add("Slice", "CPUQuota", fmt.Sprintf("%d%%", int64(math.Round(cores*100))))
+			}
+		}
+		if limits.Memory != nil {
+			bytes, err := ParseMemoryLimit(*limits.Memory)
+			if err != nil {
+				return nil, err
+			}
+			unit.Add("Slice", "MemoryMax", strconv.FormatInt(bytes, 10))
+		}
+		if limits.IoWeight != nil {
+			unit.Add("Slice", "IOWeight", strconv.Itoa(*limits.IoWeight))
+		}
+	}
+	return unit.Write()
+}
+
+// ParseCPULimit returns the number of cores of a CPU limit.
+func ParseCPULimit(cpu string) (float64, error) {
+	cores, err := strconv.ParseFloat(cpu, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cpu limit %q: %w", cpu, err)
+	}
+	if cores < 0 {
+		return 0, fmt.Errorf("invalid cpu limit %q: must be positive", cpu)
+	}
+	return cores, nil
+}
+
+// ParseMemoryLimit returns the bytes of a memory limit in the podman format of
+// a number with an optional unit of b, k, m or g.
+func ParseMemoryLimit(memory string) (int64, error) {
+	multiplier := int64(1)
+	value := memory
+	if n := len(memory); n > 0 {
+		switch memory[n-1] {
+		case 'b':
+			value = memory[:n-1]
+		case 'k':
+			multiplier = 1 << 10
+			value = memory[:n-1]
+		case 'm':
+			multiplier = 1 << 20
+			value = memory[:n-1]
+		case 'g':
+			multiplier = 1 << 30
+			value = memory[:n-1]
+		}
+	}
+	bytes, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || bytes < 0 {
+		return 0, fmt.Errorf("invalid memory limit %q", memory)
+	}
+	if bytes > math.MaxInt64/multiplier {
+		return 0, fmt.Errorf("invalid memory limit %q: too large", memory)
+	}
+	return bytes * multiplier, nil
+}
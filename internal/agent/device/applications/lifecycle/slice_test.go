@@ -0,0 +1,82 @@
+package lifecycle
+
+import (
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppSliceName(t *testing.T) {
+	require.Equal(t, "flightctl-app1_229522.slice", AppSliceName("app1-229522"))
+}
+
+func TestAppSliceUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		limits   *v1beta1.ApplicationResourceLimits
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "no limits",
+			expected: "[Unit]\nDescription=Flight Control application app1\n",
+		},
+		{
+			name: "all limits",
+			limits: &v1beta1.ApplicationResourceLimits{
+				Cpu:      lo.ToPtr("0.75"),
+				Memory:   lo.ToPtr("256m"),
+				IoWeight: lo.ToPtr(200),
+			},
+			expected: "[Unit]\nDescription=Flight Control application app1\n\n[Slice]\nCPUQuota=75%\nMemoryMax=268435456\nIOWeight=200\n",
+		},
+		{
+			name:    "invalid memory",
+			limits:  &v1beta1.ApplicationResourceLimits{Memory: lo.ToPtr("256M")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contents, err := AppSliceUnit("app1", tt.limits)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, string(contents))
+		})
+	}
+}
+
+func TestParseMemoryLimit(t *testing.T) {
+	tests := []struct {
+		memory   string
+		expected int64
+		wantErr  bool
+	}{
+		{memory: "1024", expected: 1024},
+		{memory: "512b", expected: 512},
+		{memory: "2k", expected: 2048},
+		{memory: "256m", expected: 256 << 20},
+		{memory: "1g", expected: 1 << 30},
+		{memory: "", wantErr: true},
+		{memory: "1.5g", wantErr: true},
+		{memory: "99999999999g", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.memory, func(t *testing.T) {
+			bytes, err := ParseMemoryLimit(tt.memory)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, bytes)
+		})
+	}
+}
@@ -3,9 +3,11 @@ package applications
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
@@ -108,6 +110,11 @@ func (m *manager) Remove(ctx context.Context, provider provider.Provider) error
 	if err := provider.Remove(ctx); err != nil {
 		return fmt.Errorf("%w: %w", errors.ErrRemovingApplication, err)
 	}
+	if isPodmanAppType(provider.Spec().AppType) {
+		if err := m.removePreviousVersion(provider); err != nil {
+			return fmt.Errorf("%w: removing previous version: %w", errors.ErrRemovingApplication, err)
+		}
+	}
 
 	// If dependencies are missing (e.g., kubernetes unavailable for helm apps),
 	// we can't queue the monitor action. The important cleanup already happened
@@ -135,6 +142,9 @@ func (m *manager) Update(ctx context.Context, provider provider.Provider) error
 	appType := provider.Spec().AppType
 	switch appType {
 	case v1beta1.AppTypeCompose, v1beta1.AppTypeQuadlet, v1beta1.AppTypeContainer:
+		if err := m.keepPreviousVersion(provider.Spec()); err != nil {
+			return fmt.Errorf("%w: keeping previous version: %w", errors.ErrInstallingApplication, err)
+		}
 		if err := provider.Remove(ctx); err != nil {
 			return fmt.Errorf("%w: %w", errors.ErrRemovingApplication, err)
 		}
@@ -155,6 +165,70 @@ func (m *manager) Update(ctx context.Context, provider provider.Provider) error
 	}
 }
 
+// isPodmanAppType reports whether applications of the type are run by podman
+// and keep their previous version during an update.
+func isPodmanAppType(appType v1beta1.AppType) bool {
+	return appType == v1beta1.AppTypeCompose || appType == v1beta1.AppTypeQuadlet || appType == v1beta1.AppTypeContainer
+}
+
+// keepPreviousVersion copies the installed version of an application aside
+// before the new version is rendered, so that the previous version can be
+// started again if the new version fails to start. For quadlet applications,
+// the units installed next to the quadlet files are copied as well.
+func (m *manager) keepPreviousVersion(spec *provider.ApplicationSpec) error {
+	rw, err := m.rwFactory(spec.User)
+	if err != nil {
+		return err
+	}
+	// a copy left by an earlier update is stale
+	previousPath := lifecycle.PreviousAppPath(spec.AppType, spec.Path)
+	if err := rw.RemoveAll(previousPath); err != nil {
+		return err
+	}
+	exists, err := rw.PathExists(spec.Path)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	if spec.AppType == v1beta1.AppTypeCompose {
+		return rw.CopyDir(spec.Path, previousPath)
+	}
+
+	appPath, unitPath := lifecycle.PreviousQuadletPaths(previousPath)
+	if err := rw.CopyDir(spec.Path, appPath); err != nil {
+		return err
+	}
+	if err := rw.MkdirAll(unitPath, fileio.DefaultDirectoryPermissions); err != nil {
+		return err
+	}
+	for _, unit := range provider.QuadletUnitPaths(spec.User, spec.ID) {
+		exists, err := rw.PathExists(unit)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		if err := rw.CopyFile(unit, filepath.Join(unitPath, filepath.Base(unit))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removePreviousVersion removes the copy of the previous version of an
+// application kept by its last update.
+func (m *manager) removePreviousVersion(provider provider.Provider) error {
+	spec := provider.Spec()
+	rw, err := m.rwFactory(spec.User)
+	if err != nil {
+		return err
+	}
+	return rw.RemoveAll(lifecycle.PreviousAppPath(spec.AppType, spec.Path))
+}
+
 func (m *manager) BeforeUpdate(ctx context.Context, desired *v1beta1.DeviceSpec, opts ...UpdateOpt) error {
 	o := applyUpdateOpts(opts...)
 	m.osUpdatePending = o.osUpdatePending
@@ -256,10 +330,10 @@ func aggregateAppStatuses(results []AppStatusResult) ([]v1beta1.DeviceApplicatio
 
 		switch result.Summary.Status {
 		case v1beta1.ApplicationsSummaryStatusError:
-			erroredApps = append(erroredApps, fmt.Sprintf("%s is in status %s", result.Status.Name, result.Summary.Status))
+			erroredApps = append(erroredApps, appStatusMessage(result))
 			overallStatus = v1beta1.ApplicationsSummaryStatusError
 		case v1beta1.ApplicationsSummaryStatusDegraded:
-			degradedApps = append(degradedApps, fmt.Sprintf("%s is in status %s", result.Status.Name, result.Summary.Status))
+			degradedApps = append(degradedApps, appStatusMessage(result))
 			if overallStatus != v1beta1.ApplicationsSummaryStatusError {
 				overallStatus = v1beta1.ApplicationsSummaryStatusDegraded
 			}
@@ -283,6 +357,16 @@ func aggregateAppStatuses(results []AppStatusResult) ([]v1beta1.DeviceApplicatio
 	return statuses, summary
 }
 
+// appStatusMessage describes the status of an application, including the
+// reason reported by the application if there is one.
+func appStatusMessage(result AppStatusResult) string {
+	message := fmt.Sprintf("%s is in status %s", result.Status.Name, result.Summary.Status)
+	if result.Summary.Info != nil && *result.Summary.Info != "" {
+		message = fmt.Sprintf("%s: %s", message, *result.Summary.Info)
+	}
+	return message
+}
+
 func (m *manager) Shutdown(ctx context.Context, state shutdown.State) error {
 	var errs []error
 
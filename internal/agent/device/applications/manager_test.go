@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/fs"
 	"os/exec"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -51,6 +52,7 @@ func TestManager(t *testing.T) {
 				{Content: compose1, Path: "podman-compose.yaml"},
 			}),
 			setupMocks: func(mockExec *executer.MockExecuter, mockReadWriter *fileio.MockReadWriter, mockSystemdMgr *systemd.MockManager) {
+				mockComposeSliceLoad(mockReadWriter, mockSystemdMgr, "app-new")
 				gomock.InOrder(
 					// start new app
 					mockReadWriter.EXPECT().PathExists(gomock.Any()).Return(true, nil).AnyTimes(),
@@ -67,6 +69,7 @@ func TestManager(t *testing.T) {
 			}),
 			desired: &v1beta1.DeviceSpec{},
 			setupMocks: func(mockExec *executer.MockExecuter, mockReadWriter *fileio.MockReadWriter, mockSystemdMgr *systemd.MockManager) {
+				mockComposeSliceLoad(mockReadWriter, mockSystemdMgr, "app-remove")
 				id := lifecycle.GenerateAppID("app-remove", v1beta1.CurrentProcessUsername)
 				gomock.InOrder(
 					// start current app (first AfterUpdate)
@@ -93,6 +96,7 @@ func TestManager(t *testing.T) {
 				{Content: compose2, Path: "podman-compose.yaml"},
 			}),
 			setupMocks: func(mockExec *executer.MockExecuter, mockReadWriter *fileio.MockReadWriter, mockSystemdMgr *systemd.MockManager) {
+				mockComposeSliceLoad(mockReadWriter, mockSystemdMgr, "app-update")
 				id := lifecycle.GenerateAppID("app-update", v1beta1.CurrentProcessUsername)
 				gomock.InOrder(
 					// start current app (first AfterUpdate)
@@ -111,6 +115,8 @@ func TestManager(t *testing.T) {
 					// start desired app (monitor already running, no new podman events command)
 					mockReadWriter.EXPECT().PathExists(gomock.Any()).Return(true, nil).AnyTimes(),
 					mockExecPodmanComposeUp(mockExec, "app-update", true, true),
+					// the previous version is removed once the updated app started
+					mockReadWriter.EXPECT().RemoveAll(lifecycle.PreviousAppPath(v1beta1.AppTypeCompose, filepath.Join(lifecycle.ComposeAppPath, "app-update"))).Return(nil),
 				)
 			},
 			wantAppNames: []string{"app-update"},
@@ -208,6 +214,8 @@ func TestManager(t *testing.T) {
 					mockExecSystemdListDependencies(mockSystemdMgr, appID, services),
 					mockExecSystemdListUnitsWithResults(mockSystemdMgr, services...),
 					mockSystemdMgr.EXPECT().Start(gomock.Any(), target).Return(nil),
+					// the previous version is removed once the updated app started
+					mockReadWriter.EXPECT().RemoveAll(lifecycle.PreviousAppPath(v1beta1.AppTypeQuadlet, filepath.Join(lifecycle.RootfulQuadletAppPath, "quadlet-update"))).Return(nil),
 				)
 			},
 			wantAppNames: []string{"quadlet-update"},
@@ -327,6 +335,7 @@ func TestManagerRemoveApplication(t *testing.T) {
 	})
 	desired := &v1beta1.DeviceSpec{}
 
+	mockComposeSliceLoad(mockReadWriter, mockSystemdMgr, "app-remove")
 	id := lifecycle.GenerateAppID("app-remove", v1beta1.CurrentProcessUsername)
 	gomock.InOrder(
 		// start current app
@@ -552,6 +561,15 @@ Exec=sleep infinity
 Restart=always
 `
 
+// mockComposeSliceLoad expects the slice of a compose app to be loaded once
+// before the app starts. The slice is not reloaded while it is unchanged.
+func mockComposeSliceLoad(mockReadWriter *fileio.MockReadWriter, mockSystemdMgr *systemd.MockManager, appName string) {
+	id := lifecycle.GenerateAppID(appName, v1beta1.CurrentProcessUsername)
+	slice, _ := lifecycle.AppSliceUnit(appName, nil)
+	mockReadWriter.EXPECT().ReadFile(provider.AppSlicePath(v1beta1.CurrentProcessUsername, id)).Return(slice, nil).AnyTimes()
+	mockSystemdMgr.EXPECT().DaemonReload(gomock.Any()).Return(nil).Times(1)
+}
+
 func mockExecSystemdDaemonReload(mockSystemdMgr *systemd.MockManager) *gomock.Call {
 	return mockSystemdMgr.EXPECT().DaemonReload(gomock.Any()).Return(nil)
 }
@@ -309,6 +309,20 @@ func (mr *MockApplicationMockRecorder) CopyWorkloadsFrom(other any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyWorkloadsFrom", reflect.TypeOf((*MockApplication)(nil).CopyWorkloadsFrom), other)
 }
 
+// Health mocks base method.
+func (m *MockApplication) Health() *Health {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Health")
+	ret0, _ := ret[0].(*Health)
+	return ret0
+}
+
+// Health indicates an expected call of Health.
+func (mr *MockApplicationMockRecorder) Health() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockApplication)(nil).Health))
+}
+
 // ID mocks base method.
 func (m *MockApplication) ID() string {
 	m.ctrl.T.Helper()
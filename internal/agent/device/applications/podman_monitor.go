@@ -26,6 +26,8 @@ import (
 const (
 	expectedPodmanSigTermExitCode = 1
 	quadletSystemdLabel           = "PODMAN_SYSTEMD_UNIT"
+	// healthCheckTickInterval is how often the monitor looks for due health checks.
+	healthCheckTickInterval = time.Second
 )
 
 type PodmanMonitor struct {
@@ -35,12 +37,15 @@ type PodmanMonitor struct {
 	actions                []lifecycle.Action
 	startTime              time.Time
 	lastActionsSuccessTime time.Time
+	// updates is a map of application ID to the result of its last update.
+	updates map[string]v1beta1.ApplicationUpdateStatus
 
 	handlers       map[v1beta1.AppType]lifecycle.ActionHandler
 	clientFactory  client.PodmanFactory
 	systemdFactory systemd.ManagerFactory
 	watchers       map[v1beta1.Username]*podmanEventWatcher
 	events         chan client.PodmanEvent
+	prober         Prober
 
 	log *log.PrefixLogger
 }
@@ -62,11 +67,13 @@ func NewPodmanMonitor(
 		clientFactory:  podmanFactory,
 		systemdFactory: systemdFactory,
 		handlers: map[v1beta1.AppType]lifecycle.ActionHandler{
-			v1beta1.AppTypeCompose: lifecycle.NewCompose(log, rwFactory, podmanFactory),
+			v1beta1.AppTypeCompose: lifecycle.NewCompose(log, rwFactory, systemdFactory, podmanFactory),
 			v1beta1.AppTypeQuadlet: lifecycle.NewQuadlet(log, rwFactory, systemdFactory, podmanFactory),
 		},
 		watchers:               make(map[v1beta1.Username]*podmanEventWatcher),
+		prober:                 NewPodmanProber(podmanFactory),
 		apps:                   make(map[string]Application),
+		updates:                make(map[string]v1beta1.ApplicationUpdateStatus),
 		startTime:              startTime,
 		lastActionsSuccessTime: startTime,
 		log:                    log,
@@ -91,6 +98,7 @@ func (m *PodmanMonitor) ensureMonitorForUser(ctx context.Context, username v1bet
 	if m.events == nil {
 		m.events = make(chan client.PodmanEvent)
 		go m.listenForEvents(ctx)
+		go m.runHealthChecks(ctx)
 	}
 
 	client, err := m.clientFactory(username)
@@ -207,6 +215,9 @@ func (m *PodmanMonitor) Ensure(ctx context.Context, app Application) error {
 		Embedded: app.IsEmbedded(),
 		Volumes:  provider.ToLifecycleVolumes(app.Volume().List()),
 	}
+	if app.AppType() == v1beta1.AppTypeCompose {
+		action.Spec = lifecycle.ComposeSpec{SlicePath: provider.AppSlicePath(app.User(), appID)}
+	}
 
 	m.actions = append(m.actions, action)
 	return nil
@@ -222,6 +233,7 @@ func (m *PodmanMonitor) QueueRemove(app Application) error {
 	// handle the case where the workload doesn't exist.
 	appID := app.ID()
 	delete(m.apps, appID)
+	delete(m.updates, appID)
 	appName := app.Name()
 
 	action := lifecycle.Action{
@@ -260,6 +272,12 @@ func (m *PodmanMonitor) QueueUpdate(app Application) error {
 		Path:    app.Path(),
 		Volumes: provider.ToLifecycleVolumes(app.Volume().List()),
 	}
+	action.PreviousPath = lifecycle.PreviousAppPath(app.AppType(), app.Path())
+	if app.AppType() == v1beta1.AppTypeCompose {
+		action.Spec = lifecycle.ComposeSpec{SlicePath: provider.AppSlicePath(app.User(), appID)}
+	} else {
+		action.Spec = lifecycle.QuadletSpec{UnitPaths: provider.QuadletUnitPaths(app.User(), appID)}
+	}
 
 	m.actions = append(m.actions, action)
 	return nil
@@ -271,6 +289,22 @@ func (m *PodmanMonitor) addBatchTimeToCtx(ctx context.Context) context.Context {
 	return lifecycle.ContextWithBatchStartTime(ctx, m.lastActionsSuccessTime)
 }
 
+// recordUpdate stores the result of an application update to report it in
+// the application status.
+func (m *PodmanMonitor) recordUpdate(id string, result lifecycle.UpdateResult) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	update := v1beta1.ApplicationUpdateStatus{
+		UpdatedAt:  time.Now(),
+		Downtime:   result.Downtime.Round(time.Millisecond).String(),
+		RolledBack: result.RolledBack,
+	}
+	if result.Message != "" {
+		update.Message = &result.Message
+	}
+	m.updates[id] = update
+}
+
 func (m *PodmanMonitor) updateLastSuccessTime(t time.Time) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -287,6 +321,7 @@ func normalizeActionAppType(appType v1beta1.AppType) v1beta1.AppType {
 
 func (m *PodmanMonitor) ExecuteActions(ctx context.Context) error {
 	ctx = m.addBatchTimeToCtx(ctx)
+	ctx = lifecycle.ContextWithUpdateRecorder(ctx, m.recordUpdate)
 	actions := m.drainActions()
 
 	groupedActions := make(map[v1beta1.AppType][]lifecycle.Action)
@@ -355,16 +390,20 @@ func (m *PodmanMonitor) Status() ([]AppStatusResult, error) {
 	var errs []error
 	results := make([]AppStatusResult, 0, len(m.apps))
 
-	for _, app := range m.apps {
+	for id, app := range m.apps {
 		appStatus, appSummary, err := app.Status()
 		if err != nil {
 			errs = append(errs, err)
 			continue
 		}
-		results = append(results, AppStatusResult{
+		result := AppStatusResult{
 			Status:  *appStatus,
 			Summary: appSummary,
-		})
+		}
+		if update, ok := m.updates[id]; ok {
+			result.Status.LastUpdate = &update
+		}
+		results = append(results, result)
 	}
 
 	if len(errs) > 0 {
@@ -385,6 +424,43 @@ func (m *PodmanMonitor) listenForEvents(ctx context.Context) {
 	}
 }
 
+// runHealthChecks periodically runs the due health checks of all applications
+// until the context is cancelled.
+func (m *PodmanMonitor) runHealthChecks(ctx context.Context) {
+	ticker := time.NewTicker(healthCheckTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.probeApps(ctx, now)
+		}
+	}
+}
+
+func (m *PodmanMonitor) probeApps(ctx context.Context, now time.Time) {
+	type healthTarget struct {
+		health    *Health
+		user      v1beta1.Username
+		workloads []Workload
+	}
+
+	// workloads are updated by events under the lock, so copy them before probing
+	m.mu.Lock()
+	var targets []healthTarget
+	for _, app := range m.apps {
+		if health := app.Health(); health != nil {
+			targets = append(targets, healthTarget{health: health, user: app.User(), workloads: app.Workloads()})
+		}
+	}
+	m.mu.Unlock()
+
+	for _, target := range targets {
+		target.health.Probe(ctx, now, m.prober, target.user, target.workloads)
+	}
+}
+
 func appIDFromEvent(event *client.PodmanEvent) string {
 	if appID, ok := event.Attributes[client.ComposeDockerProjectLabelKey]; ok {
 		return appID
@@ -230,10 +230,24 @@ func (p *composeProvider) Install(ctx context.Context) error {
 		return fmt.Errorf("writing env file: %w", err)
 	}
 
-	if err := writeComposeOverride(p.log, p.spec.Path, p.spec.Volume, p.readWriter, client.ComposeOverrideFilename); err != nil {
+	composeSpec, err := client.ParseComposeSpecFromDir(p.readWriter, p.spec.Path)
+	if err != nil {
+		return fmt.Errorf("parsing compose spec: %w", err)
+	}
+	services := make([]string, 0, len(composeSpec.Services))
+	for name := range composeSpec.Services {
+		services = append(services, name)
+	}
+
+	slice := lifecycle.AppSliceName(p.spec.ID)
+	if err := writeComposeOverride(p.log, p.spec.Path, p.spec.Volume, services, slice, p.readWriter, client.ComposeOverrideFilename); err != nil {
 		return fmt.Errorf("writing override file: %w", err)
 	}
 
+	if err := writeAppSlice(p.readWriter, p.spec); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -249,7 +263,7 @@ func (p *composeProvider) Remove(ctx context.Context) error {
 	if err := p.readWriter.RemoveAll(p.spec.Path); err != nil {
 		return fmt.Errorf("removing compose app path: %w", err)
 	}
-	return nil
+	return removeAppSlice(p.readWriter, p.spec)
 }
 
 func (p *composeProvider) Name() string {
@@ -161,6 +161,10 @@ func (p *containerProvider) Install(ctx context.Context) error {
 		return fmt.Errorf("installing container: %w", err)
 	}
 
+	if err := writeAppSlice(p.readWriter, p.spec); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -172,7 +176,7 @@ func (p *containerProvider) Remove(ctx context.Context) error {
 	if err := p.readWriter.RemoveAll(p.spec.Path); err != nil {
 		return fmt.Errorf("removing container app path: %w", err)
 	}
-	return nil
+	return removeAppSlice(p.readWriter, p.spec)
 }
 
 func (p *containerProvider) Name() string {
@@ -86,6 +86,37 @@ type ApplicationSpec struct {
 	QuadletApp   *v1beta1.QuadletApplication
 }
 
+// HealthChecks returns the health checks of the application, or nil if it has none.
+func (s *ApplicationSpec) HealthChecks() []v1beta1.ApplicationHealthCheck {
+	var healthChecks *[]v1beta1.ApplicationHealthCheck
+	switch {
+	case s.ContainerApp != nil:
+		healthChecks = s.ContainerApp.HealthChecks
+	case s.ComposeApp != nil:
+		healthChecks = s.ComposeApp.HealthChecks
+	case s.QuadletApp != nil:
+		healthChecks = s.QuadletApp.HealthChecks
+	}
+	if healthChecks == nil {
+		return nil
+	}
+	return *healthChecks
+}
+
+// Resources returns the resource constraints of the application, or nil if it
+// has none.
+func (s *ApplicationSpec) Resources() *v1beta1.ApplicationResources {
+	switch {
+	case s.ContainerApp != nil:
+		return s.ContainerApp.Resources
+	case s.ComposeApp != nil:
+		return s.ComposeApp.Resources
+	case s.QuadletApp != nil:
+		return s.QuadletApp.Resources
+	}
+	return nil
+}
+
 const (
 	pullAuthPath = "/root/.config/containers/auth.json"
 )
@@ -887,7 +918,7 @@ func extractAppDataFromOCITarget(
 	}
 
 	if ociType == dependency.OCITypePodmanArtifact {
-		if err := extractAndProcessArtifact(ctx, podman, log.NewPrefixLogger(""), imageRef, tmpAppPath, readWriter); err != nil {
+		if err := podman.ExtractArtifactContents(ctx, imageRef, tmpAppPath, readWriter); err != nil {
 			if rmErr := cleanupFn(); rmErr != nil {
 				return nil, fmt.Errorf("%w %w: %w (cleanup failed: %v)", errors.ErrExtractingArtifact, errors.WithElement(appName), err, rmErr)
 			}
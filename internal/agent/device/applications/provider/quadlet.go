@@ -290,6 +290,10 @@ func (p *quadletProvider) Install(ctx context.Context) error {
 		return fmt.Errorf("installing quadlet: %w", err)
 	}
 
+	if err := writeAppSlice(p.readWriter, p.spec); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -309,7 +313,7 @@ func (p *quadletProvider) Remove(ctx context.Context) error {
 	if err := p.readWriter.RemoveAll(p.spec.Path); err != nil {
 		return fmt.Errorf("removing quadlet app path: %w", err)
 	}
-	return nil
+	return removeAppSlice(p.readWriter, p.spec)
 }
 
 func (p *quadletProvider) Name() string {
@@ -701,6 +705,12 @@ func (q *quadletInstaller) createQuadletDropIn(extension string, hasEnvFile bool
 		unit.Add(sectionName, quadlet.EnvironmentFileKey, filepath.Join(q.appUnitPath, ".env"))
 	}
 
+	// run the workloads in the slice that enforces the resource limits of the application
+	switch extension {
+	case quadlet.ContainerExtension, quadlet.PodExtension, quadlet.KubeExtension:
+		unit.Add("Service", "Slice", lifecycle.AppSliceName(q.appID))
+	}
+
 	contents, err := unit.Write()
 	if err != nil {
 		return fmt.Errorf("serializing drop-in: %w", err)
@@ -1148,11 +1158,23 @@ func quadletAppPath(appName string, user v1beta1.Username) (string, error) {
 
 func quadletSystemdTargetPath(user v1beta1.Username, id string) string {
 	namespacedName := quadlet.NamespaceResource(id, lifecycle.QuadletTargetName)
+	return systemdUnitPath(user, namespacedName)
+}
+
+// QuadletUnitPaths returns the paths of the systemd units that are installed
+// for a quadlet or container application next to its quadlet files.
+func QuadletUnitPaths(user v1beta1.Username, id string) []string {
+	return []string{quadletSystemdTargetPath(user, id), systemdUnitPath(user, lifecycle.AppSliceName(id))}
+}
+
+// systemdUnitPath returns the path of a unit file of the systemd instance that
+// manages the services of the user.
+func systemdUnitPath(user v1beta1.Username, unitName string) string {
 	if user.IsCurrentProcessUser() || user.IsRootUser() {
-		return filepath.Join(lifecycle.RootfulQuadletTargetPath, namespacedName)
+		return filepath.Join(lifecycle.RootfulQuadletTargetPath, unitName)
 	} else {
 		// This lookup should be prevalidated.
 		_, _, homeDir, _ := userutil.LookupUser(user)
-		return filepath.Join(homeDir, ".config/systemd/user", namespacedName)
+		return filepath.Join(homeDir, ".config/systemd/user", unitName)
 	}
 }
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+)
+
+// appSlicePath returns the path of the unit file of the slice of an
+// application.
+func appSlicePath(spec *ApplicationSpec) string {
+	return AppSlicePath(spec.User, spec.ID)
+}
+
+// AppSlicePath returns the path of the unit file of the slice of the
+// application with the given ID.
+func AppSlicePath(user v1beta1.Username, appID string) string {
+	return systemdUnitPath(user, lifecycle.AppSliceName(appID))
+}
+
+// writeAppSlice writes the unit file of the systemd slice that the workloads of
+// an application run in, which enforces the resource limits of the application.
+func writeAppSlice(rw fileio.ReadWriter, spec *ApplicationSpec) error {
+	var limits *v1beta1.ApplicationResourceLimits
+	if resources := spec.Resources(); resources != nil {
+		limits = resources.Limits
+	}
+	contents, err := lifecycle.AppSliceUnit(spec.Name, limits)
+	if err != nil {
+		return fmt.Errorf("rendering slice: %w", err)
+	}
+
+	path := appSlicePath(spec)
+	if err := rw.MkdirAll(filepath.Dir(path), fileio.DefaultDirectoryPermissions); err != nil {
+		return fmt.Errorf("creating slice directory: %w", err)
+	}
+	if err := rw.WriteFile(path, contents, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("writing slice: %w", err)
+	}
+	return nil
+}
+
+// removeAppSlice removes the unit file of the slice of an application.
+func removeAppSlice(rw fileio.ReadWriter, spec *ApplicationSpec) error {
+	if err := rw.RemoveFile(appSlicePath(spec)); err != nil {
+		return fmt.Errorf("removing slice: %w", err)
+	}
+	return nil
+}
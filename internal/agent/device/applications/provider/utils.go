@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
-	"strings"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
@@ -83,55 +82,6 @@ func detectOCIType(ctx context.Context, podman *client.Podman, imageRef string)
 	return "", fmt.Errorf("OCI reference %s not found locally - cannot determine type", imageRef)
 }
 
-// extractAndProcessArtifact extracts an artifact and handles tar/tar.gz files.
-func extractAndProcessArtifact(ctx context.Context, podman *client.Podman, log *log.PrefixLogger, artifact, destination string, writer fileio.ReadWriter) error {
-	tmpDir, err := writer.MkdirTemp("artifact_extract")
-	if err != nil {
-		return fmt.Errorf("creating temp directory: %w", err)
-	}
-	defer func() {
-		if rmErr := writer.RemoveAll(tmpDir); rmErr != nil {
-			log.Warnf("Failed to cleanup temp directory %q: %v", tmpDir, rmErr)
-		}
-	}()
-
-	if _, err := podman.ExtractArtifact(ctx, artifact, tmpDir); err != nil {
-		return fmt.Errorf("extracting artifact: %w", err)
-	}
-
-	if err := writer.MkdirAll(destination, fileio.DefaultDirectoryPermissions); err != nil {
-		return fmt.Errorf("creating destination directory: %w", err)
-	}
-
-	entries, err := writer.ReadDir(tmpDir)
-	if err != nil {
-		return fmt.Errorf("reading extracted content: %w", err)
-	}
-
-	for _, entry := range entries {
-		srcPath := filepath.Join(tmpDir, entry.Name())
-
-		if !entry.IsDir() && (strings.HasSuffix(entry.Name(), ".tar") || strings.HasSuffix(entry.Name(), ".tar.gz") || strings.HasSuffix(entry.Name(), ".tgz")) {
-			if err := fileio.UnpackTar(writer, srcPath, destination); err != nil {
-				return fmt.Errorf("unpacking tar file %s: %w", entry.Name(), err)
-			}
-		} else {
-			destPath := filepath.Join(destination, entry.Name())
-			if entry.IsDir() {
-				if err := writer.CopyDir(srcPath, destPath); err != nil {
-					return fmt.Errorf("copying directory %s: %w", entry.Name(), err)
-				}
-			} else {
-				if err := writer.CopyFile(srcPath, destPath); err != nil {
-					return fmt.Errorf("copying file %s: %w", entry.Name(), err)
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
 // extractOCIContentsToPath extracts OCI image or artifact contents to the specified path.
 // It handles both podman images and artifacts, automatically detecting the type.
 func extractOCIContentsToPath(ctx context.Context, podman *client.Podman, log *log.PrefixLogger, rw fileio.ReadWriter, imageRef, path string) error {
@@ -147,7 +97,7 @@ func extractOCIContentsToPath(ctx context.Context, podman *client.Podman, log *l
 	}
 
 	if ociType == dependency.OCITypePodmanArtifact {
-		if err := extractAndProcessArtifact(ctx, podman, log, imageRef, path, rw); err != nil {
+		if err := podman.ExtractArtifactContents(ctx, imageRef, path, rw); err != nil {
 			clean()
 			return fmt.Errorf("extract artifact contents: %w", err)
 		}
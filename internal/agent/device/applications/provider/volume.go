@@ -261,29 +261,44 @@ func ensureDependenciesFromVolumes(ctx context.Context, podman *client.Podman, v
 	return nil
 }
 
-// writeComposeOverride creates an override file that maps volumes to external names and perists to disk.
+// writeComposeOverride creates an override file that maps volumes to external
+// names and places the services in the slice of the application and perists it
+// to disk.
 func writeComposeOverride(
 	log *log.PrefixLogger,
 	dir string,
 	volumeManager VolumeManager,
+	services []string,
+	slice string,
 	writer fileio.Writer,
 	overrideFilename string,
 ) error {
 	volumes := volumeManager.List()
-	if len(volumes) == 0 {
+	if len(volumes) == 0 && (len(services) == 0 || slice == "") {
 		return nil
 	}
 
-	override := map[string]any{
-		"volumes": map[string]any{},
+	override := map[string]any{}
+
+	if len(volumes) > 0 {
+		volMap := map[string]any{}
+		for _, volume := range volumes {
+			volMap[volume.Name] = map[string]any{
+				"external": true,
+				"name":     volume.ID,
+			}
+		}
+		override["volumes"] = volMap
 	}
 
-	volMap := override["volumes"].(map[string]any)
-	for _, volume := range volumes {
-		volMap[volume.Name] = map[string]any{
-			"external": true,
-			"name":     volume.ID,
+	if slice != "" && len(services) > 0 {
+		serviceMap := map[string]any{}
+		for _, service := range services {
+			serviceMap[service] = map[string]any{
+				"cgroup_parent": slice,
+			}
 		}
+		override["services"] = serviceMap
 	}
 
 	overrideBytes, err := yaml.Marshal(override)
@@ -21,6 +21,8 @@ func TestWriteComposeOverride(t *testing.T) {
 		name     string
 		appName  string
 		volumes  []string
+		services []string
+		slice    string
 		expected string
 		written  bool
 	}{
@@ -59,6 +61,23 @@ func TestWriteComposeOverride(t *testing.T) {
 			volumes: []string{},
 			written: false,
 		},
+		{
+			name:     "services in application slice",
+			appName:  "app1",
+			volumes:  []string{"data"},
+			services: []string{"web", "db"},
+			slice:    "flightctl-app1_229522.slice",
+			expected: `services:
+  db:
+    cgroup_parent: flightctl-app1_229522.slice
+  web:
+    cgroup_parent: flightctl-app1_229522.slice
+volumes:
+  data:
+    external: true
+    name: app1-data-254868`,
+			written: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -72,7 +91,7 @@ func TestWriteComposeOverride(t *testing.T) {
 			volumeManager, err := NewVolumeManager(log, tt.appName, v1beta1.AppTypeCompose, v1beta1.CurrentProcessUsername, newTestImageApplicationVolumes(require, tt.volumes))
 			require.NoError(err)
 
-			err = writeComposeOverride(log, "/etc/compose/manifest", volumeManager, writer, client.ComposeOverrideFilename)
+			err = writeComposeOverride(log, "/etc/compose/manifest", volumeManager, tt.services, tt.slice, writer, client.ComposeOverrideFilename)
 			require.NoError(err)
 
 			path := filepath.Join("/etc/compose/manifest", client.ComposeOverrideFilename)
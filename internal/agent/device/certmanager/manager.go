@@ -76,10 +76,11 @@ func NewAgentCertManager(
 		managementProvisionerFactory,
 	)
 
-	// Base storage: persists the management certificate.
+	// Base storage: persists the management certificate and the CA bundle returned with it.
 	managementStorageFactory := management.NewManagementStorageFactory(
 		identityProvider,
 		managementClient,
+		cfg,
 	)
 
 	// Observe completed renewal outcomes on successful store.
@@ -93,6 +94,7 @@ func NewAgentCertManager(
 		ctx,
 		log,
 		identityProvider,
+		cfg.ManagementCABundle,
 		systemInfoManager,
 		statusManager,
 		managementStorageFactory,
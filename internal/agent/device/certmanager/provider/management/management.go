@@ -31,8 +31,17 @@ const (
 	backoffJitterFactor = 0.1
 
 	managementSignerName = "flightctl.io/device-management-renewal"
+
+	// metaKeyCABundle hands the CA bundle returned with the management certificate from the provisioner to the storage.
+	metaKeyCABundle = "flightctl.mgmt_cert.ca_bundle"
 )
 
+// CABundleStore persists the CA bundle the management service returns with the management certificate,
+// so that the device trusts a new service CA before the service switches to it.
+type CABundleStore interface {
+	StoreManagementCABundle(bundle []byte) error
+}
+
 // ---- ConfigProvider ----
 
 type managementConfigProvider struct {
@@ -214,10 +223,14 @@ func (p *managementProvisioner) poll(ctx context.Context, req certmanager.Provis
 		p.csrName = ""
 		p.lastInfo = time.Time{}
 
-		return &certmanager.ProvisionResult{
+		result := &certmanager.ProvisionResult{
 			Ready: true,
 			Cert:  *csrObj.Status.Certificate,
-		}, nil
+		}
+		if csrObj.Status.CaBundle != nil && len(*csrObj.Status.CaBundle) > 0 {
+			result.Meta = map[string][]byte{metaKeyCABundle: *csrObj.Status.CaBundle}
+		}
+		return result, nil
 	}
 
 	// Denied
@@ -304,6 +317,7 @@ type managementStorage struct {
 	log              certmanager.Logger
 	identityProvider identity.Provider
 	managementClient client.Management
+	caBundleStore    CABundleStore
 }
 
 func (s *managementStorage) Store(ctx context.Context, req certmanager.StoreRequest) error {
@@ -318,6 +332,13 @@ func (s *managementStorage) Store(ctx context.Context, req certmanager.StoreRequ
 		return err
 	}
 
+	// Storing the CA bundle is best-effort: if it fails, the device keeps trusting the CAs it already trusts.
+	if bundle := req.Result.Meta[metaKeyCABundle]; len(bundle) > 0 && s.caBundleStore != nil {
+		if err := s.caBundleStore.StoreManagementCABundle(bundle); err != nil && s.log != nil {
+			s.log.Warnf("Failed to store management CA bundle: %v", err)
+		}
+	}
+
 	// Reload is best-effort: if we can reload, do it; if it fails, don't fail the store.
 	if ok, err := client.TryReload(s.managementClient); ok && err != nil {
 		s.log.Debugf("Failed to reload management client after cert rotation: %v", err)
@@ -385,12 +406,14 @@ func (f managementProvisionerFactory) New(log certmanager.Logger, cc certmanager
 type managementStorageFactory struct {
 	identityProvider identity.Provider
 	managementClient client.Management
+	caBundleStore    CABundleStore
 }
 
-func NewManagementStorageFactory(identityProvider identity.Provider, managementClient client.Management) certmanager.StorageFactory {
+func NewManagementStorageFactory(identityProvider identity.Provider, managementClient client.Management, caBundleStore CABundleStore) certmanager.StorageFactory {
 	return &managementStorageFactory{
 		identityProvider: identityProvider,
 		managementClient: managementClient,
+		caBundleStore:    caBundleStore,
 	}
 }
 
@@ -405,6 +428,7 @@ func (f managementStorageFactory) New(log certmanager.Logger, cc certmanager.Cer
 		log:              log,
 		identityProvider: f.identityProvider,
 		managementClient: f.managementClient,
+		caBundleStore:    f.caBundleStore,
 	}, nil
 }
 
@@ -2,7 +2,9 @@ package management
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/flightctl/flightctl/internal/agent/device/status"
@@ -17,6 +19,7 @@ func WithStatusCollectOnStore(
 	ctx context.Context,
 	log certmanager.Logger,
 	identityProvider identity.Provider,
+	caBundle func() ([]byte, error),
 	systemInfoManager systeminfo.Manager,
 	statusManager status.Manager,
 	f certmanager.StorageFactory,
@@ -67,6 +70,43 @@ func WithStatusCollectOnStore(
 		return certs[0].NotAfter.UTC().Format(time.RFC3339)
 	})
 
+	// The key identifiers are hex-encoded without separators, as reported by the service for its CAs.
+	systemInfoManager.RegisterCollector(ctx, systeminfocommon.ManagementCertIssuerKeyIDKey, func(ctx context.Context) string {
+		pemBytes, err := identityProvider.GetCertificate()
+		if err != nil {
+			return ""
+		}
+
+		certs, err := cert.ParseCertsPEM(pemBytes)
+		if err != nil || len(certs) == 0 || certs[0] == nil {
+			return ""
+		}
+
+		return hex.EncodeToString(certs[0].AuthorityKeyId)
+	})
+
+	if caBundle != nil {
+		systemInfoManager.RegisterCollector(ctx, systeminfocommon.ManagementCAKeyIDsKey, func(ctx context.Context) string {
+			pemBytes, err := caBundle()
+			if err != nil || len(pemBytes) == 0 {
+				return ""
+			}
+
+			certs, err := cert.ParseCertsPEM(pemBytes)
+			if err != nil {
+				return ""
+			}
+
+			keyIDs := make([]string, 0, len(certs))
+			for _, c := range certs {
+				if len(c.SubjectKeyId) > 0 {
+					keyIDs = append(keyIDs, hex.EncodeToString(c.SubjectKeyId))
+				}
+			}
+			return strings.Join(keyIDs, ",")
+		})
+	}
+
 	return &chainStorageFactory{
 		next: f,
 		store: func(ctx context.Context, next certmanager.StorageProvider, req certmanager.StoreRequest) error {
@@ -5,8 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"reflect"
+	"sync"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/dependency"
 	deviceerrors "github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/pkg/log"
@@ -15,18 +19,33 @@ import (
 // Config controller is responsible for ensuring the device configuration is reconciled
 // against the device spec.
 type Controller struct {
-	deviceWriter fileio.Writer
-	log          *log.PrefixLogger
+	deviceWriter       fileio.ReadWriter
+	podmanClient       *client.Podman
+	pullConfigResolver dependency.PullConfigResolver
+	dataDir            string
+	log                *log.PrefixLogger
+
+	mu sync.Mutex
+	// managedFiles tracks the files written from the current rendered spec by path.
+	// It is nil until the first successful sync.
+	managedFiles map[string]managedFile
+	driftAction  v1beta1.ConfigDriftAction
 }
 
 // NewController creates a new config controller.
 func NewController(
-	deviceWriter fileio.Writer,
+	deviceWriter fileio.ReadWriter,
+	podmanClient *client.Podman,
+	pullConfigResolver dependency.PullConfigResolver,
+	dataDir string,
 	log *log.PrefixLogger,
 ) *Controller {
 	return &Controller{
-		deviceWriter: deviceWriter,
-		log:          log,
+		deviceWriter:       deviceWriter,
+		podmanClient:       podmanClient,
+		pullConfigResolver: pullConfigResolver,
+		dataDir:            dataDir,
+		log:                log,
 	}
 }
 
@@ -34,6 +53,9 @@ func (c *Controller) Sync(ctx context.Context, current, desired *v1beta1.DeviceS
 	c.log.Debug("Syncing device configuration")
 	defer c.log.Debug("Finished syncing device configuration")
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	desiredFiles, err := ProviderSpecToFiles(desired.Config)
 	if err != nil {
 		return fmt.Errorf("%w: %w", deviceerrors.ErrConvertDesiredConfigToFiles, err)
@@ -44,7 +66,21 @@ func (c *Controller) Sync(ctx context.Context, current, desired *v1beta1.DeviceS
 		return fmt.Errorf("%w: %w", deviceerrors.ErrConvertCurrentConfigToFiles, err)
 	}
 
-	return c.ensureConfigFiles(currentFiles, desiredFiles)
+	driftAction := getDriftAction(desired.ConfigDriftPolicy)
+	// in the steady state files that were changed on the device are only rewritten if
+	// the drift policy asks for remediation, otherwise they are reported as drifted.
+	steadyState := reflect.DeepEqual(current.Config, desired.Config)
+	if !steadyState || driftAction == v1beta1.ConfigDriftActionRemediate {
+		if err := c.ensureConfigFiles(currentFiles, desiredFiles); err != nil {
+			return err
+		}
+	}
+
+	if err := c.ensureOCIConfigs(ctx, current.Config, desired.Config); err != nil {
+		return err
+	}
+
+	return c.trackFiles(desiredFiles, driftAction)
 }
 
 func computeRemoval(currentFileList, desiredFileList []v1beta1.FileSpec) []string {
@@ -137,19 +173,34 @@ func getFilePaths(currentFileList []v1beta1.FileSpec) []string {
 	return result
 }
 
-// ProviderSpecToFiles converts a list of ConfigProviderSpecs to a list of FileSpecs.
+// ProviderSpecToFiles converts the inline providers of a rendered list of ConfigProviderSpecs
+// to a list of FileSpecs. OCI providers are unpacked separately and are skipped.
 func ProviderSpecToFiles(configs *[]v1beta1.ConfigProviderSpec) ([]v1beta1.FileSpec, error) {
-	if configs == nil || len(*configs) == 0 {
-		return []v1beta1.FileSpec{}, nil
+	files := []v1beta1.FileSpec{}
+	if configs == nil {
+		return files, nil
 	}
 
-	configItem := (*configs)[0]
-	desiredProvider, err := configItem.AsInlineConfigProviderSpec()
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert config to inline config: %w", err)
+	for _, configItem := range *configs {
+		configType, err := configItem.Type()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get config type: %w", err)
+		}
+		switch configType {
+		case v1beta1.InlineConfigProviderType:
+			desiredProvider, err := configItem.AsInlineConfigProviderSpec()
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert config to inline config: %w", err)
+			}
+			files = append(files, desiredProvider.Inline...)
+		case v1beta1.OciConfigProviderType:
+			continue
+		default:
+			return nil, fmt.Errorf("unexpected config type in rendered spec: %s", configType)
+		}
 	}
 
-	return desiredProvider.Inline, nil
+	return files, nil
 }
 
 func FilesToProviderSpec(files []v1beta1.FileSpec) (*[]v1beta1.ConfigProviderSpec, error) {
@@ -80,10 +80,13 @@ func TestSync(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockWriter := fileio.NewMockWriter(ctrl)
+			mockWriter := fileio.NewMockReadWriter(ctrl)
 			mockManagedFile := fileio.NewMockManagedFile(ctrl)
 			controller := NewController(
 				mockWriter,
+				nil,
+				nil,
+				"/var/lib/flightctl",
 				log.NewPrefixLogger("test"),
 			)
 
@@ -163,14 +166,14 @@ func TestComputeRemoval(t *testing.T) {
 	}
 }
 
-func expectCreateFile(mockWriter *fileio.MockWriter, mockManagedFile *fileio.MockManagedFile, _ string) {
+func expectCreateFile(mockWriter *fileio.MockReadWriter, mockManagedFile *fileio.MockManagedFile, _ string) {
 	mockWriter.EXPECT().CreateManagedFile(gomock.Any()).Return(mockManagedFile, nil)
 	mockManagedFile.EXPECT().IsUpToDate().Return(false, nil)
 	mockManagedFile.EXPECT().Exists().Return(false, nil)
 	mockManagedFile.EXPECT().Write().Return(nil)
 }
 
-func expectRemoveFile(mockWriter *fileio.MockWriter, f string) {
+func expectRemoveFile(mockWriter *fileio.MockReadWriter, f string) {
 	mockWriter.EXPECT().RemoveFile(f).Return(nil)
 }
 
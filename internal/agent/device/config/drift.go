@@ -0,0 +1,142 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/samber/lo"
+)
+
+const (
+	// ConfigDriftedReason is the reason of the ConfigDrifted condition when managed files drifted.
+	ConfigDriftedReason = "Drifted"
+	// ConfigInSyncReason is the reason of the ConfigDrifted condition when all managed files match the rendered spec.
+	ConfigInSyncReason = "InSync"
+)
+
+var _ status.Exporter = (*Controller)(nil)
+
+// managedFile is a file written from the rendered spec along with the hash of its expected content.
+type managedFile struct {
+	spec v1beta1.FileSpec
+	hash string
+}
+
+// Status checks the files written from the current rendered spec for drift and reports the
+// drifted paths in the device config status and the ConfigDrifted condition. If the drift
+// policy asks for remediation, drifted files are rewritten first.
+func (c *Controller) Status(ctx context.Context, deviceStatus *v1beta1.DeviceStatus, _ ...status.CollectorOpt) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.managedFiles == nil {
+		// nothing has been synced yet
+		return nil
+	}
+
+	drifted, err := c.driftedPaths()
+	if err != nil {
+		return err
+	}
+
+	if len(drifted) > 0 && c.driftAction == v1beta1.ConfigDriftActionRemediate {
+		drifted = c.remediate(drifted)
+	}
+
+	condition := v1beta1.Condition{
+		Type:    v1beta1.ConditionTypeDeviceConfigDrifted,
+		Status:  v1beta1.ConditionStatusFalse,
+		Reason:  ConfigInSyncReason,
+		Message: "All config files match the rendered device spec",
+	}
+	deviceStatus.Config.DriftedPaths = nil
+	if len(drifted) > 0 {
+		deviceStatus.Config.DriftedPaths = &drifted
+		condition.Status = v1beta1.ConditionStatusTrue
+		condition.Reason = ConfigDriftedReason
+		condition.Message = fmt.Sprintf("Config files changed on the device: %s", strings.Join(drifted, ", "))
+		if len(condition.Message) > status.MaxMessageLength {
+			condition.Message = condition.Message[:status.MaxMessageLength]
+		}
+	}
+	v1beta1.SetStatusCondition(&deviceStatus.Conditions, condition)
+	return nil
+}
+
+// trackFiles records the expected content hash of the given files and the drift action of the
+// rendered spec they were written from. Assumes the lock is held.
+func (c *Controller) trackFiles(files []v1beta1.FileSpec, driftAction v1beta1.ConfigDriftAction) error {
+	managedFiles := make(map[string]managedFile, len(files))
+	for _, file := range files {
+		contents, err := fileio.DecodeContent(file.Content, file.ContentEncoding)
+		if err != nil {
+			return fmt.Errorf("decoding content of file %s: %w", file.Path, err)
+		}
+		managedFiles[file.Path] = managedFile{spec: file, hash: hashContent(contents)}
+	}
+	c.managedFiles = managedFiles
+	c.driftAction = driftAction
+	return nil
+}
+
+// driftedPaths returns the sorted paths of the managed files which were changed or removed
+// on the device. Assumes the lock is held.
+func (c *Controller) driftedPaths() ([]string, error) {
+	var drifted []string
+	for path, file := range c.managedFiles {
+		contents, err := os.ReadFile(c.deviceWriter.PathFor(path))
+		if err != nil {
+			if os.IsNotExist(err) {
+				drifted = append(drifted, path)
+				continue
+			}
+			return nil, fmt.Errorf("reading config file %s: %w", path, err)
+		}
+		if hashContent(contents) != file.hash {
+			drifted = append(drifted, path)
+		}
+	}
+	sort.Strings(drifted)
+	return drifted, nil
+}
+
+// remediate rewrites the given drifted files from the rendered spec and returns the paths
+// that could not be rewritten. Assumes the lock is held.
+func (c *Controller) remediate(drifted []string) []string {
+	var remaining []string
+	for _, path := range drifted {
+		managedFile, err := c.deviceWriter.CreateManagedFile(c.managedFiles[path].spec)
+		if err == nil {
+			err = managedFile.Write()
+		}
+		if err != nil {
+			c.log.Warnf("Failed to remediate drifted config file %s: %v", path, err)
+			remaining = append(remaining, path)
+			continue
+		}
+		c.log.Infof("Remediated drifted config file %s", path)
+	}
+	return remaining
+}
+
+// getDriftAction returns the drift action of the policy. Drifted files are remediated by default, as
+// agents have always rewritten changed files when reconciling the device spec.
+func getDriftAction(policy *v1beta1.ConfigDriftPolicy) v1beta1.ConfigDriftAction {
+	if policy == nil {
+		return v1beta1.ConfigDriftActionRemediate
+	}
+	return lo.FromPtrOr(policy.Action, v1beta1.ConfigDriftActionRemediate)
+}
+
+func hashContent(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
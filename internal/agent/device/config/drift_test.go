@@ -0,0 +1,176 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriftStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		driftAction  *v1beta1.ConfigDriftAction
+		modify       func(require *require.Assertions, rootDir string)
+		wantDrifted  []string
+		wantRestored bool
+	}{
+		{
+			name:   "no drift",
+			modify: func(*require.Assertions, string) {},
+		},
+		{
+			name:        "modified and removed files are reported",
+			driftAction: lo.ToPtr(v1beta1.ConfigDriftActionReport),
+			modify: func(require *require.Assertions, rootDir string) {
+				require.NoError(os.WriteFile(filepath.Join(rootDir, "/etc/example/file1.txt"), []byte("edited"), 0o600))
+				require.NoError(os.Remove(filepath.Join(rootDir, "/etc/example/file2.txt")))
+			},
+			wantDrifted: []string{"/etc/example/file1.txt", "/etc/example/file2.txt"},
+		},
+		{
+			name:        "drifted files are remediated",
+			driftAction: lo.ToPtr(v1beta1.ConfigDriftActionRemediate),
+			modify: func(require *require.Assertions, rootDir string) {
+				require.NoError(os.WriteFile(filepath.Join(rootDir, "/etc/example/file1.txt"), []byte("edited"), 0o600))
+				require.NoError(os.Remove(filepath.Join(rootDir, "/etc/example/file2.txt")))
+			},
+			wantRestored: true,
+		},
+		{
+			name: "drifted files are remediated by default",
+			modify: func(require *require.Assertions, rootDir string) {
+				require.NoError(os.Remove(filepath.Join(rootDir, "/etc/example/file2.txt")))
+			},
+			wantRestored: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+			rootDir := t.TempDir()
+			controller := NewController(
+				fileio.NewReadWriter(
+					fileio.NewReader(fileio.WithReaderRootDir(rootDir)),
+					fileio.NewWriter(fileio.WithWriterRootDir(rootDir)),
+				),
+				nil,
+				nil,
+				"/var/lib/flightctl",
+				log.NewPrefixLogger("test"),
+			)
+
+			desired := &v1beta1.DeviceSpec{
+				Config:            testConfigProvider(require, 2),
+				ConfigDriftPolicy: &v1beta1.ConfigDriftPolicy{Action: tt.driftAction},
+			}
+			require.NoError(controller.Sync(ctx, &v1beta1.DeviceSpec{}, desired))
+
+			tt.modify(require, rootDir)
+
+			// a steady state sync only rewrites drifted files if remediation is requested
+			require.NoError(controller.Sync(ctx, desired, desired))
+
+			status := v1beta1.NewDeviceStatus()
+			require.NoError(controller.Status(ctx, &status))
+
+			condition := v1beta1.FindStatusCondition(status.Conditions, v1beta1.ConditionTypeDeviceConfigDrifted)
+			require.NotNil(condition)
+			if len(tt.wantDrifted) > 0 {
+				require.Equal(tt.wantDrifted, lo.FromPtr(status.Config.DriftedPaths))
+				require.Equal(v1beta1.ConditionStatusTrue, condition.Status)
+				require.Contains(condition.Message, tt.wantDrifted[0])
+				return
+			}
+			require.Nil(status.Config.DriftedPaths)
+			require.Equal(v1beta1.ConditionStatusFalse, condition.Status)
+			if tt.wantRestored {
+				contents, err := os.ReadFile(filepath.Join(rootDir, "/etc/example/file2.txt"))
+				require.NoError(err)
+				require.Equal("File 2 contents", string(contents))
+			}
+		})
+	}
+}
+
+func TestSteadyStateSyncRewritesDriftedFilesOnlyOnRemediate(t *testing.T) {
+	tests := []struct {
+		name         string
+		driftAction  *v1beta1.ConfigDriftAction
+		wantContents string
+	}{
+		{
+			name:         "default policy rewrites the edited file",
+			wantContents: "File 1 contents",
+		},
+		{
+			name:         "remediate policy rewrites the edited file",
+			driftAction:  lo.ToPtr(v1beta1.ConfigDriftActionRemediate),
+			wantContents: "File 1 contents",
+		},
+		{
+			name:         "report policy keeps the edited file",
+			driftAction:  lo.ToPtr(v1beta1.ConfigDriftActionReport),
+			wantContents: "edited",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+			rootDir := t.TempDir()
+			controller := NewController(
+				fileio.NewReadWriter(
+					fileio.NewReader(fileio.WithReaderRootDir(rootDir)),
+					fileio.NewWriter(fileio.WithWriterRootDir(rootDir)),
+				),
+				nil,
+				nil,
+				"/var/lib/flightctl",
+				log.NewPrefixLogger("test"),
+			)
+
+			desired := &v1beta1.DeviceSpec{
+				Config:            testConfigProvider(require, 1),
+				ConfigDriftPolicy: &v1beta1.ConfigDriftPolicy{Action: tt.driftAction},
+			}
+			require.NoError(controller.Sync(ctx, &v1beta1.DeviceSpec{}, desired))
+
+			path := filepath.Join(rootDir, "/etc/example/file1.txt")
+			require.NoError(os.WriteFile(path, []byte("edited"), 0o600))
+			require.NoError(controller.Sync(ctx, desired, desired))
+
+			contents, err := os.ReadFile(path)
+			require.NoError(err)
+			require.Equal(tt.wantContents, string(contents))
+		})
+	}
+}
+
+func TestDriftStatusBeforeSync(t *testing.T) {
+	require := require.New(t)
+	rootDir := t.TempDir()
+	controller := NewController(
+		fileio.NewReadWriter(
+			fileio.NewReader(fileio.WithReaderRootDir(rootDir)),
+			fileio.NewWriter(fileio.WithWriterRootDir(rootDir)),
+		),
+		nil,
+		nil,
+		"/var/lib/flightctl",
+		log.NewPrefixLogger("test"),
+	)
+
+	status := v1beta1.NewDeviceStatus()
+	require.NoError(controller.Status(context.Background(), &status))
+	require.Nil(v1beta1.FindStatusCondition(status.Conditions, v1beta1.ConditionTypeDeviceConfigDrifted))
+}
@@ -0,0 +1,270 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"syscall"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/dependency"
+	deviceerrors "github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+)
+
+const (
+	// pullAuthPath is the default path to the pull secret used for OCI config artifacts.
+	pullAuthPath = "/root/.config/containers/auth.json"
+	// ociManifestDir is the directory within the data directory that holds the manifests of the
+	// unpacked OCI config artifacts.
+	ociManifestDir = "oci-configs"
+)
+
+var _ dependency.OCICollector = (*Controller)(nil)
+
+// CollectOCITargets returns the artifacts referenced by the OCI config providers of the desired
+// spec so that they are prefetched before the update is applied.
+func (c *Controller) CollectOCITargets(_ context.Context, _, desired *v1beta1.DeviceSpec, _ ...dependency.OCICollectOpt) (*dependency.OCICollection, error) {
+	providers, err := ociProviders(desired.Config)
+	if err != nil {
+		return nil, err
+	}
+	if len(providers) == 0 {
+		return &dependency.OCICollection{}, nil
+	}
+
+	targets := make([]dependency.OCIPullTarget, 0, len(providers))
+	for _, provider := range providers {
+		targets = append(targets, dependency.OCIPullTarget{
+			Type:       dependency.OCITypePodmanArtifact,
+			Reference:  provider.OciRef.Reference,
+			PullPolicy: v1beta1.PullIfNotPresent,
+			ClientOptsFn: c.pullConfigResolver.Options(dependency.PullConfigSpec{
+				Paths:    []string{pullAuthPath},
+				OptionFn: client.WithPullSecret,
+			}),
+		})
+	}
+
+	c.log.Debugf("Collected %d OCI targets from config spec", len(targets))
+	return &dependency.OCICollection{
+		Targets: dependency.OCIPullTargetsByUser{
+			v1beta1.CurrentProcessUsername: targets,
+		},
+	}, nil
+}
+
+// ociManifest records what the agent unpacked into the mount path of an OCI config provider, so
+// that only these entries are removed when the provider is updated or removed. The mount path
+// may be a directory that also holds files the agent does not manage.
+type ociManifest struct {
+	MountPath string `json:"mountPath"`
+	Reference string `json:"reference"`
+	// Files are the unpacked files and symlinks relative to the mount path.
+	Files []string `json:"files"`
+	// Dirs are the directories relative to the mount path that did not exist before unpacking,
+	// "." if the mount path itself was created.
+	Dirs []string `json:"dirs"`
+}
+
+// ensureOCIConfigs unpacks the artifacts of new or changed OCI config providers into their mount
+// paths and removes the unpacked contents of providers that are no longer part of the spec.
+// Entries of the mount path that were not unpacked by the agent are left in place.
+func (c *Controller) ensureOCIConfigs(ctx context.Context, currentConfig, desiredConfig *[]v1beta1.ConfigProviderSpec) error {
+	currentProviders, err := ociProviders(currentConfig)
+	if err != nil {
+		return fmt.Errorf("%w: %w", deviceerrors.ErrConvertCurrentConfigToFiles, err)
+	}
+	desiredProviders, err := ociProviders(desiredConfig)
+	if err != nil {
+		return fmt.Errorf("%w: %w", deviceerrors.ErrConvertDesiredConfigToFiles, err)
+	}
+
+	desiredRefs := make(map[string]string, len(desiredProviders))
+	for _, provider := range desiredProviders {
+		desiredRefs[provider.OciRef.MountPath] = provider.OciRef.Reference
+	}
+
+	for _, provider := range currentProviders {
+		mountPath := provider.OciRef.MountPath
+		if _, ok := desiredRefs[mountPath]; ok {
+			continue
+		}
+		c.log.Debugf("Removing OCI config %s from %s", provider.Name, mountPath)
+		if err := c.removeUnpackedOCIConfig(mountPath); err != nil {
+			return fmt.Errorf("%w: %w", deviceerrors.ErrDeletingFilesFailed, err)
+		}
+	}
+
+	for _, provider := range desiredProviders {
+		mountPath := provider.OciRef.MountPath
+		reference := provider.OciRef.Reference
+		manifest, err := c.readOCIManifest(mountPath)
+		if err != nil {
+			return fmt.Errorf("checking OCI config %s: %w", provider.Name, err)
+		}
+		if manifest != nil && manifest.Reference == reference {
+			continue
+		}
+
+		c.log.Infof("Unpacking OCI config %s from %s to %s", provider.Name, reference, mountPath)
+		if err := c.unpackOCIConfig(ctx, reference, mountPath); err != nil {
+			return fmt.Errorf("failed to apply OCI config %s: %w", provider.Name, err)
+		}
+	}
+	return nil
+}
+
+// unpackOCIConfig extracts the artifact into a staging directory, replaces the entries unpacked
+// for the previous reference with its contents and records them in the manifest of the mount path.
+func (c *Controller) unpackOCIConfig(ctx context.Context, reference, mountPath string) error {
+	stagingDir, err := c.deviceWriter.MkdirTemp("oci_config")
+	if err != nil {
+		return fmt.Errorf("creating staging directory: %w", err)
+	}
+	defer func() {
+		if err := c.deviceWriter.RemoveAll(stagingDir); err != nil {
+			c.log.Warnf("Failed to remove OCI config staging directory %s: %v", stagingDir, err)
+		}
+	}()
+
+	if err := c.podmanClient.ExtractArtifactContents(ctx, reference, stagingDir, c.deviceWriter); err != nil {
+		return err
+	}
+	manifest := &ociManifest{MountPath: mountPath, Reference: reference}
+	if err := c.listUnpacked(stagingDir, ".", manifest); err != nil {
+		return fmt.Errorf("listing unpacked contents: %w", err)
+	}
+
+	if err := c.removeUnpackedOCIConfig(mountPath); err != nil {
+		return fmt.Errorf("%w: %w", deviceerrors.ErrDeletingFilesFailed, err)
+	}
+
+	// only directories created by the agent are removed with the provider
+	dirs := manifest.Dirs
+	manifest.Dirs = nil
+	for _, dir := range append([]string{"."}, dirs...) {
+		exists, err := c.deviceWriter.PathExists(filepath.Join(mountPath, dir))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			manifest.Dirs = append(manifest.Dirs, dir)
+		}
+	}
+
+	if err := c.deviceWriter.CopyDir(stagingDir, mountPath, fileio.WithPreserveSymlinkWithinRoot()); err != nil {
+		return fmt.Errorf("copying unpacked contents: %w", err)
+	}
+	return c.writeOCIManifest(manifest)
+}
+
+// listUnpacked adds the entries below dir of the staging directory to the manifest.
+func (c *Controller) listUnpacked(stagingDir, dir string, manifest *ociManifest) error {
+	entries, err := c.deviceWriter.ReadDir(filepath.Join(stagingDir, dir))
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		relPath := filepath.Join(dir, entry.Name())
+		if entry.IsDir() {
+			manifest.Dirs = append(manifest.Dirs, relPath)
+			if err := c.listUnpacked(stagingDir, relPath, manifest); err != nil {
+				return err
+			}
+			continue
+		}
+		manifest.Files = append(manifest.Files, relPath)
+	}
+	return nil
+}
+
+// removeUnpackedOCIConfig removes the files recorded in the manifest of the mount path and the
+// directories the agent created, as long as they are empty.
+func (c *Controller) removeUnpackedOCIConfig(mountPath string) error {
+	manifest, err := c.readOCIManifest(mountPath)
+	if err != nil {
+		return err
+	}
+	if manifest == nil {
+		return nil
+	}
+
+	for _, file := range manifest.Files {
+		if err := c.deviceWriter.RemoveFile(filepath.Join(mountPath, file)); err != nil {
+			return err
+		}
+	}
+	// remove nested directories before their parents
+	dirs := slices.Clone(manifest.Dirs)
+	sort.Slice(dirs, func(i, j int) bool { return len(dirs[i]) > len(dirs[j]) })
+	for _, dir := range dirs {
+		if err := c.deviceWriter.RemoveFile(filepath.Join(mountPath, dir)); err != nil && !errors.Is(err, syscall.ENOTEMPTY) && !errors.Is(err, syscall.EEXIST) {
+			return err
+		}
+	}
+	return c.deviceWriter.RemoveFile(c.ociManifestPath(mountPath))
+}
+
+// ociManifestPath returns the path of the manifest of a mount path within the data directory.
+func (c *Controller) ociManifestPath(mountPath string) string {
+	sum := sha256.Sum256([]byte(filepath.Clean(mountPath)))
+	return filepath.Join(c.dataDir, ociManifestDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// readOCIManifest returns the manifest of the mount path, or nil if nothing was unpacked into it.
+func (c *Controller) readOCIManifest(mountPath string) (*ociManifest, error) {
+	manifestPath := c.ociManifestPath(mountPath)
+	exists, err := c.deviceWriter.PathExists(manifestPath)
+	if err != nil || !exists {
+		return nil, err
+	}
+	contents, err := c.deviceWriter.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	var manifest ociManifest
+	if err := json.Unmarshal(contents, &manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal OCI config manifest %s: %w", manifestPath, err)
+	}
+	return &manifest, nil
+}
+
+func (c *Controller) writeOCIManifest(manifest *ociManifest) error {
+	contents, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return c.deviceWriter.WriteFile(c.ociManifestPath(manifest.MountPath), contents, fileio.DefaultFilePermissions)
+}
+
+// ociProviders returns the OCI config providers of a rendered list of ConfigProviderSpecs.
+func ociProviders(configs *[]v1beta1.ConfigProviderSpec) ([]v1beta1.OciConfigProviderSpec, error) {
+	if configs == nil {
+		return nil, nil
+	}
+
+	var providers []v1beta1.OciConfigProviderSpec
+	for _, configItem := range *configs {
+		configType, err := configItem.Type()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get config type: %w", err)
+		}
+		if configType != v1beta1.OciConfigProviderType {
+			continue
+		}
+		provider, err := configItem.AsOciConfigProviderSpec()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert config to oci config: %w", err)
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
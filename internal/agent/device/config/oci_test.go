@@ -0,0 +1,124 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/dependency"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/poll"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testOCIReference = "quay.io/example/configs@sha256:4b2c5f2a1e8f9f6a1e4b7f0a2b9d8c7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a2b1c"
+
+func newOCIConfigProvider(require *require.Assertions, name, reference, mountPath string) v1beta1.ConfigProviderSpec {
+	var provider v1beta1.ConfigProviderSpec
+	spec := v1beta1.OciConfigProviderSpec{Name: name}
+	spec.OciRef.Repository = "configs"
+	spec.OciRef.Reference = reference
+	spec.OciRef.MountPath = mountPath
+	require.NoError(provider.FromOciConfigProviderSpec(spec))
+	return provider
+}
+
+func TestProviderSpecToFilesSkipsOCIProviders(t *testing.T) {
+	require := require.New(t)
+
+	configs := *testConfigProvider(require, 2)
+	configs = append(configs, newOCIConfigProvider(require, "bundle", testOCIReference, "/etc/example/bundle"))
+
+	files, err := ProviderSpecToFiles(&configs)
+	require.NoError(err)
+	require.Len(files, 2)
+}
+
+func TestCollectOCITargets(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resolver := dependency.NewMockPullConfigResolver(ctrl)
+	resolver.EXPECT().Options(gomock.Any()).Return(nil)
+
+	controller := NewController(nil, nil, resolver, "/var/lib/flightctl", log.NewPrefixLogger("test"))
+
+	configs := *testConfigProvider(require, 1)
+	configs = append(configs, newOCIConfigProvider(require, "bundle", testOCIReference, "/etc/example/bundle"))
+
+	collection, err := controller.CollectOCITargets(context.Background(), &v1beta1.DeviceSpec{}, &v1beta1.DeviceSpec{Config: &configs})
+	require.NoError(err)
+	targets := collection.Targets[v1beta1.CurrentProcessUsername]
+	require.Len(targets, 1)
+	require.Equal(dependency.OCITypePodmanArtifact, targets[0].Type)
+	require.Equal(testOCIReference, targets[0].Reference)
+}
+
+func TestEnsureOCIConfigs(t *testing.T) {
+	const updatedOCIReference = "quay.io/example/configs@sha256:9f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a39281706f5e4d3c2b1a0"
+
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rootDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(rootDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(rootDir)),
+	)
+	// the artifacts contain a file named after their digest and a nested directory
+	mockExec := executer.NewMockExecuter(ctrl)
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "--version").Return("podman version 5.5.0", "", 0).AnyTimes()
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "artifact", "extract", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, args ...string) (string, string, int) {
+			destination := filepath.Join(rootDir, args[3])
+			name := args[2][len(args[2])-6:] + ".conf"
+			require.NoError(os.WriteFile(filepath.Join(destination, name), []byte("content"), 0o600))
+			require.NoError(os.MkdirAll(filepath.Join(destination, "nested"), 0o755))
+			require.NoError(os.WriteFile(filepath.Join(destination, "nested", name), []byte("content"), 0o600))
+			return "", "", 0
+		}).Times(3)
+	podmanClient := client.NewPodman(log.NewPrefixLogger("test"), mockExec, readWriter, poll.Config{})
+	controller := NewController(readWriter, podmanClient, nil, "/var/lib/flightctl", log.NewPrefixLogger("test"))
+
+	// the shared mount path holds a file that was not unpacked by the agent
+	require.NoError(os.MkdirAll(filepath.Join(rootDir, "/etc/example"), 0o755))
+	require.NoError(os.WriteFile(filepath.Join(rootDir, "/etc/example/local.conf"), []byte("local"), 0o600))
+
+	initial := []v1beta1.ConfigProviderSpec{
+		newOCIConfigProvider(require, "shared", testOCIReference, "/etc/example"),
+		newOCIConfigProvider(require, "owned", testOCIReference, "/etc/example-owned"),
+	}
+	require.NoError(controller.ensureOCIConfigs(context.Background(), &[]v1beta1.ConfigProviderSpec{}, &initial))
+	require.FileExists(filepath.Join(rootDir, "/etc/example/3a2b1c.conf"))
+	require.FileExists(filepath.Join(rootDir, "/etc/example/nested/3a2b1c.conf"))
+	require.FileExists(filepath.Join(rootDir, "/etc/example-owned/3a2b1c.conf"))
+
+	// an unchanged provider is not unpacked again
+	require.NoError(controller.ensureOCIConfigs(context.Background(), &initial, &initial))
+
+	// an update replaces only the unpacked files
+	updated := []v1beta1.ConfigProviderSpec{
+		newOCIConfigProvider(require, "shared", updatedOCIReference, "/etc/example"),
+		newOCIConfigProvider(require, "owned", testOCIReference, "/etc/example-owned"),
+	}
+	require.NoError(controller.ensureOCIConfigs(context.Background(), &initial, &updated))
+	require.NoFileExists(filepath.Join(rootDir, "/etc/example/3a2b1c.conf"))
+	require.FileExists(filepath.Join(rootDir, "/etc/example/c2b1a0.conf"))
+	require.FileExists(filepath.Join(rootDir, "/etc/example/nested/c2b1a0.conf"))
+	require.FileExists(filepath.Join(rootDir, "/etc/example/local.conf"))
+
+	// removing the providers keeps the files and directories that were not created by the agent
+	require.NoError(controller.ensureOCIConfigs(context.Background(), &updated, &[]v1beta1.ConfigProviderSpec{}))
+	require.NoFileExists(filepath.Join(rootDir, "/etc/example/c2b1a0.conf"))
+	require.NoDirExists(filepath.Join(rootDir, "/etc/example/nested"))
+	require.FileExists(filepath.Join(rootDir, "/etc/example/local.conf"))
+	require.NoDirExists(filepath.Join(rootDir, "/etc/example-owned"))
+}
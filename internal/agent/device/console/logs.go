@@ -0,0 +1,152 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/samber/lo"
+)
+
+const (
+	journalctlCmd = "/usr/bin/journalctl"
+	podmanCmd     = "podman"
+	// journalctlTimeFormat is the local time format accepted by journalctl --since.
+	journalctlTimeFormat = "2006-01-02 15:04:05"
+)
+
+// buildLogsCommand returns the command streaming the logs requested by a logs session. Without an
+// application, the journal of the requested systemd units is streamed. With no unit either, the whole
+// journal is streamed.
+func (s *session) buildLogsCommand(ctx context.Context, request *v1beta1.DeviceLogsRequest) (*exec.Cmd, error) {
+	since, err := request.SinceTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if request.Application != "" {
+		return s.buildApplicationLogsCommand(ctx, request, since)
+	}
+
+	args := []string{"--no-pager", "--output", "short-iso"}
+	if request.Follow {
+		args = append(args, "--follow")
+	}
+	if since != nil {
+		args = append(args, "--since", since.Local().Format(journalctlTimeFormat))
+	}
+	if request.Tail != nil {
+		args = append(args, "--lines", strconv.FormatInt(*request.Tail, 10))
+	}
+	for _, unit := range request.Units {
+		args = append(args, "--unit="+unit)
+	}
+	return s.executor.CommandContext(ctx, journalctlCmd, args...), nil
+}
+
+// buildApplicationLogsCommand returns the command streaming the logs of the containers of an application
+// of the device spec. The containers are looked up by the project label set by the agent when the
+// application was started.
+func (s *session) buildApplicationLogsCommand(ctx context.Context, request *v1beta1.DeviceLogsRequest, since *time.Time) (*exec.Cmd, error) {
+	appSpec, appType, err := s.findApplication(request.Application)
+	if err != nil {
+		return nil, err
+	}
+
+	var labelKey string
+	switch appType {
+	case v1beta1.AppTypeCompose:
+		labelKey = client.ComposeDockerProjectLabelKey
+	case v1beta1.AppTypeContainer, v1beta1.AppTypeQuadlet:
+		labelKey = client.QuadletProjectLabelKey
+	default:
+		return nil, fmt.Errorf("logs are not supported for application %q of type %s", request.Application, appType)
+	}
+
+	user, err := provider.ResolveUser(appSpec)
+	if err != nil {
+		return nil, fmt.Errorf("resolving user of application %q: %w", request.Application, err)
+	}
+	executor := s.executor
+	if !user.IsCurrentProcessUser() {
+		executor, err = client.ExecuterForUser(user)
+		if err != nil {
+			return nil, fmt.Errorf("creating executer for user %s: %w", user, err)
+		}
+	}
+
+	appID := lifecycle.GenerateAppID(request.Application, user)
+	containers, err := listContainers(ctx, executor, fmt.Sprintf("%s=%s", labelKey, appID))
+	if err != nil {
+		return nil, fmt.Errorf("listing containers of application %q: %w", request.Application, err)
+	}
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("application %q has no containers", request.Application)
+	}
+
+	args := []string{"logs", "--names"}
+	if request.Follow {
+		args = append(args, "--follow")
+	}
+	if since != nil {
+		args = append(args, "--since", since.Format(time.RFC3339))
+	}
+	if request.Tail != nil {
+		args = append(args, "--tail", strconv.FormatInt(*request.Tail, 10))
+	}
+	args = append(args, containers...)
+	return executor.CommandContext(ctx, podmanCmd, args...), nil
+}
+
+// findApplication returns the application of the device spec with the given name and its type.
+func (s *session) findApplication(name string) (*v1beta1.ApplicationProviderSpec, v1beta1.AppType, error) {
+	for i := range s.applications {
+		app := &s.applications[i]
+		appType, err := app.GetAppType()
+		if err != nil {
+			return nil, "", err
+		}
+		appName, err := applicationName(app, appType)
+		if err != nil {
+			return nil, "", err
+		}
+		if appName == name {
+			return app, appType, nil
+		}
+	}
+	return nil, "", fmt.Errorf("application %q is not defined in the device spec", name)
+}
+
+// applicationName returns the name of the application, which for container applications defaults to
+// the image.
+func applicationName(app *v1beta1.ApplicationProviderSpec, appType v1beta1.AppType) (string, error) {
+	if appType == v1beta1.AppTypeContainer {
+		containerApp, err := app.AsContainerApplication()
+		if err != nil {
+			return "", err
+		}
+		return lo.CoalesceOrEmpty(lo.FromPtr(containerApp.Name), containerApp.Image), nil
+	}
+	name, err := app.GetName()
+	if err != nil {
+		return "", err
+	}
+	return lo.FromPtr(name), nil
+}
+
+// listContainers returns the names of the containers, running or not, with the given label.
+func listContainers(ctx context.Context, executor executer.Executer, label string) ([]string, error) {
+	stdout, stderr, exitCode := executor.ExecuteWithContext(ctx, podmanCmd, "ps", "--all",
+		"--filter", "label="+label, "--format", "{{.Names}}")
+	if exitCode != 0 {
+		return nil, fmt.Errorf("podman ps: %s", strings.TrimSpace(stderr))
+	}
+	return strings.Fields(stdout), nil
+}
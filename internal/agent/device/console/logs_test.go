@@ -0,0 +1,112 @@
+package console
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBuildLogsCommand(t *testing.T) {
+	containerApp := v1beta1.ContainerApplication{
+		AppType: v1beta1.AppTypeContainer,
+		Image:   "quay.io/example/web:v1",
+	}
+	var containerAppSpec v1beta1.ApplicationProviderSpec
+	require.NoError(t, containerAppSpec.FromContainerApplication(containerApp))
+
+	helmApp := v1beta1.HelmApplication{
+		AppType: v1beta1.AppTypeHelm,
+		Name:    lo.ToPtr("chart"),
+		Image:   "quay.io/example/chart:v1",
+	}
+	var helmAppSpec v1beta1.ApplicationProviderSpec
+	require.NoError(t, helmAppSpec.FromHelmApplication(helmApp))
+
+	containerLabel := "label=" + client.QuadletProjectLabelKey + "=" + lifecycle.GenerateAppID("quay.io/example/web:v1", v1beta1.CurrentProcessUsername)
+
+	tests := []struct {
+		name          string
+		request       v1beta1.DeviceLogsRequest
+		setupMocks    func(mockExec *executer.MockExecuter)
+		wantCommand   string
+		wantArgs      []string
+		errorContains string
+	}{
+		{
+			name:    "journal of units",
+			request: v1beta1.DeviceLogsRequest{Units: []string{"flightctl-agent.service", "crio.service"}, Follow: true, Tail: lo.ToPtr(int64(20))},
+			setupMocks: func(mockExec *executer.MockExecuter) {
+				mockExec.EXPECT().CommandContext(gomock.Any(), journalctlCmd,
+					"--no-pager", "--output", "short-iso", "--follow", "--lines", "20",
+					"--unit=flightctl-agent.service", "--unit=crio.service")
+			},
+		},
+		{
+			name:    "containers of application named after its image",
+			request: v1beta1.DeviceLogsRequest{Application: "quay.io/example/web:v1", Since: "2025-01-02T03:04:05Z"},
+			setupMocks: func(mockExec *executer.MockExecuter) {
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), podmanCmd, "ps", "--all", "--filter", containerLabel, "--format", "{{.Names}}").
+					Return("web-1\nweb-2\n", "", 0)
+				mockExec.EXPECT().CommandContext(gomock.Any(), podmanCmd,
+					"logs", "--names", "--since", "2025-01-02T03:04:05Z", "web-1", "web-2")
+			},
+		},
+		{
+			name:    "application without containers",
+			request: v1beta1.DeviceLogsRequest{Application: "quay.io/example/web:v1"},
+			setupMocks: func(mockExec *executer.MockExecuter) {
+				mockExec.EXPECT().ExecuteWithContext(gomock.Any(), podmanCmd, "ps", "--all", "--filter", containerLabel, "--format", "{{.Names}}").
+					Return("", "", 0)
+			},
+			errorContains: "has no containers",
+		},
+		{
+			name:          "unknown application",
+			request:       v1beta1.DeviceLogsRequest{Application: "missing"},
+			errorContains: "is not defined in the device spec",
+		},
+		{
+			name:          "helm application",
+			request:       v1beta1.DeviceLogsRequest{Application: "chart"},
+			errorContains: "not supported",
+		},
+		{
+			name:          "invalid since",
+			request:       v1beta1.DeviceLogsRequest{Since: "yesterday"},
+			errorContains: "since must be",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockExec := executer.NewMockExecuter(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockExec)
+			}
+			s := &session{
+				id:           "session",
+				log:          log.NewPrefixLogger("test"),
+				executor:     mockExec,
+				applications: []v1beta1.ApplicationProviderSpec{containerAppSpec, helmAppSpec},
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_, err := s.buildLogsCommand(ctx, &tt.request)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
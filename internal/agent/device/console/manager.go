@@ -119,12 +119,13 @@ func (c *Manager) selectProtocol(requestedProtocols []string) (string, error) {
 	return "", fmt.Errorf("none of the protocols %v are supported", requestedProtocols)
 }
 
-func (c *Manager) start(ctx context.Context, dc v1beta1.DeviceConsole) {
+func (c *Manager) start(ctx context.Context, dc v1beta1.DeviceConsole, applications []v1beta1.ApplicationProviderSpec) {
 	s := &session{
-		id:       dc.SessionID,
-		executor: c.executor,
-		log:      c.log,
-		user:     c.user,
+		id:           dc.SessionID,
+		executor:     c.executor,
+		log:          c.log,
+		user:         c.user,
+		applications: applications,
 	}
 	if !c.add(s) {
 		return
@@ -138,6 +139,10 @@ func (c *Manager) start(ctx context.Context, dc v1beta1.DeviceConsole) {
 		c.log.Errorf("failed to parse session metadata %s: %v", dc.SessionMetadata, err)
 		return
 	}
+	if sessionMetadata.Logs != nil {
+		// logs sessions only stream output
+		sessionMetadata.TTY = false
+	}
 
 	// add key-value pairs of metadata to context
 	ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcSessionIDKey, s.id)
@@ -163,9 +168,10 @@ func (c *Manager) sync(ctx context.Context, desired *v1beta1.DeviceSpec) {
 	defer c.log.Debug("Finished syncing console status")
 
 	desiredConsoles := desired.GetConsoles()
+	applications := lo.FromPtr(desired.Applications)
 
 	for _, d := range desiredConsoles {
-		go c.start(ctx, d)
+		go c.start(ctx, d, applications)
 	}
 }
 
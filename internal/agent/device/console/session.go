@@ -2,6 +2,7 @@ package console
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os/exec"
@@ -14,6 +15,7 @@ import (
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type session struct {
@@ -23,6 +25,9 @@ type session struct {
 	executor          executer.Executer
 	inactiveTimestamp time.Time
 	user              string
+	// applications of the device spec the session was requested with, used to look up the containers
+	// of an application in logs sessions
+	applications []api.ApplicationProviderSpec
 }
 
 func (s *session) getHomedir() (string, error) {
@@ -124,7 +129,15 @@ func (s *session) startProcess(metadata *api.DeviceConsoleSessionMetadata, cmd *
 }
 
 func (s *session) initialize(ctx context.Context, cancel context.CancelFunc, metadata *api.DeviceConsoleSessionMetadata) (*incomingStreams, *outgoingStreams, error) {
-	cmd := s.buildBashCommand(ctx, metadata)
+	var cmd *exec.Cmd
+	if metadata.Logs != nil {
+		var err error
+		if cmd, err = s.buildLogsCommand(ctx, metadata.Logs); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		cmd = s.buildBashCommand(ctx, metadata)
+	}
 	stdin, stdout, stderr, resizeFd, err := s.startProcess(metadata, cmd)
 	if err != nil {
 		return nil, nil, err
@@ -152,6 +165,7 @@ func (s *session) run(ctx context.Context, metadata *api.DeviceConsoleSessionMet
 	iStreams, oStreams, err := s.initialize(ctx, cancel, metadata)
 	if err != nil {
 		s.log.WithError(err).Errorf("initializing console session")
+		s.sendFailure(err)
 		return
 	}
 	var wg sync.WaitGroup
@@ -159,3 +173,18 @@ func (s *session) run(ctx context.Context, metadata *api.DeviceConsoleSessionMet
 	oStreams.start(&wg)
 	wg.Wait()
 }
+
+// sendFailure reports to the client that the session could not be started.
+func (s *session) sendFailure(err error) {
+	b, marshalErr := json.Marshal(&metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	})
+	if marshalErr != nil {
+		s.log.Errorf("failed to marshal status: %v", marshalErr)
+		return
+	}
+	if sendErr := s.streamClient.Send(&grpc_v1.StreamRequest{Payload: append([]byte{ErrID}, b...)}); sendErr != nil && sendErr != io.EOF {
+		s.log.Errorf("failed sending session failure: %v", sendErr)
+	}
+}
@@ -52,7 +52,11 @@ type Agent struct {
 	pullConfigResolver     dependency.PullConfigResolver
 	pruningManager         imagepruning.Manager
 
-	statusUpdateInterval util.Duration
+	statusUpdateInterval      util.Duration
+	updateVerificationTimeout util.Duration
+	// lastStatusSyncTime is the last time the device status reached the
+	// management service.
+	lastStatusSyncTime time.Time
 
 	backoff wait.Backoff
 	log     *log.PrefixLogger
@@ -68,6 +72,7 @@ func NewAgent(
 	appManager applications.Manager,
 	systemdManager systemd.Manager,
 	statusUpdateInterval util.Duration,
+	updateVerificationTimeout util.Duration,
 	hookManager hook.Manager,
 	osManager os.Manager,
 	policyManager policy.Manager,
@@ -85,29 +90,30 @@ func NewAgent(
 	log *log.PrefixLogger,
 ) *Agent {
 	return &Agent{
-		name:                   name,
-		systemdClient:          systemdClient,
-		deviceWriter:           deviceWriter,
-		statusManager:          statusManager,
-		specManager:            specManager,
-		hookManager:            hookManager,
-		osManager:              osManager,
-		policyManager:          policyManager,
-		lifecycleManager:       lifecycleManager,
-		appManager:             appManager,
-		systemdManager:         systemdManager,
-		statusUpdateInterval:   statusUpdateInterval,
-		applicationsController: applicationsController,
-		configController:       configController,
-		resourceManager:        resourceManager,
-		consoleManager:         consoleManager,
-		osClient:               osClient,
-		podmanClient:           podmanClient,
-		prefetchManager:        prefetchManager,
-		pullConfigResolver:     pullConfigResolver,
-		pruningManager:         pruningManager,
-		backoff:                backoff,
-		log:                    log,
+		name:                      name,
+		systemdClient:             systemdClient,
+		deviceWriter:              deviceWriter,
+		statusManager:             statusManager,
+		specManager:               specManager,
+		hookManager:               hookManager,
+		osManager:                 osManager,
+		policyManager:             policyManager,
+		lifecycleManager:          lifecycleManager,
+		appManager:                appManager,
+		systemdManager:            systemdManager,
+		statusUpdateInterval:      statusUpdateInterval,
+		updateVerificationTimeout: updateVerificationTimeout,
+		applicationsController:    applicationsController,
+		configController:          configController,
+		resourceManager:           resourceManager,
+		consoleManager:            consoleManager,
+		osClient:                  osClient,
+		podmanClient:              podmanClient,
+		prefetchManager:           prefetchManager,
+		pullConfigResolver:        pullConfigResolver,
+		pruningManager:            pruningManager,
+		backoff:                   backoff,
+		log:                       log,
 	}
 }
 
@@ -149,6 +155,11 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 		a.log.Tracef("Completed sync of device spec in %v", duration)
 	}()
 
+	// a rolled back update is reconciled on the next sync
+	if a.verifyUpdate(ctx) {
+		return
+	}
+
 	desired, requeue, err := a.specManager.GetDesired(ctx)
 	if err != nil {
 		a.log.Errorf("Failed to get desired spec: %v", err)
@@ -240,6 +251,11 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 		if err := a.updatedStatus(ctx, desired); err != nil {
 			a.log.Warnf("Failed updating status: %v", err)
 		}
+		// defer pruning until the update is verified so that the device can
+		// still roll back to the previous images.
+		if a.startUpdateVerification(ctx, current) {
+			return
+		}
 	} else {
 		a.log.Debug("No upgrade in progress, skipping status update")
 		if !a.pruningManager.PrunePending() {
@@ -323,7 +339,9 @@ func (a *Agent) statusUpdate(ctx context.Context) {
 
 	if err := a.statusManager.Sync(ctx); err != nil {
 		a.log.Errorf("Syncing status: %v", err)
+		return
 	}
+	a.lastStatusSyncTime = time.Now()
 }
 
 func (a *Agent) beforeUpdate(ctx context.Context, current, desired *v1beta1.Device) error {
@@ -353,6 +371,7 @@ func (a *Agent) beforeUpdate(ctx context.Context, current, desired *v1beta1.Devi
 	a.pullConfigResolver.BeforeUpdate(desired.Spec)
 
 	a.prefetchManager.RegisterOCICollector(a.appManager)
+	a.prefetchManager.RegisterOCICollector(a.configController)
 	if a.specManager.IsOSUpdate() {
 		a.prefetchManager.RegisterOCICollector(a.osManager)
 	}
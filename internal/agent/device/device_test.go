@@ -107,6 +107,8 @@ func TestSync(t *testing.T) {
 				mockSpecManager.EXPECT().CheckPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 				// GetDesired, Read, and BeforeUpdate may be called multiple times if syncDeviceSpec is called again
+				// no update is being verified
+				mockSpecManager.EXPECT().Verification().Return(nil, nil).AnyTimes()
 				mockSpecManager.EXPECT().GetDesired(ctx).Return(desired, false, nil).AnyTimes()
 				mockSpecManager.EXPECT().Read(spec.Current).Return(current, nil).AnyTimes()
 				mockResourceManager.EXPECT().BeforeUpdate(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -199,7 +201,7 @@ func TestSync(t *testing.T) {
 			appController := applications.NewController(podmanFactory, nil, mockAppManager, rwFactory, log, "2025-01-01T00:00:00Z")
 			statusManager := status.NewManager(deviceName, log)
 			statusManager.SetClient(mockManagementClient)
-			configController := config.NewController(readWriter, log)
+			configController := config.NewController(readWriter, podmanClient, nil, "/var/lib/flightctl", log)
 
 			agent := Agent{
 				log:                    log,
@@ -424,3 +426,66 @@ func (m *mockSync) sync(ctx context.Context, currentSpec *v1beta1.Device, desire
 	}
 	return nil
 }
+
+func TestUpdateVerificationResult(t *testing.T) {
+	startedAt := time.Now().Add(-time.Minute)
+	timeout := 5 * time.Minute
+	testCases := []struct {
+		name           string
+		now            time.Time
+		lastStatusSync time.Time
+		appsStatus     v1beta1.ApplicationsSummaryStatusType
+		wantVerified   bool
+		wantReason     string
+	}{
+		{
+			name:           "healthy and connected",
+			now:            startedAt.Add(time.Minute),
+			lastStatusSync: startedAt.Add(time.Second),
+			appsStatus:     v1beta1.ApplicationsSummaryStatusHealthy,
+			wantVerified:   true,
+		},
+		{
+			name:           "no applications and connected",
+			now:            startedAt.Add(time.Minute),
+			lastStatusSync: startedAt.Add(time.Second),
+			appsStatus:     v1beta1.ApplicationsSummaryStatusNoApplications,
+			wantVerified:   true,
+		},
+		{
+			name:           "degraded before timeout",
+			now:            startedAt.Add(time.Minute),
+			lastStatusSync: startedAt.Add(time.Second),
+			appsStatus:     v1beta1.ApplicationsSummaryStatusDegraded,
+		},
+		{
+			name:           "degraded after timeout",
+			now:            startedAt.Add(timeout),
+			lastStatusSync: startedAt.Add(time.Second),
+			appsStatus:     v1beta1.ApplicationsSummaryStatusDegraded,
+			wantReason:     "update was not verified within 5m0s: applications are Degraded",
+		},
+		{
+			name:           "disconnected after timeout",
+			now:            startedAt.Add(timeout),
+			lastStatusSync: startedAt.Add(-time.Second),
+			appsStatus:     v1beta1.ApplicationsSummaryStatusHealthy,
+			wantReason:     "update was not verified within 5m0s: the management service was not reachable",
+		},
+		{
+			name:       "unhealthy and disconnected after timeout",
+			now:        startedAt.Add(timeout),
+			appsStatus: v1beta1.ApplicationsSummaryStatusError,
+			wantReason: "update was not verified within 5m0s: applications are Error and the management service was not reachable",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			verified, reason := updateVerificationResult(tc.now, startedAt, timeout, tc.lastStatusSync, tc.appsStatus)
+			require.Equal(tc.wantVerified, verified)
+			require.Equal(tc.wantReason, reason)
+		})
+	}
+}
@@ -192,6 +192,7 @@ var (
 	ErrValidatingQuadletSpec = errors.New("validating quadlet spec")
 	ErrRemovingApplication   = errors.New("removing application")
 	ErrInstallingApplication = errors.New("installing application")
+	ErrRolledBackApplication = errors.New("rolled back application")
 	ErrCopyingImage          = errors.New("copying image")
 
 	ErrPermissionDenied   = os.ErrPermission
@@ -374,6 +375,7 @@ var (
 		ErrValidatingQuadletSpec: codes.InvalidArgument,
 		ErrRemovingApplication:   codes.Internal,
 		ErrInstallingApplication: codes.Internal,
+		ErrRolledBackApplication: codes.Internal,
 		ErrCopyingImage:          codes.Unavailable,
 
 		// error message patterns
@@ -0,0 +1,425 @@
+package resource
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/userutil"
+)
+
+const (
+	DefaultCgroupRoot = "/sys/fs/cgroup"
+)
+
+// AppMonitor samples the resource usage of the slices that the workloads of
+// applications run in and evaluates the alert rules of the applications.
+type AppMonitor struct {
+	mu   sync.Mutex
+	apps map[string]*appMonitor
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+	cgroupRoot       string
+	memInfoPath      string
+	lookupUID        func(user v1beta1.Username) (uint32, error)
+
+	log *log.PrefixLogger
+}
+
+// appMonitor is the monitored state of a single application.
+type appMonitor struct {
+	name string
+	// cgroup is the path of the slice of the application relative to the cgroup root.
+	cgroup string
+	// cpuCores and memoryBytes are the limits of the application, zero if unlimited.
+	cpuCores     float64
+	memoryBytes  int64
+	cpuAlerts    map[v1beta1.ResourceAlertSeverityType]*Alert
+	memoryAlerts map[v1beta1.ResourceAlertSeverityType]*Alert
+
+	prevCPUUsec uint64
+	prevSample  time.Time
+	usage       *v1beta1.ApplicationResourceUsage
+}
+
+func NewAppMonitor(
+	log *log.PrefixLogger,
+) *AppMonitor {
+	return &AppMonitor{
+		apps:             make(map[string]*appMonitor),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		cgroupRoot:       DefaultCgroupRoot,
+		memInfoPath:      DefaultProcMemInfoPath,
+		lookupUID: func(user v1beta1.Username) (uint32, error) {
+			uid, _, _, err := userutil.LookupUser(user)
+			return uid, err
+		},
+		log: log,
+	}
+}
+
+func (m *AppMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.getSamplingInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking application resource usage")
+			m.sync(time.Now())
+		}
+	}
+}
+
+// Update syncs the monitored applications with the resources of the
+// applications of the desired spec.
+func (m *AppMonitor) Update(desired *v1beta1.DeviceSpec) error {
+	specs, err := appResourceSpecs(desired)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	samplingInterval := DefaultSamplingInterval
+	hasInterval := false
+	apps := make(map[string]*appMonitor, len(specs))
+	for _, spec := range specs {
+		app, err := m.newAppMonitor(spec)
+		if err != nil {
+			return fmt.Errorf("application %s: %w", spec.name, err)
+		}
+		// keep the samples and the firing state of unchanged applications
+		if existing, ok := m.apps[spec.name]; ok && existing.cgroup == app.cgroup {
+			app.prevCPUUsec = existing.prevCPUUsec
+			app.prevSample = existing.prevSample
+			app.usage = existing.usage
+			app.cpuAlerts = existing.cpuAlerts
+			app.memoryAlerts = existing.memoryAlerts
+		}
+
+		var cpuRules, memoryRules []v1beta1.ResourceAlertRule
+		if monitor := spec.resources.Monitor; monitor != nil {
+			interval, err := time.ParseDuration(monitor.SamplingInterval)
+			if err != nil {
+				return fmt.Errorf("application %s: %w", spec.name, err)
+			}
+			if !hasInterval || interval < samplingInterval {
+				samplingInterval = interval
+				hasInterval = true
+			}
+			if monitor.Cpu != nil {
+				cpuRules = *monitor.Cpu
+			}
+			if monitor.Memory != nil {
+				memoryRules = *monitor.Memory
+			}
+		}
+		if _, err := updateAlerts(cpuRules, app.cpuAlerts); err != nil {
+			return fmt.Errorf("application %s: %w", spec.name, err)
+		}
+		if _, err := updateAlerts(memoryRules, app.memoryAlerts); err != nil {
+			return fmt.Errorf("application %s: %w", spec.name, err)
+		}
+		apps[spec.name] = app
+	}
+	m.apps = apps
+
+	if m.samplingInterval != samplingInterval {
+		m.log.Infof("Updating application sampling interval from %s to %s", m.samplingInterval, samplingInterval)
+		m.samplingInterval = samplingInterval
+		// replace a pending interval that was not picked up yet
+		select {
+		case <-m.updateIntervalCh:
+		default:
+		}
+		m.updateIntervalCh <- samplingInterval
+	}
+	return nil
+}
+
+// Usage returns the last sampled resource usage of an application.
+func (m *AppMonitor) Usage(name string) (*v1beta1.ApplicationResourceUsage, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	app, ok := m.apps[name]
+	if !ok || app.usage == nil {
+		return nil, false
+	}
+	usage := *app.usage
+	return &usage, true
+}
+
+// Alerts returns the firing CPU and memory alerts of an application.
+func (m *AppMonitor) Alerts(name string) (cpu []v1beta1.ResourceAlertRule, memory []v1beta1.ResourceAlertRule) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	app, ok := m.apps[name]
+	if !ok {
+		return nil, nil
+	}
+	return firingAlerts(app.cpuAlerts), firingAlerts(app.memoryAlerts)
+}
+
+func (m *AppMonitor) sync(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.apps) == 0 {
+		return
+	}
+
+	var memTotal int64
+	for _, app := range m.apps {
+		if app.memoryBytes == 0 && memTotal == 0 {
+			usage := &MemoryUsage{}
+			if err := m.collectMemInfo(usage); err != nil {
+				m.log.Errorf("Failed to collect memory usage: %v", err)
+			}
+			memTotal = int64(usage.MemTotal) * 1024
+		}
+		if err := m.syncApp(app, now, memTotal); err != nil {
+			// the slice does not exist while the application is not running
+			m.log.Debugf("Failed to collect resource usage of application %s: %v", app.name, err)
+		}
+	}
+}
+
+func (m *AppMonitor) syncApp(app *appMonitor, now time.Time, memTotal int64) error {
+	dir := filepath.Join(m.cgroupRoot, app.cgroup)
+	cpuUsec, err := readCPUUsageUsec(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return err
+	}
+	memoryBytes, err := readCgroupInt(filepath.Join(dir, "memory.current"))
+	if err != nil {
+		return err
+	}
+
+	cores := app.cpuCores
+	if cores == 0 {
+		cores = float64(runtime.NumCPU())
+	}
+	memoryLimit := app.memoryBytes
+	if memoryLimit == 0 {
+		memoryLimit = memTotal
+	}
+
+	usage := &v1beta1.ApplicationResourceUsage{
+		MemoryBytes: memoryBytes,
+	}
+	if app.usage != nil {
+		usage.CpuPercentage = app.usage.CpuPercentage
+	}
+	if !app.prevSample.IsZero() && cpuUsec >= app.prevCPUUsec {
+		elapsed := now.Sub(app.prevSample).Microseconds()
+		if elapsed > 0 {
+			usage.CpuPercentage = float32(roundPercentage(float64(cpuUsec-app.prevCPUUsec) / (float64(elapsed) * cores) * 100))
+		}
+	}
+	if memoryLimit > 0 {
+		usage.MemoryPercentage = float32(roundPercentage(float64(memoryBytes) / float64(memoryLimit) * 100))
+	}
+	app.prevCPUUsec = cpuUsec
+	app.prevSample = now
+
+	for _, alert := range app.cpuAlerts {
+		alert.Sync(int64(usage.CpuPercentage))
+	}
+	for _, alert := range app.memoryAlerts {
+		alert.Sync(int64(usage.MemoryPercentage))
+	}
+	usage.Cpu, _ = getHighestSeverityResourceStatusFromAlerts(CPUMonitorType, firingAlerts(app.cpuAlerts))
+	usage.Memory, _ = getHighestSeverityResourceStatusFromAlerts(MemoryMonitorType, firingAlerts(app.memoryAlerts))
+	if usage.Cpu == "" {
+		usage.Cpu = v1beta1.DeviceResourceStatusHealthy
+	}
+	if usage.Memory == "" {
+		usage.Memory = v1beta1.DeviceResourceStatusHealthy
+	}
+	app.usage = usage
+	return nil
+}
+
+func (m *AppMonitor) collectMemInfo(usage *MemoryUsage) error {
+	file, err := os.ReadFile(m.memInfoPath)
+	if err != nil {
+		return err
+	}
+	return parseMemStats(strings.Split(string(file), "\n"), usage)
+}
+
+func (m *AppMonitor) newAppMonitor(spec appResourceSpec) (*appMonitor, error) {
+	cgroup, err := m.appCgroup(spec)
+	if err != nil {
+		return nil, err
+	}
+	app := &appMonitor{
+		name:         spec.name,
+		cgroup:       cgroup,
+		cpuAlerts:    make(map[v1beta1.ResourceAlertSeverityType]*Alert),
+		memoryAlerts: make(map[v1beta1.ResourceAlertSeverityType]*Alert),
+	}
+	if limits := spec.resources.Limits; limits != nil {
+		if limits.Cpu != nil {
+			if app.cpuCores, err = lifecycle.ParseCPULimit(*limits.Cpu); err != nil {
+				return nil, err
+			}
+		}
+		if limits.Memory != nil {
+			if app.memoryBytes, err = lifecycle.ParseMemoryLimit(*limits.Memory); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return app, nil
+}
+
+// appCgroup returns the cgroup of the slice of an application relative to the
+// cgroup root. The slices of rootless applications are managed by the systemd
+// instance of their user.
+func (m *AppMonitor) appCgroup(spec appResourceSpec) (string, error) {
+	slice := filepath.Join(lifecycle.AppSliceParent, lifecycle.AppSliceName(lifecycle.GenerateAppID(spec.name, spec.user)))
+	if spec.user.IsCurrentProcessUser() || spec.user.IsRootUser() {
+		return slice, nil
+	}
+	uid, err := m.lookupUID(spec.user)
+	if err != nil {
+		return "", fmt.Errorf("looking up user %s: %w", spec.user, err)
+	}
+	return filepath.Join("user.slice", fmt.Sprintf("user-%d.slice", uid), fmt.Sprintf("user@%d.service", uid), slice), nil
+}
+
+func (m *AppMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+type appResourceSpec struct {
+	name      string
+	user      v1beta1.Username
+	resources *v1beta1.ApplicationResources
+}
+
+// appResourceSpecs returns the applications of a device spec that define
+// resources.
+func appResourceSpecs(desired *v1beta1.DeviceSpec) ([]appResourceSpec, error) {
+	if desired == nil || desired.Applications == nil {
+		return nil, nil
+	}
+
+	var specs []appResourceSpec
+	for _, appSpec := range *desired.Applications {
+		appType, err := appSpec.GetAppType()
+		if err != nil {
+			return nil, err
+		}
+
+		var spec appResourceSpec
+		var name *string
+		switch appType {
+		case v1beta1.AppTypeContainer:
+			app, err := appSpec.AsContainerApplication()
+			if err != nil {
+				return nil, err
+			}
+			name, spec.user, spec.resources = app.Name, app.RunAsWithDefault(), app.Resources
+			spec.name = app.Image
+		case v1beta1.AppTypeCompose:
+			app, err := appSpec.AsComposeApplication()
+			if err != nil {
+				return nil, err
+			}
+			name, spec.user, spec.resources = app.Name, v1beta1.CurrentProcessUsername, app.Resources
+			if imageSpec, err := app.AsImageApplicationProviderSpec(); err == nil {
+				spec.name = imageSpec.Image
+			}
+		case v1beta1.AppTypeQuadlet:
+			app, err := appSpec.AsQuadletApplication()
+			if err != nil {
+				return nil, err
+			}
+			name, spec.user, spec.resources = app.Name, app.RunAsWithDefault(), app.Resources
+			if imageSpec, err := app.AsImageApplicationProviderSpec(); err == nil {
+				spec.name = imageSpec.Image
+			}
+		default:
+			continue
+		}
+
+		if spec.resources == nil {
+			continue
+		}
+		// unnamed image applications are named after their image
+		if name != nil && *name != "" {
+			spec.name = *name
+		}
+		if spec.name == "" {
+			continue
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+func firingAlerts(alerts map[v1beta1.ResourceAlertSeverityType]*Alert) []v1beta1.ResourceAlertRule {
+	var firing []v1beta1.ResourceAlertRule
+	for _, alert := range alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// readCPUUsageUsec returns the total CPU time of a cgroup from its cpu.stat file.
+func readCPUUsageUsec(path string) (uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "usage_usec" {
+			return strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("usage_usec not found in %s", path)
+}
+
+func readCgroupInt(path string) (int64, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(contents)), 10, 64)
+}
+
+func roundPercentage(percentage float64) float64 {
+	return math.Round(percentage*100) / 100
+}
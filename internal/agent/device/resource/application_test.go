@@ -0,0 +1,165 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAppSpec(t *testing.T, name string, runAs v1beta1.Username, resources *v1beta1.ApplicationResources) *v1beta1.DeviceSpec {
+	t.Helper()
+	app := v1beta1.QuadletApplication{
+		AppType:   v1beta1.AppTypeQuadlet,
+		Name:      lo.ToPtr(name),
+		RunAs:     runAs,
+		Resources: resources,
+	}
+	require.NoError(t, app.FromImageApplicationProviderSpec(v1beta1.ImageApplicationProviderSpec{Image: "quay.io/app:v1"}))
+	var appSpec v1beta1.ApplicationProviderSpec
+	require.NoError(t, appSpec.FromQuadletApplication(app))
+	return &v1beta1.DeviceSpec{Applications: &[]v1beta1.ApplicationProviderSpec{appSpec}}
+}
+
+func testAppCgroup(name string, user v1beta1.Username) string {
+	return filepath.Join(lifecycle.AppSliceParent, lifecycle.AppSliceName(lifecycle.GenerateAppID(name, user)))
+}
+
+func writeAppCgroup(t *testing.T, dir string, cpuUsec string, memoryBytes string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cpu.stat"), []byte("usage_usec "+cpuUsec+"\nuser_usec 0\nsystem_usec 0\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.current"), []byte(memoryBytes+"\n"), 0600))
+}
+
+func TestAppMonitor(t *testing.T) {
+	require := require.New(t)
+
+	tmpDir := t.TempDir()
+	memInfoPath := filepath.Join(tmpDir, "meminfo")
+	require.NoError(os.WriteFile(memInfoPath, []byte(memoryInfoData), 0600))
+
+	m := NewAppMonitor(log.NewPrefixLogger("test"))
+	m.cgroupRoot = tmpDir
+	m.memInfoPath = memInfoPath
+
+	resources := &v1beta1.ApplicationResources{
+		Limits: &v1beta1.ApplicationResourceLimits{
+			Cpu:    lo.ToPtr("0.5"),
+			Memory: lo.ToPtr("100m"),
+		},
+		Monitor: &v1beta1.ApplicationResourceMonitor{
+			SamplingInterval: "10s",
+			Cpu: &[]v1beta1.ResourceAlertRule{
+				{Severity: v1beta1.ResourceAlertSeverityTypeWarning, Percentage: 40, Duration: "0s"},
+			},
+			Memory: &[]v1beta1.ResourceAlertRule{
+				{Severity: v1beta1.ResourceAlertSeverityTypeCritical, Percentage: 90, Duration: "0s"},
+			},
+		},
+	}
+	require.NoError(m.Update(newTestAppSpec(t, "web", "", resources)))
+	require.Equal(10*time.Second, m.getSamplingInterval())
+
+	_, ok := m.Usage("web")
+	require.False(ok, "usage is unknown before the first sample")
+
+	cgroupDir := filepath.Join(tmpDir, testAppCgroup("web", ""))
+	now := time.Now()
+	writeAppCgroup(t, cgroupDir, "1000000", "52428800")
+	m.sync(now)
+
+	usage, ok := m.Usage("web")
+	require.True(ok)
+	require.Equal(int64(52428800), usage.MemoryBytes)
+	require.Equal(float32(50), usage.MemoryPercentage)
+	require.Equal(float32(0), usage.CpuPercentage)
+
+	// 3s of CPU time in 10s with a limit of half a core
+	writeAppCgroup(t, cgroupDir, "4000000", "52428800")
+	m.sync(now.Add(10 * time.Second))
+	writeAppCgroup(t, cgroupDir, "7000000", "52428800")
+	m.sync(now.Add(20 * time.Second))
+
+	usage, ok = m.Usage("web")
+	require.True(ok)
+	require.Equal(float32(60), usage.CpuPercentage)
+	require.Equal(v1beta1.DeviceResourceStatusWarning, usage.Cpu)
+	require.Equal(v1beta1.DeviceResourceStatusHealthy, usage.Memory)
+
+	cpuAlerts, memoryAlerts := m.Alerts("web")
+	require.Len(cpuAlerts, 1)
+	require.Empty(memoryAlerts)
+
+	// the firing state is kept across updates of an unchanged application
+	require.NoError(m.Update(newTestAppSpec(t, "web", "", resources)))
+	cpuAlerts, _ = m.Alerts("web")
+	require.Len(cpuAlerts, 1)
+
+	// removed applications are no longer monitored
+	require.NoError(m.Update(&v1beta1.DeviceSpec{}))
+	_, ok = m.Usage("web")
+	require.False(ok)
+	require.Equal(DefaultSamplingInterval, m.getSamplingInterval())
+}
+
+func TestAppMonitorCgroup(t *testing.T) {
+	require := require.New(t)
+
+	m := NewAppMonitor(log.NewPrefixLogger("test"))
+	m.lookupUID = func(user v1beta1.Username) (uint32, error) {
+		require.Equal(v1beta1.Username("flightctl"), user)
+		return 1001, nil
+	}
+
+	resources := &v1beta1.ApplicationResources{}
+	require.NoError(m.Update(newTestAppSpec(t, "web", "", resources)))
+	require.Equal(testAppCgroup("web", ""), m.apps["web"].cgroup)
+
+	require.NoError(m.Update(newTestAppSpec(t, "web", "flightctl", resources)))
+	require.Equal(filepath.Join("user.slice/user-1001.slice/user@1001.service", testAppCgroup("web", "flightctl")), m.apps["web"].cgroup)
+}
+
+func TestResourceManagerApplicationStatus(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	tmpDir := t.TempDir()
+	manager := NewManager(log.NewPrefixLogger("test")).(*ResourceManager)
+	manager.appMonitor.cgroupRoot = tmpDir
+
+	resources := &v1beta1.ApplicationResources{
+		Monitor: &v1beta1.ApplicationResourceMonitor{
+			SamplingInterval: "1m",
+			Memory: &[]v1beta1.ResourceAlertRule{
+				{Severity: v1beta1.ResourceAlertSeverityTypeCritical, Percentage: 10, Duration: "0s"},
+			},
+		},
+		Limits: &v1beta1.ApplicationResourceLimits{Memory: lo.ToPtr("1m")},
+	}
+	require.NoError(manager.BeforeUpdate(ctx, newTestAppSpec(t, "web", "", resources)))
+
+	cgroupDir := filepath.Join(tmpDir, testAppCgroup("web", ""))
+	writeAppCgroup(t, cgroupDir, "0", "524288")
+	now := time.Now()
+	manager.appMonitor.sync(now)
+	manager.appMonitor.sync(now.Add(time.Minute))
+
+	status := v1beta1.NewDeviceStatus()
+	status.Applications = []v1beta1.DeviceApplicationStatus{{Name: "web"}, {Name: "other"}}
+	require.NoError(manager.Status(ctx, &status))
+
+	require.NotNil(status.Applications[0].Resources)
+	require.Equal(float32(50), status.Applications[0].Resources.MemoryPercentage)
+	require.Equal(v1beta1.DeviceResourceStatusCritical, status.Applications[0].Resources.Memory)
+	require.Nil(status.Applications[1].Resources)
+	require.Equal(v1beta1.DeviceSummaryStatusError, status.Summary.Status)
+	require.Contains(*status.Summary.Info, "Memory (app web)")
+}
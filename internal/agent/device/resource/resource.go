@@ -49,6 +49,7 @@ type ResourceManager struct {
 	cpuMonitor    Monitor[CPUUsage]
 	diskMonitor   Monitor[DiskUsage]
 	memoryMonitor Monitor[MemoryUsage]
+	appMonitor    *AppMonitor
 	log           *log.PrefixLogger
 }
 
@@ -60,6 +61,7 @@ func NewManager(
 		cpuMonitor:    NewCPUMonitor(log),
 		diskMonitor:   NewDiskMonitor(log),
 		memoryMonitor: NewMemoryMonitor(log),
+		appMonitor:    NewAppMonitor(log),
 		log:           log,
 	}
 }
@@ -71,6 +73,7 @@ func (m *ResourceManager) Run(ctx context.Context) {
 	go m.diskMonitor.Run(ctx)
 	go m.cpuMonitor.Run(ctx)
 	go m.memoryMonitor.Run(ctx)
+	go m.appMonitor.Run(ctx)
 
 	<-ctx.Done()
 }
@@ -195,6 +198,37 @@ func (m *ResourceManager) Status(ctx context.Context, status *v1beta1.DeviceStat
 		monitor.setStatusFn(resourceStatus)
 	}
 
+	// report the usage of the applications and their alerts
+	for i := range status.Applications {
+		app := &status.Applications[i]
+		usage, ok := m.appMonitor.Usage(app.Name)
+		if !ok {
+			continue
+		}
+		app.Resources = usage
+
+		cpuAlerts, memoryAlerts := m.appMonitor.Alerts(app.Name)
+		for monitorType, alerts := range map[string][]v1beta1.ResourceAlertRule{
+			CPUMonitorType:    cpuAlerts,
+			MemoryMonitorType: memoryAlerts,
+		} {
+			resource := fmt.Sprintf("%s (app %s)", monitorType, app.Name)
+			resourceStatus, alertMsg := getHighestSeverityResourceStatusFromAlerts(resource, alerts)
+			if alertMsg != "" {
+				m.log.Warn(alertMsg)
+			}
+
+			switch resourceStatus {
+			case v1beta1.DeviceResourceStatusCritical, v1beta1.DeviceResourceStatusError:
+				hasCriticalOrErrorResource = true
+				criticalResourceTypes = append(criticalResourceTypes, resource)
+			case v1beta1.DeviceResourceStatusWarning:
+				hasDegradedResource = true
+				degradedResourceTypes = append(degradedResourceTypes, resource)
+			}
+		}
+	}
+
 	// ensure status proper reflects in the device summary
 	if hasCriticalOrErrorResource {
 		status.Summary.Status = v1beta1.DeviceSummaryStatusError
@@ -267,7 +301,10 @@ func (m *ResourceManager) sync(ctx context.Context, desired *v1beta1.DeviceSpec)
 
 // BeforeUpdate syncs resource monitors with the desired spec.
 func (m *ResourceManager) BeforeUpdate(ctx context.Context, desired *v1beta1.DeviceSpec) error {
-	return m.sync(ctx, desired)
+	if err := m.sync(ctx, desired); err != nil {
+		return err
+	}
+	return m.appMonitor.Update(desired)
 }
 
 // IsCriticalAlert checks if there is a critical level alert for the specified resource type.
@@ -23,9 +23,10 @@ var _ Manager = (*manager)(nil)
 
 // manager is responsible for managing the rendered device spec.
 type manager struct {
-	currentPath  string
-	desiredPath  string
-	rollbackPath string
+	currentPath      string
+	desiredPath      string
+	rollbackPath     string
+	verificationPath string
 
 	deviceName       string
 	deviceReadWriter fileio.ReadWriter
@@ -70,6 +71,7 @@ func NewManager(
 		currentPath:      filepath.Join(dataDir, string(Current)+".json"),
 		desiredPath:      filepath.Join(dataDir, string(Desired)+".json"),
 		rollbackPath:     filepath.Join(dataDir, string(Rollback)+".json"),
+		verificationPath: filepath.Join(dataDir, verificationFile),
 		deviceName:       deviceName,
 		deviceReadWriter: deviceReadWriter,
 		osClient:         osClient,
@@ -85,6 +87,8 @@ func NewManager(
 		lastKnownVersion = desired.Version()
 	}
 
+	m.loadRolledBackVersion()
+
 	pub := newPublisher(deviceName, pollConfig, lastKnownVersion, deviceNotFoundHandler, log)
 	m.publisher = pub
 	m.watcher = pub.Watch()
@@ -141,6 +141,20 @@ func (mr *MockManagerMockRecorder) ClearRollback() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRollback", reflect.TypeOf((*MockManager)(nil).ClearRollback))
 }
 
+// CompleteVerification mocks base method.
+func (m *MockManager) CompleteVerification() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteVerification")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteVerification indicates an expected call of CompleteVerification.
+func (mr *MockManagerMockRecorder) CompleteVerification() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteVerification", reflect.TypeOf((*MockManager)(nil).CompleteVerification))
+}
+
 // CreateRollback mocks base method.
 func (m *MockManager) CreateRollback(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -319,6 +333,20 @@ func (mr *MockManagerMockRecorder) Rollback(ctx any, opts ...any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockManager)(nil).Rollback), varargs...)
 }
 
+// RollbackUpdate mocks base method.
+func (m *MockManager) RollbackUpdate(ctx context.Context, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackUpdate", ctx, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackUpdate indicates an expected call of RollbackUpdate.
+func (mr *MockManagerMockRecorder) RollbackUpdate(ctx, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackUpdate", reflect.TypeOf((*MockManager)(nil).RollbackUpdate), ctx, reason)
+}
+
 // SetClient mocks base method.
 func (m *MockManager) SetClient(client client.Management) {
 	m.ctrl.T.Helper()
@@ -331,6 +359,20 @@ func (mr *MockManagerMockRecorder) SetClient(client any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClient", reflect.TypeOf((*MockManager)(nil).SetClient), client)
 }
 
+// SetRollbackReported mocks base method.
+func (m *MockManager) SetRollbackReported() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRollbackReported")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRollbackReported indicates an expected call of SetRollbackReported.
+func (mr *MockManagerMockRecorder) SetRollbackReported() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRollbackReported", reflect.TypeOf((*MockManager)(nil).SetRollbackReported))
+}
+
 // SetUpgradeFailed mocks base method.
 func (m *MockManager) SetUpgradeFailed(version string) error {
 	m.ctrl.T.Helper()
@@ -345,6 +387,20 @@ func (mr *MockManagerMockRecorder) SetUpgradeFailed(version any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUpgradeFailed", reflect.TypeOf((*MockManager)(nil).SetUpgradeFailed), version)
 }
 
+// StartVerification mocks base method.
+func (m *MockManager) StartVerification(ctx context.Context, previous *v1beta1.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVerification", ctx, previous)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartVerification indicates an expected call of StartVerification.
+func (mr *MockManagerMockRecorder) StartVerification(ctx, previous any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVerification", reflect.TypeOf((*MockManager)(nil).StartVerification), ctx, previous)
+}
+
 // Status mocks base method.
 func (m *MockManager) Status(arg0 context.Context, arg1 *v1beta1.DeviceStatus, arg2 ...status.CollectorOpt) error {
 	m.ctrl.T.Helper()
@@ -378,6 +434,21 @@ func (mr *MockManagerMockRecorder) Upgrade(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockManager)(nil).Upgrade), ctx)
 }
 
+// Verification mocks base method.
+func (m *MockManager) Verification() (*Verification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verification")
+	ret0, _ := ret[0].(*Verification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verification indicates an expected call of Verification.
+func (mr *MockManagerMockRecorder) Verification() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verification", reflect.TypeOf((*MockManager)(nil).Verification))
+}
+
 // MockPriorityQueue is a mock of PriorityQueue interface.
 type MockPriorityQueue struct {
 	ctrl     *gomock.Controller
@@ -80,6 +80,21 @@ type Manager interface {
 	ClearRollback() error
 	// Rollback reverts the device to the state of the rollback rendered spec.
 	Rollback(ctx context.Context, opts ...RollbackOption) error
+	// StartVerification records the rendered device the device ran before the
+	// current rendered spec was applied, so that the update can be rolled back
+	// if it cannot be verified.
+	StartVerification(ctx context.Context, previous *v1beta1.Device) error
+	// Verification returns the verification of the last update, or nil if
+	// there is none.
+	Verification() (*Verification, error)
+	// CompleteVerification removes the verification of the last update.
+	CompleteVerification() error
+	// RollbackUpdate restores the rendered device of the update being
+	// verified and marks the updated version as failed.
+	RollbackUpdate(ctx context.Context, reason string) error
+	// SetRollbackReported records that the rollback of the last update has
+	// been reported in the device status.
+	SetRollbackReported() error
 	// GetDesired returns the desired rendered device from the management API.
 	GetDesired(ctx context.Context) (*v1beta1.Device, bool, error)
 	// CheckPolicy validates the update policy is ready to process.
@@ -0,0 +1,164 @@
+package spec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/spec/audit"
+)
+
+const (
+	// verificationFile is the name of the file in the data directory that
+	// persists the verification of the last update.
+	verificationFile = "update-verification.json"
+)
+
+// Verification tracks an update that was applied successfully but whose
+// outcome has not been verified yet.
+type Verification struct {
+	// Version is the rendered version that is being verified.
+	Version string `json:"version"`
+	// Previous is the rendered device the device ran before the update. The
+	// device rolls back to it if the update cannot be verified.
+	Previous *v1beta1.Device `json:"previous"`
+	// StartedAt is the time the update was applied.
+	StartedAt time.Time `json:"startedAt"`
+	// RolledBack is true once the update has been rolled back. The record is
+	// kept so that the rolled back version is not applied again.
+	RolledBack bool `json:"rolledBack,omitempty"`
+	// Reason describes why the update was rolled back.
+	Reason string `json:"reason,omitempty"`
+	// Reported is true once the rollback has been reported in the device status.
+	Reported bool `json:"reported,omitempty"`
+}
+
+// IsActive returns true if the update is still being verified.
+func (v *Verification) IsActive() bool {
+	return v != nil && !v.RolledBack
+}
+
+func (s *manager) StartVerification(ctx context.Context, previous *v1beta1.Device) error {
+	verification, err := s.Verification()
+	if err != nil {
+		return err
+	}
+	// if the previous update has not been verified yet, keep rolling back to
+	// the last verified version.
+	if verification.IsActive() {
+		previous = verification.Previous
+	}
+
+	return s.writeVerification(&Verification{
+		Version:   s.cache.getRenderedVersion(Current),
+		Previous:  previous,
+		StartedAt: time.Now(),
+	})
+}
+
+func (s *manager) Verification() (*Verification, error) {
+	data, err := s.deviceReadWriter.ReadFile(s.verificationPath)
+	if err != nil {
+		if fileio.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading update verification: %w", err)
+	}
+	var verification Verification
+	if err := json.Unmarshal(data, &verification); err != nil {
+		return nil, fmt.Errorf("%w: update verification: %w", errors.ErrUnmarshalSpec, err)
+	}
+	return &verification, nil
+}
+
+func (s *manager) CompleteVerification() error {
+	if err := s.deviceReadWriter.RemoveFile(s.verificationPath); err != nil {
+		return fmt.Errorf("removing update verification: %w", err)
+	}
+	return nil
+}
+
+func (s *manager) RollbackUpdate(ctx context.Context, reason string) error {
+	verification, err := s.Verification()
+	if err != nil {
+		return err
+	}
+	if !verification.IsActive() {
+		return fmt.Errorf("no update is being verified")
+	}
+
+	version, err := stringToInt64(verification.Version)
+	if err != nil {
+		return err
+	}
+	s.queue.SetFailed(version)
+
+	// record the rollback before restoring the specs so that the failed
+	// version is not applied again if the agent restarts in between.
+	verification.RolledBack = true
+	verification.Reason = reason
+	if err := s.writeVerification(verification); err != nil {
+		return err
+	}
+
+	previous := verification.Previous
+	if err := s.write(ctx, Current, previous, audit.ReasonRollback); err != nil {
+		return err
+	}
+	if err := s.write(ctx, Desired, previous, audit.ReasonRollback); err != nil {
+		return err
+	}
+	if err := s.ClearRollback(); err != nil {
+		return err
+	}
+
+	s.queue.Add(ctx, previous)
+	s.log.Warnf("Rolled back rendered version %s to %s: %s", verification.Version, previous.Version(), reason)
+	return nil
+}
+
+func (s *manager) SetRollbackReported() error {
+	verification, err := s.Verification()
+	if err != nil {
+		return err
+	}
+	if verification == nil || !verification.RolledBack {
+		return nil
+	}
+	verification.Reported = true
+	return s.writeVerification(verification)
+}
+
+func (s *manager) writeVerification(verification *Verification) error {
+	data, err := json.Marshal(verification)
+	if err != nil {
+		return err
+	}
+	if err := s.deviceReadWriter.WriteFile(s.verificationPath, data, fileio.DefaultFilePermissions); err != nil {
+		return fmt.Errorf("writing update verification: %w", err)
+	}
+	return nil
+}
+
+// loadRolledBackVersion marks the version of a rolled back update as failed
+// so that it is not applied again after the agent restarts.
+func (s *manager) loadRolledBackVersion() {
+	verification, err := s.Verification()
+	if err != nil {
+		s.log.Errorf("Failed to read update verification: %v", err)
+		return
+	}
+	if verification == nil || !verification.RolledBack {
+		return
+	}
+	version, err := stringToInt64(verification.Version)
+	if err != nil {
+		s.log.Errorf("Invalid rolled back version %q: %v", verification.Version, err)
+		return
+	}
+	s.queue.SetFailed(version)
+}
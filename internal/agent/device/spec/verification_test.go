@@ -0,0 +1,132 @@
+package spec
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/policy"
+	"github.com/flightctl/flightctl/internal/agent/device/spec/audit"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/poll"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newVerificationTestManager(t *testing.T, mockPolicyManager *policy.MockManager) *manager {
+	tmpDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	log := log.NewPrefixLogger("test")
+	pub := newPublisher("testDevice", poll.NewConfig(10*time.Millisecond, 1.5), "0", nil, log)
+	cache := newCache(log)
+	queue := newQueueManager(
+		defaultSpecQueueMaxSize,
+		defaultSpecRequeueMaxRetries,
+		defaultSpecPollConfig,
+		mockPolicyManager,
+		cache,
+		log,
+	)
+	return &manager{
+		log:              log,
+		deviceReadWriter: readWriter,
+		queue:            queue,
+		currentPath:      filepath.Join(tmpDir, string(Current)+".json"),
+		desiredPath:      filepath.Join(tmpDir, string(Desired)+".json"),
+		rollbackPath:     filepath.Join(tmpDir, string(Rollback)+".json"),
+		verificationPath: filepath.Join(tmpDir, verificationFile),
+		publisher:        pub,
+		watcher:          pub.Watch(),
+		cache:            cache,
+	}
+}
+
+func TestStartVerification(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	s := newVerificationTestManager(t, policy.NewMockManager(ctrl))
+
+	verification, err := s.Verification()
+	require.NoError(err)
+	require.Nil(verification)
+
+	require.NoError(s.write(ctx, Current, newVersionedDevice("2"), audit.ReasonInitialization))
+	require.NoError(s.StartVerification(ctx, newVersionedDevice("1")))
+
+	verification, err = s.Verification()
+	require.NoError(err)
+	require.True(verification.IsActive())
+	require.Equal("2", verification.Version)
+	require.Equal("1", verification.Previous.Version())
+
+	// an unverified update keeps the last verified version to roll back to
+	require.NoError(s.write(ctx, Current, newVersionedDevice("3"), audit.ReasonInitialization))
+	require.NoError(s.StartVerification(ctx, newVersionedDevice("2")))
+
+	verification, err = s.Verification()
+	require.NoError(err)
+	require.Equal("3", verification.Version)
+	require.Equal("1", verification.Previous.Version())
+
+	require.NoError(s.CompleteVerification())
+	verification, err = s.Verification()
+	require.NoError(err)
+	require.Nil(verification)
+}
+
+func TestRollbackUpdate(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockPolicyManager := policy.NewMockManager(ctrl)
+	mockPolicyManager.EXPECT().IsReady(gomock.Any(), policy.Download).Return(true).AnyTimes()
+	mockPolicyManager.EXPECT().IsReady(gomock.Any(), policy.Update).Return(true).AnyTimes()
+	s := newVerificationTestManager(t, mockPolicyManager)
+
+	require.ErrorContains(s.RollbackUpdate(ctx, "unhealthy"), "no update is being verified")
+
+	require.NoError(s.write(ctx, Current, newVersionedDevice("2"), audit.ReasonInitialization))
+	require.NoError(s.write(ctx, Desired, newVersionedDevice("2"), audit.ReasonInitialization))
+	require.NoError(s.StartVerification(ctx, newVersionedDevice("1")))
+
+	require.NoError(s.RollbackUpdate(ctx, "unhealthy"))
+
+	assertRenderedVersions(t, s, "1", "1")
+	assertDiskVersions(t, s, "1", "1")
+	require.True(s.queue.IsFailed(2))
+
+	verification, err := s.Verification()
+	require.NoError(err)
+	require.False(verification.IsActive())
+	require.True(verification.RolledBack)
+	require.Equal("unhealthy", verification.Reason)
+	require.False(verification.Reported)
+
+	next, requeue, err := s.GetDesired(ctx)
+	require.NoError(err)
+	require.False(requeue)
+	require.Equal("1", next.Version())
+
+	require.NoError(s.SetRollbackReported())
+	verification, err = s.Verification()
+	require.NoError(err)
+	require.True(verification.Reported)
+
+	// the rolled back version stays failed after the agent restarts
+	restarted := newVerificationTestManager(t, mockPolicyManager)
+	restarted.deviceReadWriter = s.deviceReadWriter
+	restarted.verificationPath = s.verificationPath
+	require.False(restarted.queue.IsFailed(2))
+	restarted.loadRolledBackVersion()
+	require.True(restarted.queue.IsFailed(2))
+}
@@ -38,9 +38,11 @@ const (
 	DistroVersionKey = "distroVersion"
 
 	// Identity / security (runtime/conditional collectors)
-	ManagementCertNotAfterKey = "managementCertNotAfter"
-	ManagementCertSerialKey   = "managementCertSerial"
-	TPMVendorInfoKey          = "tpmVendorInfo"
+	ManagementCertNotAfterKey    = "managementCertNotAfter"
+	ManagementCertSerialKey      = "managementCertSerial"
+	ManagementCertIssuerKeyIDKey = "managementCertIssuerKeyId"
+	ManagementCAKeyIDsKey        = "managementCAKeyIds"
+	TPMVendorInfoKey             = "tpmVendorInfo"
 )
 
 // KeySet represents a set of system-info keys.
@@ -87,6 +89,8 @@ func (s KeySet) Strings() []string {
 var runtimeKeys = newKeySet(
 	ManagementCertNotAfterKey,
 	ManagementCertSerialKey,
+	ManagementCertIssuerKeyIDKey,
+	ManagementCAKeyIDsKey,
 	TPMVendorInfoKey,
 )
 
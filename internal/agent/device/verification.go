@@ -0,0 +1,205 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/spec"
+)
+
+// startUpdateVerification starts verifying the update from the previous
+// rendered device if update verification is enabled. It returns true if the
+// update is being verified.
+func (a *Agent) startUpdateVerification(ctx context.Context, previous *v1beta1.Device) bool {
+	if a.updateVerificationTimeout == 0 {
+		return false
+	}
+	// there is no known good version to roll back to before the first update
+	if previous.Version() == "0" {
+		return false
+	}
+	if err := a.specManager.StartVerification(ctx, previous); err != nil {
+		a.log.Errorf("Failed to start update verification: %v", err)
+		return false
+	}
+	a.log.Infof("Verifying update from renderedVersion %s for up to %s", previous.Version(), a.updateVerificationTimeout)
+	return true
+}
+
+// verifyUpdate checks whether the last update has been verified and rolls it
+// back if it was not verified before the verification timeout. It returns true
+// if the update was rolled back.
+func (a *Agent) verifyUpdate(ctx context.Context) bool {
+	verification, err := a.specManager.Verification()
+	if err != nil {
+		a.log.Errorf("Failed to read update verification: %v", err)
+		return false
+	}
+	if verification == nil {
+		return false
+	}
+	if verification.RolledBack {
+		if !verification.Reported {
+			a.reportRollback(ctx, verification)
+		}
+		return false
+	}
+	// wait until the update has been committed
+	if verification.Version != a.specManager.RenderedVersion(spec.Current) {
+		return false
+	}
+
+	if a.updateVerificationTimeout == 0 {
+		a.log.Info("Update verification is disabled, completing verification")
+		a.completeUpdateVerification(ctx)
+		return false
+	}
+
+	deviceStatus := v1beta1.NewDeviceStatus()
+	if err := a.appManager.Status(ctx, &deviceStatus); err != nil {
+		a.log.Warnf("Failed to get application status for update verification: %v", err)
+		deviceStatus.ApplicationsSummary.Status = v1beta1.ApplicationsSummaryStatusUnknown
+	}
+
+	verified, reason := updateVerificationResult(
+		time.Now(),
+		verification.StartedAt,
+		time.Duration(a.updateVerificationTimeout),
+		a.lastStatusSyncTime,
+		deviceStatus.ApplicationsSummary.Status,
+	)
+	if verified {
+		a.log.Infof("Verified update to renderedVersion %s", verification.Version)
+		a.completeUpdateVerification(ctx)
+		return false
+	}
+	if reason == "" {
+		return false
+	}
+
+	if err := a.rollbackUpdate(ctx, verification, reason); err != nil {
+		a.log.Errorf("Rollback of renderedVersion %s did not complete cleanly: %v", verification.Version, err)
+	}
+	return true
+}
+
+// updateVerificationResult returns true if an update is verified, which
+// requires healthy applications and a status update that reached the
+// management service after the update. If the update is not verified by the
+// timeout, it returns the reason to roll the update back.
+func updateVerificationResult(
+	now time.Time,
+	startedAt time.Time,
+	timeout time.Duration,
+	lastStatusSync time.Time,
+	appsStatus v1beta1.ApplicationsSummaryStatusType,
+) (bool, string) {
+	appsHealthy := appsStatus == v1beta1.ApplicationsSummaryStatusHealthy ||
+		appsStatus == v1beta1.ApplicationsSummaryStatusNoApplications
+	connected := lastStatusSync.After(startedAt)
+	if appsHealthy && connected {
+		return true, ""
+	}
+	if now.Before(startedAt.Add(timeout)) {
+		return false, ""
+	}
+
+	var reasons []string
+	if !appsHealthy {
+		reasons = append(reasons, fmt.Sprintf("applications are %s", appsStatus))
+	}
+	if !connected {
+		reasons = append(reasons, "the management service was not reachable")
+	}
+	return false, fmt.Sprintf("update was not verified within %s: %s", timeout, strings.Join(reasons, " and "))
+}
+
+// rollbackUpdate reverts the device to the rendered device it ran before the
+// update, including its OS image.
+func (a *Agent) rollbackUpdate(ctx context.Context, verification *spec.Verification, reason string) error {
+	current, err := a.specManager.Read(spec.Current)
+	if err != nil {
+		return err
+	}
+	previous := verification.Previous
+
+	a.log.Warnf("Rolling back renderedVersion %s to %s: %s", current.Version(), previous.Version(), reason)
+	updateErr := a.statusManager.UpdateCondition(ctx, v1beta1.Condition{
+		Type:    v1beta1.ConditionTypeDeviceUpdating,
+		Status:  v1beta1.ConditionStatusTrue,
+		Reason:  string(v1beta1.UpdateStateRollingBack),
+		Message: fmt.Sprintf("Device is rolling back to the previous renderedVersion: %s: %s", previous.Version(), reason),
+	})
+	if updateErr != nil {
+		a.log.Warnf("Failed setting status: %v", updateErr)
+	}
+
+	if err := a.specManager.RollbackUpdate(ctx, reason); err != nil {
+		return err
+	}
+
+	// note: the specs are reversed, as in rollbackDevice, so that the
+	// applications and configuration of the update are removed.
+	if err := a.sync(ctx, current, previous); err != nil {
+		return err
+	}
+
+	// the spec manager does not consider restoring the previous rendered
+	// device an OS update, so switch back to the previous OS image explicitly.
+	if previous.Spec.Os == nil || previous.Spec.Os.Image == "" {
+		return nil
+	}
+	_, isOSReconciled, err := a.specManager.CheckOsReconciliation(ctx)
+	if err != nil {
+		return err
+	}
+	if isOSReconciled {
+		return nil
+	}
+	return a.afterUpdateOS(ctx, previous.Spec)
+}
+
+// reportRollback reports a completed rollback in the device status once the
+// device runs the OS image of the previous rendered device.
+func (a *Agent) reportRollback(ctx context.Context, verification *spec.Verification) {
+	previous := verification.Previous
+	if previous.Spec.Os != nil && previous.Spec.Os.Image != "" {
+		_, isOSReconciled, err := a.specManager.CheckOsReconciliation(ctx)
+		if err != nil {
+			a.log.Warnf("Failed to check OS reconciliation: %v", err)
+			return
+		}
+		if !isOSReconciled {
+			return
+		}
+	}
+
+	updateErr := a.statusManager.UpdateCondition(ctx, v1beta1.Condition{
+		Type:    v1beta1.ConditionTypeDeviceUpdating,
+		Status:  v1beta1.ConditionStatusFalse,
+		Reason:  string(v1beta1.UpdateStateRolledBack),
+		Message: fmt.Sprintf("Rolled back renderedVersion %s to %s: %s", verification.Version, previous.Version(), verification.Reason),
+	})
+	if updateErr != nil {
+		a.log.Warnf("Failed setting status: %v", updateErr)
+		return
+	}
+	if err := a.specManager.SetRollbackReported(); err != nil {
+		a.log.Errorf("Failed to record rollback status: %v", err)
+	}
+}
+
+// completeUpdateVerification removes the verification of the last update and
+// prunes the images and artifacts that were kept to roll it back.
+func (a *Agent) completeUpdateVerification(ctx context.Context) {
+	if err := a.specManager.CompleteVerification(); err != nil {
+		a.log.Errorf("Failed to complete update verification: %v", err)
+		return
+	}
+	if err := a.pruningManager.Prune(ctx); err != nil {
+		a.log.Warnf("Pruning completed with errors: %v", err)
+	}
+}
@@ -10,17 +10,23 @@ type AlertSender struct {
 	alertmanagerClient *AlertmanagerClient
 }
 
-func NewAlertSender(log *logrus.Logger, hostname string, port uint, cfg *config.Config) *AlertSender {
-	return &AlertSender{
-		log:                log,
-		alertmanagerClient: NewAlertmanagerClient(hostname, port, log, cfg),
+// NewAlertSender returns a sender for the configured Alertmanager. Without an Alertmanager
+// hostname alerts are still tracked, but not pushed anywhere.
+func NewAlertSender(log *logrus.Logger, cfg *config.Config) *AlertSender {
+	sender := &AlertSender{
+		log: log,
 	}
+	if cfg != nil && cfg.Alertmanager != nil && cfg.Alertmanager.Hostname != "" {
+		sender.alertmanagerClient = NewAlertmanagerClient(cfg.Alertmanager.Hostname, cfg.Alertmanager.Port, log, cfg)
+	}
+	return sender
 }
 
 func (a *AlertSender) SendAlerts(checkpoint *AlertCheckpoint) error {
-	err := a.alertmanagerClient.SendAllAlerts(checkpoint.Alerts)
-	if err != nil {
-		return err
+	if a.alertmanagerClient != nil {
+		if err := a.alertmanagerClient.SendAllAlerts(checkpoint.Alerts); err != nil {
+			return err
+		}
 	}
 
 	a.cleanupAlerts(checkpoint)
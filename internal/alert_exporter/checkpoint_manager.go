@@ -13,6 +13,10 @@ import (
 
 const AlertCheckpointConsumer = "alert-exporter"
 const AlertCheckpointKey = "active-alerts"
+const NotificationDeadLetterKey = "notification-dead-letters"
+
+// maxDeadLetters bounds the dead-letter queue, the oldest entries are dropped first
+const maxDeadLetters = 1000
 
 type CheckpointManager struct {
 	log     *logrus.Logger
@@ -138,6 +142,81 @@ func (c *CheckpointManager) StoreCheckpoint(ctx context.Context, checkpoint *Ale
 	return nil
 }
 
+// StoreDeadLetters appends notifications that could not be delivered to the dead-letter queue.
+// Entries already in the queue are replaced, so redelivered events are not recorded twice.
+func (c *CheckpointManager) StoreDeadLetters(ctx context.Context, deadLetters []*PendingNotification) error {
+	if len(deadLetters) == 0 {
+		return nil
+	}
+
+	logger := c.log.WithFields(logrus.Fields{
+		"component":    "checkpoint_manager",
+		"operation":    "store_dead_letters",
+		"dead_letters": len(deadLetters),
+	})
+
+	queue, err := c.LoadDeadLetters(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]struct{}, len(deadLetters))
+	for _, n := range deadLetters {
+		ids[n.ID] = struct{}{}
+	}
+	merged := make([]*PendingNotification, 0, len(queue)+len(deadLetters))
+	for _, n := range queue {
+		if _, replaced := ids[n.ID]; !replaced {
+			merged = append(merged, n)
+		}
+	}
+	merged = append(merged, deadLetters...)
+	if len(merged) > maxDeadLetters {
+		logger.WithField("dropped", len(merged)-maxDeadLetters).Warn("Dead-letter queue is full, dropping oldest entries")
+		merged = merged[len(merged)-maxDeadLetters:]
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		CheckpointOperationsTotal.WithLabelValues("store_dead_letters", "marshal_error").Inc()
+		return fmt.Errorf("failed to marshal dead letters: %v", err)
+	}
+	status := c.handler.SetCheckpoint(ctx, AlertCheckpointConsumer, NotificationDeadLetterKey, data)
+	if status.Code != http.StatusOK {
+		CheckpointOperationsTotal.WithLabelValues("store_dead_letters", "error").Inc()
+		logger.WithFields(logrus.Fields{
+			"status_code": status.Code,
+			"status_msg":  status.Message,
+		}).Error("Failed to store dead letters")
+		return fmt.Errorf("failed to store dead letters: %s", status.Message)
+	}
+
+	CheckpointOperationsTotal.WithLabelValues("store_dead_letters", "success").Inc()
+	logger.WithField("queue_size", len(merged)).Debug("Dead letters stored successfully")
+	return nil
+}
+
+// LoadDeadLetters returns the notifications in the dead-letter queue, oldest first.
+func (c *CheckpointManager) LoadDeadLetters(ctx context.Context) ([]*PendingNotification, error) {
+	data, status := c.handler.GetCheckpoint(ctx, AlertCheckpointConsumer, NotificationDeadLetterKey)
+	if status.Code == http.StatusNotFound {
+		return nil, nil
+	}
+	if status.Code != http.StatusOK {
+		CheckpointOperationsTotal.WithLabelValues("load_dead_letters", "error").Inc()
+		return nil, fmt.Errorf("failed to get dead letters: %s", status.Message)
+	}
+
+	var queue []*PendingNotification
+	if err := json.Unmarshal(data, &queue); err != nil {
+		// A corrupt queue must not block delivery, start a new one
+		CheckpointOperationsTotal.WithLabelValues("load_dead_letters", "unmarshal_error").Inc()
+		c.log.WithError(err).Error("Failed to unmarshal dead letters, starting a new queue")
+		return nil, nil
+	}
+	return queue, nil
+}
+
 // countTotalAlerts counts the total number of alerts across all resources
 func (c *CheckpointManager) countTotalAlerts(alerts map[AlertKey]map[string]*AlertInfo) int {
 	total := 0
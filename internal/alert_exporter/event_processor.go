@@ -16,14 +16,16 @@ import (
 )
 
 type EventProcessor struct {
-	log     *logrus.Logger
-	handler service.Service
+	log      *logrus.Logger
+	handler  service.Service
+	notifier *NotificationSender
 }
 
-func NewEventProcessor(log *logrus.Logger, handler service.Service) *EventProcessor {
+func NewEventProcessor(log *logrus.Logger, handler service.Service, notifier *NotificationSender) *EventProcessor {
 	return &EventProcessor{
-		log:     log,
-		handler: handler,
+		log:      log,
+		handler:  handler,
+		notifier: notifier,
 	}
 }
 
@@ -31,6 +33,11 @@ type CheckpointContext struct {
 	alerts         map[AlertKey]map[string]*AlertInfo
 	alertsCreated  int
 	alertsResolved int
+	notifications  []*PendingNotification
+	// notificationIDs holds the IDs of the pending notifications, redelivered events are not enqueued twice
+	notificationIDs map[string]struct{}
+	// resourceLabels caches the labels of the resources looked up for label-selected notifications
+	resourceLabels map[AlertKey]map[string]string
 }
 
 func (e *EventProcessor) ProcessLatestEvents(ctx context.Context, oldCheckpoint *AlertCheckpoint, metrics *ProcessingMetrics) (*AlertCheckpoint, error) {
@@ -57,13 +64,19 @@ func (e *EventProcessor) ProcessLatestEvents(ctx context.Context, oldCheckpoint
 	logger.WithField("org_count", len(orgs.Items)).Info("Processing events for organizations")
 
 	checkpointCtx := CheckpointContext{
-		alerts:         oldCheckpoint.Alerts,
-		alertsCreated:  0,
-		alertsResolved: 0,
+		alerts:          oldCheckpoint.Alerts,
+		alertsCreated:   0,
+		alertsResolved:  0,
+		notifications:   append([]*PendingNotification(nil), oldCheckpoint.Notifications...),
+		notificationIDs: make(map[string]struct{}, len(oldCheckpoint.Notifications)),
+		resourceLabels:  make(map[AlertKey]map[string]string),
 	}
 	if checkpointCtx.alerts == nil {
 		checkpointCtx.alerts = make(map[AlertKey]map[string]*AlertInfo)
 	}
+	for _, n := range oldCheckpoint.Notifications {
+		checkpointCtx.notificationIDs[n.ID] = struct{}{}
+	}
 
 	totalEvents := 0
 	validationErrors := 0
@@ -116,9 +129,10 @@ func (e *EventProcessor) ProcessLatestEvents(ctx context.Context, oldCheckpoint
 	}
 
 	newCheckpoint := &AlertCheckpoint{
-		Version:   CurrentAlertCheckpointVersion,
-		Alerts:    checkpointCtx.alerts,
-		Timestamp: timestamp.Format(time.RFC3339Nano),
+		Version:       CurrentAlertCheckpointVersion,
+		Alerts:        checkpointCtx.alerts,
+		Timestamp:     timestamp.Format(time.RFC3339Nano),
+		Notifications: checkpointCtx.notifications,
 	}
 
 	logger.WithFields(logrus.Fields{
@@ -128,6 +142,7 @@ func (e *EventProcessor) ProcessLatestEvents(ctx context.Context, oldCheckpoint
 		"new_timestamp":     newCheckpoint.Timestamp,
 		"final_alert_keys":  len(newCheckpoint.Alerts),
 		"total_alert_count": e.countTotalAlerts(newCheckpoint.Alerts),
+		"notifications":     len(newCheckpoint.Notifications),
 		"orgs_processed":    len(orgs.Items),
 	}).Info("Event processing completed")
 
@@ -146,7 +161,7 @@ func (e *EventProcessor) ProcessLatestEvents(ctx context.Context, oldCheckpoint
 
 // processOrganizationEvents processes events for a specific organization
 func (e *EventProcessor) processOrganizationEvents(ctx context.Context, orgID uuid.UUID, timestamp string, checkpointCtx *CheckpointContext, logger *logrus.Entry) (int, int, int, error) {
-	params := getListEventsParams(timestamp)
+	params := getListEventsParams(timestamp, e.notifier.Reasons()...)
 	logger.WithFields(logrus.Fields{
 		"newer_than": timestamp,
 		"limit":      *params.Limit,
@@ -207,6 +222,7 @@ func (e *EventProcessor) processOrganizationEvents(ctx context.Context, orgID uu
 
 			eventLogger.Debug("Processing event")
 			checkpointCtx.processEvent(ev, orgID)
+			e.enqueueNotifications(ctx, ev, orgID, checkpointCtx, eventLogger)
 		}
 
 		if events.Metadata.Continue == nil {
@@ -218,6 +234,61 @@ func (e *EventProcessor) processOrganizationEvents(ctx context.Context, orgID uu
 	return totalEvents, totalPages, validationErrors, nil
 }
 
+// enqueueNotifications adds a pending notification for every webhook sink the event is routed to
+func (e *EventProcessor) enqueueNotifications(ctx context.Context, ev domain.Event, orgID uuid.UUID, checkpointCtx *CheckpointContext, logger *logrus.Entry) {
+	pending := e.notifier.Enqueue(ev, orgID, func() map[string]string {
+		return e.getResourceLabels(ctx, ev, orgID, checkpointCtx, logger)
+	})
+	for _, n := range pending {
+		if _, exists := checkpointCtx.notificationIDs[n.ID]; exists {
+			continue
+		}
+		checkpointCtx.notificationIDs[n.ID] = struct{}{}
+		checkpointCtx.notifications = append(checkpointCtx.notifications, n)
+	}
+}
+
+// getResourceLabels returns the labels of the device or fleet involved in an event. Other
+// resources, and resources that no longer exist, have no labels.
+func (e *EventProcessor) getResourceLabels(ctx context.Context, ev domain.Event, orgID uuid.UUID, checkpointCtx *CheckpointContext, logger *logrus.Entry) map[string]string {
+	k := AlertKeyFromEvent(ev, orgID)
+	if resourceLabels, exists := checkpointCtx.resourceLabels[k]; exists {
+		return resourceLabels
+	}
+
+	var (
+		metadata *domain.ObjectMeta
+		status   domain.Status
+	)
+	switch ev.InvolvedObject.Kind {
+	case domain.DeviceKind:
+		var device *domain.Device
+		device, status = e.handler.GetDevice(ctx, orgID, ev.InvolvedObject.Name)
+		if device != nil {
+			metadata = &device.Metadata
+		}
+	case domain.FleetKind:
+		var fleet *domain.Fleet
+		fleet, status = e.handler.GetFleet(ctx, orgID, ev.InvolvedObject.Name, domain.GetFleetParams{})
+		if fleet != nil {
+			metadata = &fleet.Metadata
+		}
+	}
+	if metadata == nil && status.Code != 0 && status.Code != http.StatusNotFound {
+		logger.WithFields(logrus.Fields{
+			"status_code": status.Code,
+			"status_msg":  status.Message,
+		}).Warn("Failed to get labels of the involved resource")
+	}
+
+	var resourceLabels map[string]string
+	if metadata != nil {
+		resourceLabels = lo.FromPtr(metadata.Labels)
+	}
+	checkpointCtx.resourceLabels[k] = resourceLabels
+	return resourceLabels
+}
+
 // countTotalAlerts counts the total number of alerts across all resources
 func (e *EventProcessor) countTotalAlerts(alerts map[AlertKey]map[string]*AlertInfo) int {
 	total := 0
@@ -227,7 +298,7 @@ func (e *EventProcessor) countTotalAlerts(alerts map[AlertKey]map[string]*AlertI
 	return total
 }
 
-func getListEventsParams(newerThan string) domain.ListEventsParams {
+func getListEventsParams(newerThan string, notificationReasons ...domain.EventReason) domain.ListEventsParams {
 	eventsOfInterest := []domain.EventReason{
 		domain.EventReasonDeviceApplicationDegraded,
 		domain.EventReasonDeviceApplicationError,
@@ -246,6 +317,7 @@ func getListEventsParams(newerThan string) domain.ListEventsParams {
 		domain.EventReasonResourceDeleted,
 		domain.EventReasonDeviceDecommissioned,
 	}
+	eventsOfInterest = lo.Uniq(append(eventsOfInterest, notificationReasons...))
 
 	fieldSelectors := []string{
 		fmt.Sprintf("reason in (%s)",
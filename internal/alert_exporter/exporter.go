@@ -58,9 +58,10 @@ type AlertInfo struct {
 }
 
 type AlertCheckpoint struct {
-	Version   int
-	Timestamp string
-	Alerts    map[AlertKey]map[string]*AlertInfo
+	Version       int
+	Timestamp     string
+	Alerts        map[AlertKey]map[string]*AlertInfo
+	Notifications []*PendingNotification `json:",omitempty"`
 }
 
 // ProcessingMetrics tracks operational metrics for monitoring and observability
@@ -102,15 +103,24 @@ func (a *AlertExporter) Poll(ctx context.Context) error {
 		ProcessingDurationSeconds.Observe(time.Since(startTime).Seconds())
 	}()
 
+	alertmanager := "disabled"
+	if a.config.Alertmanager != nil && a.config.Alertmanager.Hostname != "" {
+		alertmanager = fmt.Sprintf("%s:%d", a.config.Alertmanager.Hostname, a.config.Alertmanager.Port)
+	}
 	a.log.WithFields(logrus.Fields{
 		"component":        "alert_exporter",
 		"polling_interval": a.config.Service.AlertPollingInterval,
-		"alertmanager":     fmt.Sprintf("%s:%d", a.config.Alertmanager.Hostname, a.config.Alertmanager.Port),
+		"alertmanager":     alertmanager,
 	}).Info("Starting alert exporter polling")
 
+	notificationSender, err := NewNotificationSender(a.log, a.config)
+	if err != nil {
+		return fmt.Errorf("failed to initialize notification sinks: %w", err)
+	}
+
 	checkpointManager := NewCheckpointManager(a.log, a.handler)
-	eventProcessor := NewEventProcessor(a.log, a.handler)
-	alertSender := NewAlertSender(a.log, a.config.Alertmanager.Hostname, a.config.Alertmanager.Port, a.config)
+	eventProcessor := NewEventProcessor(a.log, a.handler, notificationSender)
+	alertSender := NewAlertSender(a.log, a.config)
 
 	ticker := time.NewTicker(time.Duration(a.config.Service.AlertPollingInterval))
 	defer ticker.Stop()
@@ -132,7 +142,7 @@ func (a *AlertExporter) Poll(ctx context.Context) error {
 		}
 
 		cycleCount++
-		a.processingCycle(ctx, checkpointManager, eventProcessor, alertSender, &checkpoint, cycleCount)
+		a.processingCycle(ctx, checkpointManager, eventProcessor, alertSender, notificationSender, &checkpoint, cycleCount)
 	}
 }
 
@@ -141,6 +151,7 @@ func (a *AlertExporter) processingCycle(
 	checkpointManager *CheckpointManager,
 	eventProcessor *EventProcessor,
 	alertSender *AlertSender,
+	notificationSender *NotificationSender,
 	checkpoint **AlertCheckpoint,
 	cycleNumber int,
 ) {
@@ -197,6 +208,21 @@ func (a *AlertExporter) processingCycle(
 		}).Error("Failed sending alerts")
 		return
 	}
+
+	// Deliver webhook notifications with span. Deliveries are not bound to the cycle timeout,
+	// each request is bound by the timeout of its sink instead.
+	notifyCtx, notifySpan := tracing.StartSpan(ctx, "flightctl/alert-exporter", "SendNotifications")
+	deadLetters := notificationSender.SendNotifications(notifyCtx, newCheckpoint)
+	err = checkpointManager.StoreDeadLetters(notifyCtx, deadLetters)
+	notifySpan.End()
+	if err != nil {
+		span.RecordError(err)
+		logger.WithFields(logrus.Fields{
+			"error":        err,
+			"dead_letters": len(deadLetters),
+		}).Error("Failed storing dead-lettered notifications")
+		return
+	}
 	metrics.SendingTimeMs = time.Since(sendStart).Milliseconds()
 
 	// Store checkpoint with span
@@ -241,6 +267,8 @@ func (a *AlertExporter) processingCycle(
 		"checkpoint_time_ms":  metrics.CheckpointTimeMs,
 		"total_cycle_time_ms": metrics.TotalCycleTimeMs,
 		"active_alert_keys":   len(newCheckpoint.Alerts),
+		"notifications":       len(newCheckpoint.Notifications),
+		"dead_letters":        len(deadLetters),
 	}).Info("Processing cycle completed successfully")
 
 	// Log performance warnings if needed
@@ -57,6 +57,23 @@ var (
 		Help: "Total number of retries when sending to Alertmanager",
 	})
 
+	// Webhook notification metrics
+	WebhookDeliveriesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "flightctl_alert_exporter_webhook_deliveries_total",
+		Help: "Total number of webhook notification delivery attempts",
+	}, []string{"sink", "status"})
+
+	WebhookRequestDurationSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "flightctl_alert_exporter_webhook_request_duration_seconds",
+		Help:    "Time spent sending requests to webhook sinks in seconds",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	WebhookPendingNotifications = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "flightctl_alert_exporter_webhook_pending_notifications",
+		Help: "Current number of webhook notifications awaiting delivery",
+	})
+
 	// Checkpoint metrics
 	CheckpointOperationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "flightctl_alert_exporter_checkpoint_operations_total",
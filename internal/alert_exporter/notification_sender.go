@@ -0,0 +1,371 @@
+package alert_exporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/pkg/k8s/selector"
+	"github.com/flightctl/flightctl/pkg/k8s/selector/labels"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	WebhookSignatureHeader = "X-Flightctl-Signature-256"
+	WebhookTimestampHeader = "X-Flightctl-Timestamp"
+	WebhookDeliveryHeader  = "X-Flightctl-Delivery"
+	WebhookEventHeader     = "X-Flightctl-Event"
+
+	defaultWebhookMaxAttempts = 5
+	defaultWebhookTimeout     = 10 * time.Second
+	webhookRetryBaseDelay     = 30 * time.Second
+	webhookRetryMaxDelay      = 10 * time.Minute
+
+	// maxPendingNotifications bounds the deliveries kept in the checkpoint while sinks are down,
+	// the oldest deliveries are moved to the dead-letter queue first
+	maxPendingNotifications = 1000
+)
+
+// PendingNotification is an event awaiting delivery to a webhook sink. Pending notifications are
+// part of the alert checkpoint, so they survive restarts until delivered or dead-lettered.
+type PendingNotification struct {
+	ID          string
+	Sink        string
+	OrgID       string
+	Event       domain.Event
+	Attempts    int
+	NextAttempt time.Time
+	LastError   string
+}
+
+// WebhookPayload is the JSON body POSTed to webhook sinks.
+type WebhookPayload struct {
+	ID      string       `json:"id"`
+	Sink    string       `json:"sink"`
+	OrgID   string       `json:"orgId"`
+	Attempt int          `json:"attempt"`
+	Event   domain.Event `json:"event"`
+}
+
+type webhookSink struct {
+	name          string
+	url           string
+	secret        []byte
+	reasons       map[domain.EventReason]struct{}
+	organizations map[string]struct{}
+	selector      selector.Selector
+	maxAttempts   int
+	client        *http.Client
+}
+
+// NotificationSender delivers selected events to the configured webhook sinks.
+type NotificationSender struct {
+	log   *logrus.Logger
+	sinks map[string]*webhookSink
+	// names keeps the configured order of the sinks
+	names []string
+	now   func() time.Time
+}
+
+// NewNotificationSender returns a sender for the configured webhook sinks, or nil if none is configured.
+func NewNotificationSender(log *logrus.Logger, cfg *config.Config) (*NotificationSender, error) {
+	if cfg == nil || cfg.Notifications == nil || len(cfg.Notifications.Webhooks) == 0 {
+		return nil, nil
+	}
+
+	n := &NotificationSender{
+		log:   log,
+		sinks: make(map[string]*webhookSink, len(cfg.Notifications.Webhooks)),
+		now:   time.Now,
+	}
+	for _, sinkCfg := range cfg.Notifications.Webhooks {
+		sink, err := newWebhookSink(sinkCfg)
+		if err != nil {
+			return nil, fmt.Errorf("webhook sink %q: %w", sinkCfg.Name, err)
+		}
+		n.sinks[sink.name] = sink
+		n.names = append(n.names, sink.name)
+	}
+
+	log.WithFields(logrus.Fields{
+		"component": "notification_sender",
+		"sinks":     n.names,
+	}).Info("Webhook notification sinks initialized")
+	return n, nil
+}
+
+func newWebhookSink(cfg config.WebhookSinkConfig) (*webhookSink, error) {
+	sink := &webhookSink{
+		name:          cfg.Name,
+		url:           cfg.URL,
+		secret:        []byte(cfg.Secret.Value()),
+		reasons:       make(map[domain.EventReason]struct{}, len(cfg.Reasons)),
+		organizations: make(map[string]struct{}, len(cfg.Organizations)),
+		maxAttempts:   cfg.MaxAttempts,
+	}
+	if sink.maxAttempts == 0 {
+		sink.maxAttempts = defaultWebhookMaxAttempts
+	}
+	for _, reason := range cfg.Reasons {
+		sink.reasons[domain.EventReason(reason)] = struct{}{}
+	}
+	for _, orgID := range cfg.Organizations {
+		sink.organizations[orgID] = struct{}{}
+	}
+	if cfg.LabelSelector != "" {
+		s, err := labels.Parse(cfg.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+		sink.selector = s
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipTlsVerify, //nolint:gosec
+	}
+	if cfg.CACert != "" {
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM([]byte(cfg.CACert)) {
+			return nil, fmt.Errorf("failed to parse CA certificate")
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+	timeout := time.Duration(cfg.Timeout)
+	if timeout == 0 {
+		timeout = defaultWebhookTimeout
+	}
+	sink.client = &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}
+	return sink, nil
+}
+
+// Reasons returns the event reasons that at least one sink is interested in.
+func (n *NotificationSender) Reasons() []domain.EventReason {
+	if n == nil {
+		return nil
+	}
+	var reasons []domain.EventReason
+	for _, name := range n.names {
+		for reason := range n.sinks[name].reasons {
+			reasons = append(reasons, reason)
+		}
+	}
+	return lo.Uniq(reasons)
+}
+
+// Enqueue returns a pending notification for every sink the event is routed to. The labels of
+// the involved resource are only looked up if a sink with a label selector is interested in it.
+func (n *NotificationSender) Enqueue(event domain.Event, orgID uuid.UUID, resourceLabels func() map[string]string) []*PendingNotification {
+	if n == nil {
+		return nil
+	}
+
+	var (
+		pending       []*PendingNotification
+		labelSet      k8sLabels.Set
+		labelsFetched bool
+	)
+	for _, name := range n.names {
+		sink := n.sinks[name]
+		if _, ok := sink.reasons[event.Reason]; !ok {
+			continue
+		}
+		if len(sink.organizations) > 0 {
+			if _, ok := sink.organizations[orgID.String()]; !ok {
+				continue
+			}
+		}
+		if sink.selector != nil {
+			if !labelsFetched {
+				labelSet = k8sLabels.Set(resourceLabels())
+				labelsFetched = true
+			}
+			if !sink.selector.Matches(labelSet) {
+				continue
+			}
+		}
+		pending = append(pending, &PendingNotification{
+			ID:    notificationID(sink.name, event, orgID),
+			Sink:  sink.name,
+			OrgID: orgID.String(),
+			Event: event,
+		})
+	}
+	return pending
+}
+
+// notificationID identifies the delivery of an event to a sink. It is stable across
+// redeliveries so that receivers can deduplicate.
+func notificationID(sink string, event domain.Event, orgID uuid.UUID) string {
+	if name := lo.FromPtr(event.Metadata.Name); name != "" {
+		return fmt.Sprintf("%s:%s", sink, name)
+	}
+	return fmt.Sprintf("%s:%s:%s:%s", sink, AlertKeyFromEvent(event, orgID), event.Reason,
+		lo.FromPtr(event.Metadata.CreationTimestamp).Format(time.RFC3339Nano))
+}
+
+// SendNotifications attempts to deliver the pending notifications of the checkpoint that are due.
+// Delivered notifications are removed from the checkpoint and failed ones are rescheduled with
+// exponential backoff. Notifications that ran out of attempts, that the sink rejected permanently,
+// or that overflow the pending queue are removed and returned so they can be dead-lettered.
+func (n *NotificationSender) SendNotifications(ctx context.Context, checkpoint *AlertCheckpoint) []*PendingNotification {
+	if n == nil {
+		checkpoint.Notifications = nil
+		return nil
+	}
+
+	now := n.now()
+	failedSinks := map[string]struct{}{}
+	remaining := make([]*PendingNotification, 0, len(checkpoint.Notifications))
+	var deadLetters []*PendingNotification
+
+	for _, notification := range checkpoint.Notifications {
+		logger := n.log.WithFields(logrus.Fields{
+			"component":    "notification_sender",
+			"sink":         notification.Sink,
+			"delivery_id":  notification.ID,
+			"event_reason": notification.Event.Reason,
+		})
+
+		sink, ok := n.sinks[notification.Sink]
+		if !ok {
+			logger.Warn("Dropping notification for a sink that is no longer configured")
+			continue
+		}
+		// Once a sink failed in this cycle, its remaining notifications wait for the next one
+		// instead of running into the same error.
+		if _, failed := failedSinks[sink.name]; failed || notification.NextAttempt.After(now) {
+			remaining = append(remaining, notification)
+			continue
+		}
+
+		notification.Attempts++
+		start := time.Now()
+		retryable, err := n.deliver(ctx, sink, notification)
+		WebhookRequestDurationSeconds.Observe(time.Since(start).Seconds())
+		if err == nil {
+			WebhookDeliveriesTotal.WithLabelValues(sink.name, "success").Inc()
+			logger.Debug("Delivered notification")
+			continue
+		}
+
+		failedSinks[sink.name] = struct{}{}
+		notification.LastError = err.Error()
+		if !retryable || notification.Attempts >= sink.maxAttempts {
+			WebhookDeliveriesTotal.WithLabelValues(sink.name, "dead_letter").Inc()
+			logger.WithFields(logrus.Fields{
+				"attempts": notification.Attempts,
+				"error":    err,
+			}).Error("Giving up on notification delivery, moving it to the dead-letter queue")
+			deadLetters = append(deadLetters, notification)
+			continue
+		}
+
+		WebhookDeliveriesTotal.WithLabelValues(sink.name, "retry").Inc()
+		notification.NextAttempt = now.Add(webhookRetryDelay(notification.Attempts))
+		logger.WithFields(logrus.Fields{
+			"attempts":     notification.Attempts,
+			"next_attempt": notification.NextAttempt,
+			"error":        err,
+		}).Warn("Failed to deliver notification, will retry")
+		remaining = append(remaining, notification)
+	}
+
+	if overflow := len(remaining) - maxPendingNotifications; overflow > 0 {
+		n.log.WithFields(logrus.Fields{
+			"component": "notification_sender",
+			"dropped":   overflow,
+		}).Warn("Pending notification queue is full, moving oldest notifications to the dead-letter queue")
+		for _, notification := range remaining[:overflow] {
+			WebhookDeliveriesTotal.WithLabelValues(notification.Sink, "dead_letter").Inc()
+			if notification.LastError == "" {
+				notification.LastError = "pending notification queue is full"
+			}
+			deadLetters = append(deadLetters, notification)
+		}
+		remaining = remaining[overflow:]
+	}
+
+	checkpoint.Notifications = remaining
+	WebhookPendingNotifications.Set(float64(len(remaining)))
+	return deadLetters
+}
+
+// deliver POSTs a notification to its sink. It returns whether a failed delivery may be retried.
+func (n *NotificationSender) deliver(ctx context.Context, sink *webhookSink, notification *PendingNotification) (bool, error) {
+	body, err := json.Marshal(WebhookPayload{
+		ID:      notification.ID,
+		Sink:    notification.Sink,
+		OrgID:   notification.OrgID,
+		Attempt: notification.Attempts,
+		Event:   notification.Event,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	timestamp := strconv.FormatInt(n.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookDeliveryHeader, notification.ID)
+	req.Header.Set(WebhookEventHeader, string(notification.Event.Reason))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(sink.secret, timestamp, body))
+
+	resp, err := sink.client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	httpErr := &HTTPError{StatusCode: resp.StatusCode, Status: http.StatusText(resp.StatusCode)}
+	// Client errors will not go away by retrying, except for timeouts and rate limiting
+	retryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests
+	return retryable, httpErr
+}
+
+// SignWebhookPayload returns the value of the signature header for a request body sent at the
+// given unix timestamp: "sha256=" followed by the hex encoded HMAC-SHA256 of "<timestamp>.<body>".
+func SignWebhookPayload(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay
+	for i := 1; i < attempts && delay < webhookRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > webhookRetryMaxDelay {
+		return webhookRetryMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,335 @@
+package alert_exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func newTestNotificationSender(t *testing.T, sinks ...config.WebhookSinkConfig) *NotificationSender {
+	t.Helper()
+	logger, _ := test.NewNullLogger()
+	cfg := config.NewDefault()
+	cfg.Notifications = &config.NotificationsConfig{Webhooks: sinks}
+	sender, err := NewNotificationSender(logger, cfg)
+	if err != nil {
+		t.Fatalf("failed to create notification sender: %v", err)
+	}
+	return sender
+}
+
+func TestNotificationSender_Enqueue(t *testing.T) {
+	org1 := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	org2 := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	sender := newTestNotificationSender(t,
+		config.WebhookSinkConfig{
+			Name:    "all",
+			URL:     "http://localhost/all",
+			Secret:  "secret",
+			Reasons: []string{string(domain.EventReasonDeviceDisconnected)},
+		},
+		config.WebhookSinkConfig{
+			Name:          "org1-prod",
+			URL:           "http://localhost/org1",
+			Secret:        "secret",
+			Reasons:       []string{string(domain.EventReasonDeviceDisconnected), string(domain.EventReasonFleetRolloutFailed)},
+			Organizations: []string{org1.String()},
+			LabelSelector: "env=prod",
+		},
+	)
+
+	prodLabels := func() map[string]string { return map[string]string{"env": "prod"} }
+	devLabels := func() map[string]string { return map[string]string{"env": "dev"} }
+
+	tests := []struct {
+		name      string
+		reason    domain.EventReason
+		orgID     uuid.UUID
+		labels    func() map[string]string
+		wantSinks []string
+	}{
+		{
+			name:      "matches all sinks",
+			reason:    domain.EventReasonDeviceDisconnected,
+			orgID:     org1,
+			labels:    prodLabels,
+			wantSinks: []string{"all", "org1-prod"},
+		},
+		{
+			name:      "label selector does not match",
+			reason:    domain.EventReasonDeviceDisconnected,
+			orgID:     org1,
+			labels:    devLabels,
+			wantSinks: []string{"all"},
+		},
+		{
+			name:      "organization does not match",
+			reason:    domain.EventReasonDeviceDisconnected,
+			orgID:     org2,
+			labels:    prodLabels,
+			wantSinks: []string{"all"},
+		},
+		{
+			name:      "reason only selected by one sink",
+			reason:    domain.EventReasonFleetRolloutFailed,
+			orgID:     org1,
+			labels:    prodLabels,
+			wantSinks: []string{"org1-prod"},
+		},
+		{
+			name:   "reason not selected",
+			reason: domain.EventReasonDeviceConnected,
+			orgID:  org1,
+			labels: func() map[string]string {
+				t.Error("labels must not be looked up for events no sink is interested in")
+				return nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := fakeEvent("org", "Device", "dev1", string(tt.reason))
+			pending := sender.Enqueue(event, tt.orgID, tt.labels)
+			sinks := lo.Map(pending, func(n *PendingNotification, _ int) string { return n.Sink })
+			if strings.Join(sinks, ",") != strings.Join(tt.wantSinks, ",") {
+				t.Errorf("expected sinks %v, got %v", tt.wantSinks, sinks)
+			}
+			for _, n := range pending {
+				if n.OrgID != tt.orgID.String() {
+					t.Errorf("expected org %s, got %s", tt.orgID, n.OrgID)
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationSender_SendNotificationsSignsRequests(t *testing.T) {
+	secret := "s3cr3t"
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp := r.Header.Get(WebhookTimestampHeader)
+		if got, want := r.Header.Get(WebhookSignatureHeader), SignWebhookPayload([]byte(secret), timestamp, body); got != want {
+			t.Errorf("expected signature %s, got %s", want, got)
+		}
+		if r.Header.Get(WebhookEventHeader) != string(domain.EventReasonDeviceDisconnected) {
+			t.Errorf("unexpected event header %q", r.Header.Get(WebhookEventHeader))
+		}
+		var payload WebhookPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("failed to unmarshal payload: %v", err)
+		}
+		if payload.ID != r.Header.Get(WebhookDeliveryHeader) || payload.Attempt != 1 {
+			t.Errorf("unexpected payload %+v", payload)
+		}
+		received.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := newTestNotificationSender(t, config.WebhookSinkConfig{
+		Name:    "ops",
+		URL:     server.URL,
+		Secret:  "s3cr3t",
+		Reasons: []string{string(domain.EventReasonDeviceDisconnected)},
+	})
+
+	event := fakeEvent("org", "Device", "dev1", string(domain.EventReasonDeviceDisconnected))
+	checkpoint := &AlertCheckpoint{
+		Notifications: sender.Enqueue(event, uuid.New(), nil),
+	}
+	deadLetters := sender.SendNotifications(t.Context(), checkpoint)
+
+	if received.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", received.Load())
+	}
+	if len(checkpoint.Notifications) != 0 || len(deadLetters) != 0 {
+		t.Errorf("expected notification to be delivered, pending %d, dead letters %d", len(checkpoint.Notifications), len(deadLetters))
+	}
+}
+
+func TestNotificationSender_SendNotificationsRetries(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		maxAttempts     int
+		cycles          int
+		wantRequests    int32
+		wantPending     int
+		wantDeadLetters int
+	}{
+		{
+			name:         "server errors are retried after the backoff",
+			status:       http.StatusServiceUnavailable,
+			maxAttempts:  3,
+			cycles:       2,
+			wantRequests: 2,
+			wantPending:  2,
+		},
+		{
+			name:            "dead-lettered after max attempts",
+			status:          http.StatusInternalServerError,
+			maxAttempts:     2,
+			cycles:          4,
+			wantRequests:    4,
+			wantDeadLetters: 2,
+		},
+		{
+			name:            "client errors are dead-lettered right away",
+			status:          http.StatusBadRequest,
+			maxAttempts:     5,
+			cycles:          1,
+			wantRequests:    1,
+			wantPending:     1,
+			wantDeadLetters: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			sender := newTestNotificationSender(t, config.WebhookSinkConfig{
+				Name:        "ops",
+				URL:         server.URL,
+				Secret:      "secret",
+				Reasons:     []string{string(domain.EventReasonDeviceDisconnected)},
+				MaxAttempts: tt.maxAttempts,
+			})
+			now := time.Now()
+			sender.now = func() time.Time { return now }
+
+			orgID := uuid.New()
+			checkpoint := &AlertCheckpoint{
+				Notifications: append(
+					sender.Enqueue(fakeEvent("org", "Device", "dev1", string(domain.EventReasonDeviceDisconnected)), orgID, nil),
+					sender.Enqueue(fakeEvent("org", "Device", "dev2", string(domain.EventReasonDeviceDisconnected)), orgID, nil)...,
+				),
+			}
+
+			var deadLetters []*PendingNotification
+			for i := 0; i < tt.cycles; i++ {
+				if i > 0 {
+					now = now.Add(webhookRetryMaxDelay)
+				}
+				deadLetters = append(deadLetters, sender.SendNotifications(t.Context(), checkpoint)...)
+			}
+
+			// once a delivery failed, the remaining notifications of the sink wait for the next cycle
+			if requests.Load() != tt.wantRequests {
+				t.Errorf("expected %d requests, got %d", tt.wantRequests, requests.Load())
+			}
+			if len(checkpoint.Notifications) != tt.wantPending {
+				t.Errorf("expected %d pending notifications, got %d", tt.wantPending, len(checkpoint.Notifications))
+			}
+			if len(deadLetters) != tt.wantDeadLetters {
+				t.Errorf("expected %d dead letters, got %d", tt.wantDeadLetters, len(deadLetters))
+			}
+			for _, n := range checkpoint.Notifications {
+				if n.Attempts > 0 && !n.NextAttempt.After(now) {
+					t.Errorf("expected notification %s to be rescheduled", n.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationSender_SendNotificationsCapsPendingQueue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sender := newTestNotificationSender(t, config.WebhookSinkConfig{
+		Name:    "ops",
+		URL:     server.URL,
+		Secret:  "secret",
+		Reasons: []string{string(domain.EventReasonDeviceDisconnected)},
+	})
+
+	orgID := uuid.New()
+	checkpoint := &AlertCheckpoint{}
+	for i := 0; i < maxPendingNotifications+10; i++ {
+		event := fakeEvent("org", "Device", fmt.Sprintf("dev%d", i), string(domain.EventReasonDeviceDisconnected))
+		checkpoint.Notifications = append(checkpoint.Notifications, sender.Enqueue(event, orgID, nil)...)
+	}
+	oldest := checkpoint.Notifications[0].ID
+
+	deadLetters := sender.SendNotifications(t.Context(), checkpoint)
+	if len(checkpoint.Notifications) != maxPendingNotifications {
+		t.Errorf("expected %d pending notifications, got %d", maxPendingNotifications, len(checkpoint.Notifications))
+	}
+	if len(deadLetters) != 10 {
+		t.Fatalf("expected 10 dead letters, got %d", len(deadLetters))
+	}
+	// the oldest notifications overflow first
+	if deadLetters[0].ID != oldest {
+		t.Errorf("expected the oldest notification %s to be dead-lettered, got %s", oldest, deadLetters[0].ID)
+	}
+	for _, n := range deadLetters {
+		if n.LastError == "" {
+			t.Errorf("expected dead letter %s to record why it was dropped", n.ID)
+		}
+	}
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	if d := webhookRetryDelay(1); d != webhookRetryBaseDelay {
+		t.Errorf("expected first retry after %s, got %s", webhookRetryBaseDelay, d)
+	}
+	if d := webhookRetryDelay(2); d != 2*webhookRetryBaseDelay {
+		t.Errorf("expected second retry after %s, got %s", 2*webhookRetryBaseDelay, d)
+	}
+	if d := webhookRetryDelay(100); d != webhookRetryMaxDelay {
+		t.Errorf("expected retry delay to be capped at %s, got %s", webhookRetryMaxDelay, d)
+	}
+}
+
+func TestGetListEventsParamsIncludesNotificationReasons(t *testing.T) {
+	params := getListEventsParams("", domain.EventReasonFleetRolloutFailed, domain.EventReasonDeviceDisconnected)
+	selector := lo.FromPtr(params.FieldSelector)
+	if !strings.Contains(selector, string(domain.EventReasonFleetRolloutFailed)) {
+		t.Errorf("expected field selector to include %s: %s", domain.EventReasonFleetRolloutFailed, selector)
+	}
+	if strings.Count(selector, string(domain.EventReasonDeviceDisconnected)) != 1 {
+		t.Errorf("expected reasons to be deduplicated: %s", selector)
+	}
+}
+
+func TestNewAlertSenderWithoutAlertmanager(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	logger.SetLevel(logrus.PanicLevel)
+	cfg := config.NewDefault()
+	cfg.Alertmanager.Hostname = ""
+
+	sender := NewAlertSender(logger, cfg)
+	ended := time.Now()
+	checkpoint := &AlertCheckpoint{
+		Alerts: map[AlertKey]map[string]*AlertInfo{
+			"org:Device:dev1": {"DeviceDisconnected": {Reason: "DeviceDisconnected", EndsAt: &ended}},
+		},
+	}
+	if err := sender.SendAlerts(checkpoint); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(checkpoint.Alerts) != 0 {
+		t.Errorf("expected resolved alerts to be cleaned up")
+	}
+}
@@ -0,0 +1,39 @@
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// BulkOperationConverter converts between v1alpha1 API types and domain types for BulkOperation resources.
+type BulkOperationConverter interface {
+	ToDomain(apiv1alpha1.BulkOperation) domain.BulkOperation
+	FromDomain(*domain.BulkOperation) *apiv1alpha1.BulkOperation
+	ListFromDomain(*domain.BulkOperationList) *apiv1alpha1.BulkOperationList
+
+	// Params conversions
+	ListParamsToDomain(apiv1alpha1.ListBulkOperationsParams) domain.ListBulkOperationsParams
+}
+
+type bulkOperationConverter struct{}
+
+// NewBulkOperationConverter creates a new BulkOperationConverter.
+func NewBulkOperationConverter() BulkOperationConverter {
+	return &bulkOperationConverter{}
+}
+
+func (c *bulkOperationConverter) ToDomain(operation apiv1alpha1.BulkOperation) domain.BulkOperation {
+	return operation
+}
+
+func (c *bulkOperationConverter) FromDomain(operation *domain.BulkOperation) *apiv1alpha1.BulkOperation {
+	return operation
+}
+
+func (c *bulkOperationConverter) ListFromDomain(l *domain.BulkOperationList) *apiv1alpha1.BulkOperationList {
+	return l
+}
+
+func (c *bulkOperationConverter) ListParamsToDomain(p apiv1alpha1.ListBulkOperationsParams) domain.ListBulkOperationsParams {
+	return p
+}
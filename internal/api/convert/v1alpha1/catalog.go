@@ -16,6 +16,10 @@ type CatalogConverter interface {
 	ItemFromDomain(*domain.CatalogItem) *apiv1alpha1.CatalogItem
 	ItemListFromDomain(*domain.CatalogItemList) *apiv1alpha1.CatalogItemList
 
+	// Install conversions
+	InstallRequestToDomain(apiv1alpha1.CatalogItemInstallRequest) domain.CatalogItemInstallRequest
+	InstallationFromDomain(*domain.CatalogItemInstallation) *apiv1alpha1.CatalogItemInstallation
+
 	// Params conversions
 	ListParamsToDomain(apiv1alpha1.ListCatalogsParams) domain.ListCatalogsParams
 	ListAllItemsParamsToDomain(apiv1alpha1.ListAllCatalogItemsParams) domain.ListAllCatalogItemsParams
@@ -53,6 +57,14 @@ func (c *catalogConverter) ItemListFromDomain(l *domain.CatalogItemList) *apiv1a
 	return l
 }
 
+func (c *catalogConverter) InstallRequestToDomain(r apiv1alpha1.CatalogItemInstallRequest) domain.CatalogItemInstallRequest {
+	return r
+}
+
+func (c *catalogConverter) InstallationFromDomain(i *domain.CatalogItemInstallation) *apiv1alpha1.CatalogItemInstallation {
+	return i
+}
+
 func (c *catalogConverter) ListParamsToDomain(p apiv1alpha1.ListCatalogsParams) domain.ListCatalogsParams {
 	return p
 }
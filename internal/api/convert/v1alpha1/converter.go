@@ -9,23 +9,39 @@ import (
 
 // Converter aggregates all resource-specific converters for v1alpha1 API.
 type Converter interface {
+	BulkOperation() BulkOperationConverter
 	Catalog() CatalogConverter
 	Common() CommonConverter
+	EnrollmentApprovalPolicy() EnrollmentApprovalPolicyConverter
+	Role() RoleConverter
+	RoleBinding() RoleBindingConverter
 }
 
 type converterImpl struct {
-	catalog CatalogConverter
-	common  CommonConverter
+	bulkOperation            BulkOperationConverter
+	catalog                  CatalogConverter
+	common                   CommonConverter
+	enrollmentApprovalPolicy EnrollmentApprovalPolicyConverter
+	role                     RoleConverter
+	roleBinding              RoleBindingConverter
 }
 
 // NewConverter creates a new Converter instance with all resource converters.
 func NewConverter() Converter {
 	return &converterImpl{
-		catalog: NewCatalogConverter(),
-		common:  NewCommonConverter(),
+		bulkOperation:            NewBulkOperationConverter(),
+		catalog:                  NewCatalogConverter(),
+		common:                   NewCommonConverter(),
+		enrollmentApprovalPolicy: NewEnrollmentApprovalPolicyConverter(),
+		role:                     NewRoleConverter(),
+		roleBinding:              NewRoleBindingConverter(),
 	}
 }
 
+func (c *converterImpl) BulkOperation() BulkOperationConverter {
+	return c.bulkOperation
+}
+
 func (c *converterImpl) Catalog() CatalogConverter {
 	return c.catalog
 }
@@ -34,6 +50,18 @@ func (c *converterImpl) Common() CommonConverter {
 	return c.common
 }
 
+func (c *converterImpl) EnrollmentApprovalPolicy() EnrollmentApprovalPolicyConverter {
+	return c.enrollmentApprovalPolicy
+}
+
+func (c *converterImpl) Role() RoleConverter {
+	return c.role
+}
+
+func (c *converterImpl) RoleBinding() RoleBindingConverter {
+	return c.roleBinding
+}
+
 // CommonConverter converts common types shared across API versions.
 type CommonConverter interface {
 	PatchRequestToDomain(apiv1beta1.PatchRequest) domain.PatchRequest
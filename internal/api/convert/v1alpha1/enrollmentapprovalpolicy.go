@@ -0,0 +1,39 @@
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// EnrollmentApprovalPolicyConverter converts between v1alpha1 API types and domain types for EnrollmentApprovalPolicy resources.
+type EnrollmentApprovalPolicyConverter interface {
+	ToDomain(apiv1alpha1.EnrollmentApprovalPolicy) domain.EnrollmentApprovalPolicy
+	FromDomain(*domain.EnrollmentApprovalPolicy) *apiv1alpha1.EnrollmentApprovalPolicy
+	ListFromDomain(*domain.EnrollmentApprovalPolicyList) *apiv1alpha1.EnrollmentApprovalPolicyList
+
+	// Params conversions
+	ListParamsToDomain(apiv1alpha1.ListEnrollmentApprovalPoliciesParams) domain.ListEnrollmentApprovalPoliciesParams
+}
+
+type enrollmentApprovalPolicyConverter struct{}
+
+// NewEnrollmentApprovalPolicyConverter creates a new EnrollmentApprovalPolicyConverter.
+func NewEnrollmentApprovalPolicyConverter() EnrollmentApprovalPolicyConverter {
+	return &enrollmentApprovalPolicyConverter{}
+}
+
+func (c *enrollmentApprovalPolicyConverter) ToDomain(policy apiv1alpha1.EnrollmentApprovalPolicy) domain.EnrollmentApprovalPolicy {
+	return policy
+}
+
+func (c *enrollmentApprovalPolicyConverter) FromDomain(policy *domain.EnrollmentApprovalPolicy) *apiv1alpha1.EnrollmentApprovalPolicy {
+	return policy
+}
+
+func (c *enrollmentApprovalPolicyConverter) ListFromDomain(l *domain.EnrollmentApprovalPolicyList) *apiv1alpha1.EnrollmentApprovalPolicyList {
+	return l
+}
+
+func (c *enrollmentApprovalPolicyConverter) ListParamsToDomain(p apiv1alpha1.ListEnrollmentApprovalPoliciesParams) domain.ListEnrollmentApprovalPoliciesParams {
+	return p
+}
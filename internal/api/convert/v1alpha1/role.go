@@ -0,0 +1,39 @@
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// RoleConverter converts between v1alpha1 API types and domain types for Role resources.
+type RoleConverter interface {
+	ToDomain(apiv1alpha1.Role) domain.Role
+	FromDomain(*domain.Role) *apiv1alpha1.Role
+	ListFromDomain(*domain.RoleList) *apiv1alpha1.RoleList
+
+	// Params conversions
+	ListParamsToDomain(apiv1alpha1.ListRolesParams) domain.ListRolesParams
+}
+
+type roleConverter struct{}
+
+// NewRoleConverter creates a new RoleConverter.
+func NewRoleConverter() RoleConverter {
+	return &roleConverter{}
+}
+
+func (c *roleConverter) ToDomain(role apiv1alpha1.Role) domain.Role {
+	return role
+}
+
+func (c *roleConverter) FromDomain(role *domain.Role) *apiv1alpha1.Role {
+	return role
+}
+
+func (c *roleConverter) ListFromDomain(l *domain.RoleList) *apiv1alpha1.RoleList {
+	return l
+}
+
+func (c *roleConverter) ListParamsToDomain(p apiv1alpha1.ListRolesParams) domain.ListRolesParams {
+	return p
+}
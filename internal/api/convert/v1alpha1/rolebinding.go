@@ -0,0 +1,39 @@
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	"github.com/flightctl/flightctl/internal/domain"
+)
+
+// RoleBindingConverter converts between v1alpha1 API types and domain types for RoleBinding resources.
+type RoleBindingConverter interface {
+	ToDomain(apiv1alpha1.RoleBinding) domain.RoleBinding
+	FromDomain(*domain.RoleBinding) *apiv1alpha1.RoleBinding
+	ListFromDomain(*domain.RoleBindingList) *apiv1alpha1.RoleBindingList
+
+	// Params conversions
+	ListParamsToDomain(apiv1alpha1.ListRoleBindingsParams) domain.ListRoleBindingsParams
+}
+
+type roleBindingConverter struct{}
+
+// NewRoleBindingConverter creates a new RoleBindingConverter.
+func NewRoleBindingConverter() RoleBindingConverter {
+	return &roleBindingConverter{}
+}
+
+func (c *roleBindingConverter) ToDomain(roleBinding apiv1alpha1.RoleBinding) domain.RoleBinding {
+	return roleBinding
+}
+
+func (c *roleBindingConverter) FromDomain(roleBinding *domain.RoleBinding) *apiv1alpha1.RoleBinding {
+	return roleBinding
+}
+
+func (c *roleBindingConverter) ListFromDomain(l *domain.RoleBindingList) *apiv1alpha1.RoleBindingList {
+	return l
+}
+
+func (c *roleBindingConverter) ListParamsToDomain(p apiv1alpha1.ListRoleBindingsParams) domain.ListRoleBindingsParams {
+	return p
+}
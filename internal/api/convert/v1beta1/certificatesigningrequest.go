@@ -13,6 +13,16 @@ type CertificateSigningRequestConverter interface {
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListCertificateSigningRequestsParams) domain.ListCertificateSigningRequestsParams
+
+	// Revocation conversions
+	RevocationRequestToDomain(apiv1beta1.CertificateRevocationRequest) domain.CertificateRevocationRequest
+	RevocationResponseFromDomain(*domain.CertificateRevocationResponse) *apiv1beta1.CertificateRevocationResponse
+	RevocationListParamsToDomain(apiv1beta1.GetCertificateRevocationListParams) domain.GetCertificateRevocationListParams
+
+	// CA rotation conversions
+	CARotationStatusFromDomain(*domain.CARotationStatus) *apiv1beta1.CARotationStatus
+	CARotationDeviceListFromDomain(*domain.CARotationDeviceList) *apiv1beta1.CARotationDeviceList
+	CARotationDeviceListParamsToDomain(apiv1beta1.ListCARotationDevicesParams) domain.ListCARotationDevicesParams
 }
 
 type certificateSigningRequestConverter struct{}
@@ -37,3 +47,27 @@ func (c *certificateSigningRequestConverter) ListFromDomain(l *domain.Certificat
 func (c *certificateSigningRequestConverter) ListParamsToDomain(p apiv1beta1.ListCertificateSigningRequestsParams) domain.ListCertificateSigningRequestsParams {
 	return p
 }
+
+func (c *certificateSigningRequestConverter) RevocationRequestToDomain(r apiv1beta1.CertificateRevocationRequest) domain.CertificateRevocationRequest {
+	return r
+}
+
+func (c *certificateSigningRequestConverter) RevocationResponseFromDomain(r *domain.CertificateRevocationResponse) *apiv1beta1.CertificateRevocationResponse {
+	return r
+}
+
+func (c *certificateSigningRequestConverter) RevocationListParamsToDomain(p apiv1beta1.GetCertificateRevocationListParams) domain.GetCertificateRevocationListParams {
+	return p
+}
+
+func (c *certificateSigningRequestConverter) CARotationStatusFromDomain(s *domain.CARotationStatus) *apiv1beta1.CARotationStatus {
+	return s
+}
+
+func (c *certificateSigningRequestConverter) CARotationDeviceListFromDomain(l *domain.CARotationDeviceList) *apiv1beta1.CARotationDeviceList {
+	return l
+}
+
+func (c *certificateSigningRequestConverter) CARotationDeviceListParamsToDomain(p apiv1beta1.ListCARotationDevicesParams) domain.ListCARotationDevicesParams {
+	return p
+}
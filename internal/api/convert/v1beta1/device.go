@@ -17,10 +17,12 @@ type DeviceConverter interface {
 	ResumeRequestToDomain(apiv1beta1.DeviceResumeRequest) domain.DeviceResumeRequest
 	ResumeResponseFromDomain(domain.DeviceResumeResponse) apiv1beta1.DeviceResumeResponse
 	LastSeenFromDomain(*domain.DeviceLastSeen) *apiv1beta1.DeviceLastSeen
+	AggregateFromDomain(*domain.DeviceAggregate) *apiv1beta1.DeviceAggregate
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListDevicesParams) domain.ListDevicesParams
 	GetRenderedParamsToDomain(apiv1beta1.GetRenderedDeviceParams) domain.GetRenderedDeviceParams
+	AggregateParamsToDomain(apiv1beta1.AggregateDevicesParams) domain.AggregateDevicesParams
 }
 
 type deviceConverter struct{}
@@ -58,6 +60,10 @@ func (c *deviceConverter) LastSeenFromDomain(l *domain.DeviceLastSeen) *apiv1bet
 	return l
 }
 
+func (c *deviceConverter) AggregateFromDomain(a *domain.DeviceAggregate) *apiv1beta1.DeviceAggregate {
+	return a
+}
+
 func (c *deviceConverter) ListParamsToDomain(p apiv1beta1.ListDevicesParams) domain.ListDevicesParams {
 	return p
 }
@@ -65,3 +71,7 @@ func (c *deviceConverter) ListParamsToDomain(p apiv1beta1.ListDevicesParams) dom
 func (c *deviceConverter) GetRenderedParamsToDomain(p apiv1beta1.GetRenderedDeviceParams) domain.GetRenderedDeviceParams {
 	return p
 }
+
+func (c *deviceConverter) AggregateParamsToDomain(p apiv1beta1.AggregateDevicesParams) domain.AggregateDevicesParams {
+	return p
+}
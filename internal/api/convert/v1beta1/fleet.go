@@ -10,6 +10,7 @@ type FleetConverter interface {
 	ToDomain(apiv1beta1.Fleet) domain.Fleet
 	FromDomain(*domain.Fleet) *apiv1beta1.Fleet
 	ListFromDomain(*domain.FleetList) *apiv1beta1.FleetList
+	RollbackRequestToDomain(apiv1beta1.FleetRollbackRequest) domain.FleetRollbackRequest
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListFleetsParams) domain.ListFleetsParams
@@ -35,6 +36,10 @@ func (c *fleetConverter) ListFromDomain(l *domain.FleetList) *apiv1beta1.FleetLi
 	return l
 }
 
+func (c *fleetConverter) RollbackRequestToDomain(r apiv1beta1.FleetRollbackRequest) domain.FleetRollbackRequest {
+	return r
+}
+
 func (c *fleetConverter) ListParamsToDomain(p apiv1beta1.ListFleetsParams) domain.ListFleetsParams {
 	return p
 }
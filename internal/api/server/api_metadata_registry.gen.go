@@ -9,9 +9,13 @@ import (
 )
 const (
 	API_RESOURCE_AUTHPROVIDERS = "authproviders"
+	API_RESOURCE_BULKOPERATIONS = "bulkoperations"
+	API_RESOURCE_BULKOPERATIONS_CANCEL = "bulkoperations/cancel"
+	API_RESOURCE_CAROTATION = "carotation"
 	API_RESOURCE_CATALOGITEMS = "catalogitems"
 	API_RESOURCE_CATALOGS = "catalogs"
 	API_RESOURCE_CATALOGS_ITEMS = "catalogs/items"
+	API_RESOURCE_CATALOGS_ITEMS_INSTALL = "catalogs/items/install"
 	API_RESOURCE_CERTIFICATESIGNINGREQUESTS = "certificatesigningrequests"
 	API_RESOURCE_CERTIFICATESIGNINGREQUESTS_APPROVAL = "certificatesigningrequests/approval"
 	API_RESOURCE_DEVICES = "devices"
@@ -20,17 +24,23 @@ const (
 	API_RESOURCE_DEVICES_RENDERED = "devices/rendered"
 	API_RESOURCE_DEVICES_RESUME = "devices/resume"
 	API_RESOURCE_DEVICES_STATUS = "devices/status"
+	API_RESOURCE_ENROLLMENTAPPROVALPOLICIES = "enrollmentapprovalpolicies"
 	API_RESOURCE_ENROLLMENTREQUESTS = "enrollmentrequests"
 	API_RESOURCE_ENROLLMENTREQUESTS_APPROVAL = "enrollmentrequests/approval"
 	API_RESOURCE_ENROLLMENTREQUESTS_STATUS = "enrollmentrequests/status"
 	API_RESOURCE_EVENTS = "events"
 	API_RESOURCE_FLEETS = "fleets"
+	API_RESOURCE_FLEETS_ROLLBACK = "fleets/rollback"
 	API_RESOURCE_FLEETS_STATUS = "fleets/status"
 	API_RESOURCE_FLEETS_TEMPLATEVERSIONS = "fleets/templateversions"
 	API_RESOURCE_LABELS = "labels"
 	API_RESOURCE_ORGANIZATIONS = "organizations"
 	API_RESOURCE_REPOSITORIES = "repositories"
 	API_RESOURCE_RESOURCESYNCS = "resourcesyncs"
+	API_RESOURCE_REVOKEDCERTIFICATES = "revokedcertificates"
+	API_RESOURCE_REVOKEDCERTIFICATES_CRL = "revokedcertificates/crl"
+	API_RESOURCE_ROLEBINDINGS = "rolebindings"
+	API_RESOURCE_ROLES = "roles"
 )
 const (
 	API_ACTION_CREATE = "create"
@@ -142,6 +152,62 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/bulkoperations": {
+		OperationID: "listBulkOperations",
+		Resource:    "bulkoperations",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/bulkoperations": {
+		OperationID: "createBulkOperation",
+		Resource:    "bulkoperations",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/bulkoperations/{name}": {
+		OperationID: "deleteBulkOperation",
+		Resource:    "bulkoperations",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/bulkoperations/{name}": {
+		OperationID: "getBulkOperation",
+		Resource:    "bulkoperations",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/bulkoperations/{name}/cancel": {
+		OperationID: "cancelBulkOperation",
+		Resource:    "bulkoperations/cancel",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/carotation": {
+		OperationID: "getCARotationStatus",
+		Resource:    "carotation",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/carotation/devices": {
+		OperationID: "listCARotationDevices",
+		Resource:    "devices",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/catalogitems": {
 		OperationID: "listAllCatalogItems",
 		Resource:    "catalogitems",
@@ -206,6 +272,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1alpha1", DeprecatedAt: nil},
 		},
 	},
+	"POST:/catalogs/{catalog}/items/{name}/install": {
+		OperationID: "installCatalogItem",
+		Resource:    "catalogs/items/install",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
 	"DELETE:/catalogs/{name}": {
 		OperationID: "deleteCatalog",
 		Resource:    "catalogs",
@@ -342,6 +416,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/devices/aggregate": {
+		OperationID: "aggregateDevices",
+		Resource:    "devices",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"DELETE:/devices/{name}": {
 		OperationID: "deleteDevice",
 		Resource:    "devices",
@@ -422,6 +504,46 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/enrollmentapprovalpolicies": {
+		OperationID: "listEnrollmentApprovalPolicies",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/enrollmentapprovalpolicies": {
+		OperationID: "createEnrollmentApprovalPolicy",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/enrollmentapprovalpolicies/{name}": {
+		OperationID: "deleteEnrollmentApprovalPolicy",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/enrollmentapprovalpolicies/{name}": {
+		OperationID: "getEnrollmentApprovalPolicy",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/enrollmentapprovalpolicies/{name}": {
+		OperationID: "replaceEnrollmentApprovalPolicy",
+		Resource:    "enrollmentapprovalpolicies",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/enrollmentconfig": {
 		OperationID: "getEnrollmentConfig",
 		Resource:    "",
@@ -590,6 +712,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"POST:/fleets/{name}/rollback": {
+		OperationID: "rollbackFleet",
+		Resource:    "fleets/rollback",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/fleets/{name}/status": {
 		OperationID: "getFleetStatus",
 		Resource:    "fleets/status",
@@ -726,6 +856,102 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"POST:/revokedcertificates": {
+		OperationID: "revokeCertificates",
+		Resource:    "revokedcertificates",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/revokedcertificates/crl": {
+		OperationID: "getCertificateRevocationList",
+		Resource:    "revokedcertificates/crl",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/rolebindings": {
+		OperationID: "listRoleBindings",
+		Resource:    "rolebindings",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/rolebindings": {
+		OperationID: "createRoleBinding",
+		Resource:    "rolebindings",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/rolebindings/{name}": {
+		OperationID: "deleteRoleBinding",
+		Resource:    "rolebindings",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/rolebindings/{name}": {
+		OperationID: "getRoleBinding",
+		Resource:    "rolebindings",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/rolebindings/{name}": {
+		OperationID: "replaceRoleBinding",
+		Resource:    "rolebindings",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/roles": {
+		OperationID: "listRoles",
+		Resource:    "roles",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"POST:/roles": {
+		OperationID: "createRole",
+		Resource:    "roles",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"DELETE:/roles/{name}": {
+		OperationID: "deleteRole",
+		Resource:    "roles",
+		Action:      "delete",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"GET:/roles/{name}": {
+		OperationID: "getRole",
+		Resource:    "roles",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
+	"PUT:/roles/{name}": {
+		OperationID: "replaceRole",
+		Resource:    "roles",
+		Action:      "update",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1alpha1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/version": {
 		OperationID: "getVersion",
 		Resource:    "",
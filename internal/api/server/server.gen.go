@@ -51,6 +51,12 @@ type ServerInterface interface {
 	// (PUT /authproviders/{name})
 	ReplaceAuthProvider(w http.ResponseWriter, r *http.Request, name string)
 
+	// (GET /carotation)
+	GetCARotationStatus(w http.ResponseWriter, r *http.Request)
+
+	// (GET /carotation/devices)
+	ListCARotationDevices(w http.ResponseWriter, r *http.Request, params ListCARotationDevicesParams)
+
 	// (GET /certificatesigningrequests)
 	ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request, params ListCertificateSigningRequestsParams)
 
@@ -79,7 +85,10 @@ type ServerInterface interface {
 	ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams)
 
 	// (POST /devices)
-	CreateDevice(w http.ResponseWriter, r *http.Request)
+	CreateDevice(w http.ResponseWriter, r *http.Request, params CreateDeviceParams)
+
+	// (GET /devices/aggregate)
+	AggregateDevices(w http.ResponseWriter, r *http.Request, params AggregateDevicesParams)
 
 	// (DELETE /devices/{name})
 	DeleteDevice(w http.ResponseWriter, r *http.Request, name string)
@@ -88,10 +97,10 @@ type ServerInterface interface {
 	GetDevice(w http.ResponseWriter, r *http.Request, name string)
 
 	// (PATCH /devices/{name})
-	PatchDevice(w http.ResponseWriter, r *http.Request, name string)
+	PatchDevice(w http.ResponseWriter, r *http.Request, name string, params PatchDeviceParams)
 
 	// (PUT /devices/{name})
-	ReplaceDevice(w http.ResponseWriter, r *http.Request, name string)
+	ReplaceDevice(w http.ResponseWriter, r *http.Request, name string, params ReplaceDeviceParams)
 
 	// (PUT /devices/{name}/decommission)
 	DecommissionDevice(w http.ResponseWriter, r *http.Request, name string)
@@ -151,7 +160,7 @@ type ServerInterface interface {
 	ListFleets(w http.ResponseWriter, r *http.Request, params ListFleetsParams)
 
 	// (POST /fleets)
-	CreateFleet(w http.ResponseWriter, r *http.Request)
+	CreateFleet(w http.ResponseWriter, r *http.Request, params CreateFleetParams)
 
 	// (GET /fleets/{fleet}/templateversions)
 	ListTemplateVersions(w http.ResponseWriter, r *http.Request, fleet string, params ListTemplateVersionsParams)
@@ -169,10 +178,13 @@ type ServerInterface interface {
 	GetFleet(w http.ResponseWriter, r *http.Request, name string, params GetFleetParams)
 
 	// (PATCH /fleets/{name})
-	PatchFleet(w http.ResponseWriter, r *http.Request, name string)
+	PatchFleet(w http.ResponseWriter, r *http.Request, name string, params PatchFleetParams)
 
 	// (PUT /fleets/{name})
-	ReplaceFleet(w http.ResponseWriter, r *http.Request, name string)
+	ReplaceFleet(w http.ResponseWriter, r *http.Request, name string, params ReplaceFleetParams)
+
+	// (POST /fleets/{name}/rollback)
+	RollbackFleet(w http.ResponseWriter, r *http.Request, name string)
 
 	// (GET /fleets/{name}/status)
 	GetFleetStatus(w http.ResponseWriter, r *http.Request, name string)
@@ -225,6 +237,12 @@ type ServerInterface interface {
 	// (PUT /resourcesyncs/{name})
 	ReplaceResourceSync(w http.ResponseWriter, r *http.Request, name string)
 
+	// (POST /revokedcertificates)
+	RevokeCertificates(w http.ResponseWriter, r *http.Request)
+
+	// (GET /revokedcertificates/crl)
+	GetCertificateRevocationList(w http.ResponseWriter, r *http.Request, params GetCertificateRevocationListParams)
+
 	// (GET /version)
 	GetVersion(w http.ResponseWriter, r *http.Request)
 }
@@ -288,6 +306,16 @@ func (_ Unimplemented) ReplaceAuthProvider(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /carotation)
+func (_ Unimplemented) GetCARotationStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /carotation/devices)
+func (_ Unimplemented) ListCARotationDevices(w http.ResponseWriter, r *http.Request, params ListCARotationDevicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /certificatesigningrequests)
 func (_ Unimplemented) ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request, params ListCertificateSigningRequestsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -334,7 +362,12 @@ func (_ Unimplemented) ListDevices(w http.ResponseWriter, r *http.Request, param
 }
 
 // (POST /devices)
-func (_ Unimplemented) CreateDevice(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateDevice(w http.ResponseWriter, r *http.Request, params CreateDeviceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /devices/aggregate)
+func (_ Unimplemented) AggregateDevices(w http.ResponseWriter, r *http.Request, params AggregateDevicesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -349,12 +382,12 @@ func (_ Unimplemented) GetDevice(w http.ResponseWriter, r *http.Request, name st
 }
 
 // (PATCH /devices/{name})
-func (_ Unimplemented) PatchDevice(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) PatchDevice(w http.ResponseWriter, r *http.Request, name string, params PatchDeviceParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (PUT /devices/{name})
-func (_ Unimplemented) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string, params ReplaceDeviceParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -454,7 +487,7 @@ func (_ Unimplemented) ListFleets(w http.ResponseWriter, r *http.Request, params
 }
 
 // (POST /fleets)
-func (_ Unimplemented) CreateFleet(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateFleet(w http.ResponseWriter, r *http.Request, params CreateFleetParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -484,12 +517,17 @@ func (_ Unimplemented) GetFleet(w http.ResponseWriter, r *http.Request, name str
 }
 
 // (PATCH /fleets/{name})
-func (_ Unimplemented) PatchFleet(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) PatchFleet(w http.ResponseWriter, r *http.Request, name string, params PatchFleetParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (PUT /fleets/{name})
-func (_ Unimplemented) ReplaceFleet(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) ReplaceFleet(w http.ResponseWriter, r *http.Request, name string, params ReplaceFleetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /fleets/{name}/rollback)
+func (_ Unimplemented) RollbackFleet(w http.ResponseWriter, r *http.Request, name string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -579,6 +617,16 @@ func (_ Unimplemented) ReplaceResourceSync(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /revokedcertificates)
+func (_ Unimplemented) RevokeCertificates(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /revokedcertificates/crl)
+func (_ Unimplemented) GetCertificateRevocationList(w http.ResponseWriter, r *http.Request, params GetCertificateRevocationListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /version)
 func (_ Unimplemented) GetVersion(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -865,6 +913,71 @@ func (siw *ServerInterfaceWrapper) ReplaceAuthProvider(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// GetCARotationStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetCARotationStatus(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCARotationStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCARotationDevices operation middleware
+func (siw *ServerInterfaceWrapper) ListCARotationDevices(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCARotationDevicesParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCARotationDevices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListCertificateSigningRequests operation middleware
 func (siw *ServerInterfaceWrapper) ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request) {
 
@@ -1117,6 +1230,22 @@ func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Optional query parameter "watch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "watch", r.URL.Query(), &params.Watch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resourceVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceVersion", r.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceVersion", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListDevices(w, r, params)
 	}))
@@ -1131,8 +1260,79 @@ func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Re
 // CreateDevice operation middleware
 func (siw *ServerInterfaceWrapper) CreateDevice(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDeviceParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDevice(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AggregateDevices operation middleware
+func (siw *ServerInterfaceWrapper) AggregateDevices(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AggregateDevicesParams
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "groupBy" -------------
+
+	if paramValue := r.URL.Query().Get("groupBy"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "groupBy"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "groupBy", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDevice(w, r)
+		siw.Handler.AggregateDevices(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1206,8 +1406,19 @@ func (siw *ServerInterfaceWrapper) PatchDevice(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchDeviceParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchDevice(w, r, name)
+		siw.Handler.PatchDevice(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1231,8 +1442,19 @@ func (siw *ServerInterfaceWrapper) ReplaceDevice(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceDeviceParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceDevice(w, r, name)
+		siw.Handler.ReplaceDevice(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1470,6 +1692,22 @@ func (siw *ServerInterfaceWrapper) ListEnrollmentRequests(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Optional query parameter "watch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "watch", r.URL.Query(), &params.Watch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resourceVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceVersion", r.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceVersion", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListEnrollmentRequests(w, r, params)
 	}))
@@ -1735,6 +1973,22 @@ func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "watch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "watch", r.URL.Query(), &params.Watch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resourceVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceVersion", r.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceVersion", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListEvents(w, r, params)
 	}))
@@ -1794,6 +2048,22 @@ func (siw *ServerInterfaceWrapper) ListFleets(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "watch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "watch", r.URL.Query(), &params.Watch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resourceVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceVersion", r.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceVersion", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListFleets(w, r, params)
 	}))
@@ -1808,8 +2078,21 @@ func (siw *ServerInterfaceWrapper) ListFleets(w http.ResponseWriter, r *http.Req
 // CreateFleet operation middleware
 func (siw *ServerInterfaceWrapper) CreateFleet(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateFleetParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateFleet(w, r)
+		siw.Handler.CreateFleet(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2022,8 +2305,19 @@ func (siw *ServerInterfaceWrapper) PatchFleet(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchFleetParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchFleet(w, r, name)
+		siw.Handler.PatchFleet(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2047,8 +2341,44 @@ func (siw *ServerInterfaceWrapper) ReplaceFleet(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceFleetParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceFleet(w, r, name)
+		siw.Handler.ReplaceFleet(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RollbackFleet operation middleware
+func (siw *ServerInterfaceWrapper) RollbackFleet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackFleet(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2548,6 +2878,47 @@ func (siw *ServerInterfaceWrapper) ReplaceResourceSync(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// RevokeCertificates operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCertificates(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeCertificates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCertificateRevocationList operation middleware
+func (siw *ServerInterfaceWrapper) GetCertificateRevocationList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCertificateRevocationListParams
+
+	// ------------- Optional query parameter "issuerKeyId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "issuerKeyId", r.URL.Query(), &params.IssuerKeyId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "issuerKeyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCertificateRevocationList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetVersion operation middleware
 func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
 
@@ -2708,6 +3079,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/authproviders/{name}", wrapper.ReplaceAuthProvider)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/carotation", wrapper.GetCARotationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/carotation/devices", wrapper.ListCARotationDevices)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/certificatesigningrequests", wrapper.ListCertificateSigningRequests)
 	})
@@ -2738,6 +3115,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/devices", wrapper.CreateDevice)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devices/aggregate", wrapper.AggregateDevices)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/devices/{name}", wrapper.DeleteDevice)
 	})
@@ -2831,6 +3211,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/fleets/{name}", wrapper.ReplaceFleet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/fleets/{name}/rollback", wrapper.RollbackFleet)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/fleets/{name}/status", wrapper.GetFleetStatus)
 	})
@@ -2882,6 +3265,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/resourcesyncs/{name}", wrapper.ReplaceResourceSync)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/revokedcertificates", wrapper.RevokeCertificates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/revokedcertificates/crl", wrapper.GetCertificateRevocationList)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/version", wrapper.GetVersion)
 	})
@@ -31,6 +31,7 @@ type AgentGrpcServer struct {
 	log            logrus.FieldLogger
 	cfg            *config.Config
 	service        service.Service
+	revocations    middleware.RevocationChecker
 	pendingStreams *sync.Map
 	server         *grpc.Server
 }
@@ -40,11 +41,13 @@ func NewAgentGrpcServer(
 	log logrus.FieldLogger,
 	cfg *config.Config,
 	svc service.Service,
+	revocations middleware.RevocationChecker,
 ) *AgentGrpcServer {
 	agentServer := &AgentGrpcServer{
 		log:            log,
 		cfg:            cfg,
 		service:        svc,
+		revocations:    revocations,
 		pendingStreams: &sync.Map{},
 	}
 	agentServer.prepareGRPCService()
@@ -54,7 +57,7 @@ func NewAgentGrpcServer(
 func (s *AgentGrpcServer) prepareGRPCService() {
 	s.server = grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()), // enables tracing
-		grpc.ChainStreamInterceptor(grpcAuth.StreamServerInterceptor(middleware.GrpcAuthMiddleware)),
+		grpc.ChainStreamInterceptor(grpcAuth.StreamServerInterceptor(middleware.NewGrpcAuthMiddleware(s.revocations))),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			MaxConnectionIdle: 15 * time.Minute, // Close idle connections after 15 minutes
 			Time:              2 * time.Minute,  // Send keepalive ping every 2 minutes
@@ -32,6 +32,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
+	"gorm.io/gorm"
 )
 
 type AgentServer struct {
@@ -45,6 +46,7 @@ type AgentServer struct {
 	agentGrpcServer *AgentGrpcServer
 	serviceHandler  service.Service
 	kvStore         kvstore.KVStore
+	db              *gorm.DB
 }
 
 // New returns a new instance of a flightctl server.
@@ -57,6 +59,7 @@ func New(
 	listener net.Listener,
 	queuesProvider queues.Provider,
 	tlsConfig *tls.Config,
+	db *gorm.DB,
 ) (*AgentServer, error) {
 	s := &AgentServer{
 		log:            log,
@@ -66,6 +69,7 @@ func New(
 		listener:       listener,
 		queuesProvider: queuesProvider,
 		tlsConfig:      tlsConfig,
+		db:             db,
 	}
 
 	if err := s.init(ctx); err != nil {
@@ -86,7 +90,7 @@ func (s *AgentServer) init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	s.kvStore, err = kvstore.NewKVStore(ctx, s.log, s.cfg.KV.Hostname, s.cfg.KV.Port, s.cfg.KV.Password)
+	s.kvStore, err = kvstore.New(ctx, s.log, s.cfg, s.db)
 	if err != nil {
 		return err
 	}
@@ -95,7 +99,7 @@ func (s *AgentServer) init(ctx context.Context) error {
 	s.serviceHandler = service.WrapWithTracing(
 		service.NewServiceHandler(s.store, workerClient, s.kvStore, s.ca, s.log, s.cfg.Service.AgentEndpointAddress, s.cfg.Service.BaseUIUrl, s.cfg.Service.TPMCAPaths))
 
-	s.agentGrpcServer = NewAgentGrpcServer(s.log, s.cfg, s.serviceHandler)
+	s.agentGrpcServer = NewAgentGrpcServer(s.log, s.cfg, s.serviceHandler, s.store.RevokedCertificate())
 	return nil
 }
 
@@ -206,7 +210,7 @@ func isEnrollmentRequest(r *http.Request) bool {
 
 func (s *AgentServer) prepareHTTPHandler(ctx context.Context, serviceHandler service.Service) (http.Handler, error) {
 	// Create agent authentication middleware for device operations
-	agentAuthMiddleware := fcmiddleware.NewAgentAuthMiddleware(s.ca, s.log)
+	agentAuthMiddleware := fcmiddleware.NewAgentAuthMiddleware(s.ca, s.store.RevokedCertificate(), s.log)
 	go agentAuthMiddleware.Start()
 
 	// Create enrollment authentication middleware for enrollment/bootstrap operations
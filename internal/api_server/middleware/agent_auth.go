@@ -17,36 +17,56 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// revocationCacheTTL bounds how long a revocation takes to be enforced for a certificate that was
+// checked just before being revoked.
+const revocationCacheTTL = 30 * time.Second
+
+// RevocationChecker reports whether a certificate, identified by the hex-encoded key identifier of
+// its issuer and its hex-encoded serial number, has been revoked.
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, issuerKeyID string, serialNumber string) (bool, error)
+}
+
 // AgentAuthMiddleware handles certificate-based authentication for device agents.
 // It authenticates agents presenting a device management client certificate issued by either:
 // - the initial device management signer, or
 // - the device management renewal signer (for rotated certs).
+// Certificates that have been revoked are refused.
 type AgentAuthMiddleware struct {
-	ca    *crypto.CAClient
-	log   logrus.FieldLogger
-	cache *ttlcache.Cache[string, *AgentIdentity]
+	ca              *crypto.CAClient
+	revocations     RevocationChecker
+	log             logrus.FieldLogger
+	cache           *ttlcache.Cache[string, *AgentIdentity]
+	revocationCache *ttlcache.Cache[string, bool]
 }
 
 // NewAgentAuthMiddleware creates a new device agent authentication middleware
-func NewAgentAuthMiddleware(ca *crypto.CAClient, log logrus.FieldLogger) *AgentAuthMiddleware {
+func NewAgentAuthMiddleware(ca *crypto.CAClient, revocations RevocationChecker, log logrus.FieldLogger) *AgentAuthMiddleware {
 	cache := ttlcache.New(
 		ttlcache.WithTTL[string, *AgentIdentity](10 * time.Minute),
 	)
+	revocationCache := ttlcache.New(
+		ttlcache.WithTTL[string, bool](revocationCacheTTL),
+	)
 
 	return &AgentAuthMiddleware{
-		ca:    ca,
-		log:   log,
-		cache: cache,
+		ca:              ca,
+		revocations:     revocations,
+		log:             log,
+		cache:           cache,
+		revocationCache: revocationCache,
 	}
 }
 
 // Start starts the cache background cleanup
 func (m *AgentAuthMiddleware) Start() {
+	go m.revocationCache.Start()
 	m.cache.Start()
 }
 
 // Stop stops the cache background cleanup
 func (m *AgentAuthMiddleware) Stop() {
+	m.revocationCache.Stop()
 	m.cache.Stop()
 }
 
@@ -62,6 +82,19 @@ func (m *AgentAuthMiddleware) AuthenticateAgent(next http.Handler) http.Handler
 			return
 		}
 
+		// Refuse revoked certificates, including ones whose identity is already cached
+		revoked, err := m.isRevoked(ctx, r.TLS)
+		if err != nil {
+			m.log.Errorf("Failed to check agent certificate revocation: %v", err)
+			http.Error(w, "failed to check certificate revocation", http.StatusServiceUnavailable)
+			return
+		}
+		if revoked {
+			m.log.Warn("Agent presented a revoked certificate")
+			http.Error(w, "client certificate has been revoked", http.StatusUnauthorized)
+			return
+		}
+
 		// Create cache key from certificate fingerprint
 		cacheKey := m.createCacheKey(r.TLS)
 		if cacheKey != "" {
@@ -175,6 +208,26 @@ func (m *AgentAuthMiddleware) createCacheKey(tlsState *tls.ConnectionState) stri
 	return fmt.Sprintf("agent:%x", cert.Raw)
 }
 
+// isRevoked checks whether the client certificate has been revoked, caching the result briefly
+func (m *AgentAuthMiddleware) isRevoked(ctx context.Context, tlsState *tls.ConnectionState) (bool, error) {
+	if m.revocations == nil || len(tlsState.PeerCertificates) == 0 {
+		return false, nil
+	}
+
+	issuerKeyID, serialNumber := crypto.RevocationKeyFromCert(tlsState.PeerCertificates[0])
+	key := issuerKeyID + "/" + serialNumber
+	if item := m.revocationCache.Get(key); item != nil {
+		return item.Value(), nil
+	}
+
+	revoked, err := m.revocations.IsRevoked(ctx, issuerKeyID, serialNumber)
+	if err != nil {
+		return false, err
+	}
+	m.revocationCache.Set(key, revoked, ttlcache.DefaultTTL)
+	return revoked, nil
+}
+
 // DeviceInfo contains information extracted from the agent certificate
 type DeviceInfo struct {
 	DeviceFingerprint string
@@ -2,9 +2,34 @@ package middleware
 
 import (
 	"context"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
-// client has to present client TLS certificate
-func GrpcAuthMiddleware(ctx context.Context) (context.Context, error) {
-	return ValidateClientTlsCert(ctx)
+// NewGrpcAuthMiddleware returns a gRPC auth function that requires the client to present a verified
+// TLS certificate that has not been revoked.
+func NewGrpcAuthMiddleware(revocations RevocationChecker) func(ctx context.Context) (context.Context, error) {
+	return func(ctx context.Context) (context.Context, error) {
+		ctx, err := ValidateClientTlsCert(ctx)
+		if err != nil || revocations == nil {
+			return ctx, err
+		}
+
+		// ValidateClientTlsCert guarantees a verified peer certificate
+		p, _ := peer.FromContext(ctx)
+		tlsInfo, _ := p.AuthInfo.(credentials.TLSInfo)
+		issuerKeyID, serialNumber := crypto.RevocationKeyFromCert(tlsInfo.State.VerifiedChains[0][0])
+		revoked, err := revocations.IsRevoked(ctx, issuerKeyID, serialNumber)
+		if err != nil {
+			return ctx, status.Error(codes.Unavailable, "failed to check certificate revocation")
+		}
+		if revoked {
+			return ctx, status.Error(codes.Unauthenticated, "client certificate has been revoked")
+		}
+		return ctx, nil
+	}
 }
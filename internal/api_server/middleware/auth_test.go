@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type fakeRevocationChecker struct {
+	revoked map[string]bool
+	err     error
+}
+
+func (f *fakeRevocationChecker) IsRevoked(_ context.Context, issuerKeyID string, serialNumber string) (bool, error) {
+	return f.revoked[issuerKeyID+"/"+serialNumber], f.err
+}
+
+// contextWithVerifiedPeer returns a context of a gRPC call whose client presented the certificate.
+func contextWithVerifiedPeer(cert *x509.Certificate) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	})
+}
+
+func TestGrpcAuthMiddleware(t *testing.T) {
+	cert := &x509.Certificate{SerialNumber: big.NewInt(0x1f), AuthorityKeyId: []byte{0xab, 0xcd}}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		revocations  RevocationChecker
+		expectedCode codes.Code
+	}{
+		{
+			name:         "no peer",
+			ctx:          context.Background(),
+			revocations:  &fakeRevocationChecker{},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "valid certificate",
+			ctx:          contextWithVerifiedPeer(cert),
+			revocations:  &fakeRevocationChecker{},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "revoked certificate",
+			ctx:          contextWithVerifiedPeer(cert),
+			revocations:  &fakeRevocationChecker{revoked: map[string]bool{"abcd/1f": true}},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "revocation check fails",
+			ctx:          contextWithVerifiedPeer(cert),
+			revocations:  &fakeRevocationChecker{err: errors.New("database unavailable")},
+			expectedCode: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewGrpcAuthMiddleware(tt.revocations)(tt.ctx)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/flightctl/flightctl/internal/api_server/versioning"
 	"github.com/flightctl/flightctl/internal/auth"
 	"github.com/flightctl/flightctl/internal/auth/authn"
+	"github.com/flightctl/flightctl/internal/auth/authz"
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/console"
 	"github.com/flightctl/flightctl/internal/crypto"
@@ -36,6 +37,7 @@ import (
 	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"gorm.io/gorm"
 )
 
 // customTransportHandler wraps the transport handler to exclude the auth validate endpoint
@@ -60,6 +62,7 @@ type Server struct {
 	consoleEndpointReg console.InternalSessionRegistration
 	authN              *authn.MultiAuth
 	authZ              auth.AuthZMiddleware
+	db                 *gorm.DB
 }
 
 // New returns a new instance of a flightctl server.
@@ -71,6 +74,7 @@ func New(
 	listener net.Listener,
 	queuesProvider queues.Provider,
 	consoleEndpointReg console.InternalSessionRegistration,
+	db *gorm.DB,
 ) *Server {
 	return &Server{
 		log:                log,
@@ -80,6 +84,7 @@ func New(
 		listener:           listener,
 		queuesProvider:     queuesProvider,
 		consoleEndpointReg: consoleEndpointReg,
+		db:                 db,
 	}
 }
 
@@ -143,7 +148,7 @@ func (s *Server) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	kvStore, err := kvstore.NewKVStore(ctx, s.log, s.cfg.KV.Hostname, s.cfg.KV.Port, s.cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, s.log, s.cfg, s.db)
 	if err != nil {
 		return err
 	}
@@ -176,7 +181,8 @@ func (s *Server) Run(ctx context.Context) error {
 		s.log.Warn("Auth provider loader stopped unexpectedly")
 	}()
 
-	s.authZ, err = auth.InitMultiAuthZ(s.cfg, s.log)
+	customRoles := authz.NewCustomRoles(authz.NewCustomRoleStore(s.store), s.log)
+	s.authZ, err = auth.InitMultiAuthZ(s.cfg, s.log, authz.WithCustomRoles(customRoles))
 	if err != nil {
 		return fmt.Errorf("failed initializing authZ: %w", err)
 	}
@@ -261,7 +267,7 @@ func (s *Server) Run(ctx context.Context) error {
 
 	// Create v1alpha1 transport handler for alpha-stage resources (Catalog)
 	handlerV1Alpha1 := transportv1alpha1.NewTransportHandler(
-		serviceHandler, convertv1alpha1.NewConverter(),
+		serviceHandler, convertv1alpha1.NewConverter(), s.authZ,
 	)
 
 	routerV1Alpha1 := versioning.NewRouter(versioning.RouterConfig{
@@ -706,7 +706,7 @@ func equalRoleAssignments(a, b api.AuthRoleAssignment) bool {
 		if aSpec.Separator != nil && bSpec.Separator != nil && *aSpec.Separator != *bSpec.Separator {
 			return false
 		}
-		return true
+		return reflect.DeepEqual(lo.FromPtr(aSpec.RoleMapping), lo.FromPtr(bSpec.RoleMapping))
 
 	default:
 		return false
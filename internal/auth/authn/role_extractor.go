@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -104,7 +105,7 @@ func (r *RoleExtractor) extractDynamicOrgRolesFromMap(claims map[string]interfac
 			// Last part - extract the roles array
 			if value, exists := current[part]; exists {
 				if roles, ok := value.([]interface{}); ok {
-					return r.parseRolesWithSeparator(roles, separator)
+					return r.parseRolesWithSeparator(roles, separator, lo.FromPtr(dynamicRoleAssignment.RoleMapping))
 				}
 				r.log.Warnf("RoleExtractor: claim value is not an array: %T", value)
 			} else {
@@ -140,8 +141,9 @@ func getMapKeys(m map[string]interface{}) []string {
 	return keys
 }
 
-// parseRolesWithSeparator parses role strings and separates org-scoped from global roles
-func (r *RoleExtractor) parseRolesWithSeparator(roles []interface{}, separator string) map[string][]string {
+// parseRolesWithSeparator parses role strings and separates org-scoped from global roles.
+// Role names are translated through roleMapping, for example to map identity provider groups onto roles.
+func (r *RoleExtractor) parseRolesWithSeparator(roles []interface{}, separator string, roleMapping map[string][]string) map[string][]string {
 	orgRoles := make(map[string][]string)
 
 	for _, role := range roles {
@@ -150,39 +152,30 @@ func (r *RoleExtractor) parseRolesWithSeparator(roles []interface{}, separator s
 			continue
 		}
 
-		// If AuthProvider was not created by super admin, filter out flightctl-admin role
-		if !r.createdBySuperAdmin {
-			// Check if role is flightctl-admin (either as global or org-scoped)
-			if roleStr == api.ExternalRoleAdmin {
-				// Global flightctl-admin role - skip it
-				r.log.Debugf("RoleExtractor: filtering out flightctl-admin role (AP not created by super admin)")
+		// Global role: "role1", org-scoped role: "org1:role1"
+		orgName, roleName := "*", roleStr
+		if strings.Contains(roleStr, separator) {
+			parts := strings.SplitN(roleStr, separator, 2)
+			orgName, roleName = parts[0], parts[1]
+			if orgName == "" || roleName == "" {
 				continue
 			}
-			// Check if org-scoped role contains flightctl-admin
-			if strings.Contains(roleStr, separator) {
-				parts := strings.SplitN(roleStr, separator, 2)
-				if len(parts) == 2 && parts[1] == api.ExternalRoleAdmin {
-					// Org-scoped flightctl-admin role - skip it
-					r.log.Debugf("RoleExtractor: filtering out org-scoped flightctl-admin role (AP not created by super admin)")
-					continue
-				}
-			}
 		}
 
-		// Check if role contains separator
-		if strings.Contains(roleStr, separator) {
-			// Org-scoped role: "org1:role1"
-			parts := strings.SplitN(roleStr, separator, 2)
-			if len(parts) == 2 {
-				orgName := parts[0]
-				roleName := parts[1]
-				if orgName != "" && roleName != "" {
-					orgRoles[orgName] = append(orgRoles[orgName], roleName)
-				}
+		mappedRoles := []string{roleName}
+		if mapped, exists := roleMapping[roleName]; exists {
+			mappedRoles = mapped
+		}
+		for _, mappedRole := range mappedRoles {
+			if mappedRole == "" {
+				continue
 			}
-		} else {
-			// Global role: "role1"
-			orgRoles["*"] = append(orgRoles["*"], roleStr)
+			// If AuthProvider was not created by super admin, filter out flightctl-admin role
+			if !r.createdBySuperAdmin && mappedRole == api.ExternalRoleAdmin {
+				r.log.Debugf("RoleExtractor: filtering out flightctl-admin role for organization %s (AP not created by super admin)", orgName)
+				continue
+			}
+			orgRoles[orgName] = append(orgRoles[orgName], mappedRole)
 		}
 	}
 
@@ -213,6 +206,14 @@ func ValidateRoleAssignment(roleAssignment api.AuthRoleAssignment) error {
 		if len(dynamicRoleAssignment.ClaimPath) == 0 {
 			return fmt.Errorf("dynamic role assignment must have a claim path")
 		}
+		for claimValue, roles := range lo.FromPtr(dynamicRoleAssignment.RoleMapping) {
+			if claimValue == "" {
+				return fmt.Errorf("dynamic role assignment role mapping must not map an empty claim value")
+			}
+			if len(roles) == 0 {
+				return fmt.Errorf("dynamic role assignment role mapping for %q must have at least one role", claimValue)
+			}
+		}
 	default:
 		return fmt.Errorf("unknown role assignment type: %s", discriminator)
 	}
@@ -203,3 +203,111 @@ func TestRoleExtractor_MixedRoles_NotCreatedBySuperAdmin(t *testing.T) {
 	org2Roles := result["org2"]
 	assert.Contains(t, org2Roles, api.ExternalRoleViewer, "org2 viewer should be present")
 }
+
+func TestRoleExtractor_RoleMapping(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.ErrorLevel)
+
+	// Map identity provider groups onto built-in and custom roles
+	roleAssignment := api.AuthRoleAssignment{}
+	dynamicRoleAssignment := api.AuthDynamicRoleAssignment{
+		Type:      api.AuthDynamicRoleAssignmentTypeDynamic,
+		ClaimPath: []string{"groups"},
+		RoleMapping: lo.ToPtr(map[string][]string{
+			"berlin-techs": {"berlin-patcher"},
+			"lab-users":    {api.ExternalRoleViewer, "lab-console"},
+			"ops-leads":    {api.ExternalRoleAdmin},
+		}),
+	}
+	err := roleAssignment.FromAuthDynamicRoleAssignment(dynamicRoleAssignment)
+	require.NoError(t, err)
+
+	extractor := NewRoleExtractor(roleAssignment, false, log)
+
+	claims := map[string]interface{}{
+		"groups": []interface{}{
+			"berlin-techs",
+			"org1:lab-users",
+			"ops-leads",
+			"unmapped-group",
+		},
+	}
+
+	result := extractor.ExtractOrgRolesFromMap(claims)
+	require.NotNil(t, result)
+
+	globalRoles := result["*"]
+	assert.ElementsMatch(t, []string{"berlin-patcher", "unmapped-group"}, globalRoles, "mapped and unmapped global roles should be present")
+	assert.NotContains(t, globalRoles, api.ExternalRoleAdmin, "mapping onto flightctl-admin should be filtered out")
+
+	org1Roles := result["org1"]
+	assert.ElementsMatch(t, []string{api.ExternalRoleViewer, "lab-console"}, org1Roles, "org-scoped group should be mapped to all of its roles")
+}
+
+func TestRoleExtractor_RoleMapping_CreatedBySuperAdmin(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.ErrorLevel)
+
+	roleAssignment := api.AuthRoleAssignment{}
+	dynamicRoleAssignment := api.AuthDynamicRoleAssignment{
+		Type:      api.AuthDynamicRoleAssignmentTypeDynamic,
+		ClaimPath: []string{"groups"},
+		RoleMapping: lo.ToPtr(map[string][]string{
+			"ops-leads": {api.ExternalRoleAdmin},
+		}),
+	}
+	err := roleAssignment.FromAuthDynamicRoleAssignment(dynamicRoleAssignment)
+	require.NoError(t, err)
+
+	extractor := NewRoleExtractor(roleAssignment, true, log)
+
+	claims := map[string]interface{}{
+		"groups": []interface{}{"ops-leads"},
+	}
+
+	result := extractor.ExtractOrgRolesFromMap(claims)
+	require.NotNil(t, result)
+	assert.Equal(t, []string{api.ExternalRoleAdmin}, result["*"], "mapping onto flightctl-admin should be allowed when created by super admin")
+}
+
+func TestValidateRoleAssignment_RoleMapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		roleMapping map[string][]string
+		wantErr     bool
+	}{
+		{
+			name:        "valid mapping",
+			roleMapping: map[string][]string{"lab-users": {"lab-console"}},
+		},
+		{
+			name:        "empty claim value",
+			roleMapping: map[string][]string{"": {"lab-console"}},
+			wantErr:     true,
+		},
+		{
+			name:        "no roles",
+			roleMapping: map[string][]string{"lab-users": {}},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleAssignment := api.AuthRoleAssignment{}
+			err := roleAssignment.FromAuthDynamicRoleAssignment(api.AuthDynamicRoleAssignment{
+				Type:        api.AuthDynamicRoleAssignmentTypeDynamic,
+				ClaimPath:   []string{"groups"},
+				RoleMapping: lo.ToPtr(tt.roleMapping),
+			})
+			require.NoError(t, err)
+
+			err = ValidateRoleAssignment(roleAssignment)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ type MultiAuthZ struct {
 	caCert                string
 	staticAuthZ           *authz.StaticAuthZ
 	staticAuthZOnce       sync.Once
+	staticAuthZOpts       []authz.StaticAuthZOption
 	log                   logrus.FieldLogger
 	ctx                   context.Context
 	started               bool
@@ -106,7 +107,7 @@ func (m *MultiAuthZ) getOpenShiftAuthZ(apiUrl string) (*authz.OpenShiftAuthZ, er
 func (m *MultiAuthZ) getStaticAuthZ() *authz.StaticAuthZ {
 	m.staticAuthZOnce.Do(func() {
 		m.log.Debug("Lazy-initializing static authZ")
-		m.staticAuthZ = authz.NewStaticAuthZ(m.log)
+		m.staticAuthZ = authz.NewStaticAuthZ(m.log, m.staticAuthZOpts...)
 	})
 	return m.staticAuthZ
 }
@@ -402,8 +403,9 @@ func (m *MultiAuthZ) getUserPermissionsK8s(ctx context.Context, ident common.Ide
 	return k8sAuthZ.GetUserPermissions(ctx, token)
 }
 
-// InitMultiAuthZ initializes authorization with support for multiple methods
-func InitMultiAuthZ(cfg *config.Config, log logrus.FieldLogger) (AuthZMiddleware, error) {
+// InitMultiAuthZ initializes authorization with support for multiple methods.
+// The options are applied to the static authorization of OIDC, OAuth2 and AAP identities.
+func InitMultiAuthZ(cfg *config.Config, log logrus.FieldLogger, staticOpts ...authz.StaticAuthZOption) (AuthZMiddleware, error) {
 	if cfg.Auth == nil {
 		return nil, errors.New("no auth configuration provided")
 	}
@@ -412,6 +414,7 @@ func InitMultiAuthZ(cfg *config.Config, log logrus.FieldLogger) (AuthZMiddleware
 		log:                   log,
 		insecureSkipTlsVerify: cfg.Auth.InsecureSkipTlsVerify,
 		caCert:                cfg.Auth.CACert,
+		staticAuthZOpts:       staticOpts,
 	}
 
 	// Configure K8s authZ if K8s auth is configured
@@ -0,0 +1,374 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/pkg/k8s/selector"
+	"github.com/flightctl/flightctl/pkg/k8s/selector/labels"
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+)
+
+// customRolesCacheTTL bounds how long changes to Roles and RoleBindings take to be enforced
+const customRolesCacheTTL = 10 * time.Second
+
+// scopedResources are the resources, with their subresources, that label-scoped RoleBindings apply to
+var scopedResources = []string{"devices", "fleets"}
+
+// scopedVerbs are the verbs a label-scoped RoleBinding can grant. Verbs that do not name a single
+// existing resource (list, create and deletecollection) cannot be checked against its labels.
+var scopedVerbs = []string{"get", "update", "patch", "delete"}
+
+// CustomRoleStore provides the custom Roles and RoleBindings of an organization and the labels of the
+// resources label-scoped RoleBindings are evaluated against.
+type CustomRoleStore interface {
+	ListRoles(ctx context.Context, orgId uuid.UUID) ([]domain.Role, error)
+	ListRoleBindings(ctx context.Context, orgId uuid.UUID) ([]domain.RoleBinding, error)
+	// GetResourceLabels returns the labels of the named device or fleet, or flterrors.ErrResourceNotFound.
+	GetResourceLabels(ctx context.Context, orgId uuid.UUID, resource string, name string) (map[string]string, error)
+}
+
+type storeCustomRoleStore struct {
+	store store.Store
+}
+
+// NewCustomRoleStore returns a CustomRoleStore backed by the database store.
+func NewCustomRoleStore(st store.Store) CustomRoleStore {
+	return &storeCustomRoleStore{store: st}
+}
+
+func (s *storeCustomRoleStore) ListRoles(ctx context.Context, orgId uuid.UUID) ([]domain.Role, error) {
+	roles, err := s.store.Role().List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		return nil, err
+	}
+	return roles.Items, nil
+}
+
+func (s *storeCustomRoleStore) ListRoleBindings(ctx context.Context, orgId uuid.UUID) ([]domain.RoleBinding, error) {
+	bindings, err := s.store.RoleBinding().List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		return nil, err
+	}
+	return bindings.Items, nil
+}
+
+func (s *storeCustomRoleStore) GetResourceLabels(ctx context.Context, orgId uuid.UUID, resource string, name string) (map[string]string, error) {
+	var metadata domain.ObjectMeta
+	switch resource {
+	case "devices":
+		device, err := s.store.Device().Get(ctx, orgId, name)
+		if err != nil {
+			return nil, err
+		}
+		metadata = device.Metadata
+	case "fleets":
+		fleet, err := s.store.Fleet().Get(ctx, orgId, name)
+		if err != nil {
+			return nil, err
+		}
+		metadata = fleet.Metadata
+	default:
+		return nil, fmt.Errorf("labels of %s cannot be evaluated", resource)
+	}
+	return lo.FromPtr(metadata.Labels), nil
+}
+
+// CustomRoles evaluates the custom Roles and RoleBindings of organizations on top of the built-in roles.
+//
+// A user is granted the rules of a Role if one of the user's roles in the organization has the Role's
+// name, or if a RoleBinding names the user or one of the user's roles (groups) as subject. A RoleBinding
+// may refer to a built-in role as well. A RoleBinding with a label selector only grants get, update,
+// patch and delete on a single device or fleet, or their subresources, whose labels match the selector.
+type CustomRoles struct {
+	log   logrus.FieldLogger
+	store CustomRoleStore
+	cache *ttlcache.Cache[uuid.UUID, *orgCustomRoles]
+}
+
+// orgCustomRoles are the custom Roles and RoleBindings of an organization.
+type orgCustomRoles struct {
+	roles    map[string]domain.Role
+	bindings []customRoleBinding
+}
+
+type customRoleBinding struct {
+	binding  domain.RoleBinding
+	selector selector.Selector
+}
+
+// customGrant is a role granted to a user, optionally scoped to the resources matching a label selector.
+type customGrant struct {
+	role          string
+	labelSelector *string
+	selector      selector.Selector
+}
+
+func NewCustomRoles(st CustomRoleStore, log logrus.FieldLogger) *CustomRoles {
+	return &CustomRoles{
+		log:   log,
+		store: st,
+		cache: ttlcache.New(
+			ttlcache.WithTTL[uuid.UUID, *orgCustomRoles](customRolesCacheTTL),
+			ttlcache.WithDisableTouchOnHit[uuid.UUID, *orgCustomRoles](),
+		),
+	}
+}
+
+func (c *CustomRoles) forOrg(ctx context.Context, orgId uuid.UUID) (*orgCustomRoles, error) {
+	if item := c.cache.Get(orgId); item != nil {
+		return item.Value(), nil
+	}
+
+	roles, err := c.store.ListRoles(ctx, orgId)
+	if err != nil {
+		return nil, fmt.Errorf("listing roles: %w", err)
+	}
+	bindings, err := c.store.ListRoleBindings(ctx, orgId)
+	if err != nil {
+		return nil, fmt.Errorf("listing role bindings: %w", err)
+	}
+
+	result := &orgCustomRoles{roles: make(map[string]domain.Role, len(roles))}
+	for _, role := range roles {
+		result.roles[lo.FromPtr(role.Metadata.Name)] = role
+	}
+	for _, binding := range bindings {
+		b := customRoleBinding{binding: binding}
+		if binding.Spec.LabelSelector != nil {
+			b.selector, err = labels.Parse(*binding.Spec.LabelSelector)
+			if err != nil {
+				// validated on admission, but never let a broken selector widen a binding
+				c.log.WithError(err).Warnf("CustomRoles: ignoring role binding %s with invalid label selector", lo.FromPtr(binding.Metadata.Name))
+				continue
+			}
+		}
+		result.bindings = append(result.bindings, b)
+	}
+
+	c.cache.Set(orgId, result, ttlcache.DefaultTTL)
+	return result, nil
+}
+
+// grants returns the roles granted to the user by custom Roles and RoleBindings.
+func (o *orgCustomRoles) grants(mappedIdentity *identity.MappedIdentity, userRoles []string) []customGrant {
+	var grants []customGrant
+	for _, role := range userRoles {
+		if _, exists := o.roles[role]; exists {
+			grants = append(grants, customGrant{role: role})
+		}
+	}
+	for _, b := range o.bindings {
+		if !bindingAppliesTo(b.binding, mappedIdentity.GetUsername(), userRoles) {
+			continue
+		}
+		grants = append(grants, customGrant{
+			role:          b.binding.Spec.RoleRef,
+			labelSelector: b.binding.Spec.LabelSelector,
+			selector:      b.selector,
+		})
+	}
+	return grants
+}
+
+func bindingAppliesTo(binding domain.RoleBinding, username string, userRoles []string) bool {
+	for _, subject := range binding.Spec.Subjects {
+		switch subject.Kind {
+		case domain.RoleBindingSubjectKindUser:
+			if subject.Name == username {
+				return true
+			}
+		case domain.RoleBindingSubjectKindGroup:
+			if lo.Contains(userRoles, subject.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// builtInRolePermissions returns the permissions of the built-in role with the given internal or external
+// name. The admin role is never granted through custom RoleBindings.
+func builtInRolePermissions(roleName string) (map[string][]string, bool) {
+	if internalName, exists := strings.CutPrefix(roleName, "flightctl-"); exists && lo.Contains(v1beta1.KnownExternalRoles, roleName) {
+		roleName = internalName
+	}
+	if roleName == v1beta1.RoleAdmin {
+		return map[string][]string{}, true
+	}
+	permissions, exists := resourcePermissions[roleName]
+	return permissions, exists
+}
+
+// roleGrants reports whether the built-in or custom role with the given name grants op on resource.
+func (o *orgCustomRoles) roleGrants(roleName string, resource string, op string) bool {
+	if permissions, exists := builtInRolePermissions(roleName); exists {
+		return permissionsAllow(permissions, resource, op)
+	}
+	role, exists := o.roles[roleName]
+	if !exists {
+		return false
+	}
+	for _, rule := range role.Spec.Rules {
+		if (lo.Contains(rule.Resources, "*") || lo.Contains(rule.Resources, resource)) &&
+			(lo.Contains(rule.Verbs, "*") || lo.Contains(rule.Verbs, op)) {
+			return true
+		}
+	}
+	return false
+}
+
+// permissionsAllow evaluates a built-in role's permissions the way StaticAuthZ does: a specific resource
+// entry takes precedence over the wildcard, and an empty entry denies the resource.
+func permissionsAllow(permissions map[string][]string, resource string, op string) bool {
+	ops, exists := permissions[resource]
+	if !exists {
+		ops = permissions["*"]
+	}
+	return lo.Contains(ops, "*") || lo.Contains(ops, op)
+}
+
+// CheckPermission reports whether the custom Roles and RoleBindings of the organization grant the user op on
+// resource. The name of the requested resource, if any, is read from the context.
+func (c *CustomRoles) CheckPermission(ctx context.Context, orgId uuid.UUID, mappedIdentity *identity.MappedIdentity, resource string, op string) (bool, error) {
+	org, err := c.forOrg(ctx, orgId)
+	if err != nil {
+		return false, err
+	}
+
+	var resourceLabels k8sLabels.Set
+	for _, grant := range org.grants(mappedIdentity, mappedIdentity.GetRolesForOrg(orgId.String())) {
+		if !org.roleGrants(grant.role, resource, op) {
+			continue
+		}
+		if grant.labelSelector == nil {
+			c.log.Debugf("CustomRoles: permission granted for user=%s, role=%s, org=%s, resource=%s, op=%s",
+				mappedIdentity.GetUsername(), grant.role, orgId, resource, op)
+			return true, nil
+		}
+
+		name, _ := ctx.Value(consts.ResourceNameCtxKey).(string)
+		baseResource, _, _ := strings.Cut(resource, "/")
+		if name == "" || !lo.Contains(scopedResources, baseResource) || !lo.Contains(scopedVerbs, op) {
+			continue
+		}
+		if resourceLabels == nil {
+			resourceLabels, err = c.store.GetResourceLabels(ctx, orgId, baseResource, name)
+			if errors.Is(err, flterrors.ErrResourceNotFound) {
+				return false, nil
+			}
+			if err != nil {
+				return false, fmt.Errorf("getting labels of %s/%s: %w", baseResource, name, err)
+			}
+		}
+		if grant.selector.Matches(resourceLabels) {
+			c.log.Debugf("CustomRoles: permission granted for user=%s, role=%s, org=%s, resource=%s/%s, op=%s, labelSelector=%s",
+				mappedIdentity.GetUsername(), grant.role, orgId, resource, name, op, *grant.labelSelector)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Permissions returns the permissions granted to the user by the custom Roles and RoleBindings of the
+// organization. Unscoped permissions are merged into merged, keyed by resource. Permissions of label-scoped
+// RoleBindings are returned separately.
+func (c *CustomRoles) Permissions(ctx context.Context, orgId uuid.UUID, mappedIdentity *identity.MappedIdentity, merged map[string][]string) ([]v1beta1.Permission, error) {
+	org, err := c.forOrg(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	var scoped []v1beta1.Permission
+	for _, grant := range org.grants(mappedIdentity, mappedIdentity.GetRolesForOrg(orgId.String())) {
+		for resource, ops := range org.rolePermissions(grant.role) {
+			if grant.labelSelector == nil {
+				merged[resource] = lo.Uniq(append(merged[resource], ops...))
+				continue
+			}
+			baseResource, _, _ := strings.Cut(resource, "/")
+			if resource != "*" && !lo.Contains(scopedResources, baseResource) {
+				continue
+			}
+			if lo.Contains(ops, "*") {
+				ops = scopedVerbs
+			}
+			ops = lo.Intersect(scopedVerbs, ops)
+			if len(ops) > 0 {
+				scoped = append(scoped, v1beta1.Permission{Resource: resource, Operations: ops, LabelSelector: grant.labelSelector})
+			}
+		}
+	}
+	sort.SliceStable(scoped, func(i, j int) bool {
+		if scoped[i].Resource != scoped[j].Resource {
+			return scoped[i].Resource < scoped[j].Resource
+		}
+		return *scoped[i].LabelSelector < *scoped[j].LabelSelector
+	})
+	return scoped, nil
+}
+
+// rolePermissions returns the operations the built-in or custom role with the given name grants, keyed by resource.
+func (o *orgCustomRoles) rolePermissions(roleName string) map[string][]string {
+	if permissions, exists := BuiltInRolePermissions(roleName); exists {
+		return permissions
+	}
+	return RulePermissions(o.roles[roleName].Spec.Rules)
+}
+
+// BuiltInRolePermissions returns the operations the built-in role with the given internal or external name
+// grants through a RoleBinding, keyed by resource. It returns false if there is no such built-in role.
+func BuiltInRolePermissions(roleName string) (map[string][]string, bool) {
+	permissions, exists := builtInRolePermissions(roleName)
+	if !exists {
+		return nil, false
+	}
+	result := make(map[string][]string)
+	for resource, ops := range permissions {
+		if len(ops) > 0 {
+			result[resource] = append([]string{}, ops...)
+		}
+	}
+	return result, true
+}
+
+// RulePermissions returns the operations the rules of a custom Role grant, keyed by resource.
+func RulePermissions(rules []domain.PolicyRule) map[string][]string {
+	result := make(map[string][]string)
+	for _, rule := range rules {
+		for _, resource := range rule.Resources {
+			result[resource] = lo.Uniq(append(result[resource], rule.Verbs...))
+		}
+	}
+	return result
+}
+
+// ExplicitResources returns the resources the built-in roles have permission entries for. A built-in role can
+// deny one of them while granting all other resources through "*".
+func ExplicitResources() []string {
+	var resources []string
+	for _, permissions := range resourcePermissions {
+		for resource := range permissions {
+			if resource != "*" {
+				resources = append(resources, resource)
+			}
+		}
+	}
+	resources = lo.Uniq(resources)
+	sort.Strings(resources)
+	return resources
+}
@@ -0,0 +1,315 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCustomRoleStore struct {
+	roles    []domain.Role
+	bindings []domain.RoleBinding
+	labels   map[string]map[string]string
+}
+
+func (f *fakeCustomRoleStore) ListRoles(ctx context.Context, orgId uuid.UUID) ([]domain.Role, error) {
+	return f.roles, nil
+}
+
+func (f *fakeCustomRoleStore) ListRoleBindings(ctx context.Context, orgId uuid.UUID) ([]domain.RoleBinding, error) {
+	return f.bindings, nil
+}
+
+func (f *fakeCustomRoleStore) GetResourceLabels(ctx context.Context, orgId uuid.UUID, resource string, name string) (map[string]string, error) {
+	labels, exists := f.labels[resource+"/"+name]
+	if !exists {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	return labels, nil
+}
+
+func newTestRole(name string, rules ...domain.PolicyRule) domain.Role {
+	return domain.Role{
+		Metadata: v1beta1.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec:     domain.RoleSpec{Rules: rules},
+	}
+}
+
+func newTestRoleBinding(name string, roleRef string, labelSelector *string, subjects ...domain.RoleBindingSubject) domain.RoleBinding {
+	return domain.RoleBinding{
+		Metadata: v1beta1.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.RoleBindingSpec{
+			RoleRef:       roleRef,
+			Subjects:      subjects,
+			LabelSelector: labelSelector,
+		},
+	}
+}
+
+func newTestCustomRoleStore() *fakeCustomRoleStore {
+	return &fakeCustomRoleStore{
+		roles: []domain.Role{
+			newTestRole("device-patcher", domain.PolicyRule{Resources: []string{"devices"}, Verbs: []string{"get", "patch"}}),
+			newTestRole("console-user", domain.PolicyRule{Resources: []string{"devices/console"}, Verbs: []string{"get"}}),
+			newTestRole("fleet-reader", domain.PolicyRule{Resources: []string{"fleets"}, Verbs: []string{"*"}}),
+		},
+		bindings: []domain.RoleBinding{
+			newTestRoleBinding("berlin-patchers", "device-patcher", lo.ToPtr("site=berlin"),
+				domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindGroup, Name: "berlin-techs"}),
+			newTestRoleBinding("lab-console", "console-user", lo.ToPtr("fleet=lab"),
+				domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindUser, Name: "alice"}),
+			newTestRoleBinding("lab-operators", v1beta1.ExternalRoleOperator, lo.ToPtr("fleet=lab"),
+				domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindGroup, Name: "lab-ops"}),
+			newTestRoleBinding("fleet-readers", "fleet-reader", nil,
+				domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindUser, Name: "bob"}),
+			newTestRoleBinding("admins", v1beta1.RoleAdmin, nil,
+				domain.RoleBindingSubject{Kind: domain.RoleBindingSubjectKindUser, Name: "mallory"}),
+		},
+		labels: map[string]map[string]string{
+			"devices/berlin-1": {"site": "berlin"},
+			"devices/munich-1": {"site": "munich"},
+			"devices/lab-1":    {"fleet": "lab"},
+		},
+	}
+}
+
+func newTestCustomRoleContext(username string, roles []string, resourceName string) context.Context {
+	orgID := uuid.New()
+	testOrg := &model.Organization{
+		ID:          orgID,
+		ExternalID:  "test-org",
+		DisplayName: "Test Organization",
+	}
+	orgRoles := map[string][]string{orgID.String(): roles}
+	mappedIdentity := identity.NewMappedIdentity(username, username, []*model.Organization{testOrg}, orgRoles, false, nil)
+
+	ctx := context.WithValue(context.Background(), consts.MappedIdentityCtxKey, mappedIdentity)
+	ctx = util.WithOrganizationID(ctx, orgID)
+	if resourceName != "" {
+		ctx = context.WithValue(ctx, consts.ResourceNameCtxKey, resourceName)
+	}
+	return ctx
+}
+
+func TestStaticAuthZ_CheckPermission_CustomRoles(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.DebugLevel)
+
+	tests := []struct {
+		name         string
+		username     string
+		roles        []string
+		resourceName string
+		resource     string
+		op           string
+		expected     bool
+	}{
+		{
+			name:     "custom role granted by user role name",
+			username: "carol",
+			roles:    []string{"fleet-reader"},
+			resource: "fleets",
+			op:       "list",
+			expected: true,
+		},
+		{
+			name:     "custom role does not grant other resources",
+			username: "carol",
+			roles:    []string{"fleet-reader"},
+			resource: "devices",
+			op:       "list",
+			expected: false,
+		},
+		{
+			name:     "user binding grants unscoped role",
+			username: "bob",
+			resource: "fleets",
+			op:       "delete",
+			expected: true,
+		},
+		{
+			name:         "group binding grants scoped role on matching device",
+			username:     "dave",
+			roles:        []string{"berlin-techs"},
+			resourceName: "berlin-1",
+			resource:     "devices",
+			op:           "patch",
+			expected:     true,
+		},
+		{
+			name:         "group binding denies scoped role on other device",
+			username:     "dave",
+			roles:        []string{"berlin-techs"},
+			resourceName: "munich-1",
+			resource:     "devices",
+			op:           "patch",
+			expected:     false,
+		},
+		{
+			name:         "scoped role does not grant verbs outside the role",
+			username:     "dave",
+			roles:        []string{"berlin-techs"},
+			resourceName: "berlin-1",
+			resource:     "devices",
+			op:           "delete",
+			expected:     false,
+		},
+		{
+			name:     "scoped role does not grant list",
+			username: "dave",
+			roles:    []string{"berlin-techs"},
+			resource: "devices",
+			op:       "list",
+			expected: false,
+		},
+		{
+			name:         "scoped role denies missing device",
+			username:     "dave",
+			roles:        []string{"berlin-techs"},
+			resourceName: "unknown",
+			resource:     "devices",
+			op:           "patch",
+			expected:     false,
+		},
+		{
+			name:         "user binding grants console on matching device",
+			username:     "alice",
+			resourceName: "lab-1",
+			resource:     "devices/console",
+			op:           "get",
+			expected:     true,
+		},
+		{
+			name:         "user binding denies console on other device",
+			username:     "alice",
+			resourceName: "berlin-1",
+			resource:     "devices/console",
+			op:           "get",
+			expected:     false,
+		},
+		{
+			name:         "user binding does not apply to other users",
+			username:     "eve",
+			resourceName: "lab-1",
+			resource:     "devices/console",
+			op:           "get",
+			expected:     false,
+		},
+		{
+			name:         "built-in role binding grants scoped role",
+			username:     "frank",
+			roles:        []string{"lab-ops"},
+			resourceName: "lab-1",
+			resource:     "devices",
+			op:           "update",
+			expected:     true,
+		},
+		{
+			name:         "built-in role binding denies create",
+			username:     "frank",
+			roles:        []string{"lab-ops"},
+			resourceName: "lab-1",
+			resource:     "devices",
+			op:           "create",
+			expected:     false,
+		},
+		{
+			name:     "admin role is never granted by a binding",
+			username: "mallory",
+			resource: "organizations",
+			op:       "create",
+			expected: false,
+		},
+		{
+			name:         "static roles are still evaluated",
+			username:     "dave",
+			roles:        []string{v1beta1.RoleViewer, "berlin-techs"},
+			resourceName: "munich-1",
+			resource:     "devices",
+			op:           "get",
+			expected:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authZ := NewStaticAuthZ(log, WithCustomRoles(NewCustomRoles(newTestCustomRoleStore(), log)))
+			ctx := newTestCustomRoleContext(tt.username, tt.roles, tt.resourceName)
+
+			allowed, err := authZ.CheckPermission(ctx, tt.resource, tt.op)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, allowed)
+		})
+	}
+}
+
+func TestStaticAuthZ_GetUserPermissions_CustomRoles(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.DebugLevel)
+	authZ := NewStaticAuthZ(log, WithCustomRoles(NewCustomRoles(newTestCustomRoleStore(), log)))
+
+	tests := []struct {
+		name                string
+		username            string
+		roles               []string
+		expectedPermissions []v1beta1.Permission
+	}{
+		{
+			name:     "unscoped custom role",
+			username: "bob",
+			expectedPermissions: []v1beta1.Permission{
+				{
+					Resource:   "fleets",
+					Operations: []string{"*"},
+				},
+			},
+		},
+		{
+			name:     "scoped custom roles",
+			username: "alice",
+			roles:    []string{"berlin-techs"},
+			expectedPermissions: []v1beta1.Permission{
+				{
+					Resource:      "devices",
+					Operations:    []string{"get", "patch"},
+					LabelSelector: lo.ToPtr("site=berlin"),
+				},
+				{
+					Resource:      "devices/console",
+					Operations:    []string{"get"},
+					LabelSelector: lo.ToPtr("fleet=lab"),
+				},
+			},
+		},
+		{
+			name:                "no matching bindings",
+			username:            "eve",
+			expectedPermissions: []v1beta1.Permission{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestCustomRoleContext(tt.username, tt.roles, "")
+
+			permissionList, err := authZ.GetUserPermissions(ctx)
+
+			require.NoError(t, err)
+			require.NotNil(t, permissionList)
+			assert.Equal(t, tt.expectedPermissions, permissionList.Permissions)
+		})
+	}
+}
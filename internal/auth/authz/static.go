@@ -8,12 +8,24 @@ import (
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
 // StaticAuthZ implements role-based authorization using system groups
 type StaticAuthZ struct {
-	log logrus.FieldLogger
+	log         logrus.FieldLogger
+	customRoles *CustomRoles
+}
+
+type StaticAuthZOption func(*StaticAuthZ)
+
+// WithCustomRoles makes the custom Roles and RoleBindings of organizations grant permissions on top of
+// the built-in roles.
+func WithCustomRoles(customRoles *CustomRoles) StaticAuthZOption {
+	return func(s *StaticAuthZ) {
+		s.customRoles = customRoles
+	}
 }
 
 // Resource permissions based on K8s RBAC roles
@@ -28,22 +40,27 @@ var resourcePermissions = map[string]map[string][]string{
 	},
 	v1beta1.RoleOperator: {
 		// Operator has full CRUD on these resources (specific entries override wildcard)
-		"devices":               {"get", "list", "create", "update", "patch", "delete"},
-		"fleets":                {"get", "list", "create", "update", "patch", "delete"},
-		"resourcesyncs":         {"get", "list", "create", "update", "patch", "delete"},
-		"repositories":          {"get", "list", "create", "update", "patch", "delete"},
-		"catalogs":              {"get", "list", "create", "update", "patch", "delete"},
-		"catalogitems":          {"get", "list", "create", "update", "patch", "delete"},
-		"imagebuilds":           {"get", "list", "create", "update", "patch", "delete"},
-		"imagebuilds/cancel":    {"create"},
-		"imageexports":          {"get", "list", "create", "update", "patch", "delete"},
-		"imageexports/cancel":   {"create"},
-		"imageexports/download": {"get"},
-		"*":                     {"get", "list"}, // Default read access for other resources
+		"devices":                {"get", "list", "create", "update", "patch", "delete"},
+		"fleets":                 {"get", "list", "create", "update", "patch", "delete"},
+		"fleets/rollback":        {"create"},
+		"bulkoperations":         {"get", "list", "create", "delete"},
+		"bulkoperations/cancel":  {"create"},
+		"resourcesyncs":          {"get", "list", "create", "update", "patch", "delete"},
+		"repositories":           {"get", "list", "create", "update", "patch", "delete"},
+		"catalogs":               {"get", "list", "create", "update", "patch", "delete"},
+		"catalogitems":           {"get", "list", "create", "update", "patch", "delete"},
+		"catalogs/items/install": {"create"},
+		"imagebuilds":            {"get", "list", "create", "update", "patch", "delete"},
+		"imagebuilds/cancel":     {"create"},
+		"imageexports":           {"get", "list", "create", "update", "patch", "delete"},
+		"imageexports/cancel":    {"create"},
+		"imageexports/download":  {"get"},
+		"*":                      {"get", "list"}, // Default read access for other resources
 	},
 	v1beta1.RoleViewer: {
 		"*":                     {"get", "list"}, // Default read access to all resources
 		"imageexports/download": {},              // Explicitly denied - empty list overrides wildcard
+		"devices/console":       {},              // Explicitly denied - viewers can read device logs but get no shell
 	},
 	v1beta1.RoleInstaller: {
 		"enrollmentrequests":          {"get", "list"},
@@ -56,10 +73,14 @@ var resourcePermissions = map[string]map[string][]string{
 	},
 }
 
-func NewStaticAuthZ(log logrus.FieldLogger) *StaticAuthZ {
-	return &StaticAuthZ{
+func NewStaticAuthZ(log logrus.FieldLogger, opts ...StaticAuthZOption) *StaticAuthZ {
+	s := &StaticAuthZ{
 		log: log,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s StaticAuthZ) CheckPermission(ctx context.Context, resource string, op string) (bool, error) {
@@ -90,7 +111,7 @@ func (s StaticAuthZ) CheckPermission(ctx context.Context, resource string, op st
 
 	// 3. Get user's roles for the selected organization only
 	roles := mappedIdentity.GetRolesForOrg(orgID)
-	if len(roles) == 0 {
+	if len(roles) == 0 && s.customRoles == nil {
 		s.log.Debugf("StaticAuthZ: user=%s has no roles in organization=%s",
 			mappedIdentity.GetUsername(), orgID)
 		return false, nil
@@ -132,6 +153,17 @@ func (s StaticAuthZ) CheckPermission(ctx context.Context, resource string, op st
 		}
 	}
 
+	// 4. Custom Roles and RoleBindings may grant what the built-in roles don't
+	if s.customRoles != nil {
+		allowed, err := s.customRoles.CheckPermission(ctx, orgUUID, mappedIdentity, resource, op)
+		if err != nil {
+			return false, fmt.Errorf("evaluating custom roles: %w", err)
+		}
+		if allowed {
+			return true, nil
+		}
+	}
+
 	s.log.Debugf("StaticAuthZ: permission denied for user=%s, org=%s, resource=%s, op=%s",
 		mappedIdentity.GetUsername(), orgID, resource, op)
 	return false, nil
@@ -148,13 +180,16 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 	s.log.Debugf("StaticAuthZ: getting permissions for user=%s", mappedIdentity.GetUsername())
 
 	// Super admins have all permissions
-	var userRoles []string
+	var (
+		userRoles []string
+		orgUUID   uuid.UUID
+	)
 	if mappedIdentity.IsSuperAdmin() {
 		s.log.Debugf("StaticAuthZ: user=%s is super admin, granting all permissions", mappedIdentity.GetUsername())
 		userRoles = []string{v1beta1.RoleAdmin}
 	} else {
 		// Get the selected organization from context
-		orgUUID, ok := util.GetOrgIdFromContext(ctx)
+		orgUUID, ok = util.GetOrgIdFromContext(ctx)
 		if !ok {
 			s.log.Debug("StaticAuthZ: no organization ID found in context")
 			return nil, fmt.Errorf("no organization ID found in context")
@@ -163,7 +198,7 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 
 		// Get user's roles for the selected organization only
 		userRoles = mappedIdentity.GetRolesForOrg(orgID)
-		if len(userRoles) == 0 {
+		if len(userRoles) == 0 && s.customRoles == nil {
 			s.log.Debugf("StaticAuthZ: user=%s has no roles in organization=%s",
 				mappedIdentity.GetUsername(), orgID)
 			return &v1beta1.PermissionList{Permissions: []v1beta1.Permission{}}, nil
@@ -201,6 +236,16 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 		}
 	}
 
+	// Unscoped custom permissions are merged, permissions of label-scoped RoleBindings are listed separately
+	var scopedPermissions []v1beta1.Permission
+	if s.customRoles != nil && !mappedIdentity.IsSuperAdmin() {
+		var err error
+		scopedPermissions, err = s.customRoles.Permissions(ctx, orgUUID, mappedIdentity, mergedPermissions)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating custom roles: %w", err)
+		}
+	}
+
 	// Convert to API format with sorted resources
 	resources := make([]string, 0, len(mergedPermissions))
 	for resource := range mergedPermissions {
@@ -220,6 +265,11 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 		})
 	}
 
+	for _, permission := range scopedPermissions {
+		sort.Strings(permission.Operations)
+		apiPermissions = append(apiPermissions, permission)
+	}
+
 	s.log.Debugf("StaticAuthZ: returning %d permissions for user=%s", len(apiPermissions), mappedIdentity.GetUsername())
 	return &v1beta1.PermissionList{
 		Permissions: apiPermissions,
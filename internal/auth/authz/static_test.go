@@ -153,6 +153,27 @@ func TestStaticAuthZ_CheckPermission(t *testing.T) {
 			op:       "get",
 			expected: false,
 		},
+		{
+			name:     "viewer can read device logs",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "devices/logs",
+			op:       "get",
+			expected: true,
+		},
+		{
+			name:     "viewer cannot open device console",
+			roles:    []string{v1beta1.RoleViewer},
+			resource: "devices/console",
+			op:       "get",
+			expected: false,
+		},
+		{
+			name:     "operator can read device logs",
+			roles:    []string{v1beta1.RoleOperator},
+			resource: "devices/logs",
+			op:       "get",
+			expected: true,
+		},
 		{
 			name:     "installer can list imagebuilds",
 			roles:    []string{v1beta1.RoleInstaller},
@@ -232,6 +253,14 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "*",
 					Operations: []string{"get", "list"},
 				},
+				{
+					Resource:   "bulkoperations",
+					Operations: []string{"create", "delete", "get", "list"},
+				},
+				{
+					Resource:   "bulkoperations/cancel",
+					Operations: []string{"create"},
+				},
 				{
 					Resource:   "catalogitems",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
@@ -240,6 +269,10 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "catalogs",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
 				},
+				{
+					Resource:   "catalogs/items/install",
+					Operations: []string{"create"},
+				},
 				{
 					Resource:   "devices",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
@@ -248,6 +281,10 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "fleets",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
 				},
+				{
+					Resource:   "fleets/rollback",
+					Operations: []string{"create"},
+				},
 				{
 					Resource:   "imagebuilds",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
@@ -290,6 +327,10 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "imageexports/download",
 					Operations: []string{}, // Explicitly denied
 				},
+				{
+					Resource:   "devices/console",
+					Operations: []string{}, // Explicitly denied
+				},
 			},
 		},
 		{
@@ -338,6 +379,10 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "certificatesigningrequests",
 					Operations: []string{"create", "get", "list", "update"},
 				},
+				{
+					Resource:   "devices/console",
+					Operations: []string{}, // Explicitly denied
+				},
 				{
 					Resource:   "enrollmentrequests",
 					Operations: []string{"get", "list"},
@@ -137,8 +137,11 @@ func CreateAuthZMiddleware(authZ AuthZMiddleware, log logrus.FieldLogger) func(h
 				return
 			}
 
-			// Add HTTP request to context for authorization checks
+			// Add HTTP request and the name of the targeted resource to context for authorization checks
 			ctx := context.WithValue(r.Context(), common.ContextKey("http_request"), r)
+			if name := extractResourceName(r.URL.Path, resource); name != "" {
+				ctx = context.WithValue(ctx, consts.ResourceNameCtxKey, name)
+			}
 
 			log.Debugf("AuthZMiddleware: checking authorization for path=%s, method=%s, resource=%s, action=%s",
 				r.URL.Path, r.Method, resource, action)
@@ -220,6 +223,20 @@ func extractResourceAndAction(parts []string, method string) (string, action) {
 	return resource, action
 }
 
+// extractResourceName returns the name of the resource a request path targets, for example "my-device" for
+// /api/v1/devices/my-device/status or /ws/v1/devices/my-device/console, or "" if the path names no resource.
+func extractResourceName(path string, resource string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 5 || !apiVersionPattern.MatchString(parts[2]) {
+		return ""
+	}
+	baseResource, _, _ := strings.Cut(resource, "/")
+	if !strings.EqualFold(parts[3], baseResource) {
+		return ""
+	}
+	return parts[4]
+}
+
 func writeResponse(w http.ResponseWriter, status api.Status, log logrus.FieldLogger) {
 	resp, err := json.Marshal(status)
 	if err != nil {
@@ -1,12 +1,14 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -93,6 +95,12 @@ var requests []TestRequest = []TestRequest{
 		resource: "devices/console",
 		op:       "get",
 	},
+	{
+		url:      "wss://fctl.io/ws/v1/devices/foo/logs",
+		method:   http.MethodGet,
+		resource: "devices/logs",
+		op:       "get",
+	},
 	{
 		url:      "https://fctl.io/api/v1/fleets/foo/templateVersions/bar",
 		method:   http.MethodGet,
@@ -143,6 +151,41 @@ func TestPermissionCheck(t *testing.T) {
 	}
 }
 
+func TestPermissionCheckResourceName(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testCases := []struct {
+		url      string
+		method   string
+		expected string
+	}{
+		{"https://fctl.io/api/v1/devices", http.MethodGet, ""},
+		{"https://fctl.io/api/v1/devices/foo", http.MethodPatch, "foo"},
+		{"https://fctl.io/api/v1/devices/foo/status", http.MethodGet, "foo"},
+		{"wss://fctl.io/ws/v1/devices/foo/console", http.MethodGet, "foo"},
+		{"https://fctl.io/api/v1/fleets/foo/templateVersions/bar", http.MethodGet, "foo"},
+		{"https://fctl.io/api/version", http.MethodGet, ""},
+	}
+
+	for _, tc := range testCases {
+		authZMock := NewMockAuthZMiddleware(ctrl)
+		authZMock.EXPECT().CheckPermission(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ string, _ string) (bool, error) {
+				name, _ := ctx.Value(consts.ResourceNameCtxKey).(string)
+				require.Equal(tc.expected, name, tc.url)
+				return true, nil
+			}).Times(1)
+		authZMiddleware := createAuthZMiddleware(authZMock)
+
+		req := httptest.NewRequest(tc.method, tc.url, nil)
+		w := httptest.NewRecorder()
+		authZMiddleware.ServeHTTP(w, req)
+		require.Equal(http.StatusOK, w.Code)
+	}
+}
+
 // no permissions check is done
 var noCheckRequests []TestRequest = []TestRequest{
 	{
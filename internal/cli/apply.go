@@ -13,14 +13,18 @@ import (
 	"path/filepath"
 	"strings"
 
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/api/versioning"
 	apiclient "github.com/flightctl/flightctl/internal/api/client"
 	apiclientv1alpha1 "github.com/flightctl/flightctl/internal/api/client/v1alpha1"
 	imagebuilderclient "github.com/flightctl/flightctl/internal/api/imagebuilder/client"
 	"github.com/flightctl/flightctl/internal/client"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -33,8 +37,18 @@ var (
 
 	// alphaResources defines resource kinds that require v1alpha1 apiVersion.
 	alphaResources = map[ResourceKind]struct{}{
-		CatalogKind:     {},
-		CatalogItemKind: {},
+		BulkOperationKind:            {},
+		CatalogKind:                  {},
+		CatalogItemKind:              {},
+		EnrollmentApprovalPolicyKind: {},
+		RoleKind:                     {},
+		RoleBindingKind:              {},
+	}
+
+	// serverDryRunResources defines resource kinds that the service can apply in dry-run mode.
+	serverDryRunResources = map[ResourceKind]struct{}{
+		DeviceKind: {},
+		FleetKind:  {},
 	}
 )
 
@@ -43,6 +57,7 @@ type ApplyOptions struct {
 
 	Filenames []string
 	DryRun    bool
+	Diff      bool
 	Recursive bool
 }
 
@@ -51,6 +66,7 @@ func DefaultApplyOptions() *ApplyOptions {
 		GlobalOptions: DefaultGlobalOptions(),
 		Filenames:     []string{},
 		DryRun:        false,
+		Diff:          false,
 		Recursive:     false,
 	}
 }
@@ -89,7 +105,8 @@ func (o *ApplyOptions) Bind(fs *pflag.FlagSet) {
 	if err != nil {
 		log.Fatalf("setting filename flag annotation: %v", err)
 	}
-	fs.BoolVarP(&o.DryRun, "dry-run", "", o.DryRun, "Only print the object that would be sent, without sending it.")
+	fs.BoolVarP(&o.DryRun, "dry-run", "", o.DryRun, "Validate devices and fleets on the service without persisting them. Other resources are only printed, without sending them.")
+	fs.BoolVarP(&o.Diff, "diff", "", o.Diff, "With --dry-run, print a unified diff of the spec of each device that would change.")
 	fs.BoolVarP(&o.Recursive, "recursive", "R", o.Recursive, "Process the directory used in -f, --filename recursively.")
 }
 
@@ -112,6 +129,9 @@ func (o *ApplyOptions) Validate(args []string) error {
 	if len(args) > 0 {
 		return fmt.Errorf("unexpected arguments: %v (did you forget to quote wildcards?)", args)
 	}
+	if o.Diff && !o.DryRun {
+		return fmt.Errorf("--diff can only be used with --dry-run")
+	}
 	return nil
 }
 
@@ -135,7 +155,7 @@ func (o *ApplyOptions) Run(ctx context.Context, args []string) error {
 	for _, filename := range o.Filenames {
 		switch {
 		case filename == "-":
-			errs = append(errs, applyFromReader(ctx, c, ibClient, "<stdin>", os.Stdin, o.DryRun)...)
+			errs = append(errs, applyFromReader(ctx, c, ibClient, "<stdin>", os.Stdin, o.DryRun, o.Diff)...)
 		default:
 			expandedFilenames, err := expandIfFilePattern(filename)
 			if err != nil {
@@ -173,7 +193,7 @@ func (o *ApplyOptions) Run(ctx context.Context, args []string) error {
 						return nil
 					}
 					defer r.Close()
-					errs = append(errs, applyFromReader(ctx, c, ibClient, path, r, o.DryRun)...)
+					errs = append(errs, applyFromReader(ctx, c, ibClient, path, r, o.DryRun, o.Diff)...)
 					return nil
 				})
 				if err != nil {
@@ -210,7 +230,7 @@ func validateResourceAPIVersion(resource genericResource, kind ResourceKind) err
 	return nil
 }
 
-func applyFromReader(ctx context.Context, c *client.Client, ibClient *client.ImageBuilderClient, filename string, r io.Reader, dryRun bool) []error {
+func applyFromReader(ctx context.Context, c *client.Client, ibClient *client.ImageBuilderClient, filename string, r io.Reader, dryRun, diff bool) []error {
 	decoder := yamlutil.NewYAMLOrJSONDecoder(r, 100)
 	resources := []genericResource{}
 
@@ -229,14 +249,14 @@ func applyFromReader(ctx context.Context, c *client.Client, ibClient *client.Ima
 
 	errs := make([]error, 0)
 	for _, resource := range resources {
-		if applyErr := applySingleResource(ctx, c, ibClient, filename, resource, dryRun); applyErr != nil {
+		if applyErr := applySingleResource(ctx, c, ibClient, filename, resource, dryRun, diff); applyErr != nil {
 			errs = append(errs, applyErr...)
 		}
 	}
 	return errs
 }
 
-func applySingleResource(ctx context.Context, c *client.Client, ibClient *client.ImageBuilderClient, filename string, resource genericResource, dryRun bool) []error {
+func applySingleResource(ctx context.Context, c *client.Client, ibClient *client.ImageBuilderClient, filename string, resource genericResource, dryRun, diff bool) []error {
 	kindLike, ok := resource["kind"].(string)
 	if !ok {
 		return []error{fmt.Errorf("%s: skipping resource of unspecified kind: %v", filename, resource)}
@@ -261,16 +281,19 @@ func applySingleResource(ctx context.Context, c *client.Client, ibClient *client
 	}
 
 	if dryRun {
-		fmt.Printf("%s: applying %s/%s (dry run only)\n", filename, strings.ToLower(kindLike), resourceName)
-		return nil
+		if _, ok := serverDryRunResources[kind]; !ok {
+			fmt.Printf("%s: applying %s/%s (dry run only)\n", filename, strings.ToLower(kindLike), resourceName)
+			return nil
+		}
+		fmt.Printf("%s: applying %s/%s (dry run): ", filename, strings.ToLower(kindLike), resourceName)
+	} else {
+		fmt.Printf("%s: applying %s/%s: ", filename, strings.ToLower(kindLike), resourceName)
 	}
-
-	fmt.Printf("%s: applying %s/%s: ", filename, strings.ToLower(kindLike), resourceName)
 	buf, err := json.Marshal(resource)
 	if err != nil {
 		return []error{fmt.Errorf("%s: skipping resource of kind %q: %w", filename, kindLike, err)}
 	}
-	result := applyResourceByKind(ctx, c, ibClient, kind, resourceName, buf)
+	result := applyResourceByKind(ctx, c, ibClient, kind, resourceName, buf, dryRun)
 
 	var errs []error
 	if result.err != nil {
@@ -282,22 +305,110 @@ func applySingleResource(ctx context.Context, c *client.Client, ibClient *client
 		if result.httpResponse.StatusCode != http.StatusOK && result.httpResponse.StatusCode != http.StatusCreated {
 			errs = append(errs, fmt.Errorf("%s: failed to apply %s/%s: %s", strings.ToLower(kindLike), filename, resourceName, result.httpResponse.Status))
 			fmt.Printf("%s\n", result.message)
+		} else if diff {
+			if err := printDryRunDiff(ctx, c, kind, []byte(result.message)); err != nil {
+				errs = append(errs, fmt.Errorf("%s: failed to diff %s/%s: %w", filename, strings.ToLower(kindLike), resourceName, err))
+			}
 		}
 	}
 
 	return errs
 }
 
-func applyResourceByKind(ctx context.Context, c *client.Client, ibClient *client.ImageBuilderClient, kind ResourceKind, resourceName string, buf []byte) applyResult {
+// printDryRunDiff prints a unified diff between the current and the would-be spec of each device affected by a
+// dry-run apply.  For fleets, the service returns the would-be specs of a sample of the devices matching the fleet.
+func printDryRunDiff(ctx context.Context, c *client.Client, kind ResourceKind, body []byte) error {
+	var devices []api.Device
 	switch kind {
 	case DeviceKind:
-		response, err := c.ReplaceDeviceWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		var device api.Device
+		if err := json.Unmarshal(body, &device); err != nil {
+			return fmt.Errorf("parsing response: %w", err)
+		}
+		devices = []api.Device{device}
+	case FleetKind:
+		var fleet api.Fleet
+		if err := json.Unmarshal(body, &fleet); err != nil {
+			return fmt.Errorf("parsing response: %w", err)
+		}
+		if fleet.Status != nil {
+			devices = lo.FromPtr(fleet.Status.DryRunDevices)
+		}
+	default:
+		return nil
+	}
+
+	changed := 0
+	for _, device := range devices {
+		name := lo.FromPtr(device.Metadata.Name)
+		response, err := c.GetDeviceWithResponse(ctx, name)
+		if err != nil {
+			return fmt.Errorf("getting device %s: %w", name, err)
+		}
+		var current *api.DeviceSpec
+		switch response.StatusCode() {
+		case http.StatusOK:
+			current = response.JSON200.Spec
+		case http.StatusNotFound:
+		default:
+			return fmt.Errorf("getting device %s: %s", name, response.Status())
+		}
+
+		diff, err := deviceSpecDiff(name, current, device.Spec)
+		if err != nil {
+			return err
+		}
+		if diff != "" {
+			changed++
+			fmt.Print(diff)
+		}
+	}
+	if kind == FleetKind {
+		fmt.Printf("%d of %d sampled devices would change\n", changed, len(devices))
+	}
+	return nil
+}
+
+// deviceSpecDiff returns a unified diff of the YAML representations of two device specs, or an empty string if they
+// are equal.
+func deviceSpecDiff(name string, current, desired *api.DeviceSpec) (string, error) {
+	toYAML := func(spec *api.DeviceSpec) (string, error) {
+		if spec == nil {
+			return "", nil
+		}
+		out, err := yaml.Marshal(spec)
+		if err != nil {
+			return "", fmt.Errorf("marshalling spec of device %s: %w", name, err)
+		}
+		return string(out), nil
+	}
+	a, err := toYAML(current)
+	if err != nil {
+		return "", err
+	}
+	b, err := toYAML(desired)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: fmt.Sprintf("device/%s (current)", name),
+		ToFile:   fmt.Sprintf("device/%s (dry run)", name),
+		Context:  3,
+	})
+}
+
+func applyResourceByKind(ctx context.Context, c *client.Client, ibClient *client.ImageBuilderClient, kind ResourceKind, resourceName string, buf []byte, dryRun bool) applyResult {
+	switch kind {
+	case DeviceKind:
+		response, err := c.ReplaceDeviceWithBodyWithResponse(ctx, resourceName, &api.ReplaceDeviceParams{DryRun: lo.ToPtr(dryRun)}, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
 	case EnrollmentRequestKind:
 		response, err := c.ReplaceEnrollmentRequestWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
 	case FleetKind:
-		response, err := c.ReplaceFleetWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		response, err := c.ReplaceFleetWithBodyWithResponse(ctx, resourceName, &api.ReplaceFleetParams{DryRun: lo.ToPtr(dryRun)}, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
 	case RepositoryKind:
 		response, err := c.ReplaceRepositoryWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
@@ -333,6 +444,18 @@ func applyResourceByKind(ctx context.Context, c *client.Client, ibClient *client
 		}
 		response, err := c.V1Alpha1().ReplaceCatalogItemWithBodyWithResponse(ctx, catalogName, resourceName, "application/json", bytes.NewReader(buf))
 		return extractApplyResult(response, err)
+	case BulkOperationKind:
+		response, err := c.V1Alpha1().CreateBulkOperationWithBodyWithResponse(ctx, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case EnrollmentApprovalPolicyKind:
+		response, err := c.V1Alpha1().ReplaceEnrollmentApprovalPolicyWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case RoleKind:
+		response, err := c.V1Alpha1().ReplaceRoleWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
+	case RoleBindingKind:
+		response, err := c.V1Alpha1().ReplaceRoleBindingWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+		return extractApplyResult(response, err)
 	default:
 		return applyResult{err: fmt.Errorf("skipping resource of unknown kind %q", kind)}
 	}
@@ -367,6 +490,14 @@ func extractApplyResult(response interface{}, err error) applyResult {
 		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
 	case *apiclientv1alpha1.ReplaceCatalogItemResponse:
 		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclientv1alpha1.CreateBulkOperationResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclientv1alpha1.ReplaceEnrollmentApprovalPolicyResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclientv1alpha1.ReplaceRoleResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
+	case *apiclientv1alpha1.ReplaceRoleBindingResponse:
+		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
 	case *imagebuilderclient.CreateImageBuildResponse:
 		return applyResult{httpResponse: r.HTTPResponse, message: string(r.Body)}
 	case *imagebuilderclient.CreateImageExportResponse:
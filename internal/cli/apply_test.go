@@ -3,6 +3,7 @@ package cli
 import (
 	"testing"
 
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,7 +61,7 @@ func TestApplySingleResourceEmptyName(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			require := require.New(t)
-			errs := applySingleResource(t.Context(), nil, nil, "test.yaml", tt.resource, true)
+			errs := applySingleResource(t.Context(), nil, nil, "test.yaml", tt.resource, true, false)
 			if tt.wantErr {
 				require.NotEmpty(errs)
 				require.Contains(errs[0].Error(), tt.errContains)
@@ -70,3 +71,52 @@ func TestApplySingleResourceEmptyName(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceSpecDiff(t *testing.T) {
+	tests := []struct {
+		name         string
+		current      *api.DeviceSpec
+		desired      *api.DeviceSpec
+		wantEmpty    bool
+		wantContains []string
+	}{
+		{
+			name:      "equal specs produce no diff",
+			current:   &api.DeviceSpec{Os: &api.DeviceOsSpec{Image: "img:v1"}},
+			desired:   &api.DeviceSpec{Os: &api.DeviceOsSpec{Image: "img:v1"}},
+			wantEmpty: true,
+		},
+		{
+			name:    "changed image is shown",
+			current: &api.DeviceSpec{Os: &api.DeviceOsSpec{Image: "img:v1"}},
+			desired: &api.DeviceSpec{Os: &api.DeviceOsSpec{Image: "img:v2"}},
+			wantContains: []string{
+				"--- device/dev1 (current)",
+				"+++ device/dev1 (dry run)",
+				"-  image: img:v1",
+				"+  image: img:v2",
+			},
+		},
+		{
+			name:         "new device is diffed against an empty spec",
+			current:      nil,
+			desired:      &api.DeviceSpec{Os: &api.DeviceOsSpec{Image: "img:v1"}},
+			wantContains: []string{"+os:", "+  image: img:v1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			diff, err := deviceSpecDiff("dev1", tt.current, tt.desired)
+			require.NoError(err)
+			if tt.wantEmpty {
+				require.Empty(diff)
+				return
+			}
+			for _, s := range tt.wantContains {
+				require.Contains(diff, s)
+			}
+		})
+	}
+}
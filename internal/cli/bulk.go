@@ -0,0 +1,248 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type BulkOptions struct {
+	GlobalOptions
+	Name               string
+	LabelSelector      string
+	FieldSelector      string
+	SetLabels          []string
+	RemoveLabels       []string
+	DecommissionTarget string
+}
+
+func DefaultBulkOptions() *BulkOptions {
+	return &BulkOptions{
+		GlobalOptions:      DefaultGlobalOptions(),
+		DecommissionTarget: string(api.DeviceDecommissionTargetTypeUnenroll),
+	}
+}
+
+func NewCmdBulk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk",
+		Short: "Apply an action to all devices matching a selector.",
+		Long: `Apply an action to all devices matching a label and/or field selector.
+
+Each command creates a BulkOperation resource that is processed asynchronously by the service, in batches of devices.
+Its progress and the result for each device can be followed with 'flightctl get bulkoperation/NAME -o yaml'.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(newCmdBulkAction(apiv1alpha1.BulkOperationActionPatchLabels, "label",
+		"Add, overwrite or remove labels of the selected devices.",
+		`  # Label all devices of a site as production devices
+  flightctl bulk label -l site=berlin --set env=production
+
+  # Remove a label from the devices of a fleet
+  flightctl bulk label --field-selector metadata.owner=Fleet/my-fleet --remove maintenance`))
+	cmd.AddCommand(newCmdBulkAction(apiv1alpha1.BulkOperationActionDecommission, "decommission",
+		"Decommission the selected devices.",
+		`  # Decommission all retired devices
+  flightctl bulk decommission -l lifecycle=retired`))
+	cmd.AddCommand(newCmdBulkAction(apiv1alpha1.BulkOperationActionResume, "resume",
+		"Resume the selected devices that are paused due to conflicts.",
+		`  # Resume the paused devices of a fleet
+  flightctl bulk resume --field-selector metadata.owner=Fleet/my-fleet`))
+	cmd.AddCommand(newCmdBulkAction(apiv1alpha1.BulkOperationActionRerender, "rerender",
+		"Render the configuration of the selected devices again.",
+		`  # Re-render the devices of a site, e.g. after a referenced secret changed
+  flightctl bulk rerender -l site=berlin`))
+	cmd.AddCommand(newCmdBulkCancel())
+
+	return cmd
+}
+
+func newCmdBulkAction(action apiv1alpha1.BulkOperationActionType, use, short, example string) *cobra.Command {
+	o := DefaultBulkOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Example: example,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(action, args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, action)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags(), action)
+	return cmd
+}
+
+func newCmdBulkCancel() *cobra.Command {
+	o := DefaultBulkOptions()
+	cmd := &cobra.Command{
+		Use:     "cancel NAME",
+		Short:   "Cancel a pending or running bulk operation.",
+		Long:    "Cancel a pending or running bulk operation. Devices that were already processed keep the result of the action.",
+		Example: "  flightctl bulk cancel patchlabels-20250101-120000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.GlobalOptions.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.RunCancel(ctx, args[0])
+		},
+		SilenceUsage: true,
+	}
+	o.GlobalOptions.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *BulkOptions) Bind(fs *pflag.FlagSet, action apiv1alpha1.BulkOperationActionType) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVar(&o.Name, "name", o.Name, "Name of the bulk operation. Generated from the action and the current time if not set.")
+	fs.StringVarP(&o.LabelSelector, "selector", "l", o.LabelSelector, "Selector (label query) to filter on, supporting operators like '=', '!=', and 'in' (e.g., -l='key1=value1,key2!=value2,key3 in (value3, value4)').")
+	fs.StringVar(&o.FieldSelector, "field-selector", o.FieldSelector, "Selector (field query) to filter on, supporting operators like '=', '==', and '!=' (e.g., --field-selector='key1=value1,key2!=value2').")
+	switch action {
+	case apiv1alpha1.BulkOperationActionPatchLabels:
+		fs.StringSliceVar(&o.SetLabels, "set", o.SetLabels, "Label to add or overwrite, as key=value. Can be repeated.")
+		fs.StringSliceVar(&o.RemoveLabels, "remove", o.RemoveLabels, "Key of a label to remove. Can be repeated.")
+	case apiv1alpha1.BulkOperationActionDecommission:
+		fs.StringVarP(&o.DecommissionTarget, "target", "t", o.DecommissionTarget, "Specify the type of decommissioning operation: currently supports only 'unenroll'")
+	}
+}
+
+func (o *BulkOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *BulkOptions) Validate(action apiv1alpha1.BulkOperationActionType, args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	_, err := o.buildBulkOperation(action)
+	return err
+}
+
+// buildBulkOperation returns the bulk operation to create for the action and the options.
+func (o *BulkOptions) buildBulkOperation(action apiv1alpha1.BulkOperationActionType) (*apiv1alpha1.BulkOperation, error) {
+	if len(strings.TrimSpace(o.LabelSelector)) == 0 && len(strings.TrimSpace(o.FieldSelector)) == 0 {
+		return nil, fmt.Errorf("at least one selector is required. Use --selector/-l or --field-selector")
+	}
+
+	bulkAction := apiv1alpha1.BulkOperationAction{Type: action}
+	switch action {
+	case apiv1alpha1.BulkOperationActionPatchLabels:
+		if len(o.SetLabels) == 0 && len(o.RemoveLabels) == 0 {
+			return nil, fmt.Errorf("specify the labels to change using --set and/or --remove")
+		}
+		if len(o.SetLabels) > 0 {
+			labels := make(map[string]string, len(o.SetLabels))
+			for _, label := range o.SetLabels {
+				key, value, found := strings.Cut(label, "=")
+				if !found || len(key) == 0 {
+					return nil, fmt.Errorf("invalid label %q: must be in the format key=value", label)
+				}
+				labels[key] = value
+			}
+			bulkAction.Labels = &labels
+		}
+		if len(o.RemoveLabels) > 0 {
+			bulkAction.RemoveLabels = &o.RemoveLabels
+		}
+	case apiv1alpha1.BulkOperationActionDecommission:
+		target := o.DecommissionTarget
+		if len(target) > 0 {
+			target = strings.ToUpper(target[:1]) + target[1:]
+		}
+		if !slices.Contains(allowedTargets, target) {
+			return nil, fmt.Errorf("decommission target must be one of: (%s)", strings.Join(allowedTargets, ", "))
+		}
+		bulkAction.Decommission = &api.DeviceDecommission{Target: api.DeviceDecommissionTargetType(target)}
+	}
+
+	name := o.Name
+	if len(name) == 0 {
+		name = fmt.Sprintf("%s-%s", strings.ToLower(string(action)), time.Now().UTC().Format("20060102-150405"))
+	}
+
+	return &apiv1alpha1.BulkOperation{
+		ApiVersion: fmt.Sprintf("%s/%s", api.APIGroup, apiv1alpha1.BulkOperationAPIVersion),
+		Kind:       apiv1alpha1.BulkOperationKind,
+		Metadata:   api.ObjectMeta{Name: &name},
+		Spec: apiv1alpha1.BulkOperationSpec{
+			Action:        bulkAction,
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+		},
+	}, nil
+}
+
+func (o *BulkOptions) Run(ctx context.Context, action apiv1alpha1.BulkOperationActionType) error {
+	bulkOperation, err := o.buildBulkOperation(action)
+	if err != nil {
+		return err
+	}
+	name := *bulkOperation.Metadata.Name
+
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	response, err := c.V1Alpha1().CreateBulkOperationWithResponse(ctx, *bulkOperation)
+	if err != nil {
+		return fmt.Errorf("creating bulk operation %s: %w", name, err)
+	}
+	if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusCreated); err != nil {
+		return fmt.Errorf("creating bulk operation %s: %w", name, err)
+	}
+
+	fmt.Printf("BulkOperation created: %s\n", name)
+	fmt.Printf("Follow its progress with: flightctl get %s/%s\n", BulkOperationKind, name)
+	return nil
+}
+
+func (o *BulkOptions) RunCancel(ctx context.Context, name string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	response, err := c.V1Alpha1().CancelBulkOperationWithResponse(ctx, name)
+	if err != nil {
+		return fmt.Errorf("canceling bulk operation %s: %w", name, err)
+	}
+	if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusOK); err != nil {
+		return fmt.Errorf("canceling bulk operation %s: %w", name, err)
+	}
+
+	fmt.Printf("BulkOperation canceled: %s\n", name)
+	return nil
+}
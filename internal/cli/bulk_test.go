@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"testing"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkOptions_BuildBulkOperation(t *testing.T) {
+	tests := []struct {
+		name          string
+		action        apiv1alpha1.BulkOperationActionType
+		setup         func(o *BulkOptions)
+		errorContains string
+		verify        func(t *testing.T, op *apiv1alpha1.BulkOperation)
+	}{
+		{
+			name:   "label with set and remove",
+			action: apiv1alpha1.BulkOperationActionPatchLabels,
+			setup: func(o *BulkOptions) {
+				o.LabelSelector = "site=berlin"
+				o.SetLabels = []string{"env=production", "empty="}
+				o.RemoveLabels = []string{"maintenance"}
+			},
+			verify: func(t *testing.T, op *apiv1alpha1.BulkOperation) {
+				require.Equal(t, map[string]string{"env": "production", "empty": ""}, *op.Spec.Action.Labels)
+				require.Equal(t, []string{"maintenance"}, *op.Spec.Action.RemoveLabels)
+				require.Equal(t, "site=berlin", *op.Spec.LabelSelector)
+				require.Nil(t, op.Spec.FieldSelector)
+				require.Contains(t, *op.Metadata.Name, "patchlabels-")
+			},
+		},
+		{
+			name:   "label without changes",
+			action: apiv1alpha1.BulkOperationActionPatchLabels,
+			setup: func(o *BulkOptions) {
+				o.LabelSelector = "site=berlin"
+			},
+			errorContains: "--set and/or --remove",
+		},
+		{
+			name:   "label with invalid format",
+			action: apiv1alpha1.BulkOperationActionPatchLabels,
+			setup: func(o *BulkOptions) {
+				o.LabelSelector = "site=berlin"
+				o.SetLabels = []string{"env"}
+			},
+			errorContains: "key=value",
+		},
+		{
+			name:   "missing selector",
+			action: apiv1alpha1.BulkOperationActionResume,
+			setup: func(o *BulkOptions) {
+				o.LabelSelector = " "
+			},
+			errorContains: "at least one selector is required",
+		},
+		{
+			name:   "decommission with lowercase target",
+			action: apiv1alpha1.BulkOperationActionDecommission,
+			setup: func(o *BulkOptions) {
+				o.FieldSelector = "metadata.owner=Fleet/my-fleet"
+				o.DecommissionTarget = "unenroll"
+			},
+			verify: func(t *testing.T, op *apiv1alpha1.BulkOperation) {
+				require.Equal(t, api.DeviceDecommissionTargetTypeUnenroll, op.Spec.Action.Decommission.Target)
+			},
+		},
+		{
+			name:   "decommission with unsupported target",
+			action: apiv1alpha1.BulkOperationActionDecommission,
+			setup: func(o *BulkOptions) {
+				o.LabelSelector = "lifecycle=retired"
+				o.DecommissionTarget = "explode"
+			},
+			errorContains: "decommission target must be one of",
+		},
+		{
+			name:   "rerender with explicit name",
+			action: apiv1alpha1.BulkOperationActionRerender,
+			setup: func(o *BulkOptions) {
+				o.LabelSelector = "site=berlin"
+				o.Name = "rerender-berlin"
+			},
+			verify: func(t *testing.T, op *apiv1alpha1.BulkOperation) {
+				require.Equal(t, "rerender-berlin", *op.Metadata.Name)
+				require.Equal(t, apiv1alpha1.BulkOperationKind, op.Kind)
+				require.Equal(t, "flightctl.io/v1alpha1", op.ApiVersion)
+				require.Nil(t, op.Spec.Action.Labels)
+				require.Nil(t, op.Spec.Action.Decommission)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultBulkOptions()
+			tt.setup(o)
+			op, err := o.buildBulkOperation(tt.action)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.action, op.Spec.Action.Type)
+			tt.verify(t, op)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+const caRotationDevicesPageSize = 1000
+
+var legalCARotationOutputTypes = []string{string(display.JSONFormat), string(display.YAMLFormat)}
+
+type CARotationOptions struct {
+	GlobalOptions
+
+	Output  string
+	Devices bool
+}
+
+func DefaultCARotationOptions() *CARotationOptions {
+	return &CARotationOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdCARotation() *cobra.Command {
+	o := DefaultCARotationOptions()
+	cmd := &cobra.Command{
+		Use:   "carotation",
+		Short: "Show the progress of rotating the service's CA.",
+		Long: `Show the progress of rotating the service's CA to a new key pair.
+Devices trust the new CA once they have received the combined CA bundle with their management certificate.
+
+Examples:
+  # Show the phase of the rotation and how many devices trust the new CA
+  flightctl carotation
+
+  # Show for every device whether it trusts the new CA and which CA issued its management certificate
+  flightctl carotation --devices
+
+  # Print the progress of every device as JSON
+  flightctl carotation --devices -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *CARotationOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVarP(&o.Output, "output", "o", o.Output, fmt.Sprintf("Output format. One of: (%s).", strings.Join(legalCARotationOutputTypes, ", ")))
+	fs.BoolVar(&o.Devices, "devices", o.Devices, "List the progress of every device through the rotation.")
+}
+
+func (o *CARotationOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CARotationOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	return o.validateFlags()
+}
+
+func (o *CARotationOptions) validateFlags() error {
+	if len(o.Output) > 0 && !slices.Contains(legalCARotationOutputTypes, o.Output) {
+		return fmt.Errorf("output format must be one of (%s)", strings.Join(legalCARotationOutputTypes, ", "))
+	}
+	return nil
+}
+
+func (o *CARotationOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	if o.Devices {
+		return o.runDevices(ctx, c)
+	}
+
+	response, err := c.GetCARotationStatusWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("getting CA rotation status: %w", err)
+	}
+	if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusOK); err != nil {
+		return fmt.Errorf("failed to get CA rotation status: %w", err)
+	}
+	status := response.JSON200
+	if status == nil {
+		return fmt.Errorf("getting CA rotation status: empty response")
+	}
+	if len(o.Output) > 0 {
+		return printCARotationOutput(o.Output, status, "CA rotation status")
+	}
+	printCARotationStatus(status)
+	return nil
+}
+
+// runDevices pages through the progress of the devices through the rotation. The table is printed as the pages
+// arrive, while the yaml and json outputs are a single list of all devices.
+func (o *CARotationOptions) runDevices(ctx context.Context, c *client.Client) error {
+	var w *tabwriter.Writer
+	if len(o.Output) == 0 {
+		w = tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+		defer w.Flush()
+		fmt.Fprintln(w, "NAME\tTRUSTS NEW CA\tCERTIFICATE ISSUER")
+	}
+
+	result := api.CARotationDeviceList{Items: []api.CARotationDeviceProgress{}}
+	params := &api.ListCARotationDevicesParams{Limit: lo.ToPtr(int32(caRotationDevicesPageSize))}
+	for {
+		response, err := c.ListCARotationDevicesWithResponse(ctx, params)
+		if err != nil {
+			return fmt.Errorf("listing CA rotation progress of devices: %w", err)
+		}
+		if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusOK); err != nil {
+			return fmt.Errorf("failed to list CA rotation progress of devices: %w", err)
+		}
+		if response.JSON200 == nil {
+			return fmt.Errorf("listing CA rotation progress of devices: empty response")
+		}
+		if w != nil {
+			for _, progress := range response.JSON200.Items {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", progress.Name, progress.TrustsNewCA, formatCertificateIssuer(progress))
+			}
+		} else {
+			result.Items = append(result.Items, response.JSON200.Items...)
+		}
+		if response.JSON200.Metadata.Continue == nil {
+			break
+		}
+		params.Continue = response.JSON200.Metadata.Continue
+	}
+
+	if w != nil {
+		return nil
+	}
+	return printCARotationOutput(o.Output, result, "CA rotation progress of devices")
+}
+
+func printCARotationOutput(output string, v any, what string) error {
+	var marshalled []byte
+	var err error
+	switch output {
+	case string(display.YAMLFormat):
+		marshalled, err = yaml.Marshal(v)
+	case string(display.JSONFormat):
+		if marshalled, err = json.MarshalIndent(v, "", "  "); err == nil {
+			marshalled = append(marshalled, '\n')
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("marshalling %s: %w", what, err)
+	}
+	fmt.Print(string(marshalled))
+	return nil
+}
+
+func printCARotationStatus(status *api.CARotationStatus) {
+	fmt.Printf("Phase:       %s\n", status.Phase)
+	fmt.Printf("Signing CA:  %s\n", formatCACertificateInfo(status.SigningCA))
+	if status.NewCA == nil {
+		return
+	}
+	if status.PreviousCA != nil {
+		fmt.Printf("Previous CA: %s\n", formatCACertificateInfo(*status.PreviousCA))
+	}
+	fmt.Printf("New CA:      %s\n", formatCACertificateInfo(*status.NewCA))
+	if status.SwitchTime != nil {
+		fmt.Printf("Switch time: %s\n", status.SwitchTime.UTC().Format(time.RFC3339))
+	}
+	if status.Devices != nil {
+		fmt.Printf("Devices:     %d/%d trust the new CA, %d/%d have a certificate issued by the new CA\n",
+			status.Devices.TrustingNewCA, status.Devices.Total, status.Devices.IssuedByNewCA, status.Devices.Total)
+	}
+}
+
+func formatCACertificateInfo(info api.CACertificateInfo) string {
+	return fmt.Sprintf("%s (%s, expires %s)", info.KeyId, info.Subject, info.NotAfter.UTC().Format(time.RFC3339))
+}
+
+// formatCertificateIssuer returns which CA issued the management certificate of the device, or the key ID of
+// its issuer if it is neither the previous nor the new CA.
+func formatCertificateIssuer(progress api.CARotationDeviceProgress) string {
+	if progress.CertificateIssuer == api.CARotationDeviceIssuerOther && progress.CertificateIssuerKeyId != nil {
+		return *progress.CertificateIssuerKeyId
+	}
+	return string(progress.CertificateIssuer)
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatCertificateIssuer(t *testing.T) {
+	tests := []struct {
+		name     string
+		progress api.CARotationDeviceProgress
+		expected string
+	}{
+		{
+			name:     "not reported",
+			progress: api.CARotationDeviceProgress{CertificateIssuer: api.CARotationDeviceIssuerUnknown},
+			expected: "Unknown",
+		},
+		{
+			name:     "previous CA",
+			progress: api.CARotationDeviceProgress{CertificateIssuer: api.CARotationDeviceIssuerPrevious, CertificateIssuerKeyId: lo.ToPtr("aaaa")},
+			expected: "Previous",
+		},
+		{
+			name:     "new CA",
+			progress: api.CARotationDeviceProgress{CertificateIssuer: api.CARotationDeviceIssuerNew, CertificateIssuerKeyId: lo.ToPtr("bbbb")},
+			expected: "New",
+		},
+		{
+			name:     "another CA",
+			progress: api.CARotationDeviceProgress{CertificateIssuer: api.CARotationDeviceIssuerOther, CertificateIssuerKeyId: lo.ToPtr("cccc")},
+			expected: "cccc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, formatCertificateIssuer(tt.progress))
+		})
+	}
+}
+
+func TestCARotationOptions_ValidateFlags(t *testing.T) {
+	o := DefaultCARotationOptions()
+	require.NoError(t, o.validateFlags())
+
+	o.Output = "yaml"
+	require.NoError(t, o.validateFlags())
+
+	o.Devices = true
+	require.NoError(t, o.validateFlags())
+
+	o.Output = "wide"
+	require.ErrorContains(t, o.validateFlags(), "output format must be one of")
+}
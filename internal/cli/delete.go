@@ -277,6 +277,14 @@ func (o *DeleteOptions) deleteOne(ctx context.Context, c *client.Client, kind Re
 		response, err = c.V1Alpha1().DeleteCatalogWithResponse(ctx, name)
 	case CatalogItemKind:
 		response, err = c.V1Alpha1().DeleteCatalogItemWithResponse(ctx, o.CatalogName, name)
+	case BulkOperationKind:
+		response, err = c.V1Alpha1().DeleteBulkOperationWithResponse(ctx, name)
+	case EnrollmentApprovalPolicyKind:
+		response, err = c.V1Alpha1().DeleteEnrollmentApprovalPolicyWithResponse(ctx, name)
+	case RoleKind:
+		response, err = c.V1Alpha1().DeleteRoleWithResponse(ctx, name)
+	case RoleBindingKind:
+		response, err = c.V1Alpha1().DeleteRoleBindingWithResponse(ctx, name)
 	default:
 		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
 	}
@@ -15,6 +15,7 @@ import (
 	apiclientv1alpha1 "github.com/flightctl/flightctl/internal/api/client/v1alpha1"
 	imagebuilderclient "github.com/flightctl/flightctl/internal/api/imagebuilder/client"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
 )
 
 const NoneString = "<none>"
@@ -52,6 +53,9 @@ func (f *TableFormatter) Format(data interface{}, options FormatOptions) error {
 func (f *TableFormatter) formatList(w *tabwriter.Writer, data interface{}, options FormatOptions) error {
 	switch {
 	case strings.EqualFold(options.Kind, api.DeviceKind):
+		if aggregateResponse, ok := data.(*apiclient.AggregateDevicesResponse); ok {
+			return f.printDevicesAggregateTable(w, aggregateResponse.JSON200)
+		}
 		if options.SummaryOnly {
 			return f.printDevicesSummaryTable(w, data.(*apiclient.ListDevicesResponse).JSON200.Summary)
 		}
@@ -103,6 +107,14 @@ func (f *TableFormatter) formatList(w *tabwriter.Writer, data interface{}, optio
 		default:
 			return fmt.Errorf("unexpected response type for %s", options.Kind)
 		}
+	case strings.EqualFold(options.Kind, apiv1alpha1.BulkOperationKind):
+		return f.printBulkOperationsTable(w, data.(*apiclientv1alpha1.ListBulkOperationsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, apiv1alpha1.EnrollmentApprovalPolicyKind):
+		return f.printEnrollmentApprovalPoliciesTable(w, data.(*apiclientv1alpha1.ListEnrollmentApprovalPoliciesResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, apiv1alpha1.RoleKind):
+		return f.printRolesTable(w, data.(*apiclientv1alpha1.ListRolesResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, apiv1alpha1.RoleBindingKind):
+		return f.printRoleBindingsTable(w, data.(*apiclientv1alpha1.ListRoleBindingsResponse).JSON200.Items...)
 	default:
 		return fmt.Errorf("unknown resource type %s", options.Kind)
 	}
@@ -154,6 +166,14 @@ func (f *TableFormatter) formatSingle(w *tabwriter.Writer, data interface{}, opt
 		return f.printImageExportsTable(w, *data.(*imagebuilderclient.GetImageExportResponse).JSON200)
 	case strings.EqualFold(options.Kind, apiv1alpha1.CatalogKind):
 		return f.printCatalogsTable(w, *data.(*apiclientv1alpha1.GetCatalogResponse).JSON200)
+	case strings.EqualFold(options.Kind, apiv1alpha1.BulkOperationKind):
+		return f.printBulkOperationsTable(w, *data.(*apiclientv1alpha1.GetBulkOperationResponse).JSON200)
+	case strings.EqualFold(options.Kind, apiv1alpha1.EnrollmentApprovalPolicyKind):
+		return f.printEnrollmentApprovalPoliciesTable(w, *data.(*apiclientv1alpha1.GetEnrollmentApprovalPolicyResponse).JSON200)
+	case strings.EqualFold(options.Kind, apiv1alpha1.RoleKind):
+		return f.printRolesTable(w, *data.(*apiclientv1alpha1.GetRoleResponse).JSON200)
+	case strings.EqualFold(options.Kind, apiv1alpha1.RoleBindingKind):
+		return f.printRoleBindingsTable(w, *data.(*apiclientv1alpha1.GetRoleBindingResponse).JSON200)
 	default:
 		return fmt.Errorf("unknown resource type %s", options.Kind)
 	}
@@ -199,6 +219,18 @@ func (f *TableFormatter) printDevicesSummaryTable(w *tabwriter.Writer, summary *
 	return nil
 }
 
+func (f *TableFormatter) printDevicesAggregateTable(w *tabwriter.Writer, aggregate *api.DeviceAggregate) error {
+	header := lo.Map(aggregate.GroupBy, func(key string, _ int) string { return strings.ToUpper(key) })
+	f.printHeaderRowLn(w, append(header, "COUNT")...)
+	for _, group := range aggregate.Groups {
+		row := lo.Map(aggregate.GroupBy, func(key string, _ int) string {
+			return lo.ValueOr(group.Values, key, NoneString)
+		})
+		f.printTableRowLn(w, append(row, fmt.Sprintf("%d", group.Count))...)
+	}
+	return nil
+}
+
 func (f *TableFormatter) printDevicesLastSeenTable(w *tabwriter.Writer, lastSeen *api.DeviceLastSeen) error {
 	f.printHeaderRowLn(w, "LAST SEEN", "TIME AGO")
 	if lastSeen == nil {
@@ -252,7 +284,7 @@ func (f *TableFormatter) printEnrollmentRequestsTable(w *tabwriter.Writer, ers .
 	f.printHeaderRowLn(w, "NAME", "APPROVAL", "APPROVER", "APPROVED LABELS")
 	for _, e := range ers {
 		approval, approver, approvedLabels := "Pending", NoneString, ""
-		if e.Status.Approval != nil {
+		if e.Status != nil && e.Status.Approval != nil {
 			approval = util.BoolToStr(e.Status.Approval.Approved, "Approved", "Denied")
 			approver = e.Status.Approval.ApprovedBy
 			approvedLabels = strings.Join(util.LabelMapToArray(e.Status.Approval.Labels), ",")
@@ -715,7 +747,7 @@ func (f *TableFormatter) printImageExportsTable(w *tabwriter.Writer, imageExport
 }
 
 func (f *TableFormatter) printCatalogsTable(w *tabwriter.Writer, catalogs ...apiv1alpha1.Catalog) error {
-	f.printHeaderRowLn(w, "NAME", "DISPLAY NAME", "VISIBILITY", "AGE")
+	f.printHeaderRowLn(w, "NAME", "DISPLAY NAME", "VISIBILITY", "SOURCE", "SYNCED", "AGE")
 
 	for _, cat := range catalogs {
 		name := NoneString
@@ -733,12 +765,22 @@ func (f *TableFormatter) printCatalogsTable(w *tabwriter.Writer, catalogs ...api
 			visibility = string(*cat.Spec.Visibility)
 		}
 
+		source, synced := NoneString, NoneString
+		if cat.Spec.Source != nil {
+			source, synced = cat.Spec.Source.Repository, "Unknown"
+			if cat.Status != nil {
+				if condition := api.FindStatusCondition(cat.Status.Conditions, api.ConditionTypeCatalogSynced); condition != nil {
+					synced = string(condition.Status)
+				}
+			}
+		}
+
 		age := NoneString
 		if cat.Metadata.CreationTimestamp != nil {
 			age = humanize.Time(*cat.Metadata.CreationTimestamp)
 		}
 
-		f.printTableRowLn(w, name, displayName, visibility, age)
+		f.printTableRowLn(w, name, displayName, visibility, source, synced, age)
 	}
 	return nil
 }
@@ -776,3 +818,134 @@ func (f *TableFormatter) printCatalogItemsTable(w *tabwriter.Writer, items ...ap
 	}
 	return nil
 }
+
+func (f *TableFormatter) printBulkOperationsTable(w *tabwriter.Writer, bulkOperations ...apiv1alpha1.BulkOperation) error {
+	f.printHeaderRowLn(w, "NAME", "ACTION", "PHASE", "PROCESSED", "SUCCEEDED", "FAILED", "SKIPPED", "AGE")
+
+	for _, op := range bulkOperations {
+		name := NoneString
+		if op.Metadata.Name != nil {
+			name = *op.Metadata.Name
+		}
+
+		phase := string(apiv1alpha1.BulkOperationPhasePending)
+		processed, succeeded, failed, skipped := "0", "0", "0", "0"
+		if op.Status != nil {
+			phase = string(op.Status.Phase)
+			processed = fmt.Sprintf("%d", op.Status.ProcessedDevices)
+			if op.Status.TotalDevices != nil {
+				processed = fmt.Sprintf("%d/%d", op.Status.ProcessedDevices, *op.Status.TotalDevices)
+			}
+			succeeded = fmt.Sprintf("%d", op.Status.SucceededDevices)
+			failed = fmt.Sprintf("%d", op.Status.FailedDevices)
+			skipped = fmt.Sprintf("%d", op.Status.SkippedDevices)
+		}
+
+		age := NoneString
+		if op.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*op.Metadata.CreationTimestamp)
+		}
+
+		f.printTableRowLn(w, name, string(op.Spec.Action.Type), phase, processed, succeeded, failed, skipped, age)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printEnrollmentApprovalPoliciesTable(w *tabwriter.Writer, policies ...apiv1alpha1.EnrollmentApprovalPolicy) error {
+	f.printHeaderRowLn(w, "NAME", "PRIORITY", "MATCH", "FLEET", "AGE")
+
+	for _, policy := range policies {
+		name := NoneString
+		if policy.Metadata.Name != nil {
+			name = *policy.Metadata.Name
+		}
+
+		match := []string{}
+		if policy.Spec.Match.TpmVerified != nil && *policy.Spec.Match.TpmVerified {
+			match = append(match, "tpmVerified")
+		}
+		if policy.Spec.Match.SerialNumbers != nil {
+			match = append(match, fmt.Sprintf("serialNumbers:%d", len(*policy.Spec.Match.SerialNumbers)))
+		}
+		if policy.Spec.Match.ProductUuids != nil {
+			match = append(match, fmt.Sprintf("productUuids:%d", len(*policy.Spec.Match.ProductUuids)))
+		}
+		if policy.Spec.Match.EnrollmentCredentials != nil {
+			match = append(match, "enrollmentCredentials:"+strings.Join(*policy.Spec.Match.EnrollmentCredentials, ","))
+		}
+		if policy.Spec.Match.LabelSelector != nil {
+			match = append(match, "labelSelector:"+*policy.Spec.Match.LabelSelector)
+		}
+
+		priority := int32(0)
+		if policy.Spec.Priority != nil {
+			priority = *policy.Spec.Priority
+		}
+
+		fleet := NoneString
+		if policy.Spec.Fleet != nil {
+			fleet = *policy.Spec.Fleet
+		}
+
+		age := NoneString
+		if policy.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*policy.Metadata.CreationTimestamp)
+		}
+
+		f.printTableRowLn(w, name, fmt.Sprintf("%d", priority), strings.Join(match, " "), fleet, age)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printRolesTable(w *tabwriter.Writer, roles ...apiv1alpha1.Role) error {
+	f.printHeaderRowLn(w, "NAME", "RULES", "AGE")
+
+	for _, role := range roles {
+		name := NoneString
+		if role.Metadata.Name != nil {
+			name = *role.Metadata.Name
+		}
+
+		rules := make([]string, 0, len(role.Spec.Rules))
+		for _, rule := range role.Spec.Rules {
+			rules = append(rules, fmt.Sprintf("%s:%s", strings.Join(rule.Resources, ","), strings.Join(rule.Verbs, ",")))
+		}
+
+		age := NoneString
+		if role.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*role.Metadata.CreationTimestamp)
+		}
+
+		f.printTableRowLn(w, name, strings.Join(rules, " "), age)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printRoleBindingsTable(w *tabwriter.Writer, bindings ...apiv1alpha1.RoleBinding) error {
+	f.printHeaderRowLn(w, "NAME", "ROLE", "SUBJECTS", "LABEL SELECTOR", "AGE")
+
+	for _, binding := range bindings {
+		name := NoneString
+		if binding.Metadata.Name != nil {
+			name = *binding.Metadata.Name
+		}
+
+		subjects := make([]string, 0, len(binding.Spec.Subjects))
+		for _, subject := range binding.Spec.Subjects {
+			subjects = append(subjects, fmt.Sprintf("%s/%s", subject.Kind, subject.Name))
+		}
+
+		labelSelector := NoneString
+		if binding.Spec.LabelSelector != nil {
+			labelSelector = *binding.Spec.LabelSelector
+		}
+
+		age := NoneString
+		if binding.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*binding.Metadata.CreationTimestamp)
+		}
+
+		f.printTableRowLn(w, name, binding.Spec.RoleRef, strings.Join(subjects, ","), labelSelector, age)
+	}
+	return nil
+}
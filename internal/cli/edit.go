@@ -476,10 +476,10 @@ func (o *EditOptions) executePatchOperation(ctx context.Context, client *apiclie
 
 	switch kind {
 	case DeviceKind:
-		response, err := client.PatchDeviceWithBodyWithResponse(ctx, name, contentType, reader)
+		response, err := client.PatchDeviceWithBodyWithResponse(ctx, name, nil, contentType, reader)
 		return o.extractResponseData(response, err)
 	case FleetKind:
-		response, err := client.PatchFleetWithBodyWithResponse(ctx, name, contentType, reader)
+		response, err := client.PatchFleetWithBodyWithResponse(ctx, name, nil, contentType, reader)
 		return o.extractResponseData(response, err)
 	case RepositoryKind:
 		response, err := client.PatchRepositoryWithBodyWithResponse(ctx, name, contentType, reader)
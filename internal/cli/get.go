@@ -2,8 +2,11 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"reflect"
 	"slices"
@@ -12,6 +15,7 @@ import (
 	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	imagebuilderapi "github.com/flightctl/flightctl/api/imagebuilder/v1alpha1"
+	apiclient "github.com/flightctl/flightctl/internal/api/client"
 	"github.com/flightctl/flightctl/internal/cli/display"
 	"github.com/flightctl/flightctl/internal/client"
 	"github.com/flightctl/flightctl/internal/util"
@@ -23,6 +27,8 @@ import (
 
 var legalOutputTypes = []string{string(display.JSONFormat), string(display.YAMLFormat), string(display.NameFormat), string(display.WideFormat)}
 
+var watchableResourceKinds = []ResourceKind{DeviceKind, FleetKind, EnrollmentRequestKind, EventKind}
+
 const maxRequestLimit = 1000 // At most the server side constraint
 
 const (
@@ -41,6 +47,8 @@ const (
 	FlagSummaryOnly = "summary-only" // for listing devices
 	FlagLastSeen    = "last-seen"    // for a single device
 	FlagWithExports = "with-exports" // for imagebuilds
+	FlagWatch       = "watch"        // for listing devices, fleets, enrollmentrequests and events
+	FlagGroupBy     = "group-by"     // for listing devices
 )
 
 type FlagContextualRule struct {
@@ -64,6 +72,8 @@ type GetOptions struct {
 	SummaryOnly   bool
 	LastSeen      bool
 	WithExports   bool
+	Watch         bool
+	GroupBy       []string
 }
 
 func DefaultGetOptions() *GetOptions {
@@ -78,6 +88,8 @@ func DefaultGetOptions() *GetOptions {
 		Rendered:      false,
 		LastSeen:      false,
 		WithExports:   false,
+		Watch:         false,
+		GroupBy:       nil,
 	}
 }
 
@@ -132,6 +144,8 @@ func (o *GetOptions) Bind(fs *pflag.FlagSet) {
 	fs.BoolVar(&o.SummaryOnly, FlagSummaryOnly, false, "Display summary information only.")
 	fs.BoolVar(&o.LastSeen, FlagLastSeen, false, "Display the last seen timestamp of the device.")
 	fs.BoolVar(&o.WithExports, FlagWithExports, false, "Include related ImageExport resources when getting imagebuilds.")
+	fs.BoolVarP(&o.Watch, FlagWatch, "w", false, "After listing the requested resources, watch for changes.")
+	fs.StringSliceVar(&o.GroupBy, FlagGroupBy, o.GroupBy, "Display the number of devices per group of values of the given keys instead of the devices. A key is a label key prefixed with 'metadata.labels.', 'metadata.owner', or a device status field (e.g., --group-by=metadata.labels.site,status.summary.status).")
 	o.hideHelpContextualFlags(fs)
 }
 
@@ -142,6 +156,8 @@ var flagContextualRules = []FlagContextualRule{
 	{FlagLastSeen, []ResourceKind{DeviceKind}, []string{"single"}},
 	{FlagFleetName, []ResourceKind{TemplateVersionKind}, []string{"any"}},
 	{FlagCatalogName, []ResourceKind{CatalogItemKind}, []string{"any"}},
+	{FlagWatch, watchableResourceKinds, []string{"list"}},
+	{FlagGroupBy, []ResourceKind{DeviceKind}, []string{"list"}},
 }
 
 func (o *GetOptions) hideHelpContextualFlags(fs *pflag.FlagSet) {
@@ -250,6 +266,8 @@ func (o *GetOptions) Validate(args []string) error {
 		func() error { return o.validateLimit() },
 		func() error { return o.validateLastSeen(kind, names) },
 		func() error { return o.validateWithExports(kind) },
+		func() error { return o.validateWatch(kind, names) },
+		func() error { return o.validateGroupBy(kind, names) },
 	}
 
 	for _, v := range validators {
@@ -380,6 +398,46 @@ func (o *GetOptions) validateWithExports(kind ResourceKind) error {
 	return nil
 }
 
+// validateWatch checks the usage of the --watch flag.
+func (o *GetOptions) validateWatch(kind ResourceKind, names []string) error {
+	if !o.Watch {
+		return nil
+	}
+	if !slices.Contains(watchableResourceKinds, kind) || len(names) > 0 {
+		return fmt.Errorf("'--watch' can only be specified when getting a list of devices, fleets, enrollmentrequests or events")
+	}
+	if o.Limit > 0 || len(o.Continue) > 0 {
+		return fmt.Errorf("flags '--limit' and '--continue' are not supported when '--watch' is specified")
+	}
+	if o.SummaryOnly || (o.Summary && kind == DeviceKind) {
+		return fmt.Errorf("'--watch' cannot be combined with '--summary-only' or a devices '--summary'")
+	}
+	return nil
+}
+
+// validateGroupBy checks the usage of the --group-by flag.
+func (o *GetOptions) validateGroupBy(kind ResourceKind, names []string) error {
+	if len(o.GroupBy) == 0 {
+		return nil
+	}
+	if kind != DeviceKind || len(names) > 0 {
+		return fmt.Errorf("'--group-by' can only be specified when getting a list of devices")
+	}
+	if len(o.Continue) > 0 {
+		return fmt.Errorf("flag '--continue' is not supported when '--group-by' is specified")
+	}
+	if o.Summary || o.SummaryOnly || o.Watch {
+		return fmt.Errorf("'--group-by' cannot be combined with '--summary', '--summary-only' or '--watch'")
+	}
+	if o.Output == string(display.NameFormat) || o.Output == string(display.WideFormat) {
+		return fmt.Errorf("'--group-by' does not support '-o %s'", o.Output)
+	}
+	if slices.Contains(o.GroupBy, "") {
+		return fmt.Errorf("'--group-by' keys must not be empty")
+	}
+	return nil
+}
+
 func (o *GetOptions) Run(ctx context.Context, args []string) error {
 	kind, names, err := parseAndValidateKindNameFromArgs(args)
 	if err != nil {
@@ -388,6 +446,13 @@ func (o *GetOptions) Run(ctx context.Context, args []string) error {
 
 	formatter := display.NewFormatter(display.OutputFormat(o.Output))
 
+	if o.Watch {
+		if err := o.handleWatch(ctx, formatter, kind); err != nil {
+			return fmt.Errorf("watching %s: %w", kind.ToPlural(), err)
+		}
+		return nil
+	}
+
 	// Create resource fetchers based on kind
 	listFetcher, singleFetcher, stopFn, err := o.createFetchers(ctx, kind)
 	if err != nil {
@@ -398,6 +463,13 @@ func (o *GetOptions) Run(ctx context.Context, args []string) error {
 	}
 
 	// Handle list case (no specific names)
+	if len(names) == 0 && len(o.GroupBy) > 0 {
+		response, err := listFetcher()
+		if err != nil {
+			return fmt.Errorf("aggregating %s: %w", kind.ToPlural(), err)
+		}
+		return o.displayResponse(formatter, response, kind, "")
+	}
 	if len(names) == 0 {
 		if err := o.handleList(ctx, formatter, kind, listFetcher); err != nil {
 			return fmt.Errorf("listing %s: %w", kind.ToPlural(), err)
@@ -576,6 +648,14 @@ func (o *GetOptions) getSingleResource(ctx context.Context, c *client.Client, ki
 		return GetTemplateVersion(ctx, c, o.FleetName, name)
 	case CatalogKind:
 		return c.V1Alpha1().GetCatalogWithResponse(ctx, name)
+	case BulkOperationKind:
+		return c.V1Alpha1().GetBulkOperationWithResponse(ctx, name)
+	case EnrollmentApprovalPolicyKind:
+		return c.V1Alpha1().GetEnrollmentApprovalPolicyWithResponse(ctx, name)
+	case RoleKind:
+		return c.V1Alpha1().GetRoleWithResponse(ctx, name)
+	case RoleBindingKind:
+		return c.V1Alpha1().GetRoleBindingWithResponse(ctx, name)
 	case CatalogItemKind:
 		return nil, fmt.Errorf("catalogitems cannot be retrieved individually; use 'get catalogitems --catalog <name>' to list items")
 	default:
@@ -681,6 +761,15 @@ func (o *GetOptions) handleListWithFetcher(
 func (o *GetOptions) getResourceList(ctx context.Context, c *client.Client, kind ResourceKind) (interface{}, error) {
 	switch kind {
 	case DeviceKind:
+		if len(o.GroupBy) > 0 {
+			params := api.AggregateDevicesParams{
+				LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+				FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+				GroupBy:       o.GroupBy,
+				Limit:         util.ToPtrWithNilDefault(o.Limit),
+			}
+			return c.AggregateDevicesWithResponse(ctx, &params)
+		}
 		params := api.ListDevicesParams{
 			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
 			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
@@ -782,11 +871,188 @@ func (o *GetOptions) getResourceList(ctx context.Context, c *client.Client, kind
 			Continue:      util.ToPtrWithNilDefault(o.Continue),
 		}
 		return c.V1Alpha1().ListCatalogItemsWithResponse(ctx, o.CatalogName, &params)
+	case BulkOperationKind:
+		params := apiv1alpha1.ListBulkOperationsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.V1Alpha1().ListBulkOperationsWithResponse(ctx, &params)
+	case EnrollmentApprovalPolicyKind:
+		params := apiv1alpha1.ListEnrollmentApprovalPoliciesParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.V1Alpha1().ListEnrollmentApprovalPoliciesWithResponse(ctx, &params)
+	case RoleKind:
+		params := apiv1alpha1.ListRolesParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.V1Alpha1().ListRolesWithResponse(ctx, &params)
+	case RoleBindingKind:
+		params := apiv1alpha1.ListRoleBindingsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.V1Alpha1().ListRoleBindingsWithResponse(ctx, &params)
+	default:
+		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
+	}
+}
+
+// handleWatch streams the current state of the listed resources followed by their changes,
+// displaying every added, modified or deleted resource as it is received.
+func (o *GetOptions) handleWatch(ctx context.Context, formatter display.OutputFormatter, kind ResourceKind) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	response, err := o.startWatch(ctx, c, kind)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return fmt.Errorf("reading response: %w", err)
+		}
+		var status api.Status
+		if err := json.Unmarshal(body, &status); err != nil || len(status.Message) == 0 {
+			return fmt.Errorf("response status: %d", response.StatusCode)
+		}
+		return fmt.Errorf("response status: %d, message: %s", response.StatusCode, status.Message)
+	}
+
+	decoder := json.NewDecoder(response.Body)
+	for {
+		var event api.WatchEvent
+		if err := decoder.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("reading watch stream: %w", err)
+		}
+		switch event.Type {
+		case api.BOOKMARK:
+			continue
+		case api.ERROR:
+			if event.Status != nil {
+				return fmt.Errorf("watch ended: %s", event.Status.Message)
+			}
+			return fmt.Errorf("watch ended unexpectedly")
+		}
+		if err := o.displayWatchEvent(formatter, kind, event); err != nil {
+			return err
+		}
+	}
+}
+
+func (o *GetOptions) startWatch(ctx context.Context, c *client.Client, kind ResourceKind) (*http.Response, error) {
+	switch kind {
+	case DeviceKind:
+		params := api.ListDevicesParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Watch:         lo.ToPtr(true),
+		}
+		return c.ListDevices(ctx, &params)
+	case EnrollmentRequestKind:
+		params := api.ListEnrollmentRequestsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Watch:         lo.ToPtr(true),
+		}
+		return c.ListEnrollmentRequests(ctx, &params)
+	case FleetKind:
+		params := api.ListFleetsParams{
+			LabelSelector:     util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector:     util.ToPtrWithNilDefault(o.FieldSelector),
+			AddDevicesSummary: util.ToPtrWithNilDefault(o.Summary),
+			Watch:             lo.ToPtr(true),
+		}
+		return c.ListFleets(ctx, &params)
+	case EventKind:
+		params := api.ListEventsParams{
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Watch:         lo.ToPtr(true),
+		}
+		return c.ListEvents(ctx, &params)
 	default:
 		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
 	}
 }
 
+// displayWatchEvent displays the resource carried by a watch event. Structured formats
+// print the watch event itself so that the type of the change is preserved.
+func (o *GetOptions) displayWatchEvent(formatter display.OutputFormatter, kind ResourceKind, event api.WatchEvent) error {
+	if o.Output == string(display.JSONFormat) || o.Output == string(display.YAMLFormat) {
+		return formatter.Format(event, display.FormatOptions{Kind: kind.String(), Writer: os.Stdout})
+	}
+
+	object, err := json.Marshal(event.Object)
+	if err != nil {
+		return fmt.Errorf("marshalling %s: %w", kind, err)
+	}
+
+	var response interface{}
+	var items interface{}
+	switch kind {
+	case DeviceKind:
+		var device api.Device
+		if err = json.Unmarshal(object, &device); err == nil {
+			list := &api.DeviceList{Items: []api.Device{device}}
+			response, items = &apiclient.ListDevicesResponse{JSON200: list}, list
+		}
+	case EnrollmentRequestKind:
+		var enrollmentRequest api.EnrollmentRequest
+		if err = json.Unmarshal(object, &enrollmentRequest); err == nil {
+			list := &api.EnrollmentRequestList{Items: []api.EnrollmentRequest{enrollmentRequest}}
+			response, items = &apiclient.ListEnrollmentRequestsResponse{JSON200: list}, list
+		}
+	case FleetKind:
+		var fleet api.Fleet
+		if err = json.Unmarshal(object, &fleet); err == nil {
+			list := &api.FleetList{Items: []api.Fleet{fleet}}
+			response, items = &apiclient.ListFleetsResponse{JSON200: list}, list
+		}
+	case EventKind:
+		var e api.Event
+		if err = json.Unmarshal(object, &e); err == nil {
+			list := &api.EventList{Items: []api.Event{e}}
+			response, items = &apiclient.ListEventsResponse{JSON200: list}, list
+		}
+	default:
+		return fmt.Errorf("unsupported resource kind: %s", kind)
+	}
+	if err != nil {
+		return fmt.Errorf("unmarshalling %s: %w", kind, err)
+	}
+
+	options := display.FormatOptions{
+		Kind:    kind.String(),
+		Summary: o.Summary,
+		Wide:    o.Output == string(display.WideFormat),
+		Writer:  os.Stdout,
+	}
+	if o.Output == string(display.NameFormat) {
+		return formatter.Format(items, options)
+	}
+	return formatter.Format(response, options)
+}
+
 func (o *GetOptions) displayResponse(formatter display.OutputFormatter, response interface{}, kind ResourceKind, name string) error {
 	options := display.FormatOptions{
 		Kind:        kind.String(),
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
@@ -519,6 +520,83 @@ func TestGetOptionsValidation(t *testing.T) {
 			args:        []string{"ci"},
 			expectError: false,
 		},
+
+		// Watch validation tests
+		{
+			name:        "watch_devices_ok",
+			args:        []string{"devices"},
+			options:     &GetOptions{Watch: true, LabelSelector: "app=test"},
+			expectError: false,
+		},
+		{
+			name:        "watch_events_ok",
+			args:        []string{"events"},
+			options:     &GetOptions{Watch: true},
+			expectError: false,
+		},
+		{
+			name:          "watch_single_device",
+			args:          []string{"device", "test1"},
+			options:       &GetOptions{Watch: true},
+			expectError:   true,
+			errorContains: "'--watch' can only be specified when getting a list of",
+		},
+		{
+			name:          "watch_unsupported_kind",
+			args:          []string{"repositories"},
+			options:       &GetOptions{Watch: true},
+			expectError:   true,
+			errorContains: "'--watch' can only be specified when getting a list of",
+		},
+		{
+			name:          "watch_with_limit",
+			args:          []string{"fleets"},
+			options:       &GetOptions{Watch: true, Limit: 10},
+			expectError:   true,
+			errorContains: "flags '--limit' and '--continue' are not supported when '--watch' is specified",
+		},
+		{
+			name:          "watch_with_device_summary",
+			args:          []string{"devices"},
+			options:       &GetOptions{Watch: true, Summary: true},
+			expectError:   true,
+			errorContains: "'--watch' cannot be combined with",
+		},
+
+		// Group-by validation tests
+		{
+			name:        "group_by_devices_ok",
+			args:        []string{"devices"},
+			options:     &GetOptions{GroupBy: []string{"metadata.labels.site"}, LabelSelector: "app=test"},
+			expectError: false,
+		},
+		{
+			name:          "group_by_fleets",
+			args:          []string{"fleets"},
+			options:       &GetOptions{GroupBy: []string{"metadata.labels.site"}},
+			expectError:   true,
+			errorContains: "'--group-by' can only be specified when getting a list of devices",
+		},
+		{
+			name:        "group_by_with_limit",
+			args:        []string{"devices"},
+			options:     &GetOptions{GroupBy: []string{"status.summary.status"}, Limit: 10},
+			expectError: false,
+		},
+		{
+			name:          "group_by_with_continue",
+			args:          []string{"devices"},
+			options:       &GetOptions{GroupBy: []string{"status.summary.status"}, Continue: "token"},
+			expectError:   true,
+			errorContains: "flag '--continue' is not supported when '--group-by' is specified",
+		},
+		{
+			name:          "group_by_with_watch",
+			args:          []string{"devices"},
+			options:       &GetOptions{GroupBy: []string{"status.summary.status"}, Watch: true},
+			expectError:   true,
+			errorContains: "'--group-by' cannot be combined with",
+		},
 	}
 
 	for _, tc := range tests {
@@ -549,6 +627,10 @@ func TestGetOptionsValidation(t *testing.T) {
 				if tc.options.CatalogName != "" {
 					opts.CatalogName = tc.options.CatalogName
 				}
+				opts.Limit = tc.options.Limit
+				opts.Watch = tc.options.Watch
+				opts.GroupBy = tc.options.GroupBy
+				opts.Continue = tc.options.Continue
 			}
 
 			err := opts.Validate(tc.args)
@@ -569,6 +651,61 @@ func TestGetOptionsValidation(t *testing.T) {
 	}
 }
 
+func TestGetDevicesGroupBy(t *testing.T) {
+	body, err := json.Marshal(api.DeviceAggregate{
+		GroupBy: []string{"metadata.labels.site", "status.summary.status"},
+		Groups: []api.DeviceGroupCount{
+			{Values: map[string]string{"metadata.labels.site": "berlin", "status.summary.status": "Online"}, Count: 12},
+			{Values: map[string]string{"status.summary.status": "Error"}, Count: 3},
+		},
+		Total: 15,
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal device aggregate: %v", err)
+	}
+	apiClient, fakeClient := newTestClient(t, &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	})
+	c := client.NewTestClient(apiClient)
+
+	opts := DefaultGetOptions()
+	opts.GroupBy = []string{"metadata.labels.site", "status.summary.status"}
+
+	ctx := context.Background()
+	response, err := opts.getResourceList(ctx, c, DeviceKind)
+	if err != nil {
+		t.Fatalf("getResourceList returned unexpected error: %v", err)
+	}
+	if _, ok := response.(*apiclient.AggregateDevicesResponse); !ok {
+		t.Fatalf("expected an aggregate devices response, got %T", response)
+	}
+
+	out := captureStdout(t, func() {
+		if err := opts.displayResponse(display.NewFormatter(display.OutputFormat(opts.Output)), response, DeviceKind, ""); err != nil {
+			t.Fatalf("displayResponse returned unexpected error: %v", err)
+		}
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and 2 groups, got %q", out)
+	}
+	if fields := strings.Fields(lines[0]); !slices.Equal(fields, []string{"METADATA.LABELS.SITE", "STATUS.SUMMARY.STATUS", "COUNT"}) {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); !slices.Equal(fields, []string{"berlin", "Online", "12"}) {
+		t.Errorf("unexpected first group %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); !slices.Equal(fields, []string{display.NoneString, "Error", "3"}) {
+		t.Errorf("unexpected second group %q", lines[2])
+	}
+	if fakeClient.callCount != 1 {
+		t.Errorf("expected 1 HTTP call, got %d", fakeClient.callCount)
+	}
+}
+
 // Helper function to check if a string contains a substring
 func contains(s, substr string) bool {
 	return strings.Contains(s, substr)
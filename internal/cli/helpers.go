@@ -24,10 +24,12 @@ type ResourceKind string
 
 const (
 	InvalidKind                   ResourceKind = ""
+	BulkOperationKind             ResourceKind = "bulkoperation"
 	CatalogKind                   ResourceKind = "catalog"
 	CatalogItemKind               ResourceKind = "catalogitem"
 	CertificateSigningRequestKind ResourceKind = "certificatesigningrequest"
 	DeviceKind                    ResourceKind = "device"
+	EnrollmentApprovalPolicyKind  ResourceKind = "enrollmentapprovalpolicy"
 	EnrollmentRequestKind         ResourceKind = "enrollmentrequest"
 	EventKind                     ResourceKind = "event"
 	AuthProviderKind              ResourceKind = "authprovider"
@@ -37,6 +39,8 @@ const (
 	OrganizationKind              ResourceKind = "organization"
 	RepositoryKind                ResourceKind = "repository"
 	ResourceSyncKind              ResourceKind = "resourcesync"
+	RoleKind                      ResourceKind = "role"
+	RoleBindingKind               ResourceKind = "rolebinding"
 	TemplateVersionKind           ResourceKind = "templateversion"
 )
 
@@ -64,10 +68,12 @@ func ResourceKindFromString(kindLike string) (ResourceKind, error) {
 
 var (
 	resourceKindSet = map[ResourceKind]struct{}{
+		BulkOperationKind:             {},
 		CatalogKind:                   {},
 		CatalogItemKind:               {},
 		CertificateSigningRequestKind: {},
 		DeviceKind:                    {},
+		EnrollmentApprovalPolicyKind:  {},
 		EnrollmentRequestKind:         {},
 		EventKind:                     {},
 		AuthProviderKind:              {},
@@ -77,16 +83,20 @@ var (
 		OrganizationKind:              {},
 		RepositoryKind:                {},
 		ResourceSyncKind:              {},
+		RoleKind:                      {},
+		RoleBindingKind:               {},
 		TemplateVersionKind:           {},
 	}
 
 	validResourceKinds = slices.Collect(maps.Keys(resourceKindSet))
 
 	pluralToKind = map[string]ResourceKind{
+		"bulkoperations":             BulkOperationKind,
 		"catalogs":                   CatalogKind,
 		"catalogitems":               CatalogItemKind,
 		"certificatesigningrequests": CertificateSigningRequestKind,
 		"devices":                    DeviceKind,
+		"enrollmentapprovalpolicies": EnrollmentApprovalPolicyKind,
 		"enrollmentrequests":         EnrollmentRequestKind,
 		"events":                     EventKind,
 		"authproviders":              AuthProviderKind,
@@ -96,14 +106,18 @@ var (
 		"organizations":              OrganizationKind,
 		"repositories":               RepositoryKind,
 		"resourcesyncs":              ResourceSyncKind,
+		"roles":                      RoleKind,
+		"rolebindings":               RoleBindingKind,
 		"templateversions":           TemplateVersionKind,
 	}
 
 	kindToPlural = map[ResourceKind]string{
+		BulkOperationKind:             "bulkoperations",
 		CatalogKind:                   "catalogs",
 		CatalogItemKind:               "catalogitems",
 		CertificateSigningRequestKind: "certificatesigningrequests",
 		DeviceKind:                    "devices",
+		EnrollmentApprovalPolicyKind:  "enrollmentapprovalpolicies",
 		EnrollmentRequestKind:         "enrollmentrequests",
 		EventKind:                     "events",
 		AuthProviderKind:              "authproviders",
@@ -113,14 +127,18 @@ var (
 		OrganizationKind:              "organizations",
 		RepositoryKind:                "repositories",
 		ResourceSyncKind:              "resourcesyncs",
+		RoleKind:                      "roles",
+		RoleBindingKind:               "rolebindings",
 		TemplateVersionKind:           "templateversions",
 	}
 
 	shortnameToKind = map[string]ResourceKind{
+		"bo":   BulkOperationKind,
 		"cat":  CatalogKind,
 		"ci":   CatalogItemKind,
 		"csr":  CertificateSigningRequestKind,
 		"dev":  DeviceKind,
+		"eap":  EnrollmentApprovalPolicyKind,
 		"er":   EnrollmentRequestKind,
 		"ev":   EventKind,
 		"ap":   AuthProviderKind,
@@ -130,6 +148,7 @@ var (
 		"org":  OrganizationKind,
 		"repo": RepositoryKind,
 		"rs":   ResourceSyncKind,
+		"rb":   RoleBindingKind,
 		"tv":   TemplateVersionKind,
 	}
 )
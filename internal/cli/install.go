@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+type InstallOptions struct {
+	GlobalOptions
+	Version         string
+	Fleet           string
+	Device          string
+	ApplicationName string
+	Values          []string
+}
+
+func DefaultInstallOptions() *InstallOptions {
+	return &InstallOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdInstall() *cobra.Command {
+	o := DefaultInstallOptions()
+	cmd := &cobra.Command{
+		Use:   "install catalogitem/CATALOG/ITEM (--fleet NAME | --device NAME)",
+		Short: "Install a catalog item as an application of a fleet or device.",
+		Long: `Install a catalog item as an application of a fleet or device.
+
+The application is added to the fleet's device template or to the device's spec, or upgraded if the catalog item is already installed. Parameter values are validated against the config schema of the installed version.`,
+		Example: `  flightctl install catalogitem/my-catalog/nginx --fleet my-fleet
+  flightctl install catalogitem/my-catalog/nginx --version 1.2.0 --device my-device --set replicas=2 --set env.LOG_LEVEL=debug`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *InstallOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVar(&o.Version, "version", o.Version, "The version to install. Defaults to the highest version that is not deprecated.")
+	fs.StringVar(&o.Fleet, "fleet", o.Fleet, "The name of the fleet to install the catalog item on.")
+	fs.StringVar(&o.Device, "device", o.Device, "The name of the device to install the catalog item on.")
+	fs.StringVar(&o.ApplicationName, "app-name", o.ApplicationName, "The name of the application. Defaults to the name of the catalog item.")
+	fs.StringArrayVar(&o.Values, "set", o.Values, "Set a parameter value in the format key=value. Nested keys are separated by dots (can be repeated).")
+}
+
+func (o *InstallOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *InstallOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	if _, _, err := parseCatalogItemArg(args); err != nil {
+		return err
+	}
+
+	_, err := o.buildInstallRequest()
+	return err
+}
+
+// parseCatalogItemArg returns the catalog and item names of a catalogitem/CATALOG/ITEM argument.
+func parseCatalogItemArg(args []string) (string, string, error) {
+	if len(args) != 1 {
+		return "", "", fmt.Errorf("the catalog item must be specified in catalogitem/CATALOG/ITEM format")
+	}
+	kind, name, err := parseAndValidateKindName(args[0])
+	if err != nil {
+		return "", "", err
+	}
+	if kind != CatalogItemKind {
+		return "", "", fmt.Errorf("kind must be CatalogItem")
+	}
+	catalogName, itemName, found := strings.Cut(name, "/")
+	if !found || len(catalogName) == 0 || len(itemName) == 0 || strings.Contains(itemName, "/") {
+		return "", "", fmt.Errorf("the catalog item must be specified in catalogitem/CATALOG/ITEM format")
+	}
+	return catalogName, itemName, nil
+}
+
+// buildInstallRequest returns the install request for the options.
+func (o *InstallOptions) buildInstallRequest() (*apiv1alpha1.CatalogItemInstallRequest, error) {
+	if (len(o.Fleet) == 0) == (len(o.Device) == 0) {
+		return nil, fmt.Errorf("specify exactly one of --fleet or --device")
+	}
+
+	values, err := parseInstallValues(o.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &apiv1alpha1.CatalogItemInstallRequest{
+		Version:         util.ToPtrWithNilDefault(o.Version),
+		Fleet:           util.ToPtrWithNilDefault(o.Fleet),
+		Device:          util.ToPtrWithNilDefault(o.Device),
+		ApplicationName: util.ToPtrWithNilDefault(o.ApplicationName),
+	}
+	if len(values) > 0 {
+		request.Values = &values
+	}
+	return request, nil
+}
+
+// parseInstallValues parses key=value pairs into nested parameter values. Keys are split on dots into nested
+// objects and values are parsed as YAML, so that numbers, booleans, and lists keep their types.
+func parseInstallValues(pairs []string) (map[string]any, error) {
+	values := map[string]any{}
+	for _, pair := range pairs {
+		key, rawValue, found := strings.Cut(pair, "=")
+		if !found || len(key) == 0 {
+			return nil, fmt.Errorf("invalid value %q: must be in the format key=value", pair)
+		}
+
+		var value any
+		if err := yaml.Unmarshal([]byte(rawValue), &value); err != nil {
+			return nil, fmt.Errorf("invalid value %q: %w", pair, err)
+		}
+
+		path := strings.Split(key, ".")
+		parent := values
+		for _, segment := range path[:len(path)-1] {
+			if len(segment) == 0 {
+				return nil, fmt.Errorf("invalid key %q: must not contain empty segments", key)
+			}
+			child, exists := parent[segment]
+			if !exists {
+				child = map[string]any{}
+				parent[segment] = child
+			}
+			childMap, ok := child.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid key %q: %s is already set to a value", key, segment)
+			}
+			parent = childMap
+		}
+		last := path[len(path)-1]
+		if len(last) == 0 {
+			return nil, fmt.Errorf("invalid key %q: must not contain empty segments", key)
+		}
+		parent[last] = value
+	}
+	return values, nil
+}
+
+func (o *InstallOptions) Run(ctx context.Context, args []string) error {
+	catalogName, itemName, err := parseCatalogItemArg(args)
+	if err != nil {
+		return err
+	}
+	request, err := o.buildInstallRequest()
+	if err != nil {
+		return err
+	}
+
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	response, err := c.V1Alpha1().InstallCatalogItemWithResponse(ctx, catalogName, itemName, *request)
+	if err != nil {
+		return fmt.Errorf("installing catalog item %s/%s: %w", catalogName, itemName, err)
+	}
+	if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusOK); err != nil {
+		return fmt.Errorf("installing catalog item %s/%s: %w", catalogName, itemName, err)
+	}
+	if response.JSON200 == nil {
+		return fmt.Errorf("installing catalog item %s/%s: empty response", catalogName, itemName)
+	}
+
+	installation := response.JSON200
+	target := fmt.Sprintf("%s/%s", FleetKind, o.Fleet)
+	if len(o.Device) > 0 {
+		target = fmt.Sprintf("%s/%s", DeviceKind, o.Device)
+	}
+	if installation.PreviousVersion != nil {
+		fmt.Printf("Catalog item upgraded: %s/%s from %s to %s as application %s of %s\n",
+			catalogName, itemName, *installation.PreviousVersion, installation.Version, installation.ApplicationName, target)
+	} else {
+		fmt.Printf("Catalog item installed: %s/%s %s as application %s of %s\n",
+			catalogName, itemName, installation.Version, installation.ApplicationName, target)
+	}
+	if installation.Deprecation != nil {
+		fmt.Printf("Warning: %s/%s %s is deprecated: %s\n", catalogName, itemName, installation.Version, installation.Deprecation.Message)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCatalogItemArg(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		wantCatalog   string
+		wantItem      string
+		errorContains string
+	}{
+		{
+			name:        "valid catalog item",
+			args:        []string{"catalogitem/my-catalog/nginx"},
+			wantCatalog: "my-catalog",
+			wantItem:    "nginx",
+		},
+		{
+			name:        "valid catalog item with short kind",
+			args:        []string{"ci/my-catalog/nginx"},
+			wantCatalog: "my-catalog",
+			wantItem:    "nginx",
+		},
+		{
+			name:          "invalid kind",
+			args:          []string{"fleet/my-fleet"},
+			errorContains: "kind must be CatalogItem",
+		},
+		{
+			name:          "missing item",
+			args:          []string{"catalogitem/my-catalog"},
+			errorContains: "catalogitem/CATALOG/ITEM",
+		},
+		{
+			name:          "empty item",
+			args:          []string{"catalogitem/my-catalog/"},
+			errorContains: "catalogitem/CATALOG/ITEM",
+		},
+		{
+			name:          "too many segments",
+			args:          []string{"catalogitem/my-catalog/nginx/extra"},
+			errorContains: "catalogitem/CATALOG/ITEM",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogName, itemName, err := parseCatalogItemArg(tt.args)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCatalog, catalogName)
+			require.Equal(t, tt.wantItem, itemName)
+		})
+	}
+}
+
+func TestInstallOptions_BuildInstallRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		fleet         string
+		device        string
+		values        []string
+		wantValues    map[string]any
+		errorContains string
+	}{
+		{
+			name:  "fleet without values",
+			fleet: "my-fleet",
+		},
+		{
+			name:   "device with typed and nested values",
+			device: "my-device",
+			values: []string{"replicas=2", "debug=true", "env.LOG_LEVEL=debug", "env.PORT=8080", "name="},
+			wantValues: map[string]any{
+				"replicas": float64(2),
+				"debug":    true,
+				"env": map[string]any{
+					"LOG_LEVEL": "debug",
+					"PORT":      float64(8080),
+				},
+				"name": nil,
+			},
+		},
+		{
+			name:          "neither fleet nor device",
+			errorContains: "exactly one of --fleet or --device",
+		},
+		{
+			name:          "fleet and device",
+			fleet:         "my-fleet",
+			device:        "my-device",
+			errorContains: "exactly one of --fleet or --device",
+		},
+		{
+			name:          "value without key",
+			fleet:         "my-fleet",
+			values:        []string{"=value"},
+			errorContains: "must be in the format key=value",
+		},
+		{
+			name:          "value without separator",
+			fleet:         "my-fleet",
+			values:        []string{"replicas"},
+			errorContains: "must be in the format key=value",
+		},
+		{
+			name:          "empty key segment",
+			fleet:         "my-fleet",
+			values:        []string{"env..PORT=8080"},
+			errorContains: "must not contain empty segments",
+		},
+		{
+			name:          "nested key below a value",
+			fleet:         "my-fleet",
+			values:        []string{"env=prod", "env.PORT=8080"},
+			errorContains: "env is already set to a value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultInstallOptions()
+			o.Fleet = tt.fleet
+			o.Device = tt.device
+			o.Values = tt.values
+			request, err := o.buildInstallRequest()
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantValues == nil {
+				require.Nil(t, request.Values)
+				return
+			}
+			require.NotNil(t, request.Values)
+			require.Equal(t, tt.wantValues, *request.Values)
+		})
+	}
+}
@@ -7,23 +7,35 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	imagebuilderapi "github.com/flightctl/flightctl/api/imagebuilder/v1alpha1"
+	"github.com/flightctl/flightctl/internal/client"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	api_remotecommand "k8s.io/apimachinery/pkg/util/remotecommand"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
 )
 
 type LogsOptions struct {
 	GlobalOptions
 	Follow bool
+	Since  string
+	Units  []string
+	App    string
+	Tail   int64
 }
 
 func DefaultLogsOptions() *LogsOptions {
 	return &LogsOptions{
 		GlobalOptions: DefaultGlobalOptions(),
 		Follow:        false,
+		Tail:          -1,
 	}
 }
 
@@ -32,8 +44,18 @@ func NewCmdLogs() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "logs (TYPE/NAME | TYPE NAME) [flags]",
 		Short: "Print the logs for a resource",
-		Long:  "Print the logs for a resource. Supports imagebuild and imageexport resources.",
-		Example: `  # Get logs for an imagebuild
+		Long: `Print the logs for a resource. Supports device, imagebuild and imageexport resources.
+
+For devices, the logs are streamed from the device through the service. By default the journal of the device is
+printed; use --unit to select systemd units, e.g. flightctl-agent.service, or --app to print the logs of the
+containers of an application.`,
+		Example: `  # Follow the logs of the flightctl agent of a device
+  flightctl logs device/my-device --unit flightctl-agent.service -f
+
+  # Print the last 100 lines of the logs of an application from the last hour
+  flightctl logs device/my-device --app my-app --since 1h --tail 100
+
+  # Get logs for an imagebuild
   flightctl logs imagebuild/my-build
 
   # Follow logs for an active imagebuild
@@ -65,6 +87,10 @@ func NewCmdLogs() *cobra.Command {
 func (o *LogsOptions) Bind(fs *pflag.FlagSet) {
 	o.GlobalOptions.Bind(fs)
 	fs.BoolVarP(&o.Follow, "follow", "f", o.Follow, "Specify if the logs should be streamed. Follows the logs until the build completes or the command is interrupted.")
+	fs.StringVar(&o.Since, "since", o.Since, "Only print device logs newer than a relative duration like 10m, or an RFC 3339 timestamp.")
+	fs.StringSliceVar(&o.Units, "unit", o.Units, "Systemd unit of the device to print the logs of. Can be repeated.")
+	fs.StringVar(&o.App, "app", o.App, "Name of the device application to print the container logs of.")
+	fs.Int64Var(&o.Tail, "tail", o.Tail, "Number of recent device log lines to print. Prints all lines if negative.")
 }
 
 func (o *LogsOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -76,30 +102,85 @@ func (o *LogsOptions) Validate(args []string) error {
 		return err
 	}
 
+	return o.validateArgs(args)
+}
+
+func (o *LogsOptions) validateArgs(args []string) error {
 	// Parse the resource argument(s)
 	kind, name, err := parseResourceArgs(args)
 	if err != nil {
 		return err
 	}
 
-	// Support imagebuild and imageexport
-	if kind != ImageBuildKind && kind != ImageExportKind {
-		return fmt.Errorf("logs command only supports imagebuild and imageexport resources, got: %s", kind)
+	// Support device, imagebuild and imageexport
+	if kind != DeviceKind && kind != ImageBuildKind && kind != ImageExportKind {
+		return fmt.Errorf("logs command only supports device, imagebuild and imageexport resources, got: %s", kind)
 	}
 
 	if name == "" {
 		return fmt.Errorf("resource name is required")
 	}
 
+	if kind != DeviceKind {
+		if o.Since != "" || len(o.Units) > 0 || o.App != "" || o.Tail >= 0 {
+			return fmt.Errorf("--since, --unit, --app and --tail are only supported for devices")
+		}
+		return nil
+	}
+
+	if errs := o.deviceLogsRequest().Validate(); len(errs) > 0 {
+		return errors.Join(errs...)
+	}
 	return nil
 }
 
+// deviceLogsRequest returns the logs request of the device logs session for the options.
+func (o *LogsOptions) deviceLogsRequest() *api.DeviceLogsRequest {
+	request := &api.DeviceLogsRequest{
+		Units:       o.Units,
+		Application: o.App,
+		Follow:      o.Follow,
+		Since:       o.Since,
+	}
+	if o.Tail >= 0 {
+		request.Tail = &o.Tail
+	}
+	return request
+}
+
+// deviceLogsQuery returns the query parameters of the device logs websocket for the options.
+func (o *LogsOptions) deviceLogsQuery() url.Values {
+	request := o.deviceLogsRequest()
+	query := url.Values{}
+	for _, unit := range request.Units {
+		query.Add(api.DeviceQueryLogsUnit, unit)
+	}
+	if request.Application != "" {
+		query.Set(api.DeviceQueryLogsApplication, request.Application)
+	}
+	if request.Follow {
+		query.Set(api.DeviceQueryLogsFollow, strconv.FormatBool(request.Follow))
+	}
+	if request.Since != "" {
+		query.Set(api.DeviceQueryLogsSince, request.Since)
+	}
+	if request.Tail != nil {
+		query.Set(api.DeviceQueryLogsTail, strconv.FormatInt(*request.Tail, 10))
+	}
+	query.Set(api.OrganizationIDQueryKey, o.GetEffectiveOrganization())
+	return query
+}
+
 func (o *LogsOptions) Run(ctx context.Context, args []string) error {
 	kind, name, err := parseResourceArgs(args)
 	if err != nil {
 		return err
 	}
 
+	if kind == DeviceKind {
+		return o.runDeviceLogs(ctx, name)
+	}
+
 	// Build imagebuilder client
 	ibClient, err := o.BuildImageBuilderClient()
 	if err != nil {
@@ -150,6 +231,44 @@ func (o *LogsOptions) Run(ctx context.Context, args []string) error {
 	}
 }
 
+// runDeviceLogs streams the logs of a device over the same websocket protocol as the device console.
+func (o *LogsOptions) runDeviceLogs(ctx context.Context, name string) error {
+	config, err := client.ParseConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("parsing config file: %w", err)
+	}
+
+	refresher := client.NewAccessTokenRefresher(config, o.ConfigFilePath, 8080)
+	refresher.Start(ctx)
+	restConfig := &rest.Config{
+		BearerToken: refresher.GetAccessToken(),
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure: config.Service.InsecureSkipVerify,
+			CertData: config.AuthInfo.ClientCertificateData,
+			CAData:   config.Service.CertificateAuthorityData,
+		},
+	}
+
+	u, err := url.Parse(fmt.Sprintf("%s/ws/v1/devices/%s/logs", config.Service.Server, name))
+	if err != nil {
+		return fmt.Errorf("parsing device logs URL: %w", err)
+	}
+	u.RawQuery = o.deviceLogsQuery().Encode()
+
+	wsClient, err := remotecommand.NewWebSocketExecutorForProtocols(restConfig, "GET", u.String(), api_remotecommand.StreamProtocolV5Name)
+	if err != nil {
+		return fmt.Errorf("creating websocket executor: %w", err)
+	}
+	err = wsClient.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("streaming logs of device %s: %w", name, err)
+	}
+	return nil
+}
+
 // handleSSEStream processes Server-Sent Events stream
 // Returns nil on orderly close (completion marker received) or error on abrupt close
 func (o *LogsOptions) handleSSEStream(body io.Reader) error {
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogsOptions_ValidateArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		setup         func(o *LogsOptions)
+		errorContains string
+	}{
+		{
+			name: "device journal",
+			args: []string{"device/my-device"},
+		},
+		{
+			name: "device units with since and tail",
+			args: []string{"device", "my-device"},
+			setup: func(o *LogsOptions) {
+				o.Units = []string{"flightctl-agent.service"}
+				o.Since = "10m"
+				o.Tail = 20
+			},
+		},
+		{
+			name: "device unit and app",
+			args: []string{"device/my-device"},
+			setup: func(o *LogsOptions) {
+				o.Units = []string{"flightctl-agent.service"}
+				o.App = "my-app"
+			},
+			errorContains: "mutually exclusive",
+		},
+		{
+			name: "device invalid since",
+			args: []string{"device/my-device"},
+			setup: func(o *LogsOptions) {
+				o.Since = "yesterday"
+			},
+			errorContains: "since must be",
+		},
+		{
+			name: "imagebuild with device flag",
+			args: []string{"imagebuild/my-build"},
+			setup: func(o *LogsOptions) {
+				o.App = "my-app"
+			},
+			errorContains: "only supported for devices",
+		},
+		{
+			name:          "unsupported kind",
+			args:          []string{"fleet/my-fleet"},
+			errorContains: "only supports device, imagebuild and imageexport",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultLogsOptions()
+			if tt.setup != nil {
+				tt.setup(o)
+			}
+			err := o.validateArgs(tt.args)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLogsOptions_DeviceLogsQuery(t *testing.T) {
+	o := DefaultLogsOptions()
+	o.Units = []string{"flightctl-agent.service", "crio.service"}
+	o.Follow = true
+	o.Since = "1h"
+	o.Tail = 0
+
+	query := o.deviceLogsQuery()
+	require.Equal(t, []string{"flightctl-agent.service", "crio.service"}, query[api.DeviceQueryLogsUnit])
+	require.Equal(t, "true", query.Get(api.DeviceQueryLogsFollow))
+	require.Equal(t, "1h", query.Get(api.DeviceQueryLogsSince))
+	require.Equal(t, "0", query.Get(api.DeviceQueryLogsTail))
+	require.False(t, query.Has(api.DeviceQueryLogsApplication))
+
+	o = DefaultLogsOptions()
+	o.App = "my-app"
+	query = o.deviceLogsQuery()
+	require.Equal(t, "my-app", query.Get(api.DeviceQueryLogsApplication))
+	require.False(t, query.Has(api.DeviceQueryLogsTail))
+	require.False(t, query.Has(api.DeviceQueryLogsFollow))
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var allowedRevocationReasons = []api.CertificateRevocationReason{
+	api.CertificateRevocationReasonUnspecified,
+	api.CertificateRevocationReasonKeyCompromise,
+	api.CertificateRevocationReasonSuperseded,
+	api.CertificateRevocationReasonCessationOfOperation,
+}
+
+func revocationReasonNames() string {
+	return strings.Join(lo.Map(allowedRevocationReasons, func(r api.CertificateRevocationReason, _ int) string { return string(r) }), ", ")
+}
+
+type RevokeOptions struct {
+	GlobalOptions
+	DeviceName string
+	Reason     string
+}
+
+func DefaultRevokeOptions() *RevokeOptions {
+	return &RevokeOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		DeviceName:    "",
+		Reason:        string(api.CertificateRevocationReasonUnspecified),
+	}
+}
+
+func NewCmdRevoke() *cobra.Command {
+	o := DefaultRevokeOptions()
+	cmd := &cobra.Command{
+		Use:   "revoke certificate (SERIAL | --device NAME)",
+		Short: "Revoke device management certificates.",
+		Long: `Revoke device management certificates issued by the service's CA.
+Devices presenting a revoked certificate are refused by the agent endpoint and the certificate is listed in the CA's certificate revocation list.
+
+Examples:
+  # Revoke a certificate by its hex-encoded serial number
+  flightctl revoke certificate 1a2b3c
+
+  # Revoke all certificates issued to a device, e.g. after it was stolen
+  flightctl revoke certificate --device my-device --reason KeyCompromise`,
+		Args:      cobra.RangeArgs(1, 2),
+		ValidArgs: []string{"certificate"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *RevokeOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVar(&o.DeviceName, "device", o.DeviceName, "Revoke all certificates issued to the named device instead of a single certificate.")
+	fs.StringVar(&o.Reason, "reason", o.Reason, fmt.Sprintf("The reason for revoking the certificates, one of: %s.", revocationReasonNames()))
+}
+
+func (o *RevokeOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	// accept the reason case-insensitively
+	for _, reason := range allowedRevocationReasons {
+		if strings.EqualFold(o.Reason, string(reason)) {
+			o.Reason = string(reason)
+		}
+	}
+	return nil
+}
+
+func (o *RevokeOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	return o.validateArgs(args)
+}
+
+func (o *RevokeOptions) validateArgs(args []string) error {
+	if args[0] != "certificate" {
+		return fmt.Errorf("only certificates can be revoked")
+	}
+	switch {
+	case len(args) == 1 && o.DeviceName == "":
+		return fmt.Errorf("specify either the serial number of a certificate or --device")
+	case len(args) == 2 && o.DeviceName != "":
+		return fmt.Errorf("cannot specify both a serial number and --device")
+	}
+
+	if !lo.Contains(allowedRevocationReasons, api.CertificateRevocationReason(o.Reason)) {
+		return fmt.Errorf("reason must be one of: (%s)", revocationReasonNames())
+	}
+	return nil
+}
+
+func (o *RevokeOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	request := api.CertificateRevocationRequest{
+		Reason: lo.ToPtr(api.CertificateRevocationReason(o.Reason)),
+	}
+	target := ""
+	if o.DeviceName != "" {
+		request.DeviceName = lo.ToPtr(o.DeviceName)
+		target = fmt.Sprintf("certificates of device %s", o.DeviceName)
+	} else {
+		request.SerialNumber = lo.ToPtr(args[1])
+		target = fmt.Sprintf("certificate %s", args[1])
+	}
+
+	response, err := c.RevokeCertificatesWithResponse(ctx, request)
+	if err != nil {
+		return fmt.Errorf("revoking %s: %w", target, err)
+	}
+	if response.HTTPResponse != nil && response.HTTPResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("unsuccessful revoking %s: %s", target, string(response.Body))
+	}
+	if response.JSON200 == nil {
+		return fmt.Errorf("revoking %s: empty response", target)
+	}
+
+	if len(response.JSON200.RevokedCertificates) == 0 {
+		fmt.Printf("No certificates to revoke: %s\n", target)
+		return nil
+	}
+	for _, cert := range response.JSON200.RevokedCertificates {
+		fmt.Printf("Revoked certificate %s (%s)\n", cert.SerialNumber, cert.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeOptions_ValidateArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		deviceName    string
+		reason        string
+		errorContains string
+	}{
+		{
+			name: "valid serial number",
+			args: []string{"certificate", "1a2b3c"},
+		},
+		{
+			name:       "valid device",
+			args:       []string{"certificate"},
+			deviceName: "my-device",
+			reason:     "keycompromise",
+		},
+		{
+			name:          "not a certificate",
+			args:          []string{"device", "my-device"},
+			errorContains: "only certificates can be revoked",
+		},
+		{
+			name:          "missing serial number and device",
+			args:          []string{"certificate"},
+			errorContains: "specify either the serial number of a certificate or --device",
+		},
+		{
+			name:          "both serial number and device",
+			args:          []string{"certificate", "1a2b3c"},
+			deviceName:    "my-device",
+			errorContains: "cannot specify both",
+		},
+		{
+			name:          "unknown reason",
+			args:          []string{"certificate", "1a2b3c"},
+			reason:        "Stolen",
+			errorContains: "reason must be one of",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultRevokeOptions()
+			o.DeviceName = tt.deviceName
+			if tt.reason != "" {
+				o.Reason = tt.reason
+			}
+			require.NoError(t, o.Complete(NewCmdRevoke(), tt.args))
+			err := o.validateArgs(tt.args)
+			if tt.errorContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errorContains)
+		})
+	}
+}
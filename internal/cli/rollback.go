@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type RollbackOptions struct {
+	GlobalOptions
+	TemplateVersion string
+}
+
+func DefaultRollbackOptions() *RollbackOptions {
+	return &RollbackOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdRollback() *cobra.Command {
+	o := DefaultRollbackOptions()
+	cmd := &cobra.Command{
+		Use:   "rollback fleet/NAME --to TEMPLATEVERSION",
+		Short: "Roll a fleet back to one of its template versions.",
+		Long: `Roll a fleet back to one of its existing template versions.
+
+The devices of the fleet are re-rendered from the template version. The fleet stays on that template version until its template is changed again.`,
+		Example: "  flightctl rollback fleet/my-fleet --to my-fleet-3",
+		Args:    cobra.MinimumNArgs(1),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{FleetKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *RollbackOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+	fs.StringVar(&o.TemplateVersion, "to", o.TemplateVersion, "The name of the template version to roll the fleet back to.")
+}
+
+func (o *RollbackOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *RollbackOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	return o.validateArgs(args)
+}
+
+func (o *RollbackOptions) validateArgs(args []string) error {
+	kind, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+
+	if kind != FleetKind {
+		return fmt.Errorf("kind must be Fleet")
+	}
+
+	if len(name) == 0 {
+		return fmt.Errorf("specify a specific fleet to roll back")
+	}
+
+	if len(o.TemplateVersion) == 0 {
+		return fmt.Errorf("specify the template version to roll back to using --to")
+	}
+
+	return nil
+}
+
+func (o *RollbackOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	_, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+
+	body := api.FleetRollbackRequest{TemplateVersion: o.TemplateVersion}
+	response, err := c.RollbackFleetWithResponse(ctx, name, body)
+	if err != nil {
+		return fmt.Errorf("rolling back fleet %s: %w", name, err)
+	}
+
+	if response.HTTPResponse != nil {
+		if response.HTTPResponse.StatusCode != http.StatusOK {
+			return fmt.Errorf("unsuccessful rolling back fleet request %s: %s", name, string(response.Body))
+		}
+	}
+
+	fmt.Printf("Fleet rolled back: %s: %s to template version %s\n", response.Status(), name, o.TemplateVersion)
+	return nil
+}
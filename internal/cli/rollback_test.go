@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollbackOptions_ValidateArgs(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		templateVersion string
+		errorContains   string
+	}{
+		{
+			name:            "valid fleet",
+			args:            []string{"fleet/my-fleet"},
+			templateVersion: "my-fleet-1",
+		},
+		{
+			name:            "valid fleet with separate name",
+			args:            []string{"fleet", "my-fleet"},
+			templateVersion: "my-fleet-1",
+		},
+		{
+			name:            "invalid kind",
+			args:            []string{"device/my-device"},
+			templateVersion: "my-fleet-1",
+			errorContains:   "kind must be Fleet",
+		},
+		{
+			name:            "missing fleet name",
+			args:            []string{"fleets"},
+			templateVersion: "my-fleet-1",
+			errorContains:   "exactly one resource name must be specified",
+		},
+		{
+			name:          "missing template version",
+			args:          []string{"fleet/my-fleet"},
+			errorContains: "--to",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultRollbackOptions()
+			o.TemplateVersion = tt.templateVersion
+			err := o.validateArgs(tt.args)
+			if tt.errorContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errorContains)
+		})
+	}
+}
@@ -285,6 +285,10 @@ func (c *Config) GetClientCertificatePath() string {
 	return resolvePath(c.AuthInfo.ClientCertificate, c.baseDir)
 }
 
+func (c *Config) GetCertificateAuthorityPath() string {
+	return resolvePath(c.Service.CertificateAuthority, c.baseDir)
+}
+
 func (c *Config) SetBaseDir(baseDir string) {
 	c.baseDir = baseDir
 }
@@ -1,7 +1,11 @@
 package ca
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/util"
 )
 
 type CAIdType int
@@ -9,8 +13,43 @@ type CAIdType int
 const (
 	InternalCA CAIdType = iota + 1
 	AsyncInternalCA
+	// PKCS11CA keeps the CA key on a PKCS#11 token, e.g. an HSM.
+	PKCS11CA
 )
 
+var caIdTypeNames = map[CAIdType]string{
+	InternalCA:      "internal",
+	AsyncInternalCA: "asyncInternal",
+	PKCS11CA:        "pkcs11",
+}
+
+func (t CAIdType) String() string {
+	if name, ok := caIdTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("CAIdType(%d)", int(t))
+}
+
+// UnmarshalJSON accepts the CA type either by name, e.g. "pkcs11", or by its numeric value.
+func (t *CAIdType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var value int
+		if err := json.Unmarshal(data, &value); err != nil {
+			return fmt.Errorf("invalid CA type %s", string(data))
+		}
+		*t = CAIdType(value)
+		return nil
+	}
+	for id, n := range caIdTypeNames {
+		if n == name {
+			*t = id
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown CA type %q", name)
+}
+
 type InternalCfg struct {
 	CertFile         string `json:"certFile,omitempty"`
 	KeyFile          string `json:"keyFile,omitempty"`
@@ -21,6 +60,37 @@ type InternalCfg struct {
 	CertStore        string `json:"certStore,omitempty"`
 }
 
+// PKCS11Cfg configures a CA whose key is stored on, and never leaves, a PKCS#11 token.
+type PKCS11Cfg struct {
+	// ModulePath is the path of the PKCS#11 library, e.g. /usr/lib64/pkcs11/libsofthsm2.so.
+	ModulePath string `json:"modulePath,omitempty"`
+	// TokenLabel selects the token by its label. Either TokenLabel or SlotNumber must be set.
+	TokenLabel string `json:"tokenLabel,omitempty"`
+	// SlotNumber selects the token by the slot containing it.
+	SlotNumber *int `json:"slotNumber,omitempty"`
+	// Pin is the user PIN of the token. It can also be set by the CA_PKCS11_PIN environment variable.
+	Pin domain.SecureString `json:"pin,omitempty"`
+	// KeyLabel is the label (and ID) of the CA key pair and certificate on the token.
+	KeyLabel string `json:"keyLabel,omitempty"`
+	// MaxSessions limits the number of concurrent sessions opened on the token.
+	MaxSessions int `json:"maxSessions,omitempty"`
+}
+
+// RotationCfg rolls the CA over to a new key pair without re-enrolling devices. The new CA is
+// trusted and published to devices right away, signs new certificates once the grace period has
+// passed and replaces the current CA in the trust bundle once the current CA is retired.
+type RotationCfg struct {
+	// CertFile and KeyFile name the certificate and key of the new CA in the cert store of an internal CA.
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	// KeyLabel is the label of the new CA key pair and certificate on the token of a PKCS#11 CA.
+	KeyLabel string `json:"keyLabel,omitempty"`
+	// GracePeriod is how long after the new CA certificate becomes valid the current CA keeps signing certificates.
+	GracePeriod util.Duration `json:"gracePeriod,omitempty"`
+	// RetirePrevious stops trusting the current CA. Set it once all devices trust the new CA.
+	RetirePrevious bool `json:"retirePrevious,omitempty"`
+}
+
 type Config struct {
 	CAType                            CAIdType     `json:"type,omitempty"`
 	AdminCommonName                   string       `json:"adminCommonName,omitempty"`
@@ -35,6 +105,8 @@ type Config struct {
 	ClientBootstrapValidityDays       int          `json:"clientBootstrapValidityDays,omitempty"`
 	DeviceCommonNamePrefix            string       `json:"deviceCommonNamePrefix,omitempty"`
 	InternalConfig                    *InternalCfg `json:"internalConfig,omitempty"`
+	PKCS11Config                      *PKCS11Cfg   `json:"pkcs11Config,omitempty"`
+	Rotation                          *RotationCfg `json:"rotation,omitempty"`
 	ServerCertValidityDays            int          `json:"serverCertValidityDays,omitempty"`
 	ExtraAllowedPrefixes              []string     `json:"extraAllowedPrefixes,omitempty"`
 }
@@ -3,8 +3,10 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -14,6 +16,8 @@ import (
 	"github.com/flightctl/flightctl/internal/config/ca"
 	"github.com/flightctl/flightctl/internal/org"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/k8s/selector/labels"
+	"github.com/google/uuid"
 	"sigs.k8s.io/yaml"
 )
 
@@ -21,6 +25,8 @@ const (
 	appName = "flightctl"
 )
 
+var eventReasonRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
 type Config struct {
 	Database            *dbConfig                  `json:"database,omitempty"`
 	Service             *svcConfig                 `json:"service,omitempty"`
@@ -28,6 +34,7 @@ type Config struct {
 	ImageBuilderWorker  *imageBuilderWorkerConfig  `json:"imageBuilderWorker,omitempty"`
 	KV                  *kvConfig                  `json:"kv,omitempty"`
 	Alertmanager        *alertmanagerConfig        `json:"alertmanager,omitempty"`
+	Notifications       *NotificationsConfig       `json:"notifications,omitempty"`
 	Auth                *authConfig                `json:"auth,omitempty"`
 	Metrics             *metricsConfig             `json:"metrics,omitempty"`
 	CA                  *ca.Config                 `json:"ca,omitempty"`
@@ -170,7 +177,16 @@ func NewDefaultImageBuilderServiceConfig() *ImageBuilderServiceConfig {
 	}
 }
 
+const (
+	// KVProviderRedis stores queues and KV data in Redis/Valkey
+	KVProviderRedis = "redis"
+	// KVProviderPostgres stores queues and KV data in the service database
+	KVProviderPostgres = "postgres"
+)
+
 type kvConfig struct {
+	// Provider is one of "redis" (default) or "postgres". The hostname, port and password are only used by "redis".
+	Provider string           `json:"provider,omitempty"`
 	Hostname string           `json:"hostname,omitempty"`
 	Port     uint             `json:"port,omitempty"`
 	Password api.SecureString `json:"password,omitempty"`
@@ -184,6 +200,30 @@ type alertmanagerConfig struct {
 	MaxDelay   string `json:"maxDelay,omitempty"`
 }
 
+type NotificationsConfig struct {
+	// Webhooks are the sinks the alert exporter delivers selected events to as signed HTTP POST requests.
+	Webhooks []WebhookSinkConfig `json:"webhooks,omitempty"`
+}
+
+// WebhookSinkConfig describes a webhook receiving events of the given reasons. Each request body
+// is signed with HMAC-SHA256 using Secret so the receiver can verify its origin.
+type WebhookSinkConfig struct {
+	Name   string           `json:"name,omitempty"`
+	URL    string           `json:"url,omitempty"`
+	Secret api.SecureString `json:"secret,omitempty"`
+	// Reasons are the event reasons delivered to the sink, e.g. "DeviceDisconnected"
+	Reasons []string `json:"reasons,omitempty"`
+	// Organizations restricts delivery to the given organization IDs. Empty means all organizations.
+	Organizations []string `json:"organizations,omitempty"`
+	// LabelSelector restricts delivery to events whose involved device or fleet matches the selector
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// MaxAttempts is the number of delivery attempts before an event is dead-lettered (default: 5)
+	MaxAttempts           int           `json:"maxAttempts,omitempty"`
+	Timeout               util.Duration `json:"timeout,omitempty"` // per request timeout (default: 10s)
+	CACert                string        `json:"caCert,omitempty"`
+	InsecureSkipTlsVerify bool          `json:"insecureSkipTlsVerify,omitempty"`
+}
+
 type authConfig struct {
 	K8s                     *api.K8sProviderSpec       `json:"k8s,omitempty"`
 	OpenShift               *api.OpenShiftProviderSpec `json:"openshift,omitempty"`
@@ -500,6 +540,7 @@ func NewDefault(opts ...ConfigOption) *Config {
 		ImageBuilderService: NewDefaultImageBuilderServiceConfig(),
 		ImageBuilderWorker:  NewDefaultImageBuilderWorkerConfig(),
 		KV: &kvConfig{
+			Provider: KVProviderRedis,
 			Hostname: "localhost",
 			Port:     6379,
 			Password: "adminpass",
@@ -626,6 +667,9 @@ func applyEnvVarOverrides(c *Config) {
 	if kvPass := os.Getenv("KV_PASSWORD"); kvPass != "" {
 		c.KV.Password = api.SecureString(kvPass)
 	}
+	if kvProvider := os.Getenv("KV_PROVIDER"); kvProvider != "" {
+		c.KV.Provider = kvProvider
+	}
 	if dbUser := os.Getenv("DB_USER"); dbUser != "" {
 		c.Database.User = dbUser
 	}
@@ -638,6 +682,9 @@ func applyEnvVarOverrides(c *Config) {
 	if dbMigrationPass := os.Getenv("DB_MIGRATION_PASSWORD"); dbMigrationPass != "" {
 		c.Database.MigrationPassword = api.SecureString(dbMigrationPass)
 	}
+	if pkcs11Pin := os.Getenv("CA_PKCS11_PIN"); pkcs11Pin != "" && c.CA != nil && c.CA.PKCS11Config != nil {
+		c.CA.PKCS11Config.Pin = api.SecureString(pkcs11Pin)
+	}
 }
 
 func applyAuthDefaults(c *Config) error {
@@ -858,6 +905,26 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	if cfg.KV != nil {
+		switch cfg.KV.Provider {
+		case "", KVProviderRedis, KVProviderPostgres:
+		default:
+			return fmt.Errorf("invalid kv.provider %q: must be one of [%s, %s]", cfg.KV.Provider, KVProviderRedis, KVProviderPostgres)
+		}
+	}
+
+	if cfg.CA != nil {
+		if err := validateCA(cfg.CA); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Notifications != nil {
+		if err := validateWebhookSinks(cfg.Notifications.Webhooks); err != nil {
+			return err
+		}
+	}
+
 	if cfg.ImageBuilderWorker != nil {
 		if time.Duration(cfg.ImageBuilderWorker.TimeoutCheckTaskInterval) <= 0 {
 			return fmt.Errorf("imageBuilderWorker.timeoutCheckTaskInterval must be greater than 0")
@@ -881,6 +948,104 @@ func Validate(cfg *Config) error {
 	return nil
 }
 
+func validateCA(cfg *ca.Config) error {
+	switch cfg.CAType {
+	case 0, ca.InternalCA, ca.AsyncInternalCA:
+	case ca.PKCS11CA:
+		p := cfg.PKCS11Config
+		if p == nil {
+			return fmt.Errorf("ca.pkcs11Config must be set for CA type %s", cfg.CAType)
+		}
+		if strings.TrimSpace(p.ModulePath) == "" {
+			return fmt.Errorf("ca.pkcs11Config.modulePath must be non-empty")
+		}
+		if (p.TokenLabel == "") == (p.SlotNumber == nil) {
+			return fmt.Errorf("exactly one of ca.pkcs11Config.tokenLabel and ca.pkcs11Config.slotNumber must be set")
+		}
+		if strings.TrimSpace(p.KeyLabel) == "" {
+			return fmt.Errorf("ca.pkcs11Config.keyLabel must be non-empty")
+		}
+		if p.MaxSessions != 0 && p.MaxSessions < 2 {
+			return fmt.Errorf("ca.pkcs11Config.maxSessions must be at least 2")
+		}
+	default:
+		return fmt.Errorf("invalid ca.type %s", cfg.CAType)
+	}
+	return validateCARotation(cfg)
+}
+
+func validateCARotation(cfg *ca.Config) error {
+	r := cfg.Rotation
+	if r == nil {
+		return nil
+	}
+	if cfg.CAType == ca.PKCS11CA {
+		if strings.TrimSpace(r.KeyLabel) == "" {
+			return fmt.Errorf("ca.rotation.keyLabel must be non-empty")
+		}
+		if r.KeyLabel == cfg.PKCS11Config.KeyLabel {
+			return fmt.Errorf("ca.rotation.keyLabel must differ from ca.pkcs11Config.keyLabel")
+		}
+	} else {
+		if strings.TrimSpace(r.CertFile) == "" || strings.TrimSpace(r.KeyFile) == "" {
+			return fmt.Errorf("ca.rotation.certFile and ca.rotation.keyFile must be non-empty")
+		}
+		if cfg.InternalConfig != nil && (r.CertFile == cfg.InternalConfig.CertFile || r.KeyFile == cfg.InternalConfig.KeyFile) {
+			return fmt.Errorf("ca.rotation.certFile and ca.rotation.keyFile must differ from the files of the current CA")
+		}
+	}
+	if r.GracePeriod < 0 {
+		return fmt.Errorf("ca.rotation.gracePeriod must not be negative")
+	}
+	return nil
+}
+
+func validateWebhookSinks(sinks []WebhookSinkConfig) error {
+	names := make(map[string]struct{}, len(sinks))
+	for i, sink := range sinks {
+		if strings.TrimSpace(sink.Name) == "" {
+			return fmt.Errorf("notifications.webhooks[%d].name must be non-empty", i)
+		}
+		if _, exists := names[sink.Name]; exists {
+			return fmt.Errorf("notifications.webhooks[%d].name %q is not unique", i, sink.Name)
+		}
+		names[sink.Name] = struct{}{}
+
+		u, err := url.Parse(sink.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("notifications.webhooks[%d].url must be an absolute http or https URL", i)
+		}
+		if sink.Secret.Value() == "" {
+			return fmt.Errorf("notifications.webhooks[%d].secret must be non-empty", i)
+		}
+		if len(sink.Reasons) == 0 {
+			return fmt.Errorf("notifications.webhooks[%d].reasons must be non-empty", i)
+		}
+		for _, reason := range sink.Reasons {
+			if !eventReasonRegexp.MatchString(reason) {
+				return fmt.Errorf("notifications.webhooks[%d].reasons: invalid event reason %q", i, reason)
+			}
+		}
+		for _, orgID := range sink.Organizations {
+			if _, err := uuid.Parse(orgID); err != nil {
+				return fmt.Errorf("notifications.webhooks[%d].organizations: invalid organization ID %q", i, orgID)
+			}
+		}
+		if sink.LabelSelector != "" {
+			if _, err := labels.Parse(sink.LabelSelector); err != nil {
+				return fmt.Errorf("notifications.webhooks[%d].labelSelector: %w", i, err)
+			}
+		}
+		if sink.MaxAttempts < 0 {
+			return fmt.Errorf("notifications.webhooks[%d].maxAttempts must not be negative", i)
+		}
+		if sink.Timeout < 0 {
+			return fmt.Errorf("notifications.webhooks[%d].timeout must not be negative", i)
+		}
+	}
+	return nil
+}
+
 func validateAuthProviderRoleAssignment(roleAssignment api.AuthRoleAssignment, providerType string) error {
 	discriminator, err := roleAssignment.Discriminator()
 	if err != nil {
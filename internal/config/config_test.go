@@ -1,10 +1,15 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/flightctl/flightctl/internal/config/ca"
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/util"
 	"github.com/samber/lo"
 )
 
@@ -203,3 +208,173 @@ func TestConfig_String_HandlesNilClientSecrets(t *testing.T) {
 		t.Error("Should handle nil client secrets gracefully")
 	}
 }
+
+func TestValidate_KVProvider(t *testing.T) {
+	for _, provider := range []string{"", KVProviderRedis, KVProviderPostgres} {
+		cfg := NewDefault()
+		cfg.KV.Provider = provider
+		if err := Validate(cfg); err != nil {
+			t.Errorf("kv.provider %q should be valid: %v", provider, err)
+		}
+	}
+
+	cfg := NewDefault()
+	cfg.KV.Provider = "memcached"
+	if err := Validate(cfg); err == nil {
+		t.Error("unknown kv.provider should be rejected")
+	}
+}
+
+func TestValidate_NotificationWebhooks(t *testing.T) {
+	validSink := func() WebhookSinkConfig {
+		return WebhookSinkConfig{
+			Name:          "ops",
+			URL:           "https://hooks.example.com/flightctl",
+			Secret:        "s3cr3t",
+			Reasons:       []string{string(domain.EventReasonDeviceDisconnected)},
+			Organizations: []string{"11111111-1111-1111-1111-111111111111"},
+			LabelSelector: "env=prod,site in (a,b)",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(sinks []WebhookSinkConfig) []WebhookSinkConfig
+		wantErr bool
+	}{
+		{name: "valid", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { return s }},
+		{name: "missing name", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { s[0].Name = ""; return s }, wantErr: true},
+		{name: "duplicate name", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { return append(s, s[0]) }, wantErr: true},
+		{name: "relative url", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { s[0].URL = "/hook"; return s }, wantErr: true},
+		{name: "unsupported scheme", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { s[0].URL = "ftp://example.com"; return s }, wantErr: true},
+		{name: "missing secret", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { s[0].Secret = ""; return s }, wantErr: true},
+		{name: "missing reasons", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { s[0].Reasons = nil; return s }, wantErr: true},
+		{name: "invalid reason", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { s[0].Reasons = []string{"a,b"}; return s }, wantErr: true},
+		{name: "invalid organization", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { s[0].Organizations = []string{"org"}; return s }, wantErr: true},
+		{name: "invalid label selector", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { s[0].LabelSelector = "env in prod"; return s }, wantErr: true},
+		{name: "negative max attempts", mutate: func(s []WebhookSinkConfig) []WebhookSinkConfig { s[0].MaxAttempts = -1; return s }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewDefault()
+			cfg.Notifications = &NotificationsConfig{Webhooks: tt.mutate([]WebhookSinkConfig{validSink()})}
+			err := Validate(cfg)
+			if tt.wantErr && err == nil {
+				t.Error("expected validation error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validation error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidate_PKCS11CA(t *testing.T) {
+	validConfig := func() *ca.PKCS11Cfg {
+		return &ca.PKCS11Cfg{
+			ModulePath: "/usr/lib64/pkcs11/libsofthsm2.so",
+			TokenLabel: "flightctl",
+			KeyLabel:   "flightctl-ca",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(p *ca.PKCS11Cfg) *ca.PKCS11Cfg
+		wantErr bool
+	}{
+		{name: "valid", mutate: func(p *ca.PKCS11Cfg) *ca.PKCS11Cfg { return p }},
+		{name: "slot instead of token label", mutate: func(p *ca.PKCS11Cfg) *ca.PKCS11Cfg { p.TokenLabel = ""; p.SlotNumber = lo.ToPtr(0); return p }},
+		{name: "missing config", mutate: func(p *ca.PKCS11Cfg) *ca.PKCS11Cfg { return nil }, wantErr: true},
+		{name: "missing module path", mutate: func(p *ca.PKCS11Cfg) *ca.PKCS11Cfg { p.ModulePath = ""; return p }, wantErr: true},
+		{name: "missing token", mutate: func(p *ca.PKCS11Cfg) *ca.PKCS11Cfg { p.TokenLabel = ""; return p }, wantErr: true},
+		{name: "token label and slot", mutate: func(p *ca.PKCS11Cfg) *ca.PKCS11Cfg { p.SlotNumber = lo.ToPtr(0); return p }, wantErr: true},
+		{name: "missing key label", mutate: func(p *ca.PKCS11Cfg) *ca.PKCS11Cfg { p.KeyLabel = ""; return p }, wantErr: true},
+		{name: "single session", mutate: func(p *ca.PKCS11Cfg) *ca.PKCS11Cfg { p.MaxSessions = 1; return p }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewDefault()
+			cfg.CA.CAType = ca.PKCS11CA
+			cfg.CA.PKCS11Config = tt.mutate(validConfig())
+			err := Validate(cfg)
+			if tt.wantErr && err == nil {
+				t.Error("expected validation error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validation error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidate_CARotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		pkcs11   bool
+		rotation *ca.RotationCfg
+		wantErr  bool
+	}{
+		{name: "no rotation"},
+		{name: "valid", rotation: &ca.RotationCfg{CertFile: "client-signer-next.crt", KeyFile: "client-signer-next.key", GracePeriod: util.Duration(24 * time.Hour)}},
+		{name: "missing key file", rotation: &ca.RotationCfg{CertFile: "client-signer-next.crt"}, wantErr: true},
+		{name: "same files as the current CA", rotation: &ca.RotationCfg{CertFile: "client-signer.crt", KeyFile: "client-signer.key"}, wantErr: true},
+		{name: "negative grace period", rotation: &ca.RotationCfg{CertFile: "client-signer-next.crt", KeyFile: "client-signer-next.key", GracePeriod: util.Duration(-time.Hour)}, wantErr: true},
+		{name: "valid PKCS#11", pkcs11: true, rotation: &ca.RotationCfg{KeyLabel: "flightctl-ca-2"}},
+		{name: "PKCS#11 without key label", pkcs11: true, rotation: &ca.RotationCfg{CertFile: "client-signer-next.crt", KeyFile: "client-signer-next.key"}, wantErr: true},
+		{name: "PKCS#11 with the current key label", pkcs11: true, rotation: &ca.RotationCfg{KeyLabel: "flightctl-ca"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewDefault()
+			if tt.pkcs11 {
+				cfg.CA.CAType = ca.PKCS11CA
+				cfg.CA.PKCS11Config = &ca.PKCS11Cfg{
+					ModulePath: "/usr/lib64/pkcs11/libsofthsm2.so",
+					TokenLabel: "flightctl",
+					KeyLabel:   "flightctl-ca",
+				}
+			}
+			cfg.CA.Rotation = tt.rotation
+			err := Validate(cfg)
+			if tt.wantErr && err == nil {
+				t.Error("expected validation error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected validation error: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoad_CATypeByName(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.yaml")
+	contents := `ca:
+  type: pkcs11
+  pkcs11Config:
+    modulePath: /usr/lib64/pkcs11/libsofthsm2.so
+    tokenLabel: flightctl
+    keyLabel: flightctl-ca
+`
+	if err := os.WriteFile(cfgFile, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CA_PKCS11_PIN", "1234")
+
+	cfg, err := Load(cfgFile)
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if cfg.CA.CAType != ca.PKCS11CA {
+		t.Errorf("expected CA type %s, got %s", ca.PKCS11CA, cfg.CA.CAType)
+	}
+	if cfg.CA.PKCS11Config.Pin.Value() != "1234" {
+		t.Error("expected the PIN to be set from CA_PKCS11_PIN")
+	}
+	if strings.Contains(cfg.String(), "1234") {
+		t.Error("the PIN must not be printed")
+	}
+	if err := Validate(cfg); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+}
@@ -38,6 +38,10 @@ const (
 	// OS image and configuration have been rolled back to the pre-update
 	// version and have been activated.
 	UpdateStateRetrying UpdateState = "Retrying"
+	// The agent applied the desired spec, but rolled back to the pre-update
+	// OS image and configuration because the update could not be verified
+	// before the verification timeout. The agent will not retry.
+	UpdateStateRolledBack UpdateState = "RolledBack"
 )
 
 const (
@@ -55,6 +59,10 @@ const (
 	CheckpointConsumerTaskQueue      = "task_queue"
 	CheckpointKeyGlobal              = "global_checkpoint"
 
+	// Headers
+	// ManagementCABundleHeader carries the base64-encoded CA bundle the management service publishes to agents
+	ManagementCABundleHeader = "Flightctl-Management-CA-Bundle"
+
 	// Ctx
 	InternalRequestCtxKey      ctxKey = "internal-request"
 	ResourceSyncRequestCtxKey  ctxKey = "resource-sync-request"
@@ -68,4 +76,7 @@ const (
 	TokenCtxKey                ctxKey = "token"
 	IdentityCtxKey             ctxKey = "identity"
 	MappedIdentityCtxKey       ctxKey = "mapped-identity"
+	DryRunCtxKey               ctxKey = "dry-run"
+	// ResourceNameCtxKey holds the name of the resource an authorized request targets, if any
+	ResourceNameCtxKey ctxKey = "resource-name"
 )
@@ -6,10 +6,14 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/config/ca"
 	"github.com/flightctl/flightctl/internal/crypto/signer"
@@ -18,11 +22,15 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+// ErrUnknownIssuer is returned for a key identifier that doesn't belong to a trusted CA certificate.
+var ErrUnknownIssuer = errors.New("unknown certificate issuer")
+
 type CertOption = func(*x509.Certificate) error
 
 type CABackend interface {
 	IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error)
 	GetCABundleX509() []*x509.Certificate
+	CreateRevocationListAsDER(ctx context.Context, template *x509.RevocationList) ([]byte, error)
 }
 
 type CAClient struct {
@@ -49,10 +57,21 @@ func NewCAClient(cfg *ca.Config, caBackend CABackend) *CAClient {
 // was it loaded or generated and a nil error.
 // In case of errors a non-nil error is returned.
 func EnsureCA(cfg *ca.Config) (*CAClient, bool, error) {
-	caBackend, fresh, err := ensureInternalCA(cfg)
+	caBackend, fresh, err := ensureCABackend(cfg)
 	if err != nil {
 		return nil, fresh, err
 	}
+	if cfg.Rotation != nil {
+		// the new CA of a rotation is generated as well if it does not exist yet
+		next, _, err := ensureCABackend(rotationConfig(cfg))
+		if err != nil {
+			return nil, fresh, fmt.Errorf("ensuring the new CA: %w", err)
+		}
+		caBackend, err = newRotatingCA(cfg.Rotation, caBackend, next)
+		if err != nil {
+			return nil, fresh, err
+		}
+	}
 	ca := &CAClient{
 		caBackend: caBackend,
 		Cfg:       cfg,
@@ -62,6 +81,40 @@ func EnsureCA(cfg *ca.Config) (*CAClient, bool, error) {
 	return ca, fresh, nil
 }
 
+func ensureCABackend(cfg *ca.Config) (CABackend, bool, error) {
+	switch cfg.CAType {
+	case ca.PKCS11CA:
+		return ensurePKCS11CA(cfg)
+	default:
+		return ensureInternalCA(cfg)
+	}
+}
+
+// LoadCA connects to the CA backend selected by the configuration.
+func LoadCA(cfg *ca.Config) (CABackend, error) {
+	caBackend, err := loadCABackend(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Rotation == nil {
+		return caBackend, nil
+	}
+	next, err := loadCABackend(rotationConfig(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("loading the new CA: %w", err)
+	}
+	return newRotatingCA(cfg.Rotation, caBackend, next)
+}
+
+func loadCABackend(cfg *ca.Config) (CABackend, error) {
+	switch cfg.CAType {
+	case ca.PKCS11CA:
+		return LoadPKCS11CA(cfg)
+	default:
+		return LoadInternalCA(cfg)
+	}
+}
+
 func (caClient *CAClient) GetSigner(name string) signer.Signer {
 	return caClient.signers.GetSigner(name)
 }
@@ -264,6 +317,63 @@ func (caClient *CAClient) GetCABundleX509() []*x509.Certificate {
 	return caClient.caBackend.GetCABundleX509()
 }
 
+// IssuerKeyID returns the hex-encoded key identifier of the CA certificate that signs new certificates.
+func (caClient *CAClient) IssuerKeyID() string {
+	certs := caClient.GetCABundleX509()
+	if len(certs) == 0 {
+		return ""
+	}
+	return hex.EncodeToString(certs[0].SubjectKeyId)
+}
+
+// IssuerKeyIDs returns the hex-encoded key identifiers of the CA certificates whose certificates are trusted: the
+// one that signs new certificates and, while a CA rotation is in progress, the other CA of the rotation.
+func (caClient *CAClient) IssuerKeyIDs() []string {
+	ids := []string{caClient.IssuerKeyID()}
+	if rotation := caClient.Rotation(); rotation != nil && !rotation.Retired {
+		ids = append(ids, hex.EncodeToString(rotation.Previous.SubjectKeyId), hex.EncodeToString(rotation.Next.SubjectKeyId))
+	}
+	return sets.List(sets.New(ids...))
+}
+
+// Rotation returns the state of the CA rotation, or nil if the CA is not being rotated.
+func (caClient *CAClient) Rotation() *CARotation {
+	if rotation, ok := caClient.caBackend.(*rotatingCA); ok {
+		return rotation.rotation()
+	}
+	return nil
+}
+
+// CreateRevocationList returns a DER-encoded CRL listing the given entries, signed by the trusted CA certificate
+// with the given hex-encoded key identifier and valid for the given duration.  A CRL must be signed by the issuer
+// of the certificates it lists, so each CA of a rotation has a CRL of its own.
+func (caClient *CAClient) CreateRevocationList(ctx context.Context, issuerKeyID string, entries []x509.RevocationListEntry, validity time.Duration) ([]byte, error) {
+	issuer, ok := caClient.issuer(issuerKeyID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownIssuer, issuerKeyID)
+	}
+	now := time.Now()
+	template := &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		// CRL numbers must increase monotonically, which the issue time does across restarts and replicas
+		Number:     big.NewInt(now.UnixNano()),
+		ThisUpdate: now,
+		NextUpdate: now.Add(validity),
+	}
+	return issuer.CreateRevocationListAsDER(ctx, template)
+}
+
+// issuer returns the CA backend of the trusted CA certificate with the given hex-encoded key identifier.
+func (caClient *CAClient) issuer(keyID string) (CABackend, bool) {
+	if rotation, ok := caClient.caBackend.(*rotatingCA); ok {
+		return rotation.issuer(keyID)
+	}
+	if keyID == "" || keyID != caClient.IssuerKeyID() {
+		return nil, false
+	}
+	return caClient.caBackend, true
+}
+
 func (caClient *CAClient) GetCABundle() ([]byte, error) {
 	// If CABundleFile is configured, read it directly
 	if caClient.Cfg.InternalConfig.CABundleFile != "" {
@@ -272,6 +382,10 @@ func (caClient *CAClient) GetCABundle() ([]byte, error) {
 		if err != nil {
 			return nil, fmt.Errorf("reading ca-bundle from %s: %w", caBundlePath, err)
 		}
+		// During a CA rotation devices must trust both CAs
+		if rotation, ok := caClient.caBackend.(*rotatingCA); ok {
+			return rotation.mergeCABundle(caBundleBytes)
+		}
 		return caBundleBytes, nil
 	}
 	// Fallback to using loaded certificates
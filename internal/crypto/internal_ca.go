@@ -39,21 +39,28 @@ func ensureInternalCA(cfg *ca.Config) (CABackend, bool, error) {
 		return nil, false, err
 	}
 
-	// If CABundleFile is configured, create it (for tests, it's just a copy of the cert)
-	if cfg.InternalConfig.CABundleFile != "" {
-		caBundleFile := CertStorePath(cfg.InternalConfig.CABundleFile, cfg.InternalConfig.CertStore)
-		certBytes, err := oscrypto.EncodeCertificates(ca.GetCABundleX509()...)
-		if err != nil {
-			return nil, false, fmt.Errorf("encoding CA bundle: %w", err)
-		}
-		if err := os.WriteFile(caBundleFile, certBytes, 0600); err != nil {
-			return nil, false, fmt.Errorf("writing CA bundle to %s: %w", caBundleFile, err)
-		}
+	if err := writeCABundleFile(cfg, ca.GetCABundleX509()); err != nil {
+		return nil, false, err
 	}
-
 	return ca, true, err
 }
 
+// writeCABundleFile creates the CA bundle file if one is configured (for tests, it's just a copy of the cert).
+func writeCABundleFile(cfg *ca.Config, certs []*x509.Certificate) error {
+	if cfg.InternalConfig == nil || cfg.InternalConfig.CABundleFile == "" {
+		return nil
+	}
+	caBundleFile := CertStorePath(cfg.InternalConfig.CABundleFile, cfg.InternalConfig.CertStore)
+	certBytes, err := oscrypto.EncodeCertificates(certs...)
+	if err != nil {
+		return fmt.Errorf("encoding CA bundle: %w", err)
+	}
+	if err := os.WriteFile(caBundleFile, certBytes, 0600); err != nil {
+		return fmt.Errorf("writing CA bundle to %s: %w", caBundleFile, err)
+	}
+	return nil
+}
+
 func LoadInternalCA(cfg *ca.Config) (CABackend, error) {
 	caCertFile := CertStorePath(cfg.InternalConfig.CertFile, cfg.InternalConfig.CertStore)
 	caKeyFile := CertStorePath(cfg.InternalConfig.KeyFile, cfg.InternalConfig.CertStore)
@@ -123,7 +130,18 @@ func makeSelfSignedCAConfig(subject pkix.Name, caLifetime time.Duration, serial
 	if err != nil {
 		return nil, err
 	}
+	rootcaCert, err := makeSelfSignedCACertificate(subject, caLifetime, serial, rootcaPublicKey, rootcaPrivateKey, publicKeyHash)
+	if err != nil {
+		return nil, err
+	}
+	caConfig := &oscrypto.TLSCertificateConfig{
+		Certs: []*x509.Certificate{rootcaCert},
+		Key:   rootcaPrivateKey,
+	}
+	return caConfig, nil
+}
 
+func makeSelfSignedCACertificate(subject pkix.Name, caLifetime time.Duration, serial int64, publicKey crypto.PublicKey, privateKey crypto.PrivateKey, publicKeyHash []byte) (*x509.Certificate, error) {
 	now := time.Now()
 	rootcaTemplate := &x509.Certificate{
 		Subject: subject,
@@ -135,22 +153,14 @@ func makeSelfSignedCAConfig(subject pkix.Name, caLifetime time.Duration, serial
 
 		SerialNumber: big.NewInt(serial),
 
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 
 		AuthorityKeyId: publicKeyHash,
 		SubjectKeyId:   publicKeyHash,
 	}
-	rootcaCert, err := signCertificate(rootcaTemplate, rootcaPublicKey, rootcaTemplate, rootcaPrivateKey)
-	if err != nil {
-		return nil, err
-	}
-	caConfig := &oscrypto.TLSCertificateConfig{
-		Certs: []*x509.Certificate{rootcaCert},
-		Key:   rootcaPrivateKey,
-	}
-	return caConfig, nil
+	return signCertificate(rootcaTemplate, publicKey, rootcaTemplate, privateKey)
 }
 
 func (caBackend *internalCA) signCertificate(template *x509.Certificate, requestKey crypto.PublicKey) (*x509.Certificate, error) {
@@ -183,6 +193,15 @@ func signCertificate(template *x509.Certificate, requestKey crypto.PublicKey, is
 // This currently processes both enrollment cert and management cert signing requests, which both are signed
 // by the FC service's internal CA instance named 'ca'.
 func (caBackend *internalCA) IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error) {
+	template, err := newRequestedCertificateTemplate(caBackend.Config.Certs[0], csr, expirySeconds, usage, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return caBackend.signCertificate(template, csr.PublicKey)
+}
+
+// newRequestedCertificateTemplate returns the template of a certificate issued by issuer for the given CSR.
+func newRequestedCertificateTemplate(issuer *x509.Certificate, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error) {
 	now := time.Now()
 	expire := time.Duration(expirySeconds) * time.Second
 	// Note Subject (and other fields where applicable) validation is performed by the callers.
@@ -196,14 +215,14 @@ func (caBackend *internalCA) IssueRequestedCertificateAsX509(ctx context.Context
 		PublicKeyAlgorithm:    csr.PublicKeyAlgorithm,
 		IPAddresses:           csr.IPAddresses,
 		DNSNames:              csr.DNSNames,
-		Issuer:                caBackend.Config.Certs[0].Subject,
+		Issuer:                issuer.Subject,
 		NotBefore:             now.Add(-time.Second),
 		NotAfter:              now.Add(expire),
 		SerialNumber:          big.NewInt(1),
 		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:           usage,
 		BasicConstraintsValid: true,
-		AuthorityKeyId:        caBackend.Config.Certs[0].SubjectKeyId,
+		AuthorityKeyId:        issuer.SubjectKeyId,
 	}
 
 	for _, opt := range opts {
@@ -211,9 +230,25 @@ func (caBackend *internalCA) IssueRequestedCertificateAsX509(ctx context.Context
 			return nil, fmt.Errorf("applying cert option: %w", err)
 		}
 	}
-	return caBackend.signCertificate(template, csr.PublicKey)
+	return template, nil
 }
 
 func (caBackend *internalCA) GetCABundleX509() []*x509.Certificate {
 	return caBackend.Config.Certs
 }
+
+func (caBackend *internalCA) CreateRevocationListAsDER(ctx context.Context, template *x509.RevocationList) ([]byte, error) {
+	key, ok := caBackend.Config.Key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key does not support signing")
+	}
+	return createRevocationList(template, caBackend.Config.Certs[0], key)
+}
+
+func createRevocationList(template *x509.RevocationList, issuer *x509.Certificate, key crypto.Signer) ([]byte, error) {
+	// CAs created by earlier releases lack the cRLSign key usage and cannot sign CRLs
+	if issuer.KeyUsage&x509.KeyUsageCRLSign == 0 {
+		return nil, errors.New("CA certificate is not allowed to sign certificate revocation lists")
+	}
+	return x509.CreateRevocationList(rand.Reader, template, issuer, key)
+}
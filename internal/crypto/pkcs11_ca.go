@@ -0,0 +1,174 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"context"
+	"crypto"
+	"crypto/elliptic"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ThalesIgnite/crypto11"
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+)
+
+// pkcs11CA is a CA backend whose key is stored on a PKCS#11 token, e.g. an HSM.
+// Certificates and CRLs are signed by the token; the private key never leaves it.
+type pkcs11CA struct {
+	signer          crypto.Signer
+	certs           []*x509.Certificate
+	serialGenerator oscrypto.SerialGenerator
+}
+
+func openPKCS11Token(cfg *ca.Config) (*crypto11.Context, error) {
+	if cfg.PKCS11Config == nil {
+		return nil, errors.New("PKCS#11 CA configuration not available")
+	}
+	token, err := crypto11.Configure(&crypto11.Config{
+		Path:        cfg.PKCS11Config.ModulePath,
+		TokenLabel:  cfg.PKCS11Config.TokenLabel,
+		SlotNumber:  cfg.PKCS11Config.SlotNumber,
+		Pin:         cfg.PKCS11Config.Pin.Value(),
+		MaxSessions: cfg.PKCS11Config.MaxSessions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("opening PKCS#11 token: %w", err)
+	}
+	return token, nil
+}
+
+// LoadPKCS11CA connects to the configured PKCS#11 token and loads the CA key pair and certificate stored on it.
+func LoadPKCS11CA(cfg *ca.Config) (CABackend, error) {
+	token, err := openPKCS11Token(cfg)
+	if err != nil {
+		return nil, err
+	}
+	caBackend, err := findPKCS11CA(token, cfg.PKCS11Config.KeyLabel)
+	if err == nil && caBackend == nil {
+		err = fmt.Errorf("no CA key pair labeled %q found on the PKCS#11 token", cfg.PKCS11Config.KeyLabel)
+	}
+	if err != nil {
+		_ = token.Close()
+		return nil, err
+	}
+	return caBackend, nil
+}
+
+// ensurePKCS11CA loads the CA from the PKCS#11 token, generating a key pair and a self-signed
+// certificate on the token if it does not hold a CA key pair with the configured label yet.
+func ensurePKCS11CA(cfg *ca.Config) (CABackend, bool, error) {
+	token, err := openPKCS11Token(cfg)
+	if err != nil {
+		return nil, false, err
+	}
+	caBackend, fresh, err := ensurePKCS11CAOnToken(cfg, token)
+	if err != nil {
+		_ = token.Close()
+		return nil, false, err
+	}
+	return caBackend, fresh, nil
+}
+
+func ensurePKCS11CAOnToken(cfg *ca.Config, token *crypto11.Context) (*pkcs11CA, bool, error) {
+	label := []byte(cfg.PKCS11Config.KeyLabel)
+	caBackend, err := findPKCS11CA(token, cfg.PKCS11Config.KeyLabel)
+	if err != nil || caBackend != nil {
+		return caBackend, false, err
+	}
+
+	// crypto11 generates private keys that are sensitive and not extractable
+	key, err := token.GenerateECDSAKeyPairWithLabel(label, label, elliptic.P256())
+	if err != nil {
+		return nil, false, fmt.Errorf("generating CA key pair on the PKCS#11 token: %w", err)
+	}
+	publicKeyHash, err := fccrypto.HashPublicKey(key.Public())
+	if err != nil {
+		return nil, false, err
+	}
+	serialGenerator := &oscrypto.RandomSerialGenerator{}
+	serial, err := serialGenerator.Next(&x509.Certificate{SerialNumber: big.NewInt(1)})
+	if err != nil {
+		return nil, false, err
+	}
+	cert, err := makeSelfSignedCACertificate(
+		pkix.Name{CommonName: cfg.InternalConfig.SignerCertName},
+		time.Duration(cfg.InternalConfig.CertValidityDays)*24*time.Hour,
+		serial,
+		key.Public(),
+		key,
+		publicKeyHash,
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("creating CA certificate: %w", err)
+	}
+	if err := token.ImportCertificateWithLabel(label, label, cert); err != nil {
+		return nil, false, fmt.Errorf("storing CA certificate on the PKCS#11 token: %w", err)
+	}
+	if err := writeCABundleFile(cfg, []*x509.Certificate{cert}); err != nil {
+		return nil, false, err
+	}
+
+	return &pkcs11CA{
+		signer:          key,
+		certs:           []*x509.Certificate{cert},
+		serialGenerator: serialGenerator,
+	}, true, nil
+}
+
+// findPKCS11CA returns the CA whose key pair and certificate carry the given label, or nil if the token holds no such key pair.
+func findPKCS11CA(token *crypto11.Context, keyLabel string) (*pkcs11CA, error) {
+	label := []byte(keyLabel)
+	key, err := token.FindKeyPair(nil, label)
+	if err != nil {
+		return nil, fmt.Errorf("finding CA key pair on the PKCS#11 token: %w", err)
+	}
+	if key == nil {
+		return nil, nil
+	}
+	cert, err := token.FindCertificate(nil, label, nil)
+	if err != nil {
+		return nil, fmt.Errorf("finding CA certificate on the PKCS#11 token: %w", err)
+	}
+	if cert == nil {
+		return nil, fmt.Errorf("no CA certificate labeled %q found on the PKCS#11 token", keyLabel)
+	}
+	publicKey, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(key.Public()) {
+		return nil, fmt.Errorf("CA certificate labeled %q does not match the key pair on the PKCS#11 token", keyLabel)
+	}
+	return &pkcs11CA{
+		signer:          key,
+		certs:           []*x509.Certificate{cert},
+		serialGenerator: &oscrypto.RandomSerialGenerator{},
+	}, nil
+}
+
+func (caBackend *pkcs11CA) IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error) {
+	template, err := newRequestedCertificateTemplate(caBackend.certs[0], csr, expirySeconds, usage, opts...)
+	if err != nil {
+		return nil, err
+	}
+	// The signature algorithm follows from the type of the key on the token, not from the CSR
+	template.SignatureAlgorithm = x509.UnknownSignatureAlgorithm
+	serial, err := caBackend.serialGenerator.Next(template)
+	if err != nil {
+		return nil, err
+	}
+	template.SerialNumber = big.NewInt(serial)
+	return signCertificate(template, csr.PublicKey, caBackend.certs[0], caBackend.signer)
+}
+
+func (caBackend *pkcs11CA) GetCABundleX509() []*x509.Certificate {
+	return caBackend.certs
+}
+
+func (caBackend *pkcs11CA) CreateRevocationListAsDER(ctx context.Context, template *x509.RevocationList) ([]byte, error) {
+	return createRevocationList(template, caBackend.certs[0], caBackend.signer)
+}
@@ -0,0 +1,20 @@
+//go:build !cgo
+
+package crypto
+
+import (
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+)
+
+// PKCS#11 modules are loaded through cgo, so binaries built without it cannot use a PKCS#11 CA.
+var errPKCS11Unsupported = errors.New("PKCS#11 CA is not supported: the service was built without cgo")
+
+func LoadPKCS11CA(cfg *ca.Config) (CABackend, error) {
+	return nil, errPKCS11Unsupported
+}
+
+func ensurePKCS11CA(cfg *ca.Config) (CABackend, bool, error) {
+	return nil, false, errPKCS11Unsupported
+}
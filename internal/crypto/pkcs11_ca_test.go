@@ -0,0 +1,221 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var softHSMModulePaths = []string{
+	"/usr/lib64/pkcs11/libsofthsm2.so",
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+}
+
+// softHSMModule is the path of the SoftHSM module if TestMain initialized a token for the PKCS#11 tests.
+var softHSMModule string
+
+func TestMain(m *testing.M) {
+	dir, err := setupSoftHSM()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "setting up SoftHSM: %v\n", err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	if dir != "" {
+		_ = os.RemoveAll(dir)
+	}
+	os.Exit(code)
+}
+
+// setupSoftHSM initializes a SoftHSM token in a temporary directory if SoftHSM is installed.
+// SoftHSM reads its configuration only once per process, so all tests share the token and use
+// their own key labels. SOFTHSM2_MODULE overrides the location of the module.
+func setupSoftHSM() (string, error) {
+	modulePath := os.Getenv("SOFTHSM2_MODULE")
+	if modulePath == "" {
+		for _, path := range softHSMModulePaths {
+			if _, err := os.Stat(path); err == nil {
+				modulePath = path
+				break
+			}
+		}
+	}
+	if modulePath == "" {
+		return "", nil
+	}
+	if _, err := exec.LookPath("softhsm2-util"); err != nil {
+		return "", nil
+	}
+
+	dir, err := os.MkdirTemp("", "flightctl-softhsm")
+	if err != nil {
+		return "", err
+	}
+	tokenDir := filepath.Join(dir, "tokens")
+	if err := os.Mkdir(tokenDir, 0700); err != nil {
+		return dir, err
+	}
+	conf := filepath.Join(dir, "softhsm2.conf")
+	if err := os.WriteFile(conf, []byte(fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\n", tokenDir)), 0600); err != nil {
+		return dir, err
+	}
+	if err := os.Setenv("SOFTHSM2_CONF", conf); err != nil {
+		return dir, err
+	}
+	// #nosec G204 -- fixed arguments
+	if out, err := exec.Command("softhsm2-util", "--init-token", "--free", "--label", "flightctl", "--pin", "1234", "--so-pin", "5678").CombinedOutput(); err != nil {
+		return dir, fmt.Errorf("%w: %s", err, out)
+	}
+	softHSMModule = modulePath
+	return dir, nil
+}
+
+// newSoftHSMConfig returns a CA configuration using a key labeled after the test on the SoftHSM token.
+func newSoftHSMConfig(t *testing.T) *ca.Config {
+	t.Helper()
+	if softHSMModule == "" {
+		t.Skip("SoftHSM not found, install it or set SOFTHSM2_MODULE to run PKCS#11 tests")
+	}
+	cfg := ca.NewDefault(t.TempDir())
+	cfg.CAType = ca.PKCS11CA
+	cfg.PKCS11Config = &ca.PKCS11Cfg{
+		ModulePath: softHSMModule,
+		TokenLabel: "flightctl",
+		Pin:        "1234",
+		KeyLabel:   t.Name(),
+	}
+	return cfg
+}
+
+func TestPKCS11CA(t *testing.T) {
+	require := require.New(t)
+	cfg := newSoftHSMConfig(t)
+
+	caClient, fresh, err := EnsureCA(cfg)
+	require.NoError(err)
+	require.True(fresh)
+	caCert := caClient.GetCABundleX509()[0]
+	require.Equal(cfg.InternalConfig.SignerCertName, caCert.Subject.CommonName)
+	require.True(caCert.IsCA)
+
+	// the CA bundle is written for the agents
+	bundle, err := caClient.GetCABundle()
+	require.NoError(err)
+	require.NotEmpty(bundle)
+
+	// the key stays on the token: the backend only holds a handle to it
+	backend, ok := caClient.caBackend.(*pkcs11CA)
+	require.True(ok)
+	_, isSoftwareKey := backend.signer.(*ecdsa.PrivateKey)
+	require.False(isSoftwareKey)
+
+	cert, err := caClient.IssueRequestedClientCertificate(t.Context(), newTestCSR(t, "device:1234"), 3600)
+	require.NoError(err)
+	require.NoError(cert.CheckSignatureFrom(caCert))
+	require.Equal(caCert.SubjectKeyId, cert.AuthorityKeyId)
+	require.Equal([]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+
+	der, err := caClient.CreateRevocationList(t.Context(), caClient.IssuerKeyID(), []x509.RevocationListEntry{
+		{SerialNumber: cert.SerialNumber, RevocationTime: time.Now()},
+	}, time.Hour)
+	require.NoError(err)
+	crl, err := x509.ParseRevocationList(der)
+	require.NoError(err)
+	require.NoError(crl.CheckSignatureFrom(caCert))
+	require.Len(crl.RevokedCertificateEntries, 1)
+
+	// the services load the same CA from the token
+	loaded, err := LoadCA(cfg)
+	require.NoError(err)
+	require.True(loaded.GetCABundleX509()[0].Equal(caCert))
+
+	_, fresh, err = EnsureCA(cfg)
+	require.NoError(err)
+	require.False(fresh)
+}
+
+func TestPKCS11CAServerCertificate(t *testing.T) {
+	require := require.New(t)
+	cfg := newSoftHSMConfig(t)
+	caClient, _, err := EnsureCA(cfg)
+	require.NoError(err)
+
+	csr := newTestCSR(t, "flightctl-api")
+	cert, err := caClient.IssueRequestedServerCertificate(t.Context(), csr, 3600, func(c *x509.Certificate) error {
+		c.DNSNames = []string{"api.flightctl.example.com"}
+		return nil
+	})
+	require.NoError(err)
+	require.NoError(cert.VerifyHostname("api.flightctl.example.com"))
+	require.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+
+	// certificates get distinct serial numbers
+	other, err := caClient.IssueRequestedServerCertificate(t.Context(), csr, 3600)
+	require.NoError(err)
+	require.NotEqual(0, cert.SerialNumber.Cmp(other.SerialNumber))
+}
+
+func TestLoadPKCS11CAWithoutKey(t *testing.T) {
+	cfg := newSoftHSMConfig(t)
+
+	_, err := LoadCA(cfg)
+	require.ErrorContains(t, err, "no CA key pair labeled")
+}
+
+func TestPKCS11CASigning(t *testing.T) {
+	require := require.New(t)
+
+	// The backend only depends on the token for its crypto.Signer, so a software key exercises the signing paths
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	keyHash, err := fccrypto.HashPublicKey(key.Public())
+	require.NoError(err)
+	caCert, err := makeSelfSignedCACertificate(pkix.Name{CommonName: "test-ca"}, time.Hour, 1, key.Public(), key, keyHash)
+	require.NoError(err)
+	caClient := NewCAClient(ca.NewDefault(""), &pkcs11CA{
+		signer:          key,
+		certs:           []*x509.Certificate{caCert},
+		serialGenerator: &oscrypto.RandomSerialGenerator{},
+	})
+
+	// CSRs signed with another key type than the CA's still get certificates signed with the CA's algorithm
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: "device:1234"}}, rsaKey)
+	require.NoError(err)
+	csr, err := x509.ParseCertificateRequest(der)
+	require.NoError(err)
+
+	cert, err := caClient.IssueRequestedClientCertificate(t.Context(), csr, 3600)
+	require.NoError(err)
+	require.NoError(cert.CheckSignatureFrom(caCert))
+	require.Equal(x509.ECDSAWithSHA256, cert.SignatureAlgorithm)
+	require.Equal(caClient.IssuerKeyID(), hex.EncodeToString(cert.AuthorityKeyId))
+
+	crlDER, err := caClient.CreateRevocationList(t.Context(), caClient.IssuerKeyID(), []x509.RevocationListEntry{
+		{SerialNumber: cert.SerialNumber, RevocationTime: time.Now()},
+	}, time.Hour)
+	require.NoError(err)
+	crl, err := x509.ParseRevocationList(crlDER)
+	require.NoError(err)
+	require.NoError(crl.CheckSignatureFrom(caCert))
+}
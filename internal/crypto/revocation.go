@@ -0,0 +1,28 @@
+package crypto
+
+import (
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RevocationKeyFromCert returns the hex-encoded issuer key identifier and serial number
+// that identify a certificate in the revocation store.
+func RevocationKeyFromCert(cert *x509.Certificate) (issuerKeyID string, serialNumber string) {
+	return hex.EncodeToString(cert.AuthorityKeyId), cert.SerialNumber.Text(16)
+}
+
+// NormalizeSerialNumber parses a hex-encoded serial number, optionally "0x"-prefixed or
+// colon-separated as printed by openssl, and returns it in the form used by RevocationKeyFromCert.
+func NormalizeSerialNumber(serialNumber string) (string, error) {
+	s := strings.TrimSpace(serialNumber)
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	s = strings.ReplaceAll(s, ":", "")
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok || n.Sign() < 0 {
+		return "", fmt.Errorf("invalid serial number %q: must be hex-encoded", serialNumber)
+	}
+	return n.Text(16), nil
+}
@@ -0,0 +1,149 @@
+package crypto
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+)
+
+// CARotation describes the rotation of the CA to a new key pair.
+type CARotation struct {
+	// Previous is the CA certificate being rotated out.
+	Previous *x509.Certificate
+	// Next is the CA certificate replacing it.
+	Next *x509.Certificate
+	// SwitchTime is when the new CA starts signing certificates.
+	SwitchTime time.Time
+	// Retired is set once the previous CA is no longer trusted.
+	Retired bool
+}
+
+// Switched returns whether the new CA signs certificates at the given time.
+func (r *CARotation) Switched(now time.Time) bool {
+	return r.Retired || !now.Before(r.SwitchTime)
+}
+
+// rotatingCA is a CA backend rolling over from the previous to the next CA. Both CAs are trusted
+// until the previous CA is retired; the previous CA signs certificates until the switch time.
+type rotatingCA struct {
+	previous   CABackend
+	next       CABackend
+	switchTime time.Time
+	retired    bool
+}
+
+func newRotatingCA(cfg *ca.RotationCfg, previous, next CABackend) (*rotatingCA, error) {
+	if len(previous.GetCABundleX509()) == 0 || len(next.GetCABundleX509()) == 0 {
+		return nil, errors.New("CA rotation requires the certificates of both CAs")
+	}
+	return &rotatingCA{
+		previous:   previous,
+		next:       next,
+		switchTime: next.GetCABundleX509()[0].NotBefore.Add(time.Duration(cfg.GracePeriod)),
+		retired:    cfg.RetirePrevious,
+	}, nil
+}
+
+// rotationConfig returns the configuration of the CA replacing the configured one.
+func rotationConfig(cfg *ca.Config) *ca.Config {
+	next := *cfg
+	next.Rotation = nil
+	internalConfig := *cfg.InternalConfig
+	switch cfg.CAType {
+	case ca.PKCS11CA:
+		pkcs11Config := *cfg.PKCS11Config
+		pkcs11Config.KeyLabel = cfg.Rotation.KeyLabel
+		next.PKCS11Config = &pkcs11Config
+	default:
+		internalConfig.CertFile = cfg.Rotation.CertFile
+		internalConfig.KeyFile = cfg.Rotation.KeyFile
+		// the new CA uses random serial numbers, so the serial file of the previous CA is left untouched
+		internalConfig.SerialFile = ""
+	}
+	// the bundle of both CAs is assembled by GetCABundle, so the new CA must not overwrite the bundle file
+	internalConfig.CABundleFile = ""
+	next.InternalConfig = &internalConfig
+	return &next
+}
+
+func (caBackend *rotatingCA) rotation() *CARotation {
+	return &CARotation{
+		Previous:   caBackend.previous.GetCABundleX509()[0],
+		Next:       caBackend.next.GetCABundleX509()[0],
+		SwitchTime: caBackend.switchTime,
+		Retired:    caBackend.retired,
+	}
+}
+
+func (caBackend *rotatingCA) signingCA() CABackend {
+	if caBackend.rotation().Switched(time.Now()) {
+		return caBackend.next
+	}
+	return caBackend.previous
+}
+
+func (caBackend *rotatingCA) IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error) {
+	return caBackend.signingCA().IssueRequestedCertificateAsX509(ctx, csr, expirySeconds, usage, opts...)
+}
+
+// GetCABundleX509 returns the certificates of the signing CA followed by those of the other CA,
+// unless the previous CA has been retired.
+func (caBackend *rotatingCA) GetCABundleX509() []*x509.Certificate {
+	if caBackend.retired {
+		return caBackend.next.GetCABundleX509()
+	}
+	signing, other := caBackend.previous, caBackend.next
+	if caBackend.signingCA() == caBackend.next {
+		signing, other = caBackend.next, caBackend.previous
+	}
+	return appendMissingCertificates(slices.Clone(signing.GetCABundleX509()), other.GetCABundleX509()...)
+}
+
+func (caBackend *rotatingCA) CreateRevocationListAsDER(ctx context.Context, template *x509.RevocationList) ([]byte, error) {
+	return caBackend.signingCA().CreateRevocationListAsDER(ctx, template)
+}
+
+// issuer returns the CA of the rotation whose CA certificate has the given hex-encoded key identifier, unless it
+// is the retired previous CA.
+func (caBackend *rotatingCA) issuer(keyID string) (CABackend, bool) {
+	issuers := []CABackend{caBackend.next}
+	if !caBackend.retired {
+		issuers = append(issuers, caBackend.previous)
+	}
+	for _, issuer := range issuers {
+		if keyID != "" && hex.EncodeToString(issuer.GetCABundleX509()[0].SubjectKeyId) == keyID {
+			return issuer, true
+		}
+	}
+	return nil, false
+}
+
+// mergeCABundle adds the certificates of the new CA to a PEM-encoded CA bundle and, once the
+// previous CA is retired, removes the previous CA certificate from it.
+func (caBackend *rotatingCA) mergeCABundle(bundle []byte) ([]byte, error) {
+	certs, err := oscrypto.CertsFromPEM(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CA bundle: %w", err)
+	}
+	if caBackend.retired {
+		previous := caBackend.previous.GetCABundleX509()[0]
+		certs = slices.DeleteFunc(certs, previous.Equal)
+	}
+	return oscrypto.EncodeCertificates(appendMissingCertificates(certs, caBackend.GetCABundleX509()...)...)
+}
+
+func appendMissingCertificates(certs []*x509.Certificate, others ...*x509.Certificate) []*x509.Certificate {
+	for _, other := range others {
+		if !slices.ContainsFunc(certs, other.Equal) {
+			certs = append(certs, other)
+		}
+	}
+	return certs
+}
@@ -0,0 +1,158 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/util"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCSR(t *testing.T, commonName string) *x509.CertificateRequest {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}, key)
+	require.NoError(t, err)
+	csr, err := x509.ParseCertificateRequest(der)
+	require.NoError(t, err)
+	return csr
+}
+
+func newRotationTestConfig(t *testing.T) *ca.Config {
+	cfg := ca.NewDefault(t.TempDir())
+	cfg.InternalConfig.SerialFile = "client-signer.serial"
+	return cfg
+}
+
+func startRotation(cfg *ca.Config, gracePeriod time.Duration) {
+	cfg.Rotation = &ca.RotationCfg{
+		CertFile:    "client-signer-next.crt",
+		KeyFile:     "client-signer-next.key",
+		GracePeriod: util.Duration(gracePeriod),
+	}
+}
+
+func bundleCertificates(t *testing.T, caClient *CAClient) []*x509.Certificate {
+	bundle, err := caClient.GetCABundle()
+	require.NoError(t, err)
+	certs, err := oscrypto.CertsFromPEM(bundle)
+	require.NoError(t, err)
+	return certs
+}
+
+func TestCARotation(t *testing.T) {
+	require := require.New(t)
+	cfg := newRotationTestConfig(t)
+	previousClient, _, err := EnsureCA(cfg)
+	require.NoError(err)
+	previous := previousClient.GetCABundleX509()[0]
+	require.Nil(previousClient.Rotation())
+	deviceCert, err := previousClient.IssueRequestedClientCertificate(t.Context(), newTestCSR(t, "device:1234"), 3600)
+	require.NoError(err)
+
+	// the new CA is generated and published, but the previous CA keeps signing during the grace period
+	startRotation(cfg, time.Hour)
+	caClient, fresh, err := EnsureCA(cfg)
+	require.NoError(err)
+	require.False(fresh)
+	rotation := caClient.Rotation()
+	require.NotNil(rotation)
+	require.True(rotation.Previous.Equal(previous))
+	require.False(rotation.Next.Equal(previous))
+	require.False(rotation.Switched(time.Now()))
+	require.Equal(rotation.Next.NotBefore.Add(time.Hour), rotation.SwitchTime)
+	require.FileExists(CertStorePath("client-signer-next.key", cfg.InternalConfig.CertStore))
+
+	require.Equal(previousClient.IssuerKeyID(), caClient.IssuerKeyID())
+	require.ElementsMatch([]string{caClient.IssuerKeyID(), hex.EncodeToString(rotation.Next.SubjectKeyId)}, caClient.IssuerKeyIDs())
+	require.Len(caClient.GetCABundleX509(), 2)
+	bundle := bundleCertificates(t, caClient)
+	require.Len(bundle, 2)
+	require.True(bundle[0].Equal(previous))
+	require.True(bundle[1].Equal(rotation.Next))
+
+	cert, err := caClient.IssueRequestedClientCertificate(t.Context(), newTestCSR(t, "device:1234"), 3600)
+	require.NoError(err)
+	require.NoError(cert.CheckSignatureFrom(previous))
+
+	// the services load the same CAs
+	loaded, err := LoadCA(cfg)
+	require.NoError(err)
+	require.True(NewCAClient(cfg, loaded).Rotation().Next.Equal(rotation.Next))
+
+	// after the grace period the new CA signs certificates while both stay trusted
+	startRotation(cfg, 0)
+	caClient, _, err = EnsureCA(cfg)
+	require.NoError(err)
+	require.True(caClient.Rotation().Switched(time.Now()))
+	require.True(caClient.GetCABundleX509()[0].Equal(rotation.Next))
+	require.Len(caClient.GetCABundleX509(), 2)
+
+	cert, err = caClient.IssueRequestedClientCertificate(t.Context(), newTestCSR(t, "device:1234"), 3600)
+	require.NoError(err)
+	require.NoError(cert.CheckSignatureFrom(rotation.Next))
+
+	pool := x509.NewCertPool()
+	for _, caCert := range caClient.GetCABundleX509() {
+		pool.AddCert(caCert)
+	}
+	opts := x509.VerifyOptions{Roots: pool, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}
+	_, err = cert.Verify(opts)
+	require.NoError(err)
+	_, err = deviceCert.Verify(opts)
+	require.NoError(err)
+
+	// each CA signs its own CRL
+	for _, issuer := range []*x509.Certificate{previous, rotation.Next} {
+		crl, err := caClient.CreateRevocationList(t.Context(), hex.EncodeToString(issuer.SubjectKeyId), nil, time.Hour)
+		require.NoError(err)
+		parsed, err := x509.ParseRevocationList(crl)
+		require.NoError(err)
+		require.NoError(parsed.CheckSignatureFrom(issuer))
+	}
+	_, err = caClient.CreateRevocationList(t.Context(), "deadbeef", nil, time.Hour)
+	require.ErrorIs(err, ErrUnknownIssuer)
+
+	// once retired, the previous CA is neither trusted nor published
+	cfg.Rotation.RetirePrevious = true
+	caClient, _, err = EnsureCA(cfg)
+	require.NoError(err)
+	require.Len(caClient.GetCABundleX509(), 1)
+	require.True(caClient.GetCABundleX509()[0].Equal(rotation.Next))
+	require.Equal([]string{hex.EncodeToString(rotation.Next.SubjectKeyId)}, caClient.IssuerKeyIDs())
+	_, err = caClient.CreateRevocationList(t.Context(), hex.EncodeToString(previous.SubjectKeyId), nil, time.Hour)
+	require.ErrorIs(err, ErrUnknownIssuer)
+	bundle = bundleCertificates(t, caClient)
+	require.Len(bundle, 1)
+	require.True(bundle[0].Equal(rotation.Next))
+
+	// the bundle file of the previous CA is left as it was
+	bundleFile, err := os.ReadFile(CertStorePath(cfg.InternalConfig.CABundleFile, cfg.InternalConfig.CertStore))
+	require.NoError(err)
+	fileCerts, err := oscrypto.CertsFromPEM(bundleFile)
+	require.NoError(err)
+	require.Len(fileCerts, 1)
+	require.True(fileCerts[0].Equal(previous))
+}
+
+func TestLoadCAWithoutRotationCA(t *testing.T) {
+	cfg := newRotationTestConfig(t)
+	_, _, err := EnsureCA(cfg)
+	require.NoError(t, err)
+
+	startRotation(cfg, time.Hour)
+	_, err = LoadCA(cfg)
+	require.ErrorContains(t, err, "loading the new CA")
+}
@@ -0,0 +1,36 @@
+package domain
+
+import v1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+
+// BulkOperation domain types use v1alpha1 as the internal representation.
+// BulkOperation resources are only available in v1alpha1 (alpha-stage feature).
+
+type BulkOperation = v1alpha1.BulkOperation
+type BulkOperationList = v1alpha1.BulkOperationList
+type BulkOperationSpec = v1alpha1.BulkOperationSpec
+type BulkOperationStatus = v1alpha1.BulkOperationStatus
+type BulkOperationAction = v1alpha1.BulkOperationAction
+type BulkOperationDeviceResult = v1alpha1.BulkOperationDeviceResult
+
+type BulkOperationActionType = v1alpha1.BulkOperationActionType
+type BulkOperationPhase = v1alpha1.BulkOperationPhase
+type BulkOperationDeviceResultType = v1alpha1.BulkOperationDeviceResultType
+
+const (
+	BulkOperationActionPatchLabels  = v1alpha1.BulkOperationActionPatchLabels
+	BulkOperationActionDecommission = v1alpha1.BulkOperationActionDecommission
+	BulkOperationActionResume       = v1alpha1.BulkOperationActionResume
+	BulkOperationActionRerender     = v1alpha1.BulkOperationActionRerender
+
+	BulkOperationPhasePending   = v1alpha1.BulkOperationPhasePending
+	BulkOperationPhaseRunning   = v1alpha1.BulkOperationPhaseRunning
+	BulkOperationPhaseCompleted = v1alpha1.BulkOperationPhaseCompleted
+	BulkOperationPhaseFailed    = v1alpha1.BulkOperationPhaseFailed
+	BulkOperationPhaseCanceled  = v1alpha1.BulkOperationPhaseCanceled
+
+	BulkOperationDeviceSucceeded = v1alpha1.BulkOperationDeviceSucceeded
+	BulkOperationDeviceFailed    = v1alpha1.BulkOperationDeviceFailed
+	BulkOperationDeviceSkipped   = v1alpha1.BulkOperationDeviceSkipped
+)
+
+type ListBulkOperationsParams = v1alpha1.ListBulkOperationsParams
@@ -9,6 +9,7 @@ type Catalog = v1alpha1.Catalog
 type CatalogList = v1alpha1.CatalogList
 type CatalogSpec = v1alpha1.CatalogSpec
 type CatalogStatus = v1alpha1.CatalogStatus
+type CatalogSource = v1alpha1.CatalogSource
 
 type CatalogItem = v1alpha1.CatalogItem
 type CatalogItemMeta = v1alpha1.CatalogItemMeta
@@ -19,6 +20,8 @@ type CatalogItemConfigurable = v1alpha1.CatalogItemConfigurable
 type CatalogItemReference = v1alpha1.CatalogItemReference
 type CatalogItemArtifact = v1alpha1.CatalogItemArtifact
 type CatalogItemDeprecation = v1alpha1.CatalogItemDeprecation
+type CatalogItemInstallRequest = v1alpha1.CatalogItemInstallRequest
+type CatalogItemInstallation = v1alpha1.CatalogItemInstallation
 
 type CatalogItemCategory = v1alpha1.CatalogItemCategory
 type CatalogItemVisibility = v1alpha1.CatalogItemVisibility
@@ -8,3 +8,46 @@ type CertificateSigningRequest = v1beta1.CertificateSigningRequest
 type CertificateSigningRequestList = v1beta1.CertificateSigningRequestList
 type CertificateSigningRequestSpec = v1beta1.CertificateSigningRequestSpec
 type CertificateSigningRequestStatus = v1beta1.CertificateSigningRequestStatus
+
+// ========== Certificate Revocation ==========
+
+type CertificateRevocationRequest = v1beta1.CertificateRevocationRequest
+type CertificateRevocationResponse = v1beta1.CertificateRevocationResponse
+type CertificateRevocationReason = v1beta1.CertificateRevocationReason
+type RevokedCertificate = v1beta1.RevokedCertificate
+
+const (
+	CertificateRevocationReasonUnspecified          = v1beta1.CertificateRevocationReasonUnspecified
+	CertificateRevocationReasonKeyCompromise        = v1beta1.CertificateRevocationReasonKeyCompromise
+	CertificateRevocationReasonSuperseded           = v1beta1.CertificateRevocationReasonSuperseded
+	CertificateRevocationReasonCessationOfOperation = v1beta1.CertificateRevocationReasonCessationOfOperation
+)
+
+// ========== CA Rotation ==========
+
+type CARotationStatus = v1beta1.CARotationStatus
+type CARotationPhase = v1beta1.CARotationPhase
+type CARotationDeviceSummary = v1beta1.CARotationDeviceSummary
+type CACertificateInfo = v1beta1.CACertificateInfo
+type CARotationDeviceList = v1beta1.CARotationDeviceList
+type CARotationDeviceProgress = v1beta1.CARotationDeviceProgress
+type CARotationDeviceTrust = v1beta1.CARotationDeviceTrust
+type CARotationDeviceIssuer = v1beta1.CARotationDeviceIssuer
+
+const (
+	CARotationPhaseNone       = v1beta1.CARotationPhaseNone
+	CARotationPhasePublishing = v1beta1.CARotationPhasePublishing
+	CARotationPhaseSwitched   = v1beta1.CARotationPhaseSwitched
+	CARotationPhaseRetired    = v1beta1.CARotationPhaseRetired
+)
+
+const (
+	CARotationDeviceTrustYes     = v1beta1.CARotationDeviceTrustYes
+	CARotationDeviceTrustNo      = v1beta1.CARotationDeviceTrustNo
+	CARotationDeviceTrustUnknown = v1beta1.CARotationDeviceTrustUnknown
+
+	CARotationDeviceIssuerNew      = v1beta1.CARotationDeviceIssuerNew
+	CARotationDeviceIssuerPrevious = v1beta1.CARotationDeviceIssuerPrevious
+	CARotationDeviceIssuerOther    = v1beta1.CARotationDeviceIssuerOther
+	CARotationDeviceIssuerUnknown  = v1beta1.CARotationDeviceIssuerUnknown
+)
@@ -27,6 +27,10 @@ const (
 	ConditionTypeCertificateSigningRequestDenied      = v1beta1.ConditionTypeCertificateSigningRequestDenied
 	ConditionTypeCertificateSigningRequestFailed      = v1beta1.ConditionTypeCertificateSigningRequestFailed
 	ConditionTypeCertificateSigningRequestTPMVerified = v1beta1.ConditionTypeCertificateSigningRequestTPMVerified
+	ConditionTypeCatalogAccessible                    = v1beta1.ConditionTypeCatalogAccessible
+	ConditionTypeCatalogSynced                        = v1beta1.ConditionTypeCatalogSynced
+	ConditionTypeDeviceCatalogItemDeprecated          = v1beta1.ConditionTypeDeviceCatalogItemDeprecated
+	ConditionTypeDeviceConfigDrifted                  = v1beta1.ConditionTypeDeviceConfigDrifted
 	ConditionTypeDeviceDecommissioning                = v1beta1.ConditionTypeDeviceDecommissioning
 	ConditionTypeDeviceMultipleOwners                 = v1beta1.ConditionTypeDeviceMultipleOwners
 	ConditionTypeDeviceSpecValid                      = v1beta1.ConditionTypeDeviceSpecValid
@@ -9,6 +9,7 @@ type GitConfigProviderSpec = v1beta1.GitConfigProviderSpec
 type HttpConfigProviderSpec = v1beta1.HttpConfigProviderSpec
 type InlineConfigProviderSpec = v1beta1.InlineConfigProviderSpec
 type KubernetesSecretProviderSpec = v1beta1.KubernetesSecretProviderSpec
+type OciConfigProviderSpec = v1beta1.OciConfigProviderSpec
 
 // ConfigProviderType discriminator type
 type ConfigProviderType = v1beta1.ConfigProviderType
@@ -18,6 +19,7 @@ const (
 	HttpConfigProviderType       = v1beta1.HttpConfigProviderType
 	InlineConfigProviderType     = v1beta1.InlineConfigProviderType
 	KubernetesSecretProviderType = v1beta1.KubernetesSecretProviderType
+	OciConfigProviderType        = v1beta1.OciConfigProviderType
 )
 
 // ========== File Types ==========
@@ -49,6 +49,11 @@ const (
 	EnrollmentRequestListKind   = v1beta1.EnrollmentRequestListKind
 )
 
+// EnrollmentRequest annotation keys
+const (
+	EnrollmentRequestAnnotationEnrollmentCredential = v1beta1.EnrollmentRequestAnnotationEnrollmentCredential
+)
+
 // ========== Fleet ==========
 
 const (
@@ -66,6 +71,9 @@ const (
 	FleetAnnotationRolloutApprovalMethod       = v1beta1.FleetAnnotationRolloutApprovalMethod
 	FleetAnnotationLastBatchCompletionReport   = v1beta1.FleetAnnotationLastBatchCompletionReport
 	FleetAnnotationDeviceSelectionConfigDigest = v1beta1.FleetAnnotationDeviceSelectionConfigDigest
+	FleetAnnotationPreviousTemplateVersion     = v1beta1.FleetAnnotationPreviousTemplateVersion
+	FleetAnnotationRollbackTemplateVersion     = v1beta1.FleetAnnotationRollbackTemplateVersion
+	FleetAnnotationCanarySoakStartTime         = v1beta1.FleetAnnotationCanarySoakStartTime
 )
 
 // ========== Event ==========
@@ -118,6 +126,42 @@ const (
 	CatalogListKind     = v1alpha1.CatalogListKind
 	CatalogItemKind     = v1alpha1.CatalogItemKind
 	CatalogItemListKind = v1alpha1.CatalogItemListKind
+
+	CatalogAnnotationInstalledItems = v1alpha1.CatalogAnnotationInstalledItems
+)
+
+// ========== BulkOperation ==========
+
+const (
+	BulkOperationAPIVersion = v1alpha1.BulkOperationAPIVersion
+	BulkOperationKind       = v1alpha1.BulkOperationKind
+	BulkOperationListKind   = v1alpha1.BulkOperationListKind
+
+	BulkOperationAnnotationScopeLabelSelectors = v1alpha1.BulkOperationAnnotationScopeLabelSelectors
+)
+
+// ========== EnrollmentApprovalPolicy ==========
+
+const (
+	EnrollmentApprovalPolicyAPIVersion = v1alpha1.EnrollmentApprovalPolicyAPIVersion
+	EnrollmentApprovalPolicyKind       = v1alpha1.EnrollmentApprovalPolicyKind
+	EnrollmentApprovalPolicyListKind   = v1alpha1.EnrollmentApprovalPolicyListKind
+)
+
+// ========== Role ==========
+
+const (
+	RoleAPIVersion = v1alpha1.RoleAPIVersion
+	RoleKind       = v1alpha1.RoleKind
+	RoleListKind   = v1alpha1.RoleListKind
+)
+
+// ========== RoleBinding ==========
+
+const (
+	RoleBindingAPIVersion = v1alpha1.RoleBindingAPIVersion
+	RoleBindingKind       = v1alpha1.RoleBindingKind
+	RoleBindingListKind   = v1alpha1.RoleBindingListKind
 )
 
 // ========== TemplateVersion ==========
@@ -179,6 +223,7 @@ const (
 	UpdateStateError          = v1beta1.UpdateStateError
 	UpdateStateRollingBack    = v1beta1.UpdateStateRollingBack
 	UpdateStateRetrying       = v1beta1.UpdateStateRetrying
+	UpdateStateRolledBack     = v1beta1.UpdateStateRolledBack
 )
 
 // ========== Decommission State ==========
@@ -194,10 +239,11 @@ const (
 // ========== Rollout Reasons ==========
 
 const (
-	RolloutInactiveReason  = v1beta1.RolloutInactiveReason
-	RolloutActiveReason    = v1beta1.RolloutActiveReason
-	RolloutSuspendedReason = v1beta1.RolloutSuspendedReason
-	RolloutWaitingReason   = v1beta1.RolloutWaitingReason
+	RolloutInactiveReason   = v1beta1.RolloutInactiveReason
+	RolloutActiveReason     = v1beta1.RolloutActiveReason
+	RolloutSuspendedReason  = v1beta1.RolloutSuspendedReason
+	RolloutWaitingReason    = v1beta1.RolloutWaitingReason
+	RolloutRolledBackReason = v1beta1.RolloutRolledBackReason
 )
 
 // ========== Batch Names ==========
@@ -205,6 +251,7 @@ const (
 const (
 	PreliminaryBatchName   = v1beta1.PreliminaryBatchName
 	FinalImplicitBatchName = v1beta1.FinalImplicitBatchName
+	CanaryBatchName        = v1beta1.CanaryBatchName
 )
 
 // ========== System Resource Names ==========
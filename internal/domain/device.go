@@ -33,6 +33,8 @@ type CustomDeviceInfo = v1beta1.CustomDeviceInfo
 
 type DeviceOsSpec = v1beta1.DeviceOsSpec
 type DeviceUpdatePolicySpec = v1beta1.DeviceUpdatePolicySpec
+type ConfigDriftPolicy = v1beta1.ConfigDriftPolicy
+type ConfigDriftAction = v1beta1.ConfigDriftAction
 
 // ========== Operations ==========
 
@@ -45,6 +47,8 @@ type DeviceResumeResponse = v1beta1.DeviceResumeResponse
 
 type DevicesSummary = v1beta1.DevicesSummary
 type DeviceCompletionCount = v1beta1.DeviceCompletionCount
+type DeviceAggregate = v1beta1.DeviceAggregate
+type DeviceGroupCount = v1beta1.DeviceGroupCount
 
 // ========== Enum Types ==========
 
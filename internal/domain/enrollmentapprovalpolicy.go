@@ -0,0 +1,13 @@
+package domain
+
+import v1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+
+// EnrollmentApprovalPolicy domain types use v1alpha1 as the internal representation.
+// EnrollmentApprovalPolicy resources are only available in v1alpha1 (alpha-stage feature).
+
+type EnrollmentApprovalPolicy = v1alpha1.EnrollmentApprovalPolicy
+type EnrollmentApprovalPolicyList = v1alpha1.EnrollmentApprovalPolicyList
+type EnrollmentApprovalPolicySpec = v1alpha1.EnrollmentApprovalPolicySpec
+type EnrollmentApprovalPolicyMatch = v1alpha1.EnrollmentApprovalPolicyMatch
+
+type ListEnrollmentApprovalPoliciesParams = v1alpha1.ListEnrollmentApprovalPoliciesParams
@@ -34,63 +34,73 @@ const (
 
 // Event reason constants
 const (
-	EventReasonDeviceApplicationDegraded       = v1beta1.EventReasonDeviceApplicationDegraded
-	EventReasonDeviceApplicationError          = v1beta1.EventReasonDeviceApplicationError
-	EventReasonDeviceApplicationHealthy        = v1beta1.EventReasonDeviceApplicationHealthy
-	EventReasonDeviceCPUCritical               = v1beta1.EventReasonDeviceCPUCritical
-	EventReasonDeviceCPUNormal                 = v1beta1.EventReasonDeviceCPUNormal
-	EventReasonDeviceCPUWarning                = v1beta1.EventReasonDeviceCPUWarning
-	EventReasonDeviceConflictPaused            = v1beta1.EventReasonDeviceConflictPaused
-	EventReasonDeviceConflictResolved          = v1beta1.EventReasonDeviceConflictResolved
-	EventReasonDeviceConnected                 = v1beta1.EventReasonDeviceConnected
-	EventReasonDeviceContentOutOfDate          = v1beta1.EventReasonDeviceContentOutOfDate
-	EventReasonDeviceContentUpToDate           = v1beta1.EventReasonDeviceContentUpToDate
-	EventReasonDeviceContentUpdating           = v1beta1.EventReasonDeviceContentUpdating
-	EventReasonDeviceDecommissionFailed        = v1beta1.EventReasonDeviceDecommissionFailed
-	EventReasonDeviceDecommissioned            = v1beta1.EventReasonDeviceDecommissioned
-	EventReasonDeviceDisconnected              = v1beta1.EventReasonDeviceDisconnected
-	EventReasonDeviceDiskCritical              = v1beta1.EventReasonDeviceDiskCritical
-	EventReasonDeviceDiskNormal                = v1beta1.EventReasonDeviceDiskNormal
-	EventReasonDeviceDiskWarning               = v1beta1.EventReasonDeviceDiskWarning
-	EventReasonDeviceIsRebooting               = v1beta1.EventReasonDeviceIsRebooting
-	EventReasonDeviceMemoryCritical            = v1beta1.EventReasonDeviceMemoryCritical
-	EventReasonDeviceMemoryNormal              = v1beta1.EventReasonDeviceMemoryNormal
-	EventReasonDeviceMemoryWarning             = v1beta1.EventReasonDeviceMemoryWarning
-	EventReasonDeviceMultipleOwnersDetected    = v1beta1.EventReasonDeviceMultipleOwnersDetected
-	EventReasonDeviceMultipleOwnersResolved    = v1beta1.EventReasonDeviceMultipleOwnersResolved
-	EventReasonDeviceSpecInvalid               = v1beta1.EventReasonDeviceSpecInvalid
-	EventReasonDeviceSpecValid                 = v1beta1.EventReasonDeviceSpecValid
-	EventReasonDeviceUpdateFailed              = v1beta1.EventReasonDeviceUpdateFailed
-	EventReasonEnrollmentRequestApprovalFailed = v1beta1.EventReasonEnrollmentRequestApprovalFailed
-	EventReasonEnrollmentRequestApproved       = v1beta1.EventReasonEnrollmentRequestApproved
-	EventReasonFleetInvalid                    = v1beta1.EventReasonFleetInvalid
-	EventReasonFleetRolloutBatchCompleted      = v1beta1.EventReasonFleetRolloutBatchCompleted
-	EventReasonFleetRolloutBatchDispatched     = v1beta1.EventReasonFleetRolloutBatchDispatched
-	EventReasonFleetRolloutCompleted           = v1beta1.EventReasonFleetRolloutCompleted
-	EventReasonFleetRolloutCreated             = v1beta1.EventReasonFleetRolloutCreated
-	EventReasonFleetRolloutDeviceSelected      = v1beta1.EventReasonFleetRolloutDeviceSelected
-	EventReasonFleetRolloutFailed              = v1beta1.EventReasonFleetRolloutFailed
-	EventReasonFleetRolloutStarted             = v1beta1.EventReasonFleetRolloutStarted
-	EventReasonFleetValid                      = v1beta1.EventReasonFleetValid
-	EventReasonInternalTaskFailed              = v1beta1.EventReasonInternalTaskFailed
-	EventReasonInternalTaskPermanentlyFailed   = v1beta1.EventReasonInternalTaskPermanentlyFailed
-	EventReasonReferencedRepositoryUpdated     = v1beta1.EventReasonReferencedRepositoryUpdated
-	EventReasonRepositoryAccessible            = v1beta1.EventReasonRepositoryAccessible
-	EventReasonRepositoryInaccessible          = v1beta1.EventReasonRepositoryInaccessible
-	EventReasonResourceCreated                 = v1beta1.EventReasonResourceCreated
-	EventReasonResourceCreationFailed          = v1beta1.EventReasonResourceCreationFailed
-	EventReasonResourceDeleted                 = v1beta1.EventReasonResourceDeleted
-	EventReasonResourceDeletionFailed          = v1beta1.EventReasonResourceDeletionFailed
-	EventReasonResourceSyncAccessible          = v1beta1.EventReasonResourceSyncAccessible
-	EventReasonResourceSyncCommitDetected      = v1beta1.EventReasonResourceSyncCommitDetected
-	EventReasonResourceSyncInaccessible        = v1beta1.EventReasonResourceSyncInaccessible
-	EventReasonResourceSyncParsed              = v1beta1.EventReasonResourceSyncParsed
-	EventReasonResourceSyncParsingFailed       = v1beta1.EventReasonResourceSyncParsingFailed
-	EventReasonResourceSyncSyncFailed          = v1beta1.EventReasonResourceSyncSyncFailed
-	EventReasonResourceSyncSynced              = v1beta1.EventReasonResourceSyncSynced
-	EventReasonResourceUpdateFailed            = v1beta1.EventReasonResourceUpdateFailed
-	EventReasonResourceUpdated                 = v1beta1.EventReasonResourceUpdated
-	EventReasonSystemRestored                  = v1beta1.EventReasonSystemRestored
+	EventReasonBulkOperationCompleted               = v1beta1.EventReasonBulkOperationCompleted
+	EventReasonBulkOperationFailed                  = v1beta1.EventReasonBulkOperationFailed
+	EventReasonBulkOperationProgressed              = v1beta1.EventReasonBulkOperationProgressed
+	EventReasonDeviceApplicationDegraded            = v1beta1.EventReasonDeviceApplicationDegraded
+	EventReasonDeviceApplicationError               = v1beta1.EventReasonDeviceApplicationError
+	EventReasonDeviceApplicationHealthy             = v1beta1.EventReasonDeviceApplicationHealthy
+	EventReasonDeviceCPUCritical                    = v1beta1.EventReasonDeviceCPUCritical
+	EventReasonDeviceCPUNormal                      = v1beta1.EventReasonDeviceCPUNormal
+	EventReasonDeviceCPUWarning                     = v1beta1.EventReasonDeviceCPUWarning
+	EventReasonDeviceConflictPaused                 = v1beta1.EventReasonDeviceConflictPaused
+	EventReasonDeviceConflictResolved               = v1beta1.EventReasonDeviceConflictResolved
+	EventReasonDeviceConnected                      = v1beta1.EventReasonDeviceConnected
+	EventReasonDeviceContentOutOfDate               = v1beta1.EventReasonDeviceContentOutOfDate
+	EventReasonDeviceContentUpToDate                = v1beta1.EventReasonDeviceContentUpToDate
+	EventReasonDeviceConfigDriftResolved            = v1beta1.EventReasonDeviceConfigDriftResolved
+	EventReasonDeviceConfigDrifted                  = v1beta1.EventReasonDeviceConfigDrifted
+	EventReasonDeviceContentUpdating                = v1beta1.EventReasonDeviceContentUpdating
+	EventReasonDeviceDecommissionFailed             = v1beta1.EventReasonDeviceDecommissionFailed
+	EventReasonDeviceDecommissioned                 = v1beta1.EventReasonDeviceDecommissioned
+	EventReasonDeviceDisconnected                   = v1beta1.EventReasonDeviceDisconnected
+	EventReasonDeviceDiskCritical                   = v1beta1.EventReasonDeviceDiskCritical
+	EventReasonDeviceDiskNormal                     = v1beta1.EventReasonDeviceDiskNormal
+	EventReasonDeviceDiskWarning                    = v1beta1.EventReasonDeviceDiskWarning
+	EventReasonDeviceIsRebooting                    = v1beta1.EventReasonDeviceIsRebooting
+	EventReasonDeviceMemoryCritical                 = v1beta1.EventReasonDeviceMemoryCritical
+	EventReasonDeviceMemoryNormal                   = v1beta1.EventReasonDeviceMemoryNormal
+	EventReasonDeviceMemoryWarning                  = v1beta1.EventReasonDeviceMemoryWarning
+	EventReasonDeviceMultipleOwnersDetected         = v1beta1.EventReasonDeviceMultipleOwnersDetected
+	EventReasonDeviceMultipleOwnersResolved         = v1beta1.EventReasonDeviceMultipleOwnersResolved
+	EventReasonDeviceSpecInvalid                    = v1beta1.EventReasonDeviceSpecInvalid
+	EventReasonDeviceSpecValid                      = v1beta1.EventReasonDeviceSpecValid
+	EventReasonDeviceUpdateFailed                   = v1beta1.EventReasonDeviceUpdateFailed
+	EventReasonDeviceUpdateRolledBack               = v1beta1.EventReasonDeviceUpdateRolledBack
+	EventReasonEnrollmentRequestApprovalFailed      = v1beta1.EventReasonEnrollmentRequestApprovalFailed
+	EventReasonEnrollmentRequestApproved            = v1beta1.EventReasonEnrollmentRequestApproved
+	EventReasonEnrollmentRequestAutoApprovalFailed  = v1beta1.EventReasonEnrollmentRequestAutoApprovalFailed
+	EventReasonEnrollmentRequestAutoApprovalSkipped = v1beta1.EventReasonEnrollmentRequestAutoApprovalSkipped
+	EventReasonEnrollmentRequestAutoApproved        = v1beta1.EventReasonEnrollmentRequestAutoApproved
+	EventReasonFleetInvalid                         = v1beta1.EventReasonFleetInvalid
+	EventReasonFleetRolledBack                      = v1beta1.EventReasonFleetRolledBack
+	EventReasonFleetRolloutBatchCompleted           = v1beta1.EventReasonFleetRolloutBatchCompleted
+	EventReasonFleetRolloutBatchDispatched          = v1beta1.EventReasonFleetRolloutBatchDispatched
+	EventReasonFleetRolloutCompleted                = v1beta1.EventReasonFleetRolloutCompleted
+	EventReasonFleetRolloutCreated                  = v1beta1.EventReasonFleetRolloutCreated
+	EventReasonFleetRolloutDeviceSelected           = v1beta1.EventReasonFleetRolloutDeviceSelected
+	EventReasonFleetRolloutFailed                   = v1beta1.EventReasonFleetRolloutFailed
+	EventReasonFleetRolloutStarted                  = v1beta1.EventReasonFleetRolloutStarted
+	EventReasonFleetValid                           = v1beta1.EventReasonFleetValid
+	EventReasonInternalTaskFailed                   = v1beta1.EventReasonInternalTaskFailed
+	EventReasonInternalTaskPermanentlyFailed        = v1beta1.EventReasonInternalTaskPermanentlyFailed
+	EventReasonReferencedRepositoryUpdated          = v1beta1.EventReasonReferencedRepositoryUpdated
+	EventReasonRepositoryAccessible                 = v1beta1.EventReasonRepositoryAccessible
+	EventReasonRepositoryInaccessible               = v1beta1.EventReasonRepositoryInaccessible
+	EventReasonResourceCreated                      = v1beta1.EventReasonResourceCreated
+	EventReasonResourceCreationFailed               = v1beta1.EventReasonResourceCreationFailed
+	EventReasonResourceDeleted                      = v1beta1.EventReasonResourceDeleted
+	EventReasonResourceDeletionFailed               = v1beta1.EventReasonResourceDeletionFailed
+	EventReasonResourceSyncAccessible               = v1beta1.EventReasonResourceSyncAccessible
+	EventReasonResourceSyncCommitDetected           = v1beta1.EventReasonResourceSyncCommitDetected
+	EventReasonResourceSyncInaccessible             = v1beta1.EventReasonResourceSyncInaccessible
+	EventReasonResourceSyncParsed                   = v1beta1.EventReasonResourceSyncParsed
+	EventReasonResourceSyncParsingFailed            = v1beta1.EventReasonResourceSyncParsingFailed
+	EventReasonResourceSyncSyncFailed               = v1beta1.EventReasonResourceSyncSyncFailed
+	EventReasonResourceSyncSynced                   = v1beta1.EventReasonResourceSyncSynced
+	EventReasonResourceUpdateFailed                 = v1beta1.EventReasonResourceUpdateFailed
+	EventReasonResourceUpdated                      = v1beta1.EventReasonResourceUpdated
+	EventReasonSystemRestored                       = v1beta1.EventReasonSystemRestored
 )
 
 // ========== Event Details Types ==========
@@ -130,31 +140,36 @@ const (
 
 // warningReasons contains all event reasons that should result in Warning events
 var warningReasons = map[EventReason]struct{}{
-	EventReasonResourceCreationFailed:          {},
-	EventReasonResourceUpdateFailed:            {},
-	EventReasonResourceDeletionFailed:          {},
-	EventReasonDeviceDecommissionFailed:        {},
-	EventReasonEnrollmentRequestApprovalFailed: {},
-	EventReasonDeviceApplicationDegraded:       {},
-	EventReasonDeviceApplicationError:          {},
-	EventReasonDeviceCPUCritical:               {},
-	EventReasonDeviceCPUWarning:                {},
-	EventReasonDeviceMemoryCritical:            {},
-	EventReasonDeviceMemoryWarning:             {},
-	EventReasonDeviceDiskCritical:              {},
-	EventReasonDeviceDiskWarning:               {},
-	EventReasonDeviceDisconnected:              {},
-	EventReasonDeviceConflictPaused:            {},
-	EventReasonDeviceSpecInvalid:               {},
-	EventReasonFleetInvalid:                    {},
-	EventReasonDeviceMultipleOwnersDetected:    {},
-	EventReasonDeviceUpdateFailed:              {},
-	EventReasonInternalTaskFailed:              {},
-	EventReasonInternalTaskPermanentlyFailed:   {},
-	EventReasonResourceSyncInaccessible:        {},
-	EventReasonResourceSyncParsingFailed:       {},
-	EventReasonResourceSyncSyncFailed:          {},
-	EventReasonFleetRolloutFailed:              {},
+	EventReasonResourceCreationFailed:              {},
+	EventReasonResourceUpdateFailed:                {},
+	EventReasonResourceDeletionFailed:              {},
+	EventReasonDeviceDecommissionFailed:            {},
+	EventReasonEnrollmentRequestApprovalFailed:     {},
+	EventReasonEnrollmentRequestAutoApprovalFailed: {},
+	EventReasonDeviceApplicationDegraded:           {},
+	EventReasonDeviceApplicationError:              {},
+	EventReasonDeviceCPUCritical:                   {},
+	EventReasonDeviceCPUWarning:                    {},
+	EventReasonDeviceMemoryCritical:                {},
+	EventReasonDeviceMemoryWarning:                 {},
+	EventReasonDeviceDiskCritical:                  {},
+	EventReasonDeviceDiskWarning:                   {},
+	EventReasonDeviceDisconnected:                  {},
+	EventReasonDeviceConflictPaused:                {},
+	EventReasonDeviceSpecInvalid:                   {},
+	EventReasonFleetInvalid:                        {},
+	EventReasonDeviceMultipleOwnersDetected:        {},
+	EventReasonDeviceUpdateFailed:                  {},
+	EventReasonDeviceUpdateRolledBack:              {},
+	EventReasonDeviceConfigDrifted:                 {},
+	EventReasonInternalTaskFailed:                  {},
+	EventReasonInternalTaskPermanentlyFailed:       {},
+	EventReasonResourceSyncInaccessible:            {},
+	EventReasonResourceSyncParsingFailed:           {},
+	EventReasonResourceSyncSyncFailed:              {},
+	EventReasonFleetRolloutFailed:                  {},
+	EventReasonFleetRolledBack:                     {},
+	EventReasonBulkOperationFailed:                 {},
 }
 
 // GetEventType determines the event type based on the event reason
@@ -12,11 +12,15 @@ type FleetStatus = v1beta1.FleetStatus
 // ========== Rollout Types ==========
 
 type RolloutPolicy = v1beta1.RolloutPolicy
+type MaintenanceWindow = v1beta1.MaintenanceWindow
 type RolloutDeviceSelection = v1beta1.RolloutDeviceSelection
 type RolloutStrategy = v1beta1.RolloutStrategy
 type FleetRolloutStatus = v1beta1.FleetRolloutStatus
+type FleetRollbackStatus = v1beta1.FleetRollbackStatus
+type FleetRollbackRequest = v1beta1.FleetRollbackRequest
 type Batch = v1beta1.Batch
 type BatchSequence = v1beta1.BatchSequence
+type Canary = v1beta1.Canary
 type Batch_Limit = v1beta1.Batch_Limit
 type BatchLimit1 = v1beta1.BatchLimit1
 type DisruptionBudget = v1beta1.DisruptionBudget
@@ -25,10 +29,13 @@ type DisruptionBudget = v1beta1.DisruptionBudget
 
 const (
 	RolloutStrategyBatchSequence = v1beta1.RolloutStrategyBatchSequence
+	RolloutStrategyCanary        = v1beta1.RolloutStrategyCanary
 )
 
 // ========== Fleet Event Details Types ==========
 
+type FleetRolledBackDetails = v1beta1.FleetRolledBackDetails
+type FleetRolledBackDetailsDetailType = v1beta1.FleetRolledBackDetailsDetailType
 type FleetRolloutBatchCompletedDetails = v1beta1.FleetRolloutBatchCompletedDetails
 type FleetRolloutBatchCompletedDetailsDetailType = v1beta1.FleetRolloutBatchCompletedDetailsDetailType
 type FleetRolloutBatchDispatchedDetails = v1beta1.FleetRolloutBatchDispatchedDetails
@@ -44,6 +51,7 @@ type FleetRolloutStartedDetailsDetailType = v1beta1.FleetRolloutStartedDetailsDe
 type FleetRolloutStartedDetailsRolloutStrategy = v1beta1.FleetRolloutStartedDetailsRolloutStrategy
 
 const (
+	FleetRolledBack             = v1beta1.FleetRolledBack
 	FleetRolloutBatchCompleted  = v1beta1.FleetRolloutBatchCompleted
 	FleetRolloutBatchDispatched = v1beta1.FleetRolloutBatchDispatched
 	FleetRolloutCompleted       = v1beta1.FleetRolloutCompleted
@@ -18,10 +18,19 @@ type ListTemplateVersionsParams = v1beta1.ListTemplateVersionsParams
 
 // ========== Get Params ==========
 
+type GetCertificateRevocationListParams = v1beta1.GetCertificateRevocationListParams
 type GetEnrollmentConfigParams = v1beta1.GetEnrollmentConfigParams
 type GetFleetParams = v1beta1.GetFleetParams
 type GetRenderedDeviceParams = v1beta1.GetRenderedDeviceParams
 
+// ========== Aggregate Params ==========
+
+type AggregateDevicesParams = v1beta1.AggregateDevicesParams
+
+// ========== CA Rotation Params ==========
+
+type ListCARotationDevicesParams = v1beta1.ListCARotationDevicesParams
+
 // ========== Order Types ==========
 
 type ListEventsParamsOrder = v1beta1.ListEventsParamsOrder
@@ -0,0 +1,13 @@
+package domain
+
+import v1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+
+// Role domain types use v1alpha1 as the internal representation.
+// Role resources are only available in v1alpha1 (alpha-stage feature).
+
+type Role = v1alpha1.Role
+type RoleList = v1alpha1.RoleList
+type RoleSpec = v1alpha1.RoleSpec
+type PolicyRule = v1alpha1.PolicyRule
+
+type ListRolesParams = v1alpha1.ListRolesParams
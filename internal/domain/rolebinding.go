@@ -0,0 +1,20 @@
+package domain
+
+import v1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+
+// RoleBinding domain types use v1alpha1 as the internal representation.
+// RoleBinding resources are only available in v1alpha1 (alpha-stage feature).
+
+type RoleBinding = v1alpha1.RoleBinding
+type RoleBindingList = v1alpha1.RoleBindingList
+type RoleBindingSpec = v1alpha1.RoleBindingSpec
+type RoleBindingSubject = v1alpha1.RoleBindingSubject
+
+type RoleBindingSubjectKind = v1alpha1.RoleBindingSubjectKind
+
+const (
+	RoleBindingSubjectKindUser  = v1alpha1.RoleBindingSubjectKindUser
+	RoleBindingSubjectKindGroup = v1alpha1.RoleBindingSubjectKindGroup
+)
+
+type ListRoleBindingsParams = v1alpha1.ListRoleBindingsParams
@@ -19,6 +19,7 @@ var (
 	StatusInternalServerError     = v1beta1.StatusInternalServerError
 	StatusNotImplemented          = v1beta1.StatusNotImplemented
 	StatusTooManyRequests         = v1beta1.StatusTooManyRequests
+	StatusServiceUnavailable      = v1beta1.StatusServiceUnavailable
 	StatusAuthNotConfigured       = v1beta1.StatusAuthNotConfigured
 )
 
@@ -41,7 +41,12 @@ func (m *mockStore) Event() store.Event
 func (m *mockStore) Checkpoint() store.Checkpoint                               { return nil }
 func (m *mockStore) Organization() store.Organization                           { return nil }
 func (m *mockStore) AuthProvider() store.AuthProvider                           { return nil }
+func (m *mockStore) RevokedCertificate() store.RevokedCertificate               { return nil }
 func (m *mockStore) Catalog() store.Catalog                                     { return nil }
+func (m *mockStore) BulkOperation() store.BulkOperation                         { return nil }
+func (m *mockStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy   { return nil }
+func (m *mockStore) Role() store.Role                                           { return nil }
+func (m *mockStore) RoleBinding() store.RoleBinding                             { return nil }
 func (m *mockStore) RunMigrations(context.Context) error                        { return nil }
 func (m *mockStore) CheckHealth(context.Context) error                          { return nil }
 func (m *mockStore) Close() error                                               { return nil }
@@ -65,10 +65,30 @@ func (m *MockStore) AuthProvider() store.AuthProvider {
 	return nil
 }
 
+func (m *MockStore) RevokedCertificate() store.RevokedCertificate {
+	return nil
+}
+
 func (m *MockStore) Catalog() store.Catalog {
 	return nil
 }
 
+func (m *MockStore) BulkOperation() store.BulkOperation {
+	return nil
+}
+
+func (m *MockStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy {
+	return nil
+}
+
+func (m *MockStore) Role() store.Role {
+	return nil
+}
+
+func (m *MockStore) RoleBinding() store.RoleBinding {
+	return nil
+}
+
 func (m *MockStore) RunMigrations(context.Context) error {
 	return nil
 }
@@ -165,6 +185,9 @@ func (m *MockDevice) MarkRolloutSelection(ctx context.Context, orgId uuid.UUID,
 func (m *MockDevice) CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, error) {
 	return nil, nil
 }
+func (m *MockDevice) Aggregate(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, groupBy []string) (*domain.DeviceAggregate, error) {
+	return nil, nil
+}
 func (m *MockDevice) CountByLabels(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, groupBy []string) ([]map[string]any, error) {
 	return nil, nil
 }
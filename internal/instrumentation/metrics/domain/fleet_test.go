@@ -102,6 +102,10 @@ func (m *MockFleetStore) UpdateAnnotations(ctx context.Context, orgId uuid.UUID,
 	return nil
 }
 
+func (m *MockFleetStore) UpdateAnnotationsAndStatus(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string, updateStatus func(*domain.FleetStatus), callbackEvent store.EventCallback) (*domain.Fleet, error) {
+	return nil, nil
+}
+
 func (m *MockFleetStore) OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) error {
 	return nil
 }
@@ -166,10 +170,30 @@ func (m *MockFleetStoreWrapper) AuthProvider() store.AuthProvider {
 	return nil
 }
 
+func (m *MockFleetStoreWrapper) RevokedCertificate() store.RevokedCertificate {
+	return nil
+}
+
 func (m *MockFleetStoreWrapper) Catalog() store.Catalog {
 	return nil
 }
 
+func (m *MockFleetStoreWrapper) BulkOperation() store.BulkOperation {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) Role() store.Role {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) RoleBinding() store.RoleBinding {
+	return nil
+}
+
 func (m *MockFleetStoreWrapper) RunMigrations(context.Context) error {
 	return nil
 }
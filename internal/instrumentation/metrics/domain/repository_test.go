@@ -36,7 +36,12 @@ func (m *MockRepositoryStore) Event() store.Event
 func (m *MockRepositoryStore) Checkpoint() store.Checkpoint                               { return nil }
 func (m *MockRepositoryStore) Organization() store.Organization                           { return nil }
 func (m *MockRepositoryStore) AuthProvider() store.AuthProvider                           { return nil }
+func (m *MockRepositoryStore) RevokedCertificate() store.RevokedCertificate               { return nil }
 func (m *MockRepositoryStore) Catalog() store.Catalog                                     { return nil }
+func (m *MockRepositoryStore) BulkOperation() store.BulkOperation                         { return nil }
+func (m *MockRepositoryStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy   { return nil }
+func (m *MockRepositoryStore) Role() store.Role                                           { return nil }
+func (m *MockRepositoryStore) RoleBinding() store.RoleBinding                             { return nil }
 func (m *MockRepositoryStore) RunMigrations(context.Context) error                        { return nil }
 func (m *MockRepositoryStore) Close() error                                               { return nil }
 func (m *MockRepositoryStore) CheckHealth(context.Context) error                          { return nil }
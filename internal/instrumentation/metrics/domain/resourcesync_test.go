@@ -31,14 +31,19 @@ func (m *MockResourceSyncStore) Repository() store.Repository           { return
 func (m *MockResourceSyncStore) ResourceSync() store.ResourceSync {
 	return &MockResourceSync{results: m.results}
 }
-func (m *MockResourceSyncStore) Event() store.Event                  { return nil }
-func (m *MockResourceSyncStore) Checkpoint() store.Checkpoint        { return nil }
-func (m *MockResourceSyncStore) Organization() store.Organization    { return nil }
-func (m *MockResourceSyncStore) AuthProvider() store.AuthProvider    { return nil }
-func (m *MockResourceSyncStore) Catalog() store.Catalog              { return nil }
-func (m *MockResourceSyncStore) RunMigrations(context.Context) error { return nil }
-func (m *MockResourceSyncStore) Close() error                        { return nil }
-func (m *MockResourceSyncStore) CheckHealth(context.Context) error   { return nil }
+func (m *MockResourceSyncStore) Event() store.Event                                       { return nil }
+func (m *MockResourceSyncStore) Checkpoint() store.Checkpoint                             { return nil }
+func (m *MockResourceSyncStore) Organization() store.Organization                         { return nil }
+func (m *MockResourceSyncStore) AuthProvider() store.AuthProvider                         { return nil }
+func (m *MockResourceSyncStore) RevokedCertificate() store.RevokedCertificate             { return nil }
+func (m *MockResourceSyncStore) Catalog() store.Catalog                                   { return nil }
+func (m *MockResourceSyncStore) BulkOperation() store.BulkOperation                       { return nil }
+func (m *MockResourceSyncStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy { return nil }
+func (m *MockResourceSyncStore) Role() store.Role                                         { return nil }
+func (m *MockResourceSyncStore) RoleBinding() store.RoleBinding                           { return nil }
+func (m *MockResourceSyncStore) RunMigrations(context.Context) error                      { return nil }
+func (m *MockResourceSyncStore) Close() error                                             { return nil }
+func (m *MockResourceSyncStore) CheckHealth(context.Context) error                        { return nil }
 
 type MockResourceSync struct {
 	results []store.CountByResourceSyncOrgAndStatusResult
@@ -77,6 +77,18 @@ func (k *HttpKey) ComposeKey() string {
 	return fmt.Sprintf("v1/%s/%s/%s/http-data/%x", k.OrgID, k.Fleet, k.TemplateVersion, md5sum)
 }
 
+type OciDigestKey struct {
+	OrgID           uuid.UUID
+	Fleet           string
+	TemplateVersion string
+	Repository      string
+	Reference       string
+}
+
+func (k *OciDigestKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/%s/%s/oci-digest/%s/%s", k.OrgID, k.Fleet, k.TemplateVersion, k.Repository, k.Reference)
+}
+
 type DeviceKey struct {
 	OrgID      uuid.UUID
 	DeviceName string
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 // StreamEntry represents a single entry in a Redis stream
@@ -307,3 +309,12 @@ func (s *kvStore) Delete(ctx context.Context, key string) error {
 	}
 	return nil
 }
+
+// New returns the KV store selected by kv.provider in the configuration.
+// The database is only used by the "postgres" provider.
+func New(ctx context.Context, log logrus.FieldLogger, cfg *config.Config, db *gorm.DB) (KVStore, error) {
+	if cfg.KV.Provider == config.KVProviderPostgres {
+		return NewPostgresKVStore(ctx, log, db)
+	}
+	return NewKVStore(ctx, log, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password)
+}
@@ -0,0 +1,333 @@
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	// postgresStreamPollInterval is how often a blocking stream read checks for new entries
+	postgresStreamPollInterval = 200 * time.Millisecond
+	// postgresExpiryInterval is how often expired keys are deleted
+	postgresExpiryInterval = time.Minute
+)
+
+// notExpired filters out keys whose expiration time has passed
+const notExpired = "(expires_at IS NULL OR expires_at > now())"
+
+// streamNotExpired filters out entries of streams whose key has expired
+const streamNotExpired = "NOT EXISTS (SELECT 1 FROM kv_entries e WHERE e.key = kv_stream_entries.key AND e.expires_at <= now())"
+
+type postgresKVStore struct {
+	log    logrus.FieldLogger
+	db     *gorm.DB
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPostgresKVStore returns a KVStore that keeps its keys and streams in the service database.
+func NewPostgresKVStore(ctx context.Context, log logrus.FieldLogger, db *gorm.DB) (KVStore, error) {
+	ctx, span := tracing.StartSpan(ctx, "flightctl/kvstore", "PostgresKVStore")
+	defer span.End()
+
+	if db == nil {
+		return nil, errors.New("database cannot be nil")
+	}
+
+	// Test the connection
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to KV store: %w", err)
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	if err := sqlDB.PingContext(timeoutCtx); err != nil {
+		return nil, fmt.Errorf("failed to connect to KV store: %w", err)
+	}
+	log.Debug("successfully connected to the KV store")
+
+	// Expired keys are filtered on read; they are deleted periodically in the background
+	expiryCtx, expiryCancel := context.WithCancel(context.Background())
+	s := &postgresKVStore{
+		log:    log,
+		db:     db,
+		cancel: expiryCancel,
+	}
+	s.wg.Add(1)
+	go s.deleteExpiredKeys(expiryCtx)
+	return s, nil
+}
+
+func (s *postgresKVStore) deleteExpiredKeys(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(postgresExpiryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec("DELETE FROM kv_stream_entries WHERE NOT (" + streamNotExpired + ")").Error; err != nil {
+					return err
+				}
+				return tx.Exec("DELETE FROM kv_entries WHERE expires_at <= now()").Error
+			})
+			if err != nil && ctx.Err() == nil {
+				s.log.Warnf("failed deleting expired keys from KV store: %v", err)
+			}
+		}
+	}
+}
+
+func (s *postgresKVStore) Close() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+func (s *postgresKVStore) DeleteAllKeys(ctx context.Context) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM kv_stream_entries").Error; err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM kv_entries").Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed deleting all keys: %w", err)
+	}
+	return nil
+}
+
+// setNX inserts the key, or replaces it if it expired.  Returns true if the value was stored.
+func setNX(tx *gorm.DB, key string, value []byte) (bool, error) {
+	result := tx.Exec(`INSERT INTO kv_entries (key, value) VALUES (?, ?)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = NULL
+		WHERE kv_entries.expires_at <= now()`, key, value)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Sets the key to value only if the key does Not eXist. Returns a boolean indicating if the value was updated by this call.
+func (s *postgresKVStore) SetNX(ctx context.Context, key string, value []byte) (bool, error) {
+	success, err := setNX(s.db.WithContext(ctx), key, value)
+	if err != nil {
+		return false, fmt.Errorf("failed storing key: %w", err)
+	}
+	return success, nil
+}
+
+// Sets the key to value, only if the key does not already exist or if its current value is less than the new value.
+func (s *postgresKVStore) SetIfGreater(ctx context.Context, key string, newVal int64) (bool, error) {
+	value := []byte(strconv.FormatInt(newVal, 10))
+	updated := false
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		inserted, err := setNX(tx, key, value)
+		if err != nil || inserted {
+			updated = inserted
+			return err
+		}
+
+		var entry model.KVEntry
+		if err := tx.Raw("SELECT * FROM kv_entries WHERE key = ? FOR UPDATE", key).Scan(&entry).Error; err != nil {
+			return err
+		}
+		current, err := strconv.ParseInt(string(entry.Value), 10, 64)
+		if err == nil && newVal <= current {
+			return nil
+		}
+		updated = true
+		return tx.Exec("UPDATE kv_entries SET value = ? WHERE key = ?", value, key).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return updated, nil
+}
+
+// Gets the value for the specified key.
+func (s *postgresKVStore) Get(ctx context.Context, key string) ([]byte, error) {
+	var entries []model.KVEntry
+	err := s.db.WithContext(ctx).Where("key = ? AND "+notExpired, key).Limit(1).Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed getting key: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return entries[0].Value, nil
+}
+
+func (s *postgresKVStore) GetOrSetNX(ctx context.Context, key string, value []byte) ([]byte, error) {
+	var result []byte
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := setNX(tx, key, value); err != nil {
+			return err
+		}
+		var entry model.KVEntry
+		if err := tx.Where("key = ?", key).Take(&entry).Error; err != nil {
+			return err
+		}
+		result = entry.Value
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed executing GetOrSetNX: %w", err)
+	}
+	return result, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (s *postgresKVStore) DeleteKeysForTemplateVersion(ctx context.Context, key string) error {
+	pattern := escapeLike(key) + "%"
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM kv_stream_entries WHERE key LIKE ?", pattern).Error; err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM kv_entries WHERE key LIKE ?", pattern).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed deleting keys: %w", err)
+	}
+	return nil
+}
+
+func (s *postgresKVStore) PrintAllKeys(ctx context.Context) {
+	var keys []string
+	if err := s.db.WithContext(ctx).Model(&model.KVEntry{}).Where(notExpired).Pluck("key", &keys).Error; err != nil {
+		fmt.Printf("failed listing keys: %v\n", err)
+		return
+	}
+	fmt.Printf("Keys: %v\n", keys)
+}
+
+// StreamAdd adds a value to a stream and returns the entry ID
+func (s *postgresKVStore) StreamAdd(ctx context.Context, key string, value []byte) (string, error) {
+	entry := model.KVStreamEntry{Key: key, Value: value}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The stream key holds the expiration time of the stream
+		if _, err := setNX(tx, key, nil); err != nil {
+			return err
+		}
+		return tx.Create(&entry).Error
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to add to stream: %w", err)
+	}
+	return strconv.FormatInt(entry.ID, 10), nil
+}
+
+func toStreamEntries(entries []model.KVStreamEntry) []StreamEntry {
+	result := make([]StreamEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, StreamEntry{
+			ID:    strconv.FormatInt(entry.ID, 10),
+			Value: entry.Value,
+		})
+	}
+	return result
+}
+
+// StreamRange returns a range of entries from a stream
+// start and stop can be "-" (beginning), "+" (end), or specific entry IDs
+func (s *postgresKVStore) StreamRange(ctx context.Context, key string, start, stop string) ([]StreamEntry, error) {
+	query := s.db.WithContext(ctx).Where("key = ? AND "+streamNotExpired, key)
+	if start != "-" {
+		id, err := strconv.ParseInt(start, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stream start ID %q: %w", start, err)
+		}
+		query = query.Where("id >= ?", id)
+	}
+	if stop != "+" {
+		id, err := strconv.ParseInt(stop, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stream stop ID %q: %w", stop, err)
+		}
+		query = query.Where("id <= ?", id)
+	}
+
+	var entries []model.KVStreamEntry
+	if err := query.Order("id").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to get stream range: %w", err)
+	}
+	return toStreamEntries(entries), nil
+}
+
+// StreamRead reads entries from a stream with blocking support
+// lastID is the last entry ID read (use "0" to read from beginning, "$" for new entries only)
+// block is the blocking timeout (0 for non-blocking)
+// count limits the number of entries returned (0 for no limit)
+func (s *postgresKVStore) StreamRead(ctx context.Context, key string, lastID string, block time.Duration, count int64) ([]StreamEntry, error) {
+	var after int64
+	if lastID == "$" {
+		if err := s.db.WithContext(ctx).Model(&model.KVStreamEntry{}).Select("COALESCE(MAX(id), 0)").Scan(&after).Error; err != nil {
+			return nil, fmt.Errorf("failed to read from stream: %w", err)
+		}
+	} else {
+		var err error
+		if after, err = strconv.ParseInt(lastID, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid stream ID %q: %w", lastID, err)
+		}
+	}
+
+	deadline := time.Now().Add(block)
+	for {
+		query := s.db.WithContext(ctx).Where("key = ? AND id > ? AND "+streamNotExpired, key, after).Order("id")
+		if count > 0 {
+			query = query.Limit(int(count))
+		}
+		var entries []model.KVStreamEntry
+		if err := query.Find(&entries).Error; err != nil {
+			return nil, fmt.Errorf("failed to read from stream: %w", err)
+		}
+		if len(entries) > 0 || block <= 0 || !time.Now().Before(deadline) {
+			return toStreamEntries(entries), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to read from stream: %w", ctx.Err())
+		case <-time.After(min(postgresStreamPollInterval, time.Until(deadline))):
+		}
+	}
+}
+
+// SetExpire sets an expiration time on a key
+func (s *postgresKVStore) SetExpire(ctx context.Context, key string, expiration time.Duration) error {
+	err := s.db.WithContext(ctx).Exec("UPDATE kv_entries SET expires_at = now() + ? * interval '1 microsecond' WHERE key = ? AND "+notExpired,
+		expiration.Microseconds(), key).Error
+	if err != nil {
+		return fmt.Errorf("failed to set expiration: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a key and its stream entries
+func (s *postgresKVStore) Delete(ctx context.Context, key string) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM kv_stream_entries WHERE key = ?", key).Error; err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM kv_entries WHERE key = ?", key).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete key: %w", err)
+	}
+	return nil
+}
@@ -22,12 +22,14 @@ import (
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 type Server struct {
 	cfg   *config.Config
 	log   logrus.FieldLogger
 	store store.Store
+	db    *gorm.DB
 }
 
 // New returns a new instance of a flightctl server.
@@ -35,11 +37,13 @@ func New(
 	cfg *config.Config,
 	log logrus.FieldLogger,
 	store store.Store,
+	db *gorm.DB,
 ) *Server {
 	return &Server{
 		cfg:   cfg,
 		log:   log,
 		store: store,
+		db:    db,
 	}
 }
 
@@ -52,7 +56,13 @@ func (s *Server) Run(ctx context.Context) error {
 	defer cancel()
 
 	processID := fmt.Sprintf("periodic-%s-%s", util.GetHostname(), uuid.New().String())
-	queuesProvider, err := queues.NewRedisProvider(ctx, s.log, processID, s.cfg.KV.Hostname, s.cfg.KV.Port, s.cfg.KV.Password, queues.DefaultRetryConfig())
+	queuesProvider, err := queues.NewProvider(ctx, s.log, processID, queues.ProviderOptions{
+		Type:     queues.ProviderType(s.cfg.KV.Provider),
+		Hostname: s.cfg.KV.Hostname,
+		Port:     s.cfg.KV.Port,
+		Password: s.cfg.KV.Password,
+		DB:       s.db,
+	}, queues.DefaultRetryConfig())
 	if err != nil {
 		return err
 	}
@@ -61,7 +71,7 @@ func (s *Server) Run(ctx context.Context) error {
 		queuesProvider.Wait()
 	}()
 
-	kvStore, err := kvstore.NewKVStore(ctx, s.log, s.cfg.KV.Hostname, s.cfg.KV.Port, s.cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, s.log, s.cfg, s.db)
 	if err != nil {
 		return err
 	}
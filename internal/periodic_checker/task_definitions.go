@@ -29,6 +29,8 @@ type PeriodicTaskType string
 const (
 	PeriodicTaskTypeRepositoryTester       PeriodicTaskType = "repository-tester"
 	PeriodicTaskTypeResourceSync           PeriodicTaskType = "resource-sync"
+	PeriodicTaskTypeCatalogSync            PeriodicTaskType = "catalog-sync"
+	PeriodicTaskTypeCatalogDeprecation     PeriodicTaskType = "catalog-deprecation"
 	PeriodicTaskTypeDeviceDisconnected     PeriodicTaskType = "device-disconnected"
 	PeriodicTaskTypeRolloutDeviceSelection PeriodicTaskType = "rollout-device-selection"
 	PeriodicTaskTypeDisruptionBudget       PeriodicTaskType = "disruption-budget"
@@ -44,6 +46,8 @@ type PeriodicTaskMetadata struct {
 var periodicTasks = map[PeriodicTaskType]PeriodicTaskMetadata{
 	PeriodicTaskTypeRepositoryTester:       {Interval: 2 * time.Minute, SystemWide: false},
 	PeriodicTaskTypeResourceSync:           {Interval: 2 * time.Minute, SystemWide: false},
+	PeriodicTaskTypeCatalogSync:            {Interval: 2 * time.Minute, SystemWide: false},
+	PeriodicTaskTypeCatalogDeprecation:     {Interval: 5 * time.Minute, SystemWide: false},
 	PeriodicTaskTypeDeviceDisconnected:     {Interval: tasks.DeviceDisconnectedPollingInterval, SystemWide: false},
 	PeriodicTaskTypeRolloutDeviceSelection: {Interval: device_selection.RolloutDeviceSelectionInterval, SystemWide: false},
 	PeriodicTaskTypeDisruptionBudget:       {Interval: disruption_budget.DisruptionBudgetReconcilationInterval, SystemWide: false},
@@ -115,6 +119,28 @@ func (e *ResourceSyncExecutor) Execute(ctx context.Context, log logrus.FieldLogg
 	resourceSync.Poll(taskCtx, orgId)
 }
 
+type CatalogSyncExecutor struct {
+	serviceHandler service.Service
+	log            logrus.FieldLogger
+}
+
+func (e *CatalogSyncExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeCatalogSync)
+	catalogSync := tasks.NewCatalogSync(e.serviceHandler, e.log)
+	catalogSync.Poll(taskCtx, orgId)
+}
+
+type CatalogDeprecationExecutor struct {
+	serviceHandler service.Service
+	log            logrus.FieldLogger
+}
+
+func (e *CatalogDeprecationExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeCatalogDeprecation)
+	catalogDeprecation := tasks.NewCatalogDeprecation(e.serviceHandler, e.log)
+	catalogDeprecation.Poll(taskCtx, orgId)
+}
+
 type DeviceDisconnectedExecutor struct {
 	log            logrus.FieldLogger
 	serviceHandler service.Service
@@ -192,6 +218,14 @@ func InitializeTaskExecutors(log logrus.FieldLogger, serviceHandler service.Serv
 			log:                   log.WithField("pkg", "resource-sync"),
 			ignoreResourceUpdates: cfg.GitOps.IgnoreResourceUpdates,
 		},
+		PeriodicTaskTypeCatalogSync: &CatalogSyncExecutor{
+			serviceHandler: serviceHandler,
+			log:            log.WithField("pkg", "catalog-sync"),
+		},
+		PeriodicTaskTypeCatalogDeprecation: &CatalogDeprecationExecutor{
+			serviceHandler: serviceHandler,
+			log:            log.WithField("pkg", "catalog-deprecation"),
+		},
 		PeriodicTaskTypeDeviceDisconnected: &DeviceDisconnectedExecutor{
 			log:            log.WithField("pkg", "device-disconnected"),
 			serviceHandler: serviceHandler,
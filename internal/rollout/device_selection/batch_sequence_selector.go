@@ -91,9 +91,18 @@ func (q *querySelectorParts) withoutDisconnected() *querySelectorParts {
 	return q
 }
 
-func newBatchSequenceSelector(sequence domain.BatchSequence, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *domain.Fleet, templateVersionName string, log logrus.FieldLogger) RolloutDeviceSelector {
+func (q *querySelectorParts) withHealthy() *querySelectorParts {
+	q.fieldSelectorList = append(q.fieldSelectorList,
+		"status.updated.status="+string(domain.DeviceUpdatedStatusUpToDate),
+		"status.summary.status="+string(domain.DeviceSummaryStatusOnline),
+		fmt.Sprintf("status.applicationsSummary.status in (%s,%s)", domain.ApplicationsSummaryStatusHealthy, domain.ApplicationsSummaryStatusNoApplications))
+	return q
+}
+
+func newBatchSequenceSelector(sequence domain.BatchSequence, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *domain.Fleet, templateVersionName string, log logrus.FieldLogger) *batchSequenceSelector {
 	return &batchSequenceSelector{
 		BatchSequence:       sequence,
+		definition:          sequence,
 		serviceHandler:      serviceHandler,
 		orgId:               orgId,
 		fleetName:           lo.FromPtr(fleet.Metadata.Name),
@@ -106,6 +115,9 @@ func newBatchSequenceSelector(sequence domain.BatchSequence, updateTimeout time.
 
 type batchSequenceSelector struct {
 	domain.BatchSequence
+
+	// The device selection definition as it appears in the fleet spec.  Its digest is used to detect definition updates
+	definition          any
 	serviceHandler      service.Service
 	orgId               uuid.UUID
 	fleet               *domain.Fleet
@@ -130,8 +142,8 @@ func (b *batchSequenceSelector) getPreviousBatchSequenceDigest() (string, bool)
 	return b.fleet.GetAnnotation(domain.FleetAnnotationDeviceSelectionConfigDigest)
 }
 
-func (b *batchSequenceSelector) batchSequenceDigest() (string, error) {
-	marshalled, err := json.Marshal(&b.BatchSequence)
+func (b *batchSequenceSelector) definitionDigest() (string, error) {
+	marshalled, err := json.Marshal(b.definition)
 	if err != nil {
 		return "", err
 	}
@@ -143,22 +155,27 @@ func (b *batchSequenceSelector) IsDefinitionUpdated() (bool, error) {
 	if !exists {
 		return true, nil
 	}
-	currentBatchSequenceDigest, err := b.batchSequenceDigest()
+	currentDefinitionDigest, err := b.definitionDigest()
 	if err != nil {
 		return false, err
 	}
-	return previousBatchSequenceDigest != currentBatchSequenceDigest, nil
+	return previousBatchSequenceDigest != currentDefinitionDigest, nil
 }
 
 func (b *batchSequenceSelector) OnNewRollout(ctx context.Context) error {
 	b.log.Infof("%v/%s: OnNewRollout. Template version %s", b.orgId, b.fleetName, b.templateVersionName)
-	batchSequenceDigest, err := b.batchSequenceDigest()
+	definitionDigest, err := b.definitionDigest()
 	if err != nil {
 		return err
 	}
 	annotations := map[string]string{
 		domain.FleetAnnotationDeployingTemplateVersion:    b.templateVersionName,
-		domain.FleetAnnotationDeviceSelectionConfigDigest: batchSequenceDigest,
+		domain.FleetAnnotationDeviceSelectionConfigDigest: definitionDigest,
+	}
+
+	// Remember the template version that was deployed before this rollout, so that the rollout can be reverted
+	if dtv, exists := b.fleet.GetAnnotation(domain.FleetAnnotationDeployingTemplateVersion); exists && dtv != b.templateVersionName {
+		annotations[domain.FleetAnnotationPreviousTemplateVersion] = dtv
 	}
 	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil))
 }
@@ -260,7 +277,7 @@ func (b *batchSequenceSelector) resetApprovalAndCompletionReport(ctx context.Con
 		annotations[domain.FleetAnnotationRolloutApprovalMethod] = "automatic"
 	}
 	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, []string{
-		domain.FleetAnnotationRolloutApproved, domain.FleetAnnotationLastBatchCompletionReport}))
+		domain.FleetAnnotationRolloutApproved, domain.FleetAnnotationLastBatchCompletionReport, domain.FleetAnnotationCanarySoakStartTime}))
 }
 
 func (b *batchSequenceSelector) batchName(currentBatch int) string {
@@ -286,12 +303,15 @@ func (b *batchSequenceSelector) currentSelection(ctx context.Context, currentBat
 	if status.Code != http.StatusOK {
 		return nil, service.ApiStatusToErr(status)
 	}
+	var successThreshold *domain.Percentage
 	if currentBatch >= 0 && currentBatch < len(lo.FromPtr(b.Sequence)) {
 		batch = lo.ToPtr(lo.FromPtr(b.Sequence)[currentBatch])
+		successThreshold = batch.SuccessThreshold
 	}
 	batchName := b.batchName(currentBatch)
 	return &batchSelection{
 		batch:               batch,
+		successThreshold:    successThreshold,
 		batchNum:            currentBatch,
 		batchName:           batchName,
 		serviceHandler:      b.serviceHandler,
@@ -330,6 +350,7 @@ func (b *batchSequenceSelector) Reset(ctx context.Context) error {
 
 type batchSelection struct {
 	batch               *domain.Batch
+	successThreshold    *domain.Percentage
 	batchNum            int
 	batchName           string
 	serviceHandler      service.Service
@@ -373,8 +394,8 @@ func (b *batchSelection) getSuccessThreshold() (int, error) {
 		err error
 	)
 	successThreshold := b.fleet.Spec.RolloutPolicy.SuccessThreshold
-	if b.batch != nil && b.batch.SuccessThreshold != nil {
-		successThreshold = b.batch.SuccessThreshold
+	if b.successThreshold != nil {
+		successThreshold = b.successThreshold
 	}
 	if successThreshold != nil {
 		ret, err = util.PercentageAsInt(*successThreshold)
@@ -450,8 +471,9 @@ func (b *batchSelection) isUpdateCompletedSuccessfully(c domain.DeviceCompletion
 	return c.SameTemplateVersion && c.SameRenderedVersion
 }
 
+// A device failed to update if the agent reported an error or rolled the update back
 func (b *batchSelection) isFailed(c domain.DeviceCompletionCount) bool {
-	return c.SameTemplateVersion && c.UpdatingReason == domain.UpdateStateError
+	return c.SameTemplateVersion && (c.UpdatingReason == domain.UpdateStateError || c.UpdatingReason == domain.UpdateStateRolledBack)
 }
 
 func (b *batchSelection) isTimedOut(c domain.DeviceCompletionCount) bool {
@@ -472,7 +494,7 @@ func (b *batchSelection) IsComplete(ctx context.Context) (bool, error) {
 
 	// A device is counted as completed if it has completed successfully or, it is in error state or its update is timed out
 	complete := lo.Sum(lo.Map(counts, func(c domain.DeviceCompletionCount, _ int) int64 {
-		return lo.Ternary(b.isUpdateCompletedSuccessfully(c) || c.SameTemplateVersion && (c.UpdatingReason == domain.UpdateStateError || c.UpdateTimedOut), c.Count, 0)
+		return lo.Ternary(b.isUpdateCompletedSuccessfully(c) || b.isFailed(c) || b.isTimedOut(c), c.Count, 0)
 	}))
 	return total == complete, nil
 }
@@ -509,6 +531,10 @@ func (b *batchSelection) SetCompletionReport(ctx context.Context) error {
 	if report.Total == 0 {
 		return nil
 	}
+	return b.saveCompletionReport(ctx, report)
+}
+
+func (b *batchSelection) saveCompletionReport(ctx context.Context, report domain.RolloutBatchCompletionReport) error {
 	out, err := json.Marshal(&report)
 	if err != nil {
 		return fmt.Errorf("failed to marshal completion report: %w", err)
@@ -632,6 +658,10 @@ func (b *batchSelection) OnSuspended(ctx context.Context) error {
 	}
 }
 
+func (b *batchSelection) OnMaintenanceWindowClosed(ctx context.Context, nextOpen *time.Time) error {
+	return b.conditionEmitter.waitingForMaintenanceWindow(ctx, nextOpen)
+}
+
 func (b *batchSelection) OnFinish(ctx context.Context) error {
 	return b.conditionEmitter.inactive(ctx)
 }
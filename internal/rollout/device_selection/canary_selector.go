@@ -0,0 +1,222 @@
+package device_selection
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// The canary cohort is the single explicit batch of the equivalent batch sequence
+const canaryCohortBatch = 0
+
+// canarySelector implements the Canary strategy on top of the batch sequence machinery.  The canary cohort is rolled
+// out as a single explicit batch.  Once its devices are updated, the cohort must stay online with healthy applications
+// for the soak duration before the final implicit batch promotes the rest of the fleet.  If the success percentage of
+// the cohort falls below the success threshold, the fleet is rolled back to the template version that was deployed
+// before the rollout started.
+type canarySelector struct {
+	*batchSequenceSelector
+	canary       domain.Canary
+	soakDuration time.Duration
+}
+
+func newCanarySelector(canary domain.Canary, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *domain.Fleet, templateVersionName string, log logrus.FieldLogger) (RolloutDeviceSelector, error) {
+	var soakDuration time.Duration
+	if canary.SoakDuration != nil {
+		d, err := time.ParseDuration(*canary.SoakDuration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse soak duration %s: %w", *canary.SoakDuration, err)
+		}
+		soakDuration = d
+	}
+	batchSequenceSelector := newBatchSequenceSelector(rollout.CanaryAsBatchSequence(canary), updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log)
+	batchSequenceSelector.definition = canary
+	return &canarySelector{
+		batchSequenceSelector: batchSequenceSelector,
+		canary:                canary,
+		soakDuration:          soakDuration,
+	}, nil
+}
+
+func (c *canarySelector) CurrentSelection(ctx context.Context) (Selection, error) {
+	currentBatch, err := c.getCurrentBatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	selection, err := c.currentSelection(ctx, currentBatch)
+	if err != nil {
+		return nil, err
+	}
+	if currentBatch == canaryCohortBatch {
+		selection.batchName = domain.CanaryBatchName
+		selection.conditionEmitter = newConditionEmitter(c.orgId, c.fleetName, domain.CanaryBatchName, c.serviceHandler)
+	}
+
+	// Promotion of the rest of the fleet is gated by the success threshold of the cohort
+	if c.canary.Cohort.SuccessThreshold != nil {
+		selection.successThreshold = c.canary.Cohort.SuccessThreshold
+	}
+	return &canarySelection{
+		batchSelection: selection,
+		selector:       c,
+	}, nil
+}
+
+func (c *canarySelector) rollbackOnFailure() bool {
+	return lo.FromPtrOr(c.canary.RollbackOnFailure, true)
+}
+
+type canarySelection struct {
+	*batchSelection
+	selector *canarySelector
+}
+
+func (c *canarySelection) isCohort() bool {
+	return c.batchNum == canaryCohortBatch
+}
+
+func (c *canarySelection) getSoakStartTime() (time.Time, bool, error) {
+	soakStartTimeStr, exists := c.fleet.GetAnnotation(domain.FleetAnnotationCanarySoakStartTime)
+	if !exists {
+		return time.Time{}, false, nil
+	}
+	soakStartTime, err := time.Parse(time.RFC3339, soakStartTimeStr)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to parse canary soak start time: %w", err)
+	}
+	return soakStartTime, true, nil
+}
+
+func (c *canarySelection) setSoakStartTime(ctx context.Context, soakStartTime time.Time) error {
+	c.log.Infof("%v/%s: Canary cohort updated. Soaking for %s", c.orgId, c.fleetName, c.selector.soakDuration)
+	annotations := map[string]string{
+		domain.FleetAnnotationCanarySoakStartTime: soakStartTime.UTC().Format(time.RFC3339),
+	}
+	return service.ApiStatusToErr(c.serviceHandler.UpdateFleetAnnotations(ctx, c.orgId, c.fleetName, annotations, nil))
+}
+
+// countHealthy counts the cohort devices that were updated to the template version, are up-to-date, online and
+// their applications are healthy
+func (c *canarySelection) countHealthy(ctx context.Context) (int64, error) {
+	listParams, annotationSelector := newQuerySelectorParts().
+		withOwner(c.fleetName).
+		withSelectedForRollout().
+		withRolledOut(c.templateVersionName).
+		withHealthy().
+		listParams()
+	count, status := c.serviceHandler.CountDevices(ctx, c.orgId, listParams, annotationSelector)
+	if status.Code != http.StatusOK {
+		return 0, service.ApiStatusToErr(status)
+	}
+	return count, nil
+}
+
+// cohortCompletionReport is the completion report of the cohort in which only devices that remained healthy are
+// counted as successful
+func (c *canarySelection) cohortCompletionReport(ctx context.Context) (domain.RolloutBatchCompletionReport, error) {
+	counts, status := c.serviceHandler.GetDeviceCompletionCounts(ctx, c.orgId, util.ResourceOwner(domain.FleetKind, c.fleetName), c.templateVersionName, &c.updateTimeout)
+	if status.Code != http.StatusOK {
+		return domain.RolloutBatchCompletionReport{}, service.ApiStatusToErr(status)
+	}
+	report := c.completionReport(counts)
+	healthy, err := c.countHealthy(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.Successful = min(report.Successful, healthy)
+	report.SuccessPercentage = 100
+	if report.Total != 0 {
+		report.SuccessPercentage = report.Successful * 100 / report.Total
+	}
+	return report, nil
+}
+
+// IsComplete of the cohort requires, in addition to all cohort devices being updated, that the soak duration has
+// elapsed.  Soaking stops early once the cohort drops below the success threshold.
+func (c *canarySelection) IsComplete(ctx context.Context) (bool, error) {
+	complete, err := c.batchSelection.IsComplete(ctx)
+	if err != nil || !complete || !c.isCohort() || c.selector.soakDuration == 0 {
+		return complete, err
+	}
+	soakStartTime, exists, err := c.getSoakStartTime()
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, c.setSoakStartTime(ctx, time.Now())
+	}
+	if time.Since(soakStartTime) >= c.selector.soakDuration {
+		return true, nil
+	}
+	report, err := c.cohortCompletionReport(ctx)
+	if err != nil {
+		return false, err
+	}
+	successThreshold, err := c.getSuccessThreshold()
+	if err != nil {
+		return false, err
+	}
+	return report.SuccessPercentage < int64(successThreshold), nil
+}
+
+func (c *canarySelection) SetCompletionReport(ctx context.Context) error {
+	if !c.isCohort() {
+		return c.batchSelection.SetCompletionReport(ctx)
+	}
+	report, err := c.cohortCompletionReport(ctx)
+	if err != nil {
+		return err
+	}
+	if report.Total == 0 {
+		return nil
+	}
+	return c.saveCompletionReport(ctx, report)
+}
+
+// OnSuspended rolls the fleet back if the cohort failed and rollback is enabled.  Otherwise, the rollout is suspended
+// the same way as a batch sequence rollout.
+func (c *canarySelection) OnSuspended(ctx context.Context) error {
+	if c.isCohort() || !c.isApprovalMethodAutomatic() || !c.selector.rollbackOnFailure() {
+		return c.batchSelection.OnSuspended(ctx)
+	}
+	previousTemplateVersionName, exists := c.fleet.GetAnnotation(domain.FleetAnnotationPreviousTemplateVersion)
+	if !exists || previousTemplateVersionName == c.templateVersionName {
+		c.log.Warnf("%v/%s: Canary failed but there is no previous template version to roll back to", c.orgId, c.fleetName)
+		return c.batchSelection.OnSuspended(ctx)
+	}
+	report, exists, err := c.getLastCompletionReport()
+	if err != nil {
+		return fmt.Errorf("failed to get last completion report: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("last completion report doesn't exist")
+	}
+	successThreshold, err := c.getSuccessThreshold()
+	if err != nil {
+		return fmt.Errorf("failed to get success threshold: %w", err)
+	}
+	if err = c.rollback(ctx, previousTemplateVersionName); err != nil {
+		return fmt.Errorf("failed to roll back to template version %s: %w", previousTemplateVersionName, err)
+	}
+	return c.conditionEmitter.rolledBack(ctx, successThreshold, report, previousTemplateVersionName)
+}
+
+// rollback rolls the fleet back to the given template version, which is then rolled out to all devices of the fleet
+// at once
+func (c *canarySelection) rollback(ctx context.Context, templateVersionName string) error {
+	c.log.Infof("%v/%s: Rolling back from template version %s to %s", c.orgId, c.fleetName, c.templateVersionName, templateVersionName)
+	if err := c.unmark(ctx); err != nil {
+		return err
+	}
+	_, status := c.serviceHandler.RollbackFleet(ctx, c.orgId, c.fleetName, domain.FleetRollbackRequest{TemplateVersion: templateVersionName})
+	return service.ApiStatusToErr(status)
+}
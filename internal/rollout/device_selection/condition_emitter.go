@@ -3,6 +3,7 @@ package device_selection
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/service"
@@ -70,3 +71,24 @@ func (c *conditionEmitter) waiting(ctx context.Context) error {
 		fmt.Sprintf("Waiting for %s to be approved", c.batchName),
 	))
 }
+
+func (c *conditionEmitter) waitingForMaintenanceWindow(ctx context.Context, nextOpen *time.Time) error {
+	message := fmt.Sprintf("Waiting for a maintenance window to roll out %s", c.batchName)
+	if nextOpen != nil {
+		message = fmt.Sprintf("%s; next maintenance window opens at %s", message, nextOpen.UTC().Format(time.RFC3339))
+	}
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutWaitingReason,
+		message,
+	))
+}
+
+func (c *conditionEmitter) rolledBack(ctx context.Context, threshold int, completionReport domain.RolloutBatchCompletionReport, templateVersionName string) error {
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutRolledBackReason,
+		fmt.Sprintf("%s failed: %d%% of batch devices were updated successfully and remained healthy, while success threshold was set to %d%%; Breakdown: total=%d successful=%d failed=%d timed out=%d; Rolled back to template version %s",
+			completionReport.BatchName, completionReport.SuccessPercentage, threshold, completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut, templateVersionName),
+	))
+}
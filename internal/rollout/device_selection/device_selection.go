@@ -34,6 +34,7 @@ type Selection interface {
 	SetCompletionReport(ctx context.Context) error
 	OnRollout(ctx context.Context) error
 	OnSuspended(ctx context.Context) error
+	OnMaintenanceWindowClosed(ctx context.Context, nextOpen *time.Time) error
 	OnFinish(ctx context.Context) error
 }
 
@@ -65,6 +66,8 @@ func NewRolloutDeviceSelector(deviceSelection *domain.RolloutDeviceSelection, de
 	switch v := selectorInterface.(type) {
 	case domain.BatchSequence:
 		return newBatchSequenceSelector(v, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log), nil
+	case domain.Canary:
+		return newCanarySelector(v, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log)
 	default:
 		return nil, fmt.Errorf("unexpected selector %T", selectorInterface)
 	}
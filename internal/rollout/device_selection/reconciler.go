@@ -3,8 +3,10 @@ package device_selection
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/util"
@@ -84,11 +86,21 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 		r.log.Warnf("No template version for fleet %v/%s", orgId, fleetName)
 		return
 	}
+	if rollbackTemplateVersionName, pinned := annotations[domain.FleetAnnotationRollbackTemplateVersion]; pinned {
+		// A rollback is rolled out to all devices of the fleet at once.  Device selection resumes with the next template version
+		r.log.Debugf("Fleet %v/%s is rolled back to template version %s. Skipping device selection", orgId, fleetName, rollbackTemplateVersionName)
+		return
+	}
 	selector, err := NewRolloutDeviceSelector(fleet.Spec.RolloutPolicy.DeviceSelection, fleet.Spec.RolloutPolicy.DefaultUpdateTimeout, r.serviceHandler, orgId, &fleet, templateVersionName, r.log)
 	if err != nil {
 		r.log.WithError(err).Errorf("%v/%s: NewRolloutDeviceSelector", orgId, fleetName)
 		return
 	}
+	windows, err := rollout.NewMaintenanceWindows(&fleet)
+	if err != nil {
+		r.log.WithError(err).Errorf("%v/%s: NewMaintenanceWindows", orgId, fleetName)
+		return
+	}
 	definitionUpdated, err := selector.IsDefinitionUpdated()
 	if err != nil {
 		r.log.WithError(err).Errorf("%v/%s: IsDefinitionUpdated", orgId, fleetName)
@@ -122,6 +134,13 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 				r.log.WithError(err).Errorf("%v/%s: MayApproveAutomatically", orgId, fleetName)
 				break
 			}
+			if mayApprove && !windows.IsOpen(time.Now()) {
+				// Batches are approved automatically only within a maintenance window
+				if err = selection.OnMaintenanceWindowClosed(ctx, windows.NextOpen(time.Now())); err != nil {
+					r.log.WithError(err).Errorf("%v/%s: OnMaintenanceWindowClosed", orgId, fleetName)
+				}
+				break
+			}
 			if mayApprove {
 				if err = selection.Approve(ctx); err != nil {
 					r.log.WithError(err).Errorf("%v/%s: Approve", orgId, fleetName)
@@ -142,6 +161,13 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			break
 		}
 		if !isRolledOut {
+			if !windows.IsOpen(time.Now()) {
+				// The batch is dispatched once the next maintenance window opens
+				if err = selection.OnMaintenanceWindowClosed(ctx, windows.NextOpen(time.Now())); err != nil {
+					r.log.WithError(err).Errorf("%v/%s: OnMaintenanceWindowClosed", orgId, fleetName)
+				}
+				break
+			}
 			if err = selection.OnRollout(ctx); err != nil {
 				r.log.WithError(err).Errorf("%v/%s: OnRollout", orgId, fleetName)
 			}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store/selector"
@@ -97,7 +98,7 @@ func (r *reconciler) getFleetCounts(ctx context.Context, orgId uuid.UUID, fleet
 	return collectDeviceBudgetCounts(counts, groupBy)
 }
 
-func (r *reconciler) reconcileSelectionDevices(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet, key map[string]any, numToRender int) error {
+func (r *reconciler) reconcileSelectionDevices(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet, windows *rollout.MaintenanceWindows, key map[string]any, numToRender int) error {
 	annotations := lo.FromPtr(fleet.Metadata.Annotations)
 	if annotations == nil {
 		return fmt.Errorf("annotations don't exist")
@@ -150,6 +151,7 @@ func (r *reconciler) reconcileSelectionDevices(ctx context.Context, orgId uuid.U
 		listParams.LabelSelector = lo.ToPtr(strings.Join(labelSelectorParts, ","))
 	}
 	remaining := lo.Ternary(numToRender > 0, numToRender, math.MaxInt)
+	now := time.Now()
 	for {
 		listParams.Limit = lo.ToPtr(int32(math.Min(float64(remaining), float64(maxItemsToRender))))
 		devices, status := r.serviceHandler.ListDevices(ctx, orgId, listParams, annotationSelector)
@@ -157,10 +159,15 @@ func (r *reconciler) reconcileSelectionDevices(ctx context.Context, orgId uuid.U
 			return service.ApiStatusToErr(status)
 		}
 		for _, d := range devices.Items {
+			// Devices outside of their maintenance window are left unrendered until the window opens
+			if !windows.IsOpenForDevice(lo.FromPtr(d.Metadata.Labels), now) {
+				r.log.Debugf("%v/%s: maintenance window is closed, delaying rendering", orgId, lo.FromPtr(d.Metadata.Name))
+				continue
+			}
 			r.log.Infof("%v/%s: sending device to rendering", orgId, lo.FromPtr(d.Metadata.Name))
 			r.serviceHandler.CreateEvent(ctx, orgId, common.GetFleetRolloutDeviceSelectedEvent(ctx, lo.FromPtr(d.Metadata.Name), lo.FromPtr(fleet.Metadata.Name), templateVersionName))
+			remaining--
 		}
-		remaining = remaining - len(devices.Items)
 		if devices.Metadata.Continue == nil || remaining == 0 {
 			break
 		}
@@ -173,8 +180,12 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 	r.log.Infof("disruption budget: starting reconciling fleet %v/%s", orgId, lo.FromPtr(fleet.Metadata.Name))
 	defer r.log.Infof("disruption budget: finished reconciling fleet %v/%s", orgId, lo.FromPtr(fleet.Metadata.Name))
 
+	windows, err := rollout.NewMaintenanceWindows(fleet)
+	if err != nil {
+		return fmt.Errorf("maintenance windows: %w", err)
+	}
 	if fleet.Spec.RolloutPolicy == nil || fleet.Spec.RolloutPolicy.DisruptionBudget == nil {
-		if err := r.reconcileSelectionDevices(ctx, orgId, fleet, nil, 0); err != nil {
+		if err := r.reconcileSelectionDevices(ctx, orgId, fleet, windows, nil, 0); err != nil {
 			return fmt.Errorf("reconcileSelectionDevices: %w", err)
 		}
 		return nil
@@ -199,7 +210,7 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			numToRender = util.Min(numToRender, available-util.Min(lo.FromPtr(minAvailable), int(count.totalCount-1)))
 		}
 		if numToRender > 0 {
-			if err = r.reconcileSelectionDevices(ctx, orgId, fleet, count.key, numToRender); err != nil {
+			if err = r.reconcileSelectionDevices(ctx, orgId, fleet, windows, count.key, numToRender); err != nil {
 				return fmt.Errorf("reconcileSelectionDevices: %w", err)
 			}
 		}
@@ -0,0 +1,147 @@
+package rollout
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/pkg/k8s/selector"
+	"github.com/flightctl/flightctl/pkg/k8s/selector/labels"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+)
+
+type maintenanceWindow struct {
+	schedule cron.Schedule
+	duration time.Duration
+	start    time.Time
+	end      time.Time
+	location *time.Location
+	// nil selector means that the window applies to all the devices of the fleet
+	selector selector.Selector
+}
+
+// MaintenanceWindows evaluates the maintenance windows defined in the rollout policy of a fleet.
+// A nil *MaintenanceWindows represents a fleet without maintenance windows, which is never restricted.
+type MaintenanceWindows struct {
+	windows []maintenanceWindow
+}
+
+// NewMaintenanceWindows parses the maintenance windows of the fleet.  It returns nil if the fleet does not define any.
+func NewMaintenanceWindows(fleet *domain.Fleet) (*MaintenanceWindows, error) {
+	if fleet.Spec.RolloutPolicy == nil || len(lo.FromPtr(fleet.Spec.RolloutPolicy.MaintenanceWindows)) == 0 {
+		return nil, nil
+	}
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	ret := &MaintenanceWindows{}
+	for i, w := range lo.FromPtr(fleet.Spec.RolloutPolicy.MaintenanceWindows) {
+		// recurring windows must define their time zone, windows stored before that was enforced are evaluated in UTC
+		mw := maintenanceWindow{location: time.UTC}
+		if w.TimeZone != nil {
+			loc, err := time.LoadLocation(*w.TimeZone)
+			if err != nil {
+				return nil, fmt.Errorf("maintenance window %d: invalid time zone: %w", i, err)
+			}
+			mw.location = loc
+		}
+		switch {
+		case w.At != nil && w.Duration != nil:
+			schedule, err := parser.Parse(*w.At)
+			if err != nil {
+				return nil, fmt.Errorf("maintenance window %d: invalid cron expression: %w", i, err)
+			}
+			duration, err := time.ParseDuration(*w.Duration)
+			if err != nil {
+				return nil, fmt.Errorf("maintenance window %d: invalid duration: %w", i, err)
+			}
+			mw.schedule = schedule
+			mw.duration = duration
+		case w.Start != nil && w.End != nil:
+			mw.start = *w.Start
+			mw.end = *w.End
+		default:
+			return nil, fmt.Errorf("maintenance window %d: one of [at+duration, start+end] must be defined", i)
+		}
+		if w.Selector != nil {
+			s, err := labels.Parse(labelSelectorString(w.Selector))
+			if err != nil {
+				return nil, fmt.Errorf("maintenance window %d: invalid selector: %w", i, err)
+			}
+			mw.selector = s
+		}
+		ret.windows = append(ret.windows, mw)
+	}
+	return ret, nil
+}
+
+func labelSelectorString(l *domain.LabelSelector) string {
+	parts := lo.MapToSlice(lo.FromPtr(l.MatchLabels), func(k, v string) string { return k + "=" + v })
+	parts = append(parts, lo.Map(lo.FromPtr(l.MatchExpressions), func(e domain.MatchExpression, _ int) string { return e.String() })...)
+	return strings.Join(parts, ",")
+}
+
+func (w *maintenanceWindow) isOpen(now time.Time) bool {
+	if w.schedule == nil {
+		return !now.Before(w.start) && now.Before(w.end)
+	}
+	// The most recent window start is the first activation after now-duration.  The window is open if it already started.
+	lastStart := w.schedule.Next(now.In(w.location).Add(-w.duration))
+	return !lastStart.After(now)
+}
+
+func (w *maintenanceWindow) nextStart(now time.Time) *time.Time {
+	if w.schedule == nil {
+		if now.Before(w.start) {
+			return lo.ToPtr(w.start)
+		}
+		return nil
+	}
+	return lo.ToPtr(w.schedule.Next(now.In(w.location)))
+}
+
+func (w *maintenanceWindow) appliesTo(deviceLabels map[string]string) bool {
+	return w.selector == nil || w.selector.Matches(k8sLabels.Set(deviceLabels))
+}
+
+// Defined returns true if the fleet defines at least one maintenance window.
+func (m *MaintenanceWindows) Defined() bool {
+	return m != nil && len(m.windows) > 0
+}
+
+// IsOpen returns true if any maintenance window is open, or if no maintenance windows are defined.
+func (m *MaintenanceWindows) IsOpen(now time.Time) bool {
+	if !m.Defined() {
+		return true
+	}
+	return lo.ContainsBy(m.windows, func(w maintenanceWindow) bool { return w.isOpen(now) })
+}
+
+// IsOpenForDevice returns true if a maintenance window applying to a device with the given labels is open.
+// A device that no maintenance window applies to is not restricted.
+func (m *MaintenanceWindows) IsOpenForDevice(deviceLabels map[string]string, now time.Time) bool {
+	if !m.Defined() {
+		return true
+	}
+	applicable := lo.Filter(m.windows, func(w maintenanceWindow, _ int) bool { return w.appliesTo(deviceLabels) })
+	if len(applicable) == 0 {
+		return true
+	}
+	return lo.ContainsBy(applicable, func(w maintenanceWindow) bool { return w.isOpen(now) })
+}
+
+// NextOpen returns the earliest time after now at which a maintenance window opens, or nil if no window opens in the future.
+func (m *MaintenanceWindows) NextOpen(now time.Time) *time.Time {
+	if !m.Defined() {
+		return nil
+	}
+	var next *time.Time
+	for i := range m.windows {
+		start := m.windows[i].nextStart(now)
+		if start != nil && (next == nil || start.Before(*next)) {
+			next = start
+		}
+	}
+	return next
+}
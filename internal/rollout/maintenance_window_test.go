@@ -0,0 +1,160 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func fleetWithMaintenanceWindows(windows ...domain.MaintenanceWindow) *domain.Fleet {
+	return &domain.Fleet{
+		Spec: domain.FleetSpec{
+			RolloutPolicy: &domain.RolloutPolicy{
+				MaintenanceWindows: &windows,
+			},
+		},
+	}
+}
+
+func TestMaintenanceWindows(t *testing.T) {
+	require := require.New(t)
+	nyLoc, err := time.LoadLocation("America/New_York")
+	require.NoError(err)
+
+	nightly := domain.MaintenanceWindow{
+		TimeZone: lo.ToPtr("America/New_York"),
+		At:       lo.ToPtr("0 22 * * *"), // 10:00 pm
+		Duration: lo.ToPtr("4h"),
+	}
+	fixedStart := time.Date(2024, 12, 24, 8, 0, 0, 0, time.UTC)
+	fixed := domain.MaintenanceWindow{
+		Start:    lo.ToPtr(fixedStart),
+		End:      lo.ToPtr(fixedStart.Add(2 * time.Hour)),
+		Selector: &domain.LabelSelector{MatchLabels: &map[string]string{"site": "factory"}},
+	}
+
+	testCases := []struct {
+		name             string
+		windows          []domain.MaintenanceWindow
+		deviceLabels     map[string]string
+		currentTime      time.Time
+		expectedOpen     bool
+		expectedDevice   bool
+		expectedNextOpen *time.Time
+	}{
+		{
+			name:             "closed: before nightly window",
+			windows:          []domain.MaintenanceWindow{nightly},
+			currentTime:      time.Date(2024, 12, 20, 21, 0, 0, 0, nyLoc),
+			expectedOpen:     false,
+			expectedDevice:   false,
+			expectedNextOpen: lo.ToPtr(time.Date(2024, 12, 20, 22, 0, 0, 0, nyLoc)),
+		},
+		{
+			name:             "open: at start of nightly window",
+			windows:          []domain.MaintenanceWindow{nightly},
+			currentTime:      time.Date(2024, 12, 20, 22, 0, 0, 0, nyLoc),
+			expectedOpen:     true,
+			expectedDevice:   true,
+			expectedNextOpen: lo.ToPtr(time.Date(2024, 12, 21, 22, 0, 0, 0, nyLoc)),
+		},
+		{
+			name:             "open: nightly window spanning midnight",
+			windows:          []domain.MaintenanceWindow{nightly},
+			currentTime:      time.Date(2024, 12, 21, 1, 30, 0, 0, nyLoc),
+			expectedOpen:     true,
+			expectedDevice:   true,
+			expectedNextOpen: lo.ToPtr(time.Date(2024, 12, 21, 22, 0, 0, 0, nyLoc)),
+		},
+		{
+			name:             "closed: at end of nightly window",
+			windows:          []domain.MaintenanceWindow{nightly},
+			currentTime:      time.Date(2024, 12, 21, 2, 0, 0, 0, nyLoc),
+			expectedOpen:     false,
+			expectedDevice:   false,
+			expectedNextOpen: lo.ToPtr(time.Date(2024, 12, 21, 22, 0, 0, 0, nyLoc)),
+		},
+		{
+			name:             "closed: nightly window evaluated in its time zone",
+			windows:          []domain.MaintenanceWindow{nightly},
+			currentTime:      time.Date(2024, 12, 20, 22, 30, 0, 0, time.UTC), // 5:30 pm in New York
+			expectedOpen:     false,
+			expectedDevice:   false,
+			expectedNextOpen: lo.ToPtr(time.Date(2024, 12, 20, 22, 0, 0, 0, nyLoc)),
+		},
+		{
+			name:             "open: fixed window for matching device",
+			windows:          []domain.MaintenanceWindow{fixed},
+			deviceLabels:     map[string]string{"site": "factory"},
+			currentTime:      fixedStart.Add(time.Hour),
+			expectedOpen:     true,
+			expectedDevice:   true,
+			expectedNextOpen: nil,
+		},
+		{
+			name:             "closed: fixed window in the past",
+			windows:          []domain.MaintenanceWindow{fixed},
+			deviceLabels:     map[string]string{"site": "factory"},
+			currentTime:      fixedStart.Add(3 * time.Hour),
+			expectedOpen:     false,
+			expectedDevice:   false,
+			expectedNextOpen: nil,
+		},
+		{
+			name:             "unrestricted: no window applies to device",
+			windows:          []domain.MaintenanceWindow{fixed},
+			deviceLabels:     map[string]string{"site": "office"},
+			currentTime:      fixedStart.Add(-time.Hour),
+			expectedOpen:     false,
+			expectedDevice:   true,
+			expectedNextOpen: lo.ToPtr(fixedStart),
+		},
+		{
+			name:             "closed: window without time zone is evaluated in UTC",
+			windows:          []domain.MaintenanceWindow{{At: lo.ToPtr("0 22 * * *"), Duration: lo.ToPtr("1h")}},
+			currentTime:      time.Date(2024, 12, 20, 22, 30, 0, 0, nyLoc),
+			expectedOpen:     false,
+			expectedDevice:   false,
+			expectedNextOpen: lo.ToPtr(time.Date(2024, 12, 21, 22, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:             "open: device matched by an open and a closed window",
+			windows:          []domain.MaintenanceWindow{nightly, fixed},
+			deviceLabels:     map[string]string{"site": "factory"},
+			currentTime:      time.Date(2024, 12, 20, 23, 0, 0, 0, nyLoc),
+			expectedOpen:     true,
+			expectedDevice:   true,
+			expectedNextOpen: lo.ToPtr(time.Date(2024, 12, 21, 22, 0, 0, 0, nyLoc)),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMaintenanceWindows(fleetWithMaintenanceWindows(tt.windows...))
+			require.NoError(err)
+			require.True(m.Defined())
+			require.Equal(tt.expectedOpen, m.IsOpen(tt.currentTime))
+			require.Equal(tt.expectedDevice, m.IsOpenForDevice(tt.deviceLabels, tt.currentTime))
+			next := m.NextOpen(tt.currentTime)
+			if tt.expectedNextOpen == nil {
+				require.Nil(next)
+				return
+			}
+			require.NotNil(next)
+			require.True(tt.expectedNextOpen.Equal(*next), "expected %s, got %s", tt.expectedNextOpen, next)
+		})
+	}
+}
+
+func TestMaintenanceWindowsNotDefined(t *testing.T) {
+	require := require.New(t)
+	m, err := NewMaintenanceWindows(&domain.Fleet{})
+	require.NoError(err)
+	require.False(m.Defined())
+	require.True(m.IsOpen(time.Now()))
+	require.True(m.IsOpenForDevice(map[string]string{"site": "factory"}, time.Now()))
+	require.Nil(m.NextOpen(time.Now()))
+}
@@ -49,6 +49,15 @@ func batchSequenceProgressStage(fleet *domain.Fleet, sequence domain.BatchSequen
 	}
 }
 
+// CanaryAsBatchSequence returns the batch sequence equivalent of a canary strategy: a single explicit batch
+// holding the canary cohort, followed by the final implicit batch that promotes the rest of the fleet.
+func CanaryAsBatchSequence(canary domain.Canary) domain.BatchSequence {
+	return domain.BatchSequence{
+		Strategy: domain.RolloutStrategyBatchSequence,
+		Sequence: &[]domain.Batch{canary.Cohort},
+	}
+}
+
 func ProgressStage(fleet *domain.Fleet) (Stage, error) {
 	if fleet.Spec.RolloutPolicy == nil || fleet.Spec.RolloutPolicy.DeviceSelection == nil {
 		return Inactive, nil
@@ -60,6 +69,8 @@ func ProgressStage(fleet *domain.Fleet) (Stage, error) {
 	switch value := intf.(type) {
 	case domain.BatchSequence:
 		return batchSequenceProgressStage(fleet, value)
+	case domain.Canary:
+		return batchSequenceProgressStage(fleet, CanaryAsBatchSequence(value))
 	default:
 		return Inactive, fmt.Errorf("unexpected type for device selection %T", intf)
 	}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// maxBulkOperationCancelAttempts bounds how often canceling is retried while the workers update the operation's status
+const maxBulkOperationCancelAttempts = 5
+
+func (h *ServiceHandler) CreateBulkOperation(ctx context.Context, orgId uuid.UUID, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	// don't set fields that are managed by the service, except for the scope the transport derived from the
+	// caller's permissions
+	scope, hasScope := lo.FromPtr(operation.Metadata.Annotations)[domain.BulkOperationAnnotationScopeLabelSelectors]
+	operation.Status = nil
+	NilOutManagedObjectMetaProperties(&operation.Metadata)
+	if hasScope {
+		operation.Metadata.Annotations = &map[string]string{domain.BulkOperationAnnotationScopeLabelSelectors: scope}
+	}
+
+	if errs := operation.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	// reject malformed selectors now rather than when the workers pick the operation up
+	if _, status := prepareListParams(nil, operation.Spec.LabelSelector, operation.Spec.FieldSelector, nil); status.Code != domain.StatusOK().Code {
+		return nil, status
+	}
+
+	result, err := h.store.BulkOperation().Create(ctx, orgId, &operation, h.callbackBulkOperationUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.BulkOperationKind, operation.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListBulkOperationsParams) (*domain.BulkOperationList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.BulkOperation().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, domain.Status) {
+	result, err := h.store.BulkOperation().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.BulkOperationKind, &name)
+}
+
+// DeleteBulkOperation deletes the operation. An operation that is still running stops before its next batch of devices.
+func (h *ServiceHandler) DeleteBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.BulkOperation().Delete(ctx, orgId, name, h.callbackBulkOperationDeleted)
+	return StoreErrorToApiStatus(err, false, domain.BulkOperationKind, &name)
+}
+
+// CancelBulkOperation stops a pending or running operation. Devices the workers are processing when the
+// operation is canceled still complete, and their results are recorded.
+func (h *ServiceHandler) CancelBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, domain.Status) {
+	for attempt := 1; ; attempt++ {
+		operation, err := h.store.BulkOperation().Get(ctx, orgId, name)
+		if err != nil {
+			return nil, StoreErrorToApiStatus(err, false, domain.BulkOperationKind, &name)
+		}
+		if operation.Status.Phase != domain.BulkOperationPhasePending && operation.Status.Phase != domain.BulkOperationPhaseRunning {
+			return nil, domain.StatusConflict(fmt.Sprintf("bulk operation %s cannot be canceled in phase %s", name, operation.Status.Phase))
+		}
+
+		operation.Status.Phase = domain.BulkOperationPhaseCanceled
+		operation.Status.Message = lo.ToPtr("Bulk operation was canceled.")
+		operation.Status.CompletedAt = lo.ToPtr(time.Now().UTC())
+		operation.Status.Continue = nil
+		result, err := h.store.BulkOperation().UpdateStatus(ctx, orgId, operation)
+		if errors.Is(err, flterrors.ErrNoRowsUpdated) && attempt < maxBulkOperationCancelAttempts {
+			continue
+		}
+		return result, StoreErrorToApiStatus(err, false, domain.BulkOperationKind, &name)
+	}
+}
+
+// ReplaceBulkOperationStatus records the progress of an operation. The update is rejected with a conflict
+// if the operation changed since it was read, for example because it was canceled in the meantime.
+func (h *ServiceHandler) ReplaceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, name string, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	if name != lo.FromPtr(operation.Metadata.Name) {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, err := h.store.BulkOperation().UpdateStatus(ctx, orgId, &operation)
+	return result, StoreErrorToApiStatus(err, false, domain.BulkOperationKind, &name)
+}
+
+// callbackBulkOperationUpdated is the bulk operation-specific callback that handles bulk operation events
+func (h *ServiceHandler) callbackBulkOperationUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleBulkOperationUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackBulkOperationDeleted is the bulk operation-specific callback that handles bulk operation deletion events
+func (h *ServiceHandler) callbackBulkOperationDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
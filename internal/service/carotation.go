@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// The system info keys the agent reports the key identifiers of the CAs it trusts for the
+// management service and of the CA that issued its management certificate under.
+const (
+	managementCAKeyIdsKey        = "managementCAKeyIds"
+	managementCertIssuerKeyIdKey = "managementCertIssuerKeyId"
+
+	managementCAKeyIdsSelector        = "status.systemInfo." + managementCAKeyIdsKey
+	managementCertIssuerKeyIdSelector = "status.systemInfo." + managementCertIssuerKeyIdKey
+)
+
+// (GET /api/v1/carotation)
+func (h *ServiceHandler) GetCARotationStatus(ctx context.Context, orgId uuid.UUID) (*domain.CARotationStatus, domain.Status) {
+	if h.ca == nil {
+		return nil, domain.StatusNotImplemented("CA rotation is not available")
+	}
+	result := &domain.CARotationStatus{
+		Phase:     domain.CARotationPhaseNone,
+		SigningCA: caCertificateInfo(h.ca.GetCABundleX509()[0]),
+	}
+	rotation := h.ca.Rotation()
+	if rotation == nil {
+		return result, domain.StatusOK()
+	}
+
+	switch {
+	case rotation.Retired:
+		result.Phase = domain.CARotationPhaseRetired
+	case rotation.Switched(time.Now()):
+		result.Phase = domain.CARotationPhaseSwitched
+	default:
+		result.Phase = domain.CARotationPhasePublishing
+	}
+	result.PreviousCA = lo.ToPtr(caCertificateInfo(rotation.Previous))
+	result.NewCA = lo.ToPtr(caCertificateInfo(rotation.Next))
+	result.SwitchTime = lo.ToPtr(rotation.SwitchTime)
+
+	newKeyID := hex.EncodeToString(rotation.Next.SubjectKeyId)
+	devices := domain.CARotationDeviceSummary{}
+	var err error
+	if devices.Total, err = h.countDevicesMatching(ctx, orgId, ""); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to count devices: %v", err))
+	}
+	if devices.TrustingNewCA, err = h.countDevicesMatching(ctx, orgId, fmt.Sprintf("%s contains %s", managementCAKeyIdsSelector, newKeyID)); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to count devices trusting the new CA: %v", err))
+	}
+	if devices.IssuedByNewCA, err = h.countDevicesMatching(ctx, orgId, fmt.Sprintf("%s=%s", managementCertIssuerKeyIdSelector, newKeyID)); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to count devices with certificates of the new CA: %v", err))
+	}
+	result.Devices = &devices
+	return result, domain.StatusOK()
+}
+
+// (GET /api/v1/carotation/devices)
+func (h *ServiceHandler) ListCARotationDevices(ctx context.Context, orgId uuid.UUID, params domain.ListCARotationDevicesParams) (*domain.CARotationDeviceList, domain.Status) {
+	if h.ca == nil {
+		return nil, domain.StatusNotImplemented("CA rotation is not available")
+	}
+	rotation := h.ca.Rotation()
+	if rotation == nil {
+		return nil, domain.StatusConflict("the CA is not being rotated")
+	}
+
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	devices, err := h.store.Device().List(ctx, orgId, *listParams)
+	if err != nil {
+		var se *selector.SelectorError
+		if selector.AsSelectorError(err, &se) {
+			return nil, domain.StatusBadRequest(se.Error())
+		}
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to list devices: %v", err))
+	}
+
+	previousKeyID := hex.EncodeToString(rotation.Previous.SubjectKeyId)
+	newKeyID := hex.EncodeToString(rotation.Next.SubjectKeyId)
+	result := &domain.CARotationDeviceList{
+		Metadata: devices.Metadata,
+		Items:    make([]domain.CARotationDeviceProgress, 0, len(devices.Items)),
+	}
+	for i := range devices.Items {
+		result.Items = append(result.Items, deviceCARotationProgress(&devices.Items[i], previousKeyID, newKeyID))
+	}
+	return result, domain.StatusOK()
+}
+
+// deviceCARotationProgress returns whether the device reports trusting the new CA and which CA issued its
+// management certificate.
+func deviceCARotationProgress(device *domain.Device, previousKeyID, newKeyID string) domain.CARotationDeviceProgress {
+	progress := domain.CARotationDeviceProgress{
+		Name:              lo.FromPtr(device.Metadata.Name),
+		TrustsNewCA:       domain.CARotationDeviceTrustUnknown,
+		CertificateIssuer: domain.CARotationDeviceIssuerUnknown,
+	}
+	if device.Status == nil {
+		return progress
+	}
+	systemInfo := device.Status.SystemInfo
+	if keyIDs, ok := systemInfo.Get(managementCAKeyIdsKey); ok && keyIDs != "" {
+		progress.TrustsNewCA = domain.CARotationDeviceTrustNo
+		if slices.Contains(strings.Split(keyIDs, ","), newKeyID) {
+			progress.TrustsNewCA = domain.CARotationDeviceTrustYes
+		}
+	}
+	if keyID, ok := systemInfo.Get(managementCertIssuerKeyIdKey); ok && keyID != "" {
+		progress.CertificateIssuerKeyId = lo.ToPtr(keyID)
+		switch keyID {
+		case newKeyID:
+			progress.CertificateIssuer = domain.CARotationDeviceIssuerNew
+		case previousKeyID:
+			progress.CertificateIssuer = domain.CARotationDeviceIssuerPrevious
+		default:
+			progress.CertificateIssuer = domain.CARotationDeviceIssuerOther
+		}
+	}
+	return progress
+}
+
+func (h *ServiceHandler) countDevicesMatching(ctx context.Context, orgId uuid.UUID, fieldSelector string) (int64, error) {
+	listParams := store.ListParams{}
+	if fieldSelector != "" {
+		fs, err := selector.NewFieldSelector(fieldSelector)
+		if err != nil {
+			return 0, err
+		}
+		listParams.FieldSelector = fs
+	}
+	return h.store.Device().Count(ctx, orgId, listParams)
+}
+
+func caCertificateInfo(cert *x509.Certificate) domain.CACertificateInfo {
+	return domain.CACertificateInfo{
+		KeyId:     hex.EncodeToString(cert.SubjectKeyId),
+		Subject:   cert.Subject.String(),
+		NotBefore: cert.NotBefore,
+		NotAfter:  cert.NotAfter,
+	}
+}
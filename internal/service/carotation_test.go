@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+// countingDeviceStore returns the given device counts in the order they are requested.
+type countingDeviceStore struct {
+	store.Device
+	counts         []int64
+	fieldSelectors []bool
+	devices        []domain.Device
+}
+
+func (s *countingDeviceStore) Count(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (int64, error) {
+	s.fieldSelectors = append(s.fieldSelectors, listParams.FieldSelector != nil)
+	count := s.counts[0]
+	s.counts = s.counts[1:]
+	return count, nil
+}
+
+func (s *countingDeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.DeviceList, error) {
+	return &domain.DeviceList{Metadata: domain.ListMeta{Continue: lo.ToPtr("next")}, Items: s.devices}, nil
+}
+
+type caRotationTestStore struct {
+	*TestStore
+	devices *countingDeviceStore
+}
+
+func (s *caRotationTestStore) Device() store.Device {
+	return s.devices
+}
+
+func newTestRotatingCA(t *testing.T, gracePeriod time.Duration) *crypto.CAClient {
+	caConfig := &ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        t.TempDir(),
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			SerialFile:       "ca.serial",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+		},
+	}
+	_, _, err := crypto.EnsureCA(caConfig)
+	require.NoError(t, err)
+	caConfig.Rotation = &ca.RotationCfg{
+		CertFile:    "ca-next.crt",
+		KeyFile:     "ca-next.key",
+		GracePeriod: util.Duration(gracePeriod),
+	}
+	caClient, _, err := crypto.EnsureCA(caConfig)
+	require.NoError(t, err)
+	return caClient
+}
+
+func TestGetCARotationStatusWithoutCA(t *testing.T) {
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+	_, status := serviceHandler.GetCARotationStatus(ctx, store.NullOrgId)
+	require.Equal(t, int32(http.StatusNotImplemented), status.Code)
+}
+
+func TestGetCARotationStatusWithoutRotation(t *testing.T) {
+	require := require.New(t)
+	caClient := newTestRevocationCA(t)
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, caClient)
+
+	result, status := serviceHandler.GetCARotationStatus(ctx, store.NullOrgId)
+	require.Equal(int32(http.StatusOK), status.Code)
+	require.Equal(domain.CARotationPhaseNone, result.Phase)
+	require.Equal(caClient.IssuerKeyID(), result.SigningCA.KeyId)
+	require.Nil(result.NewCA)
+	require.Nil(result.Devices)
+}
+
+func TestGetCARotationStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		gracePeriod   time.Duration
+		expectedPhase domain.CARotationPhase
+	}{
+		{
+			name:          "publishing the new CA",
+			gracePeriod:   time.Hour,
+			expectedPhase: domain.CARotationPhasePublishing,
+		},
+		{
+			name:          "switched to the new CA",
+			expectedPhase: domain.CARotationPhaseSwitched,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			caClient := newTestRotatingCA(t, tt.gracePeriod)
+			devices := &countingDeviceStore{counts: []int64{10, 4, 1}}
+			serviceHandler, ctx := newTestServiceHandler(t, &caRotationTestStore{TestStore: &TestStore{}, devices: devices}, caClient)
+
+			result, status := serviceHandler.GetCARotationStatus(ctx, store.NullOrgId)
+			require.Equal(int32(http.StatusOK), status.Code)
+			require.Equal(tt.expectedPhase, result.Phase)
+
+			rotation := caClient.Rotation()
+			require.Equal(hex.EncodeToString(rotation.Previous.SubjectKeyId), result.PreviousCA.KeyId)
+			require.Equal(hex.EncodeToString(rotation.Next.SubjectKeyId), result.NewCA.KeyId)
+			require.Equal(caClient.IssuerKeyID(), result.SigningCA.KeyId)
+			require.Equal(rotation.SwitchTime, *result.SwitchTime)
+			require.Equal(domain.CARotationDeviceSummary{Total: 10, TrustingNewCA: 4, IssuedByNewCA: 1}, *result.Devices)
+			require.Equal([]bool{false, true, true}, devices.fieldSelectors)
+		})
+	}
+}
+
+func TestListCARotationDevicesWithoutRotation(t *testing.T) {
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, newTestRevocationCA(t))
+	_, status := serviceHandler.ListCARotationDevices(ctx, store.NullOrgId, domain.ListCARotationDevicesParams{})
+	require.Equal(t, int32(http.StatusConflict), status.Code)
+}
+
+func TestListCARotationDevices(t *testing.T) {
+	require := require.New(t)
+	caClient := newTestRotatingCA(t, time.Hour)
+	rotation := caClient.Rotation()
+	previousKeyID := hex.EncodeToString(rotation.Previous.SubjectKeyId)
+	newKeyID := hex.EncodeToString(rotation.Next.SubjectKeyId)
+
+	newDevice := func(name string, systemInfo map[string]string) domain.Device {
+		return domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)},
+			Status:   &domain.DeviceStatus{SystemInfo: domain.DeviceSystemInfo{AdditionalProperties: systemInfo}},
+		}
+	}
+	devices := &countingDeviceStore{devices: []domain.Device{
+		{Metadata: domain.ObjectMeta{Name: lo.ToPtr("no-status")}},
+		newDevice("not-reported", nil),
+		newDevice("previous", map[string]string{managementCAKeyIdsKey: previousKeyID, managementCertIssuerKeyIdKey: previousKeyID}),
+		newDevice("both", map[string]string{managementCAKeyIdsKey: previousKeyID + "," + newKeyID, managementCertIssuerKeyIdKey: previousKeyID}),
+		newDevice("new", map[string]string{managementCAKeyIdsKey: newKeyID + "," + previousKeyID, managementCertIssuerKeyIdKey: newKeyID}),
+		newDevice("other", map[string]string{managementCAKeyIdsKey: "cccc", managementCertIssuerKeyIdKey: "cccc"}),
+	}}
+	serviceHandler, ctx := newTestServiceHandler(t, &caRotationTestStore{TestStore: &TestStore{}, devices: devices}, caClient)
+
+	result, status := serviceHandler.ListCARotationDevices(ctx, store.NullOrgId, domain.ListCARotationDevicesParams{})
+	require.Equal(int32(http.StatusOK), status.Code)
+	require.Equal(lo.ToPtr("next"), result.Metadata.Continue)
+	require.Equal([]domain.CARotationDeviceProgress{
+		{Name: "no-status", TrustsNewCA: domain.CARotationDeviceTrustUnknown, CertificateIssuer: domain.CARotationDeviceIssuerUnknown},
+		{Name: "not-reported", TrustsNewCA: domain.CARotationDeviceTrustUnknown, CertificateIssuer: domain.CARotationDeviceIssuerUnknown},
+		{Name: "previous", TrustsNewCA: domain.CARotationDeviceTrustNo, CertificateIssuer: domain.CARotationDeviceIssuerPrevious, CertificateIssuerKeyId: lo.ToPtr(previousKeyID)},
+		{Name: "both", TrustsNewCA: domain.CARotationDeviceTrustYes, CertificateIssuer: domain.CARotationDeviceIssuerPrevious, CertificateIssuerKeyId: lo.ToPtr(previousKeyID)},
+		{Name: "new", TrustsNewCA: domain.CARotationDeviceTrustYes, CertificateIssuer: domain.CARotationDeviceIssuerNew, CertificateIssuerKeyId: lo.ToPtr(newKeyID)},
+		{Name: "other", TrustsNewCA: domain.CARotationDeviceTrustNo, CertificateIssuer: domain.CARotationDeviceIssuerOther, CertificateIssuerKeyId: lo.ToPtr("cccc")},
+	}, result.Items)
+
+	_, status = serviceHandler.ListCARotationDevices(ctx, store.NullOrgId, domain.ListCARotationDevicesParams{Limit: lo.ToPtr(int32(MaxRecordsPerListRequest + 1))})
+	require.Equal(int32(http.StatusBadRequest), status.Code)
+}
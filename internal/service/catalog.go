@@ -207,7 +207,12 @@ func (h *ServiceHandler) CreateCatalogItem(ctx context.Context, orgId uuid.UUID,
 }
 
 func (h *ServiceHandler) ReplaceCatalogItem(ctx context.Context, orgId uuid.UUID, catalogName string, itemName string, item domain.CatalogItem) (*domain.CatalogItem, domain.Status) {
+	// the owner is only set by internal callers, such as the catalog sync
+	owner := item.Metadata.Owner
 	NilOutManagedCatalogItemMetaProperties(&item.Metadata)
+	if IsInternalRequest(ctx) {
+		item.Metadata.Owner = owner
+	}
 
 	if errs := item.Validate(); len(errs) > 0 {
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"golang.org/x/mod/semver"
+)
+
+// InstallCatalogItem adds a version of a CatalogItem to the applications of a fleet's device template, or of a
+// device that is not owned by a fleet, and records the installed version in the catalog annotation of the fleet
+// or device. Installing an item whose application already exists upgrades the application to the new version.
+// The installation fails with a conflict if the fleet or device is modified concurrently.
+func (h *ServiceHandler) InstallCatalogItem(ctx context.Context, orgId uuid.UUID, catalogName string, itemName string, request domain.CatalogItemInstallRequest) (*domain.CatalogItemInstallation, domain.Status) {
+	if errs := request.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	item, status := h.GetCatalogItem(ctx, orgId, catalogName, itemName)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	version, err := selectCatalogItemVersion(item, request.Version)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	installation := domain.CatalogItemInstallation{
+		Catalog:         catalogName,
+		Item:            itemName,
+		Version:         version.Version,
+		ApplicationName: lo.FromPtrOr(request.ApplicationName, itemName),
+		Fleet:           request.Fleet,
+		Device:          request.Device,
+		Deprecation:     CatalogItemInstallationDeprecation(item, version.Version),
+	}
+	app, err := newCatalogItemApplication(item, version, installation.ApplicationName, lo.FromPtr(request.Values))
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	if request.Fleet != nil {
+		status = h.installCatalogItemOnFleet(ctx, orgId, *request.Fleet, app, &installation)
+	} else {
+		status = h.installCatalogItemOnDevice(ctx, orgId, *request.Device, app, &installation)
+	}
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	return &installation, domain.StatusOK()
+}
+
+func (h *ServiceHandler) installCatalogItemOnFleet(ctx context.Context, orgId uuid.UUID, name string, app domain.ApplicationProviderSpec, installation *domain.CatalogItemInstallation) domain.Status {
+	fleet, err := h.store.Fleet().Get(ctx, orgId, name)
+	if err != nil {
+		return StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
+	}
+	if lo.FromPtr(fleet.Metadata.Owner) != "" {
+		return StoreErrorToApiStatus(flterrors.ErrUpdatingResourceWithOwnerNotAllowed, false, domain.FleetKind, &name)
+	}
+	installations, err := GetCatalogItemInstallations(fleet.Metadata)
+	if err != nil {
+		return domain.StatusInternalServerError(err.Error())
+	}
+	installations, upgrade, err := addCatalogItemInstallation(installations, installation)
+	if err != nil {
+		return domain.StatusConflict(err.Error())
+	}
+	apps, err := addCatalogItemApplication(lo.FromPtr(fleet.Spec.Template.Spec.Applications), app, installation.ApplicationName, upgrade)
+	if err != nil {
+		return domain.StatusConflict(err.Error())
+	}
+	fleet.Spec.Template.Spec.Applications = &apps
+	if err = setCatalogItemInstallations(&fleet.Metadata, installations); err != nil {
+		return domain.StatusInternalServerError(err.Error())
+	}
+
+	if errs := fleet.Validate(); len(errs) > 0 {
+		return domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	// The spec and the annotation are written at once, and only if the fleet was not modified since it was read
+	if _, err = h.store.Fleet().Update(ctx, orgId, fleet, nil, false, h.callbackFleetUpdated); err != nil {
+		return StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
+	}
+	return domain.StatusOK()
+}
+
+func (h *ServiceHandler) installCatalogItemOnDevice(ctx context.Context, orgId uuid.UUID, name string, app domain.ApplicationProviderSpec, installation *domain.CatalogItemInstallation) domain.Status {
+	device, err := h.store.Device().Get(ctx, orgId, name)
+	if err != nil {
+		return StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	if owner := lo.FromPtr(device.Metadata.Owner); owner != "" {
+		return domain.StatusConflict(fmt.Sprintf("device %s is owned by %s: install the catalog item on its fleet instead", name, owner))
+	}
+	installations, err := GetCatalogItemInstallations(device.Metadata)
+	if err != nil {
+		return domain.StatusInternalServerError(err.Error())
+	}
+	installations, upgrade, err := addCatalogItemInstallation(installations, installation)
+	if err != nil {
+		return domain.StatusConflict(err.Error())
+	}
+	if device.Spec == nil {
+		device.Spec = &domain.DeviceSpec{}
+	}
+	apps, err := addCatalogItemApplication(lo.FromPtr(device.Spec.Applications), app, installation.ApplicationName, upgrade)
+	if err != nil {
+		return domain.StatusConflict(err.Error())
+	}
+	device.Spec.Applications = &apps
+	if err = setCatalogItemInstallations(&device.Metadata, installations); err != nil {
+		return domain.StatusInternalServerError(err.Error())
+	}
+
+	if errs := device.Validate(); len(errs) > 0 {
+		return domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	// The spec and the annotation are written at once, and only if the device was not modified since it was read
+	if _, err = h.store.Device().Update(ctx, orgId, device, nil, false, DeviceVerificationCallback, h.callbackDeviceUpdated); err != nil {
+		return StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	return domain.StatusOK()
+}
+
+// GetCatalogItemInstallations returns the CatalogItem installations recorded in the annotations of a fleet or device.
+func GetCatalogItemInstallations(metadata domain.ObjectMeta) ([]domain.CatalogItemInstallation, error) {
+	value, exists := lo.FromPtr(metadata.Annotations)[domain.CatalogAnnotationInstalledItems]
+	if !exists || value == "" {
+		return []domain.CatalogItemInstallation{}, nil
+	}
+	var installations []domain.CatalogItemInstallation
+	if err := json.Unmarshal([]byte(value), &installations); err != nil {
+		return nil, fmt.Errorf("failed to parse annotation %s: %w", domain.CatalogAnnotationInstalledItems, err)
+	}
+	return installations, nil
+}
+
+// setCatalogItemInstallations records the CatalogItem installations in the annotations of a fleet or device.
+func setCatalogItemInstallations(metadata *domain.ObjectMeta, installations []domain.CatalogItemInstallation) error {
+	annotation, err := json.Marshal(installations)
+	if err != nil {
+		return err
+	}
+	annotations := lo.FromPtr(metadata.Annotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[domain.CatalogAnnotationInstalledItems] = string(annotation)
+	metadata.Annotations = &annotations
+	return nil
+}
+
+// CatalogItemInstallationDeprecation returns the deprecation of the given version of a CatalogItem, or of the
+// CatalogItem itself. It returns nil if neither is deprecated.
+func CatalogItemInstallationDeprecation(item *domain.CatalogItem, version string) *domain.CatalogItemDeprecation {
+	for _, v := range item.Spec.Versions {
+		if v.Version == version && v.Deprecation != nil {
+			return v.Deprecation
+		}
+	}
+	return item.Spec.Deprecation
+}
+
+// addCatalogItemInstallation adds the installation to the recorded installations, replacing the installation of the
+// same application. The replaced version is set as the previous version of the installation. It returns whether an
+// installation was replaced.
+func addCatalogItemInstallation(installations []domain.CatalogItemInstallation, installation *domain.CatalogItemInstallation) ([]domain.CatalogItemInstallation, bool, error) {
+	recorded := domain.CatalogItemInstallation{
+		Catalog:         installation.Catalog,
+		Item:            installation.Item,
+		Version:         installation.Version,
+		ApplicationName: installation.ApplicationName,
+	}
+	for i, existing := range installations {
+		if existing.ApplicationName != installation.ApplicationName {
+			continue
+		}
+		if existing.Catalog != installation.Catalog || existing.Item != installation.Item {
+			return nil, false, fmt.Errorf("application %s is installed from catalog item %s/%s", existing.ApplicationName, existing.Catalog, existing.Item)
+		}
+		if existing.Version != installation.Version {
+			installation.PreviousVersion = lo.ToPtr(existing.Version)
+		}
+		installations[i] = recorded
+		return installations, true, nil
+	}
+	return append(installations, recorded), false, nil
+}
+
+// addCatalogItemApplication adds the application to the given applications. An existing application of the same
+// name is only replaced when upgrading an installation of the CatalogItem.
+func addCatalogItemApplication(apps []domain.ApplicationProviderSpec, app domain.ApplicationProviderSpec, appName string, upgrade bool) ([]domain.ApplicationProviderSpec, error) {
+	for i := range apps {
+		name, err := apps[i].GetName()
+		if err != nil || lo.FromPtr(name) != appName {
+			continue
+		}
+		if !upgrade {
+			return nil, fmt.Errorf("application %s already exists and was not installed from a catalog", appName)
+		}
+		apps[i] = app
+		return apps, nil
+	}
+	return append(apps, app), nil
+}
+
+// selectCatalogItemVersion returns the requested version of the CatalogItem. If no version is requested, it returns
+// the highest version that is not deprecated.
+func selectCatalogItemVersion(item *domain.CatalogItem, requested *string) (*domain.CatalogItemVersion, error) {
+	if requested != nil && *requested != "" {
+		for i := range item.Spec.Versions {
+			if item.Spec.Versions[i].Version == *requested {
+				return &item.Spec.Versions[i], nil
+			}
+		}
+		return nil, fmt.Errorf("catalog item %s has no version %s", lo.FromPtr(item.Metadata.Name), *requested)
+	}
+
+	var latest *domain.CatalogItemVersion
+	for i := range item.Spec.Versions {
+		v := &item.Spec.Versions[i]
+		if v.Deprecation != nil {
+			continue
+		}
+		if latest == nil || semver.Compare("v"+v.Version, "v"+latest.Version) > 0 {
+			latest = v
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("catalog item %s has no version that is not deprecated: specify the version to install", lo.FromPtr(item.Metadata.Name))
+	}
+	return latest, nil
+}
+
+// newCatalogItemApplication renders the application of a CatalogItem version. The values are merged into the
+// configuration of the version and validated against its configSchema.
+func newCatalogItemApplication(item *domain.CatalogItem, version *domain.CatalogItemVersion, appName string, values map[string]any) (domain.ApplicationProviderSpec, error) {
+	var app domain.ApplicationProviderSpec
+
+	var appType domain.AppType
+	switch item.Spec.Type {
+	case domain.CatalogItemTypeContainer:
+		appType = domain.AppTypeContainer
+	case domain.CatalogItemTypeHelm:
+		appType = domain.AppTypeHelm
+	case domain.CatalogItemTypeQuadlet:
+		appType = domain.AppTypeQuadlet
+	case domain.CatalogItemTypeCompose:
+		appType = domain.AppTypeCompose
+	default:
+		return app, fmt.Errorf("catalog items of type %s cannot be installed as an application", item.Spec.Type)
+	}
+
+	config, configSchema := catalogItemVersionConfig(item, version)
+	config, err := mergeCatalogItemValues(config, values)
+	if err != nil {
+		return app, err
+	}
+	if errs := item.ValidateInstallConfig(config, configSchema); len(errs) > 0 {
+		return app, errors.Join(errs...)
+	}
+
+	spec := lo.Assign(config, map[string]any{
+		"name":    appName,
+		"appType": appType,
+		"image":   catalogItemImage(item, version),
+	})
+	specBytes, err := json.Marshal(spec)
+	if err != nil {
+		return app, err
+	}
+	if err := json.Unmarshal(specBytes, &app); err != nil {
+		return app, fmt.Errorf("invalid application configuration: %w", err)
+	}
+	return app, nil
+}
+
+// catalogItemVersionConfig returns the config and configSchema of a version. Fields that are set on the version
+// replace the item's defaults.
+func catalogItemVersionConfig(item *domain.CatalogItem, version *domain.CatalogItemVersion) (map[string]any, *map[string]any) {
+	config := version.Config
+	configSchema := version.ConfigSchema
+	if item.Spec.Defaults != nil {
+		if config == nil {
+			config = item.Spec.Defaults.Config
+		}
+		if configSchema == nil {
+			configSchema = item.Spec.Defaults.ConfigSchema
+		}
+	}
+	return lo.FromPtr(config), configSchema
+}
+
+// mergeCatalogItemValues returns a copy of the config with the values merged in. Nested objects are merged,
+// all other values replace those of the config.
+func mergeCatalogItemValues(config map[string]any, values map[string]any) (map[string]any, error) {
+	merged := map[string]any{}
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if err := json.Unmarshal(configBytes, &merged); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	valuesBytes, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("invalid values: %w", err)
+	}
+	overrides := map[string]any{}
+	if err := json.Unmarshal(valuesBytes, &overrides); err != nil {
+		return nil, fmt.Errorf("invalid values: %w", err)
+	}
+	deepMergeValues(merged, overrides)
+	return merged, nil
+}
+
+func deepMergeValues(dst map[string]any, src map[string]any) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]any)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+		if srcIsMap && dstIsMap {
+			deepMergeValues(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+}
+
+// catalogItemImage returns the image reference of a version of a CatalogItem.
+func catalogItemImage(item *domain.CatalogItem, version *domain.CatalogItemVersion) string {
+	uri := strings.TrimPrefix(item.Spec.Reference.Uri, "oci://")
+	if digest := lo.FromPtr(version.Digest); digest != "" {
+		return uri + "@" + digest
+	}
+	return uri + ":" + lo.FromPtr(version.Tag)
+}
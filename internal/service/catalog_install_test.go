@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestInstallCatalogItem() domain.CatalogItem {
+	return domain.CatalogItem{
+		ApiVersion: "flightctl.io/v1alpha1",
+		Kind:       domain.CatalogItemKind,
+		Metadata: domain.CatalogItemMeta{
+			Name:    lo.ToPtr("prometheus"),
+			Catalog: "monitoring",
+		},
+		Spec: domain.CatalogItemSpec{
+			Category:  lo.ToPtr(domain.CatalogItemCategoryApplication),
+			Type:      domain.CatalogItemTypeContainer,
+			Reference: domain.CatalogItemReference{Uri: "oci://quay.io/prometheus/prometheus"},
+			Versions: []domain.CatalogItemVersion{
+				{Version: "2.44.0", Tag: lo.ToPtr("v2.44.0"), Channels: []string{"stable"}},
+				{Version: "2.45.0", Tag: lo.ToPtr("v2.45.0"), Channels: []string{"stable"}},
+				{Version: "2.46.0", Tag: lo.ToPtr("v2.46.0"), Channels: []string{"stable"}, Deprecation: &domain.CatalogItemDeprecation{Message: "broken"}},
+			},
+			Defaults: &domain.CatalogItemConfigurable{
+				Config: &map[string]any{
+					"envVars": map[string]any{"RETENTION": "15d"},
+				},
+				ConfigSchema: &map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"envVars": map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"RETENTION": map[string]any{"type": "string", "pattern": "^[0-9]+[dhm]$"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSelectCatalogItemVersion(t *testing.T) {
+	item := newTestInstallCatalogItem()
+
+	tests := []struct {
+		name          string
+		requested     *string
+		wantVersion   string
+		errorContains string
+	}{
+		{
+			name:        "highest version that is not deprecated",
+			wantVersion: "2.45.0",
+		},
+		{
+			name:        "requested deprecated version",
+			requested:   lo.ToPtr("2.46.0"),
+			wantVersion: "2.46.0",
+		},
+		{
+			name:          "requested version does not exist",
+			requested:     lo.ToPtr("3.0.0"),
+			errorContains: "has no version 3.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := selectCatalogItemVersion(&item, tt.requested)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantVersion, version.Version)
+		})
+	}
+
+	t.Run("all versions deprecated", func(t *testing.T) {
+		deprecated := newTestInstallCatalogItem()
+		for i := range deprecated.Spec.Versions {
+			deprecated.Spec.Versions[i].Deprecation = &domain.CatalogItemDeprecation{Message: "old"}
+		}
+		_, err := selectCatalogItemVersion(&deprecated, nil)
+		require.ErrorContains(t, err, "specify the version to install")
+	})
+}
+
+func TestNewCatalogItemApplication(t *testing.T) {
+	item := newTestInstallCatalogItem()
+	version := &item.Spec.Versions[1]
+
+	t.Run("values are merged into the defaults", func(t *testing.T) {
+		app, err := newCatalogItemApplication(&item, version, "metrics", map[string]any{
+			"envVars": map[string]any{"RETENTION": "30d", "LOG_LEVEL": "debug"},
+		})
+		require.NoError(t, err)
+		container, err := app.AsContainerApplication()
+		require.NoError(t, err)
+		require.Equal(t, "metrics", lo.FromPtr(container.Name))
+		require.Equal(t, "quay.io/prometheus/prometheus:v2.45.0", container.Image)
+		require.Equal(t, map[string]string{"RETENTION": "30d", "LOG_LEVEL": "debug"}, lo.FromPtr(container.EnvVars))
+	})
+
+	t.Run("digest takes precedence over tag", func(t *testing.T) {
+		digestVersion := *version
+		digestVersion.Digest = lo.ToPtr("sha256:abc")
+		app, err := newCatalogItemApplication(&item, &digestVersion, "metrics", nil)
+		require.NoError(t, err)
+		container, err := app.AsContainerApplication()
+		require.NoError(t, err)
+		require.Equal(t, "quay.io/prometheus/prometheus@sha256:abc", container.Image)
+	})
+
+	t.Run("values must match the config schema", func(t *testing.T) {
+		_, err := newCatalogItemApplication(&item, version, "metrics", map[string]any{
+			"envVars": map[string]any{"RETENTION": "forever"},
+		})
+		require.ErrorContains(t, err, "values do not match the configSchema")
+	})
+
+	t.Run("item type must be an application type", func(t *testing.T) {
+		osItem := newTestInstallCatalogItem()
+		osItem.Spec.Type = domain.CatalogItemTypeOS
+		_, err := newCatalogItemApplication(&osItem, version, "metrics", nil)
+		require.ErrorContains(t, err, "cannot be installed as an application")
+	})
+}
+
+func TestInstallCatalogItemOnFleet(t *testing.T) {
+	require := require.New(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, nil)
+	orgId := store.NullOrgId
+
+	*testStore.Catalog().(*DummyCatalog).catalogItems = []domain.CatalogItem{newTestInstallCatalogItem()}
+	fleet := prepareFleet("edge")
+	fleet.Status = nil
+	_, err := testStore.Fleet().Create(ctx, orgId, &fleet, nil)
+	require.NoError(err)
+
+	installation, status := serviceHandler.InstallCatalogItem(ctx, orgId, "monitoring", "prometheus", domain.CatalogItemInstallRequest{
+		Fleet:   lo.ToPtr("edge"),
+		Version: lo.ToPtr("2.44.0"),
+	})
+	require.Equal(domain.StatusOK(), status)
+	require.Equal("2.44.0", installation.Version)
+	require.Equal("prometheus", installation.ApplicationName)
+	require.Nil(installation.PreviousVersion)
+	require.Nil(installation.Deprecation)
+
+	updated, err := testStore.Fleet().Get(ctx, orgId, "edge")
+	require.NoError(err)
+	require.Len(lo.FromPtr(updated.Spec.Template.Spec.Applications), 1)
+	installations, err := GetCatalogItemInstallations(updated.Metadata)
+	require.NoError(err)
+	require.Equal([]domain.CatalogItemInstallation{{Catalog: "monitoring", Item: "prometheus", Version: "2.44.0", ApplicationName: "prometheus"}}, installations)
+
+	// Installing the item again upgrades the application
+	installation, status = serviceHandler.InstallCatalogItem(ctx, orgId, "monitoring", "prometheus", domain.CatalogItemInstallRequest{
+		Fleet:   lo.ToPtr("edge"),
+		Version: lo.ToPtr("2.46.0"),
+	})
+	require.Equal(domain.StatusOK(), status)
+	require.Equal("2.44.0", lo.FromPtr(installation.PreviousVersion))
+	require.NotNil(installation.Deprecation)
+
+	updated, err = testStore.Fleet().Get(ctx, orgId, "edge")
+	require.NoError(err)
+	apps := lo.FromPtr(updated.Spec.Template.Spec.Applications)
+	require.Len(apps, 1)
+	container, err := apps[0].AsContainerApplication()
+	require.NoError(err)
+	require.Equal("quay.io/prometheus/prometheus:v2.46.0", container.Image)
+
+	// An application that was not installed from a catalog is not replaced
+	require.NoError(testStore.Fleet().UpdateAnnotations(ctx, orgId, "edge", nil, []string{domain.CatalogAnnotationInstalledItems}, nil))
+	_, status = serviceHandler.InstallCatalogItem(ctx, orgId, "monitoring", "prometheus", domain.CatalogItemInstallRequest{
+		Fleet: lo.ToPtr("edge"),
+	})
+	require.Equal(int32(http.StatusConflict), status.Code)
+
+	// Missing catalog items and fleets are reported as not found
+	_, status = serviceHandler.InstallCatalogItem(ctx, orgId, "monitoring", "grafana", domain.CatalogItemInstallRequest{Fleet: lo.ToPtr("edge")})
+	require.Equal(statusNotFoundCode, status.Code)
+	_, status = serviceHandler.InstallCatalogItem(ctx, orgId, "monitoring", "prometheus", domain.CatalogItemInstallRequest{Fleet: lo.ToPtr("missing")})
+	require.Equal(statusNotFoundCode, status.Code)
+}
+
+// concurrentFleetStore bumps the resource version of a fleet each time it is read, as if it was updated by
+// someone else right after
+type concurrentFleetStore struct {
+	*DummyFleet
+}
+
+func (s *concurrentFleetStore) Get(ctx context.Context, orgId uuid.UUID, name string, options ...store.GetOption) (*domain.Fleet, error) {
+	fleet, err := s.DummyFleet.Get(ctx, orgId, name, options...)
+	if err != nil {
+		return nil, err
+	}
+	resourceVersion, err := strconv.ParseInt(lo.FromPtr(fleet.Metadata.ResourceVersion), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	for i := range *s.fleets {
+		if lo.FromPtr((*s.fleets)[i].Metadata.Name) == name {
+			(*s.fleets)[i].Metadata.ResourceVersion = lo.ToPtr(strconv.FormatInt(resourceVersion+1, 10))
+		}
+	}
+	return fleet, nil
+}
+
+type concurrentFleetTestStore struct {
+	*TestStore
+}
+
+func (s *concurrentFleetTestStore) Fleet() store.Fleet {
+	return &concurrentFleetStore{DummyFleet: s.TestStore.Fleet().(*DummyFleet)}
+}
+
+func TestInstallCatalogItemOnFleetConflict(t *testing.T) {
+	require := require.New(t)
+	testStore := &TestStore{}
+	orgId := store.NullOrgId
+
+	*testStore.Catalog().(*DummyCatalog).catalogItems = []domain.CatalogItem{newTestInstallCatalogItem()}
+	fleet := prepareFleet("edge")
+	fleet.Status = nil
+	fleet.Metadata.ResourceVersion = lo.ToPtr("1")
+	_, err := testStore.Fleet().Create(context.Background(), orgId, &fleet, nil)
+	require.NoError(err)
+	owned := prepareFleet("synced")
+	owned.Status = nil
+	owned.Metadata.Owner = lo.ToPtr("ResourceSync/git")
+	_, err = testStore.Fleet().Create(context.Background(), orgId, &owned, nil)
+	require.NoError(err)
+
+	// The fleet is modified between reading and updating it
+	serviceHandler, ctx := newTestServiceHandler(t, &concurrentFleetTestStore{TestStore: testStore}, nil)
+	_, status := serviceHandler.InstallCatalogItem(ctx, orgId, "monitoring", "prometheus", domain.CatalogItemInstallRequest{
+		Fleet: lo.ToPtr("edge"),
+	})
+	require.Equal(int32(http.StatusConflict), status.Code)
+
+	unchanged, err := testStore.Fleet().Get(ctx, orgId, "edge")
+	require.NoError(err)
+	require.Empty(lo.FromPtr(unchanged.Spec.Template.Spec.Applications))
+	require.NotContains(lo.FromPtr(unchanged.Metadata.Annotations), domain.CatalogAnnotationInstalledItems)
+
+	// Fleets managed by a resource sync are not modified
+	serviceHandler, ctx = newTestServiceHandler(t, testStore, nil)
+	_, status = serviceHandler.InstallCatalogItem(ctx, orgId, "monitoring", "prometheus", domain.CatalogItemInstallRequest{
+		Fleet: lo.ToPtr("synced"),
+	})
+	require.Equal(int32(http.StatusConflict), status.Code)
+}
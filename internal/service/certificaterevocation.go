@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// CertificateRevocationListValidity is how long a CRL served by the API is valid for.
+const CertificateRevocationListValidity = time.Hour
+
+// CRL reason codes as defined in RFC 5280 section 5.3.1
+var crlReasonCodes = map[domain.CertificateRevocationReason]int{
+	domain.CertificateRevocationReasonUnspecified:          0,
+	domain.CertificateRevocationReasonKeyCompromise:        1,
+	domain.CertificateRevocationReasonSuperseded:           4,
+	domain.CertificateRevocationReasonCessationOfOperation: 5,
+}
+
+// (POST /api/v1/revokedcertificates)
+func (h *ServiceHandler) RevokeCertificates(ctx context.Context, orgId uuid.UUID, request domain.CertificateRevocationRequest) (*domain.CertificateRevocationResponse, domain.Status) {
+	serialNumber := lo.FromPtr(request.SerialNumber)
+	deviceName := lo.FromPtr(request.DeviceName)
+	if (serialNumber == "") == (deviceName == "") {
+		return nil, domain.StatusBadRequest("exactly one of serialNumber and deviceName must be specified")
+	}
+	reason := lo.FromPtrOr(request.Reason, domain.CertificateRevocationReasonUnspecified)
+	if _, ok := crlReasonCodes[reason]; !ok {
+		return nil, domain.StatusBadRequest(fmt.Sprintf("unsupported revocation reason %q", reason))
+	}
+
+	var (
+		certs []*x509.Certificate
+		err   error
+	)
+	if deviceName != "" {
+		certs, err = h.deviceManagementCertificates(ctx, orgId, deviceName)
+		if err != nil {
+			return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to find certificates of device %s: %v", deviceName, err))
+		}
+		if len(certs) == 0 {
+			if _, err := h.store.Device().Get(ctx, orgId, deviceName); err != nil {
+				return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &deviceName)
+			}
+		}
+	} else {
+		normalized, err := crypto.NormalizeSerialNumber(serialNumber)
+		if err != nil {
+			return nil, domain.StatusBadRequest(err.Error())
+		}
+		cert, err := h.findIssuedCertificate(ctx, orgId, normalized)
+		if err != nil {
+			return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to find certificate %s: %v", normalized, err))
+		}
+		if cert == nil {
+			return nil, domain.StatusResourceNotFound("Certificate", normalized)
+		}
+		certs = []*x509.Certificate{cert}
+	}
+
+	revoked, err := h.revokeCertificates(ctx, orgId, certs, deviceName, reason)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to revoke certificates: %v", err))
+	}
+	return &domain.CertificateRevocationResponse{RevokedCertificates: revoked}, domain.StatusOK()
+}
+
+// (GET /api/v1/revokedcertificates/crl)
+func (h *ServiceHandler) GetCertificateRevocationList(ctx context.Context, orgId uuid.UUID, params domain.GetCertificateRevocationListParams) ([]byte, domain.Status) {
+	if h.ca == nil {
+		return nil, domain.StatusNotImplemented("certificate revocation lists are not available")
+	}
+	// The CRL is the CA's and so covers the certificates of all organizations.  During a CA rotation, the
+	// certificates issued by both CAs are trusted, and each CA publishes the revocations of its own certificates.
+	issuerKeyID := lo.FromPtrOr(params.IssuerKeyId, h.ca.IssuerKeyID())
+	if !slices.Contains(h.ca.IssuerKeyIDs(), issuerKeyID) {
+		return nil, domain.StatusResourceNotFound("CA", issuerKeyID)
+	}
+	revoked, err := h.store.RevokedCertificate().ListByIssuer(ctx, issuerKeyID, time.Now())
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+
+	entries := make([]x509.RevocationListEntry, 0, len(revoked))
+	for _, r := range revoked {
+		serial, ok := new(big.Int).SetString(r.SerialNumber, 16)
+		if !ok {
+			h.log.Warnf("skipping revoked certificate with invalid serial number %q", r.SerialNumber)
+			continue
+		}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: r.RevokedAt,
+			ReasonCode:     crlReasonCodes[r.Reason],
+		})
+	}
+
+	crl, err := h.ca.CreateRevocationList(ctx, issuerKeyID, entries, CertificateRevocationListValidity)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to create certificate revocation list: %v", err))
+	}
+	return crl, domain.StatusOK()
+}
+
+// revokeDeviceCertificates revokes all management certificates issued to a device.
+func (h *ServiceHandler) revokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, reason domain.CertificateRevocationReason) error {
+	certs, err := h.deviceManagementCertificates(ctx, orgId, name)
+	if err != nil {
+		return err
+	}
+	revoked, err := h.revokeCertificates(ctx, orgId, certs, name, reason)
+	if err != nil {
+		return err
+	}
+	if len(revoked) > 0 {
+		h.log.Infof("revoked %d certificate(s) of device %s: %s", len(revoked), name, reason)
+	}
+	return nil
+}
+
+func (h *ServiceHandler) revokeCertificates(ctx context.Context, orgId uuid.UUID, certs []*x509.Certificate, deviceName string, reason domain.CertificateRevocationReason) ([]domain.RevokedCertificate, error) {
+	now := time.Now()
+	revoked := make([]domain.RevokedCertificate, 0, len(certs))
+	for _, cert := range certs {
+		issuerKeyID, serialNumber := crypto.RevocationKeyFromCert(cert)
+		revoked = append(revoked, domain.RevokedCertificate{
+			IssuerKeyId:  issuerKeyID,
+			SerialNumber: serialNumber,
+			CommonName:   lo.EmptyableToPtr(cert.Subject.CommonName),
+			DeviceName:   lo.EmptyableToPtr(deviceName),
+			Reason:       reason,
+			RevokedAt:    now,
+			ExpiresAt:    lo.ToPtr(cert.NotAfter),
+		})
+	}
+	return h.store.RevokedCertificate().Create(ctx, orgId, revoked)
+}
+
+// deviceManagementCertificates returns the certificates issued to a device: the one issued when its
+// EnrollmentRequest was approved and those issued for the renewal CertificateSigningRequests it owns.
+func (h *ServiceHandler) deviceManagementCertificates(ctx context.Context, orgId uuid.UUID, name string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	er, err := h.store.EnrollmentRequest().Get(ctx, orgId, name)
+	if err != nil && !errors.Is(err, flterrors.ErrResourceNotFound) {
+		return nil, fmt.Errorf("getting enrollment request: %w", err)
+	}
+	if er != nil && er.Status != nil && lo.FromPtr(er.Status.Certificate) != "" {
+		cert, err := fccrypto.ParseCertificatePEM([]byte(*er.Status.Certificate))
+		if err != nil {
+			h.log.Warnf("skipping unparsable certificate of enrollment request %s: %v", name, err)
+		} else {
+			certs = append(certs, cert)
+		}
+	}
+
+	params := domain.ListCertificateSigningRequestsParams{
+		Limit:         lo.ToPtr(int32(MaxRecordsPerListRequest)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", util.ResourceOwner(domain.DeviceKind, name))),
+	}
+	err = h.forEachCertificateSigningRequestCertificate(ctx, orgId, params, func(cert *x509.Certificate) bool {
+		certs = append(certs, cert)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+// findIssuedCertificate looks for a certificate with the given serial number among the certificates
+// issued for the organization's EnrollmentRequests and CertificateSigningRequests.
+func (h *ServiceHandler) findIssuedCertificate(ctx context.Context, orgId uuid.UUID, serialNumber string) (*x509.Certificate, error) {
+	var found *x509.Certificate
+	match := func(cert *x509.Certificate) bool {
+		if _, serial := crypto.RevocationKeyFromCert(cert); serial == serialNumber {
+			found = cert
+			return false
+		}
+		return true
+	}
+
+	erParams := domain.ListEnrollmentRequestsParams{Limit: lo.ToPtr(int32(MaxRecordsPerListRequest))}
+	for {
+		ers, status := h.ListEnrollmentRequests(ctx, orgId, erParams)
+		if err := ApiStatusToErr(status); err != nil {
+			return nil, fmt.Errorf("listing enrollment requests: %w", err)
+		}
+		for _, er := range ers.Items {
+			if er.Status == nil || lo.FromPtr(er.Status.Certificate) == "" {
+				continue
+			}
+			cert, err := fccrypto.ParseCertificatePEM([]byte(*er.Status.Certificate))
+			if err != nil {
+				h.log.Warnf("skipping unparsable certificate of enrollment request %s: %v", lo.FromPtr(er.Metadata.Name), err)
+				continue
+			}
+			if !match(cert) {
+				return found, nil
+			}
+		}
+		if ers.Metadata.Continue == nil {
+			break
+		}
+		erParams.Continue = ers.Metadata.Continue
+	}
+
+	csrParams := domain.ListCertificateSigningRequestsParams{Limit: lo.ToPtr(int32(MaxRecordsPerListRequest))}
+	if err := h.forEachCertificateSigningRequestCertificate(ctx, orgId, csrParams, match); err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// forEachCertificateSigningRequestCertificate calls fn with the issued certificate of every CertificateSigningRequest
+// matching params until fn returns false.
+func (h *ServiceHandler) forEachCertificateSigningRequestCertificate(ctx context.Context, orgId uuid.UUID, params domain.ListCertificateSigningRequestsParams, fn func(*x509.Certificate) bool) error {
+	for {
+		csrs, status := h.ListCertificateSigningRequests(ctx, orgId, params)
+		if err := ApiStatusToErr(status); err != nil {
+			return fmt.Errorf("listing certificate signing requests: %w", err)
+		}
+		for _, csr := range csrs.Items {
+			if csr.Status == nil || len(lo.FromPtr(csr.Status.Certificate)) == 0 {
+				continue
+			}
+			cert, err := fccrypto.ParseCertificatePEM(*csr.Status.Certificate)
+			if err != nil {
+				h.log.Warnf("skipping unparsable certificate of certificate signing request %s: %v", lo.FromPtr(csr.Metadata.Name), err)
+				continue
+			}
+			if !fn(cert) {
+				return nil
+			}
+		}
+		if csrs.Metadata.Continue == nil {
+			return nil
+		}
+		params.Continue = csrs.Metadata.Continue
+	}
+}
@@ -0,0 +1,367 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRevocationCA(t *testing.T) *crypto.CAClient {
+	caClient, _, err := crypto.EnsureCA(newTestRevocationCAConfig(t))
+	require.NoError(t, err)
+	return caClient
+}
+
+func newTestRevocationCAConfig(t *testing.T) *ca.Config {
+	return &ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        t.TempDir(),
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			SerialFile:       "ca.serial",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+		},
+		DeviceManagementSignerName: "device-enrollment",
+	}
+}
+
+func issueTestCertificate(t *testing.T, caClient *crypto.CAClient, commonName string) (*x509.Certificate, []byte) {
+	require := require.New(t)
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: commonName},
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}, privateKey)
+	require.NoError(err)
+	csr, err := x509.ParseCertificateRequest(csrBytes)
+	require.NoError(err)
+	cert, err := caClient.IssueRequestedClientCertificate(t.Context(), csr, 3600)
+	require.NoError(err)
+	return cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+func createTestDeviceWithCertificates(t *testing.T, testStore *TestStore, caClient *crypto.CAClient, orgId uuid.UUID, name string) (*x509.Certificate, *x509.Certificate) {
+	require := require.New(t)
+	ctx := t.Context()
+
+	_, err := testStore.Device().Create(ctx, orgId, &domain.Device{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Status:   lo.ToPtr(domain.NewDeviceStatus()),
+	}, nil)
+	require.NoError(err)
+
+	enrollmentCert, enrollmentPEM := issueTestCertificate(t, caClient, name)
+	_, err = testStore.EnrollmentRequest().Create(ctx, orgId, &domain.EnrollmentRequest{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Status:   &domain.EnrollmentRequestStatus{Certificate: lo.ToPtr(string(enrollmentPEM))},
+	}, nil)
+	require.NoError(err)
+
+	renewedCert, renewedPEM := issueTestCertificate(t, caClient, name)
+	*testStore.csrs.csrs = append(*testStore.csrs.csrs, domain.CertificateSigningRequest{
+		Metadata: domain.ObjectMeta{
+			Name:  lo.ToPtr(name + "-renewal"),
+			Owner: util.SetResourceOwner(domain.DeviceKind, name),
+		},
+		Status: &domain.CertificateSigningRequestStatus{Certificate: lo.ToPtr(renewedPEM)},
+	})
+	return enrollmentCert, renewedCert
+}
+
+func TestRevokeCertificatesValidation(t *testing.T) {
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+
+	testCases := []struct {
+		name    string
+		request domain.CertificateRevocationRequest
+	}{
+		{
+			name:    "neither serial number nor device",
+			request: domain.CertificateRevocationRequest{},
+		},
+		{
+			name: "both serial number and device",
+			request: domain.CertificateRevocationRequest{
+				SerialNumber: lo.ToPtr("1a2b"),
+				DeviceName:   lo.ToPtr("mydevice"),
+			},
+		},
+		{
+			name: "unsupported reason",
+			request: domain.CertificateRevocationRequest{
+				DeviceName: lo.ToPtr("mydevice"),
+				Reason:     lo.ToPtr(domain.CertificateRevocationReason("Bored")),
+			},
+		},
+		{
+			name: "invalid serial number",
+			request: domain.CertificateRevocationRequest{
+				SerialNumber: lo.ToPtr("not-hex"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, tc.request)
+			require.Equal(t, statusBadRequestCode, status.Code)
+		})
+	}
+}
+
+func TestRevokeCertificatesUnknownDevice(t *testing.T) {
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, newTestRevocationCA(t))
+
+	_, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{
+		DeviceName: lo.ToPtr("missing"),
+	})
+	require.Equal(t, statusNotFoundCode, status.Code)
+}
+
+func TestRevokeCertificatesByDevice(t *testing.T) {
+	require := require.New(t)
+	caClient := newTestRevocationCA(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	enrollmentCert, renewedCert := createTestDeviceWithCertificates(t, testStore, caClient, store.NullOrgId, "mydevice")
+
+	resp, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{
+		DeviceName: lo.ToPtr("mydevice"),
+		Reason:     lo.ToPtr(domain.CertificateRevocationReasonKeyCompromise),
+	})
+	require.Equal(domain.StatusOK(), status)
+	require.Len(resp.RevokedCertificates, 2)
+	for _, revoked := range resp.RevokedCertificates {
+		require.Equal(caClient.IssuerKeyID(), revoked.IssuerKeyId)
+		require.Equal("mydevice", lo.FromPtr(revoked.DeviceName))
+		require.Equal(domain.CertificateRevocationReasonKeyCompromise, revoked.Reason)
+	}
+
+	for _, cert := range []*x509.Certificate{enrollmentCert, renewedCert} {
+		issuerKeyID, serialNumber := crypto.RevocationKeyFromCert(cert)
+		revoked, err := testStore.RevokedCertificate().IsRevoked(ctx, issuerKeyID, serialNumber)
+		require.NoError(err)
+		require.True(revoked)
+	}
+}
+
+func TestRevokeCertificatesBySerialNumber(t *testing.T) {
+	require := require.New(t)
+	caClient := newTestRevocationCA(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	enrollmentCert, renewedCert := createTestDeviceWithCertificates(t, testStore, caClient, store.NullOrgId, "mydevice")
+
+	// serial numbers are accepted in the colon-separated form printed by openssl
+	formatted := strings.ReplaceAll(fmt.Sprintf("% X", renewedCert.SerialNumber.Bytes()), " ", ":")
+
+	resp, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{
+		SerialNumber: lo.ToPtr(formatted),
+	})
+	require.Equal(domain.StatusOK(), status)
+	require.Len(resp.RevokedCertificates, 1)
+	require.Equal(renewedCert.SerialNumber.Text(16), resp.RevokedCertificates[0].SerialNumber)
+	require.Equal(domain.CertificateRevocationReasonUnspecified, resp.RevokedCertificates[0].Reason)
+
+	issuerKeyID, serialNumber := crypto.RevocationKeyFromCert(enrollmentCert)
+	revoked, err := testStore.RevokedCertificate().IsRevoked(ctx, issuerKeyID, serialNumber)
+	require.NoError(err)
+	require.False(revoked)
+
+	_, status = serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{
+		SerialNumber: lo.ToPtr("deadbeef"),
+	})
+	require.Equal(statusNotFoundCode, status.Code)
+}
+
+func TestGetCertificateRevocationList(t *testing.T) {
+	require := require.New(t)
+	caClient := newTestRevocationCA(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	enrollmentCert, renewedCert := createTestDeviceWithCertificates(t, testStore, caClient, store.NullOrgId, "mydevice")
+
+	_, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{
+		SerialNumber: lo.ToPtr(renewedCert.SerialNumber.Text(16)),
+		Reason:       lo.ToPtr(domain.CertificateRevocationReasonSuperseded),
+	})
+	require.Equal(domain.StatusOK(), status)
+
+	der, status := serviceHandler.GetCertificateRevocationList(ctx, store.NullOrgId, domain.GetCertificateRevocationListParams{})
+	require.Equal(domain.StatusOK(), status)
+	crl, err := x509.ParseRevocationList(der)
+	require.NoError(err)
+	require.NoError(crl.CheckSignatureFrom(caClient.GetCABundleX509()[0]))
+	require.Len(crl.RevokedCertificateEntries, 1)
+	require.Equal(0, crl.RevokedCertificateEntries[0].SerialNumber.Cmp(renewedCert.SerialNumber))
+	require.Equal(4, crl.RevokedCertificateEntries[0].ReasonCode)
+	require.NotEqual(0, crl.RevokedCertificateEntries[0].SerialNumber.Cmp(enrollmentCert.SerialNumber))
+}
+
+func TestGetCertificateRevocationListDuringCARotation(t *testing.T) {
+	require := require.New(t)
+	caConfig := newTestRevocationCAConfig(t)
+	previousClient, _, err := crypto.EnsureCA(caConfig)
+	require.NoError(err)
+	testStore := &TestStore{}
+	_, previousCert := createTestDeviceWithCertificates(t, testStore, previousClient, store.NullOrgId, "mydevice")
+
+	// The new CA signs right away, while certificates of the previous CA stay trusted
+	caConfig.Rotation = &ca.RotationCfg{CertFile: "ca-next.crt", KeyFile: "ca-next.key"}
+	caClient, _, err := crypto.EnsureCA(caConfig)
+	require.NoError(err)
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	_, nextCert := createTestDeviceWithCertificates(t, testStore, caClient, store.NullOrgId, "otherdevice")
+	require.NotEqual(previousClient.IssuerKeyID(), caClient.IssuerKeyID())
+
+	for _, deviceName := range []string{"mydevice", "otherdevice"} {
+		_, status := serviceHandler.RevokeCertificates(ctx, store.NullOrgId, domain.CertificateRevocationRequest{
+			DeviceName: lo.ToPtr(deviceName),
+		})
+		require.Equal(domain.StatusOK(), status)
+	}
+
+	// Each CA publishes a CRL of its own certificates, signed by itself
+	crlOf := func(serviceHandler *ServiceHandler, issuer *x509.Certificate) *x509.RevocationList {
+		der, status := serviceHandler.GetCertificateRevocationList(ctx, store.NullOrgId, domain.GetCertificateRevocationListParams{
+			IssuerKeyId: lo.ToPtr(hex.EncodeToString(issuer.SubjectKeyId)),
+		})
+		require.Equal(domain.StatusOK(), status)
+		crl, err := x509.ParseRevocationList(der)
+		require.NoError(err)
+		require.NoError(crl.CheckSignatureFrom(issuer))
+		return crl
+	}
+	serialsOf := func(crl *x509.RevocationList) []string {
+		return lo.Map(crl.RevokedCertificateEntries, func(e x509.RevocationListEntry, _ int) string { return e.SerialNumber.Text(16) })
+	}
+	previousCA := previousClient.GetCABundleX509()[0]
+	nextCA := caClient.GetCABundleX509()[0]
+
+	previousCRL := crlOf(serviceHandler, previousCA)
+	require.Len(previousCRL.RevokedCertificateEntries, 2)
+	require.Contains(serialsOf(previousCRL), previousCert.SerialNumber.Text(16))
+	nextCRL := crlOf(serviceHandler, nextCA)
+	require.Len(nextCRL.RevokedCertificateEntries, 2)
+	require.Contains(serialsOf(nextCRL), nextCert.SerialNumber.Text(16))
+
+	// The CRL of the signing CA is served by default
+	der, status := serviceHandler.GetCertificateRevocationList(ctx, store.NullOrgId, domain.GetCertificateRevocationListParams{})
+	require.Equal(domain.StatusOK(), status)
+	crl, err := x509.ParseRevocationList(der)
+	require.NoError(err)
+	require.NoError(crl.CheckSignatureFrom(nextCA))
+
+	// Once the previous CA is retired, its certificates are no longer trusted and its CRL is no longer served
+	caConfig.Rotation.RetirePrevious = true
+	caClient, _, err = crypto.EnsureCA(caConfig)
+	require.NoError(err)
+	serviceHandler, ctx = newTestServiceHandler(t, testStore, caClient)
+	_, status = serviceHandler.GetCertificateRevocationList(ctx, store.NullOrgId, domain.GetCertificateRevocationListParams{
+		IssuerKeyId: lo.ToPtr(hex.EncodeToString(previousCA.SubjectKeyId)),
+	})
+	require.Equal(statusNotFoundCode, status.Code)
+	require.Len(crlOf(serviceHandler, nextCA).RevokedCertificateEntries, 2)
+}
+
+func TestDeleteDeviceWithUnparsableCertificate(t *testing.T) {
+	require := require.New(t)
+	caClient := newTestRevocationCA(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	_, renewedCert := createTestDeviceWithCertificates(t, testStore, caClient, store.NullOrgId, "mydevice")
+	(*testStore.enrollmentRequests.enrollmentRequests)[0].Status.Certificate = lo.ToPtr("not a certificate")
+
+	status := serviceHandler.DeleteDevice(ctx, store.NullOrgId, "mydevice")
+	require.Equal(domain.StatusOK(), status)
+	_, err := testStore.Device().Get(ctx, store.NullOrgId, "mydevice")
+	require.Error(err)
+
+	// The certificates that can be parsed are still revoked
+	issuerKeyID, serialNumber := crypto.RevocationKeyFromCert(renewedCert)
+	revoked, err := testStore.RevokedCertificate().IsRevoked(ctx, issuerKeyID, serialNumber)
+	require.NoError(err)
+	require.True(revoked)
+}
+
+func TestDeleteDeviceKeepsDeviceIfRevocationFails(t *testing.T) {
+	require := require.New(t)
+	caClient := newTestRevocationCA(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	createTestDeviceWithCertificates(t, testStore, caClient, store.NullOrgId, "mydevice")
+	testStore.revokedCerts.err = fmt.Errorf("database unavailable")
+
+	status := serviceHandler.DeleteDevice(ctx, store.NullOrgId, "mydevice")
+	require.Equal(int32(http.StatusServiceUnavailable), status.Code)
+	require.Contains(status.Message, "device mydevice was not deleted")
+	_, err := testStore.Device().Get(ctx, store.NullOrgId, "mydevice")
+	require.NoError(err)
+
+	// Deleting the device can be retried once revocation works again
+	testStore.revokedCerts.err = nil
+	status = serviceHandler.DeleteDevice(ctx, store.NullOrgId, "mydevice")
+	require.Equal(domain.StatusOK(), status)
+	_, err = testStore.Device().Get(ctx, store.NullOrgId, "mydevice")
+	require.Error(err)
+}
+
+func TestGetCertificateRevocationListWithoutCA(t *testing.T) {
+	serviceHandler, ctx := newTestServiceHandler(t, &TestStore{}, nil)
+
+	_, status := serviceHandler.GetCertificateRevocationList(ctx, store.NullOrgId, domain.GetCertificateRevocationListParams{})
+	require.Equal(t, int32(501), status.Code)
+}
+
+func TestRevokeCertificatesIfDecommissioned(t *testing.T) {
+	require := require.New(t)
+	caClient := newTestRevocationCA(t)
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	createTestDeviceWithCertificates(t, testStore, caClient, store.NullOrgId, "mydevice")
+
+	withLifecycle := func(status domain.DeviceLifecycleStatusType) *domain.Device {
+		device := &domain.Device{Status: lo.ToPtr(domain.NewDeviceStatus())}
+		device.Status.Lifecycle.Status = status
+		return device
+	}
+
+	// still decommissioning: the device needs its certificate to report completion
+	status := serviceHandler.revokeCertificatesIfDecommissioned(ctx, store.NullOrgId, "mydevice", withLifecycle(domain.DeviceLifecycleStatusDecommissioning))
+	require.Equal(domain.StatusOK(), status)
+	require.Empty(*testStore.revokedCerts.revokedCerts)
+
+	// the status update fails with a retryable error if revocation fails, and revocation is retried with it
+	testStore.revokedCerts.err = fmt.Errorf("database unavailable")
+	status = serviceHandler.revokeCertificatesIfDecommissioned(ctx, store.NullOrgId, "mydevice", withLifecycle(domain.DeviceLifecycleStatusDecommissioned))
+	require.Equal(int32(http.StatusServiceUnavailable), status.Code)
+	require.Contains(status.Message, "retry updating its status")
+	require.Empty(*testStore.revokedCerts.revokedCerts)
+
+	testStore.revokedCerts.err = nil
+	status = serviceHandler.revokeCertificatesIfDecommissioned(ctx, store.NullOrgId, "mydevice", withLifecycle(domain.DeviceLifecycleStatusDecommissioned))
+	require.Equal(domain.StatusOK(), status)
+	require.Len(*testStore.revokedCerts.revokedCerts, 2)
+	for _, revoked := range *testStore.revokedCerts.revokedCerts {
+		require.Equal(domain.CertificateRevocationReasonCessationOfOperation, revoked.Reason)
+	}
+}
@@ -21,6 +21,10 @@ import (
 const (
 	MaxRecordsPerListRequest = 1000
 	MaxConcurrentAgents      = 15
+	// FleetDryRunSampleSize is the maximum number of devices rendered by a fleet dry-run request
+	FleetDryRunSampleSize = 10
+	// MaxDeviceAggregateGroupBy is the maximum number of keys devices can be grouped by in one aggregation request
+	MaxDeviceAggregateGroupBy = 5
 )
 
 func IsInternalRequest(ctx context.Context) bool {
@@ -30,6 +34,12 @@ func IsInternalRequest(ctx context.Context) bool {
 	return false
 }
 
+// IsDryRunRequest returns true if the request should be validated without persisting any changes.
+func IsDryRunRequest(ctx context.Context) bool {
+	dryRun, ok := ctx.Value(consts.DryRunCtxKey).(bool)
+	return ok && dryRun
+}
+
 func NilOutManagedObjectMetaProperties(om *domain.ObjectMeta) {
 	if om == nil {
 		return
@@ -217,7 +217,7 @@ func updateServerSideDeviceUpdatedStatus(device *domain.Device, ctx context.Cont
 
 		// Prefer update condition error if available
 		if updateCondition := domain.FindStatusCondition(device.Status.Conditions, domain.ConditionTypeDeviceUpdating); updateCondition != nil {
-			if updateCondition.Reason == string(domain.UpdateStateError) && updateCondition.Message != "" {
+			if isUpdateFailedReason(updateCondition.Reason) && updateCondition.Message != "" {
 				errorMessage = fmt.Sprintf("%s: %s", baseMessage, updateCondition.Message)
 			}
 		}
@@ -250,7 +250,7 @@ func updateServerSideDeviceUpdatedStatus(device *domain.Device, ctx context.Cont
 			var errorMessage string
 			baseMessage := "Device could not be updated to the fleet's latest device spec"
 			if updateCondition := domain.FindStatusCondition(device.Status.Conditions, domain.ConditionTypeDeviceUpdating); updateCondition != nil {
-				if updateCondition.Reason == string(domain.UpdateStateError) {
+				if isUpdateFailedReason(updateCondition.Reason) {
 					errorMessage = fmt.Sprintf("%s: %s", baseMessage, updateCondition.Message)
 				}
 			} else if device.Metadata.Annotations != nil {
@@ -279,6 +279,12 @@ func updateServerSideDeviceUpdatedStatus(device *domain.Device, ctx context.Cont
 	return device.Status.Updated.Status != lastUpdateStatus
 }
 
+// isUpdateFailedReason returns true if the reason of the updating condition
+// reports that the agent did not complete the update.
+func isUpdateFailedReason(reason string) bool {
+	return reason == string(domain.UpdateStateError) || reason == string(domain.UpdateStateRolledBack)
+}
+
 func updateServerSideApplicationStatus(device *domain.Device) bool {
 	lastApplicationSummaryStatus := device.Status.ApplicationsSummary.Status
 	if device.IsDisconnected(domain.DeviceDisconnectedTimeout) {
@@ -400,6 +406,28 @@ func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *domai
 		}
 	}
 
+	if isRolledBack(newDevice) && !isRolledBack(oldDevice) {
+		details := "Device rolled back the update"
+		if condition := domain.FindStatusCondition(newDevice.Status.Conditions, domain.ConditionTypeDeviceUpdating); condition.Message != "" {
+			details = condition.Message
+		}
+		resourceUpdates = append(resourceUpdates, ResourceUpdate{Reason: domain.EventReasonDeviceUpdateRolledBack, Details: details})
+	}
+
+	oldDrifted := oldDevice.Status != nil && domain.IsStatusConditionTrue(oldDevice.Status.Conditions, domain.ConditionTypeDeviceConfigDrifted)
+	newDrifted := newDevice.Status != nil && domain.IsStatusConditionTrue(newDevice.Status.Conditions, domain.ConditionTypeDeviceConfigDrifted)
+	if oldDrifted != newDrifted {
+		if newDrifted {
+			details := "Device configuration drifted from the rendered device spec"
+			if condition := domain.FindStatusCondition(newDevice.Status.Conditions, domain.ConditionTypeDeviceConfigDrifted); condition != nil && condition.Message != "" {
+				details = condition.Message
+			}
+			resourceUpdates = append(resourceUpdates, ResourceUpdate{Reason: domain.EventReasonDeviceConfigDrifted, Details: details})
+		} else {
+			resourceUpdates = append(resourceUpdates, ResourceUpdate{Reason: domain.EventReasonDeviceConfigDriftResolved, Details: "Device configuration matches the rendered device spec"})
+		}
+	}
+
 	resourceChecks := []struct {
 		statusMap statusType
 		getter    func(*domain.Device) domain.DeviceResourceStatusType
@@ -415,6 +443,15 @@ func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *domai
 	return resourceUpdates
 }
 
+// isRolledBack returns true if the agent reported that it rolled back the last update.
+func isRolledBack(device *domain.Device) bool {
+	if device == nil || device.Status == nil {
+		return false
+	}
+	condition := domain.FindStatusCondition(device.Status.Conditions, domain.ConditionTypeDeviceUpdating)
+	return condition != nil && condition.Reason == string(domain.UpdateStateRolledBack)
+}
+
 func hasStatusChanged[T comparable](oldDevice *domain.Device, newDevice *domain.Device, defaultValue T, getter func(*domain.Device) T) bool {
 	newStatus := getter(newDevice)
 	if oldDevice != nil && oldDevice.Status != nil {
@@ -121,6 +121,90 @@ func TestComputeDeviceStatusChanges_StatusTransition(t *testing.T) {
 	assert.Contains(t, updates[0].Details, "update failed")
 }
 
+func TestComputeDeviceStatusChanges_DeviceUpdateRolledBack(t *testing.T) {
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	rollingBack := &domain.Device{
+		Metadata: domain.ObjectMeta{
+			Name: lo.ToPtr("test-device"),
+		},
+		Status: &domain.DeviceStatus{
+			Conditions: []domain.Condition{
+				{
+					Type:   domain.ConditionTypeDeviceUpdating,
+					Status: domain.ConditionStatusTrue,
+					Reason: string(domain.UpdateStateRollingBack),
+				},
+			},
+		},
+	}
+
+	rolledBack := &domain.Device{
+		Metadata: domain.ObjectMeta{
+			Name: lo.ToPtr("test-device"),
+		},
+		Status: &domain.DeviceStatus{
+			Conditions: []domain.Condition{
+				{
+					Type:    domain.ConditionTypeDeviceUpdating,
+					Status:  domain.ConditionStatusFalse,
+					Reason:  string(domain.UpdateStateRolledBack),
+					Message: "Rolled back renderedVersion 2 to 1: applications are Error",
+				},
+			},
+		},
+	}
+
+	// The rollback emits a DeviceUpdateRolledBack event with the reason
+	updates := ComputeDeviceStatusChanges(ctx, rollingBack, rolledBack, orgId, nil)
+	assert.Len(t, updates, 1)
+	assert.Equal(t, domain.EventReasonDeviceUpdateRolledBack, updates[0].Reason)
+	assert.Contains(t, updates[0].Details, "applications are Error")
+
+	// The event is emitted only once
+	updates = ComputeDeviceStatusChanges(ctx, rolledBack, rolledBack, orgId, nil)
+	assert.Empty(t, updates)
+}
+
+func TestComputeDeviceStatusChanges_ConfigDrifted(t *testing.T) {
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	deviceWithDrift := func(status domain.ConditionStatus) *domain.Device {
+		return &domain.Device{
+			Metadata: domain.ObjectMeta{
+				Name: lo.ToPtr("test-device"),
+			},
+			Status: &domain.DeviceStatus{
+				Conditions: []domain.Condition{
+					{
+						Type:    domain.ConditionTypeDeviceConfigDrifted,
+						Status:  status,
+						Message: "Drifted paths: /etc/example.conf",
+					},
+				},
+			},
+		}
+	}
+
+	// Test case 1: Transition to drifted emits DeviceConfigDrifted event
+	updates := ComputeDeviceStatusChanges(ctx, deviceWithDrift(domain.ConditionStatusFalse), deviceWithDrift(domain.ConditionStatusTrue), orgId, nil)
+	assert.Len(t, updates, 1)
+	assert.Equal(t, domain.EventReasonDeviceConfigDrifted, updates[0].Reason)
+	assert.Contains(t, updates[0].Details, "/etc/example.conf")
+	assert.Equal(t, domain.EventTypeWarning, domain.GetEventType(updates[0].Reason))
+
+	// Test case 2: Transition back emits DeviceConfigDriftResolved event
+	updates = ComputeDeviceStatusChanges(ctx, deviceWithDrift(domain.ConditionStatusTrue), deviceWithDrift(domain.ConditionStatusFalse), orgId, nil)
+	assert.Len(t, updates, 1)
+	assert.Equal(t, domain.EventReasonDeviceConfigDriftResolved, updates[0].Reason)
+
+	// Test case 3: No transition emits no event
+	updates = ComputeDeviceStatusChanges(ctx, deviceWithDrift(domain.ConditionStatusTrue), deviceWithDrift(domain.ConditionStatusTrue), orgId, nil)
+	assert.Empty(t, updates)
+}
+
 func TestUpdateServerSideDeviceStatus_PostRestoreState(t *testing.T) {
 	// This test validates the critical post-restore state where ALL three conditions must be true:
 	// 1. awaitingReconnect annotation = "true"
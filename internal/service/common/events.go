@@ -190,6 +190,40 @@ func GetEnrollmentRequestApprovalFailedEvent(ctx context.Context, resourceName s
 	})
 }
 
+// GetEnrollmentRequestAutoApprovedEvent creates an event for enrollment request approval by an enrollment approval policy
+func GetEnrollmentRequestAutoApprovedEvent(ctx context.Context, resourceName string, policyName string) *domain.Event {
+	details := fmt.Sprintf("by %s %s", domain.EnrollmentApprovalPolicyKind, policyName)
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.EnrollmentRequestKind,
+		resourceName: resourceName,
+		reason:       domain.EventReasonEnrollmentRequestAutoApproved,
+		message:      formatResourceActionMessage(domain.EnrollmentRequestKind, "automatically approved", &details),
+		details:      nil,
+	})
+}
+
+// GetEnrollmentRequestAutoApprovalFailedEvent creates an event for failed enrollment request approval by an enrollment approval policy
+func GetEnrollmentRequestAutoApprovalFailedEvent(ctx context.Context, resourceName string, policyName string, status domain.Status) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.EnrollmentRequestKind,
+		resourceName: resourceName,
+		reason:       domain.EventReasonEnrollmentRequestAutoApprovalFailed,
+		message:      fmt.Sprintf(formatResourceActionFailedTemplate(domain.EnrollmentRequestKind, fmt.Sprintf("automatic approval by %s %s", domain.EnrollmentApprovalPolicyKind, policyName)), status.Message),
+		details:      nil,
+	})
+}
+
+// GetEnrollmentRequestAutoApprovalSkippedEvent creates an event for an enrollment request that was not approved by any enrollment approval policy
+func GetEnrollmentRequestAutoApprovalSkippedEvent(ctx context.Context, resourceName string, reason string) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.EnrollmentRequestKind,
+		resourceName: resourceName,
+		reason:       domain.EventReasonEnrollmentRequestAutoApprovalSkipped,
+		message:      fmt.Sprintf("%s was not automatically approved: %s.", domain.EnrollmentRequestKind, reason),
+		details:      nil,
+	})
+}
+
 // GetDeviceDecommissionedSuccessEvent creates an event for successful device decommission
 func GetDeviceDecommissionedSuccessEvent(ctx context.Context, _ bool, _ domain.ResourceKind, resourceName string, update *domain.ResourceUpdatedDetails, log logrus.FieldLogger) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -551,6 +585,62 @@ func GetFleetRolloutFailedEvent(ctx context.Context, name string, deployingTempl
 	})
 }
 
+// GetFleetRolledBackEvent creates an event for rolling a fleet back to a previous template version
+func GetFleetRolledBackEvent(ctx context.Context, name string, templateVersion string, fromTemplateVersion string) *domain.Event {
+	details := domain.FleetRolledBackDetails{
+		DetailType:      domain.FleetRolledBack,
+		TemplateVersion: templateVersion,
+	}
+	if fromTemplateVersion != "" {
+		details.FromTemplateVersion = lo.ToPtr(fromTemplateVersion)
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetRolledBackDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: name,
+		reason:       domain.EventReasonFleetRolledBack,
+		message:      fmt.Sprintf("Fleet was rolled back to template version %s.", templateVersion),
+		details:      &eventDetails,
+	})
+}
+
+// GetBulkOperationProgressedEvent creates an event for a processed batch of a bulk operation
+func GetBulkOperationProgressedEvent(ctx context.Context, name string, processed int64, total int64) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.BulkOperationKind,
+		resourceName: name,
+		reason:       domain.EventReasonBulkOperationProgressed,
+		message:      fmt.Sprintf("Bulk operation processed %d of %d devices.", processed, total),
+		details:      nil,
+	})
+}
+
+// GetBulkOperationCompletedEvent creates an event for bulk operation completion
+func GetBulkOperationCompletedEvent(ctx context.Context, name string, succeeded, failed, skipped int64) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.BulkOperationKind,
+		resourceName: name,
+		reason:       domain.EventReasonBulkOperationCompleted,
+		message:      fmt.Sprintf("Bulk operation completed: %d succeeded, %d failed, %d skipped.", succeeded, failed, skipped),
+		details:      nil,
+	})
+}
+
+// GetBulkOperationFailedEvent creates an event for a bulk operation that could not be processed
+func GetBulkOperationFailedEvent(ctx context.Context, name string, errorMessage string) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.BulkOperationKind,
+		resourceName: name,
+		reason:       domain.EventReasonBulkOperationFailed,
+		message:      fmt.Sprintf("Bulk operation failed: %s.", errorMessage),
+		details:      nil,
+	})
+}
+
 // GetRepositoryAccessibleEvent creates an event for repository accessibility
 func GetRepositoryAccessibleEvent(ctx context.Context, name string) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
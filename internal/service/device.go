@@ -37,6 +37,10 @@ func (h *ServiceHandler) CreateDevice(ctx context.Context, orgId uuid.UUID, devi
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
 	}
 
+	if IsDryRunRequest(ctx) {
+		return &device, domain.StatusCreated()
+	}
+
 	_ = common.UpdateServiceSideStatus(ctx, orgId, &device, h.store, h.log)
 
 	result, err := h.store.Device().Create(ctx, orgId, &device, h.callbackDeviceUpdated)
@@ -163,6 +167,29 @@ func DeviceVerificationCallback(ctx context.Context, before, after *domain.Devic
 	return nil
 }
 
+// verifyDeviceUpdate runs the checks the store runs before it persists a device, so that a dry-run request
+// fails where the real request would.  It returns whether the request creates the device.
+func verifyDeviceUpdate(ctx context.Context, existing, device *domain.Device, fromAPI bool) (bool, error) {
+	created := existing == nil || existing.Spec == nil
+	if err := DeviceVerificationCallback(ctx, existing, device); err != nil {
+		return created, err
+	}
+	if existing == nil {
+		return created, nil
+	}
+
+	isResourceSyncRequest, _ := ctx.Value(consts.ResourceSyncRequestCtxKey).(bool)
+	sameSpec := (existing.Spec == nil && device.Spec == nil) ||
+		(existing.Spec != nil && device.Spec != nil && domain.DeviceSpecsAreEqual(*existing.Spec, *device.Spec))
+	if fromAPI && lo.FromPtr(existing.Metadata.Owner) != "" && !isResourceSyncRequest && !sameSpec {
+		return created, flterrors.ErrUpdatingResourceWithOwnerNotAllowed
+	}
+	if device.Metadata.ResourceVersion != nil && lo.FromPtr(existing.Metadata.ResourceVersion) != *device.Metadata.ResourceVersion {
+		return created, flterrors.ErrResourceVersionConflict
+	}
+	return created, nil
+}
+
 func (h *ServiceHandler) ReplaceDevice(ctx context.Context, orgId uuid.UUID, name string, device domain.Device, fieldsToUnset []string) (*domain.Device, domain.Status) {
 	if device.Spec != nil && device.Spec.Decommissioning != nil {
 		h.log.WithError(flterrors.ErrDecommission).Error("attempt to set decommissioned status when replacing device, or to replace decommissioned device")
@@ -183,6 +210,18 @@ func (h *ServiceHandler) ReplaceDevice(ctx context.Context, orgId uuid.UUID, nam
 		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
 	}
 
+	if IsDryRunRequest(ctx) {
+		existing, err := h.store.Device().Get(ctx, orgId, name)
+		if err != nil && !errors.Is(err, flterrors.ErrResourceNotFound) {
+			return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+		}
+		created, err := verifyDeviceUpdate(ctx, existing, &device, isNotInternal)
+		if err != nil {
+			return nil, StoreErrorToApiStatus(err, created, domain.DeviceKind, &name)
+		}
+		return &device, StoreErrorToApiStatus(nil, created, domain.DeviceKind, &name)
+	}
+
 	_ = common.UpdateServiceSideStatus(ctx, orgId, &device, h.store, h.log)
 
 	result, created, err := h.store.Device().CreateOrUpdate(ctx, orgId, &device, fieldsToUnset, isNotInternal, DeviceVerificationCallback, h.callbackDeviceUpdated)
@@ -214,6 +253,12 @@ func (h *ServiceHandler) UpdateDevice(ctx context.Context, orgId uuid.UUID, name
 }
 
 func (h *ServiceHandler) DeleteDevice(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	// Revoke first so that a device can't keep talking to the service if revocation fails.  The device is kept
+	// so that deleting it can be retried, since its certificates can no longer be found once it is deleted.
+	if err := h.revokeDeviceCertificates(ctx, orgId, name, domain.CertificateRevocationReasonCessationOfOperation); err != nil {
+		h.log.WithError(err).Errorf("failed to revoke certificates of device %s", name)
+		return domain.StatusServiceUnavailable(fmt.Sprintf("device %s was not deleted because its certificates could not be revoked, retry deleting it: %v", name, err))
+	}
 	_, err := h.store.Device().Delete(ctx, orgId, name, h.callbackDeviceDeleted)
 	return StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
@@ -279,7 +324,13 @@ func (h *ServiceHandler) ReplaceDeviceStatus(ctx context.Context, orgId uuid.UUI
 	_ = common.UpdateServiceSideStatus(ctx, orgId, deviceToStore, h.store, h.log)
 
 	result, err := h.store.Device().UpdateStatus(ctx, orgId, deviceToStore, h.callbackDeviceUpdated)
-	return result, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	if status := h.revokeCertificatesIfDecommissioned(ctx, orgId, name, result); status.Code != http.StatusOK {
+		return nil, status
+	}
+	return result, domain.StatusOK()
 }
 
 func (h *ServiceHandler) PatchDeviceStatus(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.Device, domain.Status) {
@@ -320,7 +371,28 @@ func (h *ServiceHandler) PatchDeviceStatus(ctx context.Context, orgId uuid.UUID,
 	_ = common.UpdateServiceSideStatus(ctx, orgId, newObj, h.store, h.log)
 
 	result, err := h.store.Device().Update(ctx, orgId, newObj, nil, true, DeviceVerificationCallback, h.callbackDeviceUpdated)
-	return result, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	if status := h.revokeCertificatesIfDecommissioned(ctx, orgId, name, result); status.Code != http.StatusOK {
+		return nil, status
+	}
+	return result, domain.StatusOK()
+}
+
+// revokeCertificatesIfDecommissioned revokes a device's certificates once it reports having finished decommissioning.
+// Revoking them when decommissioning is requested would lock the device out before it learns about the request.
+// Revoking certificates that are already revoked is a no-op, so revocation is attempted on every status update of
+// a decommissioned device, which lets the device retry a status update that failed because revocation failed.
+func (h *ServiceHandler) revokeCertificatesIfDecommissioned(ctx context.Context, orgId uuid.UUID, name string, device *domain.Device) domain.Status {
+	if device == nil || device.Status == nil || device.Status.Lifecycle.Status != domain.DeviceLifecycleStatusDecommissioned {
+		return domain.StatusOK()
+	}
+	if err := h.revokeDeviceCertificates(ctx, orgId, name, domain.CertificateRevocationReasonCessationOfOperation); err != nil {
+		h.log.WithError(err).Errorf("failed to revoke certificates of decommissioned device %s", name)
+		return domain.StatusServiceUnavailable(fmt.Sprintf("the certificates of decommissioned device %s could not be revoked, retry updating its status: %v", name, err))
+	}
+	return domain.StatusOK()
 }
 
 func (h *ServiceHandler) GetRenderedDevice(ctx context.Context, orgId uuid.UUID, name string, params domain.GetRenderedDeviceParams) (*domain.Device, domain.Status) {
@@ -409,6 +481,13 @@ func (h *ServiceHandler) PatchDevice(ctx context.Context, orgId uuid.UUID, name
 	NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
 
+	if IsDryRunRequest(ctx) {
+		if _, err := verifyDeviceUpdate(ctx, currentObj, newObj, true); err != nil {
+			return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+		}
+		return newObj, domain.StatusOK()
+	}
+
 	_ = common.UpdateServiceSideStatus(ctx, orgId, newObj, h.store, h.log)
 
 	result, err := h.store.Device().Update(ctx, orgId, newObj, nil, true, DeviceVerificationCallback, h.callbackDeviceUpdated)
@@ -588,6 +667,37 @@ func (h *ServiceHandler) CountDevicesByLabels(ctx context.Context, orgId uuid.UU
 	return result, StoreErrorToApiStatus(err, false, domain.DeviceKind, nil)
 }
 
+func (h *ServiceHandler) AggregateDevices(ctx context.Context, orgId uuid.UUID, params domain.AggregateDevicesParams) (*domain.DeviceAggregate, domain.Status) {
+	switch {
+	case len(params.GroupBy) == 0:
+		return nil, domain.StatusBadRequest("at least one groupBy key is required")
+	case len(params.GroupBy) > MaxDeviceAggregateGroupBy:
+		return nil, domain.StatusBadRequest(fmt.Sprintf("devices can be grouped by at most %d keys", MaxDeviceAggregateGroupBy))
+	case len(lo.Uniq(params.GroupBy)) != len(params.GroupBy):
+		return nil, domain.StatusBadRequest("groupBy keys must be unique")
+	}
+
+	// The limit applies to the number of groups
+	storeParams, status := prepareListParams(nil, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.Device().Aggregate(ctx, orgId, *storeParams, params.GroupBy)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
 func (h *ServiceHandler) GetDevicesSummary(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*domain.DevicesSummary, domain.Status) {
 	storeParams, status := convertDeviceListParams(params, annotationSelector)
 	if status.Code != http.StatusOK {
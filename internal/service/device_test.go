@@ -8,6 +8,7 @@ import (
 
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/google/uuid"
@@ -383,3 +384,143 @@ func TestDeviceDisconnected(t *testing.T) {
 	require.Equal(true, changed)
 	require.Equal(device.Status.Summary.Status, domain.DeviceSummaryStatusUnknown)
 }
+
+func TestDeviceDryRun(t *testing.T) {
+	require := require.New(t)
+
+	ts := &TestStore{}
+	wc := &DummyWorkerClient{}
+	serviceHandler := &ServiceHandler{
+		eventHandler: NewEventHandler(ts, wc, logrus.New()),
+		store:        ts,
+		workerClient: wc,
+		log:          logrus.New(),
+	}
+	ctx := context.WithValue(context.Background(), consts.DryRunCtxKey, true)
+	testOrgId := uuid.New()
+	device := prepareDevice(testOrgId, "foo")
+
+	created, retStatus := serviceHandler.CreateDevice(ctx, testOrgId, *device)
+	require.Equal(int32(http.StatusCreated), retStatus.Code)
+	require.Equal("foo", lo.FromPtr(created.Metadata.Name))
+
+	// replacing a device that does not exist creates it
+	replaced, retStatus := serviceHandler.ReplaceDevice(ctx, testOrgId, "foo", *device, nil)
+	require.Equal(int32(http.StatusCreated), retStatus.Code)
+	require.Equal("foo", lo.FromPtr(replaced.Metadata.Name))
+
+	_, retStatus = serviceHandler.GetDevice(context.Background(), testOrgId, "foo")
+	require.Equal(int32(http.StatusNotFound), retStatus.Code)
+
+	_, err := ts.Device().Create(context.Background(), testOrgId, device, nil)
+	require.NoError(err)
+	_, retStatus = serviceHandler.ReplaceDevice(ctx, testOrgId, "foo", *device, nil)
+	require.Equal(int32(http.StatusOK), retStatus.Code)
+}
+
+func TestDeviceDryRunVerifiesUpdate(t *testing.T) {
+	require := require.New(t)
+
+	ts := &TestStore{}
+	wc := &DummyWorkerClient{}
+	serviceHandler := &ServiceHandler{
+		eventHandler: NewEventHandler(ts, wc, logrus.New()),
+		store:        ts,
+		workerClient: wc,
+		log:          logrus.New(),
+	}
+	ctx := context.WithValue(context.Background(), consts.DryRunCtxKey, true)
+	testOrgId := uuid.New()
+
+	owned := prepareDevice(testOrgId, "owned")
+	owned.Metadata.Owner = lo.ToPtr("Fleet/fleet")
+	_, err := ts.Device().Create(context.Background(), testOrgId, owned, nil)
+	require.NoError(err)
+	decommissioned := prepareDevice(testOrgId, "decommissioned")
+	decommissioned.Spec.Decommissioning = &domain.DeviceDecommission{Target: domain.DeviceDecommissionTargetTypeUnenroll}
+	_, err = ts.Device().Create(context.Background(), testOrgId, decommissioned, nil)
+	require.NoError(err)
+
+	// the spec of a device owned by a fleet can't be changed
+	changed := prepareDevice(testOrgId, "owned")
+	changed.Spec.Os.Image = "other-img"
+	_, retStatus := serviceHandler.ReplaceDevice(ctx, testOrgId, "owned", *changed, nil)
+	require.Equal(int32(http.StatusConflict), retStatus.Code)
+	require.Contains(retStatus.Message, flterrors.ErrUpdatingResourceWithOwnerNotAllowed.Error())
+
+	var image interface{} = "other-img"
+	_, retStatus = serviceHandler.PatchDevice(ctx, testOrgId, "owned", domain.PatchRequest{
+		{Op: "replace", Path: "/spec/os/image", Value: &image},
+	})
+	require.Equal(int32(http.StatusConflict), retStatus.Code)
+	require.Contains(retStatus.Message, flterrors.ErrUpdatingResourceWithOwnerNotAllowed.Error())
+
+	// its labels can
+	var labels interface{} = map[string]interface{}{"labelKey": "otherValue"}
+	_, retStatus = serviceHandler.PatchDevice(ctx, testOrgId, "owned", domain.PatchRequest{
+		{Op: "replace", Path: "/metadata/labels", Value: &labels},
+	})
+	require.Equal(int32(http.StatusOK), retStatus.Code)
+
+	// a decommissioned device can't be replaced
+	_, retStatus = serviceHandler.ReplaceDevice(ctx, testOrgId, "decommissioned", *prepareDevice(testOrgId, "decommissioned"), nil)
+	require.Equal(int32(http.StatusConflict), retStatus.Code)
+	require.Contains(retStatus.Message, flterrors.ErrDecommission.Error())
+
+	// nothing was persisted
+	device, retStatus := serviceHandler.GetDevice(context.Background(), testOrgId, "owned")
+	require.Equal(int32(http.StatusOK), retStatus.Code)
+	require.Equal("img", device.Spec.Os.Image)
+	require.Equal("labelValue", (*device.Metadata.Labels)["labelKey"])
+}
+
+func TestAggregateDevicesValidation(t *testing.T) {
+	ts := &TestStore{}
+	serviceHandler := &ServiceHandler{
+		store: ts,
+		log:   logrus.New(),
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		params  domain.AggregateDevicesParams
+		message string
+	}{
+		{
+			name:    "no group-by keys",
+			params:  domain.AggregateDevicesParams{},
+			message: "at least one groupBy key is required",
+		},
+		{
+			name:    "too many group-by keys",
+			params:  domain.AggregateDevicesParams{GroupBy: []string{"a", "b", "c", "d", "e", "f"}},
+			message: "devices can be grouped by at most 5 keys",
+		},
+		{
+			name:    "duplicate group-by keys",
+			params:  domain.AggregateDevicesParams{GroupBy: []string{"status.summary.status", "status.summary.status"}},
+			message: "groupBy keys must be unique",
+		},
+		{
+			name:    "invalid label selector",
+			params:  domain.AggregateDevicesParams{GroupBy: []string{"status.summary.status"}, LabelSelector: lo.ToPtr("a==b==c")},
+			message: "",
+		},
+		{
+			name:    "limit above maximum",
+			params:  domain.AggregateDevicesParams{GroupBy: []string{"status.summary.status"}, Limit: lo.ToPtr(int32(MaxRecordsPerListRequest + 1))},
+			message: "limit cannot exceed 1000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			_, status := serviceHandler.AggregateDevices(ctx, uuid.New(), tt.params)
+			require.Equal(int32(http.StatusBadRequest), status.Code)
+			if tt.message != "" {
+				require.Equal(tt.message, status.Message)
+			}
+		})
+	}
+}
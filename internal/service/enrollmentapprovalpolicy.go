@@ -0,0 +1,275 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/crypto/signer"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/pkg/k8s/selector/labels"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+)
+
+func (h *ServiceHandler) CreateEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	NilOutManagedObjectMetaProperties(&policy.Metadata)
+
+	if errs := policy.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.EnrollmentApprovalPolicy().Create(ctx, orgId, &policy, h.callbackEnrollmentApprovalPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.EnrollmentApprovalPolicyKind, policy.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListEnrollmentApprovalPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentApprovalPoliciesParams) (*domain.EnrollmentApprovalPolicyList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.EnrollmentApprovalPolicy().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	result, err := h.store.EnrollmentApprovalPolicy().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentApprovalPolicyKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	NilOutManagedObjectMetaProperties(&policy.Metadata)
+
+	if errs := policy.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *policy.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.EnrollmentApprovalPolicy().CreateOrUpdate(ctx, orgId, &policy, h.callbackEnrollmentApprovalPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.EnrollmentApprovalPolicyKind, &name)
+}
+
+func (h *ServiceHandler) DeleteEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.EnrollmentApprovalPolicy().Delete(ctx, orgId, name, h.callbackEnrollmentApprovalPolicyDeleted)
+	return StoreErrorToApiStatus(err, false, domain.EnrollmentApprovalPolicyKind, &name)
+}
+
+// callbackEnrollmentApprovalPolicyUpdated is the enrollment approval policy-specific callback that handles enrollment approval policy events
+func (h *ServiceHandler) callbackEnrollmentApprovalPolicyUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleEnrollmentApprovalPolicyUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackEnrollmentApprovalPolicyDeleted is the enrollment approval policy-specific callback that handles enrollment approval policy deletion events
+func (h *ServiceHandler) callbackEnrollmentApprovalPolicyDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// enrollmentApprovalPolicyResult is the result of evaluating an enrollment approval policy against an enrollment request.
+type enrollmentApprovalPolicyResult int
+
+const (
+	// the request does not match the policy
+	enrollmentApprovalPolicyNoMatch enrollmentApprovalPolicyResult = iota
+	// the request matches the policy once its TPM challenge succeeded
+	enrollmentApprovalPolicyAwaitingTPM
+	// the request matches the policy
+	enrollmentApprovalPolicyMatched
+)
+
+// system info keys reported by the agent, see internal/agent/device/systeminfo/common
+const (
+	systemInfoProductSerialKey = "productSerial"
+	systemInfoProductUUIDKey   = "productUuid"
+)
+
+// autoApproveEnrollmentRequest approves an enrollment request with the first of the organization's enrollment
+// approval policies that matches it, and records the decision as an event. It returns the approved enrollment
+// request, or nil if the request was not approved.
+func (h *ServiceHandler) autoApproveEnrollmentRequest(ctx context.Context, orgId uuid.UUID, er *domain.EnrollmentRequest) *domain.EnrollmentRequest {
+	if er == nil || er.Status == nil || domain.IsStatusConditionTrue(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved) {
+		return nil
+	}
+	name := lo.FromPtr(er.Metadata.Name)
+
+	policies, err := h.store.EnrollmentApprovalPolicy().List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		h.log.WithError(err).Errorf("failed to list enrollment approval policies for enrollment request %s in org %s", name, orgId)
+		return nil
+	}
+	if len(policies.Items) == 0 {
+		return nil
+	}
+	sortEnrollmentApprovalPolicies(policies.Items)
+
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		switch matchEnrollmentApprovalPolicy(h.ca, policy, er) {
+		case enrollmentApprovalPolicyMatched:
+			return h.approveEnrollmentRequestByPolicy(ctx, orgId, er, policy)
+		case enrollmentApprovalPolicyAwaitingTPM:
+			// don't let a lower priority policy approve the request before the TPM challenge completes
+			reason := fmt.Sprintf("%s %s requires the TPM challenge to succeed", domain.EnrollmentApprovalPolicyKind, lo.FromPtr(policy.Metadata.Name))
+			h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestAutoApprovalSkippedEvent(ctx, name, reason))
+			return nil
+		}
+	}
+
+	reason := fmt.Sprintf("no %s matched", domain.EnrollmentApprovalPolicyKind)
+	h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestAutoApprovalSkippedEvent(ctx, name, reason))
+	return nil
+}
+
+// approveEnrollmentRequestByPolicy approves and signs an enrollment request on behalf of an enrollment approval
+// policy and creates its device.
+func (h *ServiceHandler) approveEnrollmentRequestByPolicy(ctx context.Context, orgId uuid.UUID, er *domain.EnrollmentRequest, policy *domain.EnrollmentApprovalPolicy) *domain.EnrollmentRequest {
+	name := lo.FromPtr(er.Metadata.Name)
+	policyName := lo.FromPtr(policy.Metadata.Name)
+	fail := func(status domain.Status) *domain.EnrollmentRequest {
+		h.log.Warnf("failed to approve enrollment request %s in org %s with enrollment approval policy %s: %s", name, orgId, policyName, status.Message)
+		h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestAutoApprovalFailedEvent(ctx, name, policyName, status))
+		return nil
+	}
+
+	approvalLabels := map[string]string{}
+	for k, v := range lo.FromPtr(policy.Spec.Labels) {
+		approvalLabels[k] = v
+	}
+	if policy.Spec.Fleet != nil {
+		fleet, err := h.store.Fleet().Get(ctx, orgId, *policy.Spec.Fleet)
+		if err != nil {
+			return fail(StoreErrorToApiStatus(err, false, domain.FleetKind, policy.Spec.Fleet))
+		}
+		fleetLabels, err := fleetSelectorMatchLabels(fleet)
+		if err != nil {
+			return fail(domain.StatusBadRequest(err.Error()))
+		}
+		for k, v := range fleetLabels {
+			approvalLabels[k] = v
+		}
+	}
+
+	// approve a copy, so that a failed approval leaves the request untouched
+	approved := *er
+	approved.Status = &domain.EnrollmentRequestStatus{
+		Certificate: er.Status.Certificate,
+		Conditions:  slices.Clone(er.Status.Conditions),
+	}
+	approval := domain.EnrollmentRequestApprovalStatus{
+		Approved:   true,
+		Labels:     &approvalLabels,
+		ApprovedAt: time.Now(),
+		ApprovedBy: fmt.Sprintf("%s/%s", domain.EnrollmentApprovalPolicyKind, policyName),
+	}
+	if err := approveAndSignEnrollmentRequest(ctx, h.ca, &approved, &approval, enrollmentRequestAutoApprovedReason); err != nil {
+		return fail(domain.StatusBadRequest(fmt.Sprintf("Error approving and signing enrollment request: %v", err)))
+	}
+	if err := h.createDeviceFromEnrollmentRequest(ctx, orgId, &approved); err != nil {
+		return fail(domain.StatusInternalServerError(fmt.Sprintf("error creating device from enrollment request: %v", err)))
+	}
+	result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, &approved, nil)
+	if err != nil {
+		// remove the device again, so that the request can still be approved manually
+		if _, deleteErr := h.store.Device().Delete(ctx, orgId, name, nil); deleteErr != nil {
+			h.log.Errorf("failed to remove device %s in org %s after failing to approve its enrollment request: %v", name, orgId, deleteErr)
+		}
+		return fail(StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name))
+	}
+
+	h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestAutoApprovedEvent(ctx, name, policyName))
+	return result
+}
+
+// sortEnrollmentApprovalPolicies sorts policies in the order they are evaluated in: by priority, then by name.
+func sortEnrollmentApprovalPolicies(policies []domain.EnrollmentApprovalPolicy) {
+	slices.SortStableFunc(policies, func(a, b domain.EnrollmentApprovalPolicy) int {
+		if c := cmp.Compare(lo.FromPtr(a.Spec.Priority), lo.FromPtr(b.Spec.Priority)); c != 0 {
+			return c
+		}
+		return cmp.Compare(lo.FromPtr(a.Metadata.Name), lo.FromPtr(b.Metadata.Name))
+	})
+}
+
+// matchEnrollmentApprovalPolicy evaluates the criteria of a policy against an enrollment request. A request
+// matches if it meets all of the criteria that are set.
+func matchEnrollmentApprovalPolicy(caClient *crypto.CAClient, policy *domain.EnrollmentApprovalPolicy, er *domain.EnrollmentRequest) enrollmentApprovalPolicyResult {
+	match := policy.Spec.Match
+
+	if match.SerialNumbers != nil && !lo.Contains(*match.SerialNumbers, enrollmentRequestSystemInfo(er, systemInfoProductSerialKey)) {
+		return enrollmentApprovalPolicyNoMatch
+	}
+	if match.ProductUuids != nil && !lo.Contains(*match.ProductUuids, enrollmentRequestSystemInfo(er, systemInfoProductUUIDKey)) {
+		return enrollmentApprovalPolicyNoMatch
+	}
+	if match.EnrollmentCredentials != nil {
+		credential := lo.FromPtr(er.Metadata.Annotations)[domain.EnrollmentRequestAnnotationEnrollmentCredential]
+		if credential == "" || !lo.ContainsBy(*match.EnrollmentCredentials, func(name string) bool {
+			return name == credential || (caClient != nil && signer.BootstrapCNFromName(caClient.Cfg, name) == credential)
+		}) {
+			return enrollmentApprovalPolicyNoMatch
+		}
+	}
+	if match.LabelSelector != nil {
+		labelSelector, err := labels.Parse(*match.LabelSelector)
+		if err != nil || !labelSelector.Matches(k8sLabels.Set(lo.FromPtr(er.Spec.Labels))) {
+			return enrollmentApprovalPolicyNoMatch
+		}
+	}
+	if lo.FromPtr(match.TpmVerified) {
+		condition := domain.FindStatusCondition(er.Status.Conditions, domain.ConditionTypeEnrollmentRequestTPMVerified)
+		switch {
+		case condition == nil:
+			return enrollmentApprovalPolicyNoMatch
+		case condition.Status == domain.ConditionStatusTrue:
+		case condition.Reason == domain.TPMChallengeRequiredReason:
+			return enrollmentApprovalPolicyAwaitingTPM
+		default:
+			return enrollmentApprovalPolicyNoMatch
+		}
+	}
+	return enrollmentApprovalPolicyMatched
+}
+
+// enrollmentRequestSystemInfo returns a value of the system info reported with an enrollment request.
+func enrollmentRequestSystemInfo(er *domain.EnrollmentRequest, key string) string {
+	if er.Spec.DeviceStatus == nil {
+		return ""
+	}
+	return er.Spec.DeviceStatus.SystemInfo.AdditionalProperties[key]
+}
+
+// isTPMChallengeSucceeded returns whether the TPM challenge of an enrollment request succeeded.
+func isTPMChallengeSucceeded(conditions []domain.Condition) bool {
+	condition := domain.FindStatusCondition(conditions, domain.ConditionTypeEnrollmentRequestTPMVerified)
+	return condition != nil && condition.Status == domain.ConditionStatusTrue && condition.Reason == domain.TPMChallengeSucceededReason
+}
+
+// fleetSelectorMatchLabels returns the labels a device needs to be owned by a fleet.
+func fleetSelectorMatchLabels(fleet *domain.Fleet) (map[string]string, error) {
+	fleetSelector := fleet.Spec.Selector
+	if fleetSelector == nil || len(lo.FromPtr(fleetSelector.MatchLabels)) == 0 || len(lo.FromPtr(fleetSelector.MatchExpressions)) > 0 {
+		return nil, fmt.Errorf("the selector of fleet %s must consist of matchLabels only", lo.FromPtr(fleet.Metadata.Name))
+	}
+	return *fleetSelector.MatchLabels, nil
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEnrollmentApprovalPolicy(name string, priority int32, match domain.EnrollmentApprovalPolicyMatch) domain.EnrollmentApprovalPolicy {
+	return domain.EnrollmentApprovalPolicy{
+		ApiVersion: domain.EnrollmentApprovalPolicyAPIVersion,
+		Kind:       domain.EnrollmentApprovalPolicyKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec: domain.EnrollmentApprovalPolicySpec{
+			Priority: lo.ToPtr(priority),
+			Match:    match,
+		},
+	}
+}
+
+func newTestPolicyEnrollmentRequest(tpmCondition *domain.Condition) *domain.EnrollmentRequest {
+	deviceStatus := domain.NewDeviceStatus()
+	deviceStatus.SystemInfo.AdditionalProperties = map[string]string{
+		systemInfoProductSerialKey: "SN-0001",
+		systemInfoProductUUIDKey:   "4c4c4544-0001",
+	}
+	er := &domain.EnrollmentRequest{
+		Metadata: domain.ObjectMeta{
+			Name:        lo.ToPtr("device-1"),
+			Annotations: &map[string]string{domain.EnrollmentRequestAnnotationEnrollmentCredential: "client-enrollment"},
+		},
+		Spec: domain.EnrollmentRequestSpec{
+			DeviceStatus: &deviceStatus,
+			Labels:       &map[string]string{"source": "factory-berlin"},
+		},
+		Status: &domain.EnrollmentRequestStatus{Conditions: []domain.Condition{}},
+	}
+	if tpmCondition != nil {
+		er.Status.Conditions = append(er.Status.Conditions, *tpmCondition)
+	}
+	return er
+}
+
+func TestMatchEnrollmentApprovalPolicy(t *testing.T) {
+	tpmVerified := &domain.Condition{
+		Type:   domain.ConditionTypeEnrollmentRequestTPMVerified,
+		Status: domain.ConditionStatusTrue,
+		Reason: domain.TPMChallengeSucceededReason,
+	}
+	tpmPending := &domain.Condition{
+		Type:   domain.ConditionTypeEnrollmentRequestTPMVerified,
+		Status: domain.ConditionStatusFalse,
+		Reason: domain.TPMChallengeRequiredReason,
+	}
+	tpmFailed := &domain.Condition{
+		Type:   domain.ConditionTypeEnrollmentRequestTPMVerified,
+		Status: domain.ConditionStatusFalse,
+		Reason: domain.TPMVerificationFailedReason,
+	}
+
+	tests := []struct {
+		name         string
+		match        domain.EnrollmentApprovalPolicyMatch
+		tpmCondition *domain.Condition
+		expected     enrollmentApprovalPolicyResult
+	}{
+		{
+			name:     "serial number in allowlist",
+			match:    domain.EnrollmentApprovalPolicyMatch{SerialNumbers: &[]string{"SN-0000", "SN-0001"}},
+			expected: enrollmentApprovalPolicyMatched,
+		},
+		{
+			name:     "serial number not in allowlist",
+			match:    domain.EnrollmentApprovalPolicyMatch{SerialNumbers: &[]string{"SN-0000"}},
+			expected: enrollmentApprovalPolicyNoMatch,
+		},
+		{
+			name:     "product uuid in allowlist",
+			match:    domain.EnrollmentApprovalPolicyMatch{ProductUuids: &[]string{"4c4c4544-0001"}},
+			expected: enrollmentApprovalPolicyMatched,
+		},
+		{
+			name:     "enrollment credential matches",
+			match:    domain.EnrollmentApprovalPolicyMatch{EnrollmentCredentials: &[]string{"client-enrollment"}},
+			expected: enrollmentApprovalPolicyMatched,
+		},
+		{
+			name:     "enrollment credential does not match",
+			match:    domain.EnrollmentApprovalPolicyMatch{EnrollmentCredentials: &[]string{"factory-munich"}},
+			expected: enrollmentApprovalPolicyNoMatch,
+		},
+		{
+			name:     "label selector matches",
+			match:    domain.EnrollmentApprovalPolicyMatch{LabelSelector: lo.ToPtr("source=factory-berlin")},
+			expected: enrollmentApprovalPolicyMatched,
+		},
+		{
+			name:     "label selector does not match",
+			match:    domain.EnrollmentApprovalPolicyMatch{LabelSelector: lo.ToPtr("source=factory-munich")},
+			expected: enrollmentApprovalPolicyNoMatch,
+		},
+		{
+			name:         "tpm verified",
+			match:        domain.EnrollmentApprovalPolicyMatch{TpmVerified: lo.ToPtr(true)},
+			tpmCondition: tpmVerified,
+			expected:     enrollmentApprovalPolicyMatched,
+		},
+		{
+			name:         "tpm challenge pending",
+			match:        domain.EnrollmentApprovalPolicyMatch{TpmVerified: lo.ToPtr(true)},
+			tpmCondition: tpmPending,
+			expected:     enrollmentApprovalPolicyAwaitingTPM,
+		},
+		{
+			name:         "tpm verification failed",
+			match:        domain.EnrollmentApprovalPolicyMatch{TpmVerified: lo.ToPtr(true)},
+			tpmCondition: tpmFailed,
+			expected:     enrollmentApprovalPolicyNoMatch,
+		},
+		{
+			name:     "no tpm",
+			match:    domain.EnrollmentApprovalPolicyMatch{TpmVerified: lo.ToPtr(true)},
+			expected: enrollmentApprovalPolicyNoMatch,
+		},
+		{
+			name: "all criteria must match",
+			match: domain.EnrollmentApprovalPolicyMatch{
+				TpmVerified:   lo.ToPtr(true),
+				SerialNumbers: &[]string{"SN-0000"},
+			},
+			tpmCondition: tpmPending,
+			expected:     enrollmentApprovalPolicyNoMatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newTestEnrollmentApprovalPolicy("policy", 0, tt.match)
+			er := newTestPolicyEnrollmentRequest(tt.tpmCondition)
+			require.Equal(t, tt.expected, matchEnrollmentApprovalPolicy(nil, &policy, er))
+		})
+	}
+}
+
+func TestSortEnrollmentApprovalPolicies(t *testing.T) {
+	match := domain.EnrollmentApprovalPolicyMatch{TpmVerified: lo.ToPtr(true)}
+	policies := []domain.EnrollmentApprovalPolicy{
+		newTestEnrollmentApprovalPolicy("c", 10, match),
+		newTestEnrollmentApprovalPolicy("b", 0, match),
+		newTestEnrollmentApprovalPolicy("a", 10, match),
+	}
+	policies[1].Spec.Priority = nil
+
+	sortEnrollmentApprovalPolicies(policies)
+
+	names := lo.Map(policies, func(p domain.EnrollmentApprovalPolicy, _ int) string { return lo.FromPtr(p.Metadata.Name) })
+	require.Equal(t, []string{"b", "a", "c"}, names)
+}
+
+func TestCreateEnrollmentRequestAutoApproved(t *testing.T) {
+	require := require.New(t)
+
+	caClient, _, err := crypto.EnsureCA(&ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        t.TempDir(),
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			SerialFile:       "ca.serial",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+		},
+		DeviceManagementSignerName: "device-enrollment",
+	})
+	require.NoError(err)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: "auto-approved-device"},
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}, privateKey)
+	require.NoError(err)
+
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	orgId := store.NullOrgId
+
+	_, err = testStore.Fleet().Create(ctx, orgId, &domain.Fleet{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("berlin")},
+		Spec: domain.FleetSpec{
+			Selector: &domain.LabelSelector{MatchLabels: &map[string]string{"fleet": "berlin"}},
+		},
+	}, nil)
+	require.NoError(err)
+
+	policy := newTestEnrollmentApprovalPolicy("factory-berlin", 0, domain.EnrollmentApprovalPolicyMatch{
+		LabelSelector: lo.ToPtr("source=factory-berlin"),
+	})
+	policy.Spec.Labels = &map[string]string{"site": "berlin"}
+	policy.Spec.Fleet = lo.ToPtr("berlin")
+	_, err = testStore.EnrollmentApprovalPolicy().Create(ctx, orgId, &policy, nil)
+	require.NoError(err)
+
+	er := domain.EnrollmentRequest{
+		ApiVersion: "v1beta1",
+		Kind:       "EnrollmentRequest",
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr("auto-approved-device")},
+		Spec: domain.EnrollmentRequestSpec{
+			Csr:          string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes})),
+			DeviceStatus: lo.ToPtr(domain.NewDeviceStatus()),
+			Labels:       &map[string]string{"source": "factory-berlin"},
+		},
+	}
+	result, status := serviceHandler.CreateEnrollmentRequest(ctx, orgId, er)
+	require.Equal(domain.StatusCreated(), status)
+	require.NotNil(result.Status.Certificate)
+	require.NotNil(result.Status.Approval)
+	require.Equal("EnrollmentApprovalPolicy/factory-berlin", result.Status.Approval.ApprovedBy)
+	require.True(domain.IsStatusConditionTrue(result.Status.Conditions, domain.ConditionTypeEnrollmentRequestApproved))
+
+	device, err := testStore.Device().Get(ctx, orgId, "auto-approved-device")
+	require.NoError(err)
+	require.Equal(map[string]string{"site": "berlin", "fleet": "berlin", "source": "factory-berlin"}, lo.FromPtr(device.Metadata.Labels))
+
+	events, err := testStore.Event().List(ctx, orgId, store.ListParams{})
+	require.NoError(err)
+	require.True(lo.ContainsBy(events.Items, func(e domain.Event) bool {
+		return e.Reason == domain.EventReasonEnrollmentRequestAutoApproved
+	}))
+}
+
+func TestCreateEnrollmentRequestAutoApprovalSkipped(t *testing.T) {
+	require := require.New(t)
+
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, nil)
+	orgId := store.NullOrgId
+
+	policy := newTestEnrollmentApprovalPolicy("factory-munich", 0, domain.EnrollmentApprovalPolicyMatch{
+		LabelSelector: lo.ToPtr("source=factory-munich"),
+	})
+	_, err := testStore.EnrollmentApprovalPolicy().Create(ctx, orgId, &policy, nil)
+	require.NoError(err)
+
+	er := newTestPolicyEnrollmentRequest(nil)
+	require.Nil(serviceHandler.autoApproveEnrollmentRequest(ctx, orgId, er))
+
+	events, err := testStore.Event().List(ctx, orgId, store.ListParams{})
+	require.NoError(err)
+	require.Len(events.Items, 1)
+	require.Equal(domain.EventReasonEnrollmentRequestAutoApprovalSkipped, events.Items[0].Reason)
+}
+
+func TestCreateEnrollmentRequestAutoApprovalRollsBackDevice(t *testing.T) {
+	require := require.New(t)
+
+	caClient, _, err := crypto.EnsureCA(&ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        t.TempDir(),
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			SerialFile:       "ca.serial",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+		},
+		DeviceManagementSignerName: "device-enrollment",
+	})
+	require.NoError(err)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: "rolled-back-device"},
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}, privateKey)
+	require.NoError(err)
+
+	testStore := &TestStore{}
+	serviceHandler, ctx := newTestServiceHandler(t, testStore, caClient)
+	orgId := store.NullOrgId
+
+	policy := newTestEnrollmentApprovalPolicy("factory-berlin", 0, domain.EnrollmentApprovalPolicyMatch{
+		EnrollmentCredentials: &[]string{"client-enrollment"},
+	})
+	_, err = testStore.EnrollmentApprovalPolicy().Create(ctx, orgId, &policy, nil)
+	require.NoError(err)
+
+	// the request is not in the store, so writing its status fails after the device was created
+	er := newTestPolicyEnrollmentRequest(nil)
+	er.Metadata.Name = lo.ToPtr("rolled-back-device")
+	er.Spec.Csr = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes}))
+	require.Nil(serviceHandler.autoApproveEnrollmentRequest(ctx, orgId, er))
+
+	_, err = testStore.Device().Get(ctx, orgId, "rolled-back-device")
+	require.Error(err)
+
+	events, err := testStore.Event().List(ctx, orgId, store.ListParams{})
+	require.NoError(err)
+	require.True(lo.ContainsBy(events.Items, func(e domain.Event) bool {
+		return e.Reason == domain.EventReasonEnrollmentRequestAutoApprovalFailed
+	}))
+}
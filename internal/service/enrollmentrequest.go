@@ -64,7 +64,7 @@ func (h *ServiceHandler) verifyTPMEnrollmentRequest(er *domain.EnrollmentRequest
 	return nil
 }
 
-func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, enrollmentRequest *domain.EnrollmentRequest, approval *domain.EnrollmentRequestApprovalStatus) error {
+func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, enrollmentRequest *domain.EnrollmentRequest, approval *domain.EnrollmentRequestApprovalStatus, reason string) error {
 	if enrollmentRequest == nil {
 		return errors.New("approveAndSignEnrollmentRequest: enrollmentRequest is nil")
 	}
@@ -104,13 +104,18 @@ func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, e
 	condition := domain.Condition{
 		Type:    domain.ConditionTypeEnrollmentRequestApproved,
 		Status:  domain.ConditionStatusTrue,
-		Reason:  "ManuallyApproved",
+		Reason:  reason,
 		Message: "Approved by " + approval.ApprovedBy,
 	}
 	domain.SetStatusCondition(&enrollmentRequest.Status.Conditions, condition)
 	return nil
 }
 
+const (
+	enrollmentRequestManuallyApprovedReason = "ManuallyApproved"
+	enrollmentRequestAutoApprovedReason     = "AutoApproved"
+)
+
 func addStatusIfNeeded(enrollmentRequest *domain.EnrollmentRequest) {
 	if enrollmentRequest.Status == nil {
 		enrollmentRequest.Status = &domain.EnrollmentRequestStatus{
@@ -270,6 +275,16 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, orgId uuid
 		NilOutManagedObjectMetaProperties(&er.Metadata)
 	}
 
+	// record the enrollment certificate the agent submitted the request with, so that
+	// enrollment approval policies can match on it
+	if _, isAgent := ctx.Value(consts.AgentCtxKey).(string); isAgent {
+		if peerCertificate, err := signer.PeerCertificateFromCtx(ctx); err == nil {
+			annotations := util.EnsureMap(lo.FromPtr(er.Metadata.Annotations))
+			annotations[domain.EnrollmentRequestAnnotationEnrollmentCredential] = peerCertificate.Subject.CommonName
+			er.Metadata.Annotations = &annotations
+		}
+	}
+
 	// Check if knownRenderedVersion is provided and not "0", add awaitingReconnect annotation
 	if er.Spec.KnownRenderedVersion != nil && *er.Spec.KnownRenderedVersion != "" && *er.Spec.KnownRenderedVersion != "0" {
 		annotations := util.EnsureMap(lo.FromPtr(er.Metadata.Annotations))
@@ -302,7 +317,13 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, orgId uuid
 
 	// Use fromAPI=false for internal requests to preserve annotations
 	result, err := h.store.EnrollmentRequest().CreateWithFromAPI(ctx, orgId, &er, false, h.callbackEnrollmentRequestUpdated)
-	return result, StoreErrorToApiStatus(err, true, domain.EnrollmentRequestKind, er.Metadata.Name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, true, domain.EnrollmentRequestKind, er.Metadata.Name)
+	}
+	if approved := h.autoApproveEnrollmentRequest(ctx, orgId, result); approved != nil {
+		result = approved
+	}
+	return result, domain.StatusCreated()
 }
 
 func (h *ServiceHandler) ListEnrollmentRequests(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentRequestsParams) (*domain.EnrollmentRequestList, domain.Status) {
@@ -466,7 +487,7 @@ func (h *ServiceHandler) ApproveEnrollmentRequest(ctx context.Context, orgId uui
 		}
 		approvalStatusToReturn = &approvalStatus
 
-		err = approveAndSignEnrollmentRequest(ctx, h.ca, enrollmentReq, &approvalStatus)
+		err = approveAndSignEnrollmentRequest(ctx, h.ca, enrollmentReq, &approvalStatus, enrollmentRequestManuallyApprovedReason)
 		if err != nil {
 			status := domain.StatusBadRequest(fmt.Sprintf("Error approving and signing enrollment request: %v", err.Error()))
 			h.CreateEvent(ctx, orgId, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
@@ -490,7 +511,17 @@ func (h *ServiceHandler) ReplaceEnrollmentRequestStatus(ctx context.Context, org
 	addStatusIfNeeded(&er)
 
 	result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, &er, h.callbackEnrollmentRequestUpdated)
-	return result, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.EnrollmentRequestKind, &name)
+	}
+
+	// requests matching a policy that requires TPM verification are approved once the challenge succeeded
+	if result.Status != nil && isTPMChallengeSucceeded(result.Status.Conditions) {
+		if approved := h.autoApproveEnrollmentRequest(ctx, orgId, result); approved != nil {
+			result = approved
+		}
+	}
+	return result, domain.StatusOK()
 }
 
 func newSignRequestFromEnrollment(cfg *ca.Config, er *domain.EnrollmentRequest) (signer.SignRequest, bool, error) {
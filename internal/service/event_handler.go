@@ -396,6 +396,62 @@ func (h *EventHandler) HandleAuthProviderDeletedEvents(ctx context.Context, reso
 	h.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
 }
 
+//////////////////////////////////////////////////////
+//          EnrollmentApprovalPolicy Events         //
+//////////////////////////////////////////////////////
+
+// HandleEnrollmentApprovalPolicyUpdatedEvents handles enrollment approval policy update event emission logic
+func (h *EventHandler) HandleEnrollmentApprovalPolicyUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+		return
+	}
+	var updateDetails *domain.ResourceUpdatedDetails
+	if !created {
+		if oldPolicy, newPolicy, ok := castResources[domain.EnrollmentApprovalPolicy](oldResource, newResource); ok && oldPolicy != nil && newPolicy != nil {
+			updateDetails = h.computeResourceUpdatedDetails(oldPolicy.Metadata, newPolicy.Metadata)
+		}
+	}
+	h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+}
+
+//////////////////////////////////////////////////////
+//             Role and RoleBinding Events          //
+//////////////////////////////////////////////////////
+
+// HandleRoleUpdatedEvents handles role update event emission logic
+func (h *EventHandler) HandleRoleUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+		return
+	}
+	var updateDetails *domain.ResourceUpdatedDetails
+	if !created {
+		if oldRole, newRole, ok := castResources[domain.Role](oldResource, newResource); ok && oldRole != nil && newRole != nil {
+			updateDetails = h.computeResourceUpdatedDetails(oldRole.Metadata, newRole.Metadata)
+		}
+	}
+	h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+}
+
+// HandleRoleBindingUpdatedEvents handles role binding update event emission logic
+func (h *EventHandler) HandleRoleBindingUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+		return
+	}
+	var updateDetails *domain.ResourceUpdatedDetails
+	if !created {
+		if oldBinding, newBinding, ok := castResources[domain.RoleBinding](oldResource, newResource); ok && oldBinding != nil && newBinding != nil {
+			updateDetails = h.computeResourceUpdatedDetails(oldBinding.Metadata, newBinding.Metadata)
+		}
+	}
+	h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+}
+
 //////////////////////////////////////////////////////
 //               EnrollmentRequest Events           //
 //////////////////////////////////////////////////////
@@ -536,6 +592,19 @@ func (h *EventHandler) HandleCatalogUpdatedEvents(ctx context.Context, resourceK
 	}
 }
 
+// HandleBulkOperationUpdatedEvents handles bulk operation creation events.
+// Bulk operations are immutable, so only their creation is reported.
+func (h *EventHandler) HandleBulkOperationUpdatedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, _, _ interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+		return
+	}
+	if created {
+		h.CreateEvent(ctx, orgId, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, nil, h.log, nil))
+	}
+}
+
 func (h *EventHandler) emitResourceSyncConditionEvents(ctx context.Context, orgId uuid.UUID, name string, oldResourceSync, newResourceSync *domain.ResourceSync) {
 	if oldResourceSync == nil || newResourceSync == nil {
 		return
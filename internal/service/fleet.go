@@ -3,14 +3,22 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/rollout"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 func (h *ServiceHandler) CreateFleet(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet) (*domain.Fleet, domain.Status) {
@@ -25,6 +33,10 @@ func (h *ServiceHandler) CreateFleet(ctx context.Context, orgId uuid.UUID, fleet
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
 	}
 
+	if IsDryRunRequest(ctx) {
+		return h.dryRunFleet(ctx, orgId, &fleet, domain.StatusCreated())
+	}
+
 	result, err := h.store.Fleet().Create(ctx, orgId, &fleet, h.callbackFleetUpdated)
 	return result, StoreErrorToApiStatus(err, true, domain.FleetKind, fleet.Metadata.Name)
 }
@@ -37,6 +49,9 @@ func (h *ServiceHandler) ListFleets(ctx context.Context, orgId uuid.UUID, params
 
 	result, err := h.store.Fleet().List(ctx, orgId, *listParams, store.ListWithDevicesSummary(util.DefaultBoolIfNil(params.AddDevicesSummary, false)))
 	if err == nil {
+		for i := range result.Items {
+			setMaintenanceWindowStatus(&result.Items[i], time.Now())
+		}
 		return result, domain.StatusOK()
 	}
 
@@ -52,9 +67,33 @@ func (h *ServiceHandler) ListFleets(ctx context.Context, orgId uuid.UUID, params
 
 func (h *ServiceHandler) GetFleet(ctx context.Context, orgId uuid.UUID, name string, params domain.GetFleetParams) (*domain.Fleet, domain.Status) {
 	result, err := h.store.Fleet().Get(ctx, orgId, name, store.GetWithDeviceSummary(util.DefaultBoolIfNil(params.AddDevicesSummary, false)))
+	if err == nil {
+		setMaintenanceWindowStatus(result, time.Now())
+	}
 	return result, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 }
 
+// setMaintenanceWindowStatus reports whether a maintenance window of the fleet is open, and if not, when the next one opens.
+// It is computed when the fleet is read, since it depends on the current time.
+func setMaintenanceWindowStatus(fleet *domain.Fleet, now time.Time) {
+	windows, err := rollout.NewMaintenanceWindows(fleet)
+	if err != nil || !windows.Defined() {
+		return
+	}
+	if fleet.Status == nil {
+		fleet.Status = &domain.FleetStatus{}
+	}
+	if fleet.Status.Rollout == nil {
+		fleet.Status.Rollout = &domain.FleetRolloutStatus{}
+	}
+	isOpen := windows.IsOpen(now)
+	fleet.Status.Rollout.MaintenanceWindowOpen = lo.ToPtr(isOpen)
+	fleet.Status.Rollout.NextMaintenanceWindow = nil
+	if !isOpen {
+		fleet.Status.Rollout.NextMaintenanceWindow = windows.NextOpen(now)
+	}
+}
+
 func (h *ServiceHandler) ReplaceFleet(ctx context.Context, orgId uuid.UUID, name string, fleet domain.Fleet) (*domain.Fleet, domain.Status) {
 	// don't overwrite fields that are managed by the service
 	isInternal := IsInternalRequest(ctx)
@@ -73,6 +112,10 @@ func (h *ServiceHandler) ReplaceFleet(ctx context.Context, orgId uuid.UUID, name
 		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
 	}
 
+	if IsDryRunRequest(ctx) {
+		return h.dryRunFleet(ctx, orgId, &fleet, domain.StatusOK())
+	}
+
 	result, created, err := h.store.Fleet().CreateOrUpdate(ctx, orgId, &fleet, nil, !isInternal, h.callbackFleetUpdated)
 	return result, StoreErrorToApiStatus(err, created, domain.FleetKind, &name)
 }
@@ -102,6 +145,9 @@ func (h *ServiceHandler) DeleteFleet(ctx context.Context, orgId uuid.UUID, name
 
 func (h *ServiceHandler) GetFleetStatus(ctx context.Context, orgId uuid.UUID, name string) (*domain.Fleet, domain.Status) {
 	result, err := h.store.Fleet().Get(ctx, orgId, name)
+	if err == nil {
+		setMaintenanceWindowStatus(result, time.Now())
+	}
 	return result, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 }
 
@@ -134,10 +180,110 @@ func (h *ServiceHandler) PatchFleet(ctx context.Context, orgId uuid.UUID, name s
 	NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
 
+	if IsDryRunRequest(ctx) {
+		return h.dryRunFleet(ctx, orgId, newObj, domain.StatusOK())
+	}
+
 	result, err := h.store.Fleet().Update(ctx, orgId, newObj, nil, true, h.callbackFleetUpdated)
 	return result, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 }
 
+// dryRunFleet renders the device template of a fleet for a sample of the devices matching its selector, without
+// persisting the fleet.  The rendered devices are returned in the status of the fleet.
+func (h *ServiceHandler) dryRunFleet(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet, status domain.Status) (*domain.Fleet, domain.Status) {
+	devices, sampleStatus := h.dryRunFleetSample(ctx, orgId, fleet)
+	if sampleStatus.Code != http.StatusOK {
+		return nil, sampleStatus
+	}
+
+	templateVersionStatus := &domain.TemplateVersionStatus{
+		Applications:      fleet.Spec.Template.Spec.Applications,
+		Config:            fleet.Spec.Template.Spec.Config,
+		ConfigDriftPolicy: fleet.Spec.Template.Spec.ConfigDriftPolicy,
+		Os:                fleet.Spec.Template.Spec.Os,
+		Resources:         fleet.Spec.Template.Spec.Resources,
+		Systemd:           fleet.Spec.Template.Spec.Systemd,
+		UpdatePolicy:      fleet.Spec.Template.Spec.UpdatePolicy,
+	}
+	dryRunDevices := []domain.Device{}
+	var renderErrs []error
+	for i := range devices {
+		device := &devices[i]
+		spec, errs := rollout.RenderDeviceSpec(device, templateVersionStatus)
+		if len(errs) == 0 {
+			errs = spec.Validate(false)
+		}
+		if len(errs) > 0 {
+			renderErrs = append(renderErrs, fmt.Errorf("device %s: %w", lo.FromPtr(device.Metadata.Name), errors.Join(errs...)))
+			continue
+		}
+		dryRunDevices = append(dryRunDevices, domain.Device{
+			ApiVersion: device.ApiVersion,
+			Kind:       device.Kind,
+			Metadata: domain.ObjectMeta{
+				Name:   device.Metadata.Name,
+				Labels: device.Metadata.Labels,
+			},
+			Spec: spec,
+		})
+	}
+	if len(renderErrs) > 0 {
+		return nil, domain.StatusBadRequest(fmt.Sprintf("failed rendering device specs: %v", errors.Join(renderErrs...)))
+	}
+
+	fleet.Status = &domain.FleetStatus{
+		Conditions:    []domain.Condition{},
+		DryRunDevices: &dryRunDevices,
+	}
+	return fleet, status
+}
+
+// dryRunFleetSample returns up to FleetDryRunSampleSize devices matching the full selector of the fleet.  Devices
+// already owned by the fleet come first, since they are the ones the change is rolled out to.
+func (h *ServiceHandler) dryRunFleetSample(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet) ([]domain.Device, domain.Status) {
+	var labelSelector *selector.LabelSelector
+	if fleet.Spec.Selector != nil {
+		parts := lo.MapToSlice(lo.FromPtr(fleet.Spec.Selector.MatchLabels), func(k, v string) string { return k + "=" + v })
+		sort.Strings(parts)
+		parts = append(parts, lo.Map(lo.FromPtr(fleet.Spec.Selector.MatchExpressions), func(e domain.MatchExpression, _ int) string { return e.String() })...)
+		if len(parts) > 0 {
+			var err error
+			if labelSelector, err = selector.NewLabelSelector(strings.Join(parts, ",")); err != nil {
+				return nil, domain.StatusBadRequest(err.Error())
+			}
+		}
+	}
+	ownerSelector, err := selector.NewFieldSelectorFromMap(map[string]string{"metadata.owner": util.ResourceOwner(domain.FleetKind, lo.FromPtr(fleet.Metadata.Name))})
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+
+	owned, err := h.store.Device().List(ctx, orgId, store.ListParams{Limit: FleetDryRunSampleSize, LabelSelector: labelSelector, FieldSelector: ownerSelector})
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, nil)
+	}
+	sample := owned.Items
+	if len(sample) >= FleetDryRunSampleSize {
+		return sample[:FleetDryRunSampleSize], domain.StatusOK()
+	}
+
+	matching, err := h.store.Device().List(ctx, orgId, store.ListParams{Limit: FleetDryRunSampleSize, LabelSelector: labelSelector})
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.DeviceKind, nil)
+	}
+	sampled := lo.SliceToMap(sample, func(d domain.Device) (string, struct{}) { return lo.FromPtr(d.Metadata.Name), struct{}{} })
+	for _, device := range matching.Items {
+		if len(sample) == FleetDryRunSampleSize {
+			break
+		}
+		if _, ok := sampled[lo.FromPtr(device.Metadata.Name)]; ok {
+			continue
+		}
+		sample = append(sample, device)
+	}
+	return sample, domain.StatusOK()
+}
+
 func (h *ServiceHandler) ListFleetRolloutDeviceSelection(ctx context.Context, orgId uuid.UUID) (*domain.FleetList, domain.Status) {
 	result, err := h.store.Fleet().ListRolloutDeviceSelection(ctx, orgId)
 	return result, StoreErrorToApiStatus(err, false, domain.FleetKind, nil)
@@ -168,6 +314,68 @@ func (h *ServiceHandler) GetFleetRepositoryRefs(ctx context.Context, orgId uuid.
 	return result, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 }
 
+// RollbackFleet makes an existing template version of the fleet current again.  The fleet is pinned to the template
+// version until a new template version is created, and all devices of the fleet are re-rendered from it.
+func (h *ServiceHandler) RollbackFleet(ctx context.Context, orgId uuid.UUID, name string, request domain.FleetRollbackRequest) (*domain.Fleet, domain.Status) {
+	if request.TemplateVersion == "" {
+		return nil, domain.StatusBadRequest("templateVersion must be specified")
+	}
+	fleet, err := h.store.Fleet().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
+	}
+	if _, err = h.store.TemplateVersion().Get(ctx, orgId, name, request.TemplateVersion); err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.TemplateVersionKind, &request.TemplateVersion)
+	}
+	fromTemplateVersion, _ := fleet.GetAnnotation(domain.FleetAnnotationTemplateVersion)
+	if fromTemplateVersion == request.TemplateVersion {
+		return nil, domain.StatusConflict(fmt.Sprintf("fleet %s is already at template version %s", name, request.TemplateVersion))
+	}
+
+	annotations := map[string]string{
+		domain.FleetAnnotationTemplateVersion:          request.TemplateVersion,
+		domain.FleetAnnotationRollbackTemplateVersion:  request.TemplateVersion,
+		domain.FleetAnnotationDeployingTemplateVersion: request.TemplateVersion,
+	}
+	// The rollback supersedes any rollout in progress
+	deleteKeys := []string{
+		domain.FleetAnnotationBatchNumber,
+		domain.FleetAnnotationRolloutApproved,
+		domain.FleetAnnotationDeviceSelectionConfigDigest,
+		domain.FleetAnnotationLastBatchCompletionReport,
+		domain.FleetAnnotationPreviousTemplateVersion,
+		domain.FleetAnnotationCanarySoakStartTime,
+	}
+	rolledBackAt := time.Now().UTC()
+	// The annotations and the status are written together, a fleet carrying the rollback annotation with a stale
+	// status would be skipped by the device selection reconciler
+	result, err := h.store.Fleet().UpdateAnnotationsAndStatus(ctx, orgId, name, annotations, deleteKeys, func(status *domain.FleetStatus) {
+		if status.Rollout == nil {
+			status.Rollout = &domain.FleetRolloutStatus{}
+		}
+		status.Rollout.LastRollback = &domain.FleetRollbackStatus{
+			TemplateVersion:     request.TemplateVersion,
+			FromTemplateVersion: lo.EmptyableToPtr(fromTemplateVersion),
+			RolledBackAt:        rolledBackAt,
+		}
+		if domain.FindStatusCondition(status.Conditions, domain.ConditionTypeFleetRolloutInProgress) != nil {
+			domain.SetStatusCondition(&status.Conditions, domain.Condition{
+				Type:    domain.ConditionTypeFleetRolloutInProgress,
+				Status:  domain.ConditionStatusFalse,
+				Reason:  domain.RolloutRolledBackReason,
+				Message: fmt.Sprintf("Rolled back to template version %s", request.TemplateVersion),
+			})
+		}
+	}, h.callbackFleetUpdated)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
+	}
+
+	// The event triggers the rollout of the template version to all devices of the fleet
+	h.CreateEvent(ctx, orgId, common.GetFleetRolledBackEvent(ctx, name, request.TemplateVersion, fromTemplateVersion))
+	return result, domain.StatusOK()
+}
+
 // callbackFleetUpdated is the fleet-specific callback that handles fleet events
 func (h *ServiceHandler) callbackFleetUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleFleetUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
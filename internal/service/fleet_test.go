@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -346,3 +349,263 @@ func TestDeleteFleet(t *testing.T) {
 		})
 	}
 }
+
+func TestRollbackFleet(t *testing.T) {
+	tests := []struct {
+		name               string
+		templateVersion    string
+		expectedStatusCode int32
+	}{
+		{
+			name:               "rollback to previous template version succeeds",
+			templateVersion:    "tv-1",
+			expectedStatusCode: statusSuccessCode,
+		},
+		{
+			name:               "rollback to current template version conflicts",
+			templateVersion:    "tv-2",
+			expectedStatusCode: int32(http.StatusConflict),
+		},
+		{
+			name:               "rollback to non-existent template version fails",
+			templateVersion:    "tv-3",
+			expectedStatusCode: statusNotFoundCode,
+		},
+		{
+			name:               "rollback without template version fails",
+			templateVersion:    "",
+			expectedStatusCode: statusBadRequestCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			serviceHandler, testStore := createDeleteTestServiceHandler()
+			ctx := context.Background()
+			testOrgId := uuid.New()
+
+			fleet := createTestFleet("fleet", nil)
+			fleet.Metadata.Annotations = &map[string]string{
+				domain.FleetAnnotationTemplateVersion:          "tv-2",
+				domain.FleetAnnotationDeployingTemplateVersion: "tv-2",
+				domain.FleetAnnotationBatchNumber:              "1",
+			}
+			fleet.Status = &domain.FleetStatus{
+				Conditions: []domain.Condition{
+					{
+						Type:   domain.ConditionTypeFleetRolloutInProgress,
+						Status: domain.ConditionStatusTrue,
+						Reason: domain.RolloutActiveReason,
+					},
+				},
+			}
+			_, err := serviceHandler.store.Fleet().Create(ctx, testOrgId, &fleet, nil)
+			require.NoError(err)
+			testStore.TemplateVersion()
+			for _, name := range []string{"tv-1", "tv-2"} {
+				*testStore.templateVersions.templateVersions = append(*testStore.templateVersions.templateVersions, domain.TemplateVersion{
+					Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)},
+					Spec:     domain.TemplateVersionSpec{Fleet: "fleet"},
+				})
+			}
+
+			result, status := serviceHandler.RollbackFleet(ctx, testOrgId, "fleet", domain.FleetRollbackRequest{TemplateVersion: tt.templateVersion})
+			require.Equal(tt.expectedStatusCode, status.Code)
+			events, err := serviceHandler.store.Event().List(ctx, testOrgId, store.ListParams{})
+			require.NoError(err)
+			if tt.expectedStatusCode != statusSuccessCode {
+				require.Empty(events.Items)
+				return
+			}
+
+			annotations := lo.FromPtr(result.Metadata.Annotations)
+			require.Equal(tt.templateVersion, annotations[domain.FleetAnnotationTemplateVersion])
+			require.Equal(tt.templateVersion, annotations[domain.FleetAnnotationRollbackTemplateVersion])
+			require.Equal(tt.templateVersion, annotations[domain.FleetAnnotationDeployingTemplateVersion])
+			require.NotContains(annotations, domain.FleetAnnotationBatchNumber)
+
+			require.NotNil(result.Status.Rollout)
+			require.NotNil(result.Status.Rollout.LastRollback)
+			require.Equal(tt.templateVersion, result.Status.Rollout.LastRollback.TemplateVersion)
+			require.Equal("tv-2", lo.FromPtr(result.Status.Rollout.LastRollback.FromTemplateVersion))
+			condition := domain.FindStatusCondition(result.Status.Conditions, domain.ConditionTypeFleetRolloutInProgress)
+			require.NotNil(condition)
+			require.Equal(domain.RolloutRolledBackReason, condition.Reason)
+
+			require.Len(events.Items, 1)
+			require.Equal(domain.EventReasonFleetRolledBack, events.Items[0].Reason)
+			require.Equal(domain.EventTypeWarning, events.Items[0].Type)
+		})
+	}
+}
+
+func TestSetMaintenanceWindowStatus(t *testing.T) {
+	require := require.New(t)
+	start := time.Date(2025, 3, 1, 2, 0, 0, 0, time.UTC)
+	fleet := &domain.Fleet{
+		Spec: domain.FleetSpec{
+			RolloutPolicy: &domain.RolloutPolicy{
+				MaintenanceWindows: &[]domain.MaintenanceWindow{
+					{
+						TimeZone: lo.ToPtr("UTC"),
+						At:       lo.ToPtr("0 2 * * *"),
+						Duration: lo.ToPtr("2h"),
+					},
+				},
+			},
+		},
+	}
+
+	setMaintenanceWindowStatus(fleet, start.Add(time.Hour))
+	require.NotNil(fleet.Status)
+	require.NotNil(fleet.Status.Rollout)
+	require.True(lo.FromPtr(fleet.Status.Rollout.MaintenanceWindowOpen))
+	require.Nil(fleet.Status.Rollout.NextMaintenanceWindow)
+
+	setMaintenanceWindowStatus(fleet, start.Add(3*time.Hour))
+	require.False(lo.FromPtr(fleet.Status.Rollout.MaintenanceWindowOpen))
+	require.NotNil(fleet.Status.Rollout.NextMaintenanceWindow)
+	require.True(start.Add(24 * time.Hour).Equal(*fleet.Status.Rollout.NextMaintenanceWindow))
+
+	// Fleets without maintenance windows don't report them
+	noWindows := &domain.Fleet{}
+	setMaintenanceWindowStatus(noWindows, start)
+	require.Nil(noWindows.Status)
+}
+
+func TestDryRunFleet(t *testing.T) {
+	tests := []struct {
+		name               string
+		image              string
+		expectedStatusCode int32
+		expectedImage      string
+	}{
+		{
+			name:               "renders template parameters of matching devices",
+			image:              "img:{{ .metadata.labels.version }}",
+			expectedStatusCode: statusCreatedCode,
+			expectedImage:      "img:v1",
+		},
+		{
+			name:               "fails when a device is missing a referenced label",
+			image:              "img:{{ .metadata.labels.missing }}",
+			expectedStatusCode: statusBadRequestCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			serviceHandler, testStore := createDeleteTestServiceHandler()
+			ctx := context.WithValue(context.Background(), consts.DryRunCtxKey, true)
+			testOrgId := uuid.New()
+
+			device := domain.Device{
+				ApiVersion: "v1beta1",
+				Kind:       domain.DeviceKind,
+				Metadata: domain.ObjectMeta{
+					Name:   lo.ToPtr("device"),
+					Labels: &map[string]string{"devKey": "devValue", "version": "v1"},
+				},
+				Spec: &domain.DeviceSpec{Os: &domain.DeviceOsSpec{Image: "img:v0"}},
+			}
+			_, err := testStore.Device().Create(ctx, testOrgId, &device, nil)
+			require.NoError(err)
+
+			fleet := createTestFleet("fleet", nil)
+			fleet.Spec.Template.Spec.Os.Image = tt.image
+			result, status := serviceHandler.CreateFleet(ctx, testOrgId, fleet)
+			require.Equal(tt.expectedStatusCode, status.Code)
+
+			_, err = testStore.Fleet().Get(ctx, testOrgId, "fleet")
+			require.ErrorIs(err, flterrors.ErrResourceNotFound)
+			if tt.expectedStatusCode != statusCreatedCode {
+				return
+			}
+
+			require.NotNil(result.Status)
+			dryRunDevices := lo.FromPtr(result.Status.DryRunDevices)
+			require.Len(dryRunDevices, 1)
+			require.Equal("device", lo.FromPtr(dryRunDevices[0].Metadata.Name))
+			require.Equal(tt.expectedImage, dryRunDevices[0].Spec.Os.Image)
+
+			stored, err := testStore.Device().Get(ctx, testOrgId, "device")
+			require.NoError(err)
+			require.Equal("img:v0", stored.Spec.Os.Image)
+		})
+	}
+}
+
+// ownerFilteringDeviceStore records the list requests, and filters the devices by owner when the request selects
+// the devices owned by a fleet
+type ownerFilteringDeviceStore struct {
+	*DummyDevice
+	owner      string
+	listParams []store.ListParams
+}
+
+func (s *ownerFilteringDeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.DeviceList, error) {
+	s.listParams = append(s.listParams, listParams)
+	devices, err := s.DummyDevice.List(ctx, orgId, listParams)
+	if err != nil || listParams.FieldSelector == nil {
+		return devices, err
+	}
+	devices.Items = lo.Filter(devices.Items, func(d domain.Device, _ int) bool { return lo.FromPtr(d.Metadata.Owner) == s.owner })
+	return devices, nil
+}
+
+type ownerFilteringTestStore struct {
+	*TestStore
+	devices *ownerFilteringDeviceStore
+}
+
+func (s *ownerFilteringTestStore) Device() store.Device {
+	return s.devices
+}
+
+func TestDryRunFleetSample(t *testing.T) {
+	require := require.New(t)
+	testStore := &TestStore{}
+	devices := &ownerFilteringDeviceStore{DummyDevice: testStore.Device().(*DummyDevice), owner: "Fleet/fleet"}
+	serviceHandler := &ServiceHandler{store: &ownerFilteringTestStore{TestStore: testStore, devices: devices}}
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	createDevice := func(name string, owner *string) {
+		_, err := testStore.Device().Create(ctx, orgId, &domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr(name), Owner: owner},
+			Spec:     &domain.DeviceSpec{},
+		}, nil)
+		require.NoError(err)
+	}
+	for i := 1; i <= FleetDryRunSampleSize; i++ {
+		createDevice(fmt.Sprintf("other-%d", i), nil)
+	}
+	createDevice("owned-1", lo.ToPtr("Fleet/fleet"))
+	createDevice("owned-2", lo.ToPtr("Fleet/fleet"))
+
+	fleet := createTestFleet("fleet", nil)
+	fleet.Spec.Selector = &domain.LabelSelector{
+		MatchLabels: &map[string]string{"devKey": "devValue"},
+		MatchExpressions: &domain.MatchExpressions{
+			{Key: "region", Operator: domain.In, Values: lo.ToPtr([]string{"eu", "us"})},
+		},
+	}
+	sample, status := serviceHandler.dryRunFleetSample(ctx, orgId, &fleet)
+	require.Equal(domain.StatusOK(), status)
+
+	// The devices owned by the fleet come first, followed by the other matching devices
+	names := lo.Map(sample, func(d domain.Device, _ int) string { return lo.FromPtr(d.Metadata.Name) })
+	require.Len(names, FleetDryRunSampleSize)
+	require.Equal([]string{"owned-1", "owned-2", "other-1"}, names[:3])
+	require.Equal(len(names), len(lo.Uniq(names)))
+
+	// Both requests select devices by the full selector of the fleet
+	require.Len(devices.listParams, 2)
+	for _, params := range devices.listParams {
+		require.Equal(selector.NewLabelSelectorOrDie("devKey=devValue,region in (eu,us)"), params.LabelSelector)
+	}
+	require.Equal(selector.NewFieldSelectorFromMapOrDie(map[string]string{"metadata.owner": "Fleet/fleet"}), devices.listParams[0].FieldSelector)
+	require.Nil(devices.listParams[1].FieldSelector)
+}
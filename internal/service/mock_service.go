@@ -44,6 +44,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AggregateDevices mocks base method.
+func (m *MockService) AggregateDevices(ctx context.Context, orgId uuid.UUID, params domain.AggregateDevicesParams) (*domain.DeviceAggregate, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateDevices", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.DeviceAggregate)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// AggregateDevices indicates an expected call of AggregateDevices.
+func (mr *MockServiceMockRecorder) AggregateDevices(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateDevices", reflect.TypeOf((*MockService)(nil).AggregateDevices), ctx, orgId, params)
+}
+
 // ApproveEnrollmentRequest mocks base method.
 func (m *MockService) ApproveEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, approval domain.EnrollmentRequestApproval) (*domain.EnrollmentRequestApprovalStatus, domain.Status) {
 	m.ctrl.T.Helper()
@@ -59,6 +74,21 @@ func (mr *MockServiceMockRecorder) ApproveEnrollmentRequest(ctx, orgId, name, ap
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveEnrollmentRequest", reflect.TypeOf((*MockService)(nil).ApproveEnrollmentRequest), ctx, orgId, name, approval)
 }
 
+// CancelBulkOperation mocks base method.
+func (m *MockService) CancelBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBulkOperation", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.BulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CancelBulkOperation indicates an expected call of CancelBulkOperation.
+func (mr *MockServiceMockRecorder) CancelBulkOperation(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBulkOperation", reflect.TypeOf((*MockService)(nil).CancelBulkOperation), ctx, orgId, name)
+}
+
 // CountDevices mocks base method.
 func (m *MockService) CountDevices(ctx context.Context, orgId uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (int64, domain.Status) {
 	m.ctrl.T.Helper()
@@ -104,6 +134,21 @@ func (mr *MockServiceMockRecorder) CreateAuthProvider(ctx, orgId, authProvider a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthProvider", reflect.TypeOf((*MockService)(nil).CreateAuthProvider), ctx, orgId, authProvider)
 }
 
+// CreateBulkOperation mocks base method.
+func (m *MockService) CreateBulkOperation(ctx context.Context, orgId uuid.UUID, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBulkOperation", ctx, orgId, operation)
+	ret0, _ := ret[0].(*domain.BulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateBulkOperation indicates an expected call of CreateBulkOperation.
+func (mr *MockServiceMockRecorder) CreateBulkOperation(ctx, orgId, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBulkOperation", reflect.TypeOf((*MockService)(nil).CreateBulkOperation), ctx, orgId, operation)
+}
+
 // CreateCatalog mocks base method.
 func (m *MockService) CreateCatalog(ctx context.Context, orgId uuid.UUID, catalog domain.Catalog) (*domain.Catalog, domain.Status) {
 	m.ctrl.T.Helper()
@@ -164,6 +209,21 @@ func (mr *MockServiceMockRecorder) CreateDevice(ctx, orgId, device any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*MockService)(nil).CreateDevice), ctx, orgId, device)
 }
 
+// CreateEnrollmentApprovalPolicy mocks base method.
+func (m *MockService) CreateEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEnrollmentApprovalPolicy", ctx, orgId, policy)
+	ret0, _ := ret[0].(*domain.EnrollmentApprovalPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateEnrollmentApprovalPolicy indicates an expected call of CreateEnrollmentApprovalPolicy.
+func (mr *MockServiceMockRecorder) CreateEnrollmentApprovalPolicy(ctx, orgId, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnrollmentApprovalPolicy", reflect.TypeOf((*MockService)(nil).CreateEnrollmentApprovalPolicy), ctx, orgId, policy)
+}
+
 // CreateEnrollmentRequest mocks base method.
 func (m *MockService) CreateEnrollmentRequest(ctx context.Context, orgId uuid.UUID, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -236,6 +296,36 @@ func (mr *MockServiceMockRecorder) CreateResourceSync(ctx, orgId, rs any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceSync", reflect.TypeOf((*MockService)(nil).CreateResourceSync), ctx, orgId, rs)
 }
 
+// CreateRole mocks base method.
+func (m *MockService) CreateRole(ctx context.Context, orgId uuid.UUID, role domain.Role) (*domain.Role, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", ctx, orgId, role)
+	ret0, _ := ret[0].(*domain.Role)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockServiceMockRecorder) CreateRole(ctx, orgId, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockService)(nil).CreateRole), ctx, orgId, role)
+}
+
+// CreateRoleBinding mocks base method.
+func (m *MockService) CreateRoleBinding(ctx context.Context, orgId uuid.UUID, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoleBinding", ctx, orgId, binding)
+	ret0, _ := ret[0].(*domain.RoleBinding)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateRoleBinding indicates an expected call of CreateRoleBinding.
+func (mr *MockServiceMockRecorder) CreateRoleBinding(ctx, orgId, binding any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoleBinding", reflect.TypeOf((*MockService)(nil).CreateRoleBinding), ctx, orgId, binding)
+}
+
 // CreateTemplateVersion mocks base method.
 func (m *MockService) CreateTemplateVersion(ctx context.Context, orgId uuid.UUID, tv domain.TemplateVersion, immediateRollout bool) (*domain.TemplateVersion, domain.Status) {
 	m.ctrl.T.Helper()
@@ -280,6 +370,20 @@ func (mr *MockServiceMockRecorder) DeleteAuthProvider(ctx, orgId, name any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthProvider", reflect.TypeOf((*MockService)(nil).DeleteAuthProvider), ctx, orgId, name)
 }
 
+// DeleteBulkOperation mocks base method.
+func (m *MockService) DeleteBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBulkOperation", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteBulkOperation indicates an expected call of DeleteBulkOperation.
+func (mr *MockServiceMockRecorder) DeleteBulkOperation(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBulkOperation", reflect.TypeOf((*MockService)(nil).DeleteBulkOperation), ctx, orgId, name)
+}
+
 // DeleteCatalog mocks base method.
 func (m *MockService) DeleteCatalog(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -336,6 +440,20 @@ func (mr *MockServiceMockRecorder) DeleteDevice(ctx, orgId, name any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockService)(nil).DeleteDevice), ctx, orgId, name)
 }
 
+// DeleteEnrollmentApprovalPolicy mocks base method.
+func (m *MockService) DeleteEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnrollmentApprovalPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteEnrollmentApprovalPolicy indicates an expected call of DeleteEnrollmentApprovalPolicy.
+func (mr *MockServiceMockRecorder) DeleteEnrollmentApprovalPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnrollmentApprovalPolicy", reflect.TypeOf((*MockService)(nil).DeleteEnrollmentApprovalPolicy), ctx, orgId, name)
+}
+
 // DeleteEnrollmentRequest mocks base method.
 func (m *MockService) DeleteEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -407,6 +525,34 @@ func (mr *MockServiceMockRecorder) DeleteResourceSync(ctx, orgId, name any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceSync", reflect.TypeOf((*MockService)(nil).DeleteResourceSync), ctx, orgId, name)
 }
 
+// DeleteRole mocks base method.
+func (m *MockService) DeleteRole(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockServiceMockRecorder) DeleteRole(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockService)(nil).DeleteRole), ctx, orgId, name)
+}
+
+// DeleteRoleBinding mocks base method.
+func (m *MockService) DeleteRoleBinding(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoleBinding", ctx, orgId, name)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// DeleteRoleBinding indicates an expected call of DeleteRoleBinding.
+func (mr *MockServiceMockRecorder) DeleteRoleBinding(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoleBinding", reflect.TypeOf((*MockService)(nil).DeleteRoleBinding), ctx, orgId, name)
+}
+
 // DeleteTemplateVersion mocks base method.
 func (m *MockService) DeleteTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet, name string) domain.Status {
 	m.ctrl.T.Helper()
@@ -481,6 +627,36 @@ func (mr *MockServiceMockRecorder) GetAuthProviderByIssuerAndClientId(ctx, orgId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthProviderByIssuerAndClientId", reflect.TypeOf((*MockService)(nil).GetAuthProviderByIssuerAndClientId), ctx, orgId, issuer, clientId)
 }
 
+// GetBulkOperation mocks base method.
+func (m *MockService) GetBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBulkOperation", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.BulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetBulkOperation indicates an expected call of GetBulkOperation.
+func (mr *MockServiceMockRecorder) GetBulkOperation(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkOperation", reflect.TypeOf((*MockService)(nil).GetBulkOperation), ctx, orgId, name)
+}
+
+// GetCARotationStatus mocks base method.
+func (m *MockService) GetCARotationStatus(ctx context.Context, orgId uuid.UUID) (*domain.CARotationStatus, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCARotationStatus", ctx, orgId)
+	ret0, _ := ret[0].(*domain.CARotationStatus)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetCARotationStatus indicates an expected call of GetCARotationStatus.
+func (mr *MockServiceMockRecorder) GetCARotationStatus(ctx, orgId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCARotationStatus", reflect.TypeOf((*MockService)(nil).GetCARotationStatus), ctx, orgId)
+}
+
 // GetCatalog mocks base method.
 func (m *MockService) GetCatalog(ctx context.Context, orgId uuid.UUID, name string) (*domain.Catalog, domain.Status) {
 	m.ctrl.T.Helper()
@@ -526,6 +702,21 @@ func (mr *MockServiceMockRecorder) GetCatalogStatus(ctx, orgId, name any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCatalogStatus", reflect.TypeOf((*MockService)(nil).GetCatalogStatus), ctx, orgId, name)
 }
 
+// GetCertificateRevocationList mocks base method.
+func (m *MockService) GetCertificateRevocationList(ctx context.Context, orgId uuid.UUID, params domain.GetCertificateRevocationListParams) ([]byte, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateRevocationList", ctx, orgId, params)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetCertificateRevocationList indicates an expected call of GetCertificateRevocationList.
+func (mr *MockServiceMockRecorder) GetCertificateRevocationList(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateRevocationList", reflect.TypeOf((*MockService)(nil).GetCertificateRevocationList), ctx, orgId, params)
+}
+
 // GetCertificateSigningRequest mocks base method.
 func (m *MockService) GetCertificateSigningRequest(ctx context.Context, orgId uuid.UUID, name string) (*domain.CertificateSigningRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -661,6 +852,21 @@ func (mr *MockServiceMockRecorder) GetDevicesSummary(ctx, orgId, params, annotat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevicesSummary", reflect.TypeOf((*MockService)(nil).GetDevicesSummary), ctx, orgId, params, annotationSelector)
 }
 
+// GetEnrollmentApprovalPolicy mocks base method.
+func (m *MockService) GetEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollmentApprovalPolicy", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.EnrollmentApprovalPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetEnrollmentApprovalPolicy indicates an expected call of GetEnrollmentApprovalPolicy.
+func (mr *MockServiceMockRecorder) GetEnrollmentApprovalPolicy(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentApprovalPolicy", reflect.TypeOf((*MockService)(nil).GetEnrollmentApprovalPolicy), ctx, orgId, name)
+}
+
 // GetEnrollmentConfig mocks base method.
 func (m *MockService) GetEnrollmentConfig(ctx context.Context, orgId uuid.UUID, params domain.GetEnrollmentConfigParams) (*domain.EnrollmentConfig, domain.Status) {
 	m.ctrl.T.Helper()
@@ -841,6 +1047,36 @@ func (mr *MockServiceMockRecorder) GetResourceSync(ctx, orgId, name any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceSync", reflect.TypeOf((*MockService)(nil).GetResourceSync), ctx, orgId, name)
 }
 
+// GetRole mocks base method.
+func (m *MockService) GetRole(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.Role)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockServiceMockRecorder) GetRole(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockService)(nil).GetRole), ctx, orgId, name)
+}
+
+// GetRoleBinding mocks base method.
+func (m *MockService) GetRoleBinding(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleBinding", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.RoleBinding)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetRoleBinding indicates an expected call of GetRoleBinding.
+func (mr *MockServiceMockRecorder) GetRoleBinding(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleBinding", reflect.TypeOf((*MockService)(nil).GetRoleBinding), ctx, orgId, name)
+}
+
 // GetTemplateVersion mocks base method.
 func (m *MockService) GetTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet, name string) (*domain.TemplateVersion, domain.Status) {
 	m.ctrl.T.Helper()
@@ -856,6 +1092,21 @@ func (mr *MockServiceMockRecorder) GetTemplateVersion(ctx, orgId, fleet, name an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersion", reflect.TypeOf((*MockService)(nil).GetTemplateVersion), ctx, orgId, fleet, name)
 }
 
+// InstallCatalogItem mocks base method.
+func (m *MockService) InstallCatalogItem(ctx context.Context, orgId uuid.UUID, catalogName, itemName string, request domain.CatalogItemInstallRequest) (*domain.CatalogItemInstallation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallCatalogItem", ctx, orgId, catalogName, itemName, request)
+	ret0, _ := ret[0].(*domain.CatalogItemInstallation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// InstallCatalogItem indicates an expected call of InstallCatalogItem.
+func (mr *MockServiceMockRecorder) InstallCatalogItem(ctx, orgId, catalogName, itemName, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallCatalogItem", reflect.TypeOf((*MockService)(nil).InstallCatalogItem), ctx, orgId, catalogName, itemName, request)
+}
+
 // ListAllAuthProviders mocks base method.
 func (m *MockService) ListAllAuthProviders(ctx context.Context, params domain.ListAuthProvidersParams) (*domain.AuthProviderList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -901,6 +1152,36 @@ func (mr *MockServiceMockRecorder) ListAuthProviders(ctx, orgId, params any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthProviders", reflect.TypeOf((*MockService)(nil).ListAuthProviders), ctx, orgId, params)
 }
 
+// ListBulkOperations mocks base method.
+func (m *MockService) ListBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListBulkOperationsParams) (*domain.BulkOperationList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBulkOperations", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.BulkOperationList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListBulkOperations indicates an expected call of ListBulkOperations.
+func (mr *MockServiceMockRecorder) ListBulkOperations(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBulkOperations", reflect.TypeOf((*MockService)(nil).ListBulkOperations), ctx, orgId, params)
+}
+
+// ListCARotationDevices mocks base method.
+func (m *MockService) ListCARotationDevices(ctx context.Context, orgId uuid.UUID, params domain.ListCARotationDevicesParams) (*domain.CARotationDeviceList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCARotationDevices", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.CARotationDeviceList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListCARotationDevices indicates an expected call of ListCARotationDevices.
+func (mr *MockServiceMockRecorder) ListCARotationDevices(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCARotationDevices", reflect.TypeOf((*MockService)(nil).ListCARotationDevices), ctx, orgId, params)
+}
+
 // ListCatalogItems mocks base method.
 func (m *MockService) ListCatalogItems(ctx context.Context, orgId uuid.UUID, catalogName string, params domain.ListCatalogItemsParams) (*domain.CatalogItemList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1006,6 +1287,21 @@ func (mr *MockServiceMockRecorder) ListDisruptionBudgetFleets(ctx, orgId any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisruptionBudgetFleets", reflect.TypeOf((*MockService)(nil).ListDisruptionBudgetFleets), ctx, orgId)
 }
 
+// ListEnrollmentApprovalPolicies mocks base method.
+func (m *MockService) ListEnrollmentApprovalPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentApprovalPoliciesParams) (*domain.EnrollmentApprovalPolicyList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnrollmentApprovalPolicies", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.EnrollmentApprovalPolicyList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListEnrollmentApprovalPolicies indicates an expected call of ListEnrollmentApprovalPolicies.
+func (mr *MockServiceMockRecorder) ListEnrollmentApprovalPolicies(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollmentApprovalPolicies", reflect.TypeOf((*MockService)(nil).ListEnrollmentApprovalPolicies), ctx, orgId, params)
+}
+
 // ListEnrollmentRequests mocks base method.
 func (m *MockService) ListEnrollmentRequests(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentRequestsParams) (*domain.EnrollmentRequestList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1126,6 +1422,36 @@ func (mr *MockServiceMockRecorder) ListResourceSyncs(ctx, orgId, params any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceSyncs", reflect.TypeOf((*MockService)(nil).ListResourceSyncs), ctx, orgId, params)
 }
 
+// ListRoleBindings mocks base method.
+func (m *MockService) ListRoleBindings(ctx context.Context, orgId uuid.UUID, params domain.ListRoleBindingsParams) (*domain.RoleBindingList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleBindings", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.RoleBindingList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListRoleBindings indicates an expected call of ListRoleBindings.
+func (mr *MockServiceMockRecorder) ListRoleBindings(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleBindings", reflect.TypeOf((*MockService)(nil).ListRoleBindings), ctx, orgId, params)
+}
+
+// ListRoles mocks base method.
+func (m *MockService) ListRoles(ctx context.Context, orgId uuid.UUID, params domain.ListRolesParams) (*domain.RoleList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx, orgId, params)
+	ret0, _ := ret[0].(*domain.RoleList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockServiceMockRecorder) ListRoles(ctx, orgId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockService)(nil).ListRoles), ctx, orgId, params)
+}
+
 // ListTemplateVersions mocks base method.
 func (m *MockService) ListTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet string, params domain.ListTemplateVersionsParams) (*domain.TemplateVersionList, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1358,6 +1684,21 @@ func (mr *MockServiceMockRecorder) ReplaceAuthProvider(ctx, orgId, name, authPro
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAuthProvider", reflect.TypeOf((*MockService)(nil).ReplaceAuthProvider), ctx, orgId, name, authProvider)
 }
 
+// ReplaceBulkOperationStatus mocks base method.
+func (m *MockService) ReplaceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, name string, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceBulkOperationStatus", ctx, orgId, name, operation)
+	ret0, _ := ret[0].(*domain.BulkOperation)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceBulkOperationStatus indicates an expected call of ReplaceBulkOperationStatus.
+func (mr *MockServiceMockRecorder) ReplaceBulkOperationStatus(ctx, orgId, name, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceBulkOperationStatus", reflect.TypeOf((*MockService)(nil).ReplaceBulkOperationStatus), ctx, orgId, name, operation)
+}
+
 // ReplaceCatalog mocks base method.
 func (m *MockService) ReplaceCatalog(ctx context.Context, orgId uuid.UUID, name string, catalog domain.Catalog) (*domain.Catalog, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1448,6 +1789,21 @@ func (mr *MockServiceMockRecorder) ReplaceDeviceStatus(ctx, orgId, name, device
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceStatus", reflect.TypeOf((*MockService)(nil).ReplaceDeviceStatus), ctx, orgId, name, device)
 }
 
+// ReplaceEnrollmentApprovalPolicy mocks base method.
+func (m *MockService) ReplaceEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceEnrollmentApprovalPolicy", ctx, orgId, name, policy)
+	ret0, _ := ret[0].(*domain.EnrollmentApprovalPolicy)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceEnrollmentApprovalPolicy indicates an expected call of ReplaceEnrollmentApprovalPolicy.
+func (mr *MockServiceMockRecorder) ReplaceEnrollmentApprovalPolicy(ctx, orgId, name, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceEnrollmentApprovalPolicy", reflect.TypeOf((*MockService)(nil).ReplaceEnrollmentApprovalPolicy), ctx, orgId, name, policy)
+}
+
 // ReplaceEnrollmentRequest mocks base method.
 func (m *MockService) ReplaceEnrollmentRequest(ctx context.Context, orgId uuid.UUID, name string, er domain.EnrollmentRequest) (*domain.EnrollmentRequest, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1568,6 +1924,36 @@ func (mr *MockServiceMockRecorder) ReplaceResourceSyncStatus(ctx, orgId, name, r
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceResourceSyncStatus", reflect.TypeOf((*MockService)(nil).ReplaceResourceSyncStatus), ctx, orgId, name, resourceSync)
 }
 
+// ReplaceRole mocks base method.
+func (m *MockService) ReplaceRole(ctx context.Context, orgId uuid.UUID, name string, role domain.Role) (*domain.Role, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRole", ctx, orgId, name, role)
+	ret0, _ := ret[0].(*domain.Role)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceRole indicates an expected call of ReplaceRole.
+func (mr *MockServiceMockRecorder) ReplaceRole(ctx, orgId, name, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRole", reflect.TypeOf((*MockService)(nil).ReplaceRole), ctx, orgId, name, role)
+}
+
+// ReplaceRoleBinding mocks base method.
+func (m *MockService) ReplaceRoleBinding(ctx context.Context, orgId uuid.UUID, name string, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRoleBinding", ctx, orgId, name, binding)
+	ret0, _ := ret[0].(*domain.RoleBinding)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ReplaceRoleBinding indicates an expected call of ReplaceRoleBinding.
+func (mr *MockServiceMockRecorder) ReplaceRoleBinding(ctx, orgId, name, binding any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRoleBinding", reflect.TypeOf((*MockService)(nil).ReplaceRoleBinding), ctx, orgId, name, binding)
+}
+
 // ResumeDevices mocks base method.
 func (m *MockService) ResumeDevices(ctx context.Context, orgId uuid.UUID, request domain.DeviceResumeRequest) (domain.DeviceResumeResponse, domain.Status) {
 	m.ctrl.T.Helper()
@@ -1583,6 +1969,36 @@ func (mr *MockServiceMockRecorder) ResumeDevices(ctx, orgId, request any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeDevices", reflect.TypeOf((*MockService)(nil).ResumeDevices), ctx, orgId, request)
 }
 
+// RevokeCertificates mocks base method.
+func (m *MockService) RevokeCertificates(ctx context.Context, orgId uuid.UUID, request domain.CertificateRevocationRequest) (*domain.CertificateRevocationResponse, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificates", ctx, orgId, request)
+	ret0, _ := ret[0].(*domain.CertificateRevocationResponse)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// RevokeCertificates indicates an expected call of RevokeCertificates.
+func (mr *MockServiceMockRecorder) RevokeCertificates(ctx, orgId, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificates", reflect.TypeOf((*MockService)(nil).RevokeCertificates), ctx, orgId, request)
+}
+
+// RollbackFleet mocks base method.
+func (m *MockService) RollbackFleet(ctx context.Context, orgId uuid.UUID, name string, request domain.FleetRollbackRequest) (*domain.Fleet, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackFleet", ctx, orgId, name, request)
+	ret0, _ := ret[0].(*domain.Fleet)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// RollbackFleet indicates an expected call of RollbackFleet.
+func (mr *MockServiceMockRecorder) RollbackFleet(ctx, orgId, name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackFleet", reflect.TypeOf((*MockService)(nil).RollbackFleet), ctx, orgId, name, request)
+}
+
 // SetCheckpoint mocks base method.
 func (m *MockService) SetCheckpoint(ctx context.Context, consumer, key string, value []byte) domain.Status {
 	m.ctrl.T.Helper()
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+)
+
+func (h *ServiceHandler) CreateRole(ctx context.Context, orgId uuid.UUID, role domain.Role) (*domain.Role, domain.Status) {
+	NilOutManagedObjectMetaProperties(&role.Metadata)
+
+	if errs := role.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.Role().Create(ctx, orgId, &role, h.callbackRoleUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.RoleKind, role.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListRoles(ctx context.Context, orgId uuid.UUID, params domain.ListRolesParams) (*domain.RoleList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.Role().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetRole(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, domain.Status) {
+	result, err := h.store.Role().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.RoleKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceRole(ctx context.Context, orgId uuid.UUID, name string, role domain.Role) (*domain.Role, domain.Status) {
+	NilOutManagedObjectMetaProperties(&role.Metadata)
+
+	if errs := role.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *role.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.Role().CreateOrUpdate(ctx, orgId, &role, h.callbackRoleUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.RoleKind, &name)
+}
+
+func (h *ServiceHandler) DeleteRole(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.Role().Delete(ctx, orgId, name, h.callbackRoleDeleted)
+	return StoreErrorToApiStatus(err, false, domain.RoleKind, &name)
+}
+
+// callbackRoleUpdated is the role-specific callback that handles role events
+func (h *ServiceHandler) callbackRoleUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleRoleUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackRoleDeleted is the role-specific callback that handles role deletion events
+func (h *ServiceHandler) callbackRoleDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+)
+
+func (h *ServiceHandler) CreateRoleBinding(ctx context.Context, orgId uuid.UUID, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	NilOutManagedObjectMetaProperties(&binding.Metadata)
+
+	if errs := binding.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.RoleBinding().Create(ctx, orgId, &binding, h.callbackRoleBindingUpdated)
+	return result, StoreErrorToApiStatus(err, true, domain.RoleBindingKind, binding.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListRoleBindings(ctx context.Context, orgId uuid.UUID, params domain.ListRoleBindingsParams) (*domain.RoleBindingList, domain.Status) {
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != domain.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.RoleBinding().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, domain.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, domain.StatusBadRequest(se.Error())
+	default:
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetRoleBinding(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, domain.Status) {
+	result, err := h.store.RoleBinding().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, domain.RoleBindingKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceRoleBinding(ctx context.Context, orgId uuid.UUID, name string, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	NilOutManagedObjectMetaProperties(&binding.Metadata)
+
+	if errs := binding.Validate(); len(errs) > 0 {
+		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *binding.Metadata.Name {
+		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.RoleBinding().CreateOrUpdate(ctx, orgId, &binding, h.callbackRoleBindingUpdated)
+	return result, StoreErrorToApiStatus(err, created, domain.RoleBindingKind, &name)
+}
+
+func (h *ServiceHandler) DeleteRoleBinding(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	err := h.store.RoleBinding().Delete(ctx, orgId, name, h.callbackRoleBindingDeleted)
+	return StoreErrorToApiStatus(err, false, domain.RoleBindingKind, &name)
+}
+
+// callbackRoleBindingUpdated is the role binding-specific callback that handles role binding events
+func (h *ServiceHandler) callbackRoleBindingUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleRoleBindingUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackRoleBindingDeleted is the role binding-specific callback that handles role binding deletion events
+func (h *ServiceHandler) callbackRoleBindingDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
@@ -28,7 +29,12 @@ type TestStore struct {
 	repositories       *DummyRepository
 	resourceSyncVals   *DummyResourceSync
 	enrollmentRequests *DummyEnrollmentRequest
+	approvalPolicies   *DummyEnrollmentApprovalPolicy
 	organizations      *DummyOrganization
+	templateVersions   *DummyTemplateVersion
+	catalogItems       *DummyCatalog
+	csrs               *DummyCertificateSigningRequest
+	revokedCerts       *DummyRevokedCertificate
 }
 
 type DummyDevice struct {
@@ -61,6 +67,32 @@ type DummyEnrollmentRequest struct {
 	enrollmentRequests *[]domain.EnrollmentRequest
 }
 
+type DummyEnrollmentApprovalPolicy struct {
+	store.EnrollmentApprovalPolicy
+	approvalPolicies *[]domain.EnrollmentApprovalPolicy
+}
+
+type DummyTemplateVersion struct {
+	store.TemplateVersion
+	templateVersions *[]domain.TemplateVersion
+}
+
+type DummyCatalog struct {
+	store.Catalog
+	catalogItems *[]domain.CatalogItem
+}
+
+type DummyCertificateSigningRequest struct {
+	store.CertificateSigningRequest
+	csrs *[]domain.CertificateSigningRequest
+}
+
+type DummyRevokedCertificate struct {
+	store.RevokedCertificate
+	revokedCerts *[]domain.RevokedCertificate
+	err          error
+}
+
 type DummyOrganization struct {
 	store.Organization
 	organizations *[]*model.Organization
@@ -86,9 +118,24 @@ func (s *TestStore) init() {
 	if s.enrollmentRequests == nil {
 		s.enrollmentRequests = &DummyEnrollmentRequest{enrollmentRequests: &[]domain.EnrollmentRequest{}}
 	}
+	if s.approvalPolicies == nil {
+		s.approvalPolicies = &DummyEnrollmentApprovalPolicy{approvalPolicies: &[]domain.EnrollmentApprovalPolicy{}}
+	}
 	if s.organizations == nil {
 		s.organizations = &DummyOrganization{organizations: &[]*model.Organization{}}
 	}
+	if s.templateVersions == nil {
+		s.templateVersions = &DummyTemplateVersion{templateVersions: &[]domain.TemplateVersion{}}
+	}
+	if s.catalogItems == nil {
+		s.catalogItems = &DummyCatalog{catalogItems: &[]domain.CatalogItem{}}
+	}
+	if s.csrs == nil {
+		s.csrs = &DummyCertificateSigningRequest{csrs: &[]domain.CertificateSigningRequest{}}
+	}
+	if s.revokedCerts == nil {
+		s.revokedCerts = &DummyRevokedCertificate{revokedCerts: &[]domain.RevokedCertificate{}}
+	}
 }
 
 func (s *TestStore) Fleet() store.Fleet {
@@ -121,11 +168,36 @@ func (s *TestStore) EnrollmentRequest() store.EnrollmentRequest {
 	return s.enrollmentRequests
 }
 
+func (s *TestStore) EnrollmentApprovalPolicy() store.EnrollmentApprovalPolicy {
+	s.init()
+	return s.approvalPolicies
+}
+
 func (s *TestStore) Organization() store.Organization {
 	s.init()
 	return s.organizations
 }
 
+func (s *TestStore) TemplateVersion() store.TemplateVersion {
+	s.init()
+	return s.templateVersions
+}
+
+func (s *TestStore) Catalog() store.Catalog {
+	s.init()
+	return s.catalogItems
+}
+
+func (s *TestStore) CertificateSigningRequest() store.CertificateSigningRequest {
+	s.init()
+	return s.csrs
+}
+
+func (s *TestStore) RevokedCertificate() store.RevokedCertificate {
+	s.init()
+	return s.revokedCerts
+}
+
 // --------------------------------------> Event
 
 func (s *DummyEvent) Create(ctx context.Context, orgId uuid.UUID, event *domain.Event) error {
@@ -158,6 +230,12 @@ func (s *DummyDevice) Get(ctx context.Context, orgId uuid.UUID, name string) (*d
 	return nil, flterrors.ErrResourceNotFound
 }
 
+func (s *DummyDevice) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.DeviceList, error) {
+	var devices []domain.Device
+	deepCopy(*s.devices, &devices)
+	return &domain.DeviceList{Items: devices}, nil
+}
+
 func (s *DummyDevice) GetWithoutServiceConditions(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, error) {
 	return s.Get(ctx, orgId, name)
 }
@@ -165,6 +243,9 @@ func (s *DummyDevice) GetWithoutServiceConditions(ctx context.Context, orgId uui
 func (s *DummyDevice) Update(ctx context.Context, orgId uuid.UUID, device *domain.Device, fieldsToUnset []string, fromAPI bool, validationCallback store.DeviceStoreValidationCallback, callbackEvent store.EventCallback) (*domain.Device, error) {
 	for i, dev := range *s.devices {
 		if *device.Metadata.Name == *dev.Metadata.Name {
+			if resourceVersionConflict(device.Metadata, dev.Metadata) {
+				return nil, flterrors.ErrResourceVersionConflict
+			}
 			var oldDevice domain.Device
 			deepCopy(dev, &oldDevice)
 			var d domain.Device
@@ -224,6 +305,21 @@ func (s *DummyDevice) Create(ctx context.Context, orgId uuid.UUID, device *domai
 	return device, nil
 }
 
+func (s *DummyDevice) Delete(ctx context.Context, orgId uuid.UUID, name string, callbackEvent store.EventCallback) (bool, error) {
+	for i, dev := range *s.devices {
+		if name == *dev.Metadata.Name {
+			var oldDevice domain.Device
+			deepCopy(dev, &oldDevice)
+			*s.devices = append((*s.devices)[:i], (*s.devices)[i+1:]...)
+			if callbackEvent != nil {
+				callbackEvent(ctx, domain.DeviceKind, orgId, name, &oldDevice, nil, false, nil)
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (s *DummyDevice) UpdateStatus(ctx context.Context, orgId uuid.UUID, device *domain.Device, callbackEvent store.EventCallback) (*domain.Device, error) {
 	for i, dev := range *s.devices {
 		if *device.Metadata.Name == *dev.Metadata.Name {
@@ -244,6 +340,12 @@ func (s *DummyDevice) UpdateStatus(ctx context.Context, orgId uuid.UUID, device
 
 // --------------------------------------> Fleet
 
+// resourceVersionConflict reports whether an update carries a resource version other than the stored one, like the
+// optimistic concurrency check of the store
+func resourceVersionConflict(updated, stored domain.ObjectMeta) bool {
+	return updated.ResourceVersion != nil && stored.ResourceVersion != nil && *updated.ResourceVersion != *stored.ResourceVersion
+}
+
 func (s *DummyFleet) Get(ctx context.Context, orgId uuid.UUID, name string, options ...store.GetOption) (*domain.Fleet, error) {
 	for _, fleet := range *s.fleets {
 		if name == *fleet.Metadata.Name {
@@ -268,6 +370,9 @@ func (s *DummyFleet) Create(ctx context.Context, orgId uuid.UUID, fleet *domain.
 func (s *DummyFleet) Update(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet, fieldsToUnset []string, fromAPI bool, callbackEvent store.EventCallback) (*domain.Fleet, error) {
 	for i, flt := range *s.fleets {
 		if *fleet.Metadata.Name == *flt.Metadata.Name {
+			if resourceVersionConflict(fleet.Metadata, flt.Metadata) {
+				return nil, flterrors.ErrResourceVersionConflict
+			}
 			var f domain.Fleet
 			if callbackEvent != nil {
 				callbackEvent(ctx, domain.FleetKind, orgId, lo.FromPtr(fleet.Metadata.Name), &(*s.fleets)[i], fleet, false, nil)
@@ -295,6 +400,78 @@ func (s *DummyFleet) Delete(ctx context.Context, orgId uuid.UUID, name string, c
 	return flterrors.ErrResourceNotFound
 }
 
+func (s *DummyFleet) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string, callbackEvent store.EventCallback) error {
+	for i, fleet := range *s.fleets {
+		if name == *fleet.Metadata.Name {
+			existing := lo.FromPtr(fleet.Metadata.Annotations)
+			updated := lo.OmitByKeys(lo.Assign(existing, annotations), deleteKeys)
+			(*s.fleets)[i].Metadata.Annotations = &updated
+			return nil
+		}
+	}
+	return flterrors.ErrResourceNotFound
+}
+
+func (s *DummyFleet) UpdateAnnotationsAndStatus(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string, updateStatus func(*domain.FleetStatus), callbackEvent store.EventCallback) (*domain.Fleet, error) {
+	for i, fleet := range *s.fleets {
+		if name == *fleet.Metadata.Name {
+			var f domain.Fleet
+			deepCopy(fleet, &f)
+			updated := lo.OmitByKeys(lo.Assign(lo.FromPtr(f.Metadata.Annotations), annotations), deleteKeys)
+			f.Metadata.Annotations = &updated
+			if f.Status == nil {
+				f.Status = &domain.FleetStatus{}
+			}
+			updateStatus(f.Status)
+			(*s.fleets)[i] = f
+			var result domain.Fleet
+			deepCopy(f, &result)
+			return &result, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyFleet) UpdateStatus(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet) (*domain.Fleet, error) {
+	for i, flt := range *s.fleets {
+		if *fleet.Metadata.Name == *flt.Metadata.Name {
+			var status domain.FleetStatus
+			deepCopy(fleet.Status, &status)
+			(*s.fleets)[i].Status = &status
+			var f domain.Fleet
+			deepCopy((*s.fleets)[i], &f)
+			return &f, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+// --------------------------------------> Catalog
+
+func (s *DummyCatalog) GetItem(ctx context.Context, orgId uuid.UUID, catalogName string, itemName string) (*domain.CatalogItem, error) {
+	for _, item := range *s.catalogItems {
+		if catalogName == item.Metadata.Catalog && itemName == lo.FromPtr(item.Metadata.Name) {
+			var i domain.CatalogItem
+			deepCopy(item, &i)
+			return &i, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+// --------------------------------------> TemplateVersion
+
+func (s *DummyTemplateVersion) Get(ctx context.Context, orgId uuid.UUID, fleet string, name string) (*domain.TemplateVersion, error) {
+	for _, tv := range *s.templateVersions {
+		if fleet == tv.Spec.Fleet && name == lo.FromPtr(tv.Metadata.Name) {
+			var t domain.TemplateVersion
+			deepCopy(tv, &t)
+			return &t, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
 // --------------------------------------> Repository
 
 func (s *DummyRepository) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Repository, error) {
@@ -523,6 +700,80 @@ func (s *DummyEnrollmentRequest) UpdateStatus(ctx context.Context, orgId uuid.UU
 	return nil, flterrors.ErrResourceNotFound
 }
 
+func (s *DummyEnrollmentRequest) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.EnrollmentRequestList, error) {
+	items := []domain.EnrollmentRequest{}
+	for _, enrollment := range *s.enrollmentRequests {
+		var e domain.EnrollmentRequest
+		deepCopy(enrollment, &e)
+		items = append(items, e)
+	}
+	return &domain.EnrollmentRequestList{Items: items}, nil
+}
+
+// --------------------------------------> CertificateSigningRequest
+
+func (s *DummyCertificateSigningRequest) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.CertificateSigningRequestList, error) {
+	items := []domain.CertificateSigningRequest{}
+	for _, csr := range *s.csrs {
+		var c domain.CertificateSigningRequest
+		deepCopy(csr, &c)
+		items = append(items, c)
+	}
+	return &domain.CertificateSigningRequestList{Items: items}, nil
+}
+
+// --------------------------------------> RevokedCertificate
+
+func (s *DummyRevokedCertificate) Create(ctx context.Context, orgId uuid.UUID, certs []domain.RevokedCertificate) ([]domain.RevokedCertificate, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for _, cert := range certs {
+		if revoked, _ := s.IsRevoked(ctx, cert.IssuerKeyId, cert.SerialNumber); !revoked {
+			*s.revokedCerts = append(*s.revokedCerts, cert)
+		}
+	}
+	return certs, nil
+}
+
+func (s *DummyRevokedCertificate) IsRevoked(ctx context.Context, issuerKeyID string, serialNumber string) (bool, error) {
+	for _, cert := range *s.revokedCerts {
+		if cert.IssuerKeyId == issuerKeyID && cert.SerialNumber == serialNumber {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *DummyRevokedCertificate) ListByIssuer(ctx context.Context, issuerKeyID string, notExpiredAt time.Time) ([]domain.RevokedCertificate, error) {
+	list := []domain.RevokedCertificate{}
+	for _, cert := range *s.revokedCerts {
+		if cert.IssuerKeyId == issuerKeyID && (cert.ExpiresAt == nil || !cert.ExpiresAt.Before(notExpiredAt)) {
+			list = append(list, cert)
+		}
+	}
+	return list, nil
+}
+
+// --------------------------------------> EnrollmentApprovalPolicy
+
+func (s *DummyEnrollmentApprovalPolicy) Create(ctx context.Context, orgId uuid.UUID, policy *domain.EnrollmentApprovalPolicy, callbackEvent store.EventCallback) (*domain.EnrollmentApprovalPolicy, error) {
+	var p domain.EnrollmentApprovalPolicy
+	deepCopy(policy, &p)
+	*s.approvalPolicies = append(*s.approvalPolicies, p)
+	return policy, nil
+}
+
+func (s *DummyEnrollmentApprovalPolicy) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.EnrollmentApprovalPolicyList, error) {
+	items := []domain.EnrollmentApprovalPolicy{}
+	for _, policy := range *s.approvalPolicies {
+		var p domain.EnrollmentApprovalPolicy
+		deepCopy(policy, &p)
+		items = append(items, p)
+	}
+	return &domain.EnrollmentApprovalPolicyList{Items: items}, nil
+}
+
 // --------------------------------------> Organization
 
 func (s *DummyOrganization) InitialMigration(ctx context.Context) error {
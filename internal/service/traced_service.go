@@ -88,6 +88,36 @@ func (t *TracedService) UpdateCertificateSigningRequestApproval(ctx context.Cont
 	return resp, st
 }
 
+// --- CertificateRevocation ---
+func (t *TracedService) RevokeCertificates(ctx context.Context, orgId uuid.UUID, request domain.CertificateRevocationRequest) (*domain.CertificateRevocationResponse, domain.Status) {
+	ctx, span := startSpan(ctx, "RevokeCertificates")
+	resp, st := t.inner.RevokeCertificates(ctx, orgId, request)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) GetCertificateRevocationList(ctx context.Context, orgId uuid.UUID, params domain.GetCertificateRevocationListParams) ([]byte, domain.Status) {
+	ctx, span := startSpan(ctx, "GetCertificateRevocationList")
+	resp, st := t.inner.GetCertificateRevocationList(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- CARotation ---
+func (t *TracedService) GetCARotationStatus(ctx context.Context, orgId uuid.UUID) (*domain.CARotationStatus, domain.Status) {
+	ctx, span := startSpan(ctx, "GetCARotationStatus")
+	resp, st := t.inner.GetCARotationStatus(ctx, orgId)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) ListCARotationDevices(ctx context.Context, orgId uuid.UUID, params domain.ListCARotationDevicesParams) (*domain.CARotationDeviceList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListCARotationDevices")
+	resp, st := t.inner.ListCARotationDevices(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+
 // --- Device ---
 func (t *TracedService) CreateDevice(ctx context.Context, orgId uuid.UUID, d domain.Device) (*domain.Device, domain.Status) {
 	ctx, span := startSpan(ctx, "CreateDevice")
@@ -261,6 +291,12 @@ func (t *TracedService) CountDevicesByLabels(ctx context.Context, orgId uuid.UUI
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) AggregateDevices(ctx context.Context, orgId uuid.UUID, p domain.AggregateDevicesParams) (*domain.DeviceAggregate, domain.Status) {
+	ctx, span := startSpan(ctx, "AggregateDevices")
+	resp, st := t.inner.AggregateDevices(ctx, orgId, p)
+	endSpan(span, st)
+	return resp, st
+}
 func (t *TracedService) GetDevicesSummary(ctx context.Context, orgId uuid.UUID, p domain.ListDevicesParams, sel *selector.AnnotationSelector) (*domain.DevicesSummary, domain.Status) {
 	ctx, span := startSpan(ctx, "GetDevicesSummary")
 	resp, st := t.inner.GetDevicesSummary(ctx, orgId, p, sel)
@@ -338,6 +374,38 @@ func (t *TracedService) ReplaceEnrollmentRequestStatus(ctx context.Context, orgI
 	return resp, st
 }
 
+// --- EnrollmentApprovalPolicy ---
+func (t *TracedService) CreateEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateEnrollmentApprovalPolicy")
+	resp, st := t.inner.CreateEnrollmentApprovalPolicy(ctx, orgId, policy)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListEnrollmentApprovalPolicies(ctx context.Context, orgId uuid.UUID, params domain.ListEnrollmentApprovalPoliciesParams) (*domain.EnrollmentApprovalPolicyList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListEnrollmentApprovalPolicies")
+	resp, st := t.inner.ListEnrollmentApprovalPolicies(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "GetEnrollmentApprovalPolicy")
+	resp, st := t.inner.GetEnrollmentApprovalPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string, policy domain.EnrollmentApprovalPolicy) (*domain.EnrollmentApprovalPolicy, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceEnrollmentApprovalPolicy")
+	resp, st := t.inner.ReplaceEnrollmentApprovalPolicy(ctx, orgId, name, policy)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteEnrollmentApprovalPolicy(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteEnrollmentApprovalPolicy")
+	st := t.inner.DeleteEnrollmentApprovalPolicy(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+
 // --- Fleet ---
 func (t *TracedService) CreateFleet(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet) (*domain.Fleet, domain.Status) {
 	ctx, span := startSpan(ctx, "CreateFleet")
@@ -387,6 +455,12 @@ func (t *TracedService) PatchFleet(ctx context.Context, orgId uuid.UUID, name st
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) RollbackFleet(ctx context.Context, orgId uuid.UUID, name string, request domain.FleetRollbackRequest) (*domain.Fleet, domain.Status) {
+	ctx, span := startSpan(ctx, "RollbackFleet")
+	resp, st := t.inner.RollbackFleet(ctx, orgId, name, request)
+	endSpan(span, st)
+	return resp, st
+}
 func (t *TracedService) ListFleetRolloutDeviceSelection(ctx context.Context, orgId uuid.UUID) (*domain.FleetList, domain.Status) {
 	ctx, span := startSpan(ctx, "ListFleetRolloutDeviceSelection")
 	resp, st := t.inner.ListFleetRolloutDeviceSelection(ctx, orgId)
@@ -626,6 +700,115 @@ func (t *TracedService) DeleteCatalogItem(ctx context.Context, orgId uuid.UUID,
 	return st
 }
 
+func (t *TracedService) InstallCatalogItem(ctx context.Context, orgId uuid.UUID, catalogName string, itemName string, request domain.CatalogItemInstallRequest) (*domain.CatalogItemInstallation, domain.Status) {
+	ctx, span := startSpan(ctx, "InstallCatalogItem")
+	resp, st := t.inner.InstallCatalogItem(ctx, orgId, catalogName, itemName, request)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- BulkOperation ---
+func (t *TracedService) CreateBulkOperation(ctx context.Context, orgId uuid.UUID, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateBulkOperation")
+	resp, st := t.inner.CreateBulkOperation(ctx, orgId, operation)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListBulkOperations(ctx context.Context, orgId uuid.UUID, params domain.ListBulkOperationsParams) (*domain.BulkOperationList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListBulkOperations")
+	resp, st := t.inner.ListBulkOperations(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, domain.Status) {
+	ctx, span := startSpan(ctx, "GetBulkOperation")
+	resp, st := t.inner.GetBulkOperation(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteBulkOperation(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteBulkOperation")
+	st := t.inner.DeleteBulkOperation(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) CancelBulkOperation(ctx context.Context, orgId uuid.UUID, name string) (*domain.BulkOperation, domain.Status) {
+	ctx, span := startSpan(ctx, "CancelBulkOperation")
+	resp, st := t.inner.CancelBulkOperation(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceBulkOperationStatus(ctx context.Context, orgId uuid.UUID, name string, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceBulkOperationStatus")
+	resp, st := t.inner.ReplaceBulkOperationStatus(ctx, orgId, name, operation)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- Role ---
+func (t *TracedService) CreateRole(ctx context.Context, orgId uuid.UUID, role domain.Role) (*domain.Role, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateRole")
+	resp, st := t.inner.CreateRole(ctx, orgId, role)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListRoles(ctx context.Context, orgId uuid.UUID, params domain.ListRolesParams) (*domain.RoleList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListRoles")
+	resp, st := t.inner.ListRoles(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetRole(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, domain.Status) {
+	ctx, span := startSpan(ctx, "GetRole")
+	resp, st := t.inner.GetRole(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceRole(ctx context.Context, orgId uuid.UUID, name string, role domain.Role) (*domain.Role, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceRole")
+	resp, st := t.inner.ReplaceRole(ctx, orgId, name, role)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteRole(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteRole")
+	st := t.inner.DeleteRole(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+
+// --- RoleBinding ---
+func (t *TracedService) CreateRoleBinding(ctx context.Context, orgId uuid.UUID, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	ctx, span := startSpan(ctx, "CreateRoleBinding")
+	resp, st := t.inner.CreateRoleBinding(ctx, orgId, binding)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListRoleBindings(ctx context.Context, orgId uuid.UUID, params domain.ListRoleBindingsParams) (*domain.RoleBindingList, domain.Status) {
+	ctx, span := startSpan(ctx, "ListRoleBindings")
+	resp, st := t.inner.ListRoleBindings(ctx, orgId, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetRoleBinding(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, domain.Status) {
+	ctx, span := startSpan(ctx, "GetRoleBinding")
+	resp, st := t.inner.GetRoleBinding(ctx, orgId, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceRoleBinding(ctx context.Context, orgId uuid.UUID, name string, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+	ctx, span := startSpan(ctx, "ReplaceRoleBinding")
+	resp, st := t.inner.ReplaceRoleBinding(ctx, orgId, name, binding)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteRoleBinding(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	ctx, span := startSpan(ctx, "DeleteRoleBinding")
+	st := t.inner.DeleteRoleBinding(ctx, orgId, name)
+	endSpan(span, st)
+	return st
+}
+
 // --- TemplateVersion ---
 func (t *TracedService) CreateTemplateVersion(ctx context.Context, orgId uuid.UUID, tv domain.TemplateVersion, immediateRollout bool) (*domain.TemplateVersion, domain.Status) {
 	ctx, span := startSpan(ctx, "CreateTemplateVersion")
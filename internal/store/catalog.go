@@ -428,11 +428,15 @@ func (s *CatalogStore) UpdateItem(ctx context.Context, orgId uuid.UUID, catalogN
 		return nil, err
 	}
 
-	if err := db.Model(&existingItem).Updates(map[string]interface{}{
+	updates := map[string]interface{}{
 		"spec":        modelItem.Spec,
 		"labels":      modelItem.Labels,
 		"annotations": modelItem.Annotations,
-	}).Error; err != nil {
+	}
+	if modelItem.Owner != nil {
+		updates["owner"] = modelItem.Owner
+	}
+	if err := db.Model(&existingItem).Updates(updates).Error; err != nil {
 		return nil, ErrorFromGormError(err)
 	}
 
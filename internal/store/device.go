@@ -79,6 +79,9 @@ type Device interface {
 	CountByLabels(ctx context.Context, orgId uuid.UUID, listParams ListParams, groupBy []string) ([]map[string]any, error)
 	Summary(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.DevicesSummary, error)
 
+	// Used by the aggregation API
+	Aggregate(ctx context.Context, orgId uuid.UUID, listParams ListParams, groupBy []string) (*domain.DeviceAggregate, error)
+
 	// Used by fleet selector
 	ListDevicesByServiceCondition(ctx context.Context, orgId uuid.UUID, conditionType string, conditionStatus string, listParams ListParams) (*domain.DeviceList, error)
 
@@ -158,6 +161,10 @@ func (s *DeviceStore) InitialMigration(ctx context.Context) error {
 		return err
 	}
 
+	if err := s.createDeviceStatusFieldIndexes(db); err != nil {
+		return err
+	}
+
 	if err := s.createServiceConditionsIndex(db); err != nil {
 		return err
 	}
@@ -259,6 +266,31 @@ func (s *DeviceStore) createDeviceLabelsPartialIndex(db *gorm.DB) error {
 	return nil
 }
 
+// createDeviceStatusFieldIndexes creates indexes for the status fields that are commonly used in field
+// selectors and are not served by the GIN index of the status.
+func (s *DeviceStore) createDeviceStatusFieldIndexes(db *gorm.DB) error {
+	if db.Dialector.Name() != "postgres" {
+		return nil
+	}
+	if !db.Migrator().HasIndex(&model.Device{}, "idx_device_status_os_image") {
+		// Create a B-Tree index for exact matches on the OS image
+		if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_device_status_os_image ON devices USING BTREE ((status -> 'os' ->> 'image'))").Error; err != nil {
+			return err
+		}
+	}
+	if !db.Migrator().HasIndex(&model.Device{}, "idx_device_status_system_info_kernel") {
+		// Enable pg_trgm extension for partial matching
+		if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+			return err
+		}
+		// Create a GIN index for substring matches on the kernel version
+		if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_device_status_system_info_kernel ON devices USING GIN ((status -> 'systemInfo' ->> 'kernel') gin_trgm_ops)").Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *DeviceStore) createServiceConditionsIndex(db *gorm.DB) error {
 	if !db.Migrator().HasIndex(&model.Device{}, "idx_devices_service_conditions") {
 		if db.Dialector.Name() == "postgres" {
@@ -671,6 +703,92 @@ func (s *DeviceStore) CountByLabels(ctx context.Context, orgId uuid.UUID, listPa
 	return ret, nil
 }
 
+// deviceGroupByExpr returns the expression selecting the value of a group-by key of the aggregation API. Keys
+// are label keys prefixed with "metadata.labels.", "metadata.owner", or string status field selectors of devices.
+func deviceGroupByExpr(key string) (clause.Expr, error) {
+	if labelKey, found := strings.CutPrefix(key, "metadata.labels."); found && labelKey != "" {
+		return gorm.Expr("labels ->> ?", labelKey), nil
+	}
+	if key == "metadata.owner" {
+		return gorm.Expr("owner"), nil
+	}
+	field, err := (&model.Device{}).ResolveSelector(selector.NewSelectorName(key))
+	if err != nil || field.Type != selector.String || field.ArrayElement != nil {
+		return clause.Expr{}, selector.NewSelectorError(flterrors.ErrFieldSelectorUnknownSelector,
+			fmt.Errorf("unable to group devices by %q", key))
+	}
+	return gorm.Expr(field.FieldName), nil
+}
+
+// Aggregate counts the devices matching the list parameters grouped by the values of the groupBy keys.  If the list
+// parameters have a limit, only that many of the largest groups are returned and the aggregate is marked as
+// truncated if there are more.  The total always counts all matching devices.
+func (s *DeviceStore) Aggregate(ctx context.Context, orgId uuid.UUID, listParams ListParams, groupBy []string) (*domain.DeviceAggregate, error) {
+	groupExprs := make([]any, len(groupBy))
+	for i, key := range groupBy {
+		expr, err := deviceGroupByExpr(key)
+		if err != nil {
+			return nil, err
+		}
+		groupExprs[i] = expr
+	}
+
+	query, err := ListQuery(&model.Device{}).BuildNoOrder(ctx, s.getDB(ctx), orgId, listParams)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := lo.Times(len(groupBy), func(i int) string { return fmt.Sprintf("group_%d", i) })
+	selectList := lo.Map(aliases, func(alias string, _ int) string { return "? as " + alias })
+	// The window functions are evaluated over all groups, before the limit is applied
+	selectList = append(selectList,
+		"count(*) as device_count",
+		"(sum(count(*)) over ())::bigint as total_count",
+		"count(*) over () as group_count")
+	query = query.Select(strings.Join(selectList, ","), groupExprs...)
+	for _, alias := range aliases {
+		query = query.Group(alias)
+	}
+	query = query.Order(strings.Join(append([]string{"device_count DESC"}, aliases...), ","))
+	if listParams.Limit > 0 {
+		query = query.Limit(listParams.Limit)
+	}
+
+	var results []map[string]any
+	if err := query.Scan(&results).Error; err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+
+	aggregate := &domain.DeviceAggregate{
+		GroupBy: groupBy,
+		Groups:  make([]domain.DeviceGroupCount, 0, len(results)),
+	}
+	for _, m := range results {
+		count, ok := m["device_count"].(int64)
+		if !ok {
+			return nil, fmt.Errorf("device count has type %T, not int64", m["device_count"])
+		}
+		total, ok := m["total_count"].(int64)
+		if !ok {
+			return nil, fmt.Errorf("total count has type %T, not int64", m["total_count"])
+		}
+		groupCount, ok := m["group_count"].(int64)
+		if !ok {
+			return nil, fmt.Errorf("group count has type %T, not int64", m["group_count"])
+		}
+		values := make(map[string]string, len(groupBy))
+		for i, key := range groupBy {
+			if value, ok := m[aliases[i]].(string); ok {
+				values[key] = value
+			}
+		}
+		aggregate.Groups = append(aggregate.Groups, domain.DeviceGroupCount{Values: values, Count: count})
+		aggregate.Total = total
+		aggregate.Truncated = groupCount > int64(len(results))
+	}
+	return aggregate, nil
+}
+
 func (s *DeviceStore) Summary(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.DevicesSummary, error) {
 	query, err := ListQuery(&model.Device{}).Build(ctx, s.getDB(ctx), orgId, listParams)
 	if err != nil {
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type EnrollmentApprovalPolicy interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, policy *domain.EnrollmentApprovalPolicy, eventCallback EventCallback) (*domain.EnrollmentApprovalPolicy, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, policy *domain.EnrollmentApprovalPolicy, eventCallback EventCallback) (*domain.EnrollmentApprovalPolicy, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.EnrollmentApprovalPolicyList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+}
+
+type EnrollmentApprovalPolicyStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.EnrollmentApprovalPolicy, model.EnrollmentApprovalPolicy, domain.EnrollmentApprovalPolicy, domain.EnrollmentApprovalPolicyList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to EnrollmentApprovalPolicy interface
+var _ EnrollmentApprovalPolicy = (*EnrollmentApprovalPolicyStore)(nil)
+
+func NewEnrollmentApprovalPolicy(db *gorm.DB, log logrus.FieldLogger) EnrollmentApprovalPolicy {
+	genericStore := NewGenericStore[*model.EnrollmentApprovalPolicy, model.EnrollmentApprovalPolicy, domain.EnrollmentApprovalPolicy, domain.EnrollmentApprovalPolicyList](
+		db,
+		log,
+		model.NewEnrollmentApprovalPolicyFromApiResource,
+		(*model.EnrollmentApprovalPolicy).ToApiResource,
+		model.EnrollmentApprovalPoliciesToApiResource,
+	)
+	return &EnrollmentApprovalPolicyStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.EnrollmentApprovalPolicyKind, log)}
+}
+
+func (s *EnrollmentApprovalPolicyStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *EnrollmentApprovalPolicyStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.EnrollmentApprovalPolicy{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for EnrollmentApprovalPolicy labels
+	if !db.Migrator().HasIndex(&model.EnrollmentApprovalPolicy{}, "idx_enrollment_approval_policies_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_enrollment_approval_policies_labels ON enrollment_approval_policies USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.EnrollmentApprovalPolicy{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *EnrollmentApprovalPolicyStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.EnrollmentApprovalPolicy, eventCallback EventCallback) (*domain.EnrollmentApprovalPolicy, error) {
+	policy, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, policy, true, err)
+	return policy, err
+}
+
+func (s *EnrollmentApprovalPolicyStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.EnrollmentApprovalPolicy, eventCallback EventCallback) (*domain.EnrollmentApprovalPolicy, bool, error) {
+	newPolicy, oldPolicy, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldPolicy, newPolicy, created, err)
+	return newPolicy, created, err
+}
+
+func (s *EnrollmentApprovalPolicyStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.EnrollmentApprovalPolicy, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *EnrollmentApprovalPolicyStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.EnrollmentApprovalPolicyList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *EnrollmentApprovalPolicyStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.EnrollmentApprovalPolicy{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, err)
+	}
+	return err
+}
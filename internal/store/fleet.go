@@ -35,6 +35,7 @@ type Fleet interface {
 	UnsetOwnerByKind(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, resourceKind string) error
 	UpdateConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition, eventCallback EventCallback) error
 	UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string, eventCallback EventCallback) error
+	UpdateAnnotationsAndStatus(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string, updateStatus func(*domain.FleetStatus), eventCallback EventCallback) (*domain.Fleet, error)
 	OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) error
 	GetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*domain.RepositoryList, error)
 
@@ -499,6 +500,71 @@ func (s *FleetStore) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, nam
 	return err
 }
 
+func (s *FleetStore) updateAnnotationsAndStatus(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string, updateStatus func(*domain.FleetStatus)) (*domain.Fleet, *domain.Fleet, bool, error) {
+	existingRecord := model.Fleet{Resource: model.Resource{OrgID: orgId, Name: name}}
+	result := s.getDB(ctx).Take(&existingRecord)
+	if result.Error != nil {
+		return nil, nil, false, ErrorFromGormError(result.Error)
+	}
+	oldFleet, err := existingRecord.ToApiResource()
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	newAnnotations := util.MergeLabels(util.EnsureMap(existingRecord.Annotations), annotations)
+	for _, deleteKey := range deleteKeys {
+		delete(newAnnotations, deleteKey)
+	}
+	// Work on copies of the conditions and the rollout status so that the old fleet keeps the existing ones
+	status := domain.FleetStatus{Conditions: []domain.Condition{}}
+	if existingRecord.Status != nil {
+		status = existingRecord.Status.Data
+		status.Conditions = append([]domain.Condition{}, existingRecord.Status.Data.Conditions...)
+		if status.Rollout != nil {
+			rollout := *status.Rollout
+			status.Rollout = &rollout
+		}
+	}
+	updateStatus(&status)
+
+	result = s.getDB(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+		"annotations":      model.MakeJSONMap(newAnnotations),
+		"status":           model.MakeJSONField(status),
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	if err = ErrorFromGormError(result.Error); err != nil {
+		return nil, nil, strings.Contains(err.Error(), "deadlock"), err
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil, true, flterrors.ErrNoRowsUpdated
+	}
+
+	existingRecord.Annotations = model.MakeJSONMap(newAnnotations)
+	existingRecord.Status = model.MakeJSONField(status)
+	existingRecord.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
+	newFleet, err := existingRecord.ToApiResource()
+	return oldFleet, newFleet, false, err
+}
+
+// UpdateAnnotationsAndStatus merges the annotations, deletes the keys and applies updateStatus to the status of the
+// fleet in a single resource-version-checked update, so that either both or none of them are written
+func (s *FleetStore) UpdateAnnotationsAndStatus(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string, updateStatus func(*domain.FleetStatus), eventCallback EventCallback) (*domain.Fleet, error) {
+	var oldFleet, newFleet *domain.Fleet
+	err := retryUpdate(func() (bool, error) {
+		var (
+			retry bool
+			err   error
+		)
+		oldFleet, newFleet, retry, err = s.updateAnnotationsAndStatus(ctx, orgId, name, annotations, deleteKeys, updateStatus)
+		return retry, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.callEventCallback(ctx, eventCallback, orgId, name, oldFleet, newFleet, false, nil)
+	return newFleet, nil
+}
+
 func (s *FleetStore) OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) error {
 	repos := []model.Repository{}
 	for _, repoName := range repositoryNames {
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// BulkOperation represents an asynchronous operation on a set of devices in the database.
+type BulkOperation struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.BulkOperationSpec] `gorm:"type:jsonb"`
+
+	// The progress of the operation, stored as opaque JSON object.
+	Status *JSONField[domain.BulkOperationStatus] `gorm:"type:jsonb"`
+}
+
+func (b *BulkOperation) String() string {
+	val, _ := json.Marshal(b)
+	return string(val)
+}
+
+func NewBulkOperationFromApiResource(resource *domain.BulkOperation) (*BulkOperation, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &BulkOperation{}, nil
+	}
+
+	status := domain.BulkOperationStatus{Phase: domain.BulkOperationPhasePending}
+	if resource.Status != nil {
+		status = *resource.Status
+	}
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &BulkOperation{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			Generation:      resource.Metadata.Generation,
+			Owner:           resource.Metadata.Owner,
+			ResourceVersion: resourceVersion,
+		},
+		Spec:   MakeJSONField(resource.Spec),
+		Status: MakeJSONField(status),
+	}, nil
+}
+
+func BulkOperationAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.BulkOperationAPIVersion)
+}
+
+func (b *BulkOperation) ToApiResource(opts ...APIResourceOption) (*domain.BulkOperation, error) {
+	if b == nil {
+		return &domain.BulkOperation{}, nil
+	}
+
+	var spec domain.BulkOperationSpec
+	if b.Spec != nil {
+		spec = b.Spec.Data
+	}
+
+	status := domain.BulkOperationStatus{Phase: domain.BulkOperationPhasePending}
+	if b.Status != nil {
+		status = b.Status.Data
+	}
+
+	return &domain.BulkOperation{
+		ApiVersion: BulkOperationAPIVersion(),
+		Kind:       domain.BulkOperationKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(b.Name),
+			CreationTimestamp: lo.ToPtr(b.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(b.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(b.Resource.Annotations)),
+			Generation:        b.Generation,
+			Owner:             b.Owner,
+			ResourceVersion:   lo.Ternary(b.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(b.ResourceVersion), 10)), nil),
+		},
+		Spec:   spec,
+		Status: &status,
+	}, nil
+}
+
+func BulkOperationsToApiResource(operations []BulkOperation, cont *string, numRemaining *int64) (domain.BulkOperationList, error) {
+	operationList := make([]domain.BulkOperation, len(operations))
+	for i, operation := range operations {
+		apiResource, _ := operation.ToApiResource()
+		operationList[i] = *apiResource
+	}
+	ret := domain.BulkOperationList{
+		ApiVersion: BulkOperationAPIVersion(),
+		Kind:       domain.BulkOperationListKind,
+		Items:      operationList,
+		Metadata:   domain.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (b *BulkOperation) GetKind() string {
+	return domain.BulkOperationKind
+}
+
+func (b *BulkOperation) HasNilSpec() bool {
+	return b.Spec == nil
+}
+
+func (b *BulkOperation) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*BulkOperation)
+	if !ok {
+		return false
+	}
+	if other == nil {
+		return false
+	}
+	if b.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (b.Spec == nil && other.Spec != nil) || (b.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(b.Spec.Data, other.Spec.Data)
+}
+
+func (b *BulkOperation) GetStatusAsJson() ([]byte, error) {
+	if b.Status == nil {
+		return []byte("null"), nil
+	}
+	return b.Status.MarshalJSON()
+}
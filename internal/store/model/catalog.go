@@ -156,6 +156,7 @@ type CatalogItem struct {
 	Spec        *JSONField[domain.CatalogItemSpec] `gorm:"type:jsonb"`
 	Labels      JSONMap[string, string]            `gorm:"type:jsonb" selector:"metadata.labels,hidden,private"`
 	Annotations JSONMap[string, string]            `gorm:"type:jsonb"`
+	Owner       *string                            `selector:"metadata.owner"`
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -188,6 +189,7 @@ func (ci *CatalogItem) ToApiResource() *domain.CatalogItem {
 			CreationTimestamp: lo.ToPtr(ci.CreatedAt.UTC()),
 			Labels:            lo.ToPtr(util.EnsureMap(ci.Labels)),
 			Annotations:       lo.ToPtr(util.EnsureMap(ci.Annotations)),
+			Owner:             ci.Owner,
 		},
 		Spec: spec,
 	}
@@ -223,5 +225,6 @@ func NewCatalogItemFromApiResource(orgId uuid.UUID, catalogName string, resource
 		Spec:        MakeJSONField(resource.Spec),
 		Labels:      lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
 		Annotations: lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+		Owner:       resource.Metadata.Owner,
 	}, nil
 }
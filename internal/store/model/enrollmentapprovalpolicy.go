@@ -0,0 +1,130 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// EnrollmentApprovalPolicy represents a policy automatically approving enrollment requests in the database.
+type EnrollmentApprovalPolicy struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.EnrollmentApprovalPolicySpec] `gorm:"type:jsonb"`
+}
+
+func (r EnrollmentApprovalPolicy) String() string {
+	val, _ := json.Marshal(r)
+	return string(val)
+}
+
+func NewEnrollmentApprovalPolicyFromApiResource(resource *domain.EnrollmentApprovalPolicy) (*EnrollmentApprovalPolicy, error) {
+	if resource == nil {
+		return nil, fmt.Errorf("resource is nil")
+	}
+	if resource.Metadata.Name == nil {
+		return nil, fmt.Errorf("resource.Metadata.Name is nil")
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+
+	return &EnrollmentApprovalPolicy{
+		Resource: Resource{
+			Name:            lo.FromPtr(resource.Metadata.Name),
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			Generation:      resource.Metadata.Generation,
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func EnrollmentApprovalPolicyAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.EnrollmentApprovalPolicyAPIVersion)
+}
+
+func (r *EnrollmentApprovalPolicy) ToApiResource(opts ...APIResourceOption) (*domain.EnrollmentApprovalPolicy, error) {
+	if r == nil {
+		return &domain.EnrollmentApprovalPolicy{}, nil
+	}
+
+	var spec domain.EnrollmentApprovalPolicySpec
+	if r.Spec != nil {
+		spec = r.Spec.Data
+	}
+
+	return &domain.EnrollmentApprovalPolicy{
+		ApiVersion: EnrollmentApprovalPolicyAPIVersion(),
+		Kind:       domain.EnrollmentApprovalPolicyKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(r.Name),
+			CreationTimestamp: lo.ToPtr(r.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(r.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(r.Resource.Annotations)),
+			Generation:        r.Generation,
+			ResourceVersion:   lo.Ternary(r.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(r.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func (r *EnrollmentApprovalPolicy) GetKind() string {
+	return domain.EnrollmentApprovalPolicyKind
+}
+
+func (r *EnrollmentApprovalPolicy) GetStatusAsJson() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (r *EnrollmentApprovalPolicy) HasNilSpec() bool {
+	return r.Spec == nil
+}
+
+func (r *EnrollmentApprovalPolicy) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*EnrollmentApprovalPolicy)
+	if !ok {
+		return false
+	}
+	if r == nil || other == nil {
+		return false
+	}
+	if r.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if r.Spec == nil || other.Spec == nil {
+		return false
+	}
+	return reflect.DeepEqual(r.Spec.Data, other.Spec.Data)
+}
+
+func EnrollmentApprovalPoliciesToApiResource(policies []EnrollmentApprovalPolicy, continueToken *string, resourceVersion *int64) (domain.EnrollmentApprovalPolicyList, error) {
+	items := lo.Map(policies, func(policy EnrollmentApprovalPolicy, _ int) domain.EnrollmentApprovalPolicy {
+		resource, _ := policy.ToApiResource()
+		return *resource
+	})
+
+	return domain.EnrollmentApprovalPolicyList{
+		ApiVersion: EnrollmentApprovalPolicyAPIVersion(),
+		Kind:       domain.EnrollmentApprovalPolicyListKind,
+		Metadata: domain.ListMeta{
+			Continue: continueToken,
+		},
+		Items: items,
+	}, nil
+}
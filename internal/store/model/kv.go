@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+)
+
+// KVEntry is a key of the Postgres-backed KV store.  Stream keys have an entry without a value,
+// so that they can expire like any other key.
+type KVEntry struct {
+	Key       string `gorm:"primaryKey"`
+	Value     []byte
+	ExpiresAt *time.Time `gorm:"index"`
+}
+
+// KVStreamEntry is an entry of a stream of the Postgres-backed KV store.
+type KVStreamEntry struct {
+	ID    int64  `gorm:"primaryKey;autoIncrement"`
+	Key   string `gorm:"not null;index"`
+	Value []byte
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+)
+
+// QueueMessage is a message of a Postgres-backed queue.  A message is claimed by a consumer
+// using SELECT ... FOR UPDATE SKIP LOCKED and deleted once it is completed.
+type QueueMessage struct {
+	ID    int64  `gorm:"primaryKey;autoIncrement"`
+	Queue string `gorm:"not null;index:idx_queue_messages_queue_claimed,priority:1"`
+	Body  []byte
+	// Timestamp of the message in microseconds, used for checkpoint tracking
+	Timestamp    int64
+	TraceContext JSONMap[string, string] `gorm:"type:jsonb"`
+	RetryCount   int
+	// OriginalID is the ID of the message that was first enqueued, if this message is a retry
+	OriginalID string
+	Consumer   string
+	ClaimedAt  *time.Time `gorm:"index:idx_queue_messages_queue_claimed,priority:2"`
+	CreatedAt  time.Time
+}
+
+// QueueFailedMessage is a message of a Postgres-backed queue that failed processing and is scheduled for retry.
+type QueueFailedMessage struct {
+	ID         int64  `gorm:"primaryKey;autoIncrement"`
+	Queue      string `gorm:"not null;index:idx_queue_failed_messages_queue_retry_at,priority:1"`
+	EntryID    string
+	Body       []byte
+	ProcessID  string
+	RetryCount int
+	RetryAt    time.Time `gorm:"index:idx_queue_failed_messages_queue_retry_at,priority:2"`
+}
+
+// QueueInFlightTask tracks the completion of queue messages for safe checkpoint advancement.
+type QueueInFlightTask struct {
+	Queue     string `gorm:"primaryKey"`
+	EntryID   string `gorm:"primaryKey"`
+	Timestamp int64  `gorm:"index"`
+	Completed bool
+}
+
+// QueueCheckpoint stores the latest timestamp (in microseconds) up to which all queue messages were processed.
+type QueueCheckpoint struct {
+	Name      string `gorm:"primaryKey"`
+	Timestamp int64
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevokedCertificate records a client certificate issued by the service's CA that
+// must no longer be accepted. Serial numbers are only unique per issuer, so the
+// issuer's key identifier is part of the primary key.
+type RevokedCertificate struct {
+	// Hex-encoded authority key identifier of the CA that issued the certificate.
+	IssuerKeyID string `gorm:"primaryKey"`
+
+	// Hex-encoded serial number of the certificate.
+	SerialNumber string `gorm:"primaryKey"`
+
+	// The organization the certificate was issued for.
+	OrgID uuid.UUID `gorm:"type:uuid;index"`
+
+	CommonName string
+	DeviceName string `gorm:"index"`
+	Reason     string
+	RevokedAt  time.Time
+
+	// The certificate's NotAfter, if known. Entries for expired certificates are left out of the CRL.
+	ExpiresAt *time.Time `gorm:"index"`
+}
+
+func (r RevokedCertificate) String() string {
+	val, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("RevokedCertificate<marshal-error:%v>", err)
+	}
+	return string(val)
+}
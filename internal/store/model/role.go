@@ -0,0 +1,130 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// Role represents a custom set of permissions in the database.
+type Role struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.RoleSpec] `gorm:"type:jsonb"`
+}
+
+func (r Role) String() string {
+	val, _ := json.Marshal(r)
+	return string(val)
+}
+
+func NewRoleFromApiResource(resource *domain.Role) (*Role, error) {
+	if resource == nil {
+		return nil, fmt.Errorf("resource is nil")
+	}
+	if resource.Metadata.Name == nil {
+		return nil, fmt.Errorf("resource.Metadata.Name is nil")
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+
+	return &Role{
+		Resource: Resource{
+			Name:            lo.FromPtr(resource.Metadata.Name),
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			Generation:      resource.Metadata.Generation,
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func RoleAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.RoleAPIVersion)
+}
+
+func (r *Role) ToApiResource(opts ...APIResourceOption) (*domain.Role, error) {
+	if r == nil {
+		return &domain.Role{}, nil
+	}
+
+	var spec domain.RoleSpec
+	if r.Spec != nil {
+		spec = r.Spec.Data
+	}
+
+	return &domain.Role{
+		ApiVersion: RoleAPIVersion(),
+		Kind:       domain.RoleKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(r.Name),
+			CreationTimestamp: lo.ToPtr(r.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(r.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(r.Resource.Annotations)),
+			Generation:        r.Generation,
+			ResourceVersion:   lo.Ternary(r.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(r.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func (r *Role) GetKind() string {
+	return domain.RoleKind
+}
+
+func (r *Role) GetStatusAsJson() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (r *Role) HasNilSpec() bool {
+	return r.Spec == nil
+}
+
+func (r *Role) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*Role)
+	if !ok {
+		return false
+	}
+	if r == nil || other == nil {
+		return false
+	}
+	if r.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if r.Spec == nil || other.Spec == nil {
+		return false
+	}
+	return reflect.DeepEqual(r.Spec.Data, other.Spec.Data)
+}
+
+func RolesToApiResource(roles []Role, continueToken *string, resourceVersion *int64) (domain.RoleList, error) {
+	items := lo.Map(roles, func(role Role, _ int) domain.Role {
+		resource, _ := role.ToApiResource()
+		return *resource
+	})
+
+	return domain.RoleList{
+		ApiVersion: RoleAPIVersion(),
+		Kind:       domain.RoleListKind,
+		Metadata: domain.ListMeta{
+			Continue: continueToken,
+		},
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// RoleBinding represents the grant of a Role to users and groups in the database.
+type RoleBinding struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[domain.RoleBindingSpec] `gorm:"type:jsonb"`
+}
+
+func (r RoleBinding) String() string {
+	val, _ := json.Marshal(r)
+	return string(val)
+}
+
+func NewRoleBindingFromApiResource(resource *domain.RoleBinding) (*RoleBinding, error) {
+	if resource == nil {
+		return nil, fmt.Errorf("resource is nil")
+	}
+	if resource.Metadata.Name == nil {
+		return nil, fmt.Errorf("resource.Metadata.Name is nil")
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+
+	return &RoleBinding{
+		Resource: Resource{
+			Name:            lo.FromPtr(resource.Metadata.Name),
+			Owner:           resource.Metadata.Owner,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			Generation:      resource.Metadata.Generation,
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func RoleBindingAPIVersion() string {
+	return fmt.Sprintf("%s/%s", domain.APIGroup, domain.RoleBindingAPIVersion)
+}
+
+func (r *RoleBinding) ToApiResource(opts ...APIResourceOption) (*domain.RoleBinding, error) {
+	if r == nil {
+		return &domain.RoleBinding{}, nil
+	}
+
+	var spec domain.RoleBindingSpec
+	if r.Spec != nil {
+		spec = r.Spec.Data
+	}
+
+	return &domain.RoleBinding{
+		ApiVersion: RoleBindingAPIVersion(),
+		Kind:       domain.RoleBindingKind,
+		Metadata: domain.ObjectMeta{
+			Name:              lo.ToPtr(r.Name),
+			CreationTimestamp: lo.ToPtr(r.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(r.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(r.Resource.Annotations)),
+			Generation:        r.Generation,
+			ResourceVersion:   lo.Ternary(r.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(r.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func (r *RoleBinding) GetKind() string {
+	return domain.RoleBindingKind
+}
+
+func (r *RoleBinding) GetStatusAsJson() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (r *RoleBinding) HasNilSpec() bool {
+	return r.Spec == nil
+}
+
+func (r *RoleBinding) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*RoleBinding)
+	if !ok {
+		return false
+	}
+	if r == nil || other == nil {
+		return false
+	}
+	if r.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if r.Spec == nil || other.Spec == nil {
+		return false
+	}
+	return reflect.DeepEqual(r.Spec.Data, other.Spec.Data)
+}
+
+func RoleBindingsToApiResource(roleBindings []RoleBinding, continueToken *string, resourceVersion *int64) (domain.RoleBindingList, error) {
+	items := lo.Map(roleBindings, func(roleBinding RoleBinding, _ int) domain.RoleBinding {
+		resource, _ := roleBinding.ToApiResource()
+		return *resource
+	})
+
+	return domain.RoleBindingList{
+		ApiVersion: RoleBindingAPIVersion(),
+		Kind:       domain.RoleBindingListKind,
+		Metadata: domain.ListMeta{
+			Continue: continueToken,
+		},
+		Items: items,
+	}, nil
+}
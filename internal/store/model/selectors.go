@@ -5,11 +5,12 @@ import (
 	"strings"
 
 	"github.com/flightctl/flightctl/internal/store/selector"
+	gormschema "gorm.io/gorm/schema"
 )
 
 // Define additional custom selectors for various resources,
 // including Device, Fleet, EnrollmentRequest, ResourceSync, Repository,
-// and CertificateSigningRequest. Each resource is equipped with a set of selectors
+// CertificateSigningRequest, BulkOperation and RoleBinding. Each resource is equipped with a set of selectors
 // that map field paths to their corresponding types.
 type selectorToTypeMap map[selector.SelectorName]selector.SelectorType
 
@@ -19,7 +20,42 @@ var (
 		selector.NewSelectorName("status.applicationsSummary.status"): selector.String,
 		selector.NewSelectorName("status.updated.status"):             selector.String,
 		selector.NewSelectorName("status.lifecycle.status"):           selector.String,
+		selector.NewSelectorName("status.os.image"):                   selector.String,
+		selector.NewSelectorName("status.os.imageDigest"):             selector.String,
+		selector.NewSelectorName("status.config.renderedVersion"):     selector.String,
+		selector.NewSelectorName("status.integrity.status"):           selector.String,
+		selector.NewSelectorName("status.resources.cpu"):              selector.String,
+		selector.NewSelectorName("status.resources.memory"):           selector.String,
+		selector.NewSelectorName("status.resources.disk"):             selector.String,
+		selector.NewSelectorName("status.systemInfo.architecture"):    selector.String,
+		selector.NewSelectorName("status.systemInfo.operatingSystem"): selector.String,
+		selector.NewSelectorName("status.systemInfo.agentVersion"):    selector.String,
+		selector.NewSelectorName("status.systemInfo.bootID"):          selector.String,
 	}
+	// The system info collected by the agent is stored as additional
+	// properties of status.systemInfo.
+	deviceSystemInfoSelectors = selectorToTypeMap{
+		selector.NewSelectorName("status.systemInfo.hostname"):                  selector.String,
+		selector.NewSelectorName("status.systemInfo.kernel"):                    selector.String,
+		selector.NewSelectorName("status.systemInfo.productName"):               selector.String,
+		selector.NewSelectorName("status.systemInfo.distroName"):                selector.String,
+		selector.NewSelectorName("status.systemInfo.distroVersion"):             selector.String,
+		selector.NewSelectorName("status.systemInfo.managementCertIssuerKeyId"): selector.String,
+		selector.NewSelectorName("status.systemInfo.managementCAKeyIds"):        selector.String,
+	}
+	// The system info fields holding comma-separated lists support partial matching.
+	devicePartialMatchSelectors = map[selector.SelectorName]struct{}{
+		selector.NewSelectorName("status.systemInfo.managementCAKeyIds"): {},
+	}
+	// Selectors on the elements of status.applications match devices with any
+	// application of the given value.
+	deviceApplicationSelectors = map[selector.SelectorName]string{
+		selector.NewSelectorName("status.applications.name"):   "name",
+		selector.NewSelectorName("status.applications.status"): "status",
+	}
+	// The last seen time is stored in the device_timestamps table.
+	deviceLastSeenSelector = selector.NewSelectorName("lastSeen")
+
 	fleetSpecSelectors = selectorToTypeMap{
 		selector.NewSelectorName("spec.template.spec.os.image"): selector.String,
 	}
@@ -43,6 +79,13 @@ var (
 	certificateSigningRequestStatusSelectors = selectorToTypeMap{
 		selector.NewSelectorName("status.certificate"): selector.String,
 	}
+	bulkOperationSelectors = selectorToTypeMap{
+		selector.NewSelectorName("spec.action.type"): selector.String,
+		selector.NewSelectorName("status.phase"):     selector.String,
+	}
+	roleBindingSelectors = selectorToTypeMap{
+		selector.NewSelectorName("spec.roleRef"): selector.String,
+	}
 )
 
 func (m *Device) MapSelectorName(name selector.SelectorName) []selector.SelectorName {
@@ -59,15 +102,50 @@ func (m *Device) ResolveSelector(name selector.SelectorName) (*selector.Selector
 	if typ, exists := deviceStatusSelectors[name]; exists {
 		return makeJSONBSelectorField(name, typ)
 	}
+	if typ, exists := deviceSystemInfoSelectors[name]; exists {
+		field, err := makeJSONBSelectorField(name, typ)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := devicePartialMatchSelectors[name]; ok {
+			field.Options = selector.SelectorOpt{selector.PartialMatchOption: {}}
+		}
+		return field, nil
+	}
+	if key, exists := deviceApplicationSelectors[name]; exists {
+		return &selector.SelectorField{
+			Type:      selector.String,
+			FieldName: "status",
+			FieldType: "jsonb",
+			ArrayElement: &selector.JSONBArrayElement{
+				Path: []string{"applications"},
+				Key:  key,
+			},
+		}, nil
+	}
+	if name == deviceLastSeenSelector {
+		return &selector.SelectorField{
+			Type:      selector.Timestamp,
+			FieldName: "(SELECT device_timestamps.last_seen FROM device_timestamps WHERE device_timestamps.org_id = devices.org_id AND device_timestamps.name = devices.name)",
+			FieldType: gormschema.Time,
+		}, nil
+	}
 	return nil, fmt.Errorf("unable to resolve selector for device")
 }
 
 func (m *Device) ListSelectors() selector.SelectorNameSet {
-	keys := make([]selector.SelectorName, 0, len(deviceStatusSelectors))
+	keys := make([]selector.SelectorName, 0, len(deviceStatusSelectors)+len(deviceSystemInfoSelectors)+len(deviceApplicationSelectors)+2)
 	for sn := range deviceStatusSelectors {
 		keys = append(keys, sn)
 	}
-	return selector.NewSelectorFieldNameSet().Add(selector.NewSelectorName("metadata.nameOrAlias")).Add(keys...)
+	for sn := range deviceSystemInfoSelectors {
+		keys = append(keys, sn)
+	}
+	for sn := range deviceApplicationSelectors {
+		keys = append(keys, sn)
+	}
+	keys = append(keys, selector.NewSelectorName("metadata.nameOrAlias"), deviceLastSeenSelector)
+	return selector.NewSelectorFieldNameSet().Add(keys...)
 }
 
 func (m *DeviceLabel) MapSelectorName(name selector.SelectorName) []selector.SelectorName {
@@ -167,6 +245,36 @@ func (m *CertificateSigningRequest) ListSelectors() selector.SelectorNameSet {
 	return selector.NewSelectorFieldNameSet().Add(keys...)
 }
 
+func (m *BulkOperation) ResolveSelector(name selector.SelectorName) (*selector.SelectorField, error) {
+	if typ, exists := bulkOperationSelectors[name]; exists {
+		return makeJSONBSelectorField(name, typ)
+	}
+	return nil, fmt.Errorf("unable to resolve selector for bulk operation")
+}
+
+func (m *BulkOperation) ListSelectors() selector.SelectorNameSet {
+	keys := make([]selector.SelectorName, 0, len(bulkOperationSelectors))
+	for sn := range bulkOperationSelectors {
+		keys = append(keys, sn)
+	}
+	return selector.NewSelectorFieldNameSet().Add(keys...)
+}
+
+func (m *RoleBinding) ResolveSelector(name selector.SelectorName) (*selector.SelectorField, error) {
+	if typ, exists := roleBindingSelectors[name]; exists {
+		return makeJSONBSelectorField(name, typ)
+	}
+	return nil, fmt.Errorf("unable to resolve selector for role binding")
+}
+
+func (m *RoleBinding) ListSelectors() selector.SelectorNameSet {
+	keys := make([]selector.SelectorName, 0, len(roleBindingSelectors))
+	for sn := range roleBindingSelectors {
+		keys = append(keys, sn)
+	}
+	return selector.NewSelectorFieldNameSet().Add(keys...)
+}
+
 func makeJSONBSelectorField(selectorName selector.SelectorName, selectorType selector.SelectorType) (*selector.SelectorField, error) {
 	selectorStr := selectorName.String()
 	if len(selectorStr) == 0 {
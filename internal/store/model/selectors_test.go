@@ -36,6 +36,27 @@ func TestModelSchemaSelectors(t *testing.T) {
 	}
 }
 
+func TestDeviceSelectors(t *testing.T) {
+	schema := scanAPISchema("status", &domain.DeviceStatus{})
+	for name, key := range deviceApplicationSelectors {
+		elementName := selector.NewSelectorName("status.applications[]." + key)
+		if typ, exists := schema[elementName]; !exists || typ != selector.String {
+			t.Errorf("%v: application element %q is not a string in API schema", name, elementName)
+		}
+	}
+
+	resolver, err := selector.SelectorFieldResolver(&Device{})
+	if err != nil {
+		t.Fatalf("creating resolver: %v", err)
+	}
+	for _, name := range []string{"status.applications.status", "status.systemInfo.kernel", "status.systemInfo.managementCAKeyIds", "status.resources.disk", "lastSeen"} {
+		fields, err := resolver.ResolveFields(selector.NewSelectorName(name))
+		if err != nil || len(fields) != 1 {
+			t.Errorf("%v: failed to resolve selector: %v", name, err)
+		}
+	}
+}
+
 func verifySchema(schemaName string, apischema any, selectors selectorToTypeMap) error {
 	schema := scanAPISchema(schemaName, apischema)
 	for s, typ := range selectors {
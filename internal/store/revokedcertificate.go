@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RevokedCertificate interface {
+	InitialMigration(ctx context.Context) error
+
+	// Create records the given certificates as revoked. Certificates that are already revoked keep
+	// their original revocation record. It returns the stored records of all given certificates.
+	Create(ctx context.Context, orgId uuid.UUID, revoked []domain.RevokedCertificate) ([]domain.RevokedCertificate, error)
+
+	// Used by the agent server's mTLS authentication
+	IsRevoked(ctx context.Context, issuerKeyID string, serialNumber string) (bool, error)
+
+	// Used to build the CRL of an issuer, skipping certificates that are expired at the given time
+	ListByIssuer(ctx context.Context, issuerKeyID string, notExpiredAt time.Time) ([]domain.RevokedCertificate, error)
+}
+
+type RevokedCertificateStore struct {
+	dbHandler *gorm.DB
+	log       logrus.FieldLogger
+}
+
+// Make sure we conform to RevokedCertificate interface
+var _ RevokedCertificate = (*RevokedCertificateStore)(nil)
+
+func NewRevokedCertificate(db *gorm.DB, log logrus.FieldLogger) RevokedCertificate {
+	return &RevokedCertificateStore{dbHandler: db, log: log}
+}
+
+func (s *RevokedCertificateStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *RevokedCertificateStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+	return db.AutoMigrate(&model.RevokedCertificate{})
+}
+
+func (s *RevokedCertificateStore) Create(ctx context.Context, orgId uuid.UUID, revoked []domain.RevokedCertificate) ([]domain.RevokedCertificate, error) {
+	if len(revoked) == 0 {
+		return []domain.RevokedCertificate{}, nil
+	}
+
+	models := make([]model.RevokedCertificate, 0, len(revoked))
+	keys := make([][]any, 0, len(revoked))
+	for _, r := range revoked {
+		models = append(models, model.RevokedCertificate{
+			IssuerKeyID:  r.IssuerKeyId,
+			SerialNumber: r.SerialNumber,
+			OrgID:        orgId,
+			CommonName:   lo.FromPtr(r.CommonName),
+			DeviceName:   lo.FromPtr(r.DeviceName),
+			Reason:       string(r.Reason),
+			RevokedAt:    r.RevokedAt,
+			ExpiresAt:    r.ExpiresAt,
+		})
+		keys = append(keys, []any{r.IssuerKeyId, r.SerialNumber})
+	}
+
+	db := s.getDB(ctx)
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&models).Error; err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+
+	var stored []model.RevokedCertificate
+	if err := db.Where("(issuer_key_id, serial_number) IN ?", keys).Order("revoked_at, serial_number").Find(&stored).Error; err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	return revokedCertificatesToDomain(stored), nil
+}
+
+func (s *RevokedCertificateStore) IsRevoked(ctx context.Context, issuerKeyID string, serialNumber string) (bool, error) {
+	var count int64
+	err := s.getDB(ctx).Model(&model.RevokedCertificate{}).
+		Where("issuer_key_id = ? AND serial_number = ?", issuerKeyID, serialNumber).
+		Count(&count).Error
+	if err != nil {
+		return false, ErrorFromGormError(err)
+	}
+	return count > 0, nil
+}
+
+func (s *RevokedCertificateStore) ListByIssuer(ctx context.Context, issuerKeyID string, notExpiredAt time.Time) ([]domain.RevokedCertificate, error) {
+	var stored []model.RevokedCertificate
+	err := s.getDB(ctx).
+		Where("issuer_key_id = ? AND (expires_at IS NULL OR expires_at >= ?)", issuerKeyID, notExpiredAt).
+		Order("revoked_at, serial_number").
+		Find(&stored).Error
+	if err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	return revokedCertificatesToDomain(stored), nil
+}
+
+func revokedCertificatesToDomain(stored []model.RevokedCertificate) []domain.RevokedCertificate {
+	result := make([]domain.RevokedCertificate, 0, len(stored))
+	for _, r := range stored {
+		result = append(result, domain.RevokedCertificate{
+			IssuerKeyId:  r.IssuerKeyID,
+			SerialNumber: r.SerialNumber,
+			CommonName:   lo.EmptyableToPtr(r.CommonName),
+			DeviceName:   lo.EmptyableToPtr(r.DeviceName),
+			Reason:       domain.CertificateRevocationReason(r.Reason),
+			RevokedAt:    r.RevokedAt,
+			ExpiresAt:    r.ExpiresAt,
+		})
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Role interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, role *domain.Role, eventCallback EventCallback) (*domain.Role, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, role *domain.Role, eventCallback EventCallback) (*domain.Role, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.RoleList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+}
+
+type RoleStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.Role, model.Role, domain.Role, domain.RoleList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to Role interface
+var _ Role = (*RoleStore)(nil)
+
+func NewRole(db *gorm.DB, log logrus.FieldLogger) Role {
+	genericStore := NewGenericStore[*model.Role, model.Role, domain.Role, domain.RoleList](
+		db,
+		log,
+		model.NewRoleFromApiResource,
+		(*model.Role).ToApiResource,
+		model.RolesToApiResource,
+	)
+	return &RoleStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.RoleKind, log)}
+}
+
+func (s *RoleStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *RoleStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.Role{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for Role labels
+	if !db.Migrator().HasIndex(&model.Role{}, "idx_roles_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_roles_labels ON roles USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.Role{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *RoleStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.Role, eventCallback EventCallback) (*domain.Role, error) {
+	role, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, role, true, err)
+	return role, err
+}
+
+func (s *RoleStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.Role, eventCallback EventCallback) (*domain.Role, bool, error) {
+	newRole, oldRole, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldRole, newRole, created, err)
+	return newRole, created, err
+}
+
+func (s *RoleStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Role, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *RoleStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.RoleList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *RoleStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.Role{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, err)
+	}
+	return err
+}
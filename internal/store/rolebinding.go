@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type RoleBinding interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, binding *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, binding *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.RoleBindingList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+}
+
+type RoleBindingStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.RoleBinding, model.RoleBinding, domain.RoleBinding, domain.RoleBindingList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to RoleBinding interface
+var _ RoleBinding = (*RoleBindingStore)(nil)
+
+func NewRoleBinding(db *gorm.DB, log logrus.FieldLogger) RoleBinding {
+	genericStore := NewGenericStore[*model.RoleBinding, model.RoleBinding, domain.RoleBinding, domain.RoleBindingList](
+		db,
+		log,
+		model.NewRoleBindingFromApiResource,
+		(*model.RoleBinding).ToApiResource,
+		model.RoleBindingsToApiResource,
+	)
+	return &RoleBindingStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(domain.RoleBindingKind, log)}
+}
+
+func (s *RoleBindingStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *RoleBindingStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.RoleBinding{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for RoleBinding labels
+	if !db.Migrator().HasIndex(&model.RoleBinding{}, "idx_role_bindings_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_role_bindings_labels ON role_bindings USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.RoleBinding{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *RoleBindingStore) Create(ctx context.Context, orgId uuid.UUID, resource *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, error) {
+	binding, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, binding, true, err)
+	return binding, err
+}
+
+func (s *RoleBindingStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *domain.RoleBinding, eventCallback EventCallback) (*domain.RoleBinding, bool, error) {
+	newRoleBinding, oldRoleBinding, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldRoleBinding, newRoleBinding, created, err)
+	return newRoleBinding, created, err
+}
+
+func (s *RoleBindingStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.RoleBinding, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *RoleBindingStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*domain.RoleBindingList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *RoleBindingStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.RoleBinding{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, err)
+	}
+	return err
+}
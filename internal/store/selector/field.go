@@ -267,6 +267,20 @@ func (fs *FieldSelector) Tokenize(ctx context.Context, input any) (queryparser.T
 					resolvedField.Type.String())
 			}
 
+			if resolvedField.ArrayElement != nil {
+				valueStrings := make([]string, 0, len(values))
+				for _, val := range values {
+					valueStrings = append(valueStrings, val.String())
+				}
+				elementToken, err := fs.createArrayElementToken(operator, resolvedField, valueStrings)
+				if err != nil {
+					return nil, NewSelectorError(flterrors.ErrFieldSelectorParseFailed,
+						fmt.Errorf("failed to resolve operation for selector %q: %w", key, err))
+				}
+				resolvedTokens = resolvedTokens.Append(elementToken)
+				continue
+			}
+
 			fieldToken, err := fs.createFieldToken(resolvedField)
 			if err != nil {
 				return nil, NewSelectorError(flterrors.ErrFieldSelectorParseFailed,
@@ -366,6 +380,58 @@ func (fs *FieldSelector) createOperatorToken(operator selection.Operator, select
 	})
 }
 
+// createArrayElementToken creates the token of a selector on the elements of an array within a JSONB field.
+// The selector is resolved to JSONB containment, so that it is served by GIN indexes on the field.
+func (fs *FieldSelector) createArrayElementToken(operator selection.Operator, selectorField *SelectorField, values []string) (queryparser.TokenSet, error) {
+	var op string
+	switch operator {
+	case selection.Equals, selection.DoubleEquals, selection.In:
+		op = "JSONB_CONTAINS"
+	case selection.NotEquals, selection.NotIn:
+		op = "JSONB_NOTCONTAINS"
+	default:
+		return nil, fmt.Errorf("operator %q is unsupported for array elements", operator)
+	}
+
+	fieldToken, err := fs.createFieldToken(selectorField)
+	if err != nil {
+		return nil, err
+	}
+
+	containsTokens := queryparser.NewTokenSet()
+	for _, value := range values {
+		doc, err := selectorField.ArrayElement.Document(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse value for array element: %w", err)
+		}
+		containsTokens = containsTokens.AddFunctionToken(op, func() queryparser.TokenSet {
+			return queryparser.NewTokenSet().Append(fieldToken).AddFunctionToken("V", func() queryparser.TokenSet {
+				return queryparser.NewTokenSet().AddValueToken(doc)
+			})
+		})
+	}
+
+	// any of the values matches, or none of them does
+	if len(values) > 1 {
+		combined := "OR"
+		if op == "JSONB_NOTCONTAINS" {
+			combined = "AND"
+		}
+		tokens := containsTokens
+		containsTokens = queryparser.NewTokenSet().AddFunctionToken(combined, func() queryparser.TokenSet {
+			return tokens
+		})
+	}
+
+	if op == "JSONB_NOTCONTAINS" {
+		return queryparser.NewTokenSet().AddFunctionToken("OR", func() queryparser.TokenSet {
+			return queryparser.NewTokenSet().AddFunctionToken("ISNULL", func() queryparser.TokenSet { return fieldToken }).
+				Append(containsTokens)
+		}), nil
+	}
+	return containsTokens, nil
+}
+
 func (fs *FieldSelector) resolveField(selectorField *SelectorField, resolve resolverFunc[string]) (queryparser.TokenSet, error) {
 	if _, ok := selectorField.Options["private"]; ok && !fs.privateSelectors {
 		return nil, fmt.Errorf("field is marked as private and cannot be selected")
@@ -434,7 +500,7 @@ func (fs *FieldSelector) resolveValue(
 		return resolve(v), nil
 
 	case String, TextArray:
-		if !selectorField.IsJSONBCast() && selectorField.Type == String &&
+		if selectorField.AllowsPartialMatch() && selectorField.Type == String &&
 			(operator == selection.Contains || operator == selection.NotContains) {
 
 			if strings.Contains(value, "%") {
@@ -570,7 +636,7 @@ func (fs *FieldSelector) applyStringOperator(operator selection.Operator, select
 		selection.Exists, selection.DoesNotExist:
 		return resolve(operatorsMap[operator]), nil
 	case selection.Contains, selection.NotContains:
-		if selectorField.IsJSONBCast() {
+		if !selectorField.AllowsPartialMatch() {
 			return nil, fmt.Errorf("the operator %q is not supported for partial string matching when the field is of type JSONB with string casting", operator)
 		}
 		return resolve(operatorsMap[operator]), nil
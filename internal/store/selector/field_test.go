@@ -184,6 +184,16 @@ func TestFieldSelectorQueries(t *testing.T) {
 		"customfield2=2024-10-14T22:47:31+03:00": "EQ(CAST(K(goodfield ->> 'key'), timestamp),V(2024-10-14T22:47:31+03:00))",
 		"customfield3=\"text\"":                  "EQ(K(goodfield -> 'key'),V(\"text\"))",
 		"customfield5.approved = true":           "EQ(CAST(K(goodfield -> 'path' ->> 'approved'), boolean),V(true))",
+		"customfield7=5.14":                      "EQ(K(goodfield ->> 'kernel'),V(5.14))",
+		"customfield8 contains abc":              "LIKE(K(goodfield ->> 'keyIds'),V(%abc%))",
+		"customfield8 notcontains abc":           "OR(ISNULL(K(goodfield ->> 'keyIds')),NOTLIKE(K(goodfield ->> 'keyIds'),V(%abc%)))",
+
+		// Array elements within JSONB
+		"customfield6=Error":                 "JSONB_CONTAINS(K(goodfield),V({\"items\":[{\"status\":\"Error\"}]}))",
+		"customfield6==Error":                "JSONB_CONTAINS(K(goodfield),V({\"items\":[{\"status\":\"Error\"}]}))",
+		"customfield6 in (Error,Unknown)":    "OR(JSONB_CONTAINS(K(goodfield),V({\"items\":[{\"status\":\"Error\"}]})),JSONB_CONTAINS(K(goodfield),V({\"items\":[{\"status\":\"Unknown\"}]})))",
+		"customfield6!=Error":                "OR(ISNULL(K(goodfield)),JSONB_NOTCONTAINS(K(goodfield),V({\"items\":[{\"status\":\"Error\"}]})))",
+		"customfield6 notin (Error,Unknown)": "OR(ISNULL(K(goodfield)),AND(JSONB_NOTCONTAINS(K(goodfield),V({\"items\":[{\"status\":\"Error\"}]})),JSONB_NOTCONTAINS(K(goodfield),V({\"items\":[{\"status\":\"Unknown\"}]}))))",
 	}
 
 	testBadOperations := []string{
@@ -209,6 +219,16 @@ func TestFieldSelectorQueries(t *testing.T) {
 		"model.field6<1",  //LessThan
 		"model.field6<=1", //LessThanOrEquals
 
+		// Array elements within JSONB
+		"customfield6",                //Exists
+		"!customfield6",               //DoesNotExist
+		"customfield6 contains Error", //Contains
+		"customfield6>1",              //GreaterThan
+
+		// JSONB with string casting without partial matching
+		"customfield7 contains 5.14",    //Contains
+		"customfield7 notcontains 5.14", //NotContains
+
 		// Timestamps
 		"model.field7 contains 2024-10-14T22:47:31+03:00",    //Contains
 		"model.field7 notcontains 2024-10-14T22:47:31+03:00", //NotContains
@@ -2,6 +2,7 @@ package selector
 
 import (
 	"sort"
+	"strings"
 
 	gormschema "gorm.io/gorm/schema"
 )
@@ -85,6 +86,10 @@ func (r *CompositeSelectorResolver) ResolveFields(name SelectorName) ([]*Selecto
 		}
 		if len(fields) > 0 {
 			for i := range fields {
+				// subqueries qualify the columns they refer to themselves
+				if strings.HasPrefix(fields[i].FieldName, "(") {
+					continue
+				}
 				fields[i].FieldName = cr.table + "." + fields[i].FieldName
 			}
 			return fields, nil
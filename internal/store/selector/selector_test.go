@@ -67,6 +67,32 @@ func (m *goodTestModel) ResolveSelector(selector SelectorName) (*SelectorField,
 			FieldType: "jsonb",
 		}, nil
 	}
+	if strings.EqualFold("customfield6", selector.String()) {
+		return &SelectorField{
+			Type:      String,
+			FieldName: "goodfield",
+			FieldType: "jsonb",
+			ArrayElement: &JSONBArrayElement{
+				Path: []string{"items"},
+				Key:  "status",
+			},
+		}, nil
+	}
+	if strings.EqualFold("customfield7", selector.String()) {
+		return &SelectorField{
+			Type:      String,
+			FieldName: "goodfield ->> 'kernel'",
+			FieldType: "jsonb",
+		}, nil
+	}
+	if strings.EqualFold("customfield8", selector.String()) {
+		return &SelectorField{
+			Type:      String,
+			FieldName: "goodfield ->> 'keyIds'",
+			FieldType: "jsonb",
+			Options:   SelectorOpt{PartialMatchOption: {}},
+		}, nil
+	}
 	return nil, nil
 }
 
@@ -77,6 +103,9 @@ func (m *goodTestModel) ListSelectors() SelectorNameSet {
 		NewSelectorName("customfield2"),
 		NewSelectorName("customfield3"),
 		NewSelectorName("customfield5.approved"),
+		NewSelectorName("customfield6"),
+		NewSelectorName("customfield7"),
+		NewSelectorName("customfield8"),
 	)
 }
 
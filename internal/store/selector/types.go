@@ -1,6 +1,7 @@
 package selector
 
 import (
+	"encoding/json"
 	"strings"
 
 	"github.com/flightctl/flightctl/pkg/k8s/selector/selection"
@@ -184,12 +185,53 @@ func (t SelectorType) String() string {
 // SelectorOpt represents a set of options for a selector.
 type SelectorOpt = map[string]struct{}
 
+// PartialMatchOption allows partial string matching on a JSONB field with string casting, e.g. for
+// fields holding comma-separated lists.
+const PartialMatchOption = "partialmatch"
+
 type SelectorField struct {
 	Name      SelectorName
 	Type      SelectorType
 	FieldName string
 	FieldType gormschema.DataType
 	Options   SelectorOpt
+
+	// ArrayElement is set if the selector matches the elements of an array of
+	// objects within the JSONB field.
+	ArrayElement *JSONBArrayElement
+}
+
+// JSONBArrayElement describes a key of the objects of an array within a JSONB
+// field. A selector on it matches if any element of the array has the key set
+// to the selector value.
+type JSONBArrayElement struct {
+	// Path is the path of the array within the JSONB field.
+	Path []string
+	// Key is the key of the elements that is compared to the selector value.
+	Key string
+}
+
+// Document returns the JSONB document that contains an element of the array
+// with the key set to value.
+func (e *JSONBArrayElement) Document(value string) (string, error) {
+	var doc any = []map[string]string{{e.Key: value}}
+	for i := len(e.Path) - 1; i >= 0; i-- {
+		doc = map[string]any{e.Path[i]: doc}
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// AllowsPartialMatch returns true if the contains and notcontains operators are supported for the field.
+func (sf *SelectorField) AllowsPartialMatch() bool {
+	if !sf.IsJSONBCast() {
+		return true
+	}
+	_, ok := sf.Options[PartialMatchOption]
+	return ok
 }
 
 // IsJSONBCast returns true if the field's data type is 'jsonb' and the expected type is not Jsonb.
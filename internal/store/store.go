@@ -9,6 +9,7 @@ import (
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/org"
+	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -28,10 +29,15 @@ type Store interface {
 	Repository() Repository
 	ResourceSync() ResourceSync
 	Catalog() Catalog
+	BulkOperation() BulkOperation
+	EnrollmentApprovalPolicy() EnrollmentApprovalPolicy
+	Role() Role
+	RoleBinding() RoleBinding
 	Event() Event
 	Checkpoint() Checkpoint
 	Organization() Organization
 	AuthProvider() AuthProvider
+	RevokedCertificate() RevokedCertificate
 	RunMigrations(context.Context) error
 	CheckHealth(context.Context) error
 	Close() error
@@ -46,10 +52,15 @@ type DataStore struct {
 	repository                Repository
 	resourceSync              ResourceSync
 	catalog                   Catalog
+	bulkOperation             BulkOperation
+	enrollmentApprovalPolicy  EnrollmentApprovalPolicy
+	role                      Role
+	roleBinding               RoleBinding
 	event                     Event
 	checkpoint                Checkpoint
 	organization              Organization
 	authProvider              AuthProvider
+	revokedCertificate        RevokedCertificate
 
 	db *gorm.DB
 }
@@ -64,10 +75,15 @@ func NewStore(db *gorm.DB, log logrus.FieldLogger) Store {
 		repository:                NewRepository(db, log),
 		resourceSync:              NewResourceSync(db, log),
 		catalog:                   NewCatalog(db, log),
+		bulkOperation:             NewBulkOperation(db, log),
+		enrollmentApprovalPolicy:  NewEnrollmentApprovalPolicy(db, log),
+		role:                      NewRole(db, log),
+		roleBinding:               NewRoleBinding(db, log),
 		event:                     NewEvent(db, log),
 		checkpoint:                NewCheckpoint(db, log),
 		organization:              NewOrganization(db),
 		authProvider:              NewAuthProvider(db, log),
+		revokedCertificate:        NewRevokedCertificate(db, log),
 		db:                        db,
 	}
 }
@@ -104,6 +120,22 @@ func (s *DataStore) Catalog() Catalog {
 	return s.catalog
 }
 
+func (s *DataStore) BulkOperation() BulkOperation {
+	return s.bulkOperation
+}
+
+func (s *DataStore) EnrollmentApprovalPolicy() EnrollmentApprovalPolicy {
+	return s.enrollmentApprovalPolicy
+}
+
+func (s *DataStore) Role() Role {
+	return s.role
+}
+
+func (s *DataStore) RoleBinding() RoleBinding {
+	return s.roleBinding
+}
+
 func (s *DataStore) Event() Event {
 	return s.event
 }
@@ -120,6 +152,10 @@ func (s *DataStore) AuthProvider() AuthProvider {
 	return s.authProvider
 }
 
+func (s *DataStore) RevokedCertificate() RevokedCertificate {
+	return s.revokedCertificate
+}
+
 // CheckHealth verifies database connectivity and ensures the instance is not in recovery.
 func (s *DataStore) CheckHealth(ctx context.Context) error {
 	if s.db == nil {
@@ -189,6 +225,18 @@ func (s *DataStore) RunMigrations(ctx context.Context) error {
 	if err := s.Catalog().InitialMigration(ctx); err != nil {
 		return err
 	}
+	if err := s.BulkOperation().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.EnrollmentApprovalPolicy().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.Role().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.RoleBinding().InitialMigration(ctx); err != nil {
+		return err
+	}
 	if err := s.Event().InitialMigration(ctx); err != nil {
 		return err
 	}
@@ -201,6 +249,20 @@ func (s *DataStore) RunMigrations(ctx context.Context) error {
 	if err := s.AuthProvider().InitialMigration(ctx); err != nil {
 		return err
 	}
+	if err := s.RevokedCertificate().InitialMigration(ctx); err != nil {
+		return err
+	}
+	// Tables of the Postgres queue provider and KV store, used when kv.provider is "postgres"
+	if err := s.db.WithContext(ctx).AutoMigrate(
+		&model.QueueMessage{},
+		&model.QueueFailedMessage{},
+		&model.QueueInFlightTask{},
+		&model.QueueCheckpoint{},
+		&model.KVEntry{},
+		&model.KVStreamEntry{},
+	); err != nil {
+		return err
+	}
 	return s.customizeMigration(ctx)
 }
 
@@ -0,0 +1,367 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/pkg/k8s/selector"
+	"github.com/flightctl/flightctl/pkg/k8s/selector/labels"
+	"github.com/flightctl/flightctl/pkg/k8sclient"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+)
+
+// The bulk operation task applies the action of a bulk operation to the devices matching
+// its selectors.
+//
+// Behavior:
+// - Processes one batch of devices per event, in the order of their names.
+// - After each batch, records the device counters, the results of failed and skipped devices
+//   (up to maxBulkOperationResults) and the position of the next batch in the operation's
+//   status, and emits a BulkOperationProgressed event that triggers the next batch.
+// - Stops once all devices were processed, or when the operation was canceled or deleted.
+//
+// Idempotency:
+// - Status updates are conditional on the operation's resource version. If the operation
+//   changed while a batch was processed, the results of the batch are only merged if the
+//   operation was canceled in the meantime; otherwise the batch was already recorded by a
+//   redelivery of the same event.
+
+const (
+	bulkOperationBatchSize = 50
+
+	// bulkOperationBatchBudget bounds the time spent applying the action to the devices of a
+	// batch, leaving room within EventProcessingTimeout to record the results.
+	bulkOperationBatchBudget = 5 * time.Second
+
+	maxBulkOperationStatusUpdateAttempts = 3
+
+	// maxBulkOperationResults bounds the failed and skipped device results kept in the status of
+	// an operation, so that its size doesn't grow with the number of devices. Succeeded devices
+	// are only counted.
+	maxBulkOperationResults = 100
+)
+
+func bulkOperation(ctx context.Context, orgId uuid.UUID, event domain.Event, serviceHandler service.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, log logrus.FieldLogger) error {
+	logic := NewBulkOperationLogic(log, serviceHandler, k8sClient, kvStore, orgId, event.InvolvedObject.Name)
+	err := logic.ProcessBatch(ctx)
+	if err != nil {
+		log.Errorf("failed processing bulk operation %s/%s: %v", orgId, event.InvolvedObject.Name, err)
+	}
+	return err
+}
+
+type BulkOperationLogic struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	k8sClient      k8sclient.K8SClient
+	kvStore        kvstore.KVStore
+	orgId          uuid.UUID
+	name           string
+	batchSize      int
+	batchBudget    time.Duration
+}
+
+func NewBulkOperationLogic(log logrus.FieldLogger, serviceHandler service.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, orgId uuid.UUID, name string) BulkOperationLogic {
+	return BulkOperationLogic{
+		log:            log,
+		serviceHandler: serviceHandler,
+		k8sClient:      k8sClient,
+		kvStore:        kvStore,
+		orgId:          orgId,
+		name:           name,
+		batchSize:      bulkOperationBatchSize,
+		batchBudget:    bulkOperationBatchBudget,
+	}
+}
+
+// ProcessBatch applies the operation's action to its next batch of devices.
+func (b *BulkOperationLogic) ProcessBatch(ctx context.Context) error {
+	operation, status := b.serviceHandler.GetBulkOperation(ctx, b.orgId, b.name)
+	if status.Code == http.StatusNotFound {
+		b.log.Infof("bulk operation %s/%s was deleted, stopping", b.orgId, b.name)
+		return nil
+	}
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("failed getting bulk operation: %s", status.Message)
+	}
+
+	switch operation.Status.Phase {
+	case domain.BulkOperationPhasePending:
+		var err error
+		if operation, err = b.start(ctx, operation); operation == nil {
+			return err
+		}
+	case domain.BulkOperationPhaseRunning:
+	default:
+		b.log.Infof("bulk operation %s/%s is %s, nothing to process", b.orgId, b.name, operation.Status.Phase)
+		return nil
+	}
+
+	devices, status := b.serviceHandler.ListDevices(ctx, b.orgId, domain.ListDevicesParams{
+		LabelSelector: operation.Spec.LabelSelector,
+		FieldSelector: operation.Spec.FieldSelector,
+		Limit:         lo.ToPtr(int32(b.batchSize)),
+		Continue:      operation.Status.Continue,
+	}, nil)
+	if status.Code == http.StatusBadRequest {
+		return b.fail(ctx, operation, status.Message)
+	}
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("failed listing devices: %s", status.Message)
+	}
+
+	scope, err := bulkOperationScope(operation)
+	if err != nil {
+		return b.fail(ctx, operation, err.Error())
+	}
+	results, next := b.applyAction(ctx, operation.Spec.Action, scope, devices)
+	return b.recordBatch(ctx, operation, results, next)
+}
+
+// bulkOperationScope returns the label selectors of the devices the creator of the operation may apply its
+// action to, or nil if the creator's permission is not scoped.
+func bulkOperationScope(operation *domain.BulkOperation) ([]selector.Selector, error) {
+	scopeJSON, ok := lo.FromPtr(operation.Metadata.Annotations)[domain.BulkOperationAnnotationScopeLabelSelectors]
+	if !ok {
+		return nil, nil
+	}
+	var labelSelectors []string
+	if err := json.Unmarshal([]byte(scopeJSON), &labelSelectors); err != nil {
+		return nil, fmt.Errorf("invalid scope of bulk operation: %w", err)
+	}
+	scope := make([]selector.Selector, 0, len(labelSelectors))
+	for _, labelSelector := range labelSelectors {
+		parsed, err := labels.Parse(labelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid scope of bulk operation: %w", err)
+		}
+		scope = append(scope, parsed)
+	}
+	return scope, nil
+}
+
+// start moves a pending operation to running. It returns nil if the operation must not be processed.
+func (b *BulkOperationLogic) start(ctx context.Context, operation *domain.BulkOperation) (*domain.BulkOperation, error) {
+	total, status := b.serviceHandler.CountDevices(ctx, b.orgId, domain.ListDevicesParams{
+		LabelSelector: operation.Spec.LabelSelector,
+		FieldSelector: operation.Spec.FieldSelector,
+	}, nil)
+	if status.Code == http.StatusBadRequest {
+		return nil, b.fail(ctx, operation, status.Message)
+	}
+	if status.Code != http.StatusOK {
+		return nil, fmt.Errorf("failed counting devices: %s", status.Message)
+	}
+
+	operation.Status.Phase = domain.BulkOperationPhaseRunning
+	operation.Status.Message = lo.ToPtr("Processing devices.")
+	operation.Status.StartedAt = lo.ToPtr(time.Now().UTC())
+	operation.Status.TotalDevices = lo.ToPtr(total)
+	started, status := b.serviceHandler.ReplaceBulkOperationStatus(ctx, b.orgId, b.name, *operation)
+	if status.Code == http.StatusConflict || status.Code == http.StatusNotFound {
+		b.log.Infof("bulk operation %s/%s changed before it started, stopping", b.orgId, b.name)
+		return nil, nil
+	}
+	if status.Code != http.StatusOK {
+		return nil, fmt.Errorf("failed starting bulk operation: %s", status.Message)
+	}
+	return started, nil
+}
+
+// applyAction applies the action to the devices of the batch until the batch's time budget is
+// used up. It returns the results and the continue token of the next batch, if any.
+func (b *BulkOperationLogic) applyAction(ctx context.Context, action domain.BulkOperationAction, scope []selector.Selector, devices *domain.DeviceList) ([]domain.BulkOperationDeviceResult, *string) {
+	deadline := time.Now().Add(b.batchBudget)
+	results := make([]domain.BulkOperationDeviceResult, 0, len(devices.Items))
+	for i := range devices.Items {
+		name := lo.FromPtr(devices.Items[i].Metadata.Name)
+		// always make progress, even if a single device takes longer than the budget
+		if i > 0 && time.Now().After(deadline) {
+			return results, store.BuildContinueString([]string{name}, 0)
+		}
+		if scope != nil && !lo.ContainsBy(scope, func(s selector.Selector) bool {
+			return s.Matches(k8sLabels.Set(lo.FromPtr(devices.Items[i].Metadata.Labels)))
+		}) {
+			results = append(results, bulkOperationDeviceResult(name, domain.BulkOperationDeviceSkipped, "device is outside the label scope of the operation's creator"))
+			continue
+		}
+		results = append(results, b.applyToDevice(ctx, action, &devices.Items[i]))
+	}
+	return results, devices.Metadata.Continue
+}
+
+func (b *BulkOperationLogic) applyToDevice(ctx context.Context, action domain.BulkOperationAction, device *domain.Device) domain.BulkOperationDeviceResult {
+	name := lo.FromPtr(device.Metadata.Name)
+	var status domain.Status
+
+	switch action.Type {
+	case domain.BulkOperationActionPatchLabels:
+		labels, changed := patchLabels(lo.FromPtr(device.Metadata.Labels), action)
+		if !changed {
+			return bulkOperationDeviceResult(name, domain.BulkOperationDeviceSkipped, "labels are already up to date")
+		}
+		_, status = b.serviceHandler.PatchDevice(ctx, b.orgId, name, domain.PatchRequest{
+			{Op: "add", Path: "/metadata/labels", Value: labels},
+		})
+	case domain.BulkOperationActionDecommission:
+		if device.Spec != nil && device.Spec.Decommissioning != nil {
+			return bulkOperationDeviceResult(name, domain.BulkOperationDeviceSkipped, "device is already decommissioning")
+		}
+		_, status = b.serviceHandler.DecommissionDevice(ctx, b.orgId, name, lo.FromPtr(action.Decommission))
+	case domain.BulkOperationActionResume:
+		var response domain.DeviceResumeResponse
+		response, status = b.serviceHandler.ResumeDevices(ctx, b.orgId, domain.DeviceResumeRequest{
+			FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.name=%s", name)),
+		})
+		if status.Code == http.StatusOK && response.ResumedDevices == 0 {
+			return bulkOperationDeviceResult(name, domain.BulkOperationDeviceSkipped, "device is not paused")
+		}
+	case domain.BulkOperationActionRerender:
+		annotations := lo.FromPtr(device.Metadata.Annotations)
+		if annotations[domain.DeviceAnnotationConflictPaused] == "true" {
+			return bulkOperationDeviceResult(name, domain.BulkOperationDeviceSkipped, "device is paused due to conflicts")
+		}
+		if annotations[domain.DeviceAnnotationAwaitingReconnect] == "true" {
+			return bulkOperationDeviceResult(name, domain.BulkOperationDeviceSkipped, "device is awaiting reconnection")
+		}
+		event := domain.Event{InvolvedObject: domain.ObjectReference{Kind: domain.DeviceKind, Name: name}}
+		logic := NewDeviceRenderLogic(b.log, b.serviceHandler, b.k8sClient, b.kvStore, b.orgId, event)
+		if err := logic.ForceRenderDevice(ctx); err != nil {
+			return bulkOperationDeviceResult(name, domain.BulkOperationDeviceFailed, err.Error())
+		}
+		status = domain.StatusOK()
+	default:
+		return bulkOperationDeviceResult(name, domain.BulkOperationDeviceFailed, fmt.Sprintf("unsupported action %s", action.Type))
+	}
+
+	if status.Code != http.StatusOK {
+		return bulkOperationDeviceResult(name, domain.BulkOperationDeviceFailed, status.Message)
+	}
+	return bulkOperationDeviceResult(name, domain.BulkOperationDeviceSucceeded, "")
+}
+
+// recordBatch adds the results of a batch to the operation's status and triggers the next batch.
+func (b *BulkOperationLogic) recordBatch(ctx context.Context, operation *domain.BulkOperation, results []domain.BulkOperationDeviceResult, next *string) error {
+	for attempt := 1; ; attempt++ {
+		running := operation.Status.Phase == domain.BulkOperationPhaseRunning
+		addBulkOperationResults(operation.Status, results)
+		if running {
+			operation.Status.Continue = next
+			if next == nil {
+				operation.Status.Phase = domain.BulkOperationPhaseCompleted
+				operation.Status.Message = lo.ToPtr("All matching devices were processed.")
+				operation.Status.CompletedAt = lo.ToPtr(time.Now().UTC())
+			}
+		}
+
+		updated, status := b.serviceHandler.ReplaceBulkOperationStatus(ctx, b.orgId, b.name, *operation)
+		if status.Code == http.StatusOK {
+			if running {
+				b.emitBatchEvent(ctx, updated)
+			}
+			return nil
+		}
+		if status.Code != http.StatusConflict || attempt >= maxBulkOperationStatusUpdateAttempts {
+			return fmt.Errorf("failed recording results of bulk operation: %s", status.Message)
+		}
+
+		current, status := b.serviceHandler.GetBulkOperation(ctx, b.orgId, b.name)
+		if status.Code == http.StatusNotFound {
+			return nil
+		}
+		if status.Code != http.StatusOK {
+			return fmt.Errorf("failed getting bulk operation: %s", status.Message)
+		}
+		if current.Status.Phase != domain.BulkOperationPhaseCanceled {
+			b.log.Infof("bulk operation %s/%s batch was already recorded", b.orgId, b.name)
+			return nil
+		}
+		operation = current
+	}
+}
+
+func (b *BulkOperationLogic) emitBatchEvent(ctx context.Context, operation *domain.BulkOperation) {
+	status := operation.Status
+	if status.Phase == domain.BulkOperationPhaseCompleted {
+		b.serviceHandler.CreateEvent(ctx, b.orgId, common.GetBulkOperationCompletedEvent(ctx, b.name, status.SucceededDevices, status.FailedDevices, status.SkippedDevices))
+		return
+	}
+	b.serviceHandler.CreateEvent(ctx, b.orgId, common.GetBulkOperationProgressedEvent(ctx, b.name, status.ProcessedDevices, lo.FromPtr(status.TotalDevices)))
+}
+
+// fail marks the operation as failed, for example because its selectors are invalid.
+func (b *BulkOperationLogic) fail(ctx context.Context, operation *domain.BulkOperation, message string) error {
+	operation.Status.Phase = domain.BulkOperationPhaseFailed
+	operation.Status.Message = lo.ToPtr(message)
+	operation.Status.CompletedAt = lo.ToPtr(time.Now().UTC())
+	operation.Status.Continue = nil
+	_, status := b.serviceHandler.ReplaceBulkOperationStatus(ctx, b.orgId, b.name, *operation)
+	if status.Code == http.StatusConflict || status.Code == http.StatusNotFound {
+		return nil
+	}
+	if status.Code != http.StatusOK {
+		return fmt.Errorf("failed marking bulk operation as failed: %s", status.Message)
+	}
+	b.serviceHandler.CreateEvent(ctx, b.orgId, common.GetBulkOperationFailedEvent(ctx, b.name, message))
+	return nil
+}
+
+// patchLabels returns the labels resulting from the action, and whether they differ from the current ones.
+func patchLabels(current map[string]string, action domain.BulkOperationAction) (map[string]string, bool) {
+	labels := maps.Clone(current)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, lo.FromPtr(action.Labels))
+	for _, key := range lo.FromPtr(action.RemoveLabels) {
+		delete(labels, key)
+	}
+	return labels, !maps.Equal(labels, current)
+}
+
+func addBulkOperationResults(status *domain.BulkOperationStatus, results []domain.BulkOperationDeviceResult) {
+	for _, result := range results {
+		switch result.Result {
+		case domain.BulkOperationDeviceSucceeded:
+			status.SucceededDevices++
+		case domain.BulkOperationDeviceFailed:
+			status.FailedDevices++
+		case domain.BulkOperationDeviceSkipped:
+			status.SkippedDevices++
+		}
+	}
+	status.ProcessedDevices += int64(len(results))
+
+	kept := lo.FromPtr(status.Results)
+	for _, result := range results {
+		if len(kept) >= maxBulkOperationResults {
+			break
+		}
+		if result.Result != domain.BulkOperationDeviceSucceeded {
+			kept = append(kept, result)
+		}
+	}
+	if len(kept) > 0 {
+		status.Results = &kept
+	}
+}
+
+func bulkOperationDeviceResult(device string, result domain.BulkOperationDeviceResultType, message string) domain.BulkOperationDeviceResult {
+	return domain.BulkOperationDeviceResult{
+		Device:  device,
+		Result:  result,
+		Message: lo.EmptyableToPtr(message),
+	}
+}
@@ -0,0 +1,245 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func newTestBulkOperation(phase domain.BulkOperationPhase, action domain.BulkOperationAction) *domain.BulkOperation {
+	return &domain.BulkOperation{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("op"), ResourceVersion: lo.ToPtr("1")},
+		Spec: domain.BulkOperationSpec{
+			LabelSelector: lo.ToPtr("env=prod"),
+			Action:        action,
+		},
+		Status: &domain.BulkOperationStatus{Phase: phase},
+	}
+}
+
+func newTestDeviceList(cont *string, devices ...domain.Device) *domain.DeviceList {
+	return &domain.DeviceList{Items: devices, Metadata: domain.ListMeta{Continue: cont}}
+}
+
+func newTestDevice(name string, labels map[string]string) domain.Device {
+	return domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr(name), Labels: &labels}}
+}
+
+func TestPatchLabels(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     map[string]string
+		action      domain.BulkOperationAction
+		want        map[string]string
+		wantChanged bool
+	}{
+		{
+			name:        "adds and removes labels",
+			current:     map[string]string{"env": "prod", "old": "x"},
+			action:      domain.BulkOperationAction{Labels: &map[string]string{"site": "a"}, RemoveLabels: &[]string{"old"}},
+			want:        map[string]string{"env": "prod", "site": "a"},
+			wantChanged: true,
+		},
+		{
+			name:        "overwrites label",
+			current:     map[string]string{"site": "b"},
+			action:      domain.BulkOperationAction{Labels: &map[string]string{"site": "a"}},
+			want:        map[string]string{"site": "a"},
+			wantChanged: true,
+		},
+		{
+			name:    "already up to date",
+			current: map[string]string{"site": "a"},
+			action:  domain.BulkOperationAction{Labels: &map[string]string{"site": "a"}, RemoveLabels: &[]string{"missing"}},
+			want:    map[string]string{"site": "a"},
+		},
+		{
+			name:    "removing from no labels",
+			current: nil,
+			action:  domain.BulkOperationAction{RemoveLabels: &[]string{"site"}},
+			want:    map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels, changed := patchLabels(tt.current, tt.action)
+			require.Equal(t, tt.want, labels)
+			require.Equal(t, tt.wantChanged, changed)
+		})
+	}
+}
+
+func TestAddBulkOperationResults(t *testing.T) {
+	require := require.New(t)
+	status := &domain.BulkOperationStatus{Phase: domain.BulkOperationPhaseRunning}
+
+	addBulkOperationResults(status, []domain.BulkOperationDeviceResult{
+		bulkOperationDeviceResult("dev1", domain.BulkOperationDeviceSucceeded, ""),
+		bulkOperationDeviceResult("dev2", domain.BulkOperationDeviceFailed, "invalid"),
+		bulkOperationDeviceResult("dev3", domain.BulkOperationDeviceSkipped, "up to date"),
+	})
+	require.Equal(int64(3), status.ProcessedDevices)
+	require.Equal([]string{"dev2", "dev3"}, lo.Map(lo.FromPtr(status.Results), func(r domain.BulkOperationDeviceResult, _ int) string { return r.Device }))
+
+	failed := make([]domain.BulkOperationDeviceResult, maxBulkOperationResults)
+	for i := range failed {
+		failed[i] = bulkOperationDeviceResult(fmt.Sprintf("failed-%d", i), domain.BulkOperationDeviceFailed, "invalid")
+	}
+	addBulkOperationResults(status, failed)
+	require.Equal(int64(3+maxBulkOperationResults), status.ProcessedDevices)
+	require.Equal(int64(1+maxBulkOperationResults), status.FailedDevices)
+	require.Len(lo.FromPtr(status.Results), maxBulkOperationResults)
+	require.Equal("dev2", lo.FromPtr(status.Results)[0].Device)
+}
+
+func TestBulkOperationLogic_ProcessBatch(t *testing.T) {
+	action := domain.BulkOperationAction{
+		Type:   domain.BulkOperationActionPatchLabels,
+		Labels: &map[string]string{"site": "a"},
+	}
+
+	t.Run("starts and completes the operation", func(t *testing.T) {
+		require := require.New(t)
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		logic := NewBulkOperationLogic(logrus.New(), mockService, nil, nil, uuid.New(), "op")
+
+		mockService.EXPECT().GetBulkOperation(gomock.Any(), gomock.Any(), "op").Return(newTestBulkOperation(domain.BulkOperationPhasePending, action), domain.StatusOK())
+		mockService.EXPECT().CountDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(2), domain.StatusOK())
+		mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(newTestDeviceList(nil,
+			newTestDevice("dev1", map[string]string{"env": "prod"}),
+			newTestDevice("dev2", map[string]string{"env": "prod", "site": "a"}),
+		), domain.StatusOK())
+		mockService.EXPECT().PatchDevice(gomock.Any(), gomock.Any(), "dev1", gomock.Any()).Return(nil, domain.StatusOK())
+
+		var statuses []domain.BulkOperationStatus
+		mockService.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), gomock.Any(), "op", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, orgId uuid.UUID, name string, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+				statuses = append(statuses, *operation.Status)
+				return &operation, domain.StatusOK()
+			}).Times(2)
+		var reasons []domain.EventReason
+		mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(ctx context.Context, orgId uuid.UUID, event *domain.Event) {
+			reasons = append(reasons, event.Reason)
+		})
+
+		require.NoError(logic.ProcessBatch(context.Background()))
+		require.Len(statuses, 2)
+		require.Equal(domain.BulkOperationPhaseRunning, statuses[0].Phase)
+		require.Equal(int64(2), lo.FromPtr(statuses[0].TotalDevices))
+
+		final := statuses[1]
+		require.Equal(domain.BulkOperationPhaseCompleted, final.Phase)
+		require.Equal(int64(2), final.ProcessedDevices)
+		require.Equal(int64(1), final.SucceededDevices)
+		require.Equal(int64(1), final.SkippedDevices)
+		require.Nil(final.Continue)
+		require.NotNil(final.CompletedAt)
+		require.Equal([]domain.EventReason{domain.EventReasonBulkOperationCompleted}, reasons)
+	})
+
+	t.Run("continues with the next batch", func(t *testing.T) {
+		require := require.New(t)
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		logic := NewBulkOperationLogic(logrus.New(), mockService, nil, nil, uuid.New(), "op")
+
+		mockService.EXPECT().GetBulkOperation(gomock.Any(), gomock.Any(), "op").Return(newTestBulkOperation(domain.BulkOperationPhaseRunning, action), domain.StatusOK())
+		mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(newTestDeviceList(lo.ToPtr("next"),
+			newTestDevice("dev1", map[string]string{"env": "prod"}),
+		), domain.StatusOK())
+		mockService.EXPECT().PatchDevice(gomock.Any(), gomock.Any(), "dev1", gomock.Any()).Return(nil, domain.StatusBadRequest("invalid"))
+
+		var recorded domain.BulkOperationStatus
+		mockService.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), gomock.Any(), "op", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, orgId uuid.UUID, name string, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+				recorded = *operation.Status
+				return &operation, domain.StatusOK()
+			})
+		mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(ctx context.Context, orgId uuid.UUID, event *domain.Event) {
+			require.Equal(domain.EventReasonBulkOperationProgressed, event.Reason)
+		})
+
+		require.NoError(logic.ProcessBatch(context.Background()))
+		require.Equal(domain.BulkOperationPhaseRunning, recorded.Phase)
+		require.Equal("next", lo.FromPtr(recorded.Continue))
+		require.Equal(int64(1), recorded.FailedDevices)
+		require.Equal("invalid", lo.FromPtr(lo.FromPtr(recorded.Results)[0].Message))
+	})
+
+	t.Run("skips devices outside the scope of the creator", func(t *testing.T) {
+		require := require.New(t)
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		logic := NewBulkOperationLogic(logrus.New(), mockService, nil, nil, uuid.New(), "op")
+
+		operation := newTestBulkOperation(domain.BulkOperationPhaseRunning, action)
+		operation.Metadata.Annotations = &map[string]string{domain.BulkOperationAnnotationScopeLabelSelectors: `["site=berlin","site=munich"]`}
+		mockService.EXPECT().GetBulkOperation(gomock.Any(), gomock.Any(), "op").Return(operation, domain.StatusOK())
+		mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(newTestDeviceList(nil,
+			newTestDevice("berlin-1", map[string]string{"env": "prod", "site": "berlin"}),
+			newTestDevice("paris-1", map[string]string{"env": "prod", "site": "paris"}),
+		), domain.StatusOK())
+		mockService.EXPECT().PatchDevice(gomock.Any(), gomock.Any(), "berlin-1", gomock.Any()).Return(nil, domain.StatusOK())
+
+		var recorded domain.BulkOperationStatus
+		mockService.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), gomock.Any(), "op", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, orgId uuid.UUID, name string, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+				recorded = *operation.Status
+				return &operation, domain.StatusOK()
+			})
+		mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any(), gomock.Any())
+
+		require.NoError(logic.ProcessBatch(context.Background()))
+		require.Equal(int64(1), recorded.SucceededDevices)
+		require.Equal(int64(1), recorded.SkippedDevices)
+		require.Equal("paris-1", lo.FromPtr(recorded.Results)[0].Device)
+	})
+
+	t.Run("keeps results of a canceled operation", func(t *testing.T) {
+		require := require.New(t)
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		logic := NewBulkOperationLogic(logrus.New(), mockService, nil, nil, uuid.New(), "op")
+
+		gomock.InOrder(
+			mockService.EXPECT().GetBulkOperation(gomock.Any(), gomock.Any(), "op").Return(newTestBulkOperation(domain.BulkOperationPhaseRunning, action), domain.StatusOK()),
+			mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(newTestDeviceList(lo.ToPtr("next"),
+				newTestDevice("dev1", map[string]string{"env": "prod"}),
+			), domain.StatusOK()),
+			mockService.EXPECT().PatchDevice(gomock.Any(), gomock.Any(), "dev1", gomock.Any()).Return(nil, domain.StatusOK()),
+			mockService.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), gomock.Any(), "op", gomock.Any()).Return(nil, domain.StatusResourceVersionConflict("conflict")),
+			mockService.EXPECT().GetBulkOperation(gomock.Any(), gomock.Any(), "op").Return(newTestBulkOperation(domain.BulkOperationPhaseCanceled, action), domain.StatusOK()),
+			mockService.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), gomock.Any(), "op", gomock.Any()).DoAndReturn(
+				func(ctx context.Context, orgId uuid.UUID, name string, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+					require.Equal(domain.BulkOperationPhaseCanceled, operation.Status.Phase)
+					require.Equal(int64(1), operation.Status.SucceededDevices)
+					require.Nil(operation.Status.Continue)
+					return &operation, domain.StatusOK()
+				}),
+		)
+
+		require.NoError(logic.ProcessBatch(context.Background()))
+	})
+
+	t.Run("stops when the operation is done", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		logic := NewBulkOperationLogic(logrus.New(), mockService, nil, nil, uuid.New(), "op")
+
+		mockService.EXPECT().GetBulkOperation(gomock.Any(), gomock.Any(), "op").Return(newTestBulkOperation(domain.BulkOperationPhaseCanceled, action), domain.StatusOK())
+		require.NoError(t, logic.ProcessBatch(context.Background()))
+
+		mockService.EXPECT().GetBulkOperation(gomock.Any(), gomock.Any(), "op").Return(nil, domain.Status{Code: http.StatusNotFound})
+		require.NoError(t, logic.ProcessBatch(context.Background()))
+	})
+}
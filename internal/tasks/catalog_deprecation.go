@@ -0,0 +1,250 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const catalogItemDeprecatedReason = "CatalogItemDeprecated"
+
+// CatalogDeprecation flags the devices that run a deprecated CatalogItem or CatalogItemVersion, installed on
+// their fleet or on the device itself, with the CatalogItemDeprecated condition.
+type CatalogDeprecation struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+}
+
+func NewCatalogDeprecation(serviceHandler service.Service, log logrus.FieldLogger) *CatalogDeprecation {
+	return &CatalogDeprecation{
+		log:            log,
+		serviceHandler: serviceHandler,
+	}
+}
+
+// catalogDeprecationRun holds the state of a single poll: the CatalogItems that were looked up and the
+// deprecation messages of the devices that run deprecated items. Devices are only cleared if all fleets
+// and devices could be checked.
+type catalogDeprecationRun struct {
+	orgId      uuid.UUID
+	items      map[string]*domain.CatalogItem
+	deprecated map[string]string
+	incomplete bool
+}
+
+func (c *CatalogDeprecation) Poll(ctx context.Context, orgId uuid.UUID) {
+	log := log.WithReqIDFromCtx(ctx, c.log)
+
+	log.Info("Running CatalogDeprecation Polling")
+
+	run := &catalogDeprecationRun{
+		orgId:      orgId,
+		items:      make(map[string]*domain.CatalogItem),
+		deprecated: make(map[string]string),
+	}
+	if err := c.flagFleetDevices(ctx, log, run); err != nil {
+		log.Errorf("error flagging devices of fleets with deprecated catalog items: %v", err)
+		return
+	}
+	if err := c.flagDevices(ctx, log, run); err != nil {
+		log.Errorf("error flagging devices with deprecated catalog items: %v", err)
+		return
+	}
+	if run.incomplete {
+		return
+	}
+	if err := c.clearDevices(ctx, log, run); err != nil {
+		log.Errorf("error clearing the catalog item deprecation of devices: %v", err)
+	}
+}
+
+// flagFleetDevices flags the devices of fleets that have deprecated CatalogItems installed.
+func (c *CatalogDeprecation) flagFleetDevices(ctx context.Context, log logrus.FieldLogger, run *catalogDeprecationRun) error {
+	params := domain.ListFleetsParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		fleets, status := c.serviceHandler.ListFleets(ctx, run.orgId, params)
+		if err := service.ApiStatusToErr(status); err != nil {
+			return fmt.Errorf("failed to list fleets: %w", err)
+		}
+		for _, fleet := range fleets.Items {
+			fleetName := lo.FromPtr(fleet.Metadata.Name)
+			message, err := c.deprecationMessage(ctx, run, fleet.Metadata)
+			if err != nil {
+				log.Errorf("fleet/%s: %v", fleetName, err)
+				run.incomplete = true
+				continue
+			}
+			if message == "" {
+				continue
+			}
+			if err := c.flagOwnedDevices(ctx, log, run, fleetName, message); err != nil {
+				log.Errorf("fleet/%s: %v", fleetName, err)
+				run.incomplete = true
+			}
+		}
+		if fleets.Metadata.Continue == nil {
+			return nil
+		}
+		params.Continue = fleets.Metadata.Continue
+	}
+}
+
+func (c *CatalogDeprecation) flagOwnedDevices(ctx context.Context, log logrus.FieldLogger, run *catalogDeprecationRun, fleetName string, message string) error {
+	params := domain.ListDevicesParams{
+		Limit:         lo.ToPtr(int32(ItemsPerPage)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", util.ResourceOwner(domain.FleetKind, fleetName))),
+	}
+	for {
+		devices, status := c.serviceHandler.ListDevices(ctx, run.orgId, params, nil)
+		if err := service.ApiStatusToErr(status); err != nil {
+			return fmt.Errorf("failed to list devices: %w", err)
+		}
+		for i := range devices.Items {
+			c.flagDevice(ctx, log, run, &devices.Items[i], message)
+		}
+		if devices.Metadata.Continue == nil {
+			return nil
+		}
+		params.Continue = devices.Metadata.Continue
+	}
+}
+
+// flagDevices flags the devices that are not owned by a fleet and have deprecated CatalogItems installed.
+func (c *CatalogDeprecation) flagDevices(ctx context.Context, log logrus.FieldLogger, run *catalogDeprecationRun) error {
+	annotationSelector := selector.NewAnnotationSelectorOrDie(domain.MatchExpression{
+		Key:      domain.CatalogAnnotationInstalledItems,
+		Operator: domain.Exists,
+	}.String())
+	params := domain.ListDevicesParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		devices, status := c.serviceHandler.ListDevices(ctx, run.orgId, params, annotationSelector)
+		if err := service.ApiStatusToErr(status); err != nil {
+			return fmt.Errorf("failed to list devices: %w", err)
+		}
+		for i := range devices.Items {
+			device := &devices.Items[i]
+			if lo.FromPtr(device.Metadata.Owner) != "" {
+				continue
+			}
+			message, err := c.deprecationMessage(ctx, run, device.Metadata)
+			if err != nil {
+				log.Errorf("device/%s: %v", lo.FromPtr(device.Metadata.Name), err)
+				run.incomplete = true
+				continue
+			}
+			if message != "" {
+				c.flagDevice(ctx, log, run, device, message)
+			}
+		}
+		if devices.Metadata.Continue == nil {
+			return nil
+		}
+		params.Continue = devices.Metadata.Continue
+	}
+}
+
+// clearDevices clears the condition of flagged devices that no longer run deprecated CatalogItems.
+func (c *CatalogDeprecation) clearDevices(ctx context.Context, log logrus.FieldLogger, run *catalogDeprecationRun) error {
+	listParams := store.ListParams{Limit: ItemsPerPage}
+	for {
+		devices, status := c.serviceHandler.ListDevicesByServiceCondition(ctx, run.orgId, string(domain.ConditionTypeDeviceCatalogItemDeprecated), string(domain.ConditionStatusTrue), listParams)
+		if err := service.ApiStatusToErr(status); err != nil {
+			return fmt.Errorf("failed to list devices with deprecated catalog items: %w", err)
+		}
+		for i := range devices.Items {
+			device := &devices.Items[i]
+			if _, flagged := run.deprecated[lo.FromPtr(device.Metadata.Name)]; flagged {
+				continue
+			}
+			c.setCondition(ctx, log, run, device, domain.Condition{
+				Type:   domain.ConditionTypeDeviceCatalogItemDeprecated,
+				Status: domain.ConditionStatusFalse,
+			})
+		}
+		if devices.Metadata.Continue == nil {
+			return nil
+		}
+		nextContinue, err := store.ParseContinueString(devices.Metadata.Continue)
+		if err != nil {
+			return fmt.Errorf("failed to parse continue token: %w", err)
+		}
+		listParams.Continue = nextContinue
+	}
+}
+
+func (c *CatalogDeprecation) flagDevice(ctx context.Context, log logrus.FieldLogger, run *catalogDeprecationRun, device *domain.Device, message string) {
+	run.deprecated[lo.FromPtr(device.Metadata.Name)] = message
+	c.setCondition(ctx, log, run, device, domain.Condition{
+		Type:    domain.ConditionTypeDeviceCatalogItemDeprecated,
+		Status:  domain.ConditionStatusTrue,
+		Reason:  catalogItemDeprecatedReason,
+		Message: message,
+	})
+}
+
+// setCondition sets the condition of the device if it changed.
+func (c *CatalogDeprecation) setCondition(ctx context.Context, log logrus.FieldLogger, run *catalogDeprecationRun, device *domain.Device, condition domain.Condition) {
+	if device.Status != nil {
+		current := domain.FindStatusCondition(device.Status.Conditions, condition.Type)
+		if current != nil && current.Status == condition.Status && current.Message == condition.Message {
+			return
+		}
+	}
+	name := lo.FromPtr(device.Metadata.Name)
+	status := c.serviceHandler.SetDeviceServiceConditions(ctx, run.orgId, name, []domain.Condition{condition})
+	if status.Code != http.StatusOK {
+		log.Errorf("device/%s: failed to set %s condition: %s", name, condition.Type, status.Message)
+	}
+}
+
+// deprecationMessage returns a message listing the deprecated CatalogItems installed on a fleet or device, or
+// an empty string if none of them is deprecated.
+func (c *CatalogDeprecation) deprecationMessage(ctx context.Context, run *catalogDeprecationRun, metadata domain.ObjectMeta) (string, error) {
+	installations, err := service.GetCatalogItemInstallations(metadata)
+	if err != nil {
+		return "", err
+	}
+	messages := []string{}
+	for _, installation := range installations {
+		item, err := c.getCatalogItem(ctx, run, installation.Catalog, installation.Item)
+		if err != nil {
+			return "", err
+		}
+		if item == nil {
+			continue
+		}
+		if deprecation := service.CatalogItemInstallationDeprecation(item, installation.Version); deprecation != nil {
+			messages = append(messages, fmt.Sprintf("%s/%s %s is deprecated: %s", installation.Catalog, installation.Item, installation.Version, deprecation.Message))
+		}
+	}
+	return strings.Join(messages, "; "), nil
+}
+
+// getCatalogItem returns the CatalogItem, or nil if it does not exist.
+func (c *CatalogDeprecation) getCatalogItem(ctx context.Context, run *catalogDeprecationRun, catalogName string, itemName string) (*domain.CatalogItem, error) {
+	key := catalogName + "/" + itemName
+	if item, exists := run.items[key]; exists {
+		return item, nil
+	}
+	item, status := c.serviceHandler.GetCatalogItem(ctx, run.orgId, catalogName, itemName)
+	switch status.Code {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		item = nil
+	default:
+		return nil, fmt.Errorf("failed to get catalog item %s: %w", key, service.ApiStatusToErr(status))
+	}
+	run.items[key] = item
+	return item, nil
+}
@@ -0,0 +1,104 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func newTestInstalledMeta(t *testing.T, name string, owner *string, installations ...domain.CatalogItemInstallation) domain.ObjectMeta {
+	meta := domain.ObjectMeta{Name: lo.ToPtr(name), Owner: owner}
+	if len(installations) > 0 {
+		annotation, err := json.Marshal(installations)
+		require.NoError(t, err)
+		meta.Annotations = &map[string]string{domain.CatalogAnnotationInstalledItems: string(annotation)}
+	}
+	return meta
+}
+
+func TestCatalogDeprecationPoll(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := service.NewMockService(ctrl)
+	catalogDeprecation := NewCatalogDeprecation(mockService, logrus.New())
+
+	nginx := domain.CatalogItemInstallation{Catalog: "edge-apps", Item: "nginx", Version: "1.0.0", ApplicationName: "nginx"}
+	redis := domain.CatalogItemInstallation{Catalog: "edge-apps", Item: "redis", Version: "7.0.0", ApplicationName: "redis"}
+	nginxItem := newTestCatalogItem("nginx", &domain.CatalogItemDeprecation{Message: "use caddy"})
+	redisItem := newTestCatalogItem("redis", nil)
+	fleetOwner := lo.ToPtr("Fleet/edge")
+
+	mockService.EXPECT().ListFleets(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.FleetList{
+		Items: []domain.Fleet{
+			{Metadata: newTestInstalledMeta(t, "edge", nil, nginx)},
+			{Metadata: newTestInstalledMeta(t, "core", nil)},
+		},
+	}, domain.StatusOK())
+	mockService.EXPECT().GetCatalogItem(gomock.Any(), gomock.Any(), "edge-apps", "nginx").Return(&nginxItem, domain.StatusOK())
+	mockService.EXPECT().GetCatalogItem(gomock.Any(), gomock.Any(), "edge-apps", "redis").Return(&redisItem, domain.StatusOK())
+	mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, params domain.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*domain.DeviceList, domain.Status) {
+			if params.FieldSelector != nil {
+				require.Equal("metadata.owner=Fleet/edge", *params.FieldSelector)
+				return &domain.DeviceList{Items: []domain.Device{
+					{Metadata: newTestInstalledMeta(t, "fleet-device", fleetOwner)},
+				}}, domain.StatusOK()
+			}
+			require.NotNil(annotationSelector)
+			return &domain.DeviceList{Items: []domain.Device{
+				{Metadata: newTestInstalledMeta(t, "owned-device", fleetOwner, nginx)},
+				{Metadata: newTestInstalledMeta(t, "nginx-device", nil, nginx, redis)},
+				{Metadata: newTestInstalledMeta(t, "redis-device", nil, redis)},
+			}}, domain.StatusOK()
+		}).Times(2)
+	mockService.EXPECT().ListDevicesByServiceCondition(gomock.Any(), gomock.Any(), string(domain.ConditionTypeDeviceCatalogItemDeprecated), string(domain.ConditionStatusTrue), gomock.Any()).Return(&domain.DeviceList{
+		Items: []domain.Device{
+			{Metadata: newTestInstalledMeta(t, "fleet-device", fleetOwner)},
+			{Metadata: newTestInstalledMeta(t, "redis-device", nil, redis)},
+		},
+	}, domain.StatusOK())
+
+	conditions := map[string]domain.Condition{}
+	mockService.EXPECT().SetDeviceServiceConditions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, name string, c []domain.Condition) domain.Status {
+			require.Len(c, 1)
+			conditions[name] = c[0]
+			return domain.StatusOK()
+		}).Times(3)
+
+	catalogDeprecation.Poll(context.Background(), uuid.New())
+
+	require.Len(conditions, 3)
+	require.Equal(domain.ConditionStatusTrue, conditions["fleet-device"].Status)
+	require.Equal("edge-apps/nginx 1.0.0 is deprecated: use caddy", conditions["fleet-device"].Message)
+	require.Equal(domain.ConditionStatusTrue, conditions["nginx-device"].Status)
+	require.Equal(catalogItemDeprecatedReason, conditions["nginx-device"].Reason)
+	require.Equal(domain.ConditionStatusFalse, conditions["redis-device"].Status)
+}
+
+func TestCatalogDeprecationPollSkipsClearingOnErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := service.NewMockService(ctrl)
+	catalogDeprecation := NewCatalogDeprecation(mockService, logrus.New())
+
+	nginx := domain.CatalogItemInstallation{Catalog: "edge-apps", Item: "nginx", Version: "1.0.0", ApplicationName: "nginx"}
+	mockService.EXPECT().ListFleets(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.FleetList{
+		Items: []domain.Fleet{{Metadata: newTestInstalledMeta(t, "edge", nil, nginx)}},
+	}, domain.StatusOK())
+	mockService.EXPECT().GetCatalogItem(gomock.Any(), gomock.Any(), "edge-apps", "nginx").Return(nil, domain.StatusInternalServerError("db down"))
+	mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.DeviceList{}, domain.StatusOK())
+
+	// ListDevicesByServiceCondition and SetDeviceServiceConditions must not be called
+	catalogDeprecation.Poll(context.Background(), uuid.New())
+}
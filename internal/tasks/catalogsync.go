@@ -0,0 +1,303 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/go-git/go-billy/v5"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const catalogItemRemovedFromSourceMessage = "This item was removed from the catalog source."
+
+type fetchOciArtifactFilesFunc func(ctx context.Context, ociSpec *domain.OciRepoSpec, reference string) (string, map[string][]byte, error)
+
+// CatalogSync imports, updates and deprecates the CatalogItems of catalogs that have a source
+// Repository, similar to how ResourceSync manages fleets.
+type CatalogSync struct {
+	log                   logrus.FieldLogger
+	serviceHandler        service.Service
+	cloneGitRepo          cloneGitRepoFunc
+	fetchOciArtifactFiles fetchOciArtifactFilesFunc
+}
+
+func NewCatalogSync(serviceHandler service.Service, log logrus.FieldLogger) *CatalogSync {
+	return &CatalogSync{
+		log:                   log,
+		serviceHandler:        serviceHandler,
+		cloneGitRepo:          CloneGitRepo,
+		fetchOciArtifactFiles: fetchOciArtifactFiles,
+	}
+}
+
+func (c *CatalogSync) Poll(ctx context.Context, orgId uuid.UUID) {
+	log := log.WithReqIDFromCtx(ctx, c.log)
+
+	log.Info("Running CatalogSync Polling")
+
+	limit := int32(ItemsPerPage)
+	continueToken := (*string)(nil)
+
+	for {
+		catalogs, status := c.serviceHandler.ListCatalogs(ctx, orgId, domain.ListCatalogsParams{
+			Limit:    &limit,
+			Continue: continueToken,
+		})
+		if status.Code != http.StatusOK {
+			log.Errorf("error fetching catalogs: %s", status.Message)
+			return
+		}
+
+		for i := range catalogs.Items {
+			catalog := &catalogs.Items[i]
+			if catalog.Spec.Source == nil {
+				continue
+			}
+			if err := c.run(ctx, log, orgId, catalog); err != nil {
+				log.Errorf("catalog/%s: error during sync: %v", lo.FromPtr(catalog.Metadata.Name), err)
+			}
+		}
+
+		continueToken = catalogs.Metadata.Continue
+		if continueToken == nil {
+			break
+		}
+	}
+}
+
+func (c *CatalogSync) run(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, catalog *domain.Catalog) error {
+	catalogName := lo.FromPtr(catalog.Metadata.Name)
+	if catalog.Status == nil {
+		catalog.Status = &domain.CatalogStatus{}
+	}
+	if catalog.Status.Conditions == nil {
+		catalog.Status.Conditions = []domain.Condition{}
+	}
+	defer c.updateCatalogStatus(ctx, orgId, catalog)
+
+	revision, files, err := c.fetchSource(ctx, orgId, catalog.Spec.Source)
+	domain.SetStatusConditionByError(&catalog.Status.Conditions, domain.ConditionTypeCatalogAccessible, "Accessible", "Inaccessible", err)
+	if err != nil {
+		return err
+	}
+
+	if !catalogNeedsSync(catalog, revision) {
+		log.Debugf("catalog/%s: no new revision of the source. skipping", catalogName)
+		return nil
+	}
+
+	items, err := parseCatalogItems(catalogName, files)
+	if err == nil {
+		err = c.syncItems(ctx, orgId, catalogName, items)
+	}
+	domain.SetStatusConditionByError(&catalog.Status.Conditions, domain.ConditionTypeCatalogSynced, "Synced", "SyncFailed", err)
+	if err != nil {
+		return err
+	}
+
+	catalog.Status.ObservedRevision = lo.ToPtr(revision)
+	catalog.Status.ObservedGeneration = catalog.Metadata.Generation
+	log.Infof("catalog/%s: %d items synchronized from revision %s", catalogName, len(items), revision)
+	return nil
+}
+
+// fetchSource returns the revision of the catalog source and the contents of its
+// CatalogItem definition files by file name.
+func (c *CatalogSync) fetchSource(ctx context.Context, orgId uuid.UUID, source *domain.CatalogSource) (string, map[string][]byte, error) {
+	repo, status := c.serviceHandler.GetRepository(ctx, orgId, source.Repository)
+	if err := service.ApiStatusToErr(status); err != nil {
+		return "", nil, fmt.Errorf("failed to get repository %s: %w", source.Repository, err)
+	}
+
+	repoType, err := repo.Spec.Discriminator()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to determine type of repository %s: %w", source.Repository, err)
+	}
+	switch repoType {
+	case string(domain.GitRepoSpecTypeGit):
+		mfs, hash, err := c.cloneGitRepo(repo, source.TargetRevision, lo.ToPtr(1))
+		if err != nil {
+			return "", nil, err
+		}
+		files, err := readCatalogSourceFiles(mfs, lo.FromPtrOr(source.Path, "/"))
+		if err != nil {
+			return "", nil, err
+		}
+		return hash, files, nil
+	case string(domain.OciRepoSpecTypeOci):
+		if source.Reference == nil {
+			return "", nil, fmt.Errorf("spec.source.reference is required for OCI repository %s", source.Repository)
+		}
+		ociSpec, err := repo.Spec.AsOciRepoSpec()
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parse OCI repository %s: %w", source.Repository, err)
+		}
+		return c.fetchOciArtifactFiles(ctx, &ociSpec, *source.Reference)
+	default:
+		return "", nil, fmt.Errorf("repository %s of type %q is not supported as a catalog source", source.Repository, repoType)
+	}
+}
+
+// readCatalogSourceFiles reads a single file, or the files directly inside a directory, of a git repository.
+func readCatalogSourceFiles(mfs billy.Filesystem, path string) (map[string][]byte, error) {
+	fileInfo, err := mfs.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("path %s not found in repository: %w", path, err)
+	}
+
+	paths := []string{path}
+	if fileInfo.IsDir() {
+		entries, err := mfs.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		paths = []string{}
+		for _, entry := range entries {
+			if !entry.IsDir() && isValidFile(entry.Name()) {
+				paths = append(paths, mfs.Join(path, entry.Name()))
+			}
+		}
+	}
+
+	files := make(map[string][]byte, len(paths))
+	for _, p := range paths {
+		file, err := mfs.Open(p)
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", p, err)
+		}
+		files[p] = data
+	}
+	return files, nil
+}
+
+// catalogNeedsSync returns true if the catalog needs to be synced to the given revision of its source.
+func catalogNeedsSync(catalog *domain.Catalog, revision string) bool {
+	if !domain.IsStatusConditionTrue(catalog.Status.Conditions, domain.ConditionTypeCatalogSynced) {
+		return true
+	}
+	return revision != lo.FromPtr(catalog.Status.ObservedRevision) ||
+		lo.FromPtr(catalog.Status.ObservedGeneration) != lo.FromPtr(catalog.Metadata.Generation)
+}
+
+// parseCatalogItems decodes and validates the CatalogItems defined in the given files.
+// Files that are not YAML or JSON are ignored.
+func parseCatalogItems(catalogName string, files map[string][]byte) ([]domain.CatalogItem, error) {
+	items := []domain.CatalogItem{}
+	names := make(map[string]struct{})
+
+	fileNames := lo.Keys(files)
+	slices.Sort(fileNames)
+	for _, fileName := range fileNames {
+		if !isValidFile(fileName) {
+			continue
+		}
+		decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(files[fileName]), 100)
+		for {
+			var item domain.CatalogItem
+			err := decoder.Decode(&item)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("decoding %s: %w", fileName, err)
+			}
+			if item.Kind != domain.CatalogItemKind {
+				return nil, fmt.Errorf("invalid resource at '%s'. unsupported kind '%s'", fileName, item.Kind)
+			}
+			if item.Metadata.Name == nil {
+				return nil, fmt.Errorf("invalid resource at '%s'. resource name missing", fileName)
+			}
+			item.Metadata.Catalog = catalogName
+			if errs := item.Validate(); len(errs) > 0 {
+				return nil, fmt.Errorf("failed validating catalog item %s: %w", *item.Metadata.Name, errors.Join(errs...))
+			}
+			if _, exists := names[*item.Metadata.Name]; exists {
+				return nil, fmt.Errorf("found multiple catalog item definitions with name '%s'", *item.Metadata.Name)
+			}
+			names[*item.Metadata.Name] = struct{}{}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// syncItems creates or replaces the given items and deprecates the items of the catalog
+// that were synced from the source but are no longer part of it. Items created by users
+// are left untouched.
+func (c *CatalogSync) syncItems(ctx context.Context, orgId uuid.UUID, catalogName string, items []domain.CatalogItem) error {
+	existing, err := c.listCatalogItems(ctx, orgId, catalogName)
+	if err != nil {
+		return err
+	}
+
+	owner := util.ResourceOwner(domain.CatalogKind, catalogName)
+	var errs []error
+	for _, item := range items {
+		item.Metadata.Owner = &owner
+		_, status := c.serviceHandler.ReplaceCatalogItem(ctx, orgId, catalogName, *item.Metadata.Name, item)
+		if err := service.ApiStatusToErr(status); err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply catalog item %s: %w", *item.Metadata.Name, err))
+		}
+	}
+
+	for _, item := range catalogItemsDelta(existing, items, owner) {
+		if item.Spec.Deprecation != nil {
+			continue
+		}
+		item.Spec.Deprecation = &domain.CatalogItemDeprecation{Message: catalogItemRemovedFromSourceMessage}
+		_, status := c.serviceHandler.ReplaceCatalogItem(ctx, orgId, catalogName, *item.Metadata.Name, item)
+		if err := service.ApiStatusToErr(status); err != nil {
+			errs = append(errs, fmt.Errorf("failed to deprecate catalog item %s: %w", *item.Metadata.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *CatalogSync) listCatalogItems(ctx context.Context, orgId uuid.UUID, catalogName string) ([]domain.CatalogItem, error) {
+	items := []domain.CatalogItem{}
+	params := domain.ListCatalogItemsParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		list, status := c.serviceHandler.ListCatalogItems(ctx, orgId, catalogName, params)
+		if err := service.ApiStatusToErr(status); err != nil {
+			return nil, fmt.Errorf("failed to list items of catalog %s: %w", catalogName, err)
+		}
+		items = append(items, list.Items...)
+		if list.Metadata.Continue == nil {
+			return items, nil
+		}
+		params.Continue = list.Metadata.Continue
+	}
+}
+
+// catalogItemsDelta returns the existing items owned by owner that are not part of the new items.
+func catalogItemsDelta(existing []domain.CatalogItem, items []domain.CatalogItem, owner string) []domain.CatalogItem {
+	return lo.Filter(existing, func(e domain.CatalogItem, _ int) bool {
+		return lo.FromPtr(e.Metadata.Owner) == owner && !lo.ContainsBy(items, func(i domain.CatalogItem) bool {
+			return lo.FromPtr(i.Metadata.Name) == lo.FromPtr(e.Metadata.Name)
+		})
+	})
+}
+
+func (c *CatalogSync) updateCatalogStatus(ctx context.Context, orgId uuid.UUID, catalog *domain.Catalog) {
+	_, status := c.serviceHandler.ReplaceCatalogStatus(ctx, orgId, *catalog.Metadata.Name, *catalog)
+	if status.Code != http.StatusOK {
+		c.log.Errorf("Failed to update catalog status for %s: %s", *catalog.Metadata.Name, status.Message)
+	}
+}
@@ -0,0 +1,247 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+const testCatalogItemsYaml = `apiVersion: flightctl.io/v1alpha1
+kind: CatalogItem
+metadata:
+  name: nginx
+  catalog: other
+spec:
+  type: container
+  reference:
+    uri: quay.io/example/nginx
+  versions:
+    - version: 1.0.0
+      tag: 1.0.0
+      channels: [stable]
+---
+apiVersion: flightctl.io/v1alpha1
+kind: CatalogItem
+metadata:
+  name: redis
+spec:
+  type: container
+  reference:
+    uri: quay.io/example/redis
+  versions:
+    - version: 7.0.0
+      tag: 7.0.0
+      channels: [stable]
+`
+
+func newTestSourceCatalog(source domain.CatalogSource, status *domain.CatalogStatus) *domain.Catalog {
+	return &domain.Catalog{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("edge-apps"), Generation: lo.ToPtr(int64(1))},
+		Spec:     domain.CatalogSpec{Source: &source},
+		Status:   status,
+	}
+}
+
+func newTestGitRepository(t *testing.T) *domain.Repository {
+	repo := &domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("app-catalog")}}
+	require.NoError(t, repo.Spec.FromGitRepoSpec(domain.GitRepoSpec{Url: "https://example.com/app-catalog.git"}))
+	return repo
+}
+
+func newTestCatalogItem(name string, deprecation *domain.CatalogItemDeprecation) domain.CatalogItem {
+	return domain.CatalogItem{
+		Metadata: domain.CatalogItemMeta{Name: lo.ToPtr(name), Catalog: "edge-apps"},
+		Spec:     domain.CatalogItemSpec{Type: domain.CatalogItemTypeContainer, Deprecation: deprecation},
+	}
+}
+
+func TestParseCatalogItems(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       map[string][]byte
+		expected    []string
+		errContains string
+	}{
+		{
+			name: "items from multiple documents",
+			files: map[string][]byte{
+				"/catalog/items.yaml": []byte(testCatalogItemsYaml),
+				"/catalog/README.md":  []byte("# catalog"),
+			},
+			expected: []string{"nginx", "redis"},
+		},
+		{
+			name: "unsupported kind",
+			files: map[string][]byte{
+				"/fleet.yaml": []byte("apiVersion: flightctl.io/v1beta1\nkind: Fleet\nmetadata:\n  name: fleet\n"),
+			},
+			errContains: "unsupported kind 'Fleet'",
+		},
+		{
+			name: "duplicate item",
+			files: map[string][]byte{
+				"/a.yaml": []byte(testCatalogItemsYaml),
+				"/b.yaml": []byte(testCatalogItemsYaml),
+			},
+			errContains: "multiple catalog item definitions with name 'nginx'",
+		},
+		{
+			name: "invalid item",
+			files: map[string][]byte{
+				"/item.yaml": []byte("apiVersion: flightctl.io/v1alpha1\nkind: CatalogItem\nmetadata:\n  name: nginx\nspec:\n  type: container\n"),
+			},
+			errContains: "failed validating catalog item nginx",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			items, err := parseCatalogItems("edge-apps", tt.files)
+			if tt.errContains != "" {
+				require.ErrorContains(err, tt.errContains)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expected, lo.Map(items, func(item domain.CatalogItem, _ int) string { return *item.Metadata.Name }))
+			for _, item := range items {
+				require.Equal("edge-apps", item.Metadata.Catalog)
+			}
+		})
+	}
+}
+
+func newTestSyncedCatalogItem(name string, deprecation *domain.CatalogItemDeprecation) domain.CatalogItem {
+	item := newTestCatalogItem(name, deprecation)
+	item.Metadata.Owner = util.SetResourceOwner(domain.CatalogKind, "edge-apps")
+	return item
+}
+
+func TestCatalogSync_SyncFromGit(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+
+	catalogSync := NewCatalogSync(mockService, logrus.New())
+	catalogSync.cloneGitRepo = func(repo *domain.Repository, revision *string, depth *int) (billy.Filesystem, string, error) {
+		require.Equal("main", lo.FromPtr(revision))
+		mfs := memfs.New()
+		require.NoError(mfs.MkdirAll("/catalog", 0755))
+		file, err := mfs.Create("/catalog/items.yaml")
+		require.NoError(err)
+		_, err = file.Write([]byte(testCatalogItemsYaml))
+		require.NoError(err)
+		require.NoError(file.Close())
+		return mfs, "abc123", nil
+	}
+
+	catalog := newTestSourceCatalog(domain.CatalogSource{
+		Repository:     "app-catalog",
+		TargetRevision: lo.ToPtr("main"),
+		Path:           lo.ToPtr("/catalog"),
+	}, nil)
+
+	mockService.EXPECT().GetRepository(gomock.Any(), gomock.Any(), "app-catalog").Return(newTestGitRepository(t), domain.StatusOK())
+	mockService.EXPECT().ListCatalogItems(gomock.Any(), gomock.Any(), "edge-apps", gomock.Any()).Return(&domain.CatalogItemList{
+		Items: []domain.CatalogItem{
+			newTestSyncedCatalogItem("nginx", nil),
+			newTestSyncedCatalogItem("removed", nil),
+			newTestSyncedCatalogItem("deprecated", &domain.CatalogItemDeprecation{Message: "old"}),
+			newTestCatalogItem("user-created", nil),
+		},
+	}, domain.StatusOK())
+
+	replaced := map[string]domain.CatalogItem{}
+	mockService.EXPECT().ReplaceCatalogItem(gomock.Any(), gomock.Any(), "edge-apps", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, orgId uuid.UUID, catalogName string, itemName string, item domain.CatalogItem) (*domain.CatalogItem, domain.Status) {
+			replaced[itemName] = item
+			return &item, domain.StatusOK()
+		}).Times(3)
+
+	var updated domain.Catalog
+	mockService.EXPECT().ReplaceCatalogStatus(gomock.Any(), gomock.Any(), "edge-apps", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, orgId uuid.UUID, name string, catalog domain.Catalog) (*domain.Catalog, domain.Status) {
+			updated = catalog
+			return &catalog, domain.StatusOK()
+		})
+
+	require.NoError(catalogSync.run(context.Background(), logrus.New(), uuid.New(), catalog))
+
+	require.Len(replaced, 3)
+	require.Nil(replaced["nginx"].Spec.Deprecation)
+	require.Nil(replaced["redis"].Spec.Deprecation)
+	require.Equal(catalogItemRemovedFromSourceMessage, replaced["removed"].Spec.Deprecation.Message)
+	require.NotContains(replaced, "user-created")
+	for _, item := range replaced {
+		require.Equal("Catalog/edge-apps", lo.FromPtr(item.Metadata.Owner))
+	}
+
+	require.True(domain.IsStatusConditionTrue(updated.Status.Conditions, domain.ConditionTypeCatalogAccessible))
+	require.True(domain.IsStatusConditionTrue(updated.Status.Conditions, domain.ConditionTypeCatalogSynced))
+	require.Equal("abc123", lo.FromPtr(updated.Status.ObservedRevision))
+	require.Equal(int64(1), lo.FromPtr(updated.Status.ObservedGeneration))
+}
+
+func TestCatalogSync_SkipsUnchangedRevision(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+
+	catalogSync := NewCatalogSync(mockService, logrus.New())
+	catalogSync.cloneGitRepo = func(repo *domain.Repository, revision *string, depth *int) (billy.Filesystem, string, error) {
+		mfs := memfs.New()
+		file, err := mfs.Create("/items.yaml")
+		require.NoError(err)
+		require.NoError(file.Close())
+		return mfs, "abc123", nil
+	}
+
+	status := &domain.CatalogStatus{
+		Conditions:         []domain.Condition{{Type: domain.ConditionTypeCatalogSynced, Status: domain.ConditionStatusTrue}},
+		ObservedRevision:   lo.ToPtr("abc123"),
+		ObservedGeneration: lo.ToPtr(int64(1)),
+	}
+	catalog := newTestSourceCatalog(domain.CatalogSource{Repository: "app-catalog"}, status)
+
+	mockService.EXPECT().GetRepository(gomock.Any(), gomock.Any(), "app-catalog").Return(newTestGitRepository(t), domain.StatusOK())
+	mockService.EXPECT().ReplaceCatalogStatus(gomock.Any(), gomock.Any(), "edge-apps", gomock.Any()).Return(catalog, domain.StatusOK())
+
+	require.NoError(catalogSync.run(context.Background(), logrus.New(), uuid.New(), catalog))
+}
+
+func TestCatalogSync_OciSourceRequiresReference(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+
+	catalogSync := NewCatalogSync(mockService, logrus.New())
+	catalog := newTestSourceCatalog(domain.CatalogSource{Repository: "app-registry"}, nil)
+
+	repo := &domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr("app-registry")}}
+	require.NoError(repo.Spec.FromOciRepoSpec(domain.OciRepoSpec{Registry: "quay.io"}))
+	mockService.EXPECT().GetRepository(gomock.Any(), gomock.Any(), "app-registry").Return(repo, domain.StatusOK())
+
+	var updated domain.Catalog
+	mockService.EXPECT().ReplaceCatalogStatus(gomock.Any(), gomock.Any(), "edge-apps", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, orgId uuid.UUID, name string, catalog domain.Catalog) (*domain.Catalog, domain.Status) {
+			updated = catalog
+			return &catalog, domain.StatusOK()
+		})
+
+	require.ErrorContains(catalogSync.run(context.Background(), logrus.New(), uuid.New(), catalog), "spec.source.reference is required")
+
+	condition := domain.FindStatusCondition(updated.Status.Conditions, domain.ConditionTypeCatalogAccessible)
+	require.NotNil(condition)
+	require.Equal(domain.ConditionStatusFalse, condition.Status)
+	require.Equal("Inaccessible", condition.Reason)
+}
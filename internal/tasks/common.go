@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
@@ -19,7 +20,7 @@ const (
 )
 
 var (
-	ErrUnknownConfigName      = errors.New("failed to find configuration item name")
+	ErrUnknownConfigName      = rollout.ErrUnknownConfigName
 	ErrUnknownApplicationType = errors.New("unknown application type")
 )
 
@@ -103,6 +103,13 @@ func dispatchTasks(serviceHandler service.Service, k8sClient k8sclient.K8SClient
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
+		if shouldRunBulkOperation(ctx, eventWithOrgId.Event, log) {
+			taskName = "bulkOperation"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return bulkOperation(ctx, eventWithOrgId.OrgId, eventWithOrgId.Event, serviceHandler, k8sClient, kvStore, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
 
 		// Emit InternalTaskFailedEvent for any unhandled task failures
 		// This serves as a safety net while preserving specific error handling within tasks
@@ -172,6 +179,11 @@ func shouldRolloutFleet(ctx context.Context, event domain.Event, log logrus.Fiel
 		return true
 	}
 
+	// If a fleet was rolled back, the template version it was rolled back to is rolled out immediately
+	if event.Reason == domain.EventReasonFleetRolledBack && event.InvolvedObject.Kind == domain.FleetKind {
+		return true
+	}
+
 	// If a device was created, return true
 	if event.Reason == domain.EventReasonResourceCreated && event.InvolvedObject.Kind == domain.DeviceKind {
 		return true
@@ -283,6 +295,14 @@ func shouldUpdateRepositoryReferers(ctx context.Context, event domain.Event, log
 	return false
 }
 
+func shouldRunBulkOperation(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
+	if event.InvolvedObject.Kind != domain.BulkOperationKind {
+		return false
+	}
+	// a bulk operation is started when created, and each processed batch triggers the next one
+	return lo.Contains([]domain.EventReason{domain.EventReasonResourceCreated, domain.EventReasonBulkOperationProgressed}, event.Reason)
+}
+
 func hasUpdatedFields(details *domain.EventDetails, log logrus.FieldLogger, fields ...domain.ResourceUpdatedDetailsUpdatedFields) bool {
 	if details == nil {
 		return false
@@ -87,6 +87,11 @@ func TestShouldRolloutFleet(t *testing.T) {
 			event:    createTestEvent(domain.FleetKind, domain.EventReasonFleetRolloutBatchDispatched, "fleet1"),
 			expected: true,
 		},
+		{
+			name:     "FleetRolledBack",
+			event:    createTestEvent(domain.FleetKind, domain.EventReasonFleetRolledBack, "fleet1"),
+			expected: true,
+		},
 		{
 			name:     "DeviceCreated",
 			event:    createTestEvent(domain.DeviceKind, domain.EventReasonResourceCreated, "device1"),
@@ -366,6 +371,43 @@ func TestShouldUpdateRepositoryReferers(t *testing.T) {
 	}
 }
 
+func TestShouldRunBulkOperation(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    domain.Event
+		expected bool
+	}{
+		{
+			name:     "BulkOperationCreated",
+			event:    createTestEvent(domain.BulkOperationKind, domain.EventReasonResourceCreated, "op1"),
+			expected: true,
+		},
+		{
+			name:     "BulkOperationProgressed",
+			event:    createTestEvent(domain.BulkOperationKind, domain.EventReasonBulkOperationProgressed, "op1"),
+			expected: true,
+		},
+		{
+			name:     "BulkOperationCompleted",
+			event:    createTestEvent(domain.BulkOperationKind, domain.EventReasonBulkOperationCompleted, "op1"),
+			expected: false,
+		},
+		{
+			name:     "DeviceCreated",
+			event:    createTestEvent(domain.DeviceKind, domain.EventReasonResourceCreated, "device1"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logrus.New()
+			result := shouldRunBulkOperation(context.Background(), tt.event, log)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestHasUpdatedFields(t *testing.T) {
 	tests := []struct {
 		name        string
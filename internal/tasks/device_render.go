@@ -35,7 +35,7 @@ import (
 //   version is not bumped.
 // - The rendering process is deterministic, based on the device spec, configuration sources,
 //   and application specs.
-// - External inputs (e.g., Git repositories, HTTP endpoints, Kubernetes secrets) are frozen per
+// - External inputs (e.g., Git repositories, HTTP endpoints, Kubernetes secrets, OCI tags) are frozen per
 //   fleet/template version using a KV store. Writes to the store use SetNX to prevent changes
 //   after freezing, and to detect inconsistencies.
 // - The rendered output and device condition status are safely overwritten or retried without
@@ -66,16 +66,27 @@ type DeviceRenderLogic struct {
 	kvStore         kvstore.KVStore
 	orgId           uuid.UUID
 	event           domain.Event
+	force           bool
 	ownerFleet      *string
 	templateVersion *string
 	deviceConfig    *[]domain.ConfigProviderSpec
 	applications    *[]domain.ApplicationProviderSpec
+	// ociConfigs holds the digest-pinned OCI config providers, which are passed through to the
+	// agent rather than being rendered into the ignition config.
+	ociConfigs []domain.ConfigProviderSpec
 }
 
 func NewDeviceRenderLogic(log logrus.FieldLogger, serviceHandler service.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, orgId uuid.UUID, event domain.Event) DeviceRenderLogic {
 	return DeviceRenderLogic{log: log, serviceHandler: serviceHandler, k8sClient: k8sClient, kvStore: kvStore, orgId: orgId, event: event}
 }
 
+// ForceRenderDevice renders the device even if its spec did not change since the last render,
+// so that changes of the sources it references are picked up.
+func (t *DeviceRenderLogic) ForceRenderDevice(ctx context.Context) error {
+	t.force = true
+	return t.RenderDevice(ctx)
+}
+
 func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 	device, status := t.serviceHandler.GetDevice(ctx, t.orgId, t.event.InvolvedObject.Name)
 	if status.Code != http.StatusOK {
@@ -109,7 +120,7 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 		}
 
 		// Don't render if the device spec hash hasn't changed since the last render
-		if val, ok := annotations[domain.DeviceAnnotationRenderedSpecHash]; ok {
+		if val, ok := annotations[domain.DeviceAnnotationRenderedSpecHash]; ok && !t.force {
 			if val == specHash {
 				t.log.Infof("Device %s spec hash hasn't changed since the last render", t.event.InvolvedObject.Name)
 				return nil
@@ -134,7 +145,7 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 
 	// TODO: remove ignition
 	ignitionConfig, referencedRepos, renderErr := t.renderConfig(ctx)
-	renderedConfig, err := ignitionConfigToRenderedConfig(ignitionConfig)
+	renderedConfig, err := ignitionConfigToRenderedConfig(ignitionConfig, t.ociConfigs...)
 	if err != nil {
 		return fmt.Errorf("failed converting ignition config to rendered config: %w", err)
 	}
@@ -289,6 +300,8 @@ func (t *DeviceRenderLogic) renderConfigItem(ctx context.Context, configItem *do
 		return t.renderInlineConfig(configItem, ignitionConfig)
 	case domain.HttpConfigProviderType:
 		return t.renderHttpProviderConfig(ctx, configItem, ignitionConfig)
+	case domain.OciConfigProviderType:
+		return t.renderOciConfig(ctx, configItem)
 	default:
 		return nil, nil, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType)
 	}
@@ -567,6 +580,68 @@ func (t *DeviceRenderLogic) renderHttpProviderConfig(ctx context.Context, config
 	return &httpConfigProviderSpec.Name, &httpConfigProviderSpec.HttpRef.Repository, nil
 }
 
+func (t *DeviceRenderLogic) renderOciConfig(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
+	ociConfigProviderSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as OciConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+	name := &ociConfigProviderSpec.Name
+	repoName := &ociConfigProviderSpec.OciRef.Repository
+
+	repo, status := t.serviceHandler.GetRepository(ctx, t.orgId, *repoName)
+	if status.Code != http.StatusOK {
+		return name, repoName, fmt.Errorf("failed fetching specified Repository definition %s/%s: %s", t.orgId, *repoName, status.Message)
+	}
+	ociSpec, err := repo.Spec.GetOciRepoSpec()
+	if err != nil {
+		return name, repoName, fmt.Errorf("invalid Repository %s/%s: %w", t.orgId, *repoName, err)
+	}
+
+	var pinnedRef string
+	if t.ownerFleet != nil {
+		// Resolve the tag only once per template version so that all devices of the
+		// fleet receive the same digest, even if the tag is moved during the rollout.
+		ociDigestKey := kvstore.OciDigestKey{
+			OrgID:           t.orgId,
+			Fleet:           *t.ownerFleet,
+			TemplateVersion: *t.templateVersion,
+			Repository:      *repoName,
+			Reference:       ociConfigProviderSpec.OciRef.Reference,
+		}
+		frozenRef, err := t.kvStore.Get(ctx, ociDigestKey.ComposeKey())
+		if err != nil {
+			return name, repoName, fmt.Errorf("failed fetching frozen OCI digest: %w", err)
+		}
+		if frozenRef != nil {
+			pinnedRef = string(frozenRef)
+		} else {
+			resolvedRef, err := resolveOciDigest(ctx, ociSpec, ociConfigProviderSpec.OciRef.Reference)
+			if err != nil {
+				return name, repoName, fmt.Errorf("failed resolving OCI reference: %w", err)
+			}
+			frozenRef, err = t.kvStore.GetOrSetNX(ctx, ociDigestKey.ComposeKey(), []byte(resolvedRef))
+			if err != nil {
+				return name, repoName, fmt.Errorf("failed freezing OCI digest: %w", err)
+			}
+			pinnedRef = string(frozenRef)
+		}
+	} else {
+		pinnedRef, err = resolveOciDigest(ctx, ociSpec, ociConfigProviderSpec.OciRef.Reference)
+		if err != nil {
+			return name, repoName, fmt.Errorf("failed resolving OCI reference: %w", err)
+		}
+	}
+
+	ociConfigProviderSpec.OciRef.Reference = pinnedRef
+	renderedItem := domain.ConfigProviderSpec{}
+	if err := renderedItem.FromOciConfigProviderSpec(ociConfigProviderSpec); err != nil {
+		return name, repoName, fmt.Errorf("failed converting OCI config: %w", err)
+	}
+	t.ociConfigs = append(t.ociConfigs, renderedItem)
+
+	return name, repoName, nil
+}
+
 func (t *DeviceRenderLogic) getFrozenRepositoryURL(ctx context.Context, repo *domain.Repository) error {
 	repoURL, err := repo.Spec.GetRepoURL()
 	if err != nil {
@@ -678,14 +753,34 @@ func (t *DeviceRenderLogic) cloneCachedGitRepoToIgnition(ctx context.Context, re
 }
 
 // TODO: this is temporary, ignition will be removed in the future
-// ignitionConfigToRenderedConfig converts an ignition config to rendered config bytes
-func ignitionConfigToRenderedConfig(ignition *config_latest_types.Config) ([]byte, error) {
+// ignitionConfigToRenderedConfig converts an ignition config to rendered config bytes.
+// Any passthrough providers (e.g. OCI) are appended after the inline provider.
+func ignitionConfigToRenderedConfig(ignition *config_latest_types.Config, passthrough ...domain.ConfigProviderSpec) ([]byte, error) {
 	emptyConfig := []byte("[]")
 
-	if ignition == nil || len(ignition.Storage.Files) == 0 {
+	if (ignition == nil || len(ignition.Storage.Files) == 0) && len(passthrough) == 0 {
 		return emptyConfig, nil
 	}
 
+	providers := []domain.ConfigProviderSpec{}
+	if ignition != nil && len(ignition.Storage.Files) > 0 {
+		provider, err := ignitionFilesToInlineProvider(ignition)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, *provider)
+	}
+	providers = append(providers, passthrough...)
+
+	renderedConfig, err := json.Marshal(providers)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling rendered config: %w", err)
+	}
+
+	return renderedConfig, nil
+}
+
+func ignitionFilesToInlineProvider(ignition *config_latest_types.Config) (*domain.ConfigProviderSpec, error) {
 	var files []domain.FileSpec
 	for _, file := range ignition.Storage.Files {
 		content := lo.FromPtr(file.Contents.Source)
@@ -729,13 +824,7 @@ func ignitionConfigToRenderedConfig(ignition *config_latest_types.Config) ([]byt
 	if err != nil {
 		return nil, fmt.Errorf("converting files to inline config provider: %w", err)
 	}
-	providers := &[]domain.ConfigProviderSpec{provider}
-	renderedConfig, err := json.Marshal(providers)
-	if err != nil {
-		return nil, fmt.Errorf("marshalling rendered config: %w", err)
-	}
-
-	return renderedConfig, nil
+	return &provider, nil
 }
 
 // hashRenderedWithSpec creates a hash of the device spec to detect changes
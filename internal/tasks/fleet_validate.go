@@ -78,6 +78,10 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 		return t.setStatus(ctx, validationErr)
 	}
 
+	if err := t.clearRollbackIfNewTemplateVersion(ctx, fleet, templateVersionName); err != nil {
+		return t.setStatus(ctx, err)
+	}
+
 	templateVersion := domain.TemplateVersion{
 		Metadata: domain.ObjectMeta{
 			Name:  &templateVersionName,
@@ -85,12 +89,13 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 		},
 		Spec: domain.TemplateVersionSpec{Fleet: *fleet.Metadata.Name},
 		Status: &domain.TemplateVersionStatus{
-			Applications: fleet.Spec.Template.Spec.Applications,
-			Config:       fleet.Spec.Template.Spec.Config,
-			Os:           fleet.Spec.Template.Spec.Os,
-			Resources:    fleet.Spec.Template.Spec.Resources,
-			Systemd:      fleet.Spec.Template.Spec.Systemd,
-			UpdatePolicy: fleet.Spec.Template.Spec.UpdatePolicy,
+			Applications:      fleet.Spec.Template.Spec.Applications,
+			Config:            fleet.Spec.Template.Spec.Config,
+			ConfigDriftPolicy: fleet.Spec.Template.Spec.ConfigDriftPolicy,
+			Os:                fleet.Spec.Template.Spec.Os,
+			Resources:         fleet.Spec.Template.Spec.Resources,
+			Systemd:           fleet.Spec.Template.Spec.Systemd,
+			UpdatePolicy:      fleet.Spec.Template.Spec.UpdatePolicy,
 		},
 	}
 
@@ -121,6 +126,28 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 	return t.setStatus(ctx, nil)
 }
 
+// clearRollbackIfNewTemplateVersion ends a rollback when a new template version is about to be created.  The rollback
+// annotation is removed before the template version is created, so that the rollout of the new template version is not
+// pinned to the rolled back template version.
+func (t *FleetValidateLogic) clearRollbackIfNewTemplateVersion(ctx context.Context, fleet *domain.Fleet, templateVersionName string) error {
+	if _, rolledBack := fleet.GetAnnotation(domain.FleetAnnotationRollbackTemplateVersion); !rolledBack {
+		return nil
+	}
+	_, status := t.serviceHandler.GetTemplateVersion(ctx, t.orgId, *fleet.Metadata.Name, templateVersionName)
+	switch status.Code {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		status = t.serviceHandler.UpdateFleetAnnotations(ctx, t.orgId, *fleet.Metadata.Name, nil, []string{domain.FleetAnnotationRollbackTemplateVersion})
+		if status.Code != http.StatusOK {
+			return fmt.Errorf("failed removing rollback annotation: %s", status.Message)
+		}
+		return nil
+	default:
+		return fmt.Errorf("failed getting templateVersion %s: %s", templateVersionName, status.Message)
+	}
+}
+
 func (t *FleetValidateLogic) setStatus(ctx context.Context, validationErr error) error {
 	condition := domain.Condition{Type: domain.ConditionTypeFleetValid}
 
@@ -192,6 +219,8 @@ func (t *FleetValidateLogic) validateConfigItem(ctx context.Context, configItem
 		return t.validateInlineConfig(configItem)
 	case domain.HttpConfigProviderType:
 		return t.validateHttpProviderConfig(ctx, configItem)
+	case domain.OciConfigProviderType:
+		return t.validateOciProviderConfig(ctx, configItem)
 	default:
 		return nil, nil, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType)
 	}
@@ -259,6 +288,23 @@ func (t *FleetValidateLogic) validateHttpProviderConfig(ctx context.Context, con
 	return &httpConfigProviderSpec.Name, &httpConfigProviderSpec.HttpRef.Repository, nil
 }
 
+func (t *FleetValidateLogic) validateOciProviderConfig(ctx context.Context, configItem *domain.ConfigProviderSpec) (*string, *string, error) {
+	ociSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed getting config item as OciConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	repo, status := t.serviceHandler.GetRepository(ctx, t.orgId, ociSpec.OciRef.Repository)
+	if status.Code != http.StatusOK {
+		return &ociSpec.Name, &ociSpec.OciRef.Repository, fmt.Errorf("failed fetching specified Repository definition %s/%s: %s", t.orgId, ociSpec.OciRef.Repository, status.Message)
+	}
+	if _, err = repo.Spec.GetOciRepoSpec(); err != nil {
+		return &ociSpec.Name, &ociSpec.OciRef.Repository, fmt.Errorf("invalid Repository %s/%s: %w", t.orgId, ociSpec.OciRef.Repository, err)
+	}
+
+	return &ociSpec.Name, &ociSpec.OciRef.Repository, nil
+}
+
 func generateTemplateVersionName(fleet *domain.Fleet) string {
 	return fmt.Sprintf("%s-%d", *fleet.Metadata.Name, *fleet.Metadata.Generation)
 }
@@ -0,0 +1,156 @@
+package tasks
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+const (
+	ociResolveTimeout = 30 * time.Second
+	ociFetchTimeout   = 2 * time.Minute
+)
+
+// resolveOciDigest resolves a tag or digest reference relative to the registry of the given
+// OCI repository and returns the fully qualified reference pinned to the manifest digest.
+func resolveOciDigest(ctx context.Context, ociSpec *domain.OciRepoSpec, reference string) (string, error) {
+	ref, err := registry.ParseReference(fmt.Sprintf("%s/%s", ociSpec.Registry, reference))
+	if err != nil {
+		return "", fmt.Errorf("parsing reference %q: %w", reference, err)
+	}
+	if ref.Reference == "" {
+		return "", fmt.Errorf("reference %q must include a tag or digest", reference)
+	}
+
+	repoName := fmt.Sprintf("%s/%s", ref.Registry, ref.Repository)
+	if ref.ValidateReferenceAsDigest() == nil {
+		// already pinned, no need to reach out to the registry
+		return fmt.Sprintf("%s@%s", repoName, ref.Reference), nil
+	}
+
+	repo, err := newOciRepository(ociSpec, ref)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ociResolveTimeout)
+	defer cancel()
+
+	desc, err := repo.Resolve(ctx, ref.Reference)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s:%s: %w", repoName, ref.Reference, err)
+	}
+	return fmt.Sprintf("%s@%s", repoName, desc.Digest), nil
+}
+
+// fetchOciArtifactFiles fetches the artifact referenced relative to the registry of the given
+// OCI repository and returns the manifest digest and the contents of its layers by file name.
+// Only layers annotated with a file name (org.opencontainers.image.title), as pushed by
+// "oras push", are returned.
+func fetchOciArtifactFiles(ctx context.Context, ociSpec *domain.OciRepoSpec, reference string) (string, map[string][]byte, error) {
+	ref, err := registry.ParseReference(fmt.Sprintf("%s/%s", ociSpec.Registry, reference))
+	if err != nil {
+		return "", nil, fmt.Errorf("parsing reference %q: %w", reference, err)
+	}
+	if ref.Reference == "" {
+		return "", nil, fmt.Errorf("reference %q must include a tag or digest", reference)
+	}
+
+	repo, err := newOciRepository(ociSpec, ref)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ociFetchTimeout)
+	defer cancel()
+
+	desc, manifestBytes, err := oras.FetchBytes(ctx, repo, ref.Reference, oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return "", nil, fmt.Errorf("fetching manifest %s: %w", ref, err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return "", nil, fmt.Errorf("parsing manifest %s: %w", ref, err)
+	}
+
+	files := make(map[string][]byte)
+	for _, layer := range manifest.Layers {
+		name := layer.Annotations[ocispec.AnnotationTitle]
+		if name == "" {
+			continue
+		}
+		data, err := content.FetchAll(ctx, repo, layer)
+		if err != nil {
+			return "", nil, fmt.Errorf("fetching layer %s of %s: %w", name, ref, err)
+		}
+		files[name] = data
+	}
+	return desc.Digest.String(), files, nil
+}
+
+func newOciRepository(ociSpec *domain.OciRepoSpec, ref registry.Reference) (*remote.Repository, error) {
+	repo, err := remote.NewRepository(fmt.Sprintf("%s/%s", ref.Registry, ref.Repository))
+	if err != nil {
+		return nil, fmt.Errorf("creating repository reference: %w", err)
+	}
+	repo.PlainHTTP = ociSpec.Scheme != nil && *ociSpec.Scheme == domain.OciRepoSchemeHttp
+
+	httpClient, err := ociHTTPClient(ociSpec)
+	if err != nil {
+		return nil, err
+	}
+	authClient := &auth.Client{Client: httpClient}
+	if ociSpec.OciAuth != nil {
+		dockerAuth, err := ociSpec.OciAuth.AsDockerAuth()
+		if err == nil && dockerAuth.Username != "" && dockerAuth.Password != "" {
+			authClient.Credential = auth.StaticCredential(ref.Registry, auth.Credential{
+				Username: dockerAuth.Username,
+				Password: dockerAuth.Password,
+			})
+		}
+	}
+	repo.Client = authClient
+	return repo, nil
+}
+
+func ociHTTPClient(ociSpec *domain.OciRepoSpec) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if ociSpec.SkipServerVerification != nil {
+		tlsConfig.InsecureSkipVerify = *ociSpec.SkipServerVerification
+	}
+	if ociSpec.CaCrt != nil {
+		ca, err := base64.StdEncoding.DecodeString(*ociSpec.CaCrt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode CA certificate: %w", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get system cert pool: %w", err)
+		}
+		if rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		rootCAs.AppendCertsFromPEM(ca)
+		tlsConfig.RootCAs = rootCAs
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}, nil
+}
@@ -0,0 +1,135 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveOciDigest(t *testing.T) {
+	manifest := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json"}`)
+	dgst := digest.FromBytes(manifest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/configs/edge/manifests/v1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+		w.Header().Set("Docker-Content-Digest", dgst.String())
+		w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(manifest)
+		}
+	}))
+	defer server.Close()
+
+	ociSpec := &domain.OciRepoSpec{
+		Registry: strings.TrimPrefix(server.URL, "http://"),
+		Scheme:   lo.ToPtr(domain.OciRepoSchemeHttp),
+	}
+	pinned := ociSpec.Registry + "/configs/edge@" + dgst.String()
+
+	tests := []struct {
+		name      string
+		reference string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "tag is pinned to the manifest digest",
+			reference: "configs/edge:v1",
+			want:      pinned,
+		},
+		{
+			name:      "digest is kept as is",
+			reference: "configs/edge@" + dgst.String(),
+			want:      pinned,
+		},
+		{
+			name:      "unknown tag",
+			reference: "configs/edge:v2",
+			wantErr:   true,
+		},
+		{
+			name:      "missing tag or digest",
+			reference: "configs/edge",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ref, err := resolveOciDigest(context.Background(), ociSpec, tt.reference)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.want, ref)
+		})
+	}
+}
+
+func TestFetchOciArtifactFiles(t *testing.T) {
+	require := require.New(t)
+
+	items := []byte("apiVersion: flightctl.io/v1alpha1\nkind: CatalogItem\n")
+	itemsDigest := digest.FromBytes(items)
+	manifest, err := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.DescriptorEmptyJSON,
+		Layers: []ocispec.Descriptor{
+			{
+				MediaType:   "application/yaml",
+				Digest:      itemsDigest,
+				Size:        int64(len(items)),
+				Annotations: map[string]string{ocispec.AnnotationTitle: "items.yaml"},
+			},
+		},
+	})
+	require.NoError(err)
+	manifestDigest := digest.FromBytes(manifest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/catalogs/edge/manifests/v1":
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+			w.Header().Set("Docker-Content-Digest", manifestDigest.String())
+			w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+			_, _ = w.Write(manifest)
+		case "/v2/catalogs/edge/blobs/" + itemsDigest.String():
+			w.Header().Set("Content-Length", strconv.Itoa(len(items)))
+			_, _ = w.Write(items)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ociSpec := &domain.OciRepoSpec{
+		Registry: strings.TrimPrefix(server.URL, "http://"),
+		Scheme:   lo.ToPtr(domain.OciRepoSchemeHttp),
+	}
+
+	revision, files, err := fetchOciArtifactFiles(context.Background(), ociSpec, "catalogs/edge:v1")
+	require.NoError(err)
+	require.Equal(manifestDigest.String(), revision)
+	require.Equal(map[string][]byte{"items.yaml": items}, files)
+
+	_, _, err = fetchOciArtifactFiles(context.Background(), ociSpec, "catalogs/edge:v2")
+	require.Error(err)
+}
@@ -2,6 +2,7 @@ package agenttransportv1beta1
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -61,12 +62,29 @@ func (s *AgentTransportHandler) GetRenderedDevice(w http.ResponseWriter, r *http
 		return
 	}
 
+	s.setCABundleHeader(w)
+
 	domainParams := s.converter.Device().GetRenderedParamsToDomain(params)
 	body, status := s.serviceHandler.GetRenderedDevice(ctx, transport.OrgIDFromContext(ctx), fingerprint, domainParams)
 	apiResult := s.converter.Device().FromDomain(body)
 	s.SetResponse(w, apiResult, status)
 }
 
+// setCABundleHeader attaches the CA bundle to the response while a CA rotation is configured.  Devices poll for
+// their rendered spec whether or not they renew their management certificate, so the bundle reaches devices
+// that don't renew before the service switches to the new CA, and devices drop the previous CA once it retires.
+func (s *AgentTransportHandler) setCABundleHeader(w http.ResponseWriter) {
+	if s.ca == nil || s.ca.Rotation() == nil {
+		return
+	}
+	caBundle, err := s.ca.GetCABundle()
+	if err != nil {
+		s.log.WithError(err).Warn("failed to get CA bundle for rendered device")
+		return
+	}
+	w.Header().Set(consts.ManagementCABundleHeader, base64.StdEncoding.EncodeToString(caBundle))
+}
+
 // (PUT /api/v1/devices/{name}/status)
 func (s *AgentTransportHandler) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request, name string) {
 	ctx := r.Context()
@@ -313,6 +331,17 @@ func (s *AgentTransportHandler) GetCertificateSigningRequest(w http.ResponseWrit
 		return
 	}
 
+	// Issued management certificates come with the CA bundle, so that devices trust a new CA
+	// before the service switches to it during a CA rotation.
+	if csr.Status != nil && csr.Status.Certificate != nil && csr.Spec.SignerName == s.ca.Cfg.DeviceManagementRenewalSignerName {
+		caBundle, err := s.ca.GetCABundle()
+		if err != nil {
+			s.log.WithError(err).Warn("failed to get CA bundle for management certificate")
+		} else {
+			csr.Status.CaBundle = &caBundle
+		}
+	}
+
 	apiResult := s.converter.CertificateSigningRequest().FromDomain(csr)
 	s.SetResponse(w, apiResult, status)
 }
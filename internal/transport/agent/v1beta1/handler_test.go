@@ -0,0 +1,61 @@
+package agenttransportv1beta1
+
+import (
+	"encoding/base64"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCAConfig(t *testing.T) *ca.Config {
+	return &ca.Config{
+		InternalConfig: &ca.InternalCfg{
+			CertStore:        t.TempDir(),
+			CertFile:         "ca.crt",
+			KeyFile:          "ca.key",
+			SerialFile:       "ca.serial",
+			SignerCertName:   "flightctl-test-ca",
+			CertValidityDays: 365,
+		},
+	}
+}
+
+func TestSetCABundleHeader(t *testing.T) {
+	require := require.New(t)
+	caConfig := newTestCAConfig(t)
+	caClient, _, err := crypto.EnsureCA(caConfig)
+	require.NoError(err)
+
+	// without a CA rotation, devices keep the CAs they trust
+	handler := NewAgentTransportHandler(nil, nil, caClient, logrus.New())
+	recorder := httptest.NewRecorder()
+	handler.setCABundleHeader(recorder)
+	require.Empty(recorder.Header().Get(consts.ManagementCABundleHeader))
+
+	// during a CA rotation, every rendered device poll carries the bundle of both CAs, so devices that don't
+	// renew their management certificate during the grace period trust the new CA before the switch
+	caConfig.Rotation = &ca.RotationCfg{
+		CertFile:    "ca-next.crt",
+		KeyFile:     "ca-next.key",
+		GracePeriod: util.Duration(time.Hour),
+	}
+	caClient, _, err = crypto.EnsureCA(caConfig)
+	require.NoError(err)
+	handler = NewAgentTransportHandler(nil, nil, caClient, logrus.New())
+	recorder = httptest.NewRecorder()
+	handler.setCABundleHeader(recorder)
+
+	bundle, err := base64.StdEncoding.DecodeString(recorder.Header().Get(consts.ManagementCABundleHeader))
+	require.NoError(err)
+	expected, err := caClient.GetCABundle()
+	require.NoError(err)
+	require.Equal(expected, bundle)
+	require.Len(caClient.GetCABundleX509(), 2)
+}
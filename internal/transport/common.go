@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
@@ -68,3 +69,11 @@ func OrgIDFromContext(ctx context.Context) uuid.UUID {
 	}
 	return store.NullOrgId
 }
+
+// WithDryRun marks the context of a request that was sent with the dryRun query parameter.
+func WithDryRun(ctx context.Context, dryRun *bool) context.Context {
+	if dryRun != nil && *dryRun {
+		return context.WithValue(ctx, consts.DryRunCtxKey, true)
+	}
+	return ctx
+}
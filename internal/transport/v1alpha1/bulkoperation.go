@@ -0,0 +1,119 @@
+package transportv1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/samber/lo"
+)
+
+// bulkOperationActionPermissions are the device permissions a caller needs to apply each action directly.
+// The workers apply the action under the service identity, so the caller's permission is checked up front.
+var bulkOperationActionPermissions = map[apiv1alpha1.BulkOperationActionType]struct{ resource, op string }{
+	apiv1alpha1.BulkOperationActionPatchLabels:  {"devices", "patch"},
+	apiv1alpha1.BulkOperationActionDecommission: {"devices/decommission", "update"},
+	apiv1alpha1.BulkOperationActionResume:       {"devices/resume", "update"},
+	apiv1alpha1.BulkOperationActionRerender:     {"devices", "update"},
+}
+
+// bulkOperationScope returns StatusOK if the caller may apply the action of the operation to devices. If the
+// permission is only granted by label-scoped RoleBindings, it also returns their label selectors, and the
+// operation only applies its action to the devices matching one of them.
+func (h *TransportHandler) bulkOperationScope(ctx context.Context, action apiv1alpha1.BulkOperationActionType) ([]string, domain.Status) {
+	permission, ok := bulkOperationActionPermissions[action]
+	if !ok {
+		// unknown actions are rejected by the service
+		return nil, domain.StatusOK()
+	}
+	allowed, err := h.authZ.CheckPermission(ctx, permission.resource, permission.op)
+	if err != nil {
+		if flterrors.IsClientAuthError(err) {
+			return nil, domain.StatusBadRequest(err.Error())
+		}
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to check permission: %v", err))
+	}
+	if allowed {
+		return nil, domain.StatusOK()
+	}
+
+	permissions, err := h.authZ.GetUserPermissions(ctx)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("failed to get permissions: %v", err))
+	}
+	var scope []string
+	for _, p := range permissions.Permissions {
+		if p.LabelSelector == nil || (p.Resource != permission.resource && p.Resource != "*") {
+			continue
+		}
+		if lo.Contains(p.Operations, permission.op) || lo.Contains(p.Operations, "*") {
+			scope = append(scope, *p.LabelSelector)
+		}
+	}
+	if len(scope) == 0 {
+		return nil, domain.StatusForbidden(fmt.Sprintf("%s action requires %s permission on %s", action, permission.op, permission.resource))
+	}
+	return lo.Uniq(scope), domain.StatusOK()
+}
+
+// (POST /api/v1/bulkoperations)
+func (h *TransportHandler) CreateBulkOperation(w http.ResponseWriter, r *http.Request) {
+	var operation apiv1alpha1.BulkOperation
+	if err := json.NewDecoder(r.Body).Decode(&operation); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+	scope, status := h.bulkOperationScope(r.Context(), operation.Spec.Action.Type)
+	if status.Code != domain.StatusOK().Code {
+		h.SetResponse(w, nil, status)
+		return
+	}
+
+	domainOperation := h.converter.BulkOperation().ToDomain(operation)
+	// the scope annotation is only ever set from the caller's permissions
+	domainOperation.Metadata.Annotations = nil
+	if len(scope) > 0 {
+		scopeJSON, err := json.Marshal(scope)
+		if err != nil {
+			h.SetResponse(w, nil, domain.StatusInternalServerError(err.Error()))
+			return
+		}
+		domainOperation.Metadata.Annotations = &map[string]string{domain.BulkOperationAnnotationScopeLabelSelectors: string(scopeJSON)}
+	}
+	body, status := h.serviceHandler.CreateBulkOperation(r.Context(), transport.OrgIDFromContext(r.Context()), domainOperation)
+	apiResult := h.converter.BulkOperation().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/bulkoperations)
+func (h *TransportHandler) ListBulkOperations(w http.ResponseWriter, r *http.Request, params apiv1alpha1.ListBulkOperationsParams) {
+	domainParams := h.converter.BulkOperation().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListBulkOperations(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.BulkOperation().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/bulkoperations/{name})
+func (h *TransportHandler) GetBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetBulkOperation(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.BulkOperation().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/bulkoperations/{name})
+func (h *TransportHandler) DeleteBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteBulkOperation(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
+
+// (POST /api/v1/bulkoperations/{name}/cancel)
+func (h *TransportHandler) CancelBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.CancelBulkOperation(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.BulkOperation().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
@@ -0,0 +1,141 @@
+package transportv1alpha1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	convertv1alpha1 "github.com/flightctl/flightctl/internal/api/convert/v1alpha1"
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+type fakeCustomRoleStore struct {
+	roles    []domain.Role
+	bindings []domain.RoleBinding
+}
+
+func (f *fakeCustomRoleStore) ListRoles(ctx context.Context, orgId uuid.UUID) ([]domain.Role, error) {
+	return f.roles, nil
+}
+
+func (f *fakeCustomRoleStore) ListRoleBindings(ctx context.Context, orgId uuid.UUID) ([]domain.RoleBinding, error) {
+	return f.bindings, nil
+}
+
+func (f *fakeCustomRoleStore) GetResourceLabels(ctx context.Context, orgId uuid.UUID, resource string, name string) (map[string]string, error) {
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func newTestBulkOperationRequest(t *testing.T, role string, action apiv1alpha1.BulkOperationActionType) *http.Request {
+	operation := apiv1alpha1.BulkOperation{
+		ApiVersion: "v1alpha1",
+		Kind:       domain.BulkOperationKind,
+		Metadata:   apiv1beta1.ObjectMeta{Name: lo.ToPtr("op")},
+		Spec: apiv1alpha1.BulkOperationSpec{
+			Action:        apiv1alpha1.BulkOperationAction{Type: action},
+			LabelSelector: lo.ToPtr("site=berlin"),
+		},
+	}
+	body, err := json.Marshal(operation)
+	require.NoError(t, err)
+
+	orgID := uuid.New()
+	org := &model.Organization{ID: orgID, ExternalID: "test-org"}
+	roles := []string{}
+	if role != "" {
+		roles = append(roles, role)
+	}
+	mappedIdentity := identity.NewMappedIdentity("testuser", "testuser", []*model.Organization{org}, map[string][]string{orgID.String(): roles}, false, nil)
+	ctx := context.WithValue(context.Background(), consts.MappedIdentityCtxKey, mappedIdentity)
+	ctx = util.WithOrganizationID(ctx, orgID)
+	return httptest.NewRequest(http.MethodPost, "/api/v1/bulkoperations", bytes.NewReader(body)).WithContext(ctx)
+}
+
+func TestCreateBulkOperationChecksActionPermission(t *testing.T) {
+	tests := []struct {
+		name         string
+		role         string
+		action       apiv1alpha1.BulkOperationActionType
+		expectedCode int
+	}{
+		{name: "operator cannot decommission", role: apiv1beta1.RoleOperator, action: apiv1alpha1.BulkOperationActionDecommission, expectedCode: http.StatusForbidden},
+		{name: "operator cannot resume", role: apiv1beta1.RoleOperator, action: apiv1alpha1.BulkOperationActionResume, expectedCode: http.StatusForbidden},
+		{name: "operator can patch labels", role: apiv1beta1.RoleOperator, action: apiv1alpha1.BulkOperationActionPatchLabels, expectedCode: http.StatusCreated},
+		{name: "operator can rerender", role: apiv1beta1.RoleOperator, action: apiv1alpha1.BulkOperationActionRerender, expectedCode: http.StatusCreated},
+		{name: "admin can decommission", role: apiv1beta1.RoleAdmin, action: apiv1alpha1.BulkOperationActionDecommission, expectedCode: http.StatusCreated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := service.NewMockService(gomock.NewController(t))
+			h := NewTransportHandler(mockService, convertv1alpha1.NewConverter(), authz.NewStaticAuthZ(logrus.New()))
+			if tt.expectedCode == http.StatusCreated {
+				mockService.EXPECT().CreateBulkOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+						return &operation, domain.StatusCreated()
+					})
+			}
+
+			w := httptest.NewRecorder()
+			h.CreateBulkOperation(w, newTestBulkOperationRequest(t, tt.role, tt.action))
+			require.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+		})
+	}
+}
+
+func TestCreateBulkOperationScopesLabelScopedCallers(t *testing.T) {
+	roleStore := &fakeCustomRoleStore{
+		roles: []domain.Role{{
+			Metadata: apiv1beta1.ObjectMeta{Name: lo.ToPtr("device-patcher")},
+			Spec:     domain.RoleSpec{Rules: []domain.PolicyRule{{Resources: []string{"devices"}, Verbs: []string{"patch"}}}},
+		}},
+		bindings: []domain.RoleBinding{{
+			Metadata: apiv1beta1.ObjectMeta{Name: lo.ToPtr("berlin-patchers")},
+			Spec: domain.RoleBindingSpec{
+				RoleRef:       "device-patcher",
+				Subjects:      []domain.RoleBindingSubject{{Kind: domain.RoleBindingSubjectKindUser, Name: "testuser"}},
+				LabelSelector: lo.ToPtr("site=berlin"),
+			},
+		}},
+	}
+	authZ := authz.NewStaticAuthZ(logrus.New(), authz.WithCustomRoles(authz.NewCustomRoles(roleStore, logrus.New())))
+
+	t.Run("scoped action records the scope", func(t *testing.T) {
+		mockService := service.NewMockService(gomock.NewController(t))
+		h := NewTransportHandler(mockService, convertv1alpha1.NewConverter(), authZ)
+		mockService.EXPECT().CreateBulkOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, operation domain.BulkOperation) (*domain.BulkOperation, domain.Status) {
+				require.Equal(t, `["site=berlin"]`, lo.FromPtr(operation.Metadata.Annotations)[domain.BulkOperationAnnotationScopeLabelSelectors])
+				return &operation, domain.StatusCreated()
+			})
+
+		w := httptest.NewRecorder()
+		h.CreateBulkOperation(w, newTestBulkOperationRequest(t, "", apiv1alpha1.BulkOperationActionPatchLabels))
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	})
+
+	t.Run("action without any permission is forbidden", func(t *testing.T) {
+		mockService := service.NewMockService(gomock.NewController(t))
+		h := NewTransportHandler(mockService, convertv1alpha1.NewConverter(), authZ)
+
+		w := httptest.NewRecorder()
+		h.CreateBulkOperation(w, newTestBulkOperationRequest(t, "", apiv1alpha1.BulkOperationActionDecommission))
+		require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	})
+}
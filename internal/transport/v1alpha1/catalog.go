@@ -163,3 +163,17 @@ func (h *TransportHandler) DeleteCatalogItem(w http.ResponseWriter, r *http.Requ
 	status := h.serviceHandler.DeleteCatalogItem(r.Context(), transport.OrgIDFromContext(r.Context()), name, item)
 	h.SetResponse(w, nil, status)
 }
+
+// (POST /api/v1/catalogs/{name}/items/{item}/install)
+func (h *TransportHandler) InstallCatalogItem(w http.ResponseWriter, r *http.Request, name string, item string) {
+	var request apiv1alpha1.CatalogItemInstallRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRequest := h.converter.Catalog().InstallRequestToDomain(request)
+	body, status := h.serviceHandler.InstallCatalogItem(r.Context(), transport.OrgIDFromContext(r.Context()), name, item, domainRequest)
+	apiResult := h.converter.Catalog().InstallationFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
@@ -0,0 +1,58 @@
+package transportv1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/enrollmentapprovalpolicies)
+func (h *TransportHandler) CreateEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	var policy apiv1alpha1.EnrollmentApprovalPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainPolicy := h.converter.EnrollmentApprovalPolicy().ToDomain(policy)
+	body, status := h.serviceHandler.CreateEnrollmentApprovalPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), domainPolicy)
+	apiResult := h.converter.EnrollmentApprovalPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/enrollmentapprovalpolicies)
+func (h *TransportHandler) ListEnrollmentApprovalPolicies(w http.ResponseWriter, r *http.Request, params apiv1alpha1.ListEnrollmentApprovalPoliciesParams) {
+	domainParams := h.converter.EnrollmentApprovalPolicy().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListEnrollmentApprovalPolicies(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.EnrollmentApprovalPolicy().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/enrollmentapprovalpolicies/{name})
+func (h *TransportHandler) GetEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetEnrollmentApprovalPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.EnrollmentApprovalPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/enrollmentapprovalpolicies/{name})
+func (h *TransportHandler) ReplaceEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	var policy apiv1alpha1.EnrollmentApprovalPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainPolicy := h.converter.EnrollmentApprovalPolicy().ToDomain(policy)
+	body, status := h.serviceHandler.ReplaceEnrollmentApprovalPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPolicy)
+	apiResult := h.converter.EnrollmentApprovalPolicy().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/enrollmentapprovalpolicies/{name})
+func (h *TransportHandler) DeleteEnrollmentApprovalPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteEnrollmentApprovalPolicy(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
@@ -0,0 +1,106 @@
+package transportv1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/samber/lo"
+)
+
+// checkCanGrant returns StatusOK if the caller holds every permission in permissions, keyed by resource, so
+// that Roles and RoleBindings never grant more than their author holds. A permission on all resources ("*")
+// must also be held on the resources the built-in roles name explicitly, as they may deny some of them.
+func (h *TransportHandler) checkCanGrant(ctx context.Context, permissions map[string][]string) domain.Status {
+	// the name in the request path is a Role or RoleBinding, not a resource label-scoped bindings apply to
+	ctx = context.WithValue(ctx, consts.ResourceNameCtxKey, "")
+
+	resources := lo.Keys(permissions)
+	sort.Strings(resources)
+	for _, resource := range resources {
+		checked := []string{resource}
+		if resource == "*" {
+			checked = append(checked, authz.ExplicitResources()...)
+		}
+		for _, op := range permissions[resource] {
+			for _, checkedResource := range checked {
+				allowed, err := h.authZ.CheckPermission(ctx, checkedResource, op)
+				if err != nil {
+					if flterrors.IsClientAuthError(err) {
+						return domain.StatusBadRequest(err.Error())
+					}
+					return domain.StatusInternalServerError(fmt.Sprintf("failed to check permission: %v", err))
+				}
+				if !allowed {
+					return domain.StatusForbidden(fmt.Sprintf("cannot grant %s permission on %s without holding it", op, checkedResource))
+				}
+			}
+		}
+	}
+	return domain.StatusOK()
+}
+
+// (POST /api/v1/roles)
+func (h *TransportHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	var role apiv1alpha1.Role
+	if err := json.NewDecoder(r.Body).Decode(&role); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRole := h.converter.Role().ToDomain(role)
+	if status := h.checkCanGrant(r.Context(), authz.RulePermissions(domainRole.Spec.Rules)); status.Code != domain.StatusOK().Code {
+		h.SetResponse(w, nil, status)
+		return
+	}
+	body, status := h.serviceHandler.CreateRole(r.Context(), transport.OrgIDFromContext(r.Context()), domainRole)
+	apiResult := h.converter.Role().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/roles)
+func (h *TransportHandler) ListRoles(w http.ResponseWriter, r *http.Request, params apiv1alpha1.ListRolesParams) {
+	domainParams := h.converter.Role().ListParamsToDomain(params)
+	body, status := h.serviceHandler.ListRoles(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.Role().ListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/roles/{name})
+func (h *TransportHandler) GetRole(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetRole(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	apiResult := h.converter.Role().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (PUT /api/v1/roles/{name})
+func (h *TransportHandler) ReplaceRole(w http.ResponseWriter, r *http.Request, name string) {
+	var role apiv1alpha1.Role
+	if err := json.NewDecoder(r.Body).Decode(&role); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRole := h.converter.Role().ToDomain(role)
+	if status := h.checkCanGrant(r.Context(), authz.RulePermissions(domainRole.Spec.Rules)); status.Code != domain.StatusOK().Code {
+		h.SetResponse(w, nil, status)
+		return
+	}
+	body, status := h.serviceHandler.ReplaceRole(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainRole)
+	apiResult := h.converter.Role().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (DELETE /api/v1/roles/{name})
+func (h *TransportHandler) DeleteRole(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteRole(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	h.SetResponse(w, nil, status)
+}
@@ -0,0 +1,100 @@
+package transportv1alpha1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestRoleRequiresHoldingItsPermissions(t *testing.T) {
+	tests := []struct {
+		name         string
+		callerRole   string
+		rules        []apiv1alpha1.PolicyRule
+		expectedCode int
+	}{
+		{
+			name:         "role manager can grant what it holds",
+			callerRole:   "role-manager",
+			rules:        []apiv1alpha1.PolicyRule{{Resources: []string{"devices"}, Verbs: []string{"get", "list"}}},
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name:         "role manager cannot grant a verb it does not hold",
+			callerRole:   "role-manager",
+			rules:        []apiv1alpha1.PolicyRule{{Resources: []string{"devices"}, Verbs: []string{"get", "patch"}}},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "role manager cannot grant all verbs",
+			callerRole:   "role-manager",
+			rules:        []apiv1alpha1.PolicyRule{{Resources: []string{"devices"}, Verbs: []string{"*"}}},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "role manager cannot grant rolebindings",
+			callerRole:   "role-manager",
+			rules:        []apiv1alpha1.PolicyRule{{Resources: []string{"rolebindings"}, Verbs: []string{"create"}}},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "viewer cannot grant reading all resources, as it may not download images",
+			callerRole:   apiv1beta1.RoleViewer,
+			rules:        []apiv1alpha1.PolicyRule{{Resources: []string{"*"}, Verbs: []string{"get"}}},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "org admin can grant everything",
+			callerRole:   apiv1beta1.RoleOrgAdmin,
+			rules:        []apiv1alpha1.PolicyRule{{Resources: []string{"*"}, Verbs: []string{"*"}}},
+			expectedCode: http.StatusCreated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role := apiv1alpha1.Role{
+				ApiVersion: "v1alpha1",
+				Kind:       domain.RoleKind,
+				Metadata:   apiv1beta1.ObjectMeta{Name: lo.ToPtr("role")},
+				Spec:       apiv1alpha1.RoleSpec{Rules: tt.rules},
+			}
+
+			t.Run("create", func(t *testing.T) {
+				h, mockService := newTestRoleHandler(t)
+				if tt.expectedCode == http.StatusCreated {
+					mockService.EXPECT().CreateRole(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ uuid.UUID, role domain.Role) (*domain.Role, domain.Status) {
+							return &role, domain.StatusCreated()
+						})
+				}
+
+				w := httptest.NewRecorder()
+				h.CreateRole(w, newTestRoleRequest(t, tt.callerRole, http.MethodPost, "/api/v1/roles", role))
+				require.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+			})
+
+			t.Run("replace", func(t *testing.T) {
+				h, mockService := newTestRoleHandler(t)
+				if tt.expectedCode == http.StatusCreated {
+					mockService.EXPECT().ReplaceRole(gomock.Any(), gomock.Any(), "role", gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, role domain.Role) (*domain.Role, domain.Status) {
+							return &role, domain.StatusCreated()
+						})
+				}
+
+				w := httptest.NewRecorder()
+				h.ReplaceRole(w, newTestRoleRequest(t, tt.callerRole, http.MethodPut, "/api/v1/roles/role", role), "role")
+				require.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+			})
+		})
+	}
+}
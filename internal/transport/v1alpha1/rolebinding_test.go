@@ -0,0 +1,139 @@
+package transportv1alpha1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	convertv1alpha1 "github.com/flightctl/flightctl/internal/api/convert/v1alpha1"
+	"github.com/flightctl/flightctl/internal/auth/authz"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// testRoles are the custom Roles of the organization the role and role binding tests run in
+var testRoles = []domain.Role{
+	{
+		Metadata: apiv1beta1.ObjectMeta{Name: lo.ToPtr("binding-manager")},
+		Spec: domain.RoleSpec{Rules: []domain.PolicyRule{
+			{Resources: []string{"rolebindings"}, Verbs: []string{"create", "update"}},
+			{Resources: []string{"devices"}, Verbs: []string{"get", "list"}},
+		}},
+	},
+	{
+		Metadata: apiv1beta1.ObjectMeta{Name: lo.ToPtr("role-manager")},
+		Spec: domain.RoleSpec{Rules: []domain.PolicyRule{
+			{Resources: []string{"roles"}, Verbs: []string{"create", "update"}},
+			{Resources: []string{"devices"}, Verbs: []string{"get", "list"}},
+		}},
+	},
+	{
+		Metadata: apiv1beta1.ObjectMeta{Name: lo.ToPtr("device-reader")},
+		Spec:     domain.RoleSpec{Rules: []domain.PolicyRule{{Resources: []string{"devices"}, Verbs: []string{"get"}}}},
+	},
+	{
+		Metadata: apiv1beta1.ObjectMeta{Name: lo.ToPtr("device-admin")},
+		Spec:     domain.RoleSpec{Rules: []domain.PolicyRule{{Resources: []string{"devices"}, Verbs: []string{"*"}}}},
+	},
+}
+
+func newTestRoleHandler(t *testing.T) (*TransportHandler, *service.MockService) {
+	mockService := service.NewMockService(gomock.NewController(t))
+	mockService.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, name string) (*domain.Role, domain.Status) {
+			for _, role := range testRoles {
+				if *role.Metadata.Name == name {
+					return &role, domain.StatusOK()
+				}
+			}
+			return nil, domain.StatusResourceNotFound(domain.RoleKind, name)
+		}).AnyTimes()
+	roleStore := &fakeCustomRoleStore{roles: testRoles}
+	authZ := authz.NewStaticAuthZ(logrus.New(), authz.WithCustomRoles(authz.NewCustomRoles(roleStore, logrus.New())))
+	return NewTransportHandler(mockService, convertv1alpha1.NewConverter(), authZ), mockService
+}
+
+func newTestRoleRequest(t *testing.T, role string, method string, path string, resource any) *http.Request {
+	body, err := json.Marshal(resource)
+	require.NoError(t, err)
+
+	orgID := uuid.New()
+	org := &model.Organization{ID: orgID, ExternalID: "test-org"}
+	mappedIdentity := identity.NewMappedIdentity("testuser", "testuser", []*model.Organization{org}, map[string][]string{orgID.String(): {role}}, false, nil)
+	ctx := context.WithValue(context.Background(), consts.MappedIdentityCtxKey, mappedIdentity)
+	ctx = util.WithOrganizationID(ctx, orgID)
+	return httptest.NewRequest(method, path, bytes.NewReader(body)).WithContext(ctx)
+}
+
+func TestRoleBindingRequiresHoldingTheBoundPermissions(t *testing.T) {
+	tests := []struct {
+		name         string
+		callerRole   string
+		roleRef      string
+		expectedCode int
+	}{
+		{name: "binding manager cannot bind org-admin", callerRole: "binding-manager", roleRef: apiv1beta1.ExternalRoleOrgAdmin, expectedCode: http.StatusForbidden},
+		{name: "binding manager cannot bind operator", callerRole: "binding-manager", roleRef: apiv1beta1.ExternalRoleOperator, expectedCode: http.StatusForbidden},
+		{name: "binding manager cannot bind a more privileged role", callerRole: "binding-manager", roleRef: "device-admin", expectedCode: http.StatusForbidden},
+		{name: "binding manager can bind its own role", callerRole: "binding-manager", roleRef: "binding-manager", expectedCode: http.StatusCreated},
+		{name: "binding manager can bind a role it holds", callerRole: "binding-manager", roleRef: "device-reader", expectedCode: http.StatusCreated},
+		{name: "binding to a role that does not exist grants nothing", callerRole: "binding-manager", roleRef: "missing", expectedCode: http.StatusCreated},
+		{name: "org admin can bind operator", callerRole: apiv1beta1.RoleOrgAdmin, roleRef: apiv1beta1.ExternalRoleOperator, expectedCode: http.StatusCreated},
+		{name: "org admin can bind a custom role", callerRole: apiv1beta1.RoleOrgAdmin, roleRef: "device-admin", expectedCode: http.StatusCreated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binding := apiv1alpha1.RoleBinding{
+				ApiVersion: "v1alpha1",
+				Kind:       domain.RoleBindingKind,
+				Metadata:   apiv1beta1.ObjectMeta{Name: lo.ToPtr("binding")},
+				Spec: apiv1alpha1.RoleBindingSpec{
+					RoleRef:  tt.roleRef,
+					Subjects: []apiv1alpha1.RoleBindingSubject{{Kind: apiv1alpha1.RoleBindingSubjectKindUser, Name: "testuser"}},
+				},
+			}
+
+			t.Run("create", func(t *testing.T) {
+				h, mockService := newTestRoleHandler(t)
+				if tt.expectedCode == http.StatusCreated {
+					mockService.EXPECT().CreateRoleBinding(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ uuid.UUID, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+							return &binding, domain.StatusCreated()
+						})
+				}
+
+				w := httptest.NewRecorder()
+				h.CreateRoleBinding(w, newTestRoleRequest(t, tt.callerRole, http.MethodPost, "/api/v1/rolebindings", binding))
+				require.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+			})
+
+			t.Run("replace", func(t *testing.T) {
+				h, mockService := newTestRoleHandler(t)
+				if tt.expectedCode == http.StatusCreated {
+					mockService.EXPECT().ReplaceRoleBinding(gomock.Any(), gomock.Any(), "binding", gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, binding domain.RoleBinding) (*domain.RoleBinding, domain.Status) {
+							return &binding, domain.StatusCreated()
+						})
+				}
+
+				w := httptest.NewRecorder()
+				h.ReplaceRoleBinding(w, newTestRoleRequest(t, tt.callerRole, http.MethodPut, "/api/v1/rolebindings/binding", binding), "binding")
+				require.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+			})
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package transportv1beta1
+
+import (
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (GET /api/v1/carotation)
+func (h *TransportHandler) GetCARotationStatus(w http.ResponseWriter, r *http.Request) {
+	body, status := h.serviceHandler.GetCARotationStatus(r.Context(), transport.OrgIDFromContext(r.Context()))
+	apiResult := h.converter.CertificateSigningRequest().CARotationStatusFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/carotation/devices)
+func (h *TransportHandler) ListCARotationDevices(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListCARotationDevicesParams) {
+	domainParams := h.converter.CertificateSigningRequest().CARotationDeviceListParamsToDomain(params)
+	body, status := h.serviceHandler.ListCARotationDevices(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.CertificateSigningRequest().CARotationDeviceListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
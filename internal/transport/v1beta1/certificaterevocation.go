@@ -0,0 +1,36 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (POST /api/v1/revokedcertificates)
+func (h *TransportHandler) RevokeCertificates(w http.ResponseWriter, r *http.Request) {
+	var request apiv1beta1.CertificateRevocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRequest := h.converter.CertificateSigningRequest().RevocationRequestToDomain(request)
+	body, status := h.serviceHandler.RevokeCertificates(r.Context(), transport.OrgIDFromContext(r.Context()), domainRequest)
+	apiResult := h.converter.CertificateSigningRequest().RevocationResponseFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (GET /api/v1/revokedcertificates/crl)
+func (h *TransportHandler) GetCertificateRevocationList(w http.ResponseWriter, r *http.Request, params apiv1beta1.GetCertificateRevocationListParams) {
+	domainParams := h.converter.CertificateSigningRequest().RevocationListParamsToDomain(params)
+	crl, status := h.serviceHandler.GetCertificateRevocationList(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	if status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pkix-crl")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(crl)
+}
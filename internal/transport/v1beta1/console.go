@@ -2,8 +2,11 @@ package transportv1beta1
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
@@ -26,14 +29,38 @@ func (h *WebsocketHandler) injectProtocolsToMetadata(metadataStr string, protoco
 	return string(b), nil
 }
 
+// parseDeviceLogsRequest builds the logs request of a device logs session from the query parameters.
+func parseDeviceLogsRequest(query url.Values) (*api.DeviceLogsRequest, error) {
+	request := &api.DeviceLogsRequest{
+		Units:       query[api.DeviceQueryLogsUnit],
+		Application: query.Get(api.DeviceQueryLogsApplication),
+		Since:       query.Get(api.DeviceQueryLogsSince),
+	}
+	if follow := query.Get(api.DeviceQueryLogsFollow); follow != "" {
+		value, err := strconv.ParseBool(follow)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s parameter: %s", api.DeviceQueryLogsFollow, follow)
+		}
+		request.Follow = value
+	}
+	if tail := query.Get(api.DeviceQueryLogsTail); tail != "" {
+		value, err := strconv.ParseInt(tail, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s parameter: %s", api.DeviceQueryLogsTail, tail)
+		}
+		request.Tail = &value
+	}
+	if errs := request.Validate(); len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return request, nil
+}
+
 func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Request) {
 	deviceName := chi.URLParam(r, "name")
 
 	h.log.Infof("websocket console connection requested for device: %s", deviceName)
 
-	// Extract organization ID from context
-	orgId := transport.OrgIDFromContext(r.Context())
-
 	// Extract metadata
 	metadata, err := h.injectProtocolsToMetadata(r.URL.Query().Get(api.DeviceQueryConsoleSessionMetadata),
 		websocket.Subprotocols(r))
@@ -42,6 +69,38 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 		http.Error(w, "protocols injection error", http.StatusInternalServerError)
 		return
 	}
+	h.serveDeviceSession(w, r, deviceName, metadata)
+}
+
+// HandleDeviceLogs streams the logs of a device. The session metadata is built by the service so that
+// the session can only read logs and never run a command on the device.
+func (h *WebsocketHandler) HandleDeviceLogs(w http.ResponseWriter, r *http.Request) {
+	deviceName := chi.URLParam(r, "name")
+
+	h.log.Infof("websocket logs connection requested for device: %s", deviceName)
+
+	logsRequest, err := parseDeviceLogsRequest(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	b, err := json.Marshal(&api.DeviceConsoleSessionMetadata{
+		Logs:      logsRequest,
+		Protocols: websocket.Subprotocols(r),
+	})
+	if err != nil {
+		h.log.Errorf("failed marshalling logs session metadata for device %s: %v", deviceName, err)
+		http.Error(w, "session metadata error", http.StatusInternalServerError)
+		return
+	}
+	h.serveDeviceSession(w, r, deviceName, string(b))
+}
+
+// serveDeviceSession starts a session on the device and connects it to the websocket of the request.
+func (h *WebsocketHandler) serveDeviceSession(w http.ResponseWriter, r *http.Request, deviceName, metadata string) {
+	// Extract organization ID from context
+	orgId := transport.OrgIDFromContext(r.Context())
+
 	consoleSession, status := h.consoleSessionManager.StartSession(r.Context(), orgId, deviceName, metadata)
 	if status.Code != http.StatusOK {
 		http.Error(w, status.Message, int(status.Code))
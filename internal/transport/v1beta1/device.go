@@ -1,15 +1,20 @@
 package transportv1beta1
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 // (POST /api/v1/devices)
-func (h *TransportHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
+func (h *TransportHandler) CreateDevice(w http.ResponseWriter, r *http.Request, params apiv1beta1.CreateDeviceParams) {
 	var device apiv1beta1.Device
 	if err := json.NewDecoder(r.Body).Decode(&device); err != nil {
 		h.SetParseFailureResponse(w, err)
@@ -17,19 +22,57 @@ func (h *TransportHandler) CreateDevice(w http.ResponseWriter, r *http.Request)
 	}
 
 	domainDevice := h.converter.Device().ToDomain(device)
-	body, status := h.serviceHandler.CreateDevice(r.Context(), transport.OrgIDFromContext(r.Context()), domainDevice)
+	ctx := transport.WithDryRun(r.Context(), params.DryRun)
+	body, status := h.serviceHandler.CreateDevice(ctx, transport.OrgIDFromContext(ctx), domainDevice)
 	apiResult := h.converter.Device().FromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
 
 // (GET /api/v1/devices)
 func (h *TransportHandler) ListDevices(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListDevicesParams) {
+	if lo.FromPtr(params.Watch) {
+		h.watch(w, r, watchRequest{
+			kind:            apiv1beta1.DeviceKind,
+			apiVersion:      fmt.Sprintf("%s/%s", apiv1beta1.APIGroup, apiv1beta1.DeviceAPIVersion),
+			resourceVersion: params.ResourceVersion,
+			list:            h.watchDevices(params),
+		})
+		return
+	}
 	domainParams := h.converter.Device().ListParamsToDomain(params)
 	body, status := h.serviceHandler.ListDevices(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams, nil)
 	apiResult := h.converter.Device().ListFromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
 
+// (GET /api/v1/devices/aggregate)
+func (h *TransportHandler) AggregateDevices(w http.ResponseWriter, r *http.Request, params apiv1beta1.AggregateDevicesParams) {
+	domainParams := h.converter.Device().AggregateParamsToDomain(params)
+	body, status := h.serviceHandler.AggregateDevices(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
+	apiResult := h.converter.Device().AggregateFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// watchDevices lists the devices matching the selectors of a watch request.
+func (h *TransportHandler) watchDevices(params apiv1beta1.ListDevicesParams) watchListFunc {
+	return func(ctx context.Context, orgId uuid.UUID, names []string, limit int32, cont *string) ([]watchObject, *string, domain.Status) {
+		body, status := h.serviceHandler.ListDevices(ctx, orgId, domain.ListDevicesParams{
+			LabelSelector: params.LabelSelector,
+			FieldSelector: watchFieldSelector(params.FieldSelector, names),
+			Limit:         lo.ToPtr(limit),
+			Continue:      cont,
+		}, nil)
+		if status.Code != http.StatusOK {
+			return nil, nil, status
+		}
+		apiResult := h.converter.Device().ListFromDomain(body)
+		objects := lo.Map(apiResult.Items, func(d apiv1beta1.Device, _ int) watchObject {
+			return watchObject{name: lo.FromPtr(d.Metadata.Name), object: d}
+		})
+		return objects, apiResult.Metadata.Continue, status
+	}
+}
+
 // (GET /api/v1/devices/{name})
 func (h *TransportHandler) GetDevice(w http.ResponseWriter, r *http.Request, name string) {
 	body, status := h.serviceHandler.GetDevice(r.Context(), transport.OrgIDFromContext(r.Context()), name)
@@ -38,7 +81,7 @@ func (h *TransportHandler) GetDevice(w http.ResponseWriter, r *http.Request, nam
 }
 
 // (PUT /api/v1/devices/{name})
-func (h *TransportHandler) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string, params apiv1beta1.ReplaceDeviceParams) {
 	var device apiv1beta1.Device
 	if err := json.NewDecoder(r.Body).Decode(&device); err != nil {
 		h.SetParseFailureResponse(w, err)
@@ -46,7 +89,8 @@ func (h *TransportHandler) ReplaceDevice(w http.ResponseWriter, r *http.Request,
 	}
 
 	domainDevice := h.converter.Device().ToDomain(device)
-	body, status := h.serviceHandler.ReplaceDevice(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainDevice, nil)
+	ctx := transport.WithDryRun(r.Context(), params.DryRun)
+	body, status := h.serviceHandler.ReplaceDevice(ctx, transport.OrgIDFromContext(ctx), name, domainDevice, nil)
 	apiResult := h.converter.Device().FromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
@@ -94,7 +138,7 @@ func (h *TransportHandler) GetRenderedDevice(w http.ResponseWriter, r *http.Requ
 }
 
 // (PATCH /api/v1/devices/{name})
-func (h *TransportHandler) PatchDevice(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) PatchDevice(w http.ResponseWriter, r *http.Request, name string, params apiv1beta1.PatchDeviceParams) {
 	var patch apiv1beta1.PatchRequest
 	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
 		h.SetParseFailureResponse(w, err)
@@ -102,7 +146,8 @@ func (h *TransportHandler) PatchDevice(w http.ResponseWriter, r *http.Request, n
 	}
 
 	domainPatch := h.converter.Common().PatchRequestToDomain(patch)
-	body, status := h.serviceHandler.PatchDevice(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPatch)
+	ctx := transport.WithDryRun(r.Context(), params.DryRun)
+	body, status := h.serviceHandler.PatchDevice(ctx, transport.OrgIDFromContext(ctx), name, domainPatch)
 	apiResult := h.converter.Device().FromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
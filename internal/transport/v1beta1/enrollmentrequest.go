@@ -1,11 +1,16 @@
 package transportv1beta1
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 // (POST /api/v1/enrollmentrequests)
@@ -24,12 +29,41 @@ func (h *TransportHandler) CreateEnrollmentRequest(w http.ResponseWriter, r *htt
 
 // (GET /api/v1/enrollmentrequests)
 func (h *TransportHandler) ListEnrollmentRequests(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListEnrollmentRequestsParams) {
+	if lo.FromPtr(params.Watch) {
+		h.watch(w, r, watchRequest{
+			kind:            apiv1beta1.EnrollmentRequestKind,
+			apiVersion:      fmt.Sprintf("%s/%s", apiv1beta1.APIGroup, apiv1beta1.EnrollmentRequestAPIVersion),
+			resourceVersion: params.ResourceVersion,
+			list:            h.watchEnrollmentRequests(params),
+		})
+		return
+	}
 	domainParams := h.converter.EnrollmentRequest().ListParamsToDomain(params)
 	body, status := h.serviceHandler.ListEnrollmentRequests(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
 	apiResult := h.converter.EnrollmentRequest().ListFromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
 
+// watchEnrollmentRequests lists the enrollment requests matching the selectors of a watch request.
+func (h *TransportHandler) watchEnrollmentRequests(params apiv1beta1.ListEnrollmentRequestsParams) watchListFunc {
+	return func(ctx context.Context, orgId uuid.UUID, names []string, limit int32, cont *string) ([]watchObject, *string, domain.Status) {
+		body, status := h.serviceHandler.ListEnrollmentRequests(ctx, orgId, domain.ListEnrollmentRequestsParams{
+			LabelSelector: params.LabelSelector,
+			FieldSelector: watchFieldSelector(params.FieldSelector, names),
+			Limit:         lo.ToPtr(limit),
+			Continue:      cont,
+		})
+		if status.Code != http.StatusOK {
+			return nil, nil, status
+		}
+		apiResult := h.converter.EnrollmentRequest().ListFromDomain(body)
+		objects := lo.Map(apiResult.Items, func(er apiv1beta1.EnrollmentRequest, _ int) watchObject {
+			return watchObject{name: lo.FromPtr(er.Metadata.Name), object: er}
+		})
+		return objects, apiResult.Metadata.Continue, status
+	}
+}
+
 // (GET /api/v1/enrollmentrequests/{name})
 func (h *TransportHandler) GetEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string) {
 	body, status := h.serviceHandler.GetEnrollmentRequest(r.Context(), transport.OrgIDFromContext(r.Context()), name)
@@ -5,10 +5,19 @@ import (
 
 	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/samber/lo"
 )
 
 // (GET /api/v1/events)
 func (h *TransportHandler) ListEvents(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListEventsParams) {
+	if lo.FromPtr(params.Watch) {
+		h.watch(w, r, watchRequest{
+			kind:               apiv1beta1.EventKind,
+			resourceVersion:    params.ResourceVersion,
+			eventFieldSelector: params.FieldSelector,
+		})
+		return
+	}
 	domainParams := h.converter.Event().ListParamsToDomain(params)
 	body, status := h.serviceHandler.ListEvents(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
 	apiResult := h.converter.Event().ListFromDomain(body)
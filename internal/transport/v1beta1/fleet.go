@@ -1,15 +1,20 @@
 package transportv1beta1
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 // (POST /api/v1/fleets)
-func (h *TransportHandler) CreateFleet(w http.ResponseWriter, r *http.Request) {
+func (h *TransportHandler) CreateFleet(w http.ResponseWriter, r *http.Request, params apiv1beta1.CreateFleetParams) {
 	var fleet apiv1beta1.Fleet
 	if err := json.NewDecoder(r.Body).Decode(&fleet); err != nil {
 		h.SetParseFailureResponse(w, err)
@@ -17,19 +22,50 @@ func (h *TransportHandler) CreateFleet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	domainFleet := h.converter.Fleet().ToDomain(fleet)
-	body, status := h.serviceHandler.CreateFleet(r.Context(), transport.OrgIDFromContext(r.Context()), domainFleet)
+	ctx := transport.WithDryRun(r.Context(), params.DryRun)
+	body, status := h.serviceHandler.CreateFleet(ctx, transport.OrgIDFromContext(ctx), domainFleet)
 	apiResult := h.converter.Fleet().FromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
 
 // (GET /api/v1/fleets)
 func (h *TransportHandler) ListFleets(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListFleetsParams) {
+	if lo.FromPtr(params.Watch) {
+		h.watch(w, r, watchRequest{
+			kind:            apiv1beta1.FleetKind,
+			apiVersion:      fmt.Sprintf("%s/%s", apiv1beta1.APIGroup, apiv1beta1.FleetAPIVersion),
+			resourceVersion: params.ResourceVersion,
+			list:            h.watchFleets(params),
+		})
+		return
+	}
 	domainParams := h.converter.Fleet().ListParamsToDomain(params)
 	body, status := h.serviceHandler.ListFleets(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
 	apiResult := h.converter.Fleet().ListFromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
 
+// watchFleets lists the fleets matching the selectors of a watch request.
+func (h *TransportHandler) watchFleets(params apiv1beta1.ListFleetsParams) watchListFunc {
+	return func(ctx context.Context, orgId uuid.UUID, names []string, limit int32, cont *string) ([]watchObject, *string, domain.Status) {
+		body, status := h.serviceHandler.ListFleets(ctx, orgId, domain.ListFleetsParams{
+			LabelSelector:     params.LabelSelector,
+			FieldSelector:     watchFieldSelector(params.FieldSelector, names),
+			Limit:             lo.ToPtr(limit),
+			Continue:          cont,
+			AddDevicesSummary: params.AddDevicesSummary,
+		})
+		if status.Code != http.StatusOK {
+			return nil, nil, status
+		}
+		apiResult := h.converter.Fleet().ListFromDomain(body)
+		objects := lo.Map(apiResult.Items, func(f apiv1beta1.Fleet, _ int) watchObject {
+			return watchObject{name: lo.FromPtr(f.Metadata.Name), object: f}
+		})
+		return objects, apiResult.Metadata.Continue, status
+	}
+}
+
 // (GET /api/v1/fleets/{name})
 func (h *TransportHandler) GetFleet(w http.ResponseWriter, r *http.Request, name string, params apiv1beta1.GetFleetParams) {
 	domainParams := h.converter.Fleet().GetParamsToDomain(params)
@@ -39,7 +75,7 @@ func (h *TransportHandler) GetFleet(w http.ResponseWriter, r *http.Request, name
 }
 
 // (PUT /api/v1/fleets/{name})
-func (h *TransportHandler) ReplaceFleet(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) ReplaceFleet(w http.ResponseWriter, r *http.Request, name string, params apiv1beta1.ReplaceFleetParams) {
 	var fleet apiv1beta1.Fleet
 	if err := json.NewDecoder(r.Body).Decode(&fleet); err != nil {
 		h.SetParseFailureResponse(w, err)
@@ -47,7 +83,8 @@ func (h *TransportHandler) ReplaceFleet(w http.ResponseWriter, r *http.Request,
 	}
 
 	domainFleet := h.converter.Fleet().ToDomain(fleet)
-	body, status := h.serviceHandler.ReplaceFleet(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainFleet)
+	ctx := transport.WithDryRun(r.Context(), params.DryRun)
+	body, status := h.serviceHandler.ReplaceFleet(ctx, transport.OrgIDFromContext(ctx), name, domainFleet)
 	apiResult := h.converter.Fleet().FromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
@@ -80,7 +117,7 @@ func (h *TransportHandler) ReplaceFleetStatus(w http.ResponseWriter, r *http.Req
 }
 
 // (PATCH /api/v1/fleets/{name})
-func (h *TransportHandler) PatchFleet(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) PatchFleet(w http.ResponseWriter, r *http.Request, name string, params apiv1beta1.PatchFleetParams) {
 	var patch apiv1beta1.PatchRequest
 	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
 		h.SetParseFailureResponse(w, err)
@@ -88,7 +125,22 @@ func (h *TransportHandler) PatchFleet(w http.ResponseWriter, r *http.Request, na
 	}
 
 	domainPatch := h.converter.Common().PatchRequestToDomain(patch)
-	body, status := h.serviceHandler.PatchFleet(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPatch)
+	ctx := transport.WithDryRun(r.Context(), params.DryRun)
+	body, status := h.serviceHandler.PatchFleet(ctx, transport.OrgIDFromContext(ctx), name, domainPatch)
+	apiResult := h.converter.Fleet().FromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
+// (POST /api/v1/fleets/{name}/rollback)
+func (h *TransportHandler) RollbackFleet(w http.ResponseWriter, r *http.Request, name string) {
+	var request apiv1beta1.FleetRollbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainRequest := h.converter.Fleet().RollbackRequestToDomain(request)
+	body, status := h.serviceHandler.RollbackFleet(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainRequest)
 	apiResult := h.converter.Fleet().FromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
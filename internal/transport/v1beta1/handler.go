@@ -19,6 +19,7 @@ type TransportHandler struct {
 	authTokenProxy    *service.AuthTokenProxy
 	authUserInfoProxy *service.AuthUserInfoProxy
 	authZ             auth.AuthZMiddleware
+	watchFeed         *watchFeed
 }
 
 type WebsocketHandler struct {
@@ -38,6 +39,7 @@ func NewTransportHandler(serviceHandler service.Service, converter convertv1beta
 		authTokenProxy:    authTokenProxy,
 		authUserInfoProxy: authUserInfoProxy,
 		authZ:             authZ,
+		watchFeed:         newWatchFeed(serviceHandler, watchPollInterval),
 	}
 }
 
@@ -52,4 +54,6 @@ func NewWebsocketHandler(ca *crypto.CAClient, log logrus.FieldLogger, consoleSes
 func (h *WebsocketHandler) RegisterRoutes(r chi.Router) {
 	// Websocket handler for console
 	r.Get("/ws/v1/devices/{name}/console", h.HandleDeviceConsole)
+	// Websocket handler for device logs
+	r.Get("/ws/v1/devices/{name}/logs", h.HandleDeviceLogs)
 }
@@ -0,0 +1,517 @@
+package transportv1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	watchContentType = "application/x-ndjson"
+	// watchPollInterval is how often the events of a watched organization are read
+	watchPollInterval     = 2 * time.Second
+	watchBookmarkInterval = 30 * time.Second
+	// watchEventOverlap is how far behind the last seen event each poll starts reading, so that
+	// events committed out of order are not missed. Events that were already seen are skipped.
+	watchEventOverlap  = 5 * time.Second
+	watchWriteTimeout  = time.Minute
+	watchPageLimit     = int32(1000)
+	watchNameBatchSize = 100
+)
+
+// watchObject is an API resource streamed by a watch.
+type watchObject struct {
+	name   string
+	object any
+}
+
+// watchListFunc lists the resources matching the selectors of a watch request. If names is not
+// nil, only the resources with the given names are listed.
+type watchListFunc func(ctx context.Context, orgId uuid.UUID, names []string, limit int32, cont *string) ([]watchObject, *string, domain.Status)
+
+// watchRequest describes what a watch streams. Resource watches set list and are fed by the events
+// involving resources of the kind. Event watches leave list nil and stream the events themselves.
+type watchRequest struct {
+	kind               string
+	apiVersion         string
+	resourceVersion    *string
+	list               watchListFunc
+	eventFieldSelector *string
+}
+
+type watchStream struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	enc     *json.Encoder
+	started bool
+}
+
+func newWatchStream(w http.ResponseWriter) *watchStream {
+	return &watchStream{
+		w:   w,
+		rc:  http.NewResponseController(w),
+		enc: json.NewEncoder(w),
+	}
+}
+
+func (s *watchStream) start() {
+	s.w.Header().Set("Content-Type", watchContentType)
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.WriteHeader(http.StatusOK)
+	s.started = true
+}
+
+func (s *watchStream) send(event apiv1beta1.WatchEvent) error {
+	// The stream outlives the server's write timeout, so the deadline is extended on every write
+	if err := s.rc.SetWriteDeadline(time.Now().Add(watchWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if err := s.enc.Encode(event); err != nil {
+		return err
+	}
+	if err := s.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+func (s *watchStream) bookmark(cursor time.Time) error {
+	return s.send(apiv1beta1.WatchEvent{
+		Type:            apiv1beta1.BOOKMARK,
+		ResourceVersion: lo.ToPtr(cursor.UTC().Format(time.RFC3339Nano)),
+	})
+}
+
+// watcher tracks the position of a watch in the stream of events.
+type watcher struct {
+	h      *TransportHandler
+	req    watchRequest
+	orgId  uuid.UUID
+	stream *watchStream
+	cursor time.Time
+	// seen holds the events streamed within the overlap window, by name
+	seen map[string]time.Time
+	// visible holds whether the resources known to a resource watch currently match its
+	// selectors, by name. If complete, resources missing from the map don't match.
+	visible  map[string]bool
+	complete bool
+}
+
+// watch streams the changes described by the request until the client disconnects. Errors
+// before the stream started are returned as a regular response, later ones as an ERROR event.
+func (h *TransportHandler) watch(w http.ResponseWriter, r *http.Request, req watchRequest) {
+	ctx := r.Context()
+	wt := &watcher{
+		h:       h,
+		req:     req,
+		orgId:   transport.OrgIDFromContext(ctx),
+		stream:  newWatchStream(w),
+		seen:    map[string]time.Time{},
+		visible: map[string]bool{},
+	}
+
+	status := wt.init(ctx)
+	if status.Code != http.StatusOK {
+		if wt.stream.started {
+			_ = wt.stream.send(apiv1beta1.WatchEvent{Type: apiv1beta1.ERROR, Status: lo.ToPtr(h.converter.Common().StatusFromDomain(status))})
+			return
+		}
+		h.SetResponse(w, nil, status)
+		return
+	}
+
+	if err := wt.stream.bookmark(wt.cursor); err != nil {
+		return
+	}
+
+	// subscribe before catching up, so that no event falls between the two
+	sub := h.watchFeed.subscribe(ctx, wt.orgId, wt.cursor)
+	defer func() {
+		if sub != nil {
+			h.watchFeed.unsubscribe(sub)
+		}
+	}()
+	changed, status := wt.poll(ctx)
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	lastBookmark := time.Now()
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code != http.StatusOK {
+			_ = wt.stream.send(apiv1beta1.WatchEvent{Type: apiv1beta1.ERROR, Status: lo.ToPtr(h.converter.Common().StatusFromDomain(status))})
+			return
+		}
+		if changed || time.Since(lastBookmark) >= watchBookmarkInterval {
+			if err := wt.stream.bookmark(wt.cursor); err != nil {
+				return
+			}
+			lastBookmark = time.Now()
+		}
+
+		var events <-chan []domain.Event
+		if sub != nil {
+			events = sub.events
+		}
+		changed = false
+		select {
+		case <-ctx.Done():
+			return
+		case batch, ok := <-events:
+			if !ok {
+				// dropped by the feed, catch up on the next tick
+				h.watchFeed.unsubscribe(sub)
+				sub = nil
+				continue
+			}
+			changed, status = wt.process(ctx, batch, false)
+		case <-ticker.C:
+			if sub == nil {
+				sub = h.watchFeed.subscribe(ctx, wt.orgId, wt.cursor)
+				changed, status = wt.poll(ctx)
+			}
+		}
+	}
+}
+
+// init validates the request, determines where the watch starts and, for resource watches
+// without a resourceVersion, streams the current resources as ADDED events.
+func (wt *watcher) init(ctx context.Context) domain.Status {
+	if rv := lo.FromPtr(wt.req.resourceVersion); rv != "" {
+		cursor, err := time.Parse(time.RFC3339Nano, rv)
+		if err != nil {
+			return domain.StatusBadRequest(fmt.Sprintf("invalid resourceVersion %q", rv))
+		}
+		wt.cursor = cursor
+		// validate the selectors before streaming
+		if status := wt.validate(ctx); status.Code != http.StatusOK {
+			return status
+		}
+		wt.stream.start()
+		return domain.StatusOK()
+	}
+
+	now, status := wt.h.serviceHandler.GetDatabaseTime(ctx)
+	if status.Code != http.StatusOK {
+		return status
+	}
+	wt.cursor = now
+
+	if wt.req.list == nil {
+		if status := wt.validate(ctx); status.Code != http.StatusOK {
+			return status
+		}
+		wt.stream.start()
+		return domain.StatusOK()
+	}
+
+	var cont *string
+	for {
+		objects, next, status := wt.req.list(ctx, wt.orgId, nil, watchPageLimit, cont)
+		if status.Code != http.StatusOK {
+			return status
+		}
+		if !wt.stream.started {
+			wt.stream.start()
+		}
+		for _, o := range objects {
+			if err := wt.stream.send(apiv1beta1.WatchEvent{Type: apiv1beta1.ADDED, Object: o.object}); err != nil {
+				return domain.StatusOK()
+			}
+			wt.visible[o.name] = true
+		}
+		if next == nil {
+			wt.complete = true
+			return domain.StatusOK()
+		}
+		cont = next
+	}
+}
+
+func (wt *watcher) validate(ctx context.Context) domain.Status {
+	if wt.req.list != nil {
+		_, _, status := wt.req.list(ctx, wt.orgId, nil, 1, nil)
+		return status
+	}
+	_, status := wt.h.serviceHandler.ListEvents(ctx, wt.orgId, domain.ListEventsParams{
+		FieldSelector: wt.req.eventFieldSelector,
+		Limit:         lo.ToPtr(int32(1)),
+	})
+	return status
+}
+
+// poll catches up with the events since the cursor by listing them, and streams the changes.
+func (wt *watcher) poll(ctx context.Context) (bool, domain.Status) {
+	selectors := []string{fmt.Sprintf("metadata.creationTimestamp>%s", wt.cursor.Add(-watchEventOverlap).UTC().Format(time.RFC3339Nano))}
+	if wt.req.list != nil {
+		selectors = append(selectors, fmt.Sprintf("involvedObject.kind=%s", wt.req.kind))
+	} else if s := lo.FromPtr(wt.req.eventFieldSelector); s != "" {
+		selectors = append(selectors, s)
+	}
+	params := domain.ListEventsParams{
+		Order:         lo.ToPtr(domain.Asc),
+		FieldSelector: lo.ToPtr(strings.Join(selectors, ",")),
+		Limit:         lo.ToPtr(watchPageLimit),
+	}
+
+	var events []domain.Event
+	for {
+		page, status := wt.h.serviceHandler.ListEvents(ctx, wt.orgId, params)
+		if status.Code != http.StatusOK {
+			return false, status
+		}
+		events = append(events, page.Items...)
+		if page.Metadata.Continue == nil {
+			break
+		}
+		params.Continue = page.Metadata.Continue
+	}
+	return wt.process(ctx, events, true)
+}
+
+// process streams the changes signaled by the events, oldest first, and returns whether the
+// position of the watch moved. Events that were already streamed or that are older than the
+// overlap window are skipped. If filtered is false, the events of an event watch were not
+// filtered by its field selector yet.
+func (wt *watcher) process(ctx context.Context, events []domain.Event, filtered bool) (bool, domain.Status) {
+	cursor := wt.cursor
+	var fresh []domain.Event
+	for _, ev := range events {
+		name := lo.FromPtr(ev.Metadata.Name)
+		timestamp := lo.FromPtr(ev.Metadata.CreationTimestamp)
+		if !timestamp.After(wt.cursor.Add(-watchEventOverlap)) {
+			continue
+		}
+		if _, seen := wt.seen[name]; seen {
+			continue
+		}
+		wt.seen[name] = timestamp
+		if timestamp.After(cursor) {
+			cursor = timestamp
+		}
+		fresh = append(fresh, ev)
+	}
+
+	streamed := false
+	var status domain.Status
+	if wt.req.list == nil {
+		streamed, status = wt.streamEvents(ctx, fresh, filtered)
+	} else {
+		streamed, status = wt.streamResourceEvents(ctx, fresh)
+	}
+	if status.Code != http.StatusOK {
+		return streamed, status
+	}
+
+	for name, timestamp := range wt.seen {
+		if timestamp.Before(cursor.Add(-watchEventOverlap)) {
+			delete(wt.seen, name)
+		}
+	}
+	advanced := cursor.After(wt.cursor)
+	wt.cursor = cursor
+	return streamed || advanced, domain.StatusOK()
+}
+
+// streamEvents streams the events matching the field selector of an event watch.
+func (wt *watcher) streamEvents(ctx context.Context, events []domain.Event, filtered bool) (bool, domain.Status) {
+	if !filtered && lo.FromPtr(wt.req.eventFieldSelector) != "" && len(events) > 0 {
+		var status domain.Status
+		if events, status = wt.matchingEvents(ctx, events); status.Code != http.StatusOK {
+			return false, status
+		}
+	}
+
+	sent := false
+	apiEvents := wt.h.converter.Event().ListFromDomain(&domain.EventList{Items: events})
+	for _, ev := range apiEvents.Items {
+		if err := wt.stream.send(apiv1beta1.WatchEvent{Type: apiv1beta1.ADDED, Object: ev}); err != nil {
+			return sent, domain.StatusOK()
+		}
+		sent = true
+	}
+	return sent, domain.StatusOK()
+}
+
+// matchingEvents returns the events that match the field selector of an event watch, in their
+// original order.
+func (wt *watcher) matchingEvents(ctx context.Context, events []domain.Event) ([]domain.Event, domain.Status) {
+	names := lo.Map(events, func(ev domain.Event, _ int) string { return lo.FromPtr(ev.Metadata.Name) })
+	matching := map[string]struct{}{}
+	for _, batch := range lo.Chunk(names, watchNameBatchSize) {
+		params := domain.ListEventsParams{
+			FieldSelector: watchFieldSelector(wt.req.eventFieldSelector, batch),
+			Limit:         lo.ToPtr(watchPageLimit),
+		}
+		for {
+			page, status := wt.h.serviceHandler.ListEvents(ctx, wt.orgId, params)
+			if status.Code != http.StatusOK {
+				return nil, status
+			}
+			for _, ev := range page.Items {
+				matching[lo.FromPtr(ev.Metadata.Name)] = struct{}{}
+			}
+			if page.Metadata.Continue == nil {
+				break
+			}
+			params.Continue = page.Metadata.Continue
+		}
+	}
+	return lo.Filter(events, func(ev domain.Event, _ int) bool {
+		_, ok := matching[lo.FromPtr(ev.Metadata.Name)]
+		return ok
+	}), domain.StatusOK()
+}
+
+// streamResourceEvents streams the changes of the resources of the watched kind signaled by the
+// events, collapsing multiple changes of a resource into one.
+func (wt *watcher) streamResourceEvents(ctx context.Context, events []domain.Event) (bool, domain.Status) {
+	changes := map[string]apiv1beta1.WatchEventType{}
+	var order []string
+	for _, ev := range events {
+		if ev.InvolvedObject.Kind != wt.req.kind {
+			continue
+		}
+		eventType, ok := watchEventTypeForReason(ev.Reason)
+		if !ok {
+			continue
+		}
+		objectName := ev.InvolvedObject.Name
+		previous, exists := changes[objectName]
+		if !exists {
+			order = append(order, objectName)
+		}
+		changes[objectName] = mergeWatchEventTypes(previous, exists, eventType)
+	}
+	if len(order) == 0 {
+		return false, domain.StatusOK()
+	}
+	return wt.streamChanges(ctx, order, changes)
+}
+
+// streamChanges streams the current state of the changed resources in the order they changed.
+// Resources that start matching the selectors of the watch are streamed as ADDED, and resources
+// that stop matching them as DELETED.
+func (wt *watcher) streamChanges(ctx context.Context, order []string, changes map[string]apiv1beta1.WatchEventType) (bool, domain.Status) {
+	names := lo.Filter(order, func(name string, _ int) bool { return changes[name] != apiv1beta1.DELETED })
+	objects := make(map[string]any, len(names))
+	for _, batch := range lo.Chunk(names, watchNameBatchSize) {
+		var cont *string
+		for {
+			items, next, status := wt.req.list(ctx, wt.orgId, batch, watchPageLimit, cont)
+			if status.Code != http.StatusOK {
+				return false, status
+			}
+			for _, o := range items {
+				objects[o.name] = o.object
+			}
+			if next == nil {
+				break
+			}
+			cont = next
+		}
+	}
+
+	sent := false
+	for _, name := range order {
+		visible, known := wt.isVisible(name)
+		event := apiv1beta1.WatchEvent{Type: changes[name]}
+		object, matches := objects[name]
+		switch {
+		case matches:
+			if known && !visible {
+				event.Type = apiv1beta1.ADDED
+			}
+			event.Object = object
+			wt.visible[name] = true
+		case known && !visible:
+			// neither matched before nor matches now
+			continue
+		default:
+			// deleted, or no longer matching the selectors
+			event.Type = apiv1beta1.DELETED
+			event.Object = deletedWatchObject{
+				ApiVersion: wt.req.apiVersion,
+				Kind:       wt.req.kind,
+				Metadata:   apiv1beta1.ObjectMeta{Name: lo.ToPtr(name)},
+			}
+			wt.setInvisible(name)
+		}
+		if err := wt.stream.send(event); err != nil {
+			return sent, domain.StatusOK()
+		}
+		sent = true
+	}
+	return sent, domain.StatusOK()
+}
+
+// isVisible returns whether the resource matched the selectors of the watch the last time it was
+// streamed, and whether that is known.
+func (wt *watcher) isVisible(name string) (bool, bool) {
+	visible, ok := wt.visible[name]
+	return visible, ok || wt.complete
+}
+
+func (wt *watcher) setInvisible(name string) {
+	if wt.complete {
+		delete(wt.visible, name)
+		return
+	}
+	wt.visible[name] = false
+}
+
+type deletedWatchObject struct {
+	ApiVersion string                `json:"apiVersion"`
+	Kind       string                `json:"kind"`
+	Metadata   apiv1beta1.ObjectMeta `json:"metadata"`
+}
+
+// watchEventTypeForReason maps the reason of an event involving a resource to the change it
+// signals. Events of failed operations do not change the resource.
+func watchEventTypeForReason(reason apiv1beta1.EventReason) (apiv1beta1.WatchEventType, bool) {
+	switch reason {
+	case apiv1beta1.EventReasonResourceCreated:
+		return apiv1beta1.ADDED, true
+	case apiv1beta1.EventReasonResourceDeleted:
+		return apiv1beta1.DELETED, true
+	case apiv1beta1.EventReasonResourceCreationFailed, apiv1beta1.EventReasonResourceUpdateFailed, apiv1beta1.EventReasonResourceDeletionFailed:
+		return "", false
+	default:
+		return apiv1beta1.MODIFIED, true
+	}
+}
+
+// mergeWatchEventTypes collapses consecutive changes of a resource within a poll into one.
+func mergeWatchEventTypes(previous apiv1beta1.WatchEventType, exists bool, next apiv1beta1.WatchEventType) apiv1beta1.WatchEventType {
+	if !exists || next == apiv1beta1.DELETED || previous == apiv1beta1.DELETED {
+		return next
+	}
+	if previous == apiv1beta1.ADDED {
+		return apiv1beta1.ADDED
+	}
+	return next
+}
+
+// watchFieldSelector restricts a field selector to the given resource names.
+func watchFieldSelector(fieldSelector *string, names []string) *string {
+	if names == nil {
+		return fieldSelector
+	}
+	selectors := []string{fmt.Sprintf("metadata.name in (%s)", strings.Join(names, ","))}
+	if s := lo.FromPtr(fieldSelector); s != "" {
+		selectors = append([]string{s}, selectors...)
+	}
+	return lo.ToPtr(strings.Join(selectors, ","))
+}
@@ -0,0 +1,181 @@
+package transportv1beta1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// watchFeedBufferSize is the number of event batches a watcher may fall behind the feed before
+// it is dropped and has to catch up on its own.
+const watchFeedBufferSize = 16
+
+// watchFeed reads the events of each watched organization once per interval and fans them out to
+// the watches of the organization, so that the number of event queries doesn't grow with the
+// number of open watches.
+type watchFeed struct {
+	serviceHandler service.Service
+	interval       time.Duration
+
+	mu   sync.Mutex
+	orgs map[uuid.UUID]*orgWatchFeed
+}
+
+type orgWatchFeed struct {
+	subscriptions map[*watchSubscription]struct{}
+	cancel        context.CancelFunc
+}
+
+// watchSubscription receives the batches of new events of an organization, in the order they
+// were created. The channel is closed when the subscriber fell behind or the feed failed, after
+// which the subscriber has to catch up on its own before subscribing again.
+type watchSubscription struct {
+	orgId  uuid.UUID
+	events chan []domain.Event
+}
+
+func newWatchFeed(serviceHandler service.Service, interval time.Duration) *watchFeed {
+	return &watchFeed{
+		serviceHandler: serviceHandler,
+		interval:       interval,
+		orgs:           map[uuid.UUID]*orgWatchFeed{},
+	}
+}
+
+// subscribe starts delivering the events of the organization created from now on. The events are
+// read starting at the given cursor if the organization was not watched yet.
+func (f *watchFeed) subscribe(ctx context.Context, orgId uuid.UUID, cursor time.Time) *watchSubscription {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sub := &watchSubscription{orgId: orgId, events: make(chan []domain.Event, watchFeedBufferSize)}
+	org, ok := f.orgs[orgId]
+	if !ok {
+		// the feed outlives the request of its first subscriber
+		feedCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		org = &orgWatchFeed{subscriptions: map[*watchSubscription]struct{}{}, cancel: cancel}
+		f.orgs[orgId] = org
+		go f.run(feedCtx, orgId, org, cursor)
+	}
+	org.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+// unsubscribe stops delivering events to the subscription, and stops reading the events of its
+// organization once nobody watches it anymore.
+func (f *watchFeed) unsubscribe(sub *watchSubscription) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	org, ok := f.orgs[sub.orgId]
+	if !ok {
+		return
+	}
+	if _, ok := org.subscriptions[sub]; ok {
+		delete(org.subscriptions, sub)
+		close(sub.events)
+	}
+	if len(org.subscriptions) == 0 {
+		org.cancel()
+		delete(f.orgs, sub.orgId)
+	}
+}
+
+func (f *watchFeed) run(ctx context.Context, orgId uuid.UUID, org *orgWatchFeed, cursor time.Time) {
+	seen := map[string]time.Time{}
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		events, status := f.read(ctx, orgId, cursor, seen)
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code != http.StatusOK {
+			// let the subscribers catch up on their own, they report the failure if it persists
+			f.stop(orgId, org)
+			return
+		}
+		for _, ev := range events {
+			if timestamp := lo.FromPtr(ev.Metadata.CreationTimestamp); timestamp.After(cursor) {
+				cursor = timestamp
+			}
+		}
+		for name, timestamp := range seen {
+			if timestamp.Before(cursor.Add(-watchEventOverlap)) {
+				delete(seen, name)
+			}
+		}
+		if len(events) > 0 {
+			f.deliver(org, events)
+		}
+	}
+}
+
+// read returns the events created since the cursor that were not read before, oldest first.
+func (f *watchFeed) read(ctx context.Context, orgId uuid.UUID, cursor time.Time, seen map[string]time.Time) ([]domain.Event, domain.Status) {
+	params := domain.ListEventsParams{
+		Order:         lo.ToPtr(domain.Asc),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.creationTimestamp>%s", cursor.Add(-watchEventOverlap).UTC().Format(time.RFC3339Nano))),
+		Limit:         lo.ToPtr(watchPageLimit),
+	}
+	var events []domain.Event
+	for {
+		page, status := f.serviceHandler.ListEvents(ctx, orgId, params)
+		if status.Code != http.StatusOK {
+			return nil, status
+		}
+		for _, ev := range page.Items {
+			name := lo.FromPtr(ev.Metadata.Name)
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = lo.FromPtr(ev.Metadata.CreationTimestamp)
+			events = append(events, ev)
+		}
+		if page.Metadata.Continue == nil {
+			return events, domain.StatusOK()
+		}
+		params.Continue = page.Metadata.Continue
+	}
+}
+
+// deliver hands the batch to every subscription, dropping the ones that fell behind.
+func (f *watchFeed) deliver(org *orgWatchFeed, events []domain.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for sub := range org.subscriptions {
+		select {
+		case sub.events <- events:
+		default:
+			delete(org.subscriptions, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// stop drops all subscriptions of the organization and forgets its feed.
+func (f *watchFeed) stop(orgId uuid.UUID, org *orgWatchFeed) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for sub := range org.subscriptions {
+		close(sub.events)
+	}
+	org.subscriptions = map[*watchSubscription]struct{}{}
+	org.cancel()
+	if f.orgs[orgId] == org {
+		delete(f.orgs, orgId)
+	}
+}
@@ -0,0 +1,412 @@
+package transportv1beta1
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	convertv1beta1 "github.com/flightctl/flightctl/internal/api/convert/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+var watchTestStart = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestWatchHandler(t *testing.T) (*TransportHandler, *service.MockService) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	return &TransportHandler{
+		serviceHandler: mockService,
+		converter:      convertv1beta1.NewConverter(),
+		watchFeed:      newWatchFeed(mockService, time.Hour),
+	}, mockService
+}
+
+func newTestResourceEvent(name string, reason domain.EventReason, objectName string, created time.Time) domain.Event {
+	return domain.Event{
+		Metadata:       domain.ObjectMeta{Name: lo.ToPtr(name), CreationTimestamp: lo.ToPtr(created)},
+		InvolvedObject: domain.ObjectReference{Kind: domain.DeviceKind, Name: objectName},
+		Reason:         reason,
+	}
+}
+
+// newTestWatchList lists the devices whose names are in the matching set.
+func newTestWatchList(matching map[string]bool) watchListFunc {
+	return func(ctx context.Context, orgId uuid.UUID, names []string, limit int32, cont *string) ([]watchObject, *string, domain.Status) {
+		var objects []watchObject
+		for _, name := range names {
+			if matching[name] {
+				objects = append(objects, watchObject{name: name, object: apiv1beta1.Device{Metadata: apiv1beta1.ObjectMeta{Name: lo.ToPtr(name)}}})
+			}
+		}
+		return objects, nil, domain.StatusOK()
+	}
+}
+
+func newTestWatcher(h *TransportHandler, req watchRequest, w http.ResponseWriter) *watcher {
+	return &watcher{
+		h:       h,
+		req:     req,
+		orgId:   uuid.New(),
+		stream:  newWatchStream(w),
+		cursor:  watchTestStart,
+		seen:    map[string]time.Time{},
+		visible: map[string]bool{},
+	}
+}
+
+// readWatchEvents returns the streamed events as "TYPE name" strings, using the resourceVersion
+// as the name of bookmarks.
+func readWatchEvents(t *testing.T, rec *httptest.ResponseRecorder) []string {
+	var events []string
+	scanner := bufio.NewScanner(strings.NewReader(rec.Body.String()))
+	for scanner.Scan() {
+		var event struct {
+			Type            apiv1beta1.WatchEventType `json:"type"`
+			ResourceVersion *string                   `json:"resourceVersion"`
+			Object          struct {
+				Metadata apiv1beta1.ObjectMeta `json:"metadata"`
+			} `json:"object"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		name := lo.FromPtr(event.Object.Metadata.Name)
+		if event.Type == apiv1beta1.BOOKMARK {
+			name = lo.FromPtr(event.ResourceVersion)
+		}
+		events = append(events, string(event.Type)+" "+name)
+	}
+	rec.Body.Reset()
+	return events
+}
+
+// syncRecorder is a response recorder whose body can be inspected while a watch writes to it.
+type syncRecorder struct {
+	*httptest.ResponseRecorder
+	mu sync.Mutex
+}
+
+func (r *syncRecorder) Write(b []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ResponseRecorder.Write(b)
+}
+
+func (r *syncRecorder) lines() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return strings.Count(r.Body.String(), "\n")
+}
+
+func TestMergeWatchEventTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous apiv1beta1.WatchEventType
+		exists   bool
+		next     apiv1beta1.WatchEventType
+		want     apiv1beta1.WatchEventType
+	}{
+		{name: "first change", next: apiv1beta1.MODIFIED, want: apiv1beta1.MODIFIED},
+		{name: "created then modified", previous: apiv1beta1.ADDED, exists: true, next: apiv1beta1.MODIFIED, want: apiv1beta1.ADDED},
+		{name: "modified twice", previous: apiv1beta1.MODIFIED, exists: true, next: apiv1beta1.MODIFIED, want: apiv1beta1.MODIFIED},
+		{name: "created then deleted", previous: apiv1beta1.ADDED, exists: true, next: apiv1beta1.DELETED, want: apiv1beta1.DELETED},
+		{name: "deleted then created", previous: apiv1beta1.DELETED, exists: true, next: apiv1beta1.ADDED, want: apiv1beta1.ADDED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, mergeWatchEventTypes(tt.previous, tt.exists, tt.next))
+		})
+	}
+}
+
+func TestWatcherInit(t *testing.T) {
+	t.Run("rejects an invalid resourceVersion", func(t *testing.T) {
+		h, _ := newTestWatchHandler(t)
+		rec := httptest.NewRecorder()
+		wt := newTestWatcher(h, watchRequest{kind: domain.DeviceKind, resourceVersion: lo.ToPtr("yesterday"), list: newTestWatchList(nil)}, rec)
+
+		status := wt.init(context.Background())
+		require.Equal(t, http.StatusBadRequest, int(status.Code))
+		require.False(t, wt.stream.started)
+	})
+
+	t.Run("resumes at the resourceVersion", func(t *testing.T) {
+		h, _ := newTestWatchHandler(t)
+		rec := httptest.NewRecorder()
+		rv := watchTestStart.Add(time.Minute)
+		wt := newTestWatcher(h, watchRequest{kind: domain.DeviceKind, resourceVersion: lo.ToPtr(rv.Format(time.RFC3339Nano)), list: newTestWatchList(nil)}, rec)
+
+		require.Equal(t, domain.StatusOK(), wt.init(context.Background()))
+		require.True(t, wt.stream.started)
+		require.Equal(t, rv, wt.cursor)
+		require.False(t, wt.complete)
+		require.Empty(t, readWatchEvents(t, rec))
+	})
+
+	t.Run("lists the current resources", func(t *testing.T) {
+		h, mockService := newTestWatchHandler(t)
+		rec := httptest.NewRecorder()
+		pages := [][]string{{"dev1", "dev2"}, {"dev3"}}
+		list := func(ctx context.Context, orgId uuid.UUID, names []string, limit int32, cont *string) ([]watchObject, *string, domain.Status) {
+			page := 0
+			if cont != nil {
+				page = 1
+			}
+			objects := lo.Map(pages[page], func(name string, _ int) watchObject {
+				return watchObject{name: name, object: apiv1beta1.Device{Metadata: apiv1beta1.ObjectMeta{Name: lo.ToPtr(name)}}}
+			})
+			if page == 0 {
+				return objects, lo.ToPtr("next"), domain.StatusOK()
+			}
+			return objects, nil, domain.StatusOK()
+		}
+		wt := newTestWatcher(h, watchRequest{kind: domain.DeviceKind, list: list}, rec)
+		mockService.EXPECT().GetDatabaseTime(gomock.Any()).Return(watchTestStart.Add(time.Hour), domain.StatusOK())
+
+		require.Equal(t, domain.StatusOK(), wt.init(context.Background()))
+		require.Equal(t, watchTestStart.Add(time.Hour), wt.cursor)
+		require.True(t, wt.complete)
+		require.Equal(t, map[string]bool{"dev1": true, "dev2": true, "dev3": true}, wt.visible)
+		require.Equal(t, []string{"ADDED dev1", "ADDED dev2", "ADDED dev3"}, readWatchEvents(t, rec))
+	})
+}
+
+func TestWatcherProcess(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("skips events already streamed within the overlap", func(t *testing.T) {
+		h, _ := newTestWatchHandler(t)
+		rec := httptest.NewRecorder()
+		wt := newTestWatcher(h, watchRequest{kind: domain.DeviceKind, list: newTestWatchList(map[string]bool{"dev1": true, "dev2": true})}, rec)
+
+		ev1 := newTestResourceEvent("ev1", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(time.Second))
+		changed, status := wt.process(ctx, []domain.Event{ev1}, false)
+		require.Equal(t, domain.StatusOK(), status)
+		require.True(t, changed)
+		require.Equal(t, []string{"MODIFIED dev1"}, readWatchEvents(t, rec))
+		require.Equal(t, watchTestStart.Add(time.Second), wt.cursor)
+
+		// the next read overlaps with the previous one and returns ev1 again
+		ev2 := newTestResourceEvent("ev2", domain.EventReasonResourceUpdated, "dev2", watchTestStart.Add(2*time.Second))
+		_, status = wt.process(ctx, []domain.Event{ev1, ev2}, false)
+		require.Equal(t, domain.StatusOK(), status)
+		require.Equal(t, []string{"MODIFIED dev2"}, readWatchEvents(t, rec))
+
+		// events before the overlap window were covered by earlier reads
+		old := newTestResourceEvent("old", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(-time.Minute))
+		changed, _ = wt.process(ctx, []domain.Event{old}, false)
+		require.False(t, changed)
+		require.Empty(t, readWatchEvents(t, rec))
+
+		// seen events are forgotten once the cursor moved past the overlap
+		ev3 := newTestResourceEvent("ev3", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(time.Minute))
+		_, _ = wt.process(ctx, []domain.Event{ev3}, false)
+		require.Equal(t, watchTestStart.Add(time.Minute), wt.cursor)
+		require.Equal(t, []string{"ev3"}, lo.Keys(wt.seen))
+	})
+
+	t.Run("collapses changes of a resource", func(t *testing.T) {
+		h, _ := newTestWatchHandler(t)
+		rec := httptest.NewRecorder()
+		wt := newTestWatcher(h, watchRequest{kind: domain.DeviceKind, list: newTestWatchList(map[string]bool{"dev1": true})}, rec)
+
+		events := []domain.Event{
+			newTestResourceEvent("ev1", domain.EventReasonResourceCreated, "dev1", watchTestStart.Add(time.Second)),
+			newTestResourceEvent("ev2", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(2*time.Second)),
+			newTestResourceEvent("ev3", domain.EventReasonResourceUpdateFailed, "dev1", watchTestStart.Add(3*time.Second)),
+			{
+				Metadata:       domain.ObjectMeta{Name: lo.ToPtr("ev4"), CreationTimestamp: lo.ToPtr(watchTestStart.Add(4 * time.Second))},
+				InvolvedObject: domain.ObjectReference{Kind: domain.FleetKind, Name: "dev1"},
+				Reason:         domain.EventReasonResourceUpdated,
+			},
+		}
+		_, status := wt.process(ctx, events, false)
+		require.Equal(t, domain.StatusOK(), status)
+		require.Equal(t, []string{"ADDED dev1"}, readWatchEvents(t, rec))
+		require.Equal(t, watchTestStart.Add(4*time.Second), wt.cursor)
+	})
+
+	t.Run("streams resources entering and leaving the selectors", func(t *testing.T) {
+		h, _ := newTestWatchHandler(t)
+		rec := httptest.NewRecorder()
+		matching := map[string]bool{"dev1": true}
+		wt := newTestWatcher(h, watchRequest{kind: domain.DeviceKind, list: newTestWatchList(matching)}, rec)
+		wt.visible["dev1"] = true
+		wt.complete = true
+
+		// dev1 was relabeled and no longer matches, dev2 was relabeled and matches now
+		matching["dev1"] = false
+		matching["dev2"] = true
+		_, status := wt.process(ctx, []domain.Event{
+			newTestResourceEvent("ev1", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(time.Second)),
+			newTestResourceEvent("ev2", domain.EventReasonResourceUpdated, "dev2", watchTestStart.Add(2*time.Second)),
+			newTestResourceEvent("ev3", domain.EventReasonResourceUpdated, "dev3", watchTestStart.Add(3*time.Second)),
+		}, false)
+		require.Equal(t, domain.StatusOK(), status)
+		require.Equal(t, []string{"DELETED dev1", "ADDED dev2"}, readWatchEvents(t, rec))
+
+		// further changes of resources outside the selectors are not streamed
+		_, _ = wt.process(ctx, []domain.Event{
+			newTestResourceEvent("ev4", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(4*time.Second)),
+			newTestResourceEvent("ev5", domain.EventReasonResourceDeleted, "dev3", watchTestStart.Add(5*time.Second)),
+			newTestResourceEvent("ev6", domain.EventReasonResourceUpdated, "dev2", watchTestStart.Add(6*time.Second)),
+		}, false)
+		require.Equal(t, []string{"MODIFIED dev2"}, readWatchEvents(t, rec))
+	})
+
+	t.Run("streams resources leaving the selectors once after resuming", func(t *testing.T) {
+		h, _ := newTestWatchHandler(t)
+		rec := httptest.NewRecorder()
+		wt := newTestWatcher(h, watchRequest{kind: domain.DeviceKind, list: newTestWatchList(map[string]bool{})}, rec)
+
+		// the client may have seen dev1 before resuming, so it learns that dev1 doesn't match
+		_, _ = wt.process(ctx, []domain.Event{newTestResourceEvent("ev1", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(time.Second))}, false)
+		require.Equal(t, []string{"DELETED dev1"}, readWatchEvents(t, rec))
+
+		_, _ = wt.process(ctx, []domain.Event{newTestResourceEvent("ev2", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(2*time.Second))}, false)
+		require.Empty(t, readWatchEvents(t, rec))
+	})
+
+	t.Run("filters events by the field selector of an event watch", func(t *testing.T) {
+		h, mockService := newTestWatchHandler(t)
+		rec := httptest.NewRecorder()
+		wt := newTestWatcher(h, watchRequest{kind: domain.EventKind, eventFieldSelector: lo.ToPtr("type=Warning")}, rec)
+
+		ev1 := newTestResourceEvent("ev1", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(time.Second))
+		ev2 := newTestResourceEvent("ev2", domain.EventReasonResourceUpdated, "dev2", watchTestStart.Add(2*time.Second))
+		mockService.EXPECT().ListEvents(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (*domain.EventList, domain.Status) {
+				require.Equal(t, "type=Warning,metadata.name in (ev1,ev2)", lo.FromPtr(params.FieldSelector))
+				return &domain.EventList{Items: []domain.Event{ev2}}, domain.StatusOK()
+			})
+
+		_, status := wt.process(ctx, []domain.Event{ev1, ev2}, false)
+		require.Equal(t, domain.StatusOK(), status)
+		require.Equal(t, []string{"ADDED ev2"}, readWatchEvents(t, rec))
+		require.Equal(t, watchTestStart.Add(2*time.Second), wt.cursor)
+
+		// events read with the field selector are not filtered again
+		ev3 := newTestResourceEvent("ev3", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(3*time.Second))
+		_, _ = wt.process(ctx, []domain.Event{ev3}, true)
+		require.Equal(t, []string{"ADDED ev3"}, readWatchEvents(t, rec))
+	})
+}
+
+func TestWatchBookmarks(t *testing.T) {
+	h, mockService := newTestWatchHandler(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rec := &syncRecorder{ResponseRecorder: httptest.NewRecorder()}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/events?watch=true", nil).WithContext(ctx)
+
+	ev1 := newTestResourceEvent("ev1", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(time.Second))
+	mockService.EXPECT().GetDatabaseTime(gomock.Any()).Return(watchTestStart, domain.StatusOK())
+	gomock.InOrder(
+		// validates the selectors
+		mockService.EXPECT().ListEvents(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.EventList{}, domain.StatusOK()),
+		// catches up with the events since the watch started
+		mockService.EXPECT().ListEvents(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (*domain.EventList, domain.Status) {
+				require.Equal(t, "metadata.creationTimestamp>2026-03-01T11:59:55Z", lo.FromPtr(params.FieldSelector))
+				return &domain.EventList{Items: []domain.Event{ev1}}, domain.StatusOK()
+			}),
+	)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.watch(rec, req, watchRequest{kind: domain.EventKind})
+	}()
+	require.Eventually(t, func() bool { return rec.lines() == 3 }, 5*time.Second, time.Millisecond)
+	cancel()
+	<-done
+
+	require.Equal(t, []string{
+		"BOOKMARK 2026-03-01T12:00:00Z",
+		"ADDED ev1",
+		"BOOKMARK 2026-03-01T12:00:01Z",
+	}, readWatchEvents(t, rec.ResponseRecorder))
+	require.Empty(t, h.watchFeed.orgs)
+}
+
+func TestWatchFeed(t *testing.T) {
+	orgId := uuid.New()
+
+	t.Run("fans out new events to all subscriptions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		feed := newWatchFeed(mockService, 10*time.Millisecond)
+
+		ev1 := newTestResourceEvent("ev1", domain.EventReasonResourceUpdated, "dev1", watchTestStart.Add(time.Second))
+		ev2 := newTestResourceEvent("ev2", domain.EventReasonResourceUpdated, "dev2", watchTestStart.Add(2*time.Second))
+		reads := 0
+		mockService.EXPECT().ListEvents(gomock.Any(), orgId, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, orgId uuid.UUID, params domain.ListEventsParams) (*domain.EventList, domain.Status) {
+				reads++
+				switch reads {
+				case 1:
+					return &domain.EventList{Items: []domain.Event{ev1}}, domain.StatusOK()
+				case 2:
+					// overlaps with the first read
+					return &domain.EventList{Items: []domain.Event{ev1, ev2}}, domain.StatusOK()
+				default:
+					return &domain.EventList{}, domain.StatusOK()
+				}
+			}).MinTimes(2)
+
+		sub1 := feed.subscribe(context.Background(), orgId, watchTestStart)
+		sub2 := feed.subscribe(context.Background(), orgId, watchTestStart)
+		for _, sub := range []*watchSubscription{sub1, sub2} {
+			require.Equal(t, []domain.Event{ev1}, <-sub.events)
+			require.Equal(t, []domain.Event{ev2}, <-sub.events)
+		}
+
+		feed.unsubscribe(sub1)
+		feed.unsubscribe(sub2)
+		require.Empty(t, feed.orgs)
+	})
+
+	t.Run("drops subscriptions that fall behind", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		feed := newWatchFeed(mockService, time.Hour)
+
+		sub := feed.subscribe(context.Background(), orgId, watchTestStart)
+		org := feed.orgs[orgId]
+		batch := []domain.Event{newTestResourceEvent("ev1", domain.EventReasonResourceUpdated, "dev1", watchTestStart)}
+		for i := 0; i <= watchFeedBufferSize; i++ {
+			feed.deliver(org, batch)
+		}
+		for i := 0; i < watchFeedBufferSize; i++ {
+			<-sub.events
+		}
+		_, ok := <-sub.events
+		require.False(t, ok)
+
+		feed.unsubscribe(sub)
+		require.Empty(t, feed.orgs)
+	})
+
+	t.Run("drops all subscriptions when reading fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		feed := newWatchFeed(mockService, 10*time.Millisecond)
+		mockService.EXPECT().ListEvents(gomock.Any(), orgId, gomock.Any()).Return(nil, domain.StatusInternalServerError("unavailable"))
+
+		sub := feed.subscribe(context.Background(), orgId, watchTestStart)
+		_, ok := <-sub.events
+		require.False(t, ok)
+		feed.unsubscribe(sub)
+		require.Empty(t, feed.orgs)
+	})
+}
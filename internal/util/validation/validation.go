@@ -218,6 +218,31 @@ func DenyForbiddenDevicePath(p string) error {
 	return nil
 }
 
+// systemDirectories are directories of the operating system and the agent that must not be used as
+// the mount path of a config provider that unpacks a directory tree.
+var systemDirectories = []string{
+	"/bin", "/boot", "/dev", "/etc", "/home", "/lib", "/lib64", "/mnt", "/opt", "/proc", "/root", "/run",
+	"/sbin", "/srv", "/sys", "/tmp", "/usr", "/var",
+	"/etc/containers", "/etc/flightctl", "/etc/pki", "/etc/ssh", "/etc/sysconfig", "/etc/systemd",
+	"/usr/bin", "/usr/lib", "/usr/lib64", "/usr/libexec", "/usr/local", "/usr/sbin", "/usr/share",
+	"/var/lib", "/var/log", "/var/run", "/var/tmp",
+}
+
+// DenySystemDirectory validates that the given device path is not the root directory or one of the
+// directories of the operating system or the agent, so that it can be used as a dedicated mount path.
+func DenySystemDirectory(p string) error {
+	clean := filepath.Clean(p)
+	if clean == "/" {
+		return fmt.Errorf("%w: must not be the root directory", ErrForbiddenDevicePath)
+	}
+	for _, dir := range systemDirectories {
+		if clean == dir {
+			return fmt.Errorf("%w: must not be the system directory %q", ErrForbiddenDevicePath, dir)
+		}
+	}
+	return nil
+}
+
 func ValidateLinuxUserGroup(s string, path string) []error {
 	if s == "" {
 		return []error{}
@@ -111,6 +111,34 @@ func TestDenyForbiddenDevicePath(t *testing.T) {
 	}
 }
 
+func TestDenySystemDirectory(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{"reject root", "/", true},
+		{"reject /etc", "/etc", true},
+		{"reject /usr with trailing slash", "/usr/", true},
+		{"reject /etc/flightctl", "/etc/flightctl", true},
+		{"reject /var/lib", "/var/lib", true},
+		{"allow /etc/myapp", "/etc/myapp", false},
+		{"allow /etc/flightctl subdirectory", "/etc/flightctl/custom", false},
+		{"allow /var/lib/myapp", "/var/lib/myapp", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DenySystemDirectory(tt.path)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrForbiddenDevicePath)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateComposePath(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
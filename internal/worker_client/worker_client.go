@@ -82,6 +82,7 @@ var eventReasons = map[domain.EventReason]struct{}{
 	domain.EventReasonFleetRolloutBatchDispatched: {},
 	domain.EventReasonDeviceConflictResolved:      {},
 	domain.EventReasonDeviceDecommissioned:        {},
+	domain.EventReasonBulkOperationProgressed:     {},
 }
 
 func shouldEmitEvent(reason domain.EventReason) bool {
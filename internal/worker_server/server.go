@@ -19,6 +19,7 @@ import (
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 type Server struct {
@@ -28,6 +29,7 @@ type Server struct {
 	queuesProvider queues.Provider
 	k8sClient      k8sclient.K8SClient
 	workerMetrics  *worker.WorkerCollector
+	db             *gorm.DB
 }
 
 // New returns a new instance of a flightctl server.
@@ -38,6 +40,7 @@ func New(
 	queuesProvider queues.Provider,
 	k8sClient k8sclient.K8SClient,
 	workerMetrics *worker.WorkerCollector,
+	db *gorm.DB,
 ) *Server {
 	return &Server{
 		cfg:            cfg,
@@ -46,6 +49,7 @@ func New(
 		queuesProvider: queuesProvider,
 		k8sClient:      k8sClient,
 		workerMetrics:  workerMetrics,
+		db:             db,
 	}
 }
 
@@ -58,7 +62,7 @@ func (s *Server) Run(ctx context.Context) error {
 	}
 	defer publisher.Close()
 
-	kvStore, err := kvstore.NewKVStore(ctx, s.log, s.cfg.KV.Hostname, s.cfg.KV.Port, s.cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, s.log, s.cfg, s.db)
 	if err != nil {
 		s.log.WithError(err).Error("failed to create kvStore")
 		return err
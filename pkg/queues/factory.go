@@ -0,0 +1,44 @@
+package queues
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// ProviderType selects the backend of the queue provider.
+type ProviderType string
+
+const (
+	// ProviderTypeRedis keeps the queues in Redis/Valkey
+	ProviderTypeRedis ProviderType = "redis"
+	// ProviderTypePostgres keeps the queues in the service database
+	ProviderTypePostgres ProviderType = "postgres"
+)
+
+// ProviderOptions selects the queue provider and how to connect to it.
+type ProviderOptions struct {
+	// Type is the backend of the provider, Redis if empty
+	Type ProviderType
+	// Hostname, Port and Password connect to Redis
+	Hostname string
+	Port     uint
+	Password api.SecureString
+	// DB is the service database used by the Postgres provider
+	DB *gorm.DB
+}
+
+// NewProvider returns the queue provider selected by the options.
+func NewProvider(ctx context.Context, log logrus.FieldLogger, processID string, opts ProviderOptions, retryConfig RetryConfig) (Provider, error) {
+	switch opts.Type {
+	case "", ProviderTypeRedis:
+		return NewRedisProvider(ctx, log, processID, opts.Hostname, opts.Port, opts.Password, retryConfig)
+	case ProviderTypePostgres:
+		return NewPostgresProvider(ctx, log, processID, opts.DB, retryConfig)
+	default:
+		return nil, fmt.Errorf("unknown queue provider type %q", opts.Type)
+	}
+}
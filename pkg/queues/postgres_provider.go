@@ -0,0 +1,914 @@
+package queues
+
+/*
+Postgres Provider Implementation - SKIP LOCKED Queues with Checkpoint Tracking
+
+This provider implements the Provider interface on top of the service database, so that
+deployments can run without a Redis/Valkey instance. It follows the semantics of the Redis
+provider; the tables are created by the database migration.
+
+Data Structures:
+1. queue_messages
+   - Stores incoming messages with tracing context
+   - A consumer claims a message with SELECT ... FOR UPDATE SKIP LOCKED and sets claimed_at
+   - Messages are deleted once completed
+
+2. queue_failed_messages
+   - Stores failed messages with exponential backoff retry scheduling (retry_at)
+   - retry_count tracks number of retry attempts for exponential backoff
+
+3. queue_in_flight_tasks
+   - Tracks task completion status for safe checkpoint advancement
+   - Failed tasks remain incomplete to act as checkpoint barriers
+   - Completed tasks are deleted when the checkpoint advances past them
+
+4. queue_checkpoints
+   - Row "global_checkpoint" stores the latest safe checkpoint timestamp (microseconds)
+
+Pub/Sub:
+- Messages are broadcast with pg_notify and received with LISTEN on a dedicated connection.
+  Postgres limits notification payloads to 8000 bytes.
+*/
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/reqid"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	postgresGlobalCheckpoint = "global_checkpoint"
+	// postgresPollInterval is how long an idle consumer waits before polling the queue again
+	postgresPollInterval = 500 * time.Millisecond
+	// postgresMaxNotifyPayload is the maximum payload size of pg_notify
+	postgresMaxNotifyPayload = 8000
+)
+
+type postgresProvider struct {
+	db          *gorm.DB
+	log         logrus.FieldLogger
+	wg          *sync.WaitGroup
+	queues      []*postgresQueue
+	channels    []*postgresChannel
+	stopped     atomic.Bool
+	mu          sync.Mutex
+	processID   string
+	retryConfig RetryConfig
+}
+
+func NewPostgresProvider(ctx context.Context, log logrus.FieldLogger, processID string, db *gorm.DB, retryConfig RetryConfig) (Provider, error) {
+	if processID == "" {
+		return nil, errors.New("processID cannot be empty")
+	}
+	if db == nil {
+		return nil, errors.New("database cannot be nil")
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "flightctl/queues", "PostgresProvider")
+	defer span.End()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p := &postgresProvider{
+		db:          db,
+		log:         log,
+		wg:          &wg,
+		processID:   processID,
+		retryConfig: retryConfig,
+	}
+
+	// Test the connection
+	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	if err := p.CheckHealth(timeoutCtx); err != nil {
+		return nil, fmt.Errorf("failed to connect to Postgres queue: %w", err)
+	}
+	log.Info("successfully connected to the Postgres queue")
+	return p, nil
+}
+
+func (p *postgresProvider) findExistingQueue(queueName string) *postgresQueue {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, q := range p.queues {
+		if q.name == queueName && !q.closed.Load() {
+			return q
+		}
+	}
+	return nil
+}
+
+func (p *postgresProvider) newQueue(queueName string) (*postgresQueue, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped.Load() {
+		return nil, errors.New("provider is stopped")
+	}
+
+	// Check for existing active queue (deduplication)
+	for _, q := range p.queues {
+		if q.name == queueName && !q.closed.Load() {
+			p.log.WithField("queueName", queueName).Debug("reusing existing queue instance")
+			return q, nil
+		}
+	}
+
+	p.log.WithField("queueName", queueName).Debug("creating new queue instance")
+	consumerName := fmt.Sprintf("%s-consumer-%s", queueName, p.processID)
+	queue := &postgresQueue{
+		db:           p.db,
+		name:         queueName,
+		consumerName: consumerName,
+		log:          p.log.WithField("consumerName", consumerName),
+		wg:           p.wg,
+		processID:    p.processID,
+		retryConfig:  p.retryConfig,
+	}
+	p.queues = append(p.queues, queue)
+	return queue, nil
+}
+
+func (p *postgresProvider) newChannel(channelName string) (*postgresChannel, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped.Load() {
+		return nil, errors.New("provider is stopped")
+	}
+	channel := &postgresChannel{
+		db:   p.db,
+		name: channelName,
+		log:  p.log,
+		wg:   p.wg,
+	}
+	p.channels = append(p.channels, channel)
+	return channel, nil
+}
+
+func (p *postgresProvider) NewQueueConsumer(ctx context.Context, queueName string) (QueueConsumer, error) {
+	return p.newQueue(queueName)
+}
+
+func (p *postgresProvider) NewQueueProducer(ctx context.Context, queueName string) (QueueProducer, error) {
+	return p.newQueue(queueName)
+}
+
+func (p *postgresProvider) NewPubSubPublisher(ctx context.Context, channelName string) (PubSubPublisher, error) {
+	return p.newChannel(channelName)
+}
+
+func (p *postgresProvider) NewPubSubSubscriber(ctx context.Context, channelName string) (PubSubSubscriber, error) {
+	return p.newChannel(channelName)
+}
+
+func (p *postgresProvider) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped.Swap(true) {
+		return
+	}
+	// Signal all queue goroutines to exit.
+	for _, q := range p.queues {
+		p.log.WithField("queueName", q.name).Debug("closing queue instance")
+		q.Close()
+	}
+	defer p.wg.Done()
+
+	// Close all channels and their subscriptions
+	for _, channel := range p.channels {
+		p.log.WithField("channelName", channel.name).Debug("closing channel instance")
+		channel.Close()
+	}
+}
+
+func (p *postgresProvider) Wait() {
+	p.wg.Wait()
+}
+
+func (p *postgresProvider) CheckHealth(ctx context.Context) error {
+	sqlDB, err := p.db.DB()
+	if err != nil {
+		return fmt.Errorf("db handle error: %w", err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("db ping: %w", err)
+	}
+	return nil
+}
+
+func (p *postgresProvider) GetLatestProcessedTimestamp(ctx context.Context) (time.Time, error) {
+	var checkpoint model.QueueCheckpoint
+	err := p.db.WithContext(ctx).Take(&checkpoint, "name = ?", postgresGlobalCheckpoint).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, ErrCheckpointMissing
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get checkpoint: %w", err)
+	}
+	return time.UnixMicro(checkpoint.Timestamp), nil
+}
+
+func (p *postgresProvider) AdvanceCheckpointAndCleanup(ctx context.Context) error {
+	var (
+		updated      bool
+		cleanedCount int64
+		reason       string
+		safeTS       int64
+	)
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var checkpoint model.QueueCheckpoint
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&checkpoint, "name = ?", postgresGlobalCheckpoint).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCheckpointMissing
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get checkpoint: %w", err)
+		}
+
+		var tasks []model.QueueInFlightTask
+		if err := tx.Order("timestamp, queue, entry_id").Find(&tasks).Error; err != nil {
+			return fmt.Errorf("failed to list in-flight tasks: %w", err)
+		}
+		if len(tasks) == 0 {
+			reason = "no in-flight tasks found"
+			return nil
+		}
+
+		// The checkpoint advances to the latest completed task before the first incomplete task
+		found := false
+		for _, task := range tasks {
+			if !task.Completed {
+				break
+			}
+			safeTS = task.Timestamp
+			found = true
+		}
+		if !found {
+			reason = "no completed tasks found"
+			return nil
+		}
+		if safeTS <= checkpoint.Timestamp {
+			reason = "timestamp not newer than current checkpoint"
+			return nil
+		}
+
+		if err := tx.Model(&checkpoint).Update("timestamp", safeTS).Error; err != nil {
+			return fmt.Errorf("failed to update checkpoint: %w", err)
+		}
+		result := tx.Where("completed AND timestamp <= ?", safeTS).Delete(&model.QueueInFlightTask{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to clean up completed tasks: %w", result.Error)
+		}
+		updated = true
+		cleanedCount = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if updated {
+		p.log.WithField("newCheckpoint", safeTS).
+			WithField("cleanedTasks", cleanedCount).
+			Info("Advanced checkpoint and cleaned up completed tasks")
+	} else {
+		p.log.WithField("reason", reason).
+			Debug("Checkpoint not advanced")
+	}
+	return nil
+}
+
+func (p *postgresProvider) SetCheckpointTimestamp(ctx context.Context, timestamp time.Time) error {
+	var micros int64
+	if !timestamp.IsZero() {
+		micros = timestamp.UnixMicro()
+	}
+	checkpoint := model.QueueCheckpoint{Name: postgresGlobalCheckpoint, Timestamp: micros}
+	err := p.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"timestamp"}),
+	}).Create(&checkpoint).Error
+	if err != nil {
+		return fmt.Errorf("failed to set checkpoint timestamp: %w", err)
+	}
+
+	p.log.WithField("timestamp", timestamp.Format(time.RFC3339Nano)).Debug("Set checkpoint timestamp in Postgres")
+	return nil
+}
+
+func (p *postgresProvider) ProcessTimedOutMessages(ctx context.Context, queueName string, timeout time.Duration, handler func(entryID string, body []byte) error) (int, error) {
+	queue := p.findExistingQueue(queueName)
+	if queue == nil {
+		var err error
+		queue, err = p.newQueue(queueName)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return queue.ProcessTimedOutMessages(ctx, timeout, handler)
+}
+
+func (p *postgresProvider) RetryFailedMessages(ctx context.Context, queueName string, config RetryConfig, handler func(entryID string, body []byte, retryCount int) error) (int, error) {
+	queue := p.findExistingQueue(queueName)
+	if queue == nil {
+		var err error
+		queue, err = p.newQueue(queueName)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return queue.RetryFailedMessages(ctx, config, handler)
+}
+
+type postgresQueue struct {
+	db           *gorm.DB
+	name         string
+	consumerName string
+	log          logrus.FieldLogger
+	wg           *sync.WaitGroup
+	processID    string
+	closed       atomic.Bool
+	retryConfig  RetryConfig
+}
+
+func entryIDOf(m *model.QueueMessage) string {
+	return strconv.FormatInt(m.ID, 10)
+}
+
+// trackingID returns the ID used for in-flight tracking, which is the ID of the first enqueued message for retries
+func trackingID(m *model.QueueMessage) string {
+	if m.OriginalID != "" {
+		return m.OriginalID
+	}
+	return entryIDOf(m)
+}
+
+// dbNow returns the database time, to avoid clock drift between processes
+func dbNow(ctx context.Context, db *gorm.DB, log logrus.FieldLogger) time.Time {
+	var now time.Time
+	if err := db.WithContext(ctx).Raw("SELECT now()").Scan(&now).Error; err != nil {
+		log.WithError(err).Warn("failed to get database time; falling back to local clock")
+		return time.Now()
+	}
+	return now
+}
+
+func (q *postgresQueue) Enqueue(ctx context.Context, payload []byte, timestamp int64) error {
+	if q.closed.Load() {
+		return errors.New("queue is closed")
+	}
+
+	// Inject tracing context
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	msg := model.QueueMessage{
+		Queue:        q.name,
+		Body:         payload,
+		Timestamp:    timestamp,
+		TraceContext: model.MakeJSONMap(map[string]string(carrier)),
+	}
+	if err := q.db.WithContext(ctx).Create(&msg).Error; err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+	return nil
+}
+
+func (q *postgresQueue) Consume(ctx context.Context, handler ConsumeHandler) error {
+	q.wg.Add(1)
+
+	go func() {
+		defer q.wg.Done()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				if q.closed.Load() {
+					return
+				}
+
+				consumed, err := q.consumeOnce(ctx, handler)
+				if err != nil {
+					q.log.WithError(err).Error("error while consuming message")
+				}
+				if !consumed {
+					// Idle, wait before polling again
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(postgresPollInterval):
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// claim selects the oldest unclaimed message of the queue, skipping messages locked by other consumers
+func (q *postgresQueue) claim(ctx context.Context) (*model.QueueMessage, error) {
+	var claimed *model.QueueMessage
+	err := q.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var msgs []model.QueueMessage
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("queue = ? AND claimed_at IS NULL", q.name).
+			Order("id").
+			Limit(1).
+			Find(&msgs).Error
+		if err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			return nil
+		}
+		claimed = &msgs[0]
+		return tx.Model(claimed).Updates(map[string]any{
+			"consumer":   q.consumerName,
+			"claimed_at": gorm.Expr("now()"),
+		}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim message: %w", err)
+	}
+	return claimed, nil
+}
+
+func (q *postgresQueue) consumeOnce(ctx context.Context, handler ConsumeHandler) (bool, error) {
+	ctx, parentSpan := tracing.StartSpan(ctx, "flightctl/queues", q.name)
+	defer parentSpan.End()
+
+	msg, err := q.claim(ctx)
+	if err != nil {
+		parentSpan.RecordError(err)
+		parentSpan.SetStatus(codes.Error, err.Error())
+		return false, err
+	}
+	if msg == nil {
+		return false, nil
+	}
+	entryID := entryIDOf(msg)
+
+	carrier := propagation.MapCarrier(msg.TraceContext)
+	receivedCtx := otel.GetTextMapPropagator().Extract(ctx, carrier)
+	requestID := reqid.NextRequestID()
+
+	// Start span for handler logic
+	receivedCtx, handlerSpan := tracing.StartSpan(
+		receivedCtx, "flightctl/queues", q.name, trace.WithLinks(
+			trace.LinkFromContext(ctx, attribute.String("request.id", requestID))))
+	defer handlerSpan.End()
+
+	handlerSpan.SetAttributes(attribute.String("request.id", requestID))
+	parentSpan.SetAttributes(attribute.String("request.id", requestID))
+
+	receivedCtx = context.WithValue(receivedCtx, middleware.RequestIDKey, requestID)
+	log := log.WithReqIDFromCtx(receivedCtx, q.log)
+
+	// Add to in-flight tasks before processing using message timestamp
+	if err := q.addToInFlightTasks(receivedCtx, trackingID(msg), msg.Timestamp); err != nil {
+		q.log.WithError(err).WithField("entryID", entryID).Debug("failed to add to in-flight tasks, continuing processing")
+	}
+
+	// The message is deleted when Complete() is called, or it remains claimed for timeout processing
+	if err := handler(receivedCtx, msg.Body, entryID, q, log); err != nil {
+		handlerSpan.RecordError(err)
+		handlerSpan.SetStatus(codes.Error, err.Error())
+		return true, fmt.Errorf("handler error on ID %s: %w", entryID, err)
+	}
+	return true, nil
+}
+
+func (q *postgresQueue) Complete(ctx context.Context, entryID string, body []byte, processingErr error) error {
+	if q.closed.Load() {
+		return errors.New("queue is closed")
+	}
+	id, err := strconv.ParseInt(entryID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid entry ID %s: %w", entryID, err)
+	}
+
+	var msgs []model.QueueMessage
+	if err := q.db.WithContext(ctx).Where("id = ?", id).Limit(1).Find(&msgs).Error; err != nil {
+		return fmt.Errorf("failed to get message ID %s: %w", entryID, err)
+	}
+	tracking := entryID
+	var currentRetryCount int
+	var timestamp int64
+	if len(msgs) > 0 {
+		tracking = trackingID(&msgs[0])
+		currentRetryCount = msgs[0].RetryCount
+		timestamp = msgs[0].Timestamp
+	} else {
+		q.log.WithField("entryID", entryID).Warn("could not read message; defaulting retryCount to 0")
+	}
+
+	// If processing failed, add the message to the failed messages
+	if processingErr != nil {
+		newRetryCount := currentRetryCount + 1
+		backoffDelay := calculateBackoff(newRetryCount, q.retryConfig)
+		failed := model.QueueFailedMessage{
+			Queue:      q.name,
+			EntryID:    tracking,
+			Body:       body,
+			ProcessID:  q.processID,
+			RetryCount: newRetryCount,
+			RetryAt:    dbNow(ctx, q.db, q.log).Add(backoffDelay),
+		}
+		if err := q.db.WithContext(ctx).Create(&failed).Error; err != nil {
+			return fmt.Errorf("failed to add message to failed messages: %w", err)
+		}
+		q.log.WithField("entryID", entryID).
+			WithField("processID", q.processID).
+			WithField("currentRetryCount", currentRetryCount).
+			WithField("newRetryCount", newRetryCount).
+			WithField("backoffDelay", backoffDelay).
+			Info("message processing failed, added to failed messages with exponential backoff")
+	}
+
+	if err := q.db.WithContext(ctx).Delete(&model.QueueMessage{}, id).Error; err != nil {
+		return fmt.Errorf("failed to delete message ID %s after completion: %w", entryID, err)
+	}
+
+	// Failed tasks remain incomplete, preventing checkpoint advancement past their timestamp
+	if processingErr == nil {
+		if err := q.markInFlightTaskComplete(q.db.WithContext(ctx), q.name, tracking, timestamp); err != nil {
+			q.log.WithError(err).WithField("entryID", tracking).Debug("failed to mark in-flight task as completed")
+		}
+	}
+	return nil
+}
+
+func (q *postgresQueue) addToInFlightTasks(ctx context.Context, entryID string, timestamp int64) error {
+	task := model.QueueInFlightTask{
+		Queue:     q.name,
+		EntryID:   entryID,
+		Timestamp: timestamp,
+	}
+	return q.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&task).Error
+}
+
+// markInFlightTaskComplete marks a task as completed through db, which is the transaction when called from one
+func (q *postgresQueue) markInFlightTaskComplete(db *gorm.DB, queueName string, entryID string, timestamp int64) error {
+	task := model.QueueInFlightTask{
+		Queue:     queueName,
+		EntryID:   entryID,
+		Timestamp: timestamp,
+		Completed: true,
+	}
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "queue"}, {Name: "entry_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"completed"}),
+	}).Create(&task).Error
+	if err != nil {
+		return err
+	}
+	q.log.WithField("entryID", entryID).Debug("successfully marked in-flight task as completed")
+	return nil
+}
+
+func (q *postgresQueue) Close() {
+	q.closed.Store(true)
+}
+
+// ProcessTimedOutMessages moves messages that were claimed longer than the timeout ago to the failed messages.
+func (q *postgresQueue) ProcessTimedOutMessages(ctx context.Context, timeout time.Duration, handler func(entryID string, body []byte) error) (int, error) {
+	if q.closed.Load() {
+		return 0, errors.New("queue is closed")
+	}
+
+	timedOutCount := 0
+	err := q.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := dbNow(ctx, tx, q.log)
+		var msgs []model.QueueMessage
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("queue = ? AND claimed_at < ?", q.name, now.Add(-timeout)).
+			Order("id").
+			Find(&msgs).Error
+		if err != nil {
+			return fmt.Errorf("failed to get timed out messages: %w", err)
+		}
+
+		for i := range msgs {
+			msg := &msgs[i]
+			entryID := entryIDOf(msg)
+			if handler != nil {
+				if err := handler(entryID, msg.Body); err != nil {
+					q.log.WithField("entryID", entryID).WithField("processID", q.processID).WithError(err).Warn("handler failed for timed out message, continuing")
+				}
+			}
+
+			newRetryCount := msg.RetryCount + 1
+			failed := model.QueueFailedMessage{
+				Queue:      q.name,
+				EntryID:    trackingID(msg),
+				Body:       msg.Body,
+				ProcessID:  q.processID,
+				RetryCount: newRetryCount,
+				RetryAt:    now.Add(calculateBackoff(newRetryCount, q.retryConfig)),
+			}
+			if err := tx.Create(&failed).Error; err != nil {
+				return fmt.Errorf("failed to add timed out message %s to failed messages: %w", entryID, err)
+			}
+			if err := tx.Delete(msg).Error; err != nil {
+				return fmt.Errorf("failed to delete timed out message %s: %w", entryID, err)
+			}
+
+			q.log.WithField("entryID", entryID).WithField("processID", q.processID).WithField("currentRetryCount", msg.RetryCount).WithField("newRetryCount", newRetryCount).Info("moved timed out message to failed messages")
+			timedOutCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return timedOutCount, nil
+}
+
+// RetryFailedMessages moves failed messages that are due for retry back to the queue
+func (q *postgresQueue) RetryFailedMessages(ctx context.Context, config RetryConfig, handler func(entryID string, body []byte, retryCount int) error) (int, error) {
+	if q.closed.Load() {
+		return 0, errors.New("queue is closed")
+	}
+
+	retriedCount := 0
+	err := q.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := dbNow(ctx, tx, q.log)
+		var failedMsgs []model.QueueFailedMessage
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("queue = ? AND retry_at <= ?", q.name, now).
+			Order("retry_at").
+			Find(&failedMsgs).Error
+		if err != nil {
+			return fmt.Errorf("failed to get failed messages: %w", err)
+		}
+		q.log.
+			WithField("queue", q.name).
+			WithField("failedMessageCount", len(failedMsgs)).
+			Debug("Found failed messages for retry")
+
+		for i := range failedMsgs {
+			failed := &failedMsgs[i]
+			if err := tx.Delete(failed).Error; err != nil {
+				return fmt.Errorf("failed to remove failed message %s: %w", failed.EntryID, err)
+			}
+
+			// Check if we've exceeded max retries
+			if failed.RetryCount >= config.MaxRetries {
+				q.log.WithField("entryID", failed.EntryID).
+					WithField("processID", failed.ProcessID).
+					WithField("retryCount", failed.RetryCount).
+					Warn("message exceeded max retries, removing from failed messages")
+				if handler != nil {
+					if err := handler(failed.EntryID, failed.Body, failed.RetryCount); err != nil {
+						q.log.WithField("entryID", failed.EntryID).WithField("processID", q.processID).WithError(err).Warn("handler failed for permanently failed message, continuing")
+					}
+				}
+				// Mark task as completed in in-flight tracking so the checkpoint can advance past it.  This is written
+				// within the transaction, so it is rolled back together with the removal of the failed message.
+				if err := q.markInFlightTaskComplete(tx, failed.Queue, failed.EntryID, now.UnixMicro()); err != nil {
+					return fmt.Errorf("failed to mark in-flight task %s as completed: %w", failed.EntryID, err)
+				}
+				continue
+			}
+
+			msg := model.QueueMessage{
+				Queue:      failed.Queue,
+				Body:       failed.Body,
+				Timestamp:  now.UnixMicro(),
+				RetryCount: failed.RetryCount,
+				OriginalID: failed.EntryID,
+			}
+			if err := tx.Create(&msg).Error; err != nil {
+				return fmt.Errorf("failed to add retry message %s to queue: %w", failed.EntryID, err)
+			}
+			q.log.
+				WithField("originalEntryID", failed.EntryID).
+				WithField("newEntryID", entryIDOf(&msg)).
+				WithField("processID", failed.ProcessID).
+				WithField("retryCount", failed.RetryCount).
+				Info("retried failed message to queue")
+			retriedCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return retriedCount, nil
+}
+
+// postgresChannel implements PubSubPublisher and PubSubSubscriber interfaces using LISTEN/NOTIFY
+type postgresChannel struct {
+	db            *gorm.DB
+	name          string
+	log           logrus.FieldLogger
+	wg            *sync.WaitGroup
+	closed        atomic.Bool
+	mu            sync.Mutex
+	subscriptions []*postgresSubscription
+}
+
+func (c *postgresChannel) Publish(ctx context.Context, payload []byte) error {
+	if c.closed.Load() {
+		return errors.New("channel is closed")
+	}
+
+	// Inject tracing context
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	message := map[string]interface{}{
+		"body": string(payload),
+	}
+	for k, v := range carrier {
+		message["ctx_"+k] = v
+	}
+	messageBytes, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal broadcast message: %w", err)
+	}
+	if len(messageBytes) >= postgresMaxNotifyPayload {
+		return fmt.Errorf("broadcast message of %d bytes exceeds the Postgres notification limit", len(messageBytes))
+	}
+
+	if err := c.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", c.name, string(messageBytes)).Error; err != nil {
+		return fmt.Errorf("failed to broadcast message: %w", err)
+	}
+	return nil
+}
+
+func (c *postgresChannel) Subscribe(ctx context.Context, handler PubSubHandler) (Subscription, error) {
+	if c.closed.Load() {
+		return nil, errors.New("channel is closed")
+	}
+
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("db handle error: %w", err)
+	}
+	// Each subscription owns a dedicated connection, since LISTEN is bound to the connection
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	if _, err := conn.ExecContext(ctx, "LISTEN "+pgx.Identifier{c.name}.Sanitize()); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to listen on channel %s: %w", c.name, err)
+	}
+
+	subscription := &postgresSubscription{
+		conn:    conn,
+		name:    c.name,
+		log:     c.log,
+		wg:      c.wg,
+		handler: handler,
+	}
+	subscription.start(ctx)
+
+	c.mu.Lock()
+	c.subscriptions = append(c.subscriptions, subscription)
+	c.mu.Unlock()
+	return subscription, nil
+}
+
+func (c *postgresChannel) Close() {
+	c.closed.Store(true)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, s := range c.subscriptions {
+		s.Close()
+	}
+}
+
+// postgresSubscription represents an active subscription that owns its listening connection
+type postgresSubscription struct {
+	conn    *sql.Conn
+	name    string
+	log     logrus.FieldLogger
+	wg      *sync.WaitGroup
+	handler PubSubHandler
+	closed  atomic.Bool
+	cancel  context.CancelFunc
+}
+
+func (s *postgresSubscription) start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+		// Waiting for a notification is interrupted by canceling the context, which closes the
+		// underlying connection, so it is discarded rather than returned to the pool.
+		defer s.conn.Close()
+
+		for {
+			var payload string
+			err := s.conn.Raw(func(driverConn any) error {
+				c, ok := driverConn.(*stdlib.Conn)
+				if !ok {
+					return fmt.Errorf("unexpected driver connection type %T", driverConn)
+				}
+				notification, err := c.Conn().WaitForNotification(ctx)
+				if err != nil {
+					return err
+				}
+				payload = notification.Payload
+				return nil
+			})
+			if ctx.Err() != nil || s.closed.Load() {
+				return
+			}
+			if err != nil {
+				s.log.WithError(err).Error("error while waiting for broadcast message")
+				return
+			}
+			if err := s.handleBroadcastMessage(ctx, payload); err != nil {
+				s.log.WithError(err).Error("error while handling broadcast message")
+			}
+		}
+	}()
+}
+
+func (s *postgresSubscription) handleBroadcastMessage(ctx context.Context, payload string) error {
+	ctx, parentSpan := tracing.StartSpan(ctx, "flightctl/queues/broadcast", s.name)
+	defer parentSpan.End()
+
+	var message map[string]interface{}
+	if err := json.Unmarshal([]byte(payload), &message); err != nil {
+		parentSpan.RecordError(err)
+		parentSpan.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to unmarshal broadcast message: %w", err)
+	}
+
+	// Extract tracing context
+	carrier := propagation.MapCarrier{}
+	for k, v := range message {
+		if name, found := strings.CutPrefix(k, "ctx_"); found && name != "" {
+			if valStr, ok := v.(string); ok {
+				carrier[name] = valStr
+			}
+		}
+	}
+
+	receivedCtx := otel.GetTextMapPropagator().Extract(ctx, carrier)
+	requestID := reqid.NextRequestID()
+
+	receivedCtx, handlerSpan := tracing.StartSpan(
+		receivedCtx, "flightctl/queues/broadcast", s.name, trace.WithLinks(
+			trace.LinkFromContext(ctx, attribute.String("request.id", requestID))))
+	defer handlerSpan.End()
+
+	handlerSpan.SetAttributes(attribute.String("request.id", requestID))
+	parentSpan.SetAttributes(attribute.String("request.id", requestID))
+
+	receivedCtx = context.WithValue(receivedCtx, middleware.RequestIDKey, requestID)
+	log := log.WithReqIDFromCtx(receivedCtx, s.log)
+
+	bodyStr, ok := message["body"].(string)
+	if !ok {
+		err := fmt.Errorf("unexpected body type %T in broadcast message", message["body"])
+		handlerSpan.RecordError(err)
+		handlerSpan.SetStatus(codes.Error, "unexpected body type")
+		return err
+	}
+
+	if err := s.handler(receivedCtx, []byte(bodyStr), log); err != nil {
+		handlerSpan.RecordError(err)
+		handlerSpan.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("broadcast handler error: %w", err)
+	}
+	return nil
+}
+
+func (s *postgresSubscription) Close() {
+	if s.closed.Swap(true) {
+		return
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
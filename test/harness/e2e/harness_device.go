@@ -88,7 +88,7 @@ func (h *Harness) UpdateDevice(deviceId string, updateFunction func(*v1beta1.Dev
 
 	updateFunction(device)
 
-	resp, err := h.Client.ReplaceDeviceWithResponse(h.Context, deviceId, *device)
+	resp, err := h.Client.ReplaceDeviceWithResponse(h.Context, deviceId, nil, *device)
 	if err != nil {
 		logrus.Errorf("Unexpected error updating device %s: %v", deviceId, err)
 		return err
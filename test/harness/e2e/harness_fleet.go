@@ -93,7 +93,7 @@ func (h *Harness) CreateOrUpdateTestFleet(testFleetName string, fleetSpecOrSelec
 		return fmt.Errorf("first parameter must be either FleetSpec or LabelSelector")
 	}
 
-	_, err := h.Client.ReplaceFleetWithResponse(h.Context, testFleetName, testFleet)
+	_, err := h.Client.ReplaceFleetWithResponse(h.Context, testFleetName, nil, testFleet)
 	return err
 }
 
@@ -224,7 +224,7 @@ func (h *Harness) UpdateFleet(fleetName string, updateFunc func(*v1beta1.Fleet))
 
 	updateFunc(fleet)
 
-	replaceResp, err := h.Client.ReplaceFleetWithResponse(h.Context, fleetName, *fleet)
+	replaceResp, err := h.Client.ReplaceFleetWithResponse(h.Context, fleetName, nil, *fleet)
 	if err != nil {
 		logrus.Errorf("Unexpected error updating fleet %s: %v", fleetName, err)
 		return err
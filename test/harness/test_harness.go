@@ -171,7 +171,7 @@ func NewTestHarness(ctx context.Context, testDirPath string, goRoutineErrorHandl
 
 	ctrl := gomock.NewController(GinkgoT())
 	mockK8sClient := k8sclient.NewMockK8SClient(ctrl)
-	workerServer := workerserver.New(&serverCfg, serverLog, store, provider, mockK8sClient, nil)
+	workerServer := workerserver.New(&serverCfg, serverLog, store, provider, mockK8sClient, nil, nil)
 
 	agentServer, agentListener, err := testutil.NewTestAgentServer(ctx, serverLog, &serverCfg, store, ca, serverCerts, provider)
 	if err != nil {
@@ -73,8 +73,8 @@ var _ = Describe("Alert Exporter", func() {
 		Expect(err).ToNot(HaveOccurred())
 		serviceHandler = service.NewServiceHandler(storeInst, workerClient, kvStore, nil, log, "", "", []string{})
 		checkpointManager = alert_exporter.NewCheckpointManager(log, serviceHandler)
-		eventProcessor = alert_exporter.NewEventProcessor(log, serviceHandler)
-		alertSender = alert_exporter.NewAlertSender(log, cfg.Alertmanager.Hostname, cfg.Alertmanager.Port, cfg)
+		eventProcessor = alert_exporter.NewEventProcessor(log, serviceHandler, nil)
+		alertSender = alert_exporter.NewAlertSender(log, cfg)
 
 		err = db.WithContext(ctx).Exec(`
 				DELETE FROM checkpoints
@@ -283,7 +283,7 @@ var _ = Describe("Alert Exporter", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			// Create AlertSender with invalid hostname to simulate unreachable Alertmanager
-			badAlertSender := alert_exporter.NewAlertSender(log, "invalid-hostname", 9999, cfg)
+			badAlertSender := newAlertSenderFor(log, cfg, "invalid-hostname", 9999)
 
 			// This should fail but not crash
 			err = badAlertSender.SendAlerts(checkpoint)
@@ -302,7 +302,7 @@ var _ = Describe("Alert Exporter", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			// Mock Alertmanager with wrong port (should return connection refused)
-			badAlertSender := alert_exporter.NewAlertSender(log, "localhost", 9999, cfg)
+			badAlertSender := newAlertSenderFor(log, cfg, "localhost", 9999)
 
 			err = badAlertSender.SendAlerts(checkpoint)
 			Expect(err).To(HaveOccurred())
@@ -329,7 +329,7 @@ var _ = Describe("Alert Exporter", func() {
 			Expect(len(checkpoint.Alerts)).To(BeNumerically(">", 0))
 
 			// Even if alert sending fails, the checkpoint should contain the alerts
-			badAlertSender := alert_exporter.NewAlertSender(log, "invalid-hostname", 9999, cfg)
+			badAlertSender := newAlertSenderFor(log, cfg, "invalid-hostname", 9999)
 			err = badAlertSender.SendAlerts(checkpoint)
 			Expect(err).To(HaveOccurred())
 
@@ -649,3 +649,13 @@ func replayEventsFromFreshState(ctx context.Context, prefix string, db *gorm.DB,
 		Expect(string(checkpointBytes)).ToNot(ContainSubstring(`"DeviceCPUWarning"`))
 	}
 }
+
+// newAlertSenderFor returns an AlertSender pushing to the given Alertmanager instead of the configured one.
+func newAlertSenderFor(log *logrus.Logger, cfg *config.Config, hostname string, port uint) *alert_exporter.AlertSender {
+	alertmanagerCfg := *cfg.Alertmanager
+	alertmanagerCfg.Hostname = hostname
+	alertmanagerCfg.Port = port
+	senderCfg := *cfg
+	senderCfg.Alertmanager = &alertmanagerCfg
+	return alert_exporter.NewAlertSender(log, &senderCfg)
+}
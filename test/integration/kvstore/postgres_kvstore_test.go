@@ -0,0 +1,322 @@
+package kvstore_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/store"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("PostgresKVStore", func() {
+	var (
+		ctx       context.Context
+		orgId     uuid.UUID
+		kvStore   kvstore.KVStore
+		log       *logrus.Logger
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+		db        *gorm.DB
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+		orgId, _ = uuid.NewUUID()
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, db = store.PrepareDBForUnitTests(ctx, log)
+		cfg.KV.Provider = config.KVProviderPostgres
+		var err error
+		kvStore, err = kvstore.New(ctx, log, cfg, db)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := kvStore.DeleteAllKeys(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		kvStore.Close()
+		store.DeleteTestDB(ctx, log, cfg, storeInst, dbName)
+	})
+
+	When("fetching a git revision", func() {
+		It("returns what is stored if the key exists", func() {
+			key := kvstore.GitRevisionKey{
+				OrgID:           orgId,
+				Fleet:           "myfleet",
+				TemplateVersion: "mytv",
+				Repository:      "myrepo",
+				TargetRevision:  "main",
+			}
+
+			updated, err := kvStore.SetNX(ctx, key.ComposeKey(), []byte("abc123"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			updated, err = kvStore.SetNX(ctx, key.ComposeKey(), []byte("def456"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeFalse())
+
+			hash, err := kvStore.Get(ctx, key.ComposeKey())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).To(Equal([]byte("abc123")))
+		})
+
+		It("returns an empty string if the key doesn't exist", func() {
+			key := kvstore.GitRevisionKey{
+				OrgID:           orgId,
+				Fleet:           "myfleet",
+				TemplateVersion: "mytv",
+				Repository:      "myrepo",
+				TargetRevision:  "main",
+			}
+
+			hash, err := kvStore.Get(ctx, key.ComposeKey())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).To(HaveLen(0))
+		})
+	})
+
+	When("setting a repo URL", func() {
+		It("stores what is passed if the key doesn't exist", func() {
+			key := kvstore.RepositoryUrlKey{
+				OrgID:           orgId,
+				Fleet:           "myfleet",
+				TemplateVersion: "mytv",
+				Repository:      "myrepo",
+			}
+
+			url, err := kvStore.GetOrSetNX(ctx, key.ComposeKey(), []byte("https://myurl"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).To(Equal([]byte("https://myurl")))
+		})
+
+		It("returns what is stored if the key exists", func() {
+			key := kvstore.RepositoryUrlKey{
+				OrgID:           orgId,
+				Fleet:           "myfleet",
+				TemplateVersion: "mytv",
+				Repository:      "myrepo",
+			}
+
+			url, err := kvStore.GetOrSetNX(ctx, key.ComposeKey(), []byte("https://myurl"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).To(Equal([]byte("https://myurl")))
+
+			url, err = kvStore.GetOrSetNX(ctx, key.ComposeKey(), []byte("https://otherurl"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).To(Equal([]byte("https://myurl")))
+		})
+	})
+
+	When("deleting a TemplateVersion", func() {
+		It("deletes all its related keys", func() {
+			key := kvstore.RepositoryUrlKey{
+				OrgID:           orgId,
+				Fleet:           "myfleet",
+				TemplateVersion: "mytv",
+				Repository:      "myrepo",
+			}
+
+			updated, err := kvStore.SetNX(ctx, key.ComposeKey(), []byte("https://myurl"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			key.TemplateVersion = "othertv"
+			updated, err = kvStore.SetNX(ctx, key.ComposeKey(), []byte("https://otherurl"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			key2 := kvstore.GitRevisionKey{
+				OrgID:           orgId,
+				Fleet:           "myfleet",
+				TemplateVersion: "mytv",
+				Repository:      "myrepo",
+				TargetRevision:  "main",
+			}
+
+			updated, err = kvStore.SetNX(ctx, key2.ComposeKey(), []byte("abc123"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			key2.TemplateVersion = "othertv"
+			updated, err = kvStore.SetNX(ctx, key2.ComposeKey(), []byte("def456"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			tvkey := kvstore.TemplateVersionKey{OrgID: orgId, Fleet: "myfleet", TemplateVersion: "mytv"}
+			err = kvStore.DeleteKeysForTemplateVersion(ctx, tvkey.ComposeKey())
+			Expect(err).ToNot(HaveOccurred())
+
+			ret, err := kvStore.Get(ctx, key.ComposeKey())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(Equal([]byte("https://otherurl")))
+			ret, err = kvStore.Get(ctx, key2.ComposeKey())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(Equal([]byte("def456")))
+
+			key.TemplateVersion = "mytv"
+			key2.TemplateVersion = "mytv"
+			ret, err = kvStore.Get(ctx, key.ComposeKey())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(BeEmpty())
+			ret, err = kvStore.Get(ctx, key2.ComposeKey())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(BeEmpty())
+		})
+
+		It("does not treat LIKE wildcards in the key as patterns", func() {
+			updated, err := kvStore.SetNX(ctx, "v1/org/fleet/tv_1/repo", []byte("a"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			updated, err = kvStore.SetNX(ctx, "v1/org/fleet/tvx1/repo", []byte("b"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			err = kvStore.DeleteKeysForTemplateVersion(ctx, "v1/org/fleet/tv_1/")
+			Expect(err).ToNot(HaveOccurred())
+
+			ret, err := kvStore.Get(ctx, "v1/org/fleet/tv_1/repo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(BeEmpty())
+			ret, err = kvStore.Get(ctx, "v1/org/fleet/tvx1/repo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(Equal([]byte("b")))
+		})
+	})
+
+	When("setting a value only if it is greater", func() {
+		It("keeps the greatest value", func() {
+			updated, err := kvStore.SetIfGreater(ctx, "counter", 5)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			updated, err = kvStore.SetIfGreater(ctx, "counter", 3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeFalse())
+
+			updated, err = kvStore.SetIfGreater(ctx, "counter", 5)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeFalse())
+
+			updated, err = kvStore.SetIfGreater(ctx, "counter", 7)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			ret, err := kvStore.Get(ctx, "counter")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(Equal([]byte("7")))
+		})
+	})
+
+	When("a key expires", func() {
+		It("is no longer returned and can be set again", func() {
+			updated, err := kvStore.SetNX(ctx, "expiring", []byte("old"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(kvStore.SetExpire(ctx, "expiring", 500*time.Millisecond)).To(Succeed())
+
+			ret, err := kvStore.Get(ctx, "expiring")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ret).To(Equal([]byte("old")))
+
+			Eventually(func() []byte {
+				ret, err := kvStore.Get(ctx, "expiring")
+				Expect(err).ToNot(HaveOccurred())
+				return ret
+			}, 5*time.Second, 100*time.Millisecond).Should(BeEmpty())
+
+			// an expired key is replaced without an expiration
+			updated, err = kvStore.SetNX(ctx, "expiring", []byte("new"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Consistently(func() []byte {
+				ret, err := kvStore.Get(ctx, "expiring")
+				Expect(err).ToNot(HaveOccurred())
+				return ret
+			}, time.Second, 100*time.Millisecond).Should(Equal([]byte("new")))
+		})
+
+		It("expires the entries of a stream with its key", func() {
+			_, err := kvStore.StreamAdd(ctx, "stream", []byte("entry"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kvStore.SetExpire(ctx, "stream", 500*time.Millisecond)).To(Succeed())
+
+			Eventually(func() []kvstore.StreamEntry {
+				entries, err := kvStore.StreamRange(ctx, "stream", "-", "+")
+				Expect(err).ToNot(HaveOccurred())
+				return entries
+			}, 5*time.Second, 100*time.Millisecond).Should(BeEmpty())
+		})
+	})
+
+	When("using streams", func() {
+		It("returns the entries in order", func() {
+			var ids []string
+			for _, value := range []string{"one", "two", "three"} {
+				id, err := kvStore.StreamAdd(ctx, "stream", []byte(value))
+				Expect(err).ToNot(HaveOccurred())
+				ids = append(ids, id)
+			}
+
+			entries, err := kvStore.StreamRange(ctx, "stream", "-", "+")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]kvstore.StreamEntry{
+				{ID: ids[0], Value: []byte("one")},
+				{ID: ids[1], Value: []byte("two")},
+				{ID: ids[2], Value: []byte("three")},
+			}))
+
+			entries, err = kvStore.StreamRange(ctx, "stream", ids[1], ids[1])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]kvstore.StreamEntry{{ID: ids[1], Value: []byte("two")}}))
+
+			entries, err = kvStore.StreamRead(ctx, "stream", ids[0], 0, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]kvstore.StreamEntry{{ID: ids[1], Value: []byte("two")}}))
+		})
+
+		It("blocks until a new entry is added", func() {
+			_, err := kvStore.StreamAdd(ctx, "stream", []byte("old"))
+			Expect(err).ToNot(HaveOccurred())
+
+			result := make(chan []kvstore.StreamEntry, 1)
+			go func() {
+				defer GinkgoRecover()
+				entries, err := kvStore.StreamRead(ctx, "stream", "$", 5*time.Second, 0)
+				Expect(err).ToNot(HaveOccurred())
+				result <- entries
+			}()
+
+			Consistently(result, 500*time.Millisecond).ShouldNot(Receive())
+			_, err = kvStore.StreamAdd(ctx, "stream", []byte("new"))
+			Expect(err).ToNot(HaveOccurred())
+
+			var entries []kvstore.StreamEntry
+			Eventually(result, 5*time.Second).Should(Receive(&entries))
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Value).To(Equal([]byte("new")))
+		})
+
+		It("returns nothing once the blocking timeout passes", func() {
+			entries, err := kvStore.StreamRead(ctx, "stream", "0", 300*time.Millisecond, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+
+		It("deletes the stream with its key", func() {
+			_, err := kvStore.StreamAdd(ctx, "stream", []byte("entry"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kvStore.Delete(ctx, "stream")).To(Succeed())
+
+			entries, err := kvStore.StreamRange(ctx, "stream", "-", "+")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+})
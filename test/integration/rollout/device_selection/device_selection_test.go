@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"testing"
 	"time"
@@ -384,6 +385,100 @@ var _ = Describe("Rollout batch sequence test", func() {
 			Expect(storeInst.Device().UpdateAnnotations(ctx, store.NullOrgId, deviceName, annotations, nil)).ToNot(HaveOccurred())
 		}
 
+		// initCanaryTest creates a fleet rolling out a new template version with a canary of 2 out of 4 devices.  It
+		// returns the name of the template version that was deployed before the rollout started.
+		initCanaryTest := func(soakDuration string, rollbackOnFailure *bool) (device_selection.RolloutDeviceSelector, string) {
+			deviceSelection := &api.RolloutDeviceSelection{}
+			Expect(deviceSelection.FromCanary(api.Canary{
+				Strategy: api.RolloutStrategyCanary,
+				Cohort: api.Batch{
+					Selector: &api.LabelSelector{
+						MatchLabels: &labels1,
+					},
+					Limit: intLimit(2),
+				},
+				SoakDuration:      lo.ToPtr(soakDuration),
+				RollbackOnFailure: rollbackOnFailure,
+			})).ToNot(HaveOccurred())
+			_, err := storeInst.Fleet().Create(ctx, store.NullOrgId, &api.Fleet{
+				Metadata: api.ObjectMeta{
+					Name: lo.ToPtr(FleetName),
+				},
+				Spec: api.FleetSpec{
+					RolloutPolicy: &api.RolloutPolicy{
+						DeviceSelection: deviceSelection,
+					},
+				},
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			createTestTemplateVersion(FleetName)
+			previousTvName := tvName
+			createTestTemplateVersion(FleetName)
+			Expect(tvName).ToNot(Equal(previousTvName))
+			annotations := map[string]string{
+				api.FleetAnnotationPreviousTemplateVersion: previousTvName,
+			}
+			Expect(storeInst.Fleet().UpdateAnnotations(ctx, store.NullOrgId, FleetName, annotations, nil, nil)).ToNot(HaveOccurred())
+			testutil.CreateTestDevices(ctx, 4, storeInst.Device(), store.NullOrgId, util.SetResourceOwner(api.FleetKind, FleetName), false)
+			setLabels([]map[string]string{labels1}, []int{3})
+			fleet, err := storeInst.Fleet().Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			selector, err := device_selection.NewRolloutDeviceSelector(fleet.Spec.RolloutPolicy.DeviceSelection, nil, serviceHandler, store.NullOrgId, fleet, tvName, log)
+			Expect(err).ToNot(HaveOccurred())
+			return selector, previousTvName
+		}
+
+		setHealthy := func(deviceName string) {
+			setRendered(deviceName)
+			device, err := storeInst.Device().Get(ctx, store.NullOrgId, deviceName)
+			Expect(err).ToNot(HaveOccurred())
+			device.Status.Config.RenderedVersion = "5"
+			device.Status.Updated.Status = api.DeviceUpdatedStatusUpToDate
+			device.Status.Summary.Status = api.DeviceSummaryStatusOnline
+			device.Status.ApplicationsSummary.Status = api.ApplicationsSummaryStatusHealthy
+			_, err = storeInst.Device().UpdateStatus(ctx, store.NullOrgId, device, nil)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		getFleet := func() *api.Fleet {
+			fleet, err := storeInst.Fleet().Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			return fleet
+		}
+
+		getRolloutReason := func() string {
+			condition := api.FindStatusCondition(getFleet().Status.Conditions, api.ConditionTypeFleetRolloutInProgress)
+			Expect(condition).ToNot(BeNil())
+			return condition.Reason
+		}
+
+		// suspendFailedCanary rolls out the cohort, fails one of its 2 devices and lets the selector suspend the
+		// rollout before the rest of the fleet is promoted
+		suspendFailedCanary := func(selector device_selection.RolloutDeviceSelector) {
+			selection := processBatch(selector, 2, labels1)
+			devices, err := selection.Devices(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			setHealthy(lo.FromPtr(devices.Items[0].Metadata.Name))
+			setFailed(lo.FromPtr(devices.Items[1].Metadata.Name))
+
+			// The first check starts the soak, the next one stops it early since the cohort is below the threshold
+			Expect(selection.IsComplete(ctx)).To(BeFalse())
+			selection, err = selector.CurrentSelection(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selection.IsComplete(ctx)).To(BeTrue())
+			Expect(selection.SetCompletionReport(ctx)).ToNot(HaveOccurred())
+
+			Expect(selector.HasMoreSelections(ctx)).To(BeTrue())
+			Expect(selector.Advance(ctx)).ToNot(HaveOccurred())
+			setAutomaticApproval(FleetName)
+			selection, err = selector.CurrentSelection(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			mayApprove, err := selection.MayApproveAutomatically()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mayApprove).To(BeFalse())
+			Expect(selection.OnSuspended(ctx)).ToNot(HaveOccurred())
+		}
+
 		It("single batch - no devices", func() {
 			selector := initTest(singleElementBatchSequence, 0, nil)
 			processBatch(selector, 0, nil)
@@ -439,6 +534,130 @@ var _ = Describe("Rollout batch sequence test", func() {
 			Expect(selection.IsRolledOut(ctx)).To(Equal(true))
 			Expect(selection.IsComplete(ctx)).To(Equal(true))
 		})
+		It("canary - cohort followed by the rest of the fleet", func() {
+			deviceSelection := &api.RolloutDeviceSelection{}
+			Expect(deviceSelection.FromCanary(api.Canary{
+				Strategy: api.RolloutStrategyCanary,
+				Cohort: api.Batch{
+					Selector: &api.LabelSelector{
+						MatchLabels: &labels1,
+					},
+					Limit: intLimit(2),
+				},
+			})).ToNot(HaveOccurred())
+			fleet, err := storeInst.Fleet().Create(ctx, store.NullOrgId, &api.Fleet{
+				Metadata: api.ObjectMeta{
+					Name: lo.ToPtr(FleetName),
+				},
+				Spec: api.FleetSpec{
+					RolloutPolicy: &api.RolloutPolicy{
+						DeviceSelection: deviceSelection,
+					},
+				},
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			createTestTemplateVersion(FleetName)
+			testutil.CreateTestDevices(ctx, 6, storeInst.Device(), store.NullOrgId, util.SetResourceOwner(api.FleetKind, FleetName), false)
+			setLabels([]map[string]string{labels1, labels2}, []int{4, 1})
+			selector, err := device_selection.NewRolloutDeviceSelector(fleet.Spec.RolloutPolicy.DeviceSelection, nil, serviceHandler, store.NullOrgId, fleet, tvName, log)
+			Expect(err).ToNot(HaveOccurred())
+			processBatch(selector, 2, labels1)
+			processBatch(selector, 4, nil)
+			Expect(selector.HasMoreSelections(ctx)).To(BeTrue())
+			processBatch(selector, 0, nil)
+			Expect(selector.HasMoreSelections(ctx)).To(BeFalse())
+		})
+		It("canary - cohort is not complete before the soak duration elapses", func() {
+			selector, _ := initCanaryTest("1h", nil)
+			selection := processBatch(selector, 2, labels1)
+			devices, err := selection.Devices(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			for _, d := range devices.Items {
+				setHealthy(lo.FromPtr(d.Metadata.Name))
+			}
+
+			// Updating the cohort starts the soak
+			Expect(selection.IsComplete(ctx)).To(BeFalse())
+			soakStartTime, exists := getFleet().GetAnnotation(api.FleetAnnotationCanarySoakStartTime)
+			Expect(exists).To(BeTrue())
+
+			// A healthy cohort keeps soaking
+			selection, err = selector.CurrentSelection(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selection.IsComplete(ctx)).To(BeFalse())
+			currentSoakStartTime, _ := getFleet().GetAnnotation(api.FleetAnnotationCanarySoakStartTime)
+			Expect(currentSoakStartTime).To(Equal(soakStartTime))
+
+			annotations := map[string]string{
+				api.FleetAnnotationCanarySoakStartTime: time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+			}
+			Expect(storeInst.Fleet().UpdateAnnotations(ctx, store.NullOrgId, FleetName, annotations, nil, nil)).ToNot(HaveOccurred())
+			selection, err = selector.CurrentSelection(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selection.IsComplete(ctx)).To(BeTrue())
+		})
+		It("canary - cohort below the success threshold rolls the fleet back", func() {
+			mockWorkerClient.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			selector, previousTvName := initCanaryTest("1h", nil)
+			suspendFailedCanary(selector)
+
+			fleet := getFleet()
+			Expect(lo.FromPtr(fleet.Metadata.Annotations)[api.FleetAnnotationTemplateVersion]).To(Equal(previousTvName))
+			Expect(lo.FromPtr(fleet.Metadata.Annotations)[api.FleetAnnotationRollbackTemplateVersion]).To(Equal(previousTvName))
+			for _, key := range []string{
+				api.FleetAnnotationBatchNumber,
+				api.FleetAnnotationCanarySoakStartTime,
+				api.FleetAnnotationLastBatchCompletionReport,
+				api.FleetAnnotationPreviousTemplateVersion,
+			} {
+				_, exists := fleet.GetAnnotation(key)
+				Expect(exists).To(BeFalse(), key)
+			}
+			Expect(fleet.Status.Rollout).ToNot(BeNil())
+			Expect(fleet.Status.Rollout.LastRollback).ToNot(BeNil())
+			Expect(fleet.Status.Rollout.LastRollback.TemplateVersion).To(Equal(previousTvName))
+			Expect(fleet.Status.Rollout.LastRollback.FromTemplateVersion).To(Equal(lo.ToPtr(tvName)))
+			Expect(getRolloutReason()).To(Equal(api.RolloutRolledBackReason))
+
+			// None of the devices is left selected for the abandoned rollout
+			devices, err := storeInst.Device().List(ctx, store.NullOrgId, store.ListParams{})
+			Expect(err).ToNot(HaveOccurred())
+			for _, d := range devices.Items {
+				Expect(lo.FromPtr(d.Metadata.Annotations)).ToNot(HaveKey(api.DeviceAnnotationSelectedForRollout), lo.FromPtr(d.Metadata.Name))
+			}
+		})
+		It("canary - rollback while the canary is suspended", func() {
+			mockWorkerClient.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			selector, previousTvName := initCanaryTest("1h", lo.ToPtr(false))
+			suspendFailedCanary(selector)
+
+			// Without rollback on failure, the rollout is only suspended
+			Expect(getRolloutReason()).To(Equal(api.RolloutSuspendedReason))
+			Expect(lo.FromPtr(getFleet().Metadata.Annotations)[api.FleetAnnotationTemplateVersion]).To(Equal(tvName))
+
+			fleet, status := serviceHandler.RollbackFleet(ctx, store.NullOrgId, FleetName, api.FleetRollbackRequest{TemplateVersion: previousTvName})
+			Expect(status.Code).To(BeEquivalentTo(http.StatusOK))
+			Expect(lo.FromPtr(fleet.Metadata.Annotations)[api.FleetAnnotationTemplateVersion]).To(Equal(previousTvName))
+			for _, key := range []string{
+				api.FleetAnnotationBatchNumber,
+				api.FleetAnnotationCanarySoakStartTime,
+				api.FleetAnnotationLastBatchCompletionReport,
+			} {
+				_, exists := fleet.GetAnnotation(key)
+				Expect(exists).To(BeFalse(), key)
+			}
+			Expect(getRolloutReason()).To(Equal(api.RolloutRolledBackReason))
+
+			// The suspended rollout doesn't resume once the fleet is pinned to the rolled back template version
+			ctx = context.WithValue(ctx, chi.RequestIDKey, reqid.NextRequestID())
+			device_selection.NewReconciler(serviceHandler, log).Reconcile(ctx, store.NullOrgId)
+			_, exists := getFleet().GetAnnotation(api.FleetAnnotationBatchNumber)
+			Expect(exists).To(BeFalse())
+			Expect(getRolloutReason()).To(Equal(api.RolloutRolledBackReason))
+
+			_, status = serviceHandler.RollbackFleet(ctx, store.NullOrgId, FleetName, api.FleetRollbackRequest{TemplateVersion: previousTvName})
+			Expect(status.Code).To(BeEquivalentTo(http.StatusConflict))
+		})
 		DescribeTable("may approve automatically",
 			func(lastSuccessPercentage int, automaticApproval bool, threshold *string, expectedMayApprove bool) {
 				selector := initTestWithThreshold(singleElementBatchSequence, 1, lo.ToPtr("20s"), threshold)
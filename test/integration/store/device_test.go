@@ -290,6 +290,82 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(len(devices.Items)).To(Equal(3))
 		})
 
+		It("List with application, resource, system info and last seen field filters", func() {
+			device, err := devStore.Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			device.Status.Applications = []domain.DeviceApplicationStatus{
+				{Name: "web", Status: domain.ApplicationStatusError},
+				{Name: "db", Status: domain.ApplicationStatusRunning},
+			}
+			device.Status.Resources.Disk = domain.DeviceResourceStatusCritical
+			device.Status.SystemInfo.AdditionalProperties = map[string]string{
+				"kernel":             "5.14.0-427.el9.x86_64",
+				"managementCAKeyIds": "0a1b,2c3d",
+			}
+			_, err = devStore.UpdateStatus(ctx, orgId, device, callback)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(devStore.Healthcheck(ctx, orgId, []string{"mydevice-1"})).To(Succeed())
+
+			tests := map[string][]string{
+				"status.applications.status=Error":                       {"mydevice-1"},
+				"status.applications.status in (Error, Degraded)":        {"mydevice-1"},
+				"status.applications.status!=Error":                      {"mydevice-2", "mydevice-3"},
+				"status.applications.name=db":                            {"mydevice-1"},
+				"status.resources.disk=Critical":                         {"mydevice-1"},
+				"status.os.image!=quay.io/flightctl/test-osimage:latest": {},
+				"status.systemInfo.kernel=5.14.0-427.el9.x86_64":         {"mydevice-1"},
+				"status.systemInfo.managementCAKeyIds contains 2c3d":     {"mydevice-1"},
+				"lastSeen>2020-01-01T00:00:00Z":                          {"mydevice-1"},
+			}
+			for fieldSelector, expected := range tests {
+				devices, err := devStore.List(ctx, orgId, store.ListParams{
+					Limit:         1000,
+					FieldSelector: selector.NewFieldSelectorOrDie(fieldSelector),
+				})
+				Expect(err).ToNot(HaveOccurred(), fieldSelector)
+				names := lo.Map(devices.Items, func(d domain.Device, _ int) string { return *d.Metadata.Name })
+				Expect(names).To(ConsistOf(expected), fieldSelector)
+			}
+		})
+
+		It("Aggregate by labels and status fields", func() {
+			device, err := devStore.Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			device.Status.Summary.Status = domain.DeviceSummaryStatusError
+			_, err = devStore.UpdateStatus(ctx, orgId, device, callback)
+			Expect(err).ToNot(HaveOccurred())
+
+			aggregate, err := devStore.Aggregate(ctx, orgId, store.ListParams{}, []string{"metadata.labels.otherkey", "status.summary.status"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(aggregate.Total).To(Equal(int64(3)))
+			Expect(aggregate.Groups).To(Equal([]domain.DeviceGroupCount{
+				{Values: map[string]string{"metadata.labels.otherkey": "othervalue", "status.summary.status": string(domain.DeviceSummaryStatusUnknown)}, Count: 2},
+				{Values: map[string]string{"metadata.labels.otherkey": "othervalue", "status.summary.status": string(domain.DeviceSummaryStatusError)}, Count: 1},
+			}))
+			Expect(aggregate.Truncated).To(BeFalse())
+
+			// Only the largest groups are returned, but all devices are counted
+			aggregate, err = devStore.Aggregate(ctx, orgId, store.ListParams{Limit: 1}, []string{"metadata.labels.otherkey", "status.summary.status"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(aggregate.Total).To(Equal(int64(3)))
+			Expect(aggregate.Truncated).To(BeTrue())
+			Expect(aggregate.Groups).To(Equal([]domain.DeviceGroupCount{
+				{Values: map[string]string{"metadata.labels.otherkey": "othervalue", "status.summary.status": string(domain.DeviceSummaryStatusUnknown)}, Count: 2},
+			}))
+
+			aggregate, err = devStore.Aggregate(ctx, orgId, store.ListParams{
+				LabelSelector: selector.NewLabelSelectorFromMapOrDie(map[string]string{"key": "value-2"}),
+			}, []string{"metadata.labels.missing", "metadata.owner"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(aggregate.Total).To(Equal(int64(1)))
+			Expect(aggregate.Groups).To(Equal([]domain.DeviceGroupCount{{Values: map[string]string{}, Count: 1}}))
+
+			_, err = devStore.Aggregate(ctx, orgId, store.ListParams{}, []string{"status.applications.status"})
+			Expect(err).To(HaveOccurred())
+			var se *selector.SelectorError
+			Expect(selector.AsSelectorError(err, &se)).To(BeTrue())
+		})
+
 		It("List with owner selector", func() {
 			testutil.CreateTestDevice(ctx, devStore, orgId, "fleet-a-device", lo.ToPtr("Fleet/fleet-a"), nil, nil)
 			testutil.CreateTestDevice(ctx, devStore, orgId, "fleet-b-device", lo.ToPtr("Fleet/fleet-b"), nil, nil)
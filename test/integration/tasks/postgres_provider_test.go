@@ -0,0 +1,553 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/queues"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Postgres state helpers, the queue tables are inspected directly instead of sleeping
+func countPostgresQueueMessages(ctx context.Context, db *gorm.DB, queueName string) int64 {
+	var count int64
+	Expect(db.WithContext(ctx).Model(&model.QueueMessage{}).Where("queue = ?", queueName).Count(&count).Error).ToNot(HaveOccurred())
+	return count
+}
+
+func countPostgresClaimedMessages(ctx context.Context, db *gorm.DB, queueName string) int64 {
+	var count int64
+	Expect(db.WithContext(ctx).Model(&model.QueueMessage{}).Where("queue = ? AND claimed_at IS NOT NULL", queueName).Count(&count).Error).ToNot(HaveOccurred())
+	return count
+}
+
+func getPostgresFailedMessages(ctx context.Context, db *gorm.DB, queueName string) []model.QueueFailedMessage {
+	var failed []model.QueueFailedMessage
+	Expect(db.WithContext(ctx).Where("queue = ?", queueName).Order("id").Find(&failed).Error).ToNot(HaveOccurred())
+	return failed
+}
+
+// postgresInFlightTasks returns the number of completed and incomplete in-flight tasks of the queue
+func postgresInFlightTasks(ctx context.Context, db *gorm.DB, queueName string) (int, int) {
+	var tasks []model.QueueInFlightTask
+	Expect(db.WithContext(ctx).Where("queue = ?", queueName).Find(&tasks).Error).ToNot(HaveOccurred())
+	completed, incomplete := 0, 0
+	for _, task := range tasks {
+		if task.Completed {
+			completed++
+		} else {
+			incomplete++
+		}
+	}
+	return completed, incomplete
+}
+
+// makePostgresFailedMessagesDue moves the retry time of the failed messages of the queue, so
+// tests do not depend on the backoff delay
+func makePostgresFailedMessagesDue(ctx context.Context, db *gorm.DB, queueName string, due bool) {
+	retryAt := "now() + interval '1 hour'"
+	if due {
+		retryAt = "now() - interval '1 second'"
+	}
+	Expect(db.WithContext(ctx).Exec("UPDATE queue_failed_messages SET retry_at = "+retryAt+" WHERE queue = ?", queueName).Error).ToNot(HaveOccurred())
+}
+
+var _ = Describe("Postgres Provider Integration Tests", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		cancel    context.CancelFunc
+		provider  queues.Provider
+		processID string
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+		db        *gorm.DB
+		queueName string
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(testutil.StartSpecTracerForGinkgo(suiteCtx))
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, db = store.PrepareDBForUnitTests(ctx, log)
+		processID = fmt.Sprintf("test-process-%s", uuid.New().String())
+		queueName = fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+		// Every test gets its own database, so the global in-flight tasks and checkpoint start empty
+		var err error
+		provider, err = queues.NewProvider(ctx, log, processID, queues.ProviderOptions{
+			Type: queues.ProviderTypePostgres,
+			DB:   db,
+		}, queues.RetryConfig{
+			BaseDelay:    100 * time.Millisecond,
+			MaxRetries:   3,
+			MaxDelay:     500 * time.Millisecond,
+			JitterFactor: 0.0,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+		provider.Stop()
+		provider.Wait()
+		store.DeleteTestDB(ctx, log, cfg, storeInst, dbName)
+	})
+
+	// consume starts consuming the queue, completing every message with the error returned by result
+	consume := func(consumer queues.QueueConsumer, received chan<- string, result func(payload string) error) context.CancelFunc {
+		consumerCtx, consumerCancel := context.WithCancel(ctx)
+		err := consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+			err := consumer.Complete(ctx, entryID, payload, result(string(payload)))
+			received <- string(payload)
+			return err
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return consumerCancel
+	}
+	succeed := func(string) error { return nil }
+	fail := func(string) error { return errors.New("processing error") }
+
+	Describe("Basic Queue Operations", func() {
+		It("should enqueue and consume messages in order", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			messages := []string{"message1", "message2", "message3"}
+			for _, msg := range messages {
+				Expect(producer.Enqueue(ctx, []byte(msg), time.Now().UnixMicro())).To(Succeed())
+			}
+
+			received := make(chan string, len(messages))
+			defer consume(consumer, received, succeed)()
+
+			// a single consumer claims the messages in the order they were enqueued
+			for _, msg := range messages {
+				Eventually(received, 5*time.Second).Should(Receive(Equal(msg)))
+			}
+			Consistently(received, time.Second).ShouldNot(Receive())
+
+			// completed messages are deleted
+			Eventually(func() int64 {
+				return countPostgresQueueMessages(ctx, db, queueName)
+			}, 5*time.Second, 100*time.Millisecond).Should(BeZero())
+			completed, incomplete := postgresInFlightTasks(ctx, db, queueName)
+			Expect(completed).To(Equal(len(messages)))
+			Expect(incomplete).To(BeZero())
+		})
+
+		It("should deliver every message to exactly one of several consumers", func() {
+			otherProvider, err := queues.NewPostgresProvider(ctx, log, fmt.Sprintf("test-process-%s", uuid.New().String()), db, queues.DefaultRetryConfig())
+			Expect(err).ToNot(HaveOccurred())
+			defer func() {
+				otherProvider.Stop()
+				otherProvider.Wait()
+			}()
+
+			consumer1, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			consumer2, err := otherProvider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			received := make(chan string, 20)
+			defer consume(consumer1, received, succeed)()
+			defer consume(consumer2, received, succeed)()
+
+			var messages []string
+			for i := 0; i < 10; i++ {
+				msg := fmt.Sprintf("msg%d", i)
+				messages = append(messages, msg)
+				Expect(producer.Enqueue(ctx, []byte(msg), time.Now().UnixMicro())).To(Succeed())
+			}
+
+			seen := map[string]int{}
+			for range messages {
+				var msg string
+				Eventually(received, 10*time.Second).Should(Receive(&msg))
+				seen[msg]++
+			}
+			Consistently(received, 2*time.Second).ShouldNot(Receive())
+			for _, msg := range messages {
+				Expect(seen[msg]).To(Equal(1), "message %s", msg)
+			}
+		})
+
+		It("should not accept messages once the provider is stopped", func() {
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			provider.Stop()
+			Expect(producer.Enqueue(ctx, []byte("late"), time.Now().UnixMicro())).ToNot(Succeed())
+			_, err = provider.NewQueueProducer(ctx, queueName)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Retry and Backoff Functionality", func() {
+		It("should add failed messages to the failed messages with exponential backoff", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			before := time.Now()
+			Expect(producer.Enqueue(ctx, []byte("test message"), time.Now().UnixMicro())).To(Succeed())
+
+			received := make(chan string, 1)
+			consumerCancel := consume(consumer, received, fail)
+			Eventually(received, 5*time.Second).Should(Receive(Equal("test message")))
+			consumerCancel()
+
+			var failed []model.QueueFailedMessage
+			Eventually(func() []model.QueueFailedMessage {
+				failed = getPostgresFailedMessages(ctx, db, queueName)
+				return failed
+			}, 5*time.Second, 100*time.Millisecond).Should(HaveLen(1))
+			Expect(failed[0].RetryCount).To(Equal(1))
+			Expect(failed[0].Body).To(Equal([]byte("test message")))
+			Expect(failed[0].ProcessID).To(Equal(processID))
+			// the first retry waits BaseDelay * 2, the database clock may differ slightly from the local one
+			Expect(failed[0].RetryAt).To(BeTemporally("~", before.Add(200*time.Millisecond), time.Second))
+
+			// the failed message no longer sits in the queue and keeps its in-flight task open
+			Expect(countPostgresQueueMessages(ctx, db, queueName)).To(BeZero())
+			completed, incomplete := postgresInFlightTasks(ctx, db, queueName)
+			Expect(completed).To(BeZero())
+			Expect(incomplete).To(Equal(1))
+		})
+
+		It("should only retry failed messages that are due", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(producer.Enqueue(ctx, []byte("test retry message"), time.Now().UnixMicro())).To(Succeed())
+
+			// fail the first delivery and succeed the retry
+			var attempts sync.Map
+			received := make(chan string, 2)
+			defer consume(consumer, received, func(payload string) error {
+				if _, retried := attempts.LoadOrStore(payload, true); !retried {
+					return errors.New("processing error")
+				}
+				return nil
+			})()
+			Eventually(received, 5*time.Second).Should(Receive(Equal("test retry message")))
+			Eventually(func() []model.QueueFailedMessage {
+				return getPostgresFailedMessages(ctx, db, queueName)
+			}, 5*time.Second, 100*time.Millisecond).Should(HaveLen(1))
+
+			makePostgresFailedMessagesDue(ctx, db, queueName, false)
+			retried, err := provider.RetryFailedMessages(ctx, queueName, queues.DefaultRetryConfig(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retried).To(BeZero())
+			Expect(getPostgresFailedMessages(ctx, db, queueName)).To(HaveLen(1))
+
+			makePostgresFailedMessagesDue(ctx, db, queueName, true)
+			retried, err = provider.RetryFailedMessages(ctx, queueName, queues.DefaultRetryConfig(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retried).To(Equal(1))
+			Expect(getPostgresFailedMessages(ctx, db, queueName)).To(BeEmpty())
+
+			// the retry is redelivered and completes the in-flight task of the original message
+			Eventually(received, 5*time.Second).Should(Receive(Equal("test retry message")))
+			Eventually(func() []int {
+				completed, incomplete := postgresInFlightTasks(ctx, db, queueName)
+				return []int{completed, incomplete}
+			}, 5*time.Second, 100*time.Millisecond).Should(Equal([]int{1, 0}))
+		})
+
+		It("should carry the retry count over to the redelivered message", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(producer.Enqueue(ctx, []byte("test retry count message"), time.Now().UnixMicro())).To(Succeed())
+
+			received := make(chan string, 2)
+			defer consume(consumer, received, fail)()
+			Eventually(received, 5*time.Second).Should(Receive())
+			Eventually(func() []model.QueueFailedMessage {
+				return getPostgresFailedMessages(ctx, db, queueName)
+			}, 5*time.Second, 100*time.Millisecond).Should(HaveLen(1))
+
+			makePostgresFailedMessagesDue(ctx, db, queueName, true)
+			_, err = provider.RetryFailedMessages(ctx, queueName, queues.DefaultRetryConfig(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(received, 5*time.Second).Should(Receive())
+
+			var failed []model.QueueFailedMessage
+			Eventually(func() []model.QueueFailedMessage {
+				failed = getPostgresFailedMessages(ctx, db, queueName)
+				return failed
+			}, 5*time.Second, 100*time.Millisecond).Should(HaveLen(1))
+			Expect(failed[0].RetryCount).To(Equal(2))
+		})
+
+		It("should respect max retries configuration", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(producer.Enqueue(ctx, []byte("test max retries message"), time.Now().UnixMicro())).To(Succeed())
+
+			received := make(chan string, 1)
+			consumerCancel := consume(consumer, received, fail)
+			Eventually(received, 5*time.Second).Should(Receive())
+			Eventually(func() []model.QueueFailedMessage {
+				return getPostgresFailedMessages(ctx, db, queueName)
+			}, 5*time.Second, 100*time.Millisecond).Should(HaveLen(1))
+			consumerCancel()
+
+			type permanentFailure struct {
+				body       string
+				retryCount int
+			}
+			var failures []permanentFailure
+			makePostgresFailedMessagesDue(ctx, db, queueName, true)
+			retried, err := provider.RetryFailedMessages(ctx, queueName, queues.RetryConfig{
+				BaseDelay:  10 * time.Millisecond,
+				MaxRetries: 1,
+				MaxDelay:   50 * time.Millisecond,
+			}, func(entryID string, body []byte, retryCount int) error {
+				failures = append(failures, permanentFailure{body: string(body), retryCount: retryCount})
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retried).To(BeZero())
+			Expect(failures).To(Equal([]permanentFailure{{body: "test max retries message", retryCount: 1}}))
+
+			// the message is dropped and no longer holds back the checkpoint
+			Expect(getPostgresFailedMessages(ctx, db, queueName)).To(BeEmpty())
+			Expect(countPostgresQueueMessages(ctx, db, queueName)).To(BeZero())
+			completed, incomplete := postgresInFlightTasks(ctx, db, queueName)
+			Expect(completed).To(Equal(1))
+			Expect(incomplete).To(BeZero())
+		})
+	})
+
+	Describe("Visibility Timeout", func() {
+		It("should move messages claimed longer than the timeout to the failed messages", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			// claim the message without completing it
+			claimed := make(chan string, 1)
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				claimed <- entryID
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(producer.Enqueue(ctx, []byte("test timeout message"), time.Now().UnixMicro())).To(Succeed())
+
+			var entryID string
+			Eventually(claimed, 5*time.Second).Should(Receive(&entryID))
+			Expect(countPostgresClaimedMessages(ctx, db, queueName)).To(Equal(int64(1)))
+
+			// a claimed message is neither redelivered nor timed out before the timeout
+			Consistently(claimed, time.Second).ShouldNot(Receive())
+			timedOut, err := provider.ProcessTimedOutMessages(ctx, queueName, time.Hour, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(timedOut).To(BeZero())
+
+			Expect(db.WithContext(ctx).Exec("UPDATE queue_messages SET claimed_at = now() - interval '1 minute' WHERE queue = ?", queueName).Error).ToNot(HaveOccurred())
+			var handled []string
+			timedOut, err = provider.ProcessTimedOutMessages(ctx, queueName, 30*time.Second, func(id string, body []byte) error {
+				handled = append(handled, id+"|"+string(body))
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(timedOut).To(Equal(1))
+			Expect(handled).To(Equal([]string{entryID + "|test timeout message"}))
+
+			Expect(countPostgresQueueMessages(ctx, db, queueName)).To(BeZero())
+			failed := getPostgresFailedMessages(ctx, db, queueName)
+			Expect(failed).To(HaveLen(1))
+			Expect(failed[0].EntryID).To(Equal(entryID))
+			Expect(failed[0].RetryCount).To(Equal(1))
+		})
+	})
+
+	Describe("Checkpoint Advancement", func() {
+		It("should report a missing checkpoint until one is set", func() {
+			err := provider.AdvanceCheckpointAndCleanup(ctx)
+			Expect(errors.Is(err, queues.ErrCheckpointMissing)).To(BeTrue())
+			_, err = provider.GetLatestProcessedTimestamp(ctx)
+			Expect(errors.Is(err, queues.ErrCheckpointMissing)).To(BeTrue())
+
+			Expect(provider.SetCheckpointTimestamp(ctx, time.Time{})).To(Succeed())
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+			checkpoint, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpoint.UnixMicro()).To(BeZero())
+		})
+
+		It("should advance the checkpoint when all tasks complete successfully", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			baseTime := time.Now()
+			for i := 0; i < 3; i++ {
+				Expect(producer.Enqueue(ctx, []byte(fmt.Sprintf("message%d", i)), baseTime.Add(time.Duration(i)*time.Millisecond).UnixMicro())).To(Succeed())
+			}
+			received := make(chan string, 3)
+			defer consume(consumer, received, succeed)()
+			Eventually(func() []int {
+				completed, incomplete := postgresInFlightTasks(ctx, db, queueName)
+				return []int{completed, incomplete}
+			}, 5*time.Second, 100*time.Millisecond).Should(Equal([]int{3, 0}))
+
+			Expect(provider.SetCheckpointTimestamp(ctx, time.Time{})).To(Succeed())
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+
+			checkpoint, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpoint.UnixMicro()).To(Equal(baseTime.Add(2 * time.Millisecond).UnixMicro()))
+
+			// the completed tasks are cleaned up
+			completed, incomplete := postgresInFlightTasks(ctx, db, queueName)
+			Expect(completed).To(BeZero())
+			Expect(incomplete).To(BeZero())
+		})
+
+		It("should not advance the checkpoint past incomplete tasks", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			baseTime := time.Now()
+			timestamps := []time.Time{baseTime, baseTime.Add(100 * time.Millisecond), baseTime.Add(200 * time.Millisecond)}
+			for i, ts := range timestamps {
+				Expect(producer.Enqueue(ctx, []byte(fmt.Sprintf("message%d", i)), ts.UnixMicro())).To(Succeed())
+			}
+			received := make(chan string, 3)
+			defer consume(consumer, received, func(payload string) error {
+				if payload == "message1" {
+					return errors.New("simulated failure")
+				}
+				return nil
+			})()
+			Eventually(func() []int {
+				completed, incomplete := postgresInFlightTasks(ctx, db, queueName)
+				return []int{completed, incomplete}
+			}, 5*time.Second, 100*time.Millisecond).Should(Equal([]int{2, 1}))
+
+			Expect(provider.SetCheckpointTimestamp(ctx, time.Time{})).To(Succeed())
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+
+			checkpoint, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpoint.UnixMicro()).To(Equal(timestamps[0].UnixMicro()))
+
+			// only the tasks up to the checkpoint are cleaned up
+			completed, incomplete := postgresInFlightTasks(ctx, db, queueName)
+			Expect(completed).To(Equal(1))
+			Expect(incomplete).To(Equal(1))
+		})
+
+		It("should advance the checkpoint past permanently failed tasks", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			timestamp := time.Now()
+			Expect(producer.Enqueue(ctx, []byte("test message"), timestamp.UnixMicro())).To(Succeed())
+			received := make(chan string, 1)
+			consumerCancel := consume(consumer, received, fail)
+			Eventually(received, 5*time.Second).Should(Receive())
+			Eventually(func() []model.QueueFailedMessage {
+				return getPostgresFailedMessages(ctx, db, queueName)
+			}, 5*time.Second, 100*time.Millisecond).Should(HaveLen(1))
+			consumerCancel()
+
+			makePostgresFailedMessagesDue(ctx, db, queueName, true)
+			_, err = provider.RetryFailedMessages(ctx, queueName, queues.RetryConfig{MaxRetries: 1}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(provider.SetCheckpointTimestamp(ctx, time.Time{})).To(Succeed())
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+
+			checkpoint, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpoint.UnixMicro()).To(Equal(timestamp.UnixMicro()))
+		})
+
+		It("should not advance the checkpoint backwards", func() {
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			futureTime := time.Now().Add(time.Hour)
+			Expect(provider.SetCheckpointTimestamp(ctx, futureTime)).To(Succeed())
+
+			Expect(producer.Enqueue(ctx, []byte("past message"), time.Now().UnixMicro())).To(Succeed())
+			received := make(chan string, 1)
+			defer consume(consumer, received, succeed)()
+			Eventually(func() []int {
+				completed, incomplete := postgresInFlightTasks(ctx, db, queueName)
+				return []int{completed, incomplete}
+			}, 5*time.Second, 100*time.Millisecond).Should(Equal([]int{1, 0}))
+
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+			checkpoint, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpoint.UnixMicro()).To(Equal(futureTime.UnixMicro()))
+		})
+	})
+
+	Describe("Pub/Sub", func() {
+		It("should broadcast messages to every subscriber", func() {
+			channelName := fmt.Sprintf("test-channel-%s", uuid.New().String())
+			publisher, err := provider.NewPubSubPublisher(ctx, channelName)
+			Expect(err).ToNot(HaveOccurred())
+
+			received := make(chan string, 2)
+			for i := 0; i < 2; i++ {
+				subscriber, err := provider.NewPubSubSubscriber(ctx, channelName)
+				Expect(err).ToNot(HaveOccurred())
+				subscription, err := subscriber.Subscribe(ctx, func(ctx context.Context, payload []byte, log logrus.FieldLogger) error {
+					received <- string(payload)
+					return nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+				defer subscription.Close()
+			}
+
+			Expect(publisher.Publish(ctx, []byte("broadcast"))).To(Succeed())
+			Eventually(received, 5*time.Second).Should(Receive(Equal("broadcast")))
+			Eventually(received, 5*time.Second).Should(Receive(Equal("broadcast")))
+		})
+
+		It("should reject messages exceeding the notification limit", func() {
+			publisher, err := provider.NewPubSubPublisher(ctx, fmt.Sprintf("test-channel-%s", uuid.New().String()))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(publisher.Publish(ctx, []byte(strings.Repeat("x", 8000)))).To(MatchError(ContainSubstring("notification limit")))
+		})
+	})
+})